@@ -0,0 +1,314 @@
+package arbitrage
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/stats"
+)
+
+var (
+	errNoFeeModel   = errors.New("exchange does not support fee calculation")
+	errNoCrossing   = errors.New("orderbooks do not cross")
+	errSameExchange = errors.New("buy and sell exchanges are the same")
+)
+
+// Opportunity holds an arbitrage opportunity between two exchanges. The
+// amount is in the base currency and all profit and fee values are in the
+// quote currency
+type Opportunity struct {
+	Pair                pair.CurrencyPair `json:"pair"`
+	AssetType           string            `json:"assetType"`
+	BuyExchange         string            `json:"buyExchange"`
+	SellExchange        string            `json:"sellExchange"`
+	Amount              float64           `json:"amount"`
+	BuyPrice            float64           `json:"buyPrice"`
+	SellPrice           float64           `json:"sellPrice"`
+	GrossProfit         float64           `json:"grossProfit"`
+	TradingFees         float64           `json:"tradingFees"`
+	WithdrawalFee       float64           `json:"withdrawalFee"`
+	NetProfit           float64           `json:"netProfit"`
+	NetProfitPercentage float64           `json:"netProfitPercentage"`
+	Time                time.Time         `json:"time"`
+}
+
+// String returns a summary of the opportunity
+func (o *Opportunity) String() string {
+	return fmt.Sprintf("Arbitrage %s %s: buy %f on %s at %f, sell on %s at %f. Net profit %f %s (%.4f%%) after %f trading and %f withdrawal fees.",
+		o.Pair.Pair().String(), o.AssetType, o.Amount, o.BuyExchange,
+		o.BuyPrice, o.SellExchange, o.SellPrice, o.NetProfit,
+		o.Pair.SecondCurrency.String(), o.NetProfitPercentage, o.TradingFees,
+		o.WithdrawalFee)
+}
+
+// Key returns an identifier for the exchanges, pair and asset type of the
+// opportunity
+func (o *Opportunity) Key() string {
+	return fmt.Sprintf("%s-%s-%s-%s", o.BuyExchange, o.SellExchange,
+		o.Pair.Pair().String(), o.AssetType)
+}
+
+// Scanner finds arbitrage opportunities by walking the orderbook depth of
+// exchanges trading the same currency pair
+type Scanner struct {
+	// MinNetProfitPercentage is the minimum net profit, as a percentage of the
+	// buy cost, required for an opportunity to be reported
+	MinNetProfitPercentage float64
+	// MaxAmount limits the base currency amount of an opportunity, zero is
+	// unlimited
+	MaxAmount float64
+
+	fees   exchange.FeeRateCache
+	errLog errorLog
+}
+
+// MatchOrderbooks walks the asks of the buy orderbook against the bids of the
+// sell orderbook while the ask price is below the bid price. It returns the
+// crossed base amount, the quote cost of buying it and the quote value of
+// selling it. A maxAmount of zero is unlimited
+func MatchOrderbooks(asks, bids []orderbook.Item, maxAmount float64) (amount, cost, value float64) {
+	var a, b int
+	var askRemaining, bidRemaining float64
+	if len(asks) > 0 {
+		askRemaining = asks[0].Amount
+	}
+	if len(bids) > 0 {
+		bidRemaining = bids[0].Amount
+	}
+
+	for a < len(asks) && b < len(bids) {
+		if asks[a].Price >= bids[b].Price {
+			break
+		}
+
+		fill := askRemaining
+		if bidRemaining < fill {
+			fill = bidRemaining
+		}
+		if maxAmount > 0 && amount+fill > maxAmount {
+			fill = maxAmount - amount
+		}
+
+		amount += fill
+		cost += fill * asks[a].Price
+		value += fill * bids[b].Price
+		askRemaining -= fill
+		bidRemaining -= fill
+
+		if maxAmount > 0 && amount >= maxAmount {
+			break
+		}
+
+		if askRemaining <= 0 {
+			a++
+			if a < len(asks) {
+				askRemaining = asks[a].Amount
+			}
+		}
+
+		if bidRemaining <= 0 {
+			b++
+			if b < len(bids) {
+				bidRemaining = bids[b].Amount
+			}
+		}
+	}
+	return amount, cost, value
+}
+
+// Evaluate calculates the opportunity of buying on one exchange and selling
+// on another using their orderbooks. Taker fees are charged on both legs and
+// the withdrawal fee of moving the base currency from the buy exchange to the
+// sell exchange is deducted
+func (s *Scanner) Evaluate(buy, sell exchange.IBotExchange, p pair.CurrencyPair, assetType string, buyBook, sellBook orderbook.Base) (Opportunity, error) {
+	if common.StringToUpper(buy.GetName()) == common.StringToUpper(sell.GetName()) {
+		return Opportunity{}, errSameExchange
+	}
+
+	amount, cost, value := MatchOrderbooks(buyBook.Asks, sellBook.Bids,
+		s.MaxAmount)
+	if amount <= 0 {
+		return Opportunity{}, errNoCrossing
+	}
+
+	o := Opportunity{
+		Pair:         p,
+		AssetType:    assetType,
+		BuyExchange:  buy.GetName(),
+		SellExchange: sell.GetName(),
+		Amount:       amount,
+		BuyPrice:     cost / amount,
+		SellPrice:    value / amount,
+		GrossProfit:  value - cost,
+		Time:         time.Now(),
+	}
+
+	buyFee, err := s.tradingFee(buy, o.Pair, o.BuyPrice, amount)
+	if err != nil {
+		return o, err
+	}
+
+	sellFee, err := s.tradingFee(sell, o.Pair, o.SellPrice, amount)
+	if err != nil {
+		return o, err
+	}
+	o.TradingFees = buyFee + sellFee
+
+	fees, ok := buy.(exchange.FeeModel)
+	if !ok {
+		return o, errNoFeeModel
+	}
+
+	withdrawalFee, err := fees.GetFeeByType(exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyWithdrawalFee,
+		FirstCurrency: o.Pair.FirstCurrency.Upper().String(),
+		PurchasePrice: o.BuyPrice,
+		Amount:        amount,
+	})
+	if err != nil {
+		return o, err
+	}
+	// withdrawal fees are charged in the withdrawn base currency
+	o.WithdrawalFee = withdrawalFee * o.SellPrice
+
+	o.NetProfit = o.GrossProfit - o.TradingFees - o.WithdrawalFee
+	o.NetProfitPercentage = o.NetProfit / cost * 100
+	return o, nil
+}
+
+// Scan evaluates every pair of exchanges trading the same currency pair, as
+// recorded by the stats package, using their latest stored orderbooks and
+// returns the opportunities above the minimum net profit sorted by net profit
+func (s *Scanner) Scan(exchanges []exchange.IBotExchange) []Opportunity {
+	var opportunities []Opportunity
+	for _, m := range getMarkets(exchanges) {
+		for i := range m {
+			for j := range m {
+				if i == j || len(m[i].ob.Asks) == 0 || len(m[j].ob.Bids) == 0 ||
+					m[i].ob.Asks[0].Price >= m[j].ob.Bids[0].Price {
+					continue
+				}
+
+				o, err := s.Evaluate(m[i].exch, m[j].exch, m[i].pair,
+					m[i].assetType, m[i].ob, m[j].ob)
+				if err != nil {
+					s.errLog.logOnce(fmt.Sprintf("%s to %s", m[i].exch.GetName(),
+						m[j].exch.GetName()), err)
+					continue
+				}
+
+				if o.NetProfitPercentage < s.MinNetProfitPercentage {
+					continue
+				}
+				opportunities = append(opportunities, o)
+			}
+		}
+	}
+
+	sort.Slice(opportunities, func(i, j int) bool {
+		return opportunities[i].NetProfit > opportunities[j].NetProfit
+	})
+	return opportunities
+}
+
+// market holds an exchange and its orderbook for a currency pair
+type market struct {
+	exch      exchange.IBotExchange
+	pair      pair.CurrencyPair
+	assetType string
+	ob        orderbook.Base
+}
+
+// getMarkets groups the stored orderbooks of the supplied exchanges by
+// currency pair and asset type, only including pairs traded on more than one
+// exchange
+func getMarkets(exchanges []exchange.IBotExchange) map[string][]market {
+	markets := make(map[string][]market)
+	for _, item := range stats.GetItems() {
+		var exch exchange.IBotExchange
+		for i := range exchanges {
+			if exchanges[i] != nil && exchanges[i].GetName() == item.Exchange {
+				exch = exchanges[i]
+				break
+			}
+		}
+
+		if exch == nil {
+			continue
+		}
+
+		// Stats also records some pairs under a normalised pair, such as
+		// USDT quoted pairs as USD, which will not have a stored orderbook
+		ob, err := orderbook.GetOrderbook(item.Exchange, item.Pair, item.AssetType)
+		if err != nil {
+			continue
+		}
+
+		key := item.Pair.Pair().Upper().String() + item.AssetType
+		markets[key] = append(markets[key], market{
+			exch:      exch,
+			pair:      item.Pair,
+			assetType: item.AssetType,
+			ob:        ob,
+		})
+	}
+
+	for k := range markets {
+		if len(markets[k]) < 2 {
+			delete(markets, k)
+		}
+	}
+	return markets
+}
+
+// tradingFee returns the taker fee for a trade
+func (s *Scanner) tradingFee(exch exchange.IBotExchange, p pair.CurrencyPair, price, amount float64) (float64, error) {
+	rate, err := tradingFeeRate(&s.fees, exch, p, false, price, amount)
+	if err != nil {
+		return 0, err
+	}
+	return price * amount * rate, nil
+}
+
+// tradingFeeRate returns the maker or taker trading fee rate of an exchange
+// as a fraction of the trade value from the fee rate cache
+func tradingFeeRate(fees *exchange.FeeRateCache, exch exchange.IBotExchange, p pair.CurrencyPair, isMaker bool, price, amount float64) (float64, error) {
+	feeModel, ok := exch.(exchange.FeeModel)
+	if !ok {
+		return 0, errNoFeeModel
+	}
+	return fees.Rate(feeModel, exch.GetName(), p, isMaker, price, amount)
+}
+
+// errorLog logs each distinct error once so errors which recur on every scan,
+// such as an unsupported fee model, do not flood the log
+type errorLog struct {
+	logged map[string]bool
+	m      sync.Mutex
+}
+
+// logOnce logs an error for the description if it has not already been logged
+func (e *errorLog) logOnce(description string, err error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.logged == nil {
+		e.logged = make(map[string]bool)
+	}
+
+	key := description + err.Error()
+	if e.logged[key] {
+		return
+	}
+	e.logged[key] = true
+	log.Printf("Arbitrage scanner: unable to evaluate %s. Error: %s\n",
+		description, err)
+}
@@ -0,0 +1,133 @@
+package arbitrage
+
+import (
+	"math"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/stats"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+type testExchange struct {
+	exchange.IBotExchange
+	name string
+}
+
+func (e *testExchange) GetName() string {
+	return e.name
+}
+
+// GetFeeByType charges a 0.1% taker fee and a 0.01 base currency withdrawal
+// fee
+func (e *testExchange) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+	if feeBuilder.FeeType == exchange.CryptocurrencyWithdrawalFee {
+		return 0.01, nil
+	}
+	return feeBuilder.PurchasePrice * feeBuilder.Amount * 0.001, nil
+}
+
+func TestMatchOrderbooks(t *testing.T) {
+	asks := []orderbook.Item{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}, {Price: 105, Amount: 5}}
+	bids := []orderbook.Item{{Price: 103, Amount: 0.5}, {Price: 102, Amount: 2}, {Price: 90, Amount: 5}}
+
+	amount, cost, value := MatchOrderbooks(asks, bids, 0)
+	if amount != 2 || cost != 201 || value != 0.5*103+1.5*102 {
+		t.Errorf("Test failed. Unexpected match %f %f %f", amount, cost, value)
+	}
+
+	amount, cost, _ = MatchOrderbooks(asks, bids, 0.75)
+	if amount != 0.75 || cost != 75 {
+		t.Errorf("Test failed. Unexpected limited match %f %f", amount, cost)
+	}
+
+	amount, _, _ = MatchOrderbooks(bids, asks, 0)
+	if amount != 0 {
+		t.Errorf("Test failed. Expected no match, received %f", amount)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	var s Scanner
+	buy := &testExchange{name: "Buy"}
+	sell := &testExchange{name: "Sell"}
+	p := pair.NewCurrencyPair("BTC", "USD")
+
+	buyBook := orderbook.Base{Asks: []orderbook.Item{{Price: 100, Amount: 1}}}
+	sellBook := orderbook.Base{Bids: []orderbook.Item{{Price: 110, Amount: 1}}}
+
+	o, err := s.Evaluate(buy, sell, p, ticker.Spot, buyBook, sellBook)
+	if err != nil {
+		t.Fatal("Test failed. Evaluate error", err)
+	}
+
+	// gross 10, taker fees 0.1 + 0.11, withdrawal 0.01 BTC at 110
+	if math.Abs(o.NetProfit-(10-0.21-1.1)) > 1e-9 {
+		t.Errorf("Test failed. Unexpected net profit %f", o.NetProfit)
+	}
+
+	if math.Abs(o.NetProfitPercentage-8.69) > 1e-9 {
+		t.Errorf("Test failed. Unexpected net profit percentage %f",
+			o.NetProfitPercentage)
+	}
+
+	_, err = s.Evaluate(buy, buy, p, ticker.Spot, buyBook, sellBook)
+	if err != errSameExchange {
+		t.Errorf("Test failed. Expected %s, received %v", errSameExchange, err)
+	}
+
+	_, err = s.Evaluate(sell, buy, p, ticker.Spot, sellBook, buyBook)
+	if err != errNoCrossing {
+		t.Errorf("Test failed. Expected %s, received %v", errNoCrossing, err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	p := pair.NewCurrencyPair("ETH", "EUR")
+	exchanges := []exchange.IBotExchange{
+		&testExchange{name: "ArbA"},
+		&testExchange{name: "ArbB"},
+		&testExchange{name: "ArbC"},
+	}
+
+	books := []orderbook.Base{
+		{
+			Bids: []orderbook.Item{{Price: 99, Amount: 1}},
+			Asks: []orderbook.Item{{Price: 100, Amount: 1}},
+		},
+		{
+			Bids: []orderbook.Item{{Price: 120, Amount: 1}},
+			Asks: []orderbook.Item{{Price: 121, Amount: 1}},
+		},
+		{
+			Bids: []orderbook.Item{{Price: 101, Amount: 1}},
+			Asks: []orderbook.Item{{Price: 102, Amount: 1}},
+		},
+	}
+
+	for i := range exchanges {
+		orderbook.ProcessOrderbook(exchanges[i].GetName(), p, books[i], ticker.Spot)
+		stats.Add(exchanges[i].GetName(), p, ticker.Spot, books[i].Asks[0].Price, 1)
+	}
+
+	s := Scanner{MinNetProfitPercentage: 5}
+	opportunities := s.Scan(exchanges)
+	if len(opportunities) != 2 {
+		t.Fatalf("Test failed. Expected 2 opportunities, received %d",
+			len(opportunities))
+	}
+
+	if opportunities[0].BuyExchange != "ArbA" ||
+		opportunities[0].SellExchange != "ArbB" {
+		t.Errorf("Test failed. Unexpected best opportunity %s",
+			opportunities[0].String())
+	}
+
+	// ArbA to ArbC is unprofitable after fees
+	s.MinNetProfitPercentage = 0
+	if len(s.Scan(exchanges)) != 2 {
+		t.Error("Test failed. Expected unprofitable opportunity to be excluded")
+	}
+}
@@ -0,0 +1,260 @@
+package arbitrage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+)
+
+// Leg holds a single trade of a triangular arbitrage loop. Amount is the
+// executable order amount in the base currency of the pair, Input and Output
+// are the amounts of the From and To currencies spent and received after fees
+type Leg struct {
+	Pair    pair.CurrencyPair  `json:"pair"`
+	Side    exchange.OrderSide `json:"side"`
+	From    string             `json:"from"`
+	To      string             `json:"to"`
+	Price   float64            `json:"price"`
+	Amount  float64            `json:"amount"`
+	Input   float64            `json:"input"`
+	Output  float64            `json:"output"`
+	FeeRate float64            `json:"feeRate"`
+}
+
+// TriangularOpportunity holds a profitable loop of three trades on a single
+// exchange starting and ending in the same currency
+type TriangularOpportunity struct {
+	Exchange         string    `json:"exchange"`
+	AssetType        string    `json:"assetType"`
+	Currency         string    `json:"currency"`
+	Legs             []Leg     `json:"legs"`
+	StartAmount      float64   `json:"startAmount"`
+	EndAmount        float64   `json:"endAmount"`
+	Profit           float64   `json:"profit"`
+	ProfitPercentage float64   `json:"profitPercentage"`
+	Time             time.Time `json:"time"`
+}
+
+// String returns a summary of the opportunity
+func (t *TriangularOpportunity) String() string {
+	path := t.Currency
+	for i := range t.Legs {
+		path += fmt.Sprintf(" -> %s %s %f at %f -> %s", t.Legs[i].Side,
+			t.Legs[i].Pair.Pair().String(), t.Legs[i].Amount, t.Legs[i].Price,
+			t.Legs[i].To)
+	}
+	return fmt.Sprintf("Triangular arbitrage %s %s: %s. Start %f end %f %s, profit %.4f%%.",
+		t.Exchange, t.AssetType, path, t.StartAmount, t.EndAmount, t.Currency,
+		t.ProfitPercentage)
+}
+
+// Key returns an identifier for the exchange, asset type and path of the
+// opportunity
+func (t *TriangularOpportunity) Key() string {
+	key := t.Exchange + "-" + t.AssetType + "-" + t.Currency
+	for i := range t.Legs {
+		key += "-" + t.Legs[i].To
+	}
+	return key
+}
+
+// edge is a conversion from one currency to another through a single trade at
+// the best bid or ask. Rate is the amount of the To currency received per unit
+// of the From currency after fees and Capacity is the maximum From currency
+// amount which can be spent at the top of the orderbook
+type edge struct {
+	pair     pair.CurrencyPair
+	side     exchange.OrderSide
+	from     string
+	to       string
+	price    float64
+	rate     float64
+	capacity float64
+	feeRate  float64
+}
+
+// TriangularScanner finds triangular arbitrage loops within a single exchange
+// using the best bid and ask of each enabled pair
+type TriangularScanner struct {
+	// MinProfitPercentage is the minimum profit after fees required for a
+	// loop to be reported
+	MinProfitPercentage float64
+	// UseMakerFees evaluates loops using maker fees, for legs executed with
+	// resting orders, instead of taker fees
+	UseMakerFees bool
+
+	fees   exchange.FeeRateCache
+	errLog errorLog
+}
+
+// Scan builds a currency graph from the enabled pairs of the exchange and
+// returns the profitable loops of three trades, sorted by profit percentage
+func (t *TriangularScanner) Scan(exch exchange.IBotExchange, assetType string) []TriangularOpportunity {
+	var edges []edge
+	for _, p := range exch.GetEnabledCurrencies() {
+		ob, err := orderbook.GetOrderbook(exch.GetName(), p, assetType)
+		if err != nil {
+			continue
+		}
+
+		pairEdges, err := t.getEdges(exch, p, ob)
+		if err != nil {
+			t.errLog.logOnce(fmt.Sprintf("%s %s", exch.GetName(),
+				p.Pair().String()), err)
+			continue
+		}
+		edges = append(edges, pairEdges...)
+	}
+
+	opportunities := findTriangularLoops(edges, t.MinProfitPercentage)
+	for i := range opportunities {
+		opportunities[i].Exchange = exch.GetName()
+		opportunities[i].AssetType = assetType
+	}
+	return opportunities
+}
+
+// getEdges returns the conversions for a pair, selling the base currency at
+// the best bid and buying it at the best ask
+func (t *TriangularScanner) getEdges(exch exchange.IBotExchange, p pair.CurrencyPair, ob orderbook.Base) ([]edge, error) {
+	base := p.FirstCurrency.Upper().String()
+	quote := p.SecondCurrency.Upper().String()
+	p = pair.NewCurrencyPair(base, quote)
+
+	var edges []edge
+	if len(ob.Bids) > 0 && ob.Bids[0].Price > 0 && ob.Bids[0].Amount > 0 {
+		bid := ob.Bids[0]
+		feeRate, err := tradingFeeRate(&t.fees, exch, p, t.UseMakerFees, bid.Price, bid.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		edges = append(edges, edge{
+			pair:     p,
+			side:     exchange.Sell,
+			from:     base,
+			to:       quote,
+			price:    bid.Price,
+			rate:     bid.Price * (1 - feeRate),
+			capacity: bid.Amount,
+			feeRate:  feeRate,
+		})
+	}
+
+	if len(ob.Asks) > 0 && ob.Asks[0].Price > 0 && ob.Asks[0].Amount > 0 {
+		ask := ob.Asks[0]
+		feeRate, err := tradingFeeRate(&t.fees, exch, p, t.UseMakerFees, ask.Price, ask.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		edges = append(edges, edge{
+			pair:     p,
+			side:     exchange.Buy,
+			from:     quote,
+			to:       base,
+			price:    ask.Price,
+			rate:     (1 - feeRate) / ask.Price,
+			capacity: ask.Amount * ask.Price,
+			feeRate:  feeRate,
+		})
+	}
+	return edges, nil
+}
+
+// findTriangularLoops evaluates every loop of three conversions in the
+// currency graph and returns those with a profit above the minimum
+// percentage. Each loop is reported once, starting from its alphabetically
+// lowest currency, and sized to the largest amount executable at every leg
+func findTriangularLoops(edges []edge, minProfitPercentage float64) []TriangularOpportunity {
+	graph := make(map[string][]edge)
+	for i := range edges {
+		graph[edges[i].from] = append(graph[edges[i].from], edges[i])
+	}
+
+	var opportunities []TriangularOpportunity
+	for start, first := range graph {
+		for _, a := range first {
+			if a.to <= start {
+				continue
+			}
+
+			for _, b := range graph[a.to] {
+				if b.to <= start {
+					continue
+				}
+
+				for _, c := range graph[b.to] {
+					if c.to != start {
+						continue
+					}
+
+					o, ok := evaluateLoop(start, []edge{a, b, c})
+					if ok && o.ProfitPercentage > minProfitPercentage {
+						opportunities = append(opportunities, o)
+					}
+				}
+			}
+		}
+	}
+
+	sort.Slice(opportunities, func(i, j int) bool {
+		return opportunities[i].ProfitPercentage > opportunities[j].ProfitPercentage
+	})
+	return opportunities
+}
+
+// evaluateLoop calculates the profit of a loop and the largest start amount
+// executable at the top of the orderbook for every leg
+func evaluateLoop(start string, loop []edge) (TriangularOpportunity, bool) {
+	factor := 1.0
+	startAmount := loop[0].capacity
+	for i := range loop {
+		if loop[i].rate <= 0 {
+			return TriangularOpportunity{}, false
+		}
+
+		// the amount reaching this leg per unit of the start currency is
+		// the product of the previous rates
+		if limit := loop[i].capacity / factor; limit < startAmount {
+			startAmount = limit
+		}
+		factor *= loop[i].rate
+	}
+
+	o := TriangularOpportunity{
+		Currency:         start,
+		StartAmount:      startAmount,
+		EndAmount:        startAmount * factor,
+		Profit:           startAmount*factor - startAmount,
+		ProfitPercentage: (factor - 1) * 100,
+		Time:             time.Now(),
+	}
+
+	input := startAmount
+	for i := range loop {
+		output := input * loop[i].rate
+		amount := input
+		if loop[i].side == exchange.Buy {
+			amount = input / loop[i].price
+		}
+
+		o.Legs = append(o.Legs, Leg{
+			Pair:    loop[i].pair,
+			Side:    loop[i].side,
+			From:    loop[i].from,
+			To:      loop[i].to,
+			Price:   loop[i].price,
+			Amount:  amount,
+			Input:   input,
+			Output:  output,
+			FeeRate: loop[i].feeRate,
+		})
+		input = output
+	}
+	return o, true
+}
@@ -0,0 +1,85 @@
+package arbitrage
+
+import (
+	"math"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+type triangularExchange struct {
+	testExchange
+	pairs []pair.CurrencyPair
+}
+
+func (e *triangularExchange) GetEnabledCurrencies() []pair.CurrencyPair {
+	return e.pairs
+}
+
+func TestTriangularScan(t *testing.T) {
+	exch := &triangularExchange{
+		testExchange: testExchange{name: "Triangle"},
+		pairs: []pair.CurrencyPair{
+			pair.NewCurrencyPair("ETH", "BTC"),
+			pair.NewCurrencyPair("BTC", "USDT"),
+			pair.NewCurrencyPair("ETH", "USDT"),
+		},
+	}
+
+	// buying ETH with USDT at 200, selling it for BTC at 0.021 and selling
+	// the BTC for USDT at 10000 returns 210 USDT per 200 spent before fees
+	books := []orderbook.Base{
+		{
+			Bids: []orderbook.Item{{Price: 0.021, Amount: 10}},
+			Asks: []orderbook.Item{{Price: 0.022, Amount: 10}},
+		},
+		{
+			Bids: []orderbook.Item{{Price: 10000, Amount: 0.042}},
+			Asks: []orderbook.Item{{Price: 10010, Amount: 1}},
+		},
+		{
+			Bids: []orderbook.Item{{Price: 199, Amount: 10}},
+			Asks: []orderbook.Item{{Price: 200, Amount: 5}},
+		},
+	}
+
+	for i := range exch.pairs {
+		orderbook.ProcessOrderbook(exch.GetName(), exch.pairs[i], books[i], ticker.Spot)
+	}
+
+	var s TriangularScanner
+	opportunities := s.Scan(exch, ticker.Spot)
+	if len(opportunities) != 1 {
+		t.Fatalf("Test failed. Expected 1 opportunity, received %d",
+			len(opportunities))
+	}
+
+	o := opportunities[0]
+	if o.Currency != "BTC" || len(o.Legs) != 3 {
+		t.Fatalf("Test failed. Unexpected opportunity %s", o.String())
+	}
+
+	// three legs with a 0.1% taker fee each
+	expected := (1.05*math.Pow(0.999, 3) - 1) * 100
+	if math.Abs(o.ProfitPercentage-expected) > 1e-9 {
+		t.Errorf("Test failed. Expected profit of %f%%, received %f%%",
+			expected, o.ProfitPercentage)
+	}
+
+	// the BTC-USDT bid of 0.042 BTC limits the size of the loop
+	if o.Legs[0].Side != exchange.Sell || o.Legs[0].Amount != 0.042 {
+		t.Errorf("Test failed. Unexpected first leg %v", o.Legs[0])
+	}
+
+	if o.Legs[2].Side != exchange.Sell || o.Legs[2].Pair.Pair().String() != "ETHBTC" {
+		t.Errorf("Test failed. Unexpected final leg %v", o.Legs[2])
+	}
+
+	s.MinProfitPercentage = 5
+	if len(s.Scan(exch, ticker.Spot)) != 0 {
+		t.Error("Test failed. Expected no opportunities above 5%")
+	}
+}
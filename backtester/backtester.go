@@ -0,0 +1,192 @@
+package backtester
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+// Strategy is called for each replayed period with the simulated exchange,
+// strategies should only interact with the market through the supplied
+// exchange so they run unchanged against a live exchange
+type Strategy interface {
+	OnTicker(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string, tick ticker.Price) error
+}
+
+// Trade holds a simulated fill
+type Trade struct {
+	Time     time.Time          `json:"time"`
+	OrderID  string             `json:"orderID"`
+	ClientID string             `json:"clientID"`
+	Side     exchange.OrderSide `json:"side"`
+	Price    float64            `json:"price"`
+	Amount   float64            `json:"amount"`
+	Fee      float64            `json:"fee"`
+}
+
+// EquityPoint holds the total account value, in the quote currency, at the
+// close of a replayed period
+type EquityPoint struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// Report holds the results of a backtest, values are denominated in the quote
+// currency of the replayed pair
+type Report struct {
+	Exchange         string                 `json:"exchange"`
+	Pair             pair.CurrencyPair      `json:"pair"`
+	Start            time.Time              `json:"start"`
+	End              time.Time              `json:"end"`
+	Periods          int                    `json:"periods"`
+	InitialValue     float64                `json:"initialValue"`
+	FinalValue       float64                `json:"finalValue"`
+	ProfitLoss       float64                `json:"profitLoss"`
+	ReturnPercentage float64                `json:"returnPercentage"`
+	MaxDrawdown      float64                `json:"maxDrawdown"`
+	SharpeRatio      float64                `json:"sharpeRatio"`
+	TotalFees        float64                `json:"totalFees"`
+	Trades           []Trade                `json:"trades"`
+	Orders           []exchange.OrderDetail `json:"orders"`
+	Equity           []EquityPoint          `json:"equity"`
+	FinalBalances    map[string]float64     `json:"finalBalances"`
+}
+
+// Run replays all market data loaded into the simulated exchange through the
+// strategy and returns the results
+func Run(exch *Exchange, strategy Strategy) (Report, error) {
+	report := Report{
+		Exchange: exch.GetName(),
+		Pair:     exch.pair,
+	}
+
+	for {
+		ok, err := exch.Next()
+		if err != nil {
+			return report, err
+		}
+		if !ok {
+			break
+		}
+
+		c, err := exch.CurrentCandle()
+		if err != nil {
+			return report, err
+		}
+
+		if report.Periods == 0 {
+			report.Start = c.Time
+			report.InitialValue = exch.value(c.Close)
+		}
+		report.Periods++
+		report.End = c.Time
+
+		tick, err := exch.GetTickerPrice(context.Background(), exch.pair, exch.assetType)
+		if err != nil {
+			return report, err
+		}
+
+		err = strategy.OnTicker(exch, exch.pair, exch.assetType, tick)
+		if err != nil {
+			return report, err
+		}
+
+		report.Equity = append(report.Equity, EquityPoint{
+			Time:  c.Time,
+			Value: exch.value(c.Close),
+		})
+	}
+
+	report.Trades = exch.GetTrades()
+	report.Orders, _ = exch.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{})
+	report.FinalBalances = exch.GetBalances()
+	for i := range report.Trades {
+		report.TotalFees += report.Trades[i].Fee
+	}
+
+	if len(report.Equity) > 0 {
+		report.FinalValue = report.Equity[len(report.Equity)-1].Value
+	}
+	report.ProfitLoss = report.FinalValue - report.InitialValue
+	if report.InitialValue != 0 {
+		report.ReturnPercentage = report.ProfitLoss / report.InitialValue * 100
+	}
+	report.MaxDrawdown = MaxDrawdown(report.Equity)
+	report.SharpeRatio = SharpeRatio(report.Equity)
+	return report, nil
+}
+
+// value returns the total account value in the quote currency at the price
+func (e *Exchange) value(price float64) float64 {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.balances[e.pair.SecondCurrency.String()] +
+		e.balances[e.pair.FirstCurrency.String()]*price
+}
+
+// MaxDrawdown returns the largest peak to trough decline of the equity curve
+// as a percentage
+func MaxDrawdown(equity []EquityPoint) float64 {
+	var peak, maxDrawdown float64
+	for i := range equity {
+		if equity[i].Value > peak {
+			peak = equity[i].Value
+		}
+		if peak <= 0 {
+			continue
+		}
+		drawdown := (peak - equity[i].Value) / peak * 100
+		if drawdown > maxDrawdown {
+			maxDrawdown = drawdown
+		}
+	}
+	return maxDrawdown
+}
+
+// SharpeRatio returns the annualised Sharpe ratio of the per period returns of
+// the equity curve, assuming a risk free rate of zero. The period length is
+// taken from the first two equity points
+func SharpeRatio(equity []EquityPoint) float64 {
+	if len(equity) < 3 {
+		return 0
+	}
+
+	period := equity[1].Time.Sub(equity[0].Time)
+	if period <= 0 {
+		return 0
+	}
+
+	var returns []float64
+	for i := 1; i < len(equity); i++ {
+		if equity[i-1].Value == 0 {
+			continue
+		}
+		returns = append(returns, equity[i].Value/equity[i-1].Value-1)
+	}
+
+	if len(returns) < 2 {
+		return 0
+	}
+
+	var mean float64
+	for i := range returns {
+		mean += returns[i]
+	}
+	mean /= float64(len(returns))
+
+	var variance float64
+	for i := range returns {
+		variance += (returns[i] - mean) * (returns[i] - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(returns)-1))
+	if stdDev == 0 {
+		return 0
+	}
+
+	periodsPerYear := float64(365*24*time.Hour) / float64(period)
+	return mean / stdDev * math.Sqrt(periodsPerYear)
+}
@@ -0,0 +1,273 @@
+package backtester
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+var testPair = pair.NewCurrencyPair("BTC", "USD")
+
+func testCandles() []exchange.Candle {
+	start := time.Unix(1540000000, 0)
+	closes := []float64{100, 110, 120, 90, 130}
+	var candles []exchange.Candle
+	for i := range closes {
+		open := closes[i]
+		if i > 0 {
+			open = closes[i-1]
+		}
+		candles = append(candles, exchange.Candle{
+			Time:   start.Add(time.Duration(i) * time.Hour),
+			Open:   open,
+			High:   math.Max(open, closes[i]) + 5,
+			Low:    math.Min(open, closes[i]) - 5,
+			Close:  closes[i],
+			Volume: 10,
+		})
+	}
+	return candles
+}
+
+type buyAndSell struct {
+	ticks int
+}
+
+func (b *buyAndSell) OnTicker(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string, tick ticker.Price) error {
+	b.ticks++
+	switch b.ticks {
+	case 1:
+		_, err := exch.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, ClientID: "buy"})
+		return err
+	case 4:
+		_, err := exch.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Sell, OrderType: exchange.Market, Amount: 1, ClientID: "sell"})
+		return err
+	}
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	exch, err := NewExchange("Backtest", testPair, ticker.Spot, testCandles(),
+		map[string]float64{"usd": 1000}, FixedFee{Taker: 0.01})
+	if err != nil {
+		t.Fatal("Test failed. NewExchange error", err)
+	}
+
+	strategy := &buyAndSell{}
+	report, err := Run(exch, strategy)
+	if err != nil {
+		t.Fatal("Test failed. Run error", err)
+	}
+
+	if strategy.ticks != 5 || report.Periods != 5 {
+		t.Errorf("Test failed. Expected 5 periods, received %d", report.Periods)
+	}
+
+	if len(report.Trades) != 2 {
+		t.Fatalf("Test failed. Expected 2 trades, received %d", len(report.Trades))
+	}
+
+	// bought at the second candles open of 100, sold at the fifth candles open
+	// of 90 with a 1% taker fee on each fill
+	if report.Trades[0].Price != 100 || report.Trades[1].Price != 90 {
+		t.Errorf("Test failed. Unexpected fill prices %v", report.Trades)
+	}
+
+	if math.Abs(report.TotalFees-1.9) > 1e-9 {
+		t.Errorf("Test failed. Expected fees of 1.9, received %f", report.TotalFees)
+	}
+
+	if math.Abs(report.ProfitLoss+11.9) > 1e-9 {
+		t.Errorf("Test failed. Expected P&L of -11.9, received %f", report.ProfitLoss)
+	}
+
+	if report.FinalBalances["BTC"] != 0 {
+		t.Errorf("Test failed. Expected no BTC balance, received %f", report.FinalBalances["BTC"])
+	}
+
+	if report.MaxDrawdown <= 0 {
+		t.Error("Test failed. Expected a drawdown")
+	}
+
+	if len(report.Orders) != 2 {
+		t.Errorf("Test failed. Expected 2 orders, received %d", len(report.Orders))
+	}
+}
+
+func TestLimitOrders(t *testing.T) {
+	exch, err := NewExchange("Backtest", testPair, ticker.Spot, testCandles(),
+		map[string]float64{"USD": 1000}, nil)
+	if err != nil {
+		t.Fatal("Test failed. NewExchange error", err)
+	}
+
+	_, err = exch.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 90})
+	if err != errNotStarted {
+		t.Errorf("Test failed. Expected %s, received %v", errNotStarted, err)
+	}
+
+	exch.Next()
+	_, err = exch.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 20, Price: 90})
+	if err != errInsufficientBalance {
+		t.Errorf("Test failed. Expected %s, received %v", errInsufficientBalance, err)
+	}
+
+	resp, err := exch.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 90})
+	if err != nil || !resp.IsOrderPlaced {
+		t.Fatal("Test failed. SubmitOrder error", err)
+	}
+
+	active, _ := exch.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if len(active) != 1 {
+		t.Fatalf("Test failed. Expected 1 active order, received %d", len(active))
+	}
+
+	// the next two candles do not trade down to 90
+	exch.Next()
+	exch.Next()
+	active, _ = exch.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if len(active) != 1 {
+		t.Fatalf("Test failed. Expected 1 active order, received %d", len(active))
+	}
+
+	exch.Next()
+	active, _ = exch.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if len(active) != 0 {
+		t.Errorf("Test failed. Expected no active orders, received %d", len(active))
+	}
+
+	balances := exch.GetBalances()
+	if balances["BTC"] != 1 || balances["USD"] != 910 {
+		t.Errorf("Test failed. Unexpected balances %v", balances)
+	}
+
+	resp, err = exch.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Sell, OrderType: exchange.Limit, Amount: 1, Price: 1000})
+	if err != nil {
+		t.Fatal("Test failed. SubmitOrder error", err)
+	}
+
+	err = exch.CancelOrder(context.Background(), exchange.OrderCancellation{OrderID: resp.OrderID})
+	if err != nil {
+		t.Error("Test failed. CancelOrder error", err)
+	}
+
+	err = exch.CancelOrder(context.Background(), exchange.OrderCancellation{OrderID: resp.OrderID})
+	if err != errOrderNotFound {
+		t.Errorf("Test failed. Expected %s, received %v", errOrderNotFound, err)
+	}
+}
+
+func TestSimulatedMarketData(t *testing.T) {
+	exch, err := NewExchange("Backtest", testPair, "", testCandles(), nil, nil)
+	if err != nil {
+		t.Fatal("Test failed. NewExchange error", err)
+	}
+
+	_, err = exch.GetTickerPrice(context.Background(), testPair, ticker.Spot)
+	if err != errNotStarted {
+		t.Errorf("Test failed. Expected %s, received %v", errNotStarted, err)
+	}
+
+	exch.Next()
+	exch.Next()
+	tick, err := exch.GetTickerPrice(context.Background(), testPair, ticker.Spot)
+	if err != nil {
+		t.Fatal("Test failed. GetTickerPrice error", err)
+	}
+	if tick.Last != 110 {
+		t.Errorf("Test failed. Expected last of 110, received %f", tick.Last)
+	}
+
+	ob, err := exch.GetOrderbookEx(context.Background(), testPair, ticker.Spot)
+	if err != nil || len(ob.Bids) != 1 || ob.Asks[0].Price != 110 {
+		t.Errorf("Test failed. Unexpected orderbook %v %v", ob, err)
+	}
+
+	candles, err := exch.GetHistoricCandles(context.Background(), testPair, ticker.Spot,
+		exchange.OneHour, time.Unix(0, 0), time.Time{})
+	if err != nil || len(candles) != 2 {
+		t.Errorf("Test failed. Expected 2 replayed candles, received %d %v",
+			len(candles), err)
+	}
+
+	_, err = exch.GetTickerPrice(context.Background(), pair.NewCurrencyPair("LTC", "USD"), ticker.Spot)
+	if err != errUnsupportedPair {
+		t.Errorf("Test failed. Expected %s, received %v", errUnsupportedPair, err)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backtester")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "candles.csv")
+	err = SaveCandlesToCSV(path, testCandles())
+	if err != nil {
+		t.Fatal("Test failed. SaveCandlesToCSV error", err)
+	}
+
+	candles, err := LoadCandlesFromCSV(path)
+	if err != nil {
+		t.Fatal("Test failed. LoadCandlesFromCSV error", err)
+	}
+
+	if len(candles) != 5 || candles[4].Close != 130 {
+		t.Errorf("Test failed. Unexpected candles %v", candles)
+	}
+
+	tradesPath := filepath.Join(dir, "trades.csv")
+	err = ioutil.WriteFile(tradesPath, []byte("timestamp,tid,price,amount,type\n1540000060,2,101,0.5,sell\n1540000000,1,100,1,buy\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trades, err := LoadTradesFromCSV(tradesPath)
+	if err != nil {
+		t.Fatal("Test failed. LoadTradesFromCSV error", err)
+	}
+
+	candles = TradesToCandles(trades)
+	if len(candles) != 2 || candles[0].Close != 100 || candles[1].Volume != 0.5 {
+		t.Errorf("Test failed. Unexpected trade candles %v", candles)
+	}
+
+	_, err = LoadCandlesFromStore(dir, "binance", testPair, exchange.OneHour,
+		time.Time{}, time.Time{})
+	if err == nil {
+		t.Error("Test failed. Expected missing store file error")
+	}
+}
+
+func TestSharpeRatio(t *testing.T) {
+	start := time.Unix(0, 0)
+	equity := []EquityPoint{
+		{Time: start, Value: 100},
+		{Time: start.Add(time.Hour), Value: 100},
+		{Time: start.Add(2 * time.Hour), Value: 100},
+	}
+
+	if SharpeRatio(equity) != 0 {
+		t.Error("Test failed. Expected a Sharpe ratio of zero for flat equity")
+	}
+
+	equity[1].Value = 101
+	equity[2].Value = 103
+	if SharpeRatio(equity) <= 0 {
+		t.Error("Test failed. Expected a positive Sharpe ratio")
+	}
+
+	if MaxDrawdown([]EquityPoint{{Value: 100}, {Value: 50}, {Value: 120}}) != 50 {
+		t.Error("Test failed. Expected a drawdown of 50%")
+	}
+}
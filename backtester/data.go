@@ -0,0 +1,235 @@
+package backtester
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+)
+
+const (
+	candleCSVFields = 6
+	tradeCSVFields  = 5
+)
+
+var errNoData = errors.New("no market data loaded")
+
+// LoadCandlesFromCSV loads candles from a CSV file with the columns
+// timestamp,open,high,low,close,volume where timestamp is a unix timestamp in
+// seconds. A header row is skipped
+func LoadCandlesFromCSV(path string) ([]exchange.Candle, error) {
+	records, err := readCSV(path, candleCSVFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var candles []exchange.Candle
+	for i := range records {
+		values, err := parseFloats(records[i])
+		if err != nil {
+			if i == 0 {
+				// header row
+				continue
+			}
+			return nil, fmt.Errorf("%s line %d: %s", path, i+1, err)
+		}
+
+		candles = append(candles, exchange.Candle{
+			Time:   time.Unix(int64(values[0]), 0),
+			Open:   values[1],
+			High:   values[2],
+			Low:    values[3],
+			Close:  values[4],
+			Volume: values[5],
+		})
+	}
+
+	sortCandles(candles)
+	return candles, nil
+}
+
+// SaveCandlesToCSV writes candles to a CSV file in the format read by
+// LoadCandlesFromCSV
+func SaveCandlesToCSV(path string, candles []exchange.Candle) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	err := w.Write([]string{"timestamp", "open", "high", "low", "close", "volume"})
+	if err != nil {
+		return err
+	}
+
+	for i := range candles {
+		err = w.Write([]string{
+			strconv.FormatInt(candles[i].Time.Unix(), 10),
+			strconv.FormatFloat(candles[i].Open, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].High, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].Low, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].Close, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].Volume, 'f', -1, 64),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	if err = w.Error(); err != nil {
+		return err
+	}
+	return common.WriteFile(path, buf.Bytes())
+}
+
+// LoadTradesFromCSV loads trade history from a CSV file with the columns
+// timestamp,tid,price,amount,type where timestamp is a unix timestamp in
+// seconds. A header row is skipped
+func LoadTradesFromCSV(path string) ([]exchange.TradeHistory, error) {
+	records, err := readCSV(path, tradeCSVFields)
+	if err != nil {
+		return nil, err
+	}
+
+	var trades []exchange.TradeHistory
+	for i := range records {
+		values, err := parseFloats(records[i][:tradeCSVFields-1])
+		if err != nil {
+			if i == 0 {
+				// header row
+				continue
+			}
+			return nil, fmt.Errorf("%s line %d: %s", path, i+1, err)
+		}
+
+		trades = append(trades, exchange.TradeHistory{
+			Timestamp: int64(values[0]),
+			TID:       int64(values[1]),
+			Price:     values[2],
+			Amount:    values[3],
+			Type:      records[i][4],
+		})
+	}
+
+	sort.Slice(trades, func(i, j int) bool {
+		return trades[i].Timestamp < trades[j].Timestamp
+	})
+	return trades, nil
+}
+
+// TradesToCandles converts trade history into a candle per trade so it can be
+// replayed through the simulated exchange
+func TradesToCandles(trades []exchange.TradeHistory) []exchange.Candle {
+	candles := make([]exchange.Candle, 0, len(trades))
+	for i := range trades {
+		candles = append(candles, exchange.Candle{
+			Time:   time.Unix(trades[i].Timestamp, 0),
+			Open:   trades[i].Price,
+			High:   trades[i].Price,
+			Low:    trades[i].Price,
+			Close:  trades[i].Price,
+			Volume: trades[i].Amount,
+		})
+	}
+	return candles
+}
+
+// GetStorePath returns the path of a recorded candle file in the local store
+// directory, e.g. <dir>/binance/BTC-USDT_1h0m0s.csv
+func GetStorePath(dir, exchName string, p pair.CurrencyPair, interval exchange.CandleInterval) string {
+	return filepath.Join(dir,
+		common.StringToLower(exchName),
+		fmt.Sprintf("%s_%s.csv", p.Display("-", true), interval))
+}
+
+// RecordCandles fetches candles from an exchange and saves them in the local
+// store directory for later replay
+func RecordCandles(ctx context.Context, exch exchange.IBotExchange, dir string, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	candles, err := exch.GetHistoricCandles(ctx, p, assetType, interval, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	path := GetStorePath(dir, exch.GetName(), p, interval)
+	err = os.MkdirAll(filepath.Dir(path), 0770)
+	if err != nil {
+		return nil, err
+	}
+
+	return candles, SaveCandlesToCSV(path, candles)
+}
+
+// LoadCandlesFromStore loads candles previously saved by RecordCandles between
+// the start and end times, a zero time is unbounded
+func LoadCandlesFromStore(dir, exchName string, p pair.CurrencyPair, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	candles, err := LoadCandlesFromCSV(GetStorePath(dir, exchName, p, interval))
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []exchange.Candle
+	for i := range candles {
+		if !start.IsZero() && candles[i].Time.Before(start) {
+			continue
+		}
+		if !end.IsZero() && !candles[i].Time.Before(end) {
+			continue
+		}
+		filtered = append(filtered, candles[i])
+	}
+	return filtered, nil
+}
+
+func readCSV(path string, fields int) ([][]string, error) {
+	data, err := common.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = fields
+	r.TrimLeadingSpace = true
+
+	var records [][]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	if len(records) == 0 {
+		return nil, errNoData
+	}
+	return records, nil
+}
+
+func parseFloats(record []string) ([]float64, error) {
+	values := make([]float64, len(record))
+	for i := range record {
+		v, err := strconv.ParseFloat(record[i], 64)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func sortCandles(candles []exchange.Candle) {
+	sort.Slice(candles, func(i, j int) bool {
+		return candles[i].Time.Before(candles[j].Time)
+	})
+}
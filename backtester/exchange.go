@@ -0,0 +1,624 @@
+package backtester
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+var (
+	errOrderNotFound       = errors.New("order not found")
+	errInsufficientBalance = errors.New("insufficient balance")
+	errUnsupportedPair     = errors.New("currency pair is not being replayed")
+	errNotStarted          = errors.New("no market data has been replayed yet")
+)
+
+// FeeModel calculates the fee for a simulated fill, all exchange wrappers
+// satisfy this interface through their GetFeeByType function
+type FeeModel interface {
+	GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error)
+}
+
+// FixedFee is a FeeModel which charges a fixed fraction of the trade value
+type FixedFee struct {
+	Maker float64
+	Taker float64
+}
+
+// GetFeeByType returns the fixed maker or taker fee for the trade value
+func (f FixedFee) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+	if feeBuilder.FeeType != exchange.CryptocurrencyTradeFee {
+		return 0, nil
+	}
+
+	rate := f.Taker
+	if feeBuilder.IsMaker {
+		rate = f.Maker
+	}
+	return feeBuilder.PurchasePrice * feeBuilder.Amount * rate, nil
+}
+
+// simOrder holds an order submitted to the simulated exchange
+type simOrder struct {
+	detail    exchange.OrderDetail
+	side      exchange.OrderSide
+	orderType exchange.OrderType
+	clientID  string
+}
+
+// Exchange is a simulated exchange which implements exchange.IBotExchange and
+// fills orders against replayed market data
+type Exchange struct {
+	exchange.Base
+	pair      pair.CurrencyPair
+	assetType string
+	candles   []exchange.Candle
+	current   int
+	balances  map[string]float64
+	orders    []*simOrder
+	trades    []Trade
+	orderID   int64
+	fees      FeeModel
+	feeRates  exchange.FeeRateCache
+	m         sync.Mutex
+}
+
+// NewExchange returns a simulated exchange which replays the supplied candles
+// for a single currency pair. The name is used for fee lookups and reporting
+func NewExchange(name string, p pair.CurrencyPair, assetType string, candles []exchange.Candle, balances map[string]float64, fees FeeModel) (*Exchange, error) {
+	if len(candles) == 0 {
+		return nil, errNoData
+	}
+
+	if fees == nil {
+		fees = FixedFee{}
+	}
+
+	if assetType == "" {
+		assetType = ticker.Spot
+	}
+
+	e := &Exchange{
+		pair:      pair.NewCurrencyPair(p.FirstCurrency.Upper().String(), p.SecondCurrency.Upper().String()),
+		assetType: assetType,
+		candles:   append([]exchange.Candle(nil), candles...),
+		current:   -1,
+		balances:  make(map[string]float64),
+		fees:      fees,
+	}
+	sortCandles(e.candles)
+
+	for k, v := range balances {
+		e.balances[common.StringToUpper(k)] = v
+	}
+
+	e.SetDefaults()
+	e.Name = name
+	return e, nil
+}
+
+// SetDefaults sets the default values for the simulated exchange
+func (e *Exchange) SetDefaults() {
+	e.Enabled = true
+	e.AuthenticatedAPISupport = true
+	e.APIWithdrawPermissions = exchange.NoAPIWithdrawalMethods
+	e.ConfigCurrencyPairFormat.Delimiter = "-"
+	e.ConfigCurrencyPairFormat.Uppercase = true
+	e.RequestCurrencyPairFormat = e.ConfigCurrencyPairFormat
+	e.AssetTypes = []string{e.assetType}
+	e.EnabledPairs = []string{e.pair.Display("-", true).String()}
+	e.AvailablePairs = e.EnabledPairs
+}
+
+// Setup is a no-op for the simulated exchange
+func (e *Exchange) Setup(exch config.ExchangeConfig) {}
+
+// Start is a no-op for the simulated exchange, data is replayed by the
+// backtester
+func (e *Exchange) Start(wg *sync.WaitGroup) {}
+
+// SetCurrencies is a no-op for the simulated exchange, only the replayed pair
+// is supported
+func (e *Exchange) SetCurrencies(pairs []pair.CurrencyPair, enabledPairs bool) error {
+	return nil
+}
+
+// Next advances the replay by a single period, filling any orders placed
+// during the previous period. Returns false when there is no data left
+func (e *Exchange) Next() (bool, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.current+1 >= len(e.candles) {
+		return false, nil
+	}
+
+	e.current++
+	return true, e.matchOrders(e.candles[e.current])
+}
+
+// CurrentCandle returns the candle currently being replayed
+func (e *Exchange) CurrentCandle() (exchange.Candle, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.current < 0 {
+		return exchange.Candle{}, errNotStarted
+	}
+	return e.candles[e.current], nil
+}
+
+// GetBalances returns a copy of the simulated balances
+func (e *Exchange) GetBalances() map[string]float64 {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	balances := make(map[string]float64)
+	for k, v := range e.balances {
+		balances[k] = v
+	}
+	return balances
+}
+
+// GetTrades returns all simulated fills
+func (e *Exchange) GetTrades() []Trade {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return append([]Trade(nil), e.trades...)
+}
+
+// GetTickerPrice returns a ticker built from the current replayed period
+func (e *Exchange) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+	return e.UpdateTicker(ctx, p, assetType)
+}
+
+// UpdateTicker returns a ticker built from the current replayed period
+func (e *Exchange) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+	c, err := e.getCandle(p)
+	if err != nil {
+		return ticker.Price{}, err
+	}
+
+	return ticker.Price{
+		Pair:         e.pair,
+		LastUpdated:  c.Time,
+		CurrencyPair: e.pair.Pair().String(),
+		Last:         c.Close,
+		High:         c.High,
+		Low:          c.Low,
+		Bid:          c.Close,
+		Ask:          c.Close,
+		Volume:       c.Volume,
+	}, nil
+}
+
+// GetOrderbookEx returns an orderbook built from the current replayed period
+func (e *Exchange) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+	return e.UpdateOrderbook(ctx, p, assetType)
+}
+
+// UpdateOrderbook returns an orderbook built from the current replayed period,
+// the period volume is available on each side at the closing price
+func (e *Exchange) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+	c, err := e.getCandle(p)
+	if err != nil {
+		return orderbook.Base{}, err
+	}
+
+	return orderbook.Base{
+		Pair:         e.pair,
+		CurrencyPair: e.pair.Pair().String(),
+		Bids:         []orderbook.Item{{Price: c.Close, Amount: c.Volume}},
+		Asks:         []orderbook.Item{{Price: c.Close, Amount: c.Volume}},
+		LastUpdated:  c.Time,
+		AssetType:    e.assetType,
+	}, nil
+}
+
+// GetAccountInfo returns the simulated balances, funds reserved by open
+// orders are reported as on hold
+func (e *Exchange) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	info := exchange.AccountInfo{ExchangeName: e.Name}
+	for k, v := range e.balances {
+		info.Currencies = append(info.Currencies, exchange.AccountCurrencyInfo{
+			CurrencyName: k,
+			TotalValue:   v,
+			Hold:         e.reserved(k),
+		})
+	}
+	return info, nil
+}
+
+// GetExchangeHistory returns the simulated fills which have occurred so far
+func (e *Exchange) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	var history []exchange.TradeHistory
+	for i := range e.trades {
+		history = append(history, exchange.TradeHistory{
+			Timestamp: e.trades[i].Time.Unix(),
+			TID:       int64(i),
+			Price:     e.trades[i].Price,
+			Amount:    e.trades[i].Amount,
+			Exchange:  e.Name,
+			Type:      e.trades[i].Side.ToString(),
+		})
+	}
+	return history, nil
+}
+
+// GetHistoricCandles returns replayed candles between the start and end times,
+// candles after the current period are never returned
+func (e *Exchange) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if !e.isPair(p) {
+		return nil, errUnsupportedPair
+	}
+
+	var candles []exchange.Candle
+	for i := 0; i <= e.current; i++ {
+		if e.candles[i].Time.Before(start) {
+			continue
+		}
+		if !end.IsZero() && !e.candles[i].Time.Before(end) {
+			continue
+		}
+		candles = append(candles, e.candles[i])
+	}
+	return candles, nil
+}
+
+// GetFundingHistory is not supported by the simulated exchange
+func (e *Exchange) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrder queues an order which will be matched against the next replayed
+// period
+func (e *Exchange) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
+	var resp exchange.SubmitOrderResponse
+
+	err := e.ValidateOrder(&order)
+	if err != nil {
+		return resp, err
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if !e.isPair(order.Pair) {
+		return resp, errUnsupportedPair
+	}
+
+	if e.current < 0 {
+		return resp, errNotStarted
+	}
+
+	side, orderType := order.Side, order.OrderType
+	amount, price := order.Amount, order.Price
+	if orderType == exchange.Market {
+		price = e.candles[e.current].Close
+	}
+
+	currency, required := e.requiredFunds(side, amount, price)
+	if e.balances[currency]-e.reserved(currency) < required {
+		return resp, errInsufficientBalance
+	}
+
+	e.orderID++
+	c := e.candles[e.current]
+	e.orders = append(e.orders, &simOrder{
+		detail: exchange.OrderDetail{
+			Exchange:      e.Name,
+			ID:            strconv.FormatInt(e.orderID, 10),
+			BaseCurrency:  e.pair.FirstCurrency.String(),
+			QuoteCurrency: e.pair.SecondCurrency.String(),
+			OrderSide:     side.ToString(),
+			OrderType:     orderType.ToString(),
+			CreationTime:  c.Time.Unix(),
+			OrderDate:     c.Time,
+			Status:        string(orders.New),
+			Price:         price,
+			Amount:        amount,
+			OpenVolume:    amount,
+		},
+		side:      side,
+		orderType: orderType,
+		clientID:  order.ClientID,
+	})
+
+	resp.IsOrderPlaced = true
+	resp.OrderID = strconv.FormatInt(e.orderID, 10)
+	resp.OrderDate = c.Time
+	resp.LastUpdated = c.Time
+	return resp, nil
+}
+
+// ModifyOrder is not supported by the simulated exchange
+func (e *Exchange) ModifyOrder(ctx context.Context, orderID string, modify exchange.ModifyOrder) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// CancelOrder cancels an open simulated order
+func (e *Exchange) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	o := e.getOrder(order.OrderID)
+	if o == nil || !orders.Status(o.detail.Status).IsOpen() {
+		return errOrderNotFound
+	}
+
+	o.detail.Status = string(orders.Cancelled)
+	return nil
+}
+
+// CancelAllOrders cancels all open simulated orders
+func (e *Exchange) CancelAllOrders(ctx context.Context) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	for i := range e.orders {
+		if orders.Status(e.orders[i].detail.Status).IsOpen() {
+			e.orders[i].detail.Status = string(orders.Cancelled)
+		}
+	}
+	return nil
+}
+
+// GetOrderInfo returns information on a simulated order
+func (e *Exchange) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	o := e.getOrder(orderID)
+	if o == nil {
+		return exchange.OrderDetail{}, errOrderNotFound
+	}
+	return o.detail, nil
+}
+
+// GetActiveOrders retrieves any simulated orders that are active/open
+func (e *Exchange) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return e.getOrders(getOrdersRequest, true), nil
+}
+
+// GetOrderHistory retrieves all simulated orders
+func (e *Exchange) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return e.getOrders(getOrdersRequest, false), nil
+}
+
+// GetDepositAddress is not supported by the simulated exchange
+func (e *Exchange) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// GetDerivativesPositions is not supported by the simulated exchange
+func (e *Exchange) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage is not supported by the simulated exchange
+func (e *Exchange) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode is not supported by the simulated exchange
+func (e *Exchange) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// CloseDerivativesPosition is not supported by the simulated exchange
+func (e *Exchange) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	return exchange.SubmitOrderResponse{}, common.ErrFunctionNotSupported
+}
+
+// GetFundingRate is not supported by the simulated exchange
+func (e *Exchange) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	return exchange.FundingRate{}, common.ErrFunctionNotSupported
+}
+
+// WithdrawCryptocurrencyFunds is not supported by the simulated exchange
+func (e *Exchange) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// WithdrawFiatFunds is not supported by the simulated exchange
+func (e *Exchange) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// GetWebsocket is not supported by the simulated exchange
+func (e *Exchange) GetWebsocket() (*exchange.Websocket, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetFeeByType returns a fee estimate using the configured fee model
+func (e *Exchange) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+	return e.fees.GetFeeByType(feeBuilder)
+}
+
+// GetWithdrawCapabilities returns the types of withdrawal methods permitted by
+// the exchange
+func (e *Exchange) GetWithdrawCapabilities() uint32 {
+	return e.GetWithdrawPermissions()
+}
+
+// matchOrders fills any open orders which can be matched against the candle,
+// market orders fill at the open and limit orders fill at the better of their
+// price and the open once the candle trades through them. The caller must
+// hold the lock
+func (e *Exchange) matchOrders(c exchange.Candle) error {
+	for _, o := range e.orders {
+		if !orders.Status(o.detail.Status).IsOpen() {
+			continue
+		}
+
+		var fillPrice float64
+		switch o.orderType {
+		case exchange.Market:
+			fillPrice = c.Open
+		case exchange.Limit:
+			if o.side == exchange.Buy && c.Low <= o.detail.Price {
+				fillPrice = o.detail.Price
+				if c.Open < fillPrice {
+					fillPrice = c.Open
+				}
+			} else if o.side == exchange.Sell && c.High >= o.detail.Price {
+				fillPrice = o.detail.Price
+				if c.Open > fillPrice {
+					fillPrice = c.Open
+				}
+			}
+		}
+
+		if fillPrice == 0 {
+			continue
+		}
+
+		err := e.fill(o, c.Time, fillPrice)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fill executes an order at the fill price, charging the fee in the quote
+// currency. The caller must hold the lock
+func (e *Exchange) fill(o *simOrder, t time.Time, fillPrice float64) error {
+	amount := o.detail.OpenVolume
+	fee, err := e.calculateFee(o.orderType == exchange.Limit, fillPrice, amount)
+	if err != nil {
+		return err
+	}
+
+	base := e.pair.FirstCurrency.String()
+	quote := e.pair.SecondCurrency.String()
+	value := fillPrice * amount
+
+	if o.side == exchange.Buy {
+		if e.balances[quote] < value+fee {
+			o.detail.Status = string(orders.Rejected)
+			return nil
+		}
+		e.balances[quote] -= value + fee
+		e.balances[base] += amount
+	} else {
+		if e.balances[base] < amount {
+			o.detail.Status = string(orders.Rejected)
+			return nil
+		}
+		e.balances[base] -= amount
+		e.balances[quote] += value - fee
+	}
+
+	o.detail.ExecutedAmount = o.detail.OpenVolume
+	o.detail.OpenVolume = 0
+	o.detail.Status = string(orders.Filled)
+	e.trades = append(e.trades, Trade{
+		Time:     t,
+		OrderID:  o.detail.ID,
+		ClientID: o.clientID,
+		Side:     o.side,
+		Price:    fillPrice,
+		Amount:   amount,
+		Fee:      fee,
+	})
+	return nil
+}
+
+// calculateFee returns the fee for a fill. The fee rate is cached for maker
+// and taker fills, so exchange fee models which query the account are not
+// called for every fill
+func (e *Exchange) calculateFee(isMaker bool, price, amount float64) (float64, error) {
+	fee, err := e.feeRates.Fee(e.fees, e.Name, e.pair, isMaker, price, amount)
+	if err != nil {
+		return 0, fmt.Errorf("%s fee model error: %s", e.Name, err)
+	}
+	return fee, nil
+}
+
+// requiredFunds returns the currency and amount required to place an order
+func (e *Exchange) requiredFunds(side exchange.OrderSide, amount, price float64) (string, float64) {
+	if side == exchange.Buy {
+		return e.pair.SecondCurrency.String(), amount * price
+	}
+	return e.pair.FirstCurrency.String(), amount
+}
+
+// reserved returns the amount of a currency reserved by open orders. The
+// caller must hold the lock
+func (e *Exchange) reserved(currency string) float64 {
+	var total float64
+	for _, o := range e.orders {
+		if !orders.Status(o.detail.Status).IsOpen() {
+			continue
+		}
+		c, amount := e.requiredFunds(o.side, o.detail.OpenVolume, o.detail.Price)
+		if c == currency {
+			total += amount
+		}
+	}
+	return total
+}
+
+// getOrder returns an order by ID, the caller must hold the lock
+func (e *Exchange) getOrder(orderID string) *simOrder {
+	for _, o := range e.orders {
+		if o.detail.ID == orderID {
+			return o
+		}
+	}
+	return nil
+}
+
+func (e *Exchange) getOrders(getOrdersRequest exchange.GetOrdersRequest, open bool) []exchange.OrderDetail {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	var details []exchange.OrderDetail
+	for _, o := range e.orders {
+		if open && !orders.Status(o.detail.Status).IsOpen() {
+			continue
+		}
+		details = append(details, o.detail)
+	}
+	return exchange.FilterOrders(details, getOrdersRequest)
+}
+
+func (e *Exchange) getCandle(p pair.CurrencyPair) (exchange.Candle, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if !e.isPair(p) {
+		return exchange.Candle{}, errUnsupportedPair
+	}
+
+	if e.current < 0 {
+		return exchange.Candle{}, errNotStarted
+	}
+	return e.candles[e.current], nil
+}
+
+func (e *Exchange) isPair(p pair.CurrencyPair) bool {
+	return p.FirstCurrency.Upper() == e.pair.FirstCurrency &&
+		p.SecondCurrency.Upper() == e.pair.SecondCurrency
+}
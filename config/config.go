@@ -33,6 +33,7 @@ const (
 	configPairsLastUpdatedWarningThreshold = 30 // 30 days
 	configDefaultHTTPTimeout               = time.Duration(time.Second * 15)
 	configMaxAuthFailres                   = 3
+	configDefaultArbitrageScanInterval     = time.Duration(time.Second * 10)
 )
 
 // Constants here hold some messages
@@ -105,6 +106,9 @@ type Config struct {
 	Webserver         WebserverConfig      `json:"webserver"`
 	Exchanges         []ExchangeConfig     `json:"exchanges"`
 	BankAccounts      []BankAccount        `json:"bankAccounts"`
+	Strategies        []StrategyConfig     `json:"strategies,omitempty"`
+	Arbitrage         *ArbitrageConfig     `json:"arbitrage,omitempty"`
+	MarketData        *MarketDataConfig    `json:"marketData,omitempty"`
 
 	// Deprecated config settings, will be removed at a future date
 	CurrencyPairFormat  *CurrencyPairFormatConfig `json:"currencyPairFormat,omitempty"`
@@ -142,6 +146,54 @@ type ExchangeConfig struct {
 	ConfigCurrencyPairFormat  *CurrencyPairFormatConfig `json:"configCurrencyPairFormat"`
 	RequestCurrencyPairFormat *CurrencyPairFormatConfig `json:"requestCurrencyPairFormat"`
 	BankAccounts              []BankAccount             `json:"bankAccounts"`
+	PaperTrading              *PaperTradingConfig       `json:"paperTrading,omitempty"`
+}
+
+// PaperTradingConfig holds the paper trading settings for an exchange, when
+// enabled orders are simulated against live orderbooks using the starting
+// balances
+type PaperTradingConfig struct {
+	Enabled  bool               `json:"enabled"`
+	Balances map[string]float64 `json:"balances"`
+}
+
+// StrategyConfig holds the settings for a trading strategy run by the bot.
+// Strategy is the registered strategy type, Pairs are in the format BTC-USD
+// and an empty Exchanges or Pairs list applies the strategy to all enabled
+// exchanges or pairs
+type StrategyConfig struct {
+	Name          string            `json:"name"`
+	Strategy      string            `json:"strategy"`
+	Enabled       bool              `json:"enabled"`
+	Exchanges     []string          `json:"exchanges"`
+	Pairs         []string          `json:"pairs"`
+	TimerInterval time.Duration     `json:"timerInterval"`
+	Parameters    map[string]string `json:"parameters"`
+}
+
+// ArbitrageConfig holds the settings for the arbitrage scanners. Enabled
+// scans for cross exchange opportunities and Triangular scans for loops
+// within each exchange. MinNetProfitPercentage is the net profit after fees,
+// as a percentage of the amount spent, required to publish an opportunity and
+// MaxAmount limits the base currency amount of cross exchange opportunities,
+// zero is unlimited. UseMakerFees evaluates triangular loops with maker fees
+type ArbitrageConfig struct {
+	Enabled                bool          `json:"enabled"`
+	Triangular             bool          `json:"triangular"`
+	MinNetProfitPercentage float64       `json:"minNetProfitPercentage"`
+	MaxAmount              float64       `json:"maxAmount"`
+	UseMakerFees           bool          `json:"useMakerFees"`
+	ScanInterval           time.Duration `json:"scanInterval"`
+}
+
+// MarketDataConfig holds the settings for recording tickers, orderbooks and
+// websocket trades to the data directory. RetentionDays is the number of days
+// of records kept, zero keeps all records, and OrderbookDepth limits the
+// number of bid and ask levels stored, zero stores the full orderbook
+type MarketDataConfig struct {
+	Enabled        bool `json:"enabled"`
+	RetentionDays  int  `json:"retentionDays"`
+	OrderbookDepth int  `json:"orderbookDepth"`
 }
 
 // BankAccount holds differing bank account details by supported funding
@@ -364,6 +416,43 @@ func (c *Config) UpdateCommunicationsConfig(config CommunicationsConfig) {
 	m.Unlock()
 }
 
+// CheckStrategiesConfig checks the strategy settings are valid
+func (c *Config) CheckStrategiesConfig() error {
+	m.Lock()
+	defer m.Unlock()
+
+	names := make(map[string]bool)
+	for i := range c.Strategies {
+		if c.Strategies[i].Name == "" {
+			return fmt.Errorf("strategy #%d in config: name is empty", i)
+		}
+
+		name := common.StringToLower(c.Strategies[i].Name)
+		if names[name] {
+			return fmt.Errorf("strategy %s: duplicate name", c.Strategies[i].Name)
+		}
+		names[name] = true
+
+		if c.Strategies[i].Strategy == "" {
+			return fmt.Errorf("strategy %s: strategy type is empty",
+				c.Strategies[i].Name)
+		}
+
+		for _, p := range c.Strategies[i].Pairs {
+			if !common.StringContains(p, "-") {
+				return fmt.Errorf("strategy %s: invalid pair %s, expected format BTC-USD",
+					c.Strategies[i].Name, p)
+			}
+		}
+
+		if c.Strategies[i].TimerInterval < 0 {
+			return fmt.Errorf("strategy %s: timer interval cannot be negative",
+				c.Strategies[i].Name)
+		}
+	}
+	return nil
+}
+
 // CheckCommunicationsConfig checks to see if the variables are set correctly
 // from config.json
 func (c *Config) CheckCommunicationsConfig() error {
@@ -1172,11 +1261,22 @@ func (c *Config) CheckConfig() error {
 		c.GlobalHTTPTimeout = configDefaultHTTPTimeout
 	}
 
+	if c.Arbitrage != nil && (c.Arbitrage.Enabled || c.Arbitrage.Triangular) &&
+		c.Arbitrage.ScanInterval <= 0 {
+		log.Printf("Arbitrage scan interval value not set, defaulting to %v.", configDefaultArbitrageScanInterval)
+		c.Arbitrage.ScanInterval = configDefaultArbitrageScanInterval
+	}
+
 	err = c.CheckClientBankAccounts()
 	if err != nil {
 		return err
 	}
 
+	err = c.CheckStrategiesConfig()
+	if err != nil {
+		return fmt.Errorf(ErrCheckingConfigValues, err)
+	}
+
 	return nil
 }
 
@@ -266,6 +266,38 @@ func TestCheckCommunicationsConfig(t *testing.T) {
 	}
 }
 
+func TestCheckStrategiesConfig(t *testing.T) {
+	cfg := GetConfig()
+	cfg.Strategies = []StrategyConfig{
+		{Name: "test", Strategy: "test", Pairs: []string{"BTC-USD"}},
+	}
+	err := cfg.CheckStrategiesConfig()
+	if err != nil {
+		t.Error("Test failed. CheckStrategiesConfig error:", err)
+	}
+
+	cfg.Strategies = append(cfg.Strategies, StrategyConfig{Name: "TEST", Strategy: "test"})
+	err = cfg.CheckStrategiesConfig()
+	if err == nil {
+		t.Error("Test failed. CheckStrategiesConfig expected duplicate name error")
+	}
+
+	cfg.Strategies = []StrategyConfig{{Name: "test"}}
+	err = cfg.CheckStrategiesConfig()
+	if err == nil {
+		t.Error("Test failed. CheckStrategiesConfig expected empty strategy error")
+	}
+
+	cfg.Strategies = []StrategyConfig{
+		{Name: "test", Strategy: "test", Pairs: []string{"BTCUSD"}},
+	}
+	err = cfg.CheckStrategiesConfig()
+	if err == nil {
+		t.Error("Test failed. CheckStrategiesConfig expected invalid pair error")
+	}
+	cfg.Strategies = nil
+}
+
 func TestCheckPairConsistency(t *testing.T) {
 	cfg := GetConfig()
 	err := cfg.LoadConfig(ConfigTestFile)
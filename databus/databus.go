@@ -0,0 +1,270 @@
+package databus
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+)
+
+// Topics published on the data bus
+const (
+	Ticker    = "ticker"
+	Orderbook = "orderbook"
+	Trade     = "trade"
+	Kline     = "kline"
+
+	// DefaultBlockTimeout is how long a publisher waits on a full Block
+	// subscriber before dropping the message
+	DefaultBlockTimeout = 100 * time.Millisecond
+)
+
+// Policy defines how a subscription handles a message when its buffer is full
+type Policy int
+
+// Slow consumer policies
+const (
+	// DropNewest discards the message being published
+	DropNewest Policy = iota
+	// DropOldest discards the oldest buffered message to make room
+	DropOldest
+	// Block waits up to the bus block timeout for room before discarding the
+	// message being published
+	Block
+	// Disconnect unsubscribes the subscriber, closing its channel
+	Disconnect
+)
+
+// Data is implemented by the values published on the bus, each type of value
+// is published on a single topic
+type Data interface {
+	BusTopic() string
+}
+
+// Message is a data update published on the bus
+type Message struct {
+	Exchange  string
+	Pair      pair.CurrencyPair
+	Asset     string
+	Data      Data
+	Timestamp time.Time
+}
+
+// Topic returns the topic of the message data
+func (m *Message) Topic() string {
+	if m.Data == nil {
+		return ""
+	}
+	return m.Data.BusTopic()
+}
+
+// Filter selects the messages delivered to a subscription, an empty field
+// matches every message
+type Filter struct {
+	Topics    []string
+	Exchanges []string
+	Pairs     []pair.CurrencyPair
+	Assets    []string
+}
+
+// Match returns whether the message passes the filter
+func (f *Filter) Match(msg *Message) bool {
+	if len(f.Topics) > 0 && !common.StringDataCompare(f.Topics, msg.Topic()) {
+		return false
+	}
+
+	if len(f.Exchanges) > 0 && !common.StringDataCompareUpper(f.Exchanges, msg.Exchange) {
+		return false
+	}
+
+	if len(f.Assets) > 0 && !common.StringDataCompare(f.Assets, msg.Asset) {
+		return false
+	}
+
+	if len(f.Pairs) > 0 && !pair.Contains(f.Pairs, msg.Pair, true) {
+		return false
+	}
+	return true
+}
+
+// Subscription receives the messages matching its filter on C until it is
+// unsubscribed, after which C is closed
+type Subscription struct {
+	C <-chan Message
+
+	ch      chan Message
+	filter  Filter
+	policy  Policy
+	dropped int64
+	closed  bool
+	bus     *Bus
+	m       sync.Mutex
+}
+
+// Dropped returns the number of messages discarded because the subscription
+// buffer was full
+func (s *Subscription) Dropped() int64 {
+	return atomic.LoadInt64(&s.dropped)
+}
+
+// Unsubscribe stops delivery to the subscription and closes its channel
+func (s *Subscription) Unsubscribe() {
+	s.bus.remove(s)
+}
+
+// close closes the subscription channel once
+func (s *Subscription) close() {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.ch)
+}
+
+// deliver sends the message according to the subscription policy and returns
+// false when the subscriber should be disconnected
+func (s *Subscription) deliver(msg Message, blockTimeout time.Duration) bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.closed {
+		return true
+	}
+
+	select {
+	case s.ch <- msg:
+		return true
+	default:
+	}
+
+	switch s.policy {
+	case DropOldest:
+		select {
+		case <-s.ch:
+			atomic.AddInt64(&s.dropped, 1)
+		default:
+		}
+		select {
+		case s.ch <- msg:
+		default:
+			atomic.AddInt64(&s.dropped, 1)
+		}
+	case Block:
+		timer := time.NewTimer(blockTimeout)
+		defer timer.Stop()
+		select {
+		case s.ch <- msg:
+		case <-timer.C:
+			atomic.AddInt64(&s.dropped, 1)
+		}
+	case Disconnect:
+		atomic.AddInt64(&s.dropped, 1)
+		return false
+	default:
+		atomic.AddInt64(&s.dropped, 1)
+	}
+	return true
+}
+
+// Bus is an in-process publish and subscribe data bus
+type Bus struct {
+	subscribers  map[*Subscription]bool
+	blockTimeout time.Duration
+	m            sync.RWMutex
+}
+
+// New returns a data bus
+func New() *Bus {
+	return &Bus{
+		subscribers:  make(map[*Subscription]bool),
+		blockTimeout: DefaultBlockTimeout,
+	}
+}
+
+// SetBlockTimeout sets how long publishers wait on full Block subscribers
+func (b *Bus) SetBlockTimeout(timeout time.Duration) {
+	b.m.Lock()
+	b.blockTimeout = timeout
+	b.m.Unlock()
+}
+
+// Subscribe returns a subscription for the messages matching the filter with a
+// buffer of the size and the policy used when the buffer is full
+func (b *Bus) Subscribe(filter Filter, buffer int, policy Policy) *Subscription {
+	if buffer < 1 {
+		buffer = 1
+	}
+
+	ch := make(chan Message, buffer)
+	s := &Subscription{
+		C:      ch,
+		ch:     ch,
+		filter: filter,
+		policy: policy,
+		bus:    b,
+	}
+
+	b.m.Lock()
+	b.subscribers[s] = true
+	b.m.Unlock()
+	return s
+}
+
+// remove removes a subscription and closes its channel
+func (b *Bus) remove(s *Subscription) {
+	b.m.Lock()
+	delete(b.subscribers, s)
+	b.m.Unlock()
+	s.close()
+}
+
+// Publish delivers the message to every subscription matching it, messages
+// without data are discarded
+func (b *Bus) Publish(msg Message) {
+	if msg.Data == nil {
+		return
+	}
+
+	if msg.Timestamp.IsZero() {
+		msg.Timestamp = time.Now()
+	}
+
+	var disconnect []*Subscription
+	b.m.RLock()
+	for s := range b.subscribers {
+		if !s.filter.Match(&msg) {
+			continue
+		}
+		if !s.deliver(msg, b.blockTimeout) {
+			disconnect = append(disconnect, s)
+		}
+	}
+	b.m.RUnlock()
+
+	for i := range disconnect {
+		b.remove(disconnect[i])
+	}
+}
+
+// Subscribers returns the number of subscriptions
+func (b *Bus) Subscribers() int {
+	b.m.RLock()
+	defer b.m.RUnlock()
+	return len(b.subscribers)
+}
+
+var defaultBus = New()
+
+// Publish delivers the message to every subscription of the default bus
+// matching it
+func Publish(msg Message) {
+	defaultBus.Publish(msg)
+}
+
+// Subscribe returns a subscription to the default bus
+func Subscribe(filter Filter, buffer int, policy Policy) *Subscription {
+	return defaultBus.Subscribe(filter, buffer, policy)
+}
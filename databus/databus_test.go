@@ -0,0 +1,123 @@
+package databus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+)
+
+var btcusd = pair.NewCurrencyPair("BTC", "USD")
+
+// testData is the data published by the tests
+type testData struct {
+	topic string
+	value int
+}
+
+func (d testData) BusTopic() string {
+	return d.topic
+}
+
+func TestFilterMatch(t *testing.T) {
+	msg := Message{Exchange: "Bitstamp", Pair: btcusd, Asset: "SPOT",
+		Data: testData{topic: Ticker}}
+	tests := []struct {
+		filter   Filter
+		expected bool
+	}{
+		{Filter{}, true},
+		{Filter{Topics: []string{Ticker, Trade}}, true},
+		{Filter{Topics: []string{Orderbook}}, false},
+		{Filter{Exchanges: []string{"BITSTAMP"}}, true},
+		{Filter{Exchanges: []string{"Kraken"}}, false},
+		{Filter{Pairs: []pair.CurrencyPair{pair.NewCurrencyPair("btc", "usd")}}, true},
+		{Filter{Pairs: []pair.CurrencyPair{pair.NewCurrencyPair("LTC", "USD")}}, false},
+		{Filter{Assets: []string{"FUTURES"}}, false},
+	}
+
+	for i := range tests {
+		if tests[i].filter.Match(&msg) != tests[i].expected {
+			t.Errorf("Test failed. Filter %d %+v expected match %v",
+				i, tests[i].filter, tests[i].expected)
+		}
+	}
+}
+
+func TestPublishSubscribe(t *testing.T) {
+	b := New()
+	tickers := b.Subscribe(Filter{Topics: []string{Ticker}}, 10, DropNewest)
+	all := b.Subscribe(Filter{}, 10, DropNewest)
+
+	b.Publish(Message{Exchange: "Bitstamp", Pair: btcusd, Data: testData{Ticker, 1}})
+	b.Publish(Message{Exchange: "Bitstamp", Pair: btcusd, Data: testData{Orderbook, 2}})
+
+	if len(tickers.C) != 1 || len(all.C) != 2 {
+		t.Fatalf("Test failed. Expected 1 and 2 messages, received %d and %d",
+			len(tickers.C), len(all.C))
+	}
+
+	msg := <-tickers.C
+	if msg.Data.(testData).value != 1 || msg.Timestamp.IsZero() {
+		t.Errorf("Test failed. Unexpected message %+v", msg)
+	}
+
+	tickers.Unsubscribe()
+	tickers.Unsubscribe()
+	if _, ok := <-tickers.C; ok {
+		t.Error("Test failed. Expected channel to be closed")
+	}
+
+	if b.Subscribers() != 1 {
+		t.Errorf("Test failed. Expected 1 subscriber, received %d", b.Subscribers())
+	}
+
+	b.Publish(Message{Exchange: "Bitstamp"})
+	if len(all.C) != 2 {
+		t.Error("Test failed. Expected messages without data to be discarded")
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	b := New()
+	b.SetBlockTimeout(10 * time.Millisecond)
+	newest := b.Subscribe(Filter{}, 2, DropNewest)
+	oldest := b.Subscribe(Filter{}, 2, DropOldest)
+	block := b.Subscribe(Filter{}, 2, Block)
+	disconnect := b.Subscribe(Filter{}, 2, Disconnect)
+
+	for i := 1; i <= 3; i++ {
+		b.Publish(Message{Data: testData{Ticker, i}})
+	}
+
+	if (<-newest.C).Data.(testData).value != 1 || newest.Dropped() != 1 {
+		t.Error("Test failed. Expected DropNewest to keep the first messages")
+	}
+
+	if (<-oldest.C).Data.(testData).value != 2 || oldest.Dropped() != 1 {
+		t.Error("Test failed. Expected DropOldest to keep the latest messages")
+	}
+
+	if len(block.C) != 2 || block.Dropped() != 1 {
+		t.Error("Test failed. Expected Block to drop after the timeout")
+	}
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		<-block.C
+	}()
+	b.SetBlockTimeout(time.Second)
+	b.Publish(Message{Data: testData{Ticker, 4}})
+	if block.Dropped() != 1 {
+		t.Error("Test failed. Expected Block to wait for the consumer")
+	}
+
+	var received int
+	for range disconnect.C {
+		received++
+	}
+	if received != 2 || disconnect.Dropped() != 1 || b.Subscribers() != 3 {
+		t.Errorf("Test failed. Expected Disconnect to close the subscription, received %d",
+			received)
+	}
+}
@@ -367,3 +367,107 @@ package events
 // 		t.Error("Test Failed. IsValidItem: Error, incorrect return")
 // 	}
 // }
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+var configLoaded bool
+
+func loadTestConfig(t *testing.T) {
+	if configLoaded {
+		return
+	}
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig(config.ConfigTestFile)
+	if err != nil {
+		t.Fatalf("Test failed. Failed to load config %s", err)
+	}
+	configLoaded = true
+}
+
+func TestEventManagerPersistence(t *testing.T) {
+	loadTestConfig(t)
+
+	dir, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	Events = nil
+	err = Start(dir)
+	if err != nil {
+		t.Fatalf("Test failed. Start: %s", err)
+	}
+
+	if err = Start(dir); err == nil {
+		t.Error("Test failed. Start: expected error when already started")
+	}
+
+	p := pair.NewCurrencyPair("BTC", "USD")
+	id, err := AddEvent("Bitstamp", "price", ">,100", p, ticker.Spot, actionTest)
+	if err != nil {
+		t.Fatalf("Test failed. AddEvent: %s", err)
+	}
+
+	secondID, err := AddEvent("Bitstamp", "price", "<,100", p, ticker.Spot, actionTest)
+	if err != nil {
+		t.Fatalf("Test failed. AddEvent: %s", err)
+	}
+
+	if id == secondID {
+		t.Error("Test failed. AddEvent: event IDs are not unique")
+	}
+
+	ticker.ProcessTicker("Bitstamp", p, ticker.Price{Last: 1000}, ticker.Spot)
+	if triggered := CheckEventsByTicker("Bitstamp", p, ticker.Spot); triggered != 1 {
+		t.Errorf("Test failed. CheckEventsByTicker: expected 1 triggered event, got %d",
+			triggered)
+	}
+
+	err = Stop()
+	if err != nil {
+		t.Fatalf("Test failed. Stop: %s", err)
+	}
+
+	if err = Stop(); err == nil {
+		t.Error("Test failed. Stop: expected error when not started")
+	}
+
+	Events = nil
+	err = Start(dir)
+	if err != nil {
+		t.Fatalf("Test failed. Start: %s", err)
+	}
+	defer Stop()
+
+	events := GetEvents()
+	if len(events) != 2 {
+		t.Fatalf("Test failed. Expected 2 persisted events, got %d", len(events))
+	}
+
+	for x := range events {
+		if events[x].ID == id && !events[x].Executed {
+			t.Error("Test failed. Executed state was not persisted")
+		}
+		if events[x].ID == secondID && events[x].Executed {
+			t.Error("Test failed. Event should not have been executed")
+		}
+	}
+
+	if !RemoveEvent(secondID) {
+		t.Error("Test failed. RemoveEvent: failed to remove event")
+	}
+
+	if total, executed := GetEventCounter(); total != 1 || executed != 1 {
+		t.Errorf("Test failed. GetEventCounter: unexpected values %d %d",
+			total, executed)
+	}
+}
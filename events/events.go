@@ -4,13 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"os"
 	"strconv"
+	"sync"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/communications"
 	"github.com/thrasher-/gocryptotrader/communications/base"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
 )
 
@@ -24,6 +27,9 @@ const (
 	actionSMSNotify    = "SMS"
 	actionConsolePrint = "CONSOLE_PRINT"
 	actionTest         = "ACTION_TEST"
+
+	eventsFile        = "events.json"
+	maxPendingUpdates = 1000
 )
 
 var (
@@ -31,6 +37,8 @@ var (
 	errInvalidCondition = errors.New("invalid conditional option")
 	errInvalidAction    = errors.New("invalid action")
 	errExchangeDisabled = errors.New("desired exchange is disabled")
+	errAlreadyStarted   = errors.New("event manager already started")
+	errNotStarted       = errors.New("event manager not started")
 
 	// NOTE comms is an interim implementation
 	comms *communications.Communications
@@ -38,20 +46,30 @@ var (
 
 // Event struct holds the event variables
 type Event struct {
-	ID        int
-	Exchange  string
-	Item      string
-	Condition string
-	Pair      pair.CurrencyPair
-	Asset     string
-	Action    string
-	Executed  bool
+	ID        int               `json:"id"`
+	Exchange  string            `json:"exchange"`
+	Item      string            `json:"item"`
+	Condition string            `json:"condition"`
+	Pair      pair.CurrencyPair `json:"pair"`
+	Asset     string            `json:"asset"`
+	Action    string            `json:"action"`
+	Executed  bool              `json:"executed"`
 }
 
 // Events variable is a pointer array to the event structures that will be
 // appended
 var Events []*Event
 
+// Vars for the event manager routine
+var (
+	m         sync.Mutex
+	started   bool
+	eventPath string
+	updates   *databus.Subscription
+	shutdown  chan struct{}
+	wg        sync.WaitGroup
+)
+
 // SetComms is an interim function that will support a median integration. This
 // sets the current comms package.
 func SetComms(commsP *communications.Communications) {
@@ -66,12 +84,14 @@ func AddEvent(Exchange, Item, Condition string, CurrencyPair pair.CurrencyPair,
 		return 0, err
 	}
 
-	Event := &Event{}
+	m.Lock()
+	defer m.Unlock()
 
-	if len(Events) == 0 {
-		Event.ID = 0
-	} else {
-		Event.ID = len(Events) + 1
+	Event := &Event{}
+	for x := range Events {
+		if Events[x].ID >= Event.ID {
+			Event.ID = Events[x].ID + 1
+		}
 	}
 
 	Event.Exchange = Exchange
@@ -82,23 +102,40 @@ func AddEvent(Exchange, Item, Condition string, CurrencyPair pair.CurrencyPair,
 	Event.Action = Action
 	Event.Executed = false
 	Events = append(Events, Event)
+	saveEvents()
 	return Event.ID, nil
 }
 
 // RemoveEvent deletes and event by its ID
 func RemoveEvent(EventID int) bool {
+	m.Lock()
+	defer m.Unlock()
 	for i, x := range Events {
 		if x.ID == EventID {
 			Events = append(Events[:i], Events[i+1:]...)
+			saveEvents()
 			return true
 		}
 	}
 	return false
 }
 
+// GetEvents returns a copy of all the events on the chain
+func GetEvents() []Event {
+	m.Lock()
+	defer m.Unlock()
+	events := make([]Event, len(Events))
+	for x := range Events {
+		events[x] = *Events[x]
+	}
+	return events
+}
+
 // GetEventCounter displays the emount of total events on the chain and the
 // events that have been executed.
 func GetEventCounter() (int, int) {
+	m.Lock()
+	defer m.Unlock()
 	total := len(Events)
 	executed := 0
 
@@ -116,7 +153,7 @@ func (e *Event) ExecuteAction() bool {
 		action := common.SplitStrings(e.Action, ",")
 		if action[0] == actionSMSNotify {
 			message := fmt.Sprintf("Event triggered: %s", e.String())
-			if action[1] == "ALL" {
+			if action[1] == "ALL" && comms != nil {
 				comms.PushEvent(base.Event{TradeDetails: message})
 			}
 		}
@@ -218,7 +255,7 @@ func IsValidEvent(Exchange, Item, Condition, Action string) error {
 			return errInvalidAction
 		}
 
-		if action[1] != "ALL" {
+		if action[1] != "ALL" && comms != nil {
 			comms.PushEvent(base.Event{Type: action[1]})
 		}
 	} else {
@@ -229,34 +266,177 @@ func IsValidEvent(Exchange, Item, Condition, Action string) error {
 	return nil
 }
 
-// CheckEvents is the overarching routine that will iterate through the Events
-// chain
-func CheckEvents() {
+// CheckEvents iterates through the Events chain and executes any pending event
+// whose condition has been met, returning the number of events triggered
+func CheckEvents() int {
+	m.Lock()
+	defer m.Unlock()
+	triggered := 0
+	for _, event := range Events {
+		if event.Executed {
+			continue
+		}
+		if event.CheckCondition() {
+			log.Printf("Event %d triggered on %s successfully.\n", event.ID,
+				event.Exchange)
+			event.Executed = true
+			triggered++
+		}
+	}
+	if triggered > 0 {
+		saveEvents()
+	}
+	return triggered
+}
+
+// CheckEventsByTicker checks all pending events associated with the supplied
+// exchange, currency pair and asset type against the latest ticker data,
+// returning the number of events triggered
+func CheckEventsByTicker(exchName string, p pair.CurrencyPair, assetType string) int {
+	m.Lock()
+	defer m.Unlock()
+	triggered := 0
+	for _, event := range Events {
+		if event.Executed ||
+			common.StringToUpper(event.Exchange) != common.StringToUpper(exchName) ||
+			event.Asset != assetType ||
+			!event.Pair.Equal(p, true) {
+			continue
+		}
+		if event.CheckCondition() {
+			log.Printf("Event %d triggered on %s successfully.\n", event.ID,
+				event.Exchange)
+			event.Executed = true
+			triggered++
+		}
+	}
+	if triggered > 0 {
+		saveEvents()
+	}
+	return triggered
+}
+
+// Start loads any events persisted in the supplied data directory and starts
+// the event manager routine
+func Start(dataDir string) error {
+	m.Lock()
+	defer m.Unlock()
+	if started {
+		return errAlreadyStarted
+	}
+
+	eventPath = dataDir + common.GetOSPathSlash() + eventsFile
+	err := loadEvents()
+	if err != nil {
+		return err
+	}
+
+	updates = databus.Subscribe(databus.Filter{Topics: []string{databus.Ticker}},
+		maxPendingUpdates,
+		databus.DropNewest)
+	shutdown = make(chan struct{})
+	started = true
+
+	wg.Add(1)
+	go run(updates.C, shutdown)
+	log.Printf("Event manager started: Have %d event(s) loaded.\n", len(Events))
+	return nil
+}
+
+// Stop shuts down the event manager routine and persists all events to the
+// data directory
+func Stop() error {
+	m.Lock()
+	if !started {
+		m.Unlock()
+		return errNotStarted
+	}
+	started = false
+	close(shutdown)
+	updates.Unsubscribe()
+	m.Unlock()
+
+	wg.Wait()
+
+	m.Lock()
+	defer m.Unlock()
+	return saveEventsFile()
+}
+
+// IsRunning returns whether or not the event manager routine is running
+func IsRunning() bool {
+	m.Lock()
+	defer m.Unlock()
+	return started
+}
+
+// run checks events against ticker updates from the data bus until shutdown
+func run(updates <-chan databus.Message, shutdown <-chan struct{}) {
+	defer wg.Done()
 	for {
-		total, executed := GetEventCounter()
-		if total > 0 && executed != total {
-			for _, event := range Events {
-				if !event.Executed {
-					success := event.CheckCondition()
-					if success {
-						log.Printf(
-							"Event %d triggered on %s successfully.\n", event.ID,
-							event.Exchange,
-						)
-						event.Executed = true
-					}
-				}
+		select {
+		case <-shutdown:
+			return
+		case u, ok := <-updates:
+			if !ok {
+				return
 			}
+			CheckEventsByTicker(u.Exchange, u.Pair, u.Asset)
 		}
 	}
 }
 
+// loadEvents reads the persisted events from the events file, if any
+func loadEvents() error {
+	data, err := common.ReadFile(eventPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	var loaded []*Event
+	err = common.JSONDecode(data, &loaded)
+	if err != nil {
+		return fmt.Errorf("unable to decode events file %s. Error: %s",
+			eventPath, err)
+	}
+	Events = loaded
+	return nil
+}
+
+// saveEvents persists events if the event manager is running, errors are
+// logged as callers can't act on them
+func saveEvents() {
+	if !started {
+		return
+	}
+	err := saveEventsFile()
+	if err != nil {
+		log.Printf("Event manager: failed to save events. Error: %s\n", err)
+	}
+}
+
+// saveEventsFile writes all events to the events file
+func saveEventsFile() error {
+	if eventPath == "" {
+		return nil
+	}
+
+	data, err := common.JSONEncode(Events)
+	if err != nil {
+		return err
+	}
+	return common.WriteFile(eventPath, data)
+}
+
 // IsValidExchange validates the exchange
 func IsValidExchange(Exchange string) bool {
 	Exchange = common.StringToUpper(Exchange)
 	cfg := config.GetConfig()
 	for _, x := range cfg.Exchanges {
-		if x.Name == Exchange && x.Enabled {
+		if common.StringToUpper(x.Name) == Exchange && x.Enabled {
 			return true
 		}
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"log"
 	"sync"
@@ -30,12 +31,16 @@ import (
 	"github.com/thrasher-/gocryptotrader/exchanges/lakebtc"
 	"github.com/thrasher-/gocryptotrader/exchanges/liqui"
 	"github.com/thrasher-/gocryptotrader/exchanges/localbitcoins"
+	"github.com/thrasher-/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-/gocryptotrader/exchanges/okcoin"
 	"github.com/thrasher-/gocryptotrader/exchanges/okex"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/paper"
 	"github.com/thrasher-/gocryptotrader/exchanges/poloniex"
 	"github.com/thrasher-/gocryptotrader/exchanges/wex"
 	"github.com/thrasher-/gocryptotrader/exchanges/yobit"
 	"github.com/thrasher-/gocryptotrader/exchanges/zb"
+	"github.com/thrasher-/gocryptotrader/strategies"
 )
 
 // vars related to exchange functions
@@ -182,6 +187,8 @@ func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 		exch = new(liqui.Liqui)
 	case "localbitcoins":
 		exch = new(localbitcoins.LocalBitcoins)
+	case "mock":
+		exch = new(mock.Mock)
 	case "okcoin china":
 		exch = new(okcoin.OKCoin)
 	case "okcoin international":
@@ -214,6 +221,16 @@ func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 	exchCfg.Enabled = true
 	exch.Setup(exchCfg)
 
+	if bot.paperTrading || (exchCfg.PaperTrading != nil && exchCfg.PaperTrading.Enabled) {
+		var balances map[string]float64
+		if exchCfg.PaperTrading != nil {
+			balances = exchCfg.PaperTrading.Balances
+		}
+		exch = paper.New(exch, balances)
+		bot.exchanges[len(bot.exchanges)-1] = exch
+		log.Printf("%s: Paper trading enabled.\n", exchCfg.Name)
+	}
+
 	if useWG {
 		exch.Start(wg)
 	} else {
@@ -267,3 +284,112 @@ func SetupExchanges() {
 	}
 	wg.Wait()
 }
+
+// SubmitExchangeOrder submits an order to an exchange by name and records the
+// result with the order manager
+func SubmitExchangeOrder(ctx context.Context, exchName string, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return exchange.SubmitOrderResponse{}, ErrExchangeNotFound
+	}
+	return orderTrackingExchange{exch}.SubmitOrder(ctx, order)
+}
+
+// submitSyntheticOrder submits the order fired by a triggered synthetic order
+func submitSyntheticOrder(exchName string, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
+	return SubmitExchangeOrder(bot.ctx, exchName, order)
+}
+
+// CancelExchangeOrder cancels an order on an exchange by name and records the
+// cancellation with the order manager
+func CancelExchangeOrder(ctx context.Context, exchName string, cancel exchange.OrderCancellation) error {
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return ErrExchangeNotFound
+	}
+	return orderTrackingExchange{exch}.CancelOrder(ctx, cancel)
+}
+
+// orderTrackingExchange records orders submitted and cancelled through an
+// exchange with the order manager and notifies strategies of the changes
+type orderTrackingExchange struct {
+	exchange.IBotExchange
+}
+
+// SubmitOrder rounds an order to the trading rules of its currency pair,
+// submits it and records the result with the order manager, any amount filled
+// on placement is recorded against the order
+func (o orderTrackingExchange) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
+	o.RoundOrder(&order)
+	resp, err := o.IBotExchange.SubmitOrder(ctx, order)
+	orderID := orders.Submitted(o.GetName(), resp.OrderID, order.ClientID,
+		order.Pair, order.Side.ToString(), order.OrderType.ToString(),
+		order.Amount, order.Price, err == nil && resp.IsOrderPlaced)
+	if resp.OrderID == "" {
+		return resp, err
+	}
+
+	if err == nil && resp.FilledAmount > 0 {
+		status := orders.PartiallyFilled
+		if resp.FilledAmount >= order.Amount {
+			status = orders.Filled
+		}
+
+		_, updateErr := orders.UpdateStatus(orderID, status, resp.FilledAmount)
+		if updateErr != nil {
+			log.Printf("Order manager: failed to update %s order %s. Error: %s",
+				o.GetName(), resp.OrderID, updateErr)
+		}
+	}
+
+	notifyOrderUpdate(o.IBotExchange, resp.OrderID)
+	return resp, err
+}
+
+// CancelOrder cancels an order and records the cancellation with the order
+// manager
+func (o orderTrackingExchange) CancelOrder(ctx context.Context, cancel exchange.OrderCancellation) error {
+	err := o.IBotExchange.CancelOrder(ctx, cancel)
+	if err != nil {
+		return err
+	}
+
+	order, err := orders.GetOrderByExchangeOrderID(o.GetName(), cancel.OrderID)
+	if err != nil {
+		// Order was not submitted through the bot
+		return nil
+	}
+
+	_, err = orders.UpdateStatus(order.OrderID, orders.Cancelled,
+		order.FilledAmount)
+	if err != nil {
+		return err
+	}
+
+	notifyOrderUpdate(o.IBotExchange, cancel.OrderID)
+	return nil
+}
+
+// notifyOrderUpdate passes the latest state of an order tracked by the order
+// manager to any running strategies
+func notifyOrderUpdate(exch exchange.IBotExchange, exchangeOrderID string) {
+	order, err := orders.GetOrderByExchangeOrderID(exch.GetName(),
+		exchangeOrderID)
+	if err != nil {
+		return
+	}
+	strategies.OrderUpdated(orderTrackingExchange{exch}, order)
+}
+
+// GetStrategyExchanges returns all loaded exchanges for use by strategies,
+// orders placed through them are tracked by the order manager
+func GetStrategyExchanges() []exchange.IBotExchange {
+	var exchanges []exchange.IBotExchange
+	for x := range bot.exchanges {
+		if bot.exchanges[x] == nil {
+			continue
+		}
+		exchanges = append(exchanges, orderTrackingExchange{bot.exchanges[x]})
+	}
+	return exchanges
+}
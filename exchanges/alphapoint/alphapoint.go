@@ -2,6 +2,7 @@ package alphapoint
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -64,12 +65,12 @@ func (a *Alphapoint) SetDefaults() {
 
 // GetTicker returns current ticker information from Alphapoint for a selected
 // currency pair ie "BTCUSD"
-func (a *Alphapoint) GetTicker(currencyPair string) (Ticker, error) {
+func (a *Alphapoint) GetTicker(ctx context.Context, currencyPair string) (Ticker, error) {
 	request := make(map[string]interface{})
 	request["productPair"] = currencyPair
 	response := Ticker{}
 
-	err := a.SendHTTPRequest("POST", alphapointTicker, request, &response)
+	err := a.SendHTTPRequest(ctx, "POST", alphapointTicker, request, &response)
 	if err != nil {
 		return response, err
 	}
@@ -85,14 +86,14 @@ func (a *Alphapoint) GetTicker(currencyPair string) (Ticker, error) {
 // AlphaPoint Exchange. To begin from the most recent trade, set startIndex to
 // 0 (default: 0)
 // Count: specifies the number of trades to return (default: 10)
-func (a *Alphapoint) GetTrades(currencyPair string, startIndex, count int) (Trades, error) {
+func (a *Alphapoint) GetTrades(ctx context.Context, currencyPair string, startIndex, count int) (Trades, error) {
 	request := make(map[string]interface{})
 	request["ins"] = currencyPair
 	request["startIndex"] = startIndex
 	request["Count"] = count
 	response := Trades{}
 
-	err := a.SendHTTPRequest("POST", alphapointTrades, request, &response)
+	err := a.SendHTTPRequest(ctx, "POST", alphapointTrades, request, &response)
 	if err != nil {
 		return response, err
 	}
@@ -106,14 +107,14 @@ func (a *Alphapoint) GetTrades(currencyPair string, startIndex, count int) (Trad
 // CurrencyPair - instrument code (ex: “BTCUSD”)
 // StartDate - specifies the starting time in epoch time, type is long
 // EndDate - specifies the end time in epoch time, type is long
-func (a *Alphapoint) GetTradesByDate(currencyPair string, startDate, endDate int64) (Trades, error) {
+func (a *Alphapoint) GetTradesByDate(ctx context.Context, currencyPair string, startDate, endDate int64) (Trades, error) {
 	request := make(map[string]interface{})
 	request["ins"] = currencyPair
 	request["startDate"] = startDate
 	request["endDate"] = endDate
 	response := Trades{}
 
-	err := a.SendHTTPRequest("POST", alphapointTradesByDate, request, &response)
+	err := a.SendHTTPRequest(ctx, "POST", alphapointTradesByDate, request, &response)
 	if err != nil {
 		return response, err
 	}
@@ -125,12 +126,12 @@ func (a *Alphapoint) GetTradesByDate(currencyPair string, startDate, endDate int
 
 // GetOrderbook fetches the current orderbook for a given currency pair
 // CurrencyPair - trade pair (ex: “BTCUSD”)
-func (a *Alphapoint) GetOrderbook(currencyPair string) (Orderbook, error) {
+func (a *Alphapoint) GetOrderbook(ctx context.Context, currencyPair string) (Orderbook, error) {
 	request := make(map[string]interface{})
 	request["productPair"] = currencyPair
 	response := Orderbook{}
 
-	err := a.SendHTTPRequest("POST", alphapointOrderbook, request, &response)
+	err := a.SendHTTPRequest(ctx, "POST", alphapointOrderbook, request, &response)
 	if err != nil {
 		return response, err
 	}
@@ -141,10 +142,10 @@ func (a *Alphapoint) GetOrderbook(currencyPair string) (Orderbook, error) {
 }
 
 // GetProductPairs gets the currency pairs currently traded on alphapoint
-func (a *Alphapoint) GetProductPairs() (ProductPairs, error) {
+func (a *Alphapoint) GetProductPairs(ctx context.Context) (ProductPairs, error) {
 	response := ProductPairs{}
 
-	err := a.SendHTTPRequest("POST", alphapointProductPairs, nil, &response)
+	err := a.SendHTTPRequest(ctx, "POST", alphapointProductPairs, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -155,10 +156,10 @@ func (a *Alphapoint) GetProductPairs() (ProductPairs, error) {
 }
 
 // GetProducts gets the currency products currently supported on alphapoint
-func (a *Alphapoint) GetProducts() (Products, error) {
+func (a *Alphapoint) GetProducts(ctx context.Context) (Products, error) {
 	response := Products{}
 
-	err := a.SendHTTPRequest("POST", alphapointProducts, nil, &response)
+	err := a.SendHTTPRequest(ctx, "POST", alphapointProducts, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -174,7 +175,7 @@ func (a *Alphapoint) GetProducts() (Products, error) {
 // Email - Email address
 // Phone - Phone number (ex: “+12223334444”)
 // Password - Minimum 8 characters
-func (a *Alphapoint) CreateAccount(firstName, lastName, email, phone, password string) error {
+func (a *Alphapoint) CreateAccount(ctx context.Context, firstName, lastName, email, phone, password string) error {
 	if len(password) < 8 {
 		return errors.New(
 			"alphapoint Error - Create account - Password must be 8 characters or more",
@@ -189,7 +190,7 @@ func (a *Alphapoint) CreateAccount(firstName, lastName, email, phone, password s
 	request["password"] = password
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest("POST", alphapointCreateAccount, request, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, "POST", alphapointCreateAccount, request, &response)
 	if err != nil {
 		log.Println(err)
 	}
@@ -200,10 +201,10 @@ func (a *Alphapoint) CreateAccount(firstName, lastName, email, phone, password s
 }
 
 // GetUserInfo returns current account user information
-func (a *Alphapoint) GetUserInfo() (UserInfo, error) {
+func (a *Alphapoint) GetUserInfo(ctx context.Context) (UserInfo, error) {
 	response := UserInfo{}
 
-	err := a.SendAuthenticatedHTTPRequest("POST", alphapointUserInfo, map[string]interface{}{}, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, "POST", alphapointUserInfo, map[string]interface{}{}, &response)
 	if err != nil {
 		return UserInfo{}, err
 	}
@@ -222,7 +223,7 @@ func (a *Alphapoint) GetUserInfo() (UserInfo, error) {
 // Cell2FAValue - Cell phone number, required for Authentication
 // Use2FAForWithdraw - “true” or “false” set to true for using 2FA for
 // withdrawals
-func (a *Alphapoint) SetUserInfo(firstName, lastName, cell2FACountryCode, cell2FAValue string, useAuthy2FA, use2FAForWithdraw bool) (UserInfoSet, error) {
+func (a *Alphapoint) SetUserInfo(ctx context.Context, firstName, lastName, cell2FACountryCode, cell2FAValue string, useAuthy2FA, use2FAForWithdraw bool) (UserInfoSet, error) {
 	response := UserInfoSet{}
 
 	var userInfoKVPs = []UserInfoKVP{
@@ -255,7 +256,7 @@ func (a *Alphapoint) SetUserInfo(firstName, lastName, cell2FACountryCode, cell2F
 	request := make(map[string]interface{})
 	request["userInfoKVP"] = userInfoKVPs
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointUserInfo,
 		request,
@@ -271,10 +272,10 @@ func (a *Alphapoint) SetUserInfo(firstName, lastName, cell2FACountryCode, cell2F
 }
 
 // GetAccountInformation returns account info
-func (a *Alphapoint) GetAccountInformation() (AccountInfo, error) {
+func (a *Alphapoint) GetAccountInformation(ctx context.Context) (AccountInfo, error) {
 	response := AccountInfo{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointAccountInfo,
 		map[string]interface{}{},
@@ -293,14 +294,14 @@ func (a *Alphapoint) GetAccountInformation() (AccountInfo, error) {
 // CurrencyPair - Instrument code (ex: “BTCUSD”)
 // StartIndex - Starting index, if less than 0 then start from the beginning
 // Count - Returns last trade, (Default: 30)
-func (a *Alphapoint) GetAccountTrades(currencyPair string, startIndex, count int) (Trades, error) {
+func (a *Alphapoint) GetAccountTrades(ctx context.Context, currencyPair string, startIndex, count int) (Trades, error) {
 	request := make(map[string]interface{})
 	request["ins"] = currencyPair
 	request["startIndex"] = startIndex
 	request["count"] = count
 	response := Trades{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointAccountTrades,
 		request,
@@ -316,10 +317,10 @@ func (a *Alphapoint) GetAccountTrades(currencyPair string, startIndex, count int
 }
 
 // GetDepositAddresses generates a deposit address
-func (a *Alphapoint) GetDepositAddresses() ([]DepositAddresses, error) {
+func (a *Alphapoint) GetDepositAddresses(ctx context.Context) ([]DepositAddresses, error) {
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest("POST", alphapointDepositAddresses,
+	err := a.SendAuthenticatedHTTPRequest(ctx, "POST", alphapointDepositAddresses,
 		map[string]interface{}{}, &response,
 	)
 	if err != nil {
@@ -336,7 +337,7 @@ func (a *Alphapoint) GetDepositAddresses() ([]DepositAddresses, error) {
 // product - Currency name (ex: “BTC”)
 // amount - Amount (ex: “.011”)
 // address - Withdraw address
-func (a *Alphapoint) WithdrawCoins(symbol, product, address string, amount float64) error {
+func (a *Alphapoint) WithdrawCoins(ctx context.Context, symbol, product, address string, amount float64) error {
 	request := make(map[string]interface{})
 	request["ins"] = symbol
 	request["product"] = product
@@ -344,7 +345,7 @@ func (a *Alphapoint) WithdrawCoins(symbol, product, address string, amount float
 	request["sendToAddress"] = address
 
 	response := Response{}
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointWithdraw,
 		request,
@@ -373,7 +374,7 @@ func (a *Alphapoint) convertOrderTypeToOrderTypeNumber(orderType string) (orderT
 // orderType - “1” for market orders, “0” for limit orders
 // quantity - Quantity
 // price - Price in USD
-func (a *Alphapoint) CreateOrder(symbol, side, orderType string, quantity, price float64) (int64, error) {
+func (a *Alphapoint) CreateOrder(ctx context.Context, symbol, side, orderType string, quantity, price float64) (int64, error) {
 	orderTypeNumber := a.convertOrderTypeToOrderTypeNumber(orderType)
 	request := make(map[string]interface{})
 	request["ins"] = symbol
@@ -383,7 +384,7 @@ func (a *Alphapoint) CreateOrder(symbol, side, orderType string, quantity, price
 	request["px"] = strconv.FormatFloat(price, 'f', -1, 64)
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointCreateOrder,
 		request,
@@ -406,14 +407,14 @@ func (a *Alphapoint) CreateOrder(symbol, side, orderType string, quantity, price
 // book. A buy order will be modified to the highest bid and a sell order will
 // be modified to the lowest ask price. “1” means "Execute now", which will
 // convert a limit order into a market order.
-func (a *Alphapoint) ModifyExistingOrder(symbol string, OrderID, action int64) (int64, error) {
+func (a *Alphapoint) ModifyExistingOrder(ctx context.Context, symbol string, OrderID, action int64) (int64, error) {
 	request := make(map[string]interface{})
 	request["ins"] = symbol
 	request["serverOrderId"] = OrderID
 	request["modifyAction"] = action
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointModifyOrder,
 		request,
@@ -431,13 +432,13 @@ func (a *Alphapoint) ModifyExistingOrder(symbol string, OrderID, action int64) (
 // CancelExistingOrder cancels an order that has not been executed.
 // symbol - Instrument code (ex: “BTCUSD”)
 // OrderId - Order id (ex: 1000)
-func (a *Alphapoint) CancelExistingOrder(OrderID int64, OMSID string) (int64, error) {
+func (a *Alphapoint) CancelExistingOrder(ctx context.Context, OrderID int64, OMSID string) (int64, error) {
 	request := make(map[string]interface{})
 	request["OrderId"] = OrderID
 	request["OMSId"] = OMSID
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointCancelOrder,
 		request,
@@ -454,12 +455,12 @@ func (a *Alphapoint) CancelExistingOrder(OrderID int64, OMSID string) (int64, er
 
 // CancelAllExistingOrders cancels all open orders by symbol
 // symbol - Instrument code (ex: “BTCUSD”)
-func (a *Alphapoint) CancelAllExistingOrders(symbol string) error {
+func (a *Alphapoint) CancelAllExistingOrders(ctx context.Context, symbol string) error {
 	request := make(map[string]interface{})
 	request["ins"] = symbol
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointCancelAllOrders,
 		request,
@@ -475,10 +476,10 @@ func (a *Alphapoint) CancelAllExistingOrders(symbol string) error {
 }
 
 // GetOrders returns all current open orders
-func (a *Alphapoint) GetOrders() ([]OpenOrders, error) {
+func (a *Alphapoint) GetOrders(ctx context.Context) ([]OpenOrders, error) {
 	response := OrderInfo{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointOpenOrders,
 		map[string]interface{}{},
@@ -498,7 +499,7 @@ func (a *Alphapoint) GetOrders() ([]OpenOrders, error) {
 // side - “buy” or “sell”
 // quantity - Quantity
 // price - Price in USD
-func (a *Alphapoint) GetOrderFee(symbol, side string, quantity, price float64) (float64, error) {
+func (a *Alphapoint) GetOrderFee(ctx context.Context, symbol, side string, quantity, price float64) (float64, error) {
 	request := make(map[string]interface{})
 	request["ins"] = symbol
 	request["side"] = side
@@ -506,7 +507,7 @@ func (a *Alphapoint) GetOrderFee(symbol, side string, quantity, price float64) (
 	request["px"] = strconv.FormatFloat(price, 'f', -1, 64)
 	response := Response{}
 
-	err := a.SendAuthenticatedHTTPRequest(
+	err := a.SendAuthenticatedHTTPRequest(ctx,
 		"POST",
 		alphapointOrderFee,
 		request,
@@ -522,7 +523,7 @@ func (a *Alphapoint) GetOrderFee(symbol, side string, quantity, price float64) (
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (a *Alphapoint) SendHTTPRequest(method, path string, data map[string]interface{}, result interface{}) error {
+func (a *Alphapoint) SendHTTPRequest(ctx context.Context, method, path string, data map[string]interface{}, result interface{}) error {
 	headers := make(map[string]string)
 	headers["Content-Type"] = "application/json"
 	path = fmt.Sprintf("%s/ajax/v%s/%s", a.APIUrl, alphapointAPIVersion, path)
@@ -532,11 +533,11 @@ func (a *Alphapoint) SendHTTPRequest(method, path string, data map[string]interf
 		return errors.New("SendHTTPRequest: Unable to JSON request")
 	}
 
-	return a.SendPayload(method, path, headers, bytes.NewBuffer(PayloadJSON), result, false, a.Verbose)
+	return a.SendPayload(ctx, method, path, headers, bytes.NewBuffer(PayloadJSON), result, false, a.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated request
-func (a *Alphapoint) SendAuthenticatedHTTPRequest(method, path string, data map[string]interface{}, result interface{}) error {
+func (a *Alphapoint) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, data map[string]interface{}, result interface{}) error {
 	if !a.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, a.Name)
 	}
@@ -560,5 +561,5 @@ func (a *Alphapoint) SendAuthenticatedHTTPRequest(method, path string, data map[
 		return errors.New("SendAuthenticatedHTTPRequest: Unable to JSON request")
 	}
 
-	return a.SendPayload(method, path, headers, bytes.NewBuffer(PayloadJSON), result, true, a.Verbose)
+	return a.SendPayload(ctx, method, path, headers, bytes.NewBuffer(PayloadJSON), result, true, a.Verbose)
 }
@@ -1,6 +1,7 @@
 package alphapoint
 
 import (
+	"context"
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/common"
@@ -49,12 +50,12 @@ func TestGetTicker(t *testing.T) {
 	var err error
 
 	if onlineTest {
-		ticker, err = alpha.GetTicker("BTCUSD")
+		ticker, err = alpha.GetTicker(context.Background(), "BTCUSD")
 		if err != nil {
 			t.Fatal("Test Failed - Alphapoint GetTicker init error: ", err)
 		}
 
-		_, err = alpha.GetTicker("wigwham")
+		_, err = alpha.GetTicker(context.Background(), "wigwham")
 		if err == nil {
 			t.Error("Test Failed - Alphapoint GetTicker error")
 		}
@@ -86,12 +87,12 @@ func TestGetTrades(t *testing.T) {
 	var err error
 
 	if onlineTest {
-		trades, err = alpha.GetTrades("BTCUSD", 0, 10)
+		trades, err = alpha.GetTrades(context.Background(), "BTCUSD", 0, 10)
 		if err != nil {
 			t.Fatalf("Test Failed - Init error: %s", err)
 		}
 
-		_, err = alpha.GetTrades("wigwham", 0, 10)
+		_, err = alpha.GetTrades(context.Background(), "wigwham", 0, 10)
 		if err == nil {
 			t.Fatal("Test Failed - GetTrades error")
 		}
@@ -127,11 +128,11 @@ func TestGetTradesByDate(t *testing.T) {
 	var err error
 
 	if onlineTest {
-		trades, err = alpha.GetTradesByDate("BTCUSD", 1414799400, 1414800000)
+		trades, err = alpha.GetTradesByDate(context.Background(), "BTCUSD", 1414799400, 1414800000)
 		if err != nil {
 			t.Errorf("Test Failed - Init error: %s", err)
 		}
-		_, err = alpha.GetTradesByDate("wigwham", 1414799400, 1414800000)
+		_, err = alpha.GetTradesByDate(context.Background(), "wigwham", 1414799400, 1414800000)
 		if err == nil {
 			t.Error("Test Failed - GetTradesByDate error")
 		}
@@ -174,12 +175,12 @@ func TestGetOrderbook(t *testing.T) {
 	var err error
 
 	if onlineTest {
-		orderBook, err = alpha.GetOrderbook("BTCUSD")
+		orderBook, err = alpha.GetOrderbook(context.Background(), "BTCUSD")
 		if err != nil {
 			t.Errorf("Test Failed - Init error: %s", err)
 		}
 
-		_, err = alpha.GetOrderbook("wigwham")
+		_, err = alpha.GetOrderbook(context.Background(), "wigwham")
 		if err == nil {
 			t.Error("Test Failed - GetOrderbook() error")
 		}
@@ -219,7 +220,7 @@ func TestGetProductPairs(t *testing.T) {
 	var err error
 
 	if onlineTest {
-		products, err = alpha.GetProductPairs()
+		products, err = alpha.GetProductPairs(context.Background())
 		if err != nil {
 			t.Errorf("Test Failed - Init error: %s", err)
 		}
@@ -259,7 +260,7 @@ func TestGetProducts(t *testing.T) {
 	var err error
 
 	if onlineTest {
-		products, err = alpha.GetProducts()
+		products, err = alpha.GetProducts(context.Background())
 		if err != nil {
 			t.Errorf("Test Failed - Init error: %s", err)
 		}
@@ -300,15 +301,15 @@ func TestCreateAccount(t *testing.T) {
 		return
 	}
 
-	err := a.CreateAccount("test", "account", "something@something.com", "0292383745", "lolcat123")
+	err := a.CreateAccount(context.Background(), "test", "account", "something@something.com", "0292383745", "lolcat123")
 	if err != nil {
 		t.Errorf("Test Failed - Init error: %s", err)
 	}
-	err = a.CreateAccount("test", "account", "something@something.com", "0292383745", "bla")
+	err = a.CreateAccount(context.Background(), "test", "account", "something@something.com", "0292383745", "bla")
 	if err == nil {
 		t.Errorf("Test Failed - CreateAccount() error")
 	}
-	err = a.CreateAccount("", "", "", "", "lolcat123")
+	err = a.CreateAccount(context.Background(), "", "", "", "", "lolcat123")
 	if err == nil {
 		t.Errorf("Test Failed - CreateAccount() error")
 	}
@@ -323,7 +324,7 @@ func TestGetUserInfo(t *testing.T) {
 		return
 	}
 
-	_, err := a.GetUserInfo()
+	_, err := a.GetUserInfo(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -338,7 +339,7 @@ func TestSetUserInfo(t *testing.T) {
 		return
 	}
 
-	_, err := a.SetUserInfo("bla", "bla", "1", "meh", true, true)
+	_, err := a.SetUserInfo(context.Background(), "bla", "bla", "1", "meh", true, true)
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -353,7 +354,7 @@ func TestGetAccountInfo(t *testing.T) {
 		return
 	}
 
-	_, err := a.GetAccountInfo()
+	_, err := a.GetAccountInfo(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -368,7 +369,7 @@ func TestGetAccountTrades(t *testing.T) {
 		return
 	}
 
-	_, err := a.GetAccountTrades("", 1, 2)
+	_, err := a.GetAccountTrades(context.Background(), "", 1, 2)
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -383,7 +384,7 @@ func TestGetDepositAddresses(t *testing.T) {
 		return
 	}
 
-	_, err := a.GetDepositAddresses()
+	_, err := a.GetDepositAddresses(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -398,7 +399,7 @@ func TestWithdrawCoins(t *testing.T) {
 		return
 	}
 
-	err := a.WithdrawCoins("", "", "", 0.01)
+	err := a.WithdrawCoins(context.Background(), "", "", "", 0.01)
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -413,7 +414,7 @@ func TestCreateOrder(t *testing.T) {
 		return
 	}
 
-	_, err := a.CreateOrder("", "", exchange.Market.ToString(), 0.01, 0)
+	_, err := a.CreateOrder(context.Background(), "", "", exchange.Market.ToString(), 0.01, 0)
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -428,7 +429,7 @@ func TestModifyExistingOrder(t *testing.T) {
 		return
 	}
 
-	_, err := a.ModifyExistingOrder("", 1, 1)
+	_, err := a.ModifyExistingOrder(context.Background(), "", 1, 1)
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -443,7 +444,7 @@ func TestCancelAllExistingOrders(t *testing.T) {
 		return
 	}
 
-	err := a.CancelAllExistingOrders("")
+	err := a.CancelAllExistingOrders(context.Background(), "")
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -458,7 +459,7 @@ func TestGetOrders(t *testing.T) {
 		return
 	}
 
-	_, err := a.GetOrders()
+	_, err := a.GetOrders(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -473,7 +474,7 @@ func TestGetOrderFee(t *testing.T) {
 		return
 	}
 
-	_, err := a.GetOrderFee("", "", 1, 1)
+	_, err := a.GetOrderFee(context.Background(), "", "", 1, 1)
 	if err == nil {
 		t.Error("Test Failed - GetUserInfo() error")
 	}
@@ -516,7 +517,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USD,
 	}
-	response, err := a.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := a.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -541,7 +542,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 	// Act
-	err := a.CancelOrder(orderCancellation)
+	err := a.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
@@ -1,6 +1,7 @@
 package alphapoint
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -14,10 +15,10 @@ import (
 
 // GetAccountInfo retrieves balances for all enabled currencies on the
 // Alphapoint exchange
-func (a *Alphapoint) GetAccountInfo() (exchange.AccountInfo, error) {
+func (a *Alphapoint) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = a.GetName()
-	account, err := a.GetAccountInformation()
+	account, err := a.GetAccountInformation(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -33,9 +34,9 @@ func (a *Alphapoint) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (a *Alphapoint) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (a *Alphapoint) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := a.GetTicker(p.Pair().String())
+	tick, err := a.GetTicker(ctx, p.Pair().String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -52,18 +53,18 @@ func (a *Alphapoint) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (a *Alphapoint) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (a *Alphapoint) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(a.GetName(), p, assetType)
 	if err != nil {
-		return a.UpdateTicker(p, assetType)
+		return a.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (a *Alphapoint) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (a *Alphapoint) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := a.GetOrderbook(p.Pair().String())
+	orderbookNew, err := a.GetOrderbook(ctx, p.Pair().String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -83,23 +84,23 @@ func (a *Alphapoint) UpdateOrderbook(p pair.CurrencyPair, assetType string) (ord
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (a *Alphapoint) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (a *Alphapoint) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(a.GetName(), p, assetType)
 	if err != nil {
-		return a.UpdateOrderbook(p, assetType)
+		return a.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (a *Alphapoint) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (a *Alphapoint) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (a *Alphapoint) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (a *Alphapoint) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
@@ -107,10 +108,13 @@ func (a *Alphapoint) GetExchangeHistory(p pair.CurrencyPair, assetType string) (
 
 // SubmitOrder submits a new order and returns a true value when
 // successfully submitted
-func (a *Alphapoint) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (a *Alphapoint) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := a.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
 
-	response, err := a.CreateOrder(p.Pair().String(), side.ToString(), orderType.ToString(), amount, price)
+	response, err := a.CreateOrder(ctx, order.Pair.Pair().String(), order.Side.ToString(), order.OrderType.ToString(), order.Amount, order.Price)
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
 	}
@@ -124,40 +128,40 @@ func (a *Alphapoint) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, o
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (a *Alphapoint) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
+func (a *Alphapoint) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
 	// return a.ModifyExistingOrder(p.Pair().String(), orderID, action)
-	return 0, common.ErrNotYetImplemented
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (a *Alphapoint) CancelOrder(order exchange.OrderCancellation) error {
+func (a *Alphapoint) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = a.CancelExistingOrder(orderIDInt, order.AccountID)
+	_, err = a.CancelExistingOrder(ctx, orderIDInt, order.AccountID)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (a *Alphapoint) CancelAllOrders() error {
+func (a *Alphapoint) CancelAllOrders(ctx context.Context) error {
 	// return a.CancelAllExistingOrders(p.Pair().String())
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (a *Alphapoint) GetOrderInfo(orderID int64) (float64, error) {
-	orders, err := a.GetOrders()
+func (a *Alphapoint) GetOrderInfo(ctx context.Context, orderID string) (float64, error) {
+	orders, err := a.GetOrders(ctx)
 	if err != nil {
 		return 0, err
 	}
 
 	for x := range orders {
 		for y := range orders[x].Openorders {
-			if int64(orders[x].Openorders[y].Serverorderid) == orderID {
+			if strconv.Itoa(orders[x].Openorders[y].Serverorderid) == orderID {
 				return float64(orders[x].Openorders[y].QtyRemaining), nil
 			}
 		}
@@ -166,8 +170,8 @@ func (a *Alphapoint) GetOrderInfo(orderID int64) (float64, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (a *Alphapoint) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
-	addreses, err := a.GetDepositAddresses()
+func (a *Alphapoint) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
+	addreses, err := a.GetDepositAddresses(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -182,12 +186,12 @@ func (a *Alphapoint) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (a *Alphapoint) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (a *Alphapoint) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is submitted
-func (a *Alphapoint) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (a *Alphapoint) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -2,6 +2,7 @@ package anx
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -72,7 +73,7 @@ func (a *ANX) SetDefaults() {
 	a.WebsocketInit()
 }
 
-//Setup is run on startup to setup exchange with config values
+// Setup is run on startup to setup exchange with config values
 func (a *ANX) Setup(exch config.ExchangeConfig) {
 	if !exch.Enabled {
 		a.SetEnabled(false)
@@ -112,11 +113,11 @@ func (a *ANX) Setup(exch config.ExchangeConfig) {
 
 // GetCurrencies returns a list of supported currencies (both fiat
 // and cryptocurrencies)
-func (a *ANX) GetCurrencies() (CurrenciesStore, error) {
+func (a *ANX) GetCurrencies(ctx context.Context) (CurrenciesStore, error) {
 	var result CurrenciesStaticResponse
 	path := fmt.Sprintf("%sapi/3/%s", a.APIUrl, anxCurrencies)
 
-	err := a.SendHTTPRequest(path, &result)
+	err := a.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return CurrenciesStore{}, err
 	}
@@ -125,23 +126,23 @@ func (a *ANX) GetCurrencies() (CurrenciesStore, error) {
 }
 
 // GetTicker returns the current ticker
-func (a *ANX) GetTicker(currency string) (Ticker, error) {
+func (a *ANX) GetTicker(ctx context.Context, currency string) (Ticker, error) {
 	var ticker Ticker
 	path := fmt.Sprintf("%sapi/2/%s/%s", a.APIUrl, currency, anxTicker)
 
-	return ticker, a.SendHTTPRequest(path, &ticker)
+	return ticker, a.SendHTTPRequest(ctx, path, &ticker)
 }
 
 // GetDepth returns current orderbook depth.
-func (a *ANX) GetDepth(currency string) (Depth, error) {
+func (a *ANX) GetDepth(ctx context.Context, currency string) (Depth, error) {
 	var depth Depth
 	path := fmt.Sprintf("%sapi/2/%s/%s", a.APIUrl, currency, anxDepth)
 
-	return depth, a.SendHTTPRequest(path, &depth)
+	return depth, a.SendHTTPRequest(ctx, path, &depth)
 }
 
 // GetAPIKey returns a new generated API key set.
-func (a *ANX) GetAPIKey(username, password, otp, deviceID string) (string, string, error) {
+func (a *ANX) GetAPIKey(ctx context.Context, username, password, otp, deviceID string) (string, string, error) {
 	request := make(map[string]interface{})
 	request["nonce"] = strconv.FormatInt(time.Now().UnixNano(), 10)[0:13]
 	request["username"] = username
@@ -161,7 +162,7 @@ func (a *ANX) GetAPIKey(username, password, otp, deviceID string) (string, strin
 	}
 	var response APIKeyResponse
 
-	err := a.SendAuthenticatedHTTPRequest(anxAPIKey, request, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, anxAPIKey, request, &response)
 	if err != nil {
 		return "", "", err
 	}
@@ -174,7 +175,7 @@ func (a *ANX) GetAPIKey(username, password, otp, deviceID string) (string, strin
 }
 
 // GetDataToken returns token data
-func (a *ANX) GetDataToken() (string, error) {
+func (a *ANX) GetDataToken(ctx context.Context) (string, error) {
 	request := make(map[string]interface{})
 
 	type DataTokenResponse struct {
@@ -185,7 +186,7 @@ func (a *ANX) GetDataToken() (string, error) {
 	}
 	var response DataTokenResponse
 
-	err := a.SendAuthenticatedHTTPRequest(anxDataToken, request, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, anxDataToken, request, &response)
 	if err != nil {
 		return "", err
 	}
@@ -197,7 +198,7 @@ func (a *ANX) GetDataToken() (string, error) {
 }
 
 // NewOrder sends a new order request to the exchange.
-func (a *ANX) NewOrder(orderType string, buy bool, tradedCurrency string, tradedCurrencyAmount float64, settlementCurrency string, settlementCurrencyAmount float64, limitPriceSettlement float64,
+func (a *ANX) NewOrder(ctx context.Context, orderType string, buy bool, tradedCurrency string, tradedCurrencyAmount float64, settlementCurrency string, settlementCurrencyAmount float64, limitPriceSettlement float64,
 	replace bool, replaceUUID string, replaceIfActive bool) (string, error) {
 
 	request := make(map[string]interface{})
@@ -229,7 +230,7 @@ func (a *ANX) NewOrder(orderType string, buy bool, tradedCurrency string, traded
 	}
 	var response OrderResponse
 
-	err := a.SendAuthenticatedHTTPRequest(anxOrderNew, request, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, anxOrderNew, request, &response)
 	if err != nil {
 		return "", err
 	}
@@ -242,7 +243,7 @@ func (a *ANX) NewOrder(orderType string, buy bool, tradedCurrency string, traded
 
 // CancelOrderByIDs cancels orders, requires already knowing order IDs
 // There is no existing API call to retrieve orderIds
-func (a *ANX) CancelOrderByIDs(orderIds []string) (err error) {
+func (a *ANX) CancelOrderByIDs(ctx context.Context, orderIds []string) (err error) {
 	request := make(map[string]interface{})
 	request["orderIds"] = orderIds
 	type OrderCancelResponse struct {
@@ -252,7 +253,7 @@ func (a *ANX) CancelOrderByIDs(orderIds []string) (err error) {
 		ErrorCode  int64         `json:"errorCode"`
 	}
 	var response OrderCancelResponse
-	err = a.SendAuthenticatedHTTPRequest(anxCancel, request, &response)
+	err = a.SendAuthenticatedHTTPRequest(ctx, anxCancel, request, &response)
 	if response.ResultCode != "OK" {
 		log.Printf("Response code is not OK: %s\n", response.ResultCode)
 		return errors.New(response.ResultCode)
@@ -261,7 +262,7 @@ func (a *ANX) CancelOrderByIDs(orderIds []string) (err error) {
 }
 
 // OrderInfo returns information about a specific order
-func (a *ANX) OrderInfo(orderID string) (OrderResponse, error) {
+func (a *ANX) OrderInfo(ctx context.Context, orderID string) (OrderResponse, error) {
 	request := make(map[string]interface{})
 	request["orderId"] = orderID
 
@@ -272,7 +273,7 @@ func (a *ANX) OrderInfo(orderID string) (OrderResponse, error) {
 	}
 	var response OrderInfoResponse
 
-	err := a.SendAuthenticatedHTTPRequest(anxOrderInfo, request, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, anxOrderInfo, request, &response)
 
 	if err != nil {
 		return OrderResponse{}, err
@@ -286,7 +287,7 @@ func (a *ANX) OrderInfo(orderID string) (OrderResponse, error) {
 }
 
 // Send withdraws a currency to an address
-func (a *ANX) Send(currency, address, otp, amount string) (string, error) {
+func (a *ANX) Send(ctx context.Context, currency, address, otp, amount string) (string, error) {
 	request := make(map[string]interface{})
 	request["ccy"] = currency
 	request["amount"] = amount
@@ -303,7 +304,7 @@ func (a *ANX) Send(currency, address, otp, amount string) (string, error) {
 	}
 	var response SendResponse
 
-	err := a.SendAuthenticatedHTTPRequest(anxSend, request, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, anxSend, request, &response)
 
 	if err != nil {
 		return "", err
@@ -317,7 +318,7 @@ func (a *ANX) Send(currency, address, otp, amount string) (string, error) {
 }
 
 // CreateNewSubAccount generates a new sub account
-func (a *ANX) CreateNewSubAccount(currency, name string) (string, error) {
+func (a *ANX) CreateNewSubAccount(ctx context.Context, currency, name string) (string, error) {
 	request := make(map[string]interface{})
 	request["ccy"] = currency
 	request["customRef"] = name
@@ -329,7 +330,7 @@ func (a *ANX) CreateNewSubAccount(currency, name string) (string, error) {
 	}
 	var response SubaccountResponse
 
-	err := a.SendAuthenticatedHTTPRequest(anxSubaccountNew, request, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, anxSubaccountNew, request, &response)
 
 	if err != nil {
 		return "", err
@@ -343,7 +344,7 @@ func (a *ANX) CreateNewSubAccount(currency, name string) (string, error) {
 }
 
 // GetDepositAddressByCurrency returns a deposit address for a specific currency
-func (a *ANX) GetDepositAddressByCurrency(currency, name string, new bool) (string, error) {
+func (a *ANX) GetDepositAddressByCurrency(ctx context.Context, currency, name string, new bool) (string, error) {
 	request := make(map[string]interface{})
 	request["ccy"] = currency
 
@@ -364,7 +365,7 @@ func (a *ANX) GetDepositAddressByCurrency(currency, name string, new bool) (stri
 		path = anxCreateAddress
 	}
 
-	err := a.SendAuthenticatedHTTPRequest(path, request, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, path, request, &response)
 
 	if err != nil {
 		return "", err
@@ -379,12 +380,12 @@ func (a *ANX) GetDepositAddressByCurrency(currency, name string, new bool) (stri
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (a *ANX) SendHTTPRequest(path string, result interface{}) error {
-	return a.SendPayload("GET", path, nil, nil, result, false, a.Verbose)
+func (a *ANX) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return a.SendPayload(ctx, "GET", path, nil, nil, result, false, a.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends a authenticated HTTP request
-func (a *ANX) SendAuthenticatedHTTPRequest(path string, params map[string]interface{}, result interface{}) error {
+func (a *ANX) SendAuthenticatedHTTPRequest(ctx context.Context, path string, params map[string]interface{}, result interface{}) error {
 	if !a.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, a.Name)
 	}
@@ -421,7 +422,7 @@ func (a *ANX) SendAuthenticatedHTTPRequest(path string, params map[string]interf
 	headers["Rest-Sign"] = common.Base64Encode([]byte(hmac))
 	headers["Content-Type"] = "application/json"
 
-	return a.SendPayload("POST", a.APIUrl+path, headers, bytes.NewBuffer(PayloadJSON), result, true, a.Verbose)
+	return a.SendPayload(ctx, "POST", a.APIUrl+path, headers, bytes.NewBuffer(PayloadJSON), result, true, a.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
@@ -469,9 +470,9 @@ func getInternationalBankWithdrawalFee(currency string, amount float64) float64
 }
 
 // GetAccountInformation retrieves details including API permissions
-func (a *ANX) GetAccountInformation() (AccountInformation, error) {
+func (a *ANX) GetAccountInformation(ctx context.Context) (AccountInformation, error) {
 	var response AccountInformation
-	err := a.SendAuthenticatedHTTPRequest(anxOrderInfo, nil, &response)
+	err := a.SendAuthenticatedHTTPRequest(ctx, anxOrderInfo, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -484,8 +485,8 @@ func (a *ANX) GetAccountInformation() (AccountInformation, error) {
 }
 
 // CheckAPIWithdrawPermission checks if the API key is allowed to withdraw
-func (a *ANX) CheckAPIWithdrawPermission() (bool, error) {
-	accountInfo, err := a.GetAccountInformation()
+func (a *ANX) CheckAPIWithdrawPermission(ctx context.Context) (bool, error) {
+	accountInfo, err := a.GetAccountInformation(ctx)
 
 	if err != nil {
 		return false, err
@@ -1,12 +1,14 @@
 package anx
 
 import (
+	"context"
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -18,6 +20,12 @@ const (
 
 var a ANX
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	a.SetDefaults()
 
@@ -84,21 +92,21 @@ func TestSetup(t *testing.T) {
 }
 
 func TestGetCurrencies(t *testing.T) {
-	_, err := a.GetCurrencies()
+	_, err := a.GetCurrencies(context.Background())
 	if err != nil {
 		t.Fatalf("Test failed. TestGetCurrencies failed. Err: %s", err)
 	}
 }
 
 func TestGetTradablePairs(t *testing.T) {
-	_, err := a.GetTradablePairs()
+	_, err := a.GetTradablePairs(context.Background())
 	if err != nil {
 		t.Fatalf("Test failed. TestGetTradablePairs failed. Err: %s", err)
 	}
 }
 
 func TestGetTicker(t *testing.T) {
-	ticker, err := a.GetTicker("BTCUSD")
+	ticker, err := a.GetTicker(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Errorf("Test Failed - ANX GetTicker() error: %s", err)
 	}
@@ -108,7 +116,7 @@ func TestGetTicker(t *testing.T) {
 }
 
 func TestGetDepth(t *testing.T) {
-	ticker, err := a.GetDepth("BTCUSD")
+	ticker, err := a.GetDepth(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Errorf("Test Failed - ANX GetDepth() error: %s", err)
 	}
@@ -118,7 +126,7 @@ func TestGetDepth(t *testing.T) {
 }
 
 func TestGetAPIKey(t *testing.T) {
-	apiKey, apiSecret, err := a.GetAPIKey("userName", "passWord", "", "1337")
+	apiKey, apiSecret, err := a.GetAPIKey(context.Background(), "userName", "passWord", "", "1337")
 	if err == nil {
 		t.Error("Test Failed - ANX GetAPIKey() Incorrect")
 	}
@@ -251,7 +259,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USD,
 	}
-	response, err := a.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := a.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -276,7 +284,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 	// Act
-	err := a.CancelOrder(orderCancellation)
+	err := a.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
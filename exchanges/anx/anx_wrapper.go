@@ -1,9 +1,11 @@
 package anx
 
 import (
+	"context"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -28,7 +30,7 @@ func (a *ANX) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", a.GetName(), len(a.EnabledPairs), a.EnabledPairs)
 	}
 
-	exchangeProducts, err := a.GetTradablePairs()
+	exchangeProducts, err := a.GetTradablePairs(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", a.GetName())
 	} else {
@@ -54,8 +56,8 @@ func (a *ANX) Run() {
 }
 
 // GetTradablePairs returns a list of available
-func (a *ANX) GetTradablePairs() ([]string, error) {
-	result, err := a.GetCurrencies()
+func (a *ANX) GetTradablePairs(ctx context.Context) ([]string, error) {
+	result, err := a.GetCurrencies(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -69,9 +71,9 @@ func (a *ANX) GetTradablePairs() ([]string, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (a *ANX) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (a *ANX) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := a.GetTicker(exchange.FormatExchangeCurrency(a.GetName(), p).String())
+	tick, err := a.GetTicker(ctx, exchange.FormatExchangeCurrency(a.GetName(), p).String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -136,27 +138,27 @@ func (a *ANX) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (a *ANX) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (a *ANX) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(a.GetName(), p, assetType)
 	if err != nil {
-		return a.UpdateTicker(p, assetType)
+		return a.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (a *ANX) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (a *ANX) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(a.GetName(), p, assetType)
 	if err != nil {
-		return a.UpdateOrderbook(p, assetType)
+		return a.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (a *ANX) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (a *ANX) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := a.GetDepth(exchange.FormatExchangeCurrency(a.GetName(), p).String())
+	orderbookNew, err := a.GetDepth(ctx, exchange.FormatExchangeCurrency(a.GetName(), p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -175,41 +177,44 @@ func (a *ANX) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.
 
 // GetAccountInfo retrieves balances for all enabled currencies on the
 // exchange
-func (a *ANX) GetAccountInfo() (exchange.AccountInfo, error) {
+func (a *ANX) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var info exchange.AccountInfo
 	return info, common.ErrNotYetImplemented
 }
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (a *ANX) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (a *ANX) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (a *ANX) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (a *ANX) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (a *ANX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (a *ANX) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := a.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
 
 	var isBuying bool
 	var limitPriceInSettlementCurrency float64
 
-	if side == exchange.Buy {
+	if order.Side == exchange.Buy {
 		isBuying = true
 	}
 
-	if orderType == exchange.Limit {
-		limitPriceInSettlementCurrency = price
+	if order.OrderType == exchange.Limit {
+		limitPriceInSettlementCurrency = order.Price
 	}
 
-	response, err := a.NewOrder(orderType.ToString(), isBuying, p.FirstCurrency.String(), amount, p.SecondCurrency.String(), amount, limitPriceInSettlementCurrency, false, "", false)
+	response, err := a.NewOrder(ctx, order.OrderType.ToString(), isBuying, order.Pair.FirstCurrency.String(), order.Amount, order.Pair.SecondCurrency.String(), order.Amount, limitPriceInSettlementCurrency, false, "", false)
 	if response != "" {
 		submitOrderResponse.OrderID = response
 	}
@@ -223,41 +228,68 @@ func (a *ANX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderTyp
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (a *ANX) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (a *ANX) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (a *ANX) CancelOrder(order exchange.OrderCancellation) error {
+func (a *ANX) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDs := []string{order.OrderID}
-	return a.CancelOrderByIDs(orderIDs)
+	return a.CancelOrderByIDs(ctx, orderIDs)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (a *ANX) CancelAllOrders() error {
+func (a *ANX) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (a *ANX) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (a *ANX) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (a *ANX) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (a *ANX) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (a *ANX) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (a *ANX) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (a *ANX) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (a *ANX) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (a *ANX) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (a *ANX) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (a *ANX) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (a *ANX) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (a *ANX) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -281,3 +313,20 @@ func (a *ANX) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (a *ANX) GetWithdrawCapabilities() uint32 {
 	return a.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (a *ANX) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (a *ANX) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (a *ANX) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
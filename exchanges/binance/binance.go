@@ -2,6 +2,7 @@ package binance
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -20,7 +21,8 @@ import (
 // Binance is the overarching type across the Bithumb package
 type Binance struct {
 	exchange.Base
-	WebsocketConn *websocket.Conn
+	WebsocketConn     *websocket.Conn
+	WebsocketUserConn *websocket.Conn
 
 	// Valid string list that is required by the exchange
 	validLimits    []int
@@ -51,10 +53,23 @@ const (
 	openOrders   = "/api/v3/openOrders"
 	allOrders    = "/api/v3/allOrders"
 
-	// binance authenticated and unauthenticated limit rates
-	// to-do
+	// User data stream endpoints, authenticated by the API key only
+	userDataStream = "/api/v1/userDataStream"
+
+	// binance authenticated and unauthenticated limit rates, requests are
+	// metered by the request weight and order limits instead
 	binanceAuthRate   = 0
 	binanceUnauthRate = 0
+
+	// binance rate limits, each request adds its weight to the request weight
+	// limit and each new order counts towards the order limit
+	binanceRequestWeight      = "requestWeight"
+	binanceRequestWeightLimit = 1200
+	binanceOrders             = "orders"
+	binanceOrdersLimit        = 10
+
+	// binanceMaxKlineLimit is the maximum amount of klines returned per request
+	binanceMaxKlineLimit = 500
 )
 
 // SetDefaults sets the basic defaults for Binance
@@ -71,11 +86,23 @@ func (b *Binance) SetDefaults() {
 	b.SupportsAutoPairUpdating = true
 	b.SupportsRESTTickerBatching = true
 	b.APIWithdrawPermissions = exchange.AutoWithdrawCrypto
+	b.OrderSupport = exchange.OrderSupport{
+		OrderTypes: []exchange.OrderType{exchange.Limit, exchange.Market,
+			exchange.Stop, exchange.StopLimit, exchange.TakeProfit,
+			exchange.TakeProfitLimit},
+		TimeInForce: []exchange.TimeInForce{exchange.GoodTillCancel,
+			exchange.ImmediateOrCancel, exchange.FillOrKill},
+		PostOnly: true,
+	}
 	b.SetValues()
 	b.Requester = request.New(b.Name,
 		request.NewRateLimit(time.Second, binanceAuthRate),
 		request.NewRateLimit(time.Second, binanceUnauthRate),
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout))
+	b.Requester.AddRateLimit(binanceRequestWeight,
+		request.NewRateLimit(time.Minute, binanceRequestWeightLimit))
+	b.Requester.AddRateLimit(binanceOrders,
+		request.NewRateLimit(time.Second, binanceOrdersLimit))
 	b.APIUrlDefault = apiURL
 	b.APIUrl = b.APIUrlDefault
 	b.WebsocketInit()
@@ -124,34 +151,42 @@ func (b *Binance) Setup(exch config.ExchangeConfig) {
 		if err != nil {
 			log.Fatal(err)
 		}
+
+		if b.AuthenticatedAPISupport {
+			b.Websocket.SetAuthenticator(b.WsAuthenticate)
+		}
 	}
 }
 
 // GetExchangeValidCurrencyPairs returns the full pair list from the exchange
 // at the moment do not integrate with config currency pairs automatically
-func (b *Binance) GetExchangeValidCurrencyPairs() ([]string, error) {
-	var validCurrencyPairs []string
-
-	info, err := b.GetExchangeInfo()
+func (b *Binance) GetExchangeValidCurrencyPairs(ctx context.Context) ([]string, error) {
+	info, err := b.GetExchangeInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return getValidCurrencyPairs(&info), nil
+}
 
+// getValidCurrencyPairs returns the pairs of the exchange information which
+// are trading
+func getValidCurrencyPairs(info *ExchangeInfo) []string {
+	var validCurrencyPairs []string
 	for _, symbol := range info.Symbols {
 		if symbol.Status == "TRADING" {
 			validCurrencyPairs = append(validCurrencyPairs, symbol.BaseAsset+"-"+symbol.QuoteAsset)
 		}
 	}
-	return validCurrencyPairs, nil
+	return validCurrencyPairs
 }
 
 // GetExchangeInfo returns exchange information. Check binance_types for more
 // information
-func (b *Binance) GetExchangeInfo() (ExchangeInfo, error) {
+func (b *Binance) GetExchangeInfo(ctx context.Context) (ExchangeInfo, error) {
 	var resp ExchangeInfo
 	path := b.APIUrl + exchangeInfo
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, 1, &resp)
 }
 
 // GetOrderBook returns full orderbook information
@@ -159,7 +194,7 @@ func (b *Binance) GetExchangeInfo() (ExchangeInfo, error) {
 // OrderBookDataRequestParams contains the following members
 // symbol: string of currency pair
 // limit: returned limit amount
-func (b *Binance) GetOrderBook(obd OrderBookDataRequestParams) (OrderBook, error) {
+func (b *Binance) GetOrderBook(ctx context.Context, obd OrderBookDataRequestParams) (OrderBook, error) {
 	orderbook, resp := OrderBook{}, OrderBookData{}
 
 	if err := b.CheckLimit(obd.Limit); err != nil {
@@ -175,7 +210,7 @@ func (b *Binance) GetOrderBook(obd OrderBookDataRequestParams) (OrderBook, error
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, orderBookDepth, params.Encode())
 
-	if err := b.SendHTTPRequest(path, &resp); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, getOrderBookWeight(obd.Limit), &resp); err != nil {
 		return orderbook, err
 	}
 
@@ -219,7 +254,7 @@ func (b *Binance) GetOrderBook(obd OrderBookDataRequestParams) (OrderBook, error
 
 // GetRecentTrades returns recent trade activity
 // limit: Up to 500 results returned
-func (b *Binance) GetRecentTrades(rtr RecentTradeRequestParams) ([]RecentTrade, error) {
+func (b *Binance) GetRecentTrades(ctx context.Context, rtr RecentTradeRequestParams) ([]RecentTrade, error) {
 	resp := []RecentTrade{}
 
 	params := url.Values{}
@@ -228,7 +263,7 @@ func (b *Binance) GetRecentTrades(rtr RecentTradeRequestParams) ([]RecentTrade,
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, recentTrades, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, 1, &resp)
 }
 
 // GetHistoricalTrades returns historical trade activity
@@ -236,7 +271,7 @@ func (b *Binance) GetRecentTrades(rtr RecentTradeRequestParams) ([]RecentTrade,
 // symbol: string of currency pair
 // limit: Optional. Default 500; max 1000.
 // fromID:
-func (b *Binance) GetHistoricalTrades(symbol string, limit int, fromID int64) ([]HistoricalTrade, error) {
+func (b *Binance) GetHistoricalTrades(ctx context.Context, symbol string, limit int, fromID int64) ([]HistoricalTrade, error) {
 	resp := []HistoricalTrade{}
 
 	if err := b.CheckLimit(limit); err != nil {
@@ -250,14 +285,14 @@ func (b *Binance) GetHistoricalTrades(symbol string, limit int, fromID int64) ([
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, historicalTrades, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, 5, &resp)
 }
 
 // GetAggregatedTrades returns aggregated trade activity
 //
 // symbol: string of currency pair
 // limit: Optional. Default 500; max 1000.
-func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTrade, error) {
+func (b *Binance) GetAggregatedTrades(ctx context.Context, symbol string, limit int) ([]AggregatedTrade, error) {
 	resp := []AggregatedTrade{}
 
 	if err := b.CheckLimit(limit); err != nil {
@@ -273,7 +308,7 @@ func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTra
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, aggregatedTrades, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, 1, &resp)
 }
 
 // GetSpotKline returns kline data
@@ -284,7 +319,7 @@ func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTra
 // interval: the interval time for the data
 // startTime: startTime filter for kline data
 // endTime: endTime filter for the kline data
-func (b *Binance) GetSpotKline(arg KlinesRequestParams) ([]CandleStick, error) {
+func (b *Binance) GetSpotKline(ctx context.Context, arg KlinesRequestParams) ([]CandleStick, error) {
 	var resp interface{}
 	var kline []CandleStick
 
@@ -303,7 +338,7 @@ func (b *Binance) GetSpotKline(arg KlinesRequestParams) ([]CandleStick, error) {
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, candleStick, params.Encode())
 
-	if err := b.SendHTTPRequest(path, &resp); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, 1, &resp); err != nil {
 		return kline, err
 	}
 
@@ -343,7 +378,7 @@ func (b *Binance) GetSpotKline(arg KlinesRequestParams) ([]CandleStick, error) {
 // GetAveragePrice returns current average price for a symbol.
 //
 // symbol: string of currency pair
-func (b *Binance) GetAveragePrice(symbol string) (AveragePrice, error) {
+func (b *Binance) GetAveragePrice(ctx context.Context, symbol string) (AveragePrice, error) {
 	resp := AveragePrice{}
 
 	if err := b.CheckSymbol(symbol); err != nil {
@@ -355,13 +390,13 @@ func (b *Binance) GetAveragePrice(symbol string) (AveragePrice, error) {
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, averagePrice, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, 1, &resp)
 }
 
 // GetPriceChangeStats returns price change statistics for the last 24 hours
 //
 // symbol: string of currency pair
-func (b *Binance) GetPriceChangeStats(symbol string) (PriceChangeStats, error) {
+func (b *Binance) GetPriceChangeStats(ctx context.Context, symbol string) (PriceChangeStats, error) {
 	resp := PriceChangeStats{}
 
 	if err := b.CheckSymbol(symbol); err != nil {
@@ -373,20 +408,20 @@ func (b *Binance) GetPriceChangeStats(symbol string) (PriceChangeStats, error) {
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, priceChange, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, 1, &resp)
 }
 
 // GetTickers returns the ticker data for the last 24 hrs
-func (b *Binance) GetTickers() ([]PriceChangeStats, error) {
+func (b *Binance) GetTickers(ctx context.Context) ([]PriceChangeStats, error) {
 	var resp []PriceChangeStats
 	path := fmt.Sprintf("%s%s", b.APIUrl, priceChange)
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, 40, &resp)
 }
 
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
-func (b *Binance) GetLatestSpotPrice(symbol string) (SymbolPrice, error) {
+func (b *Binance) GetLatestSpotPrice(ctx context.Context, symbol string) (SymbolPrice, error) {
 	resp := SymbolPrice{}
 
 	if err := b.CheckSymbol(symbol); err != nil {
@@ -398,13 +433,13 @@ func (b *Binance) GetLatestSpotPrice(symbol string) (SymbolPrice, error) {
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, symbolPrice, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, 1, &resp)
 }
 
 // GetBestPrice returns the latest best price for symbol
 //
 // symbol: string of currency pair
-func (b *Binance) GetBestPrice(symbol string) (BestPrice, error) {
+func (b *Binance) GetBestPrice(ctx context.Context, symbol string) (BestPrice, error) {
 	resp := BestPrice{}
 
 	if err := b.CheckSymbol(symbol); err != nil {
@@ -416,11 +451,11 @@ func (b *Binance) GetBestPrice(symbol string) (BestPrice, error) {
 
 	path := fmt.Sprintf("%s%s?%s", b.APIUrl, bestPrice, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, 1, &resp)
 }
 
 // NewOrder sends a new order to Binance
-func (b *Binance) NewOrder(o NewOrderRequest) (NewOrderResponse, error) {
+func (b *Binance) NewOrder(ctx context.Context, o NewOrderRequest) (NewOrderResponse, error) {
 	var resp NewOrderResponse
 
 	path := fmt.Sprintf("%s%s", b.APIUrl, newOrder)
@@ -430,7 +465,11 @@ func (b *Binance) NewOrder(o NewOrderRequest) (NewOrderResponse, error) {
 	params.Set("side", string(o.Side))
 	params.Set("type", string(o.TradeType))
 	params.Set("quantity", strconv.FormatFloat(o.Quantity, 'f', -1, 64))
-	if o.TradeType == "LIMIT" {
+	switch o.TradeType {
+	case BinanceRequestParamsOrderLimit,
+		BinanceRequestParamsOrderStopLossLimit,
+		BinanceRequestParamsOrderTakeProfitLimit,
+		BinanceRequestParamsOrderLimitMarker:
 		params.Set("price", strconv.FormatFloat(o.Price, 'f', -1, 64))
 	}
 	if o.TimeInForce != "" {
@@ -453,7 +492,7 @@ func (b *Binance) NewOrder(o NewOrderRequest) (NewOrderResponse, error) {
 		params.Set("newOrderRespType", o.NewOrderRespType)
 	}
 
-	if err := b.SendAuthHTTPRequest("POST", path, params, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, "POST", path, params, 1, &resp); err != nil {
 		return resp, err
 	}
 
@@ -464,7 +503,7 @@ func (b *Binance) NewOrder(o NewOrderRequest) (NewOrderResponse, error) {
 }
 
 // CancelExistingOrder sends a cancel order to Binance
-func (b *Binance) CancelExistingOrder(symbol string, orderID int64, origClientOrderID string) (CancelOrderResponse, error) {
+func (b *Binance) CancelExistingOrder(ctx context.Context, symbol string, orderID int64, origClientOrderID string) (CancelOrderResponse, error) {
 	var resp CancelOrderResponse
 
 	path := fmt.Sprintf("%s%s", b.APIUrl, cancelOrder)
@@ -480,12 +519,12 @@ func (b *Binance) CancelExistingOrder(symbol string, orderID int64, origClientOr
 		params.Set("origClientOrderId", origClientOrderID)
 	}
 
-	return resp, b.SendAuthHTTPRequest("DELETE", path, params, &resp)
+	return resp, b.SendAuthHTTPRequest(ctx, "DELETE", path, params, 1, &resp)
 }
 
 // OpenOrders Current open orders
 // Get all open orders on a symbol. Careful when accessing this with no symbol.
-func (b *Binance) OpenOrders(symbol string) ([]QueryOrderData, error) {
+func (b *Binance) OpenOrders(ctx context.Context, symbol string) ([]QueryOrderData, error) {
 	var resp []QueryOrderData
 
 	path := fmt.Sprintf("%s%s", b.APIUrl, openOrders)
@@ -494,7 +533,7 @@ func (b *Binance) OpenOrders(symbol string) ([]QueryOrderData, error) {
 	if symbol != "" {
 		params.Set("symbol", common.StringToUpper(symbol))
 	}
-	if err := b.SendAuthHTTPRequest("GET", path, params, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, "GET", path, params, getOpenOrdersWeight(symbol), &resp); err != nil {
 		return resp, err
 	}
 
@@ -504,7 +543,7 @@ func (b *Binance) OpenOrders(symbol string) ([]QueryOrderData, error) {
 // AllOrders Get all account orders; active, canceled, or filled.
 // orderId optional param
 // limit optional param, default 500; max 500
-func (b *Binance) AllOrders(symbol, orderID, limit string) ([]QueryOrderData, error) {
+func (b *Binance) AllOrders(ctx context.Context, symbol, orderID, limit string) ([]QueryOrderData, error) {
 	var resp []QueryOrderData
 
 	path := fmt.Sprintf("%s%s", b.APIUrl, allOrders)
@@ -517,7 +556,7 @@ func (b *Binance) AllOrders(symbol, orderID, limit string) ([]QueryOrderData, er
 	if limit != "" {
 		params.Set("limit", limit)
 	}
-	if err := b.SendAuthHTTPRequest("GET", path, params, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, "GET", path, params, 5, &resp); err != nil {
 		return resp, err
 	}
 
@@ -525,7 +564,7 @@ func (b *Binance) AllOrders(symbol, orderID, limit string) ([]QueryOrderData, er
 }
 
 // QueryOrder returns information on a past order
-func (b *Binance) QueryOrder(symbol, origClientOrderID string, orderID int64) (QueryOrderData, error) {
+func (b *Binance) QueryOrder(ctx context.Context, symbol, origClientOrderID string, orderID int64) (QueryOrderData, error) {
 	var resp QueryOrderData
 
 	path := fmt.Sprintf("%s%s", b.APIUrl, queryOrder)
@@ -539,7 +578,7 @@ func (b *Binance) QueryOrder(symbol, origClientOrderID string, orderID int64) (Q
 		params.Set("orderId", strconv.FormatInt(orderID, 10))
 	}
 
-	if err := b.SendAuthHTTPRequest("GET", path, params, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, "GET", path, params, 1, &resp); err != nil {
 		return resp, err
 	}
 
@@ -550,7 +589,7 @@ func (b *Binance) QueryOrder(symbol, origClientOrderID string, orderID int64) (Q
 }
 
 // GetAccount returns binance user accounts
-func (b *Binance) GetAccount() (*Account, error) {
+func (b *Binance) GetAccount(ctx context.Context) (*Account, error) {
 	type response struct {
 		Response
 		Account
@@ -561,7 +600,7 @@ func (b *Binance) GetAccount() (*Account, error) {
 	path := fmt.Sprintf("%s%s", b.APIUrl, accountInfo)
 	params := url.Values{}
 
-	if err := b.SendAuthHTTPRequest("GET", path, params, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, "GET", path, params, 5, &resp); err != nil {
 		return &resp.Account, err
 	}
 
@@ -572,13 +611,39 @@ func (b *Binance) GetAccount() (*Account, error) {
 	return &resp.Account, nil
 }
 
-// SendHTTPRequest sends an unauthenticated request
-func (b *Binance) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload("GET", path, nil, nil, result, false, b.Verbose)
+// GetUserDataStreamListenKey starts a user data stream and returns its listen
+// key, the stream closes after 60 minutes unless it is kept alive
+func (b *Binance) GetUserDataStreamListenKey(ctx context.Context) (string, error) {
+	var resp UserDataStream
+	path := fmt.Sprintf("%s%s", b.APIUrl, userDataStream)
+
+	return resp.ListenKey, b.SendAPIKeyHTTPRequest(ctx, "POST", path, nil, &resp)
 }
 
-// SendAuthHTTPRequest sends an authenticated HTTP request
-func (b *Binance) SendAuthHTTPRequest(method, path string, params url.Values, result interface{}) error {
+// KeepAliveUserDataStream extends the life of a user data stream by 60
+// minutes
+func (b *Binance) KeepAliveUserDataStream(ctx context.Context, listenKey string) error {
+	params := url.Values{}
+	params.Set("listenKey", listenKey)
+	path := fmt.Sprintf("%s%s", b.APIUrl, userDataStream)
+
+	return b.SendAPIKeyHTTPRequest(ctx, "PUT", path, params, &struct{}{})
+}
+
+// SendHTTPRequest sends an unauthenticated request, charging its weight to the
+// request weight limit
+func (b *Binance) SendHTTPRequest(ctx context.Context, path string, weight int, result interface{}) error {
+	return b.SendPayloadWithOptions(ctx, "GET", path, nil, nil, result, false, b.Verbose,
+		request.Options{
+			Limits:   map[string]int{binanceRequestWeight: weight},
+			Priority: request.LowPriority,
+		})
+}
+
+// SendAuthHTTPRequest sends an authenticated HTTP request, charging its weight
+// to the request weight limit. New orders also count towards the order limit
+// and cancels are sent ahead of other queued requests
+func (b *Binance) SendAuthHTTPRequest(ctx context.Context, method, path string, params url.Values, weight int, result interface{}) error {
 	if !b.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -602,7 +667,56 @@ func (b *Binance) SendAuthHTTPRequest(method, path string, params url.Values, re
 	}
 	path = common.EncodeURLValues(path, params)
 
-	return b.SendPayload(method, path, headers, bytes.NewBufferString(""), result, true, b.Verbose)
+	opts := request.Options{
+		Limits:   map[string]int{binanceRequestWeight: weight},
+		Priority: request.NormalPriority,
+	}
+	switch method {
+	case "POST":
+		opts.Limits[binanceOrders] = 1
+	case "DELETE":
+		opts.Priority = request.HighPriority
+	}
+
+	return b.SendPayloadWithOptions(ctx, method, path, headers, bytes.NewBufferString(""), result, true, b.Verbose, opts)
+}
+
+// SendAPIKeyHTTPRequest sends a request authenticated by the API key header
+// without a signature, as used by the user data stream endpoints
+func (b *Binance) SendAPIKeyHTTPRequest(ctx context.Context, method, path string, params url.Values, result interface{}) error {
+	if !b.AuthenticatedAPISupport {
+		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
+	}
+
+	headers := make(map[string]string)
+	headers["X-MBX-APIKEY"] = b.APIKey
+
+	return b.SendPayloadWithOptions(ctx, method, common.EncodeURLValues(path, params),
+		headers, bytes.NewBufferString(""), result, true, b.Verbose,
+		request.Options{
+			Limits:   map[string]int{binanceRequestWeight: 1},
+			Priority: request.NormalPriority,
+		})
+}
+
+// getOrderBookWeight returns the request weight of an orderbook depth limit
+func getOrderBookWeight(limit int) int {
+	switch {
+	case limit <= 100:
+		return 1
+	case limit <= 500:
+		return 5
+	}
+	return 10
+}
+
+// getOpenOrdersWeight returns the request weight of retrieving open orders,
+// which is higher for all symbols
+func getOpenOrdersWeight(symbol string) int {
+	if symbol == "" {
+		return 40
+	}
+	return 1
 }
 
 // CheckLimit checks value against a variable list
@@ -659,12 +773,12 @@ func (b *Binance) SetValues() {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Binance) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *Binance) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		multiplier, err := b.getMultiplier(feeBuilder.IsMaker)
+		multiplier, err := b.getMultiplier(ctx, feeBuilder.IsMaker)
 		if err != nil {
 			return 0, err
 		}
@@ -679,9 +793,9 @@ func (b *Binance) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 }
 
 // getMultiplier retrieves account based taker/maker fees
-func (b *Binance) getMultiplier(isMaker bool) (float64, error) {
+func (b *Binance) getMultiplier(ctx context.Context, isMaker bool) (float64, error) {
 	var multiplier float64
-	account, err := b.GetAccount()
+	account, err := b.GetAccount(ctx)
 	if err != nil {
 		return 0, err
 	}
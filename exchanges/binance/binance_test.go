@@ -1,13 +1,17 @@
 package binance
 
 import (
+	"context"
 	"testing"
 
+	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -19,6 +23,12 @@ const (
 
 var b Binance
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	b.SetDefaults()
 }
@@ -39,7 +49,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetExchangeValidCurrencyPairs(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExchangeValidCurrencyPairs()
+	_, err := b.GetExchangeValidCurrencyPairs(context.Background())
 	if err != nil {
 		t.Error("Test Failed - Binance GetExchangeValidCurrencyPairs() error", err)
 	}
@@ -47,7 +57,7 @@ func TestGetExchangeValidCurrencyPairs(t *testing.T) {
 
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderBook(OrderBookDataRequestParams{
+	_, err := b.GetOrderBook(context.Background(), OrderBookDataRequestParams{
 		Symbol: "BTCUSDT",
 		Limit:  10,
 	})
@@ -60,7 +70,7 @@ func TestGetOrderBook(t *testing.T) {
 func TestGetRecentTrades(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetRecentTrades(RecentTradeRequestParams{
+	_, err := b.GetRecentTrades(context.Background(), RecentTradeRequestParams{
 		Symbol: "BTCUSDT",
 		Limit:  15,
 	})
@@ -72,7 +82,7 @@ func TestGetRecentTrades(t *testing.T) {
 
 func TestGetHistoricalTrades(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetHistoricalTrades("BTCUSDT", 5, 1337)
+	_, err := b.GetHistoricalTrades(context.Background(), "BTCUSDT", 5, 1337)
 	if err == nil {
 		t.Error("Test Failed - Binance GetHistoricalTrades() error", err)
 	}
@@ -80,7 +90,7 @@ func TestGetHistoricalTrades(t *testing.T) {
 
 func TestGetAggregatedTrades(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetAggregatedTrades("BTCUSDT", 5)
+	_, err := b.GetAggregatedTrades(context.Background(), "BTCUSDT", 5)
 	if err != nil {
 		t.Error("Test Failed - Binance GetAggregatedTrades() error", err)
 	}
@@ -88,7 +98,7 @@ func TestGetAggregatedTrades(t *testing.T) {
 
 func TestGetSpotKline(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetSpotKline(KlinesRequestParams{
+	_, err := b.GetSpotKline(context.Background(), KlinesRequestParams{
 		Symbol:   "BTCUSDT",
 		Interval: TimeIntervalFiveMinutes,
 		Limit:    24,
@@ -100,7 +110,7 @@ func TestGetSpotKline(t *testing.T) {
 
 func TestGetAveragePrice(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetAveragePrice("BTCUSDT")
+	_, err := b.GetAveragePrice(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Test Failed - Binance GetAveragePrice() error", err)
 	}
@@ -108,7 +118,7 @@ func TestGetAveragePrice(t *testing.T) {
 
 func TestGetPriceChangeStats(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetPriceChangeStats("BTCUSDT")
+	_, err := b.GetPriceChangeStats(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Test Failed - Binance GetPriceChangeStats() error", err)
 	}
@@ -116,7 +126,7 @@ func TestGetPriceChangeStats(t *testing.T) {
 
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTickers()
+	_, err := b.GetTickers(context.Background())
 	if err != nil {
 		t.Error("Test Failed - Binance TestGetTickers error", err)
 	}
@@ -124,7 +134,7 @@ func TestGetTickers(t *testing.T) {
 
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLatestSpotPrice("BTCUSDT")
+	_, err := b.GetLatestSpotPrice(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Test Failed - Binance GetLatestSpotPrice() error", err)
 	}
@@ -132,7 +142,7 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetBestPrice(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetBestPrice("BTCUSDT")
+	_, err := b.GetBestPrice(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Test Failed - Binance GetBestPrice() error", err)
 	}
@@ -144,7 +154,7 @@ func TestNewOrder(t *testing.T) {
 	if testAPIKey == "" || testAPISecret == "" {
 		t.Skip()
 	}
-	_, err := b.NewOrder(NewOrderRequest{
+	_, err := b.NewOrder(context.Background(), NewOrderRequest{
 		Symbol:      "BTCUSDT",
 		Side:        BinanceRequestParamsSideSell,
 		TradeType:   BinanceRequestParamsOrderLimit,
@@ -165,7 +175,7 @@ func TestCancelExistingOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := b.CancelExistingOrder("BTCUSDT", 82584683, "")
+	_, err := b.CancelExistingOrder(context.Background(), "BTCUSDT", 82584683, "")
 	if err == nil {
 		t.Error("Test Failed - Binance CancelExistingOrder() error", err)
 	}
@@ -178,7 +188,7 @@ func TestQueryOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := b.QueryOrder("BTCUSDT", "", 1337)
+	_, err := b.QueryOrder(context.Background(), "BTCUSDT", "", 1337)
 	if err != nil {
 		t.Error("Test Failed - Binance QueryOrder() error", err)
 	}
@@ -191,7 +201,7 @@ func TestOpenOrders(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := b.OpenOrders("BTCUSDT")
+	_, err := b.OpenOrders(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Test Failed - Binance OpenOrders() error", err)
 	}
@@ -204,7 +214,7 @@ func TestAllOrders(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := b.AllOrders("BTCUSDT", "", "")
+	_, err := b.AllOrders(context.Background(), "BTCUSDT", "", "")
 	if err != nil {
 		t.Error("Test Failed - Binance AllOrders() error", err)
 	}
@@ -217,7 +227,7 @@ func TestGetAccount(t *testing.T) {
 	t.Parallel()
 	b.SetDefaults()
 	TestSetup(t)
-	account, err := b.GetAccount()
+	account, err := b.GetAccount(context.Background())
 	if err != nil {
 		t.Fatal("Test Failed - Binance GetAccount() error", err)
 	}
@@ -252,7 +262,7 @@ func TestGetFee(t *testing.T) {
 
 	if testAPIKey != "" || testAPISecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.1) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.1) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		}
@@ -261,7 +271,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(100000) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(100000) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(100000), resp)
 			t.Error(err)
 		}
@@ -269,7 +279,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.1) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.1) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.1), resp)
 			t.Error(err)
 		}
@@ -277,7 +287,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -287,7 +297,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -295,7 +305,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -304,7 +314,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -313,7 +323,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -331,6 +341,64 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Binance using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *Binance {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	binanceConfig, err := cfg.GetExchangeConfig("Binance")
+	if err != nil {
+		t.Fatal("Test Failed - Binance Setup() init error")
+	}
+
+	binanceConfig.AuthenticatedAPISupport = true
+	binanceConfig.APIKey, binanceConfig.APISecret = request.FixtureCredentials(t, testAPIKey, testAPISecret)
+
+	var r Binance
+	r.SetDefaults()
+	r.Setup(binanceConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.LTC, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Binance GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "1" ||
+		orders[0].BaseCurrency != symbol.LTC || orders[0].QuoteCurrency != symbol.BTC ||
+		orders[0].Price != 0.1 || orders[0].OpenVolume != 0.5 ||
+		orders[0].ExecutedAmount != 0.5 || orders[0].OrderDate.Unix() != 1499827319) {
+		t.Errorf("Test Failed - Binance GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	_, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{})
+	if err == nil {
+		t.Error("Test Failed - Binance GetOrderHistory() expected an error without currencies")
+	}
+
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.LTC, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Binance GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 2 || orders[1].ID != "2" ||
+		orders[1].Status != "FILLED" || orders[1].OrderSide != "SELL" ||
+		orders[1].OpenVolume != 0) {
+		t.Errorf("Test Failed - Binance GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // -----------------------------------------------------------------------------------------------------------------------------
 
@@ -356,7 +424,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.LTC,
 		SecondCurrency: symbol.BTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -384,10 +452,110 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
-	// Assert 
+	// Assert
 	if err != nil {
 		t.Errorf("Could not cancel order: %s", err)
 	}
 }
+
+func TestGetOrderBookWeight(t *testing.T) {
+	for limit, weight := range map[int]int{5: 1, 100: 1, 500: 5, 1000: 10} {
+		if w := getOrderBookWeight(limit); w != weight {
+			t.Errorf("Test failed. Expected limit %d weight %d, received %d",
+				limit, weight, w)
+		}
+	}
+
+	if getOpenOrdersWeight("") != 40 || getOpenOrdersWeight("BTCUSDT") != 1 {
+		t.Error("Test failed. Unexpected open orders weight")
+	}
+}
+
+func TestOrderParams(t *testing.T) {
+	p := pair.NewCurrencyPair(symbol.BTC, symbol.USDT)
+	request, err := orderParams(&exchange.SubmitOrder{Pair: p, Side: exchange.Buy,
+		OrderType: exchange.Limit, Amount: 1, Price: 90,
+		Options: exchange.OrderOptions{PostOnly: true}})
+	if err != nil || request.TradeType != BinanceRequestParamsOrderLimitMarker ||
+		request.TimeInForce != "" {
+		t.Errorf("Test failed. Unexpected post only params %+v %v", request, err)
+	}
+
+	request, err = orderParams(&exchange.SubmitOrder{Pair: p, Side: exchange.Sell,
+		OrderType: exchange.StopLimit, Amount: 1, Price: 90,
+		Options: exchange.OrderOptions{StopPrice: 95, TimeInForce: exchange.FillOrKill}})
+	if err != nil || request.TradeType != BinanceRequestParamsOrderStopLossLimit ||
+		request.TimeInForce != BinanceRequestParamsTimeFOK ||
+		request.StopPrice != 95 || request.Side != BinanceRequestParamsSideSell {
+		t.Errorf("Test failed. Unexpected stop limit params %+v %v", request, err)
+	}
+
+	_, err = orderParams(&exchange.SubmitOrder{Pair: p, Side: exchange.Buy,
+		OrderType: exchange.Market, Amount: 1,
+		Options: exchange.OrderOptions{TimeInForce: exchange.ImmediateOrCancel}})
+	if err == nil {
+		t.Error("Test failed. Expected time in force error for market orders")
+	}
+}
+
+func TestGetMarketInfo(t *testing.T) {
+	var info ExchangeInfo
+	err := common.JSONDecode([]byte(`{"symbols":[
+		{"symbol":"ETHBTC","status":"TRADING","baseAsset":"ETH","quoteAsset":"BTC","filters":[
+			{"filterType":"PRICE_FILTER","minPrice":"0.00000100","maxPrice":"100000.00000000","tickSize":"0.00000100"},
+			{"filterType":"LOT_SIZE","minQty":"0.00100000","maxQty":"100000.00000000","stepSize":"0.00100000"},
+			{"filterType":"MIN_NOTIONAL","minNotional":"0.00100000"}]},
+		{"symbol":"BCCBTC","status":"BREAK","baseAsset":"BCC","quoteAsset":"BTC"}]}`), &info)
+	if err != nil {
+		t.Fatal("Test failed. JSONDecode error", err)
+	}
+
+	markets := getMarketInfo(&info)
+	if len(markets) != 1 || markets[0].Pair.Pair() != "ETHBTC" ||
+		markets[0].PriceStep != 0.000001 || markets[0].MinAmount != 0.001 ||
+		markets[0].MaxAmount != 100000 || markets[0].AmountStep != 0.001 ||
+		markets[0].MinNotional != 0.001 {
+		t.Errorf("Test failed. Unexpected market info %+v", markets)
+	}
+
+	if pairs := getValidCurrencyPairs(&info); len(pairs) != 1 || pairs[0] != "ETH-BTC" {
+		t.Errorf("Test failed. Unexpected valid pairs %v", pairs)
+	}
+}
+
+func TestWsHandleUserData(t *testing.T) {
+	var r Binance
+	r.SetDefaults()
+	r.Websocket.DataHandler = make(chan interface{}, 10)
+
+	r.wsHandleUserData([]byte(`{"e":"executionReport","E":1499405658658,"s":"ETHBTC","c":"mUvoqJxFIILMdfAW5iGSOW","S":"BUY","o":"LIMIT","f":"GTC","q":"1.00000000","p":"0.10264410","P":"0.00000000","F":"0.00000000","g":-1,"C":"","x":"TRADE","X":"PARTIALLY_FILLED","r":"NONE","i":4293153,"l":"0.25000000","z":"0.25000000","L":"0.10264410","n":"0.00010000","N":"BNB","T":1499405658657,"t":12345,"I":8641984,"w":false,"m":false,"M":true,"O":1499405658657,"Z":"0.02566102","Y":"0.02566102","Q":"0.00000000"}`))
+	r.wsHandleUserData([]byte(`{"e":"outboundAccountInfo","E":1499405658849,"m":0,"t":0,"b":0,"s":0,"T":true,"W":true,"D":true,"u":1499405658849,"B":[{"a":"LTC","f":"17366.18538083","l":"0.00000000"},{"a":"BTC","f":"10537.85314051","l":"2.19464093"}]}`))
+
+	if len(r.Websocket.DataHandler) != 4 {
+		t.Fatalf("Test failed. Expected an order update, fill and two balances, received %d messages",
+			len(r.Websocket.DataHandler))
+	}
+
+	update, ok := (<-r.Websocket.DataHandler).(exchange.WebsocketOrderUpdate)
+	if !ok || update.OrderID != "4293153" || update.ClientOrderID != "mUvoqJxFIILMdfAW5iGSOW" ||
+		update.Status != orders.PartiallyFilled || update.Side != exchange.Buy ||
+		update.OrderType != exchange.Limit || update.Amount != 1 ||
+		update.FilledAmount != 0.25 || update.Pair.Pair().String() != "ETHBTC" {
+		t.Errorf("Test failed. Unexpected order update %+v", update)
+	}
+
+	fill, ok := (<-r.Websocket.DataHandler).(exchange.WebsocketOrderFill)
+	if !ok || fill.TradeID != "12345" || fill.Amount != 0.25 || fill.Price != 0.1026441 ||
+		fill.Fee != 0.0001 || fill.FeeCurrency != "BNB" {
+		t.Errorf("Test failed. Unexpected order fill %+v", fill)
+	}
+
+	<-r.Websocket.DataHandler
+	balance, ok := (<-r.Websocket.DataHandler).(exchange.WebsocketBalanceUpdate)
+	if !ok || balance.Currency != "BTC" || balance.Hold != 2.19464093 ||
+		balance.Total != 10537.85314051+2.19464093 {
+		t.Errorf("Test failed. Unexpected balance update %+v", balance)
+	}
+}
@@ -192,6 +192,61 @@ type TickerStream struct {
 	NumberOfTrades         int64  `json:"n"`
 }
 
+// UserDataStream holds the listen key of a user data stream
+type UserDataStream struct {
+	ListenKey string `json:"listenKey"`
+}
+
+// UserDataEvent holds the event type of a user data stream message
+type UserDataEvent struct {
+	EventType string `json:"e"`
+	EventTime int64  `json:"E"`
+}
+
+// ExecutionReport holds an order update from the user data stream. Fields
+// whose keys only differ in case are all declared, as decoding would otherwise
+// match them case insensitively
+type ExecutionReport struct {
+	EventType                string  `json:"e"`
+	EventTime                int64   `json:"E"`
+	Symbol                   string  `json:"s"`
+	ClientOrderID            string  `json:"c"`
+	Side                     string  `json:"S"`
+	OrderType                string  `json:"o"`
+	Quantity                 float64 `json:"q,string"`
+	QuoteOrderQuantity       float64 `json:"Q,string"`
+	Price                    float64 `json:"p,string"`
+	StopPrice                float64 `json:"P,string"`
+	OriginalClientOrderID    string  `json:"C"`
+	ExecutionType            string  `json:"x"`
+	OrderStatus              string  `json:"X"`
+	OrderID                  int64   `json:"i"`
+	Ignore                   int64   `json:"I"`
+	LastExecutedQuantity     float64 `json:"l,string"`
+	CumulativeFilledQuantity float64 `json:"z,string"`
+	LastExecutedPrice        float64 `json:"L,string"`
+	Commission               float64 `json:"n,string"`
+	CommissionAsset          string  `json:"N"`
+	TransactionTime          int64   `json:"T"`
+	TradeID                  int64   `json:"t"`
+	OrderCreationTime        int64   `json:"O"`
+	CumulativeQuoteQuantity  float64 `json:"Z,string"`
+}
+
+// AccountInfoStream holds the balances sent by the user data stream when the
+// account changes, the buyer commission is declared so it is not matched to
+// the balances
+type AccountInfoStream struct {
+	EventType       string `json:"e"`
+	EventTime       int64  `json:"E"`
+	BuyerCommission int64  `json:"b"`
+	Balances        []struct {
+		Asset  string  `json:"a"`
+		Free   float64 `json:"f,string"`
+		Locked float64 `json:"l,string"`
+	} `json:"B"`
+}
+
 // HistoricalTrade holds recent trade data
 type HistoricalTrade struct {
 	Code         int     `json:"code"`
@@ -314,7 +369,7 @@ type NewOrderResponse struct {
 		Price           float64 `json:"price,string"`
 		Qty             float64 `json:"qty,string"`
 		Commission      float64 `json:"commission,string"`
-		CommissionAsset float64 `json:"commissionAsset,string"`
+		CommissionAsset string  `json:"commissionAsset"`
 	} `json:"fills"`
 }
 
@@ -1,13 +1,13 @@
 package binance
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -15,22 +15,36 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
 )
 
 const (
 	binanceDefaultWebsocketURL = "wss://stream.binance.com:9443"
+
+	// binanceListenKeyKeepAlive is how often the user data stream listen key
+	// is kept alive, it expires after 60 minutes
+	binanceListenKeyKeepAlive = 30 * time.Minute
 )
 
-var lastUpdateID map[string]int64
-var m sync.Mutex
+// wsOrderTypes maps the order types of execution reports, limit maker orders
+// are post only limit orders
+var wsOrderTypes = map[RequestParamsOrderType]exchange.OrderType{
+	BinanceRequestParamsOrderLimit:           exchange.Limit,
+	BinanceRequestParamsOrderLimitMarker:     exchange.Limit,
+	BinanceRequestParamsOrderMarket:          exchange.Market,
+	BinanceRequestParamsOrderStopLoss:        exchange.Stop,
+	BinanceRequestParamsOrderStopLossLimit:   exchange.StopLimit,
+	BinanceRequestParamsOrderTakeProfit:      exchange.TakeProfit,
+	BinanceRequestParamsOrderTakeProfitLimit: exchange.TakeProfitLimit,
+}
 
 // SeedLocalCache seeds depth data
-func (b *Binance) SeedLocalCache(p pair.CurrencyPair) error {
+func (b *Binance) SeedLocalCache(ctx context.Context, p pair.CurrencyPair) error {
 	var newOrderBook orderbook.Base
 
 	formattedPair := exchange.FormatExchangeCurrency(b.Name, p)
 
-	orderbookNew, err := b.GetOrderBook(
+	orderbookNew, err := b.GetOrderBook(ctx,
 		OrderBookDataRequestParams{
 			Symbol: formattedPair.String(),
 			Limit:  1000,
@@ -40,14 +54,6 @@ func (b *Binance) SeedLocalCache(p pair.CurrencyPair) error {
 		return err
 	}
 
-	m.Lock()
-	if lastUpdateID == nil {
-		lastUpdateID = make(map[string]int64)
-	}
-
-	lastUpdateID[formattedPair.String()] = orderbookNew.LastUpdateID
-	m.Unlock()
-
 	for _, bids := range orderbookNew.Bids {
 		newOrderBook.Bids = append(newOrderBook.Bids,
 			orderbook.Item{Amount: bids.Quantity, Price: bids.Price})
@@ -62,27 +68,15 @@ func (b *Binance) SeedLocalCache(p pair.CurrencyPair) error {
 	newOrderBook.LastUpdated = time.Now()
 	newOrderBook.AssetType = "SPOT"
 
-	return b.Websocket.Orderbook.LoadSnapshot(newOrderBook, b.GetName())
+	return b.Websocket.Orderbook.LoadSnapshotWithSequence(newOrderBook,
+		b.GetName(),
+		orderbookNew.LastUpdateID)
 }
 
-// UpdateLocalCache updates and returns the most recent iteration of the orderbook
+// UpdateLocalCache updates and returns the most recent iteration of the
+// orderbook, updates already covered by the snapshot are dropped and a gap in
+// update IDs resyncs the orderbook
 func (b *Binance) UpdateLocalCache(ob WebsocketDepthStream) error {
-	m.Lock()
-	ID, ok := lastUpdateID[ob.Pair]
-	if !ok {
-		m.Unlock()
-		return errors.New("binance_websocket.go - Unable to find lastUpdateID")
-	}
-
-	if ob.LastUpdateID+1 <= ID || ID >= ob.LastUpdateID+1 {
-		// Drop update, out of order
-		m.Unlock()
-		return nil
-	}
-
-	lastUpdateID[ob.Pair] = ob.LastUpdateID
-	m.Unlock()
-
 	var updateBid, updateAsk []orderbook.Item
 
 	for _, bidsToUpdate := range ob.UpdateBids {
@@ -108,18 +102,20 @@ func (b *Binance) UpdateLocalCache(ob WebsocketDepthStream) error {
 				priceToBeUpdated.Amount, _ = strconv.ParseFloat(asks.(string), 64)
 			}
 		}
-		updateAsk = append(updateBid, priceToBeUpdated)
+		updateAsk = append(updateAsk, priceToBeUpdated)
 	}
 
 	updatedTime := time.Unix(ob.Timestamp, 0)
 	currencyPair := pair.NewCurrencyPairFromString(ob.Pair)
 
-	return b.Websocket.Orderbook.Update(updateBid,
+	return b.Websocket.Orderbook.UpdateWithSequence(updateBid,
 		updateAsk,
 		currencyPair,
 		updatedTime,
 		b.GetName(),
-		"SPOT")
+		"SPOT",
+		ob.FirstUpdateID,
+		ob.LastUpdateID)
 }
 
 // WSConnect intiates a websocket connection
@@ -128,9 +124,6 @@ func (b *Binance) WSConnect() error {
 		return errors.New(exchange.WebsocketNotEnabled)
 	}
 
-	var Dialer websocket.Dialer
-	var err error
-
 	ticker := strings.ToLower(
 		strings.Replace(
 			strings.Join(b.EnabledPairs, "@ticker/"), "-", "", -1)) + "@ticker"
@@ -154,18 +147,13 @@ func (b *Binance) WSConnect() error {
 		"/" +
 		depth
 
-	if b.Websocket.GetProxyAddress() != "" {
-		url, err := url.Parse(b.Websocket.GetProxyAddress())
-		if err != nil {
-			return fmt.Errorf("binance_websocket.go - Unable to connect to parse proxy address. Error: %s",
-				err)
-		}
-
-		Dialer.Proxy = http.ProxyURL(url)
+	Dialer, err := b.wsDialer()
+	if err != nil {
+		return err
 	}
 
 	for _, ePair := range b.GetEnabledCurrencies() {
-		err := b.SeedLocalCache(ePair)
+		err := b.SeedLocalCache(context.Background(), ePair)
 		if err != nil {
 			return err
 		}
@@ -182,6 +170,197 @@ func (b *Binance) WSConnect() error {
 	return nil
 }
 
+// wsDialer returns a websocket dialer using the websocket proxy, if set
+func (b *Binance) wsDialer() (websocket.Dialer, error) {
+	var dialer websocket.Dialer
+	if b.Websocket.GetProxyAddress() != "" {
+		proxy, err := url.Parse(b.Websocket.GetProxyAddress())
+		if err != nil {
+			return dialer, fmt.Errorf("binance_websocket.go - Unable to connect to parse proxy address. Error: %s",
+				err)
+		}
+
+		dialer.Proxy = http.ProxyURL(proxy)
+	}
+	return dialer, nil
+}
+
+// WsAuthenticate starts a user data stream and connects to it, the order
+// updates, fills and balances of the account are sent to the data handler
+func (b *Binance) WsAuthenticate() error {
+	listenKey, err := b.GetUserDataStreamListenKey(context.Background())
+	if err != nil {
+		return err
+	}
+
+	dialer, err := b.wsDialer()
+	if err != nil {
+		return err
+	}
+
+	b.WebsocketUserConn, _, err = dialer.Dial(b.Websocket.GetWebsocketURL()+"/ws/"+listenKey,
+		http.Header{})
+	if err != nil {
+		return fmt.Errorf("binance_websocket.go - Unable to connect to user data stream. Error: %s",
+			err)
+	}
+
+	b.Websocket.Wg.Add(2)
+	go b.wsReadUserData(b.WebsocketUserConn)
+	go b.wsKeepAliveUserData(b.WebsocketUserConn, listenKey)
+	return nil
+}
+
+// wsReadUserData reads from the user data stream connection until it is
+// closed
+func (b *Binance) wsReadUserData(conn *websocket.Conn) {
+	defer b.Websocket.Wg.Done()
+	for {
+		_, resp, err := conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-b.Websocket.ShutdownC:
+			default:
+				b.Websocket.SetAuthenticated(false)
+				b.Websocket.DataHandler <- fmt.Errorf("binance_websocket.go - User data stream read error: %s",
+					err)
+			}
+			return
+		}
+
+		b.wsHandleUserData(resp)
+	}
+}
+
+// wsKeepAliveUserData keeps the user data stream listen key alive and closes
+// the connection on shutdown
+func (b *Binance) wsKeepAliveUserData(conn *websocket.Conn, listenKey string) {
+	defer b.Websocket.Wg.Done()
+	keepAlive := time.NewTicker(binanceListenKeyKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-b.Websocket.ShutdownC:
+			err := conn.Close()
+			if err != nil {
+				b.Websocket.DataHandler <- fmt.Errorf("binance_websocket.go - Unable to to close user data stream connection. Error: %s",
+					err)
+			}
+			return
+
+		case <-keepAlive.C:
+			err := b.KeepAliveUserDataStream(context.Background(), listenKey)
+			if err != nil {
+				b.Websocket.DataHandler <- fmt.Errorf("binance_websocket.go - Unable to keep user data stream alive. Error: %s",
+					err)
+			}
+		}
+	}
+}
+
+// wsHandleUserData sends order updates, fills and balance changes from the
+// user data stream to the data handler
+func (b *Binance) wsHandleUserData(raw []byte) {
+	var event UserDataEvent
+	err := common.JSONDecode(raw, &event)
+	if err != nil {
+		b.Websocket.DataHandler <- fmt.Errorf("binance_websocket.go - Could not load user data: %s",
+			string(raw))
+		return
+	}
+
+	switch event.EventType {
+	case "executionReport":
+		var report ExecutionReport
+		err = common.JSONDecode(raw, &report)
+		if err != nil {
+			b.Websocket.DataHandler <- fmt.Errorf("binance_websocket.go - Could not convert to an ExecutionReport structure %s",
+				err)
+			return
+		}
+		b.wsProcessExecutionReport(&report)
+
+	case "outboundAccountInfo":
+		var info AccountInfoStream
+		err = common.JSONDecode(raw, &info)
+		if err != nil {
+			b.Websocket.DataHandler <- fmt.Errorf("binance_websocket.go - Could not convert to an AccountInfoStream structure %s",
+				err)
+			return
+		}
+
+		timestamp := time.Unix(0, info.EventTime*int64(time.Millisecond))
+		for i := range info.Balances {
+			b.Websocket.DataHandler <- exchange.WebsocketBalanceUpdate{
+				Exchange:  b.GetName(),
+				Currency:  info.Balances[i].Asset,
+				Total:     info.Balances[i].Free + info.Balances[i].Locked,
+				Hold:      info.Balances[i].Locked,
+				Timestamp: timestamp,
+			}
+		}
+	}
+}
+
+// wsProcessExecutionReport sends the order state carried by an execution
+// report as an order update and, when the execution is a trade, the trade as
+// an order fill
+func (b *Binance) wsProcessExecutionReport(r *ExecutionReport) {
+	side := exchange.Sell
+	if r.Side == string(BinanceRequestParamsSideBuy) {
+		side = exchange.Buy
+	}
+
+	// Cancels carry the client ID of the cancel request and the client ID of
+	// the order as the original client ID
+	clientID := r.ClientOrderID
+	if r.OriginalClientOrderID != "" {
+		clientID = r.OriginalClientOrderID
+	}
+
+	var averagePrice float64
+	if r.CumulativeFilledQuantity > 0 {
+		averagePrice = r.CumulativeQuoteQuantity / r.CumulativeFilledQuantity
+	}
+
+	p := b.GetPairFromSymbol(r.Symbol)
+	orderID := strconv.FormatInt(r.OrderID, 10)
+	timestamp := time.Unix(0, r.TransactionTime*int64(time.Millisecond))
+	b.Websocket.DataHandler <- exchange.WebsocketOrderUpdate{
+		Exchange:      b.GetName(),
+		AssetType:     "SPOT",
+		Pair:          p,
+		OrderID:       orderID,
+		ClientOrderID: clientID,
+		Side:          side,
+		OrderType:     wsOrderTypes[RequestParamsOrderType(r.OrderType)],
+		Status:        orders.ParseStatus(r.OrderStatus),
+		Price:         r.Price,
+		Amount:        r.Quantity,
+		FilledAmount:  r.CumulativeFilledQuantity,
+		AveragePrice:  averagePrice,
+		Timestamp:     timestamp,
+	}
+
+	if r.ExecutionType != "TRADE" {
+		return
+	}
+
+	b.Websocket.DataHandler <- exchange.WebsocketOrderFill{
+		Exchange:    b.GetName(),
+		AssetType:   "SPOT",
+		Pair:        p,
+		OrderID:     orderID,
+		TradeID:     strconv.FormatInt(r.TradeID, 10),
+		Side:        side,
+		Price:       r.LastExecutedPrice,
+		Amount:      r.LastExecutedQuantity,
+		Fee:         r.Commission,
+		FeeCurrency: r.CommissionAsset,
+		Timestamp:   timestamp,
+	}
+}
+
 // WSReadData reads from the websocket connection
 func (b *Binance) WSReadData() {
 	b.Websocket.Wg.Add(1)
@@ -294,6 +473,8 @@ func (b *Binance) WsHandleData() {
 					wsTicker.OpenPrice, _ = strconv.ParseFloat(ticker.OpenPrice, 64)
 					wsTicker.HighPrice, _ = strconv.ParseFloat(ticker.HighPrice, 64)
 					wsTicker.LowPrice, _ = strconv.ParseFloat(ticker.LowPrice, 64)
+					wsTicker.BidPrice, _ = strconv.ParseFloat(ticker.BestBidPrice, 64)
+					wsTicker.AskPrice, _ = strconv.ParseFloat(ticker.BestAskPrice, 64)
 
 					b.Websocket.DataHandler <- wsTicker
 					continue
@@ -1,11 +1,13 @@
 package binance
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -31,10 +33,13 @@ func (b *Binance) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", b.GetName(), len(b.EnabledPairs), b.EnabledPairs)
 	}
 
-	symbols, err := b.GetExchangeValidCurrencyPairs()
+	info, err := b.GetExchangeInfo(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get exchange info.\n", b.GetName())
 	} else {
+		b.SetMarketInfo(getMarketInfo(&info))
+		symbols := getValidCurrencyPairs(&info)
+
 		forceUpgrade := false
 		if !common.StringDataContains(b.EnabledPairs, "-") || !common.StringDataContains(b.AvailablePairs, "-") {
 			forceUpgrade = true
@@ -56,11 +61,42 @@ func (b *Binance) Run() {
 	}
 }
 
+// getMarketInfo returns the trading rules of the pairs of the exchange
+// information which are trading
+func getMarketInfo(info *ExchangeInfo) []exchange.MarketInfo {
+	var markets []exchange.MarketInfo
+	for _, symbol := range info.Symbols {
+		if symbol.Status != "TRADING" {
+			continue
+		}
+
+		market := exchange.MarketInfo{
+			Pair:      pair.NewCurrencyPair(symbol.BaseAsset, symbol.QuoteAsset),
+			AssetType: ticker.Spot,
+		}
+
+		for _, filter := range symbol.Filters {
+			switch filter.FilterType {
+			case "PRICE_FILTER":
+				market.PriceStep = filter.TickSize
+			case "LOT_SIZE":
+				market.MinAmount = filter.MinQty
+				market.MaxAmount = filter.MaxQty
+				market.AmountStep = filter.StepSize
+			case "MIN_NOTIONAL":
+				market.MinNotional = filter.MinNotional
+			}
+		}
+		markets = append(markets, market)
+	}
+	return markets
+}
+
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Binance) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Binance) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 
-	tick, err := b.GetTickers()
+	tick, err := b.GetTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -84,27 +120,27 @@ func (b *Binance) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pr
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Binance) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Binance) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (b *Binance) GetOrderbookEx(currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Binance) GetOrderbookEx(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(b.GetName(), currency, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(currency, assetType)
+		return b.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Binance) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Binance) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := b.GetOrderBook(OrderBookDataRequestParams{Symbol: exchange.FormatExchangeCurrency(b.Name, p).String(), Limit: 1000})
+	orderbookNew, err := b.GetOrderBook(ctx, OrderBookDataRequestParams{Symbol: exchange.FormatExchangeCurrency(b.Name, p).String(), Limit: 1000})
 	if err != nil {
 		return orderBook, err
 	}
@@ -123,55 +159,38 @@ func (b *Binance) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderb
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // Bithumb exchange
-func (b *Binance) GetAccountInfo() (exchange.AccountInfo, error) {
+func (b *Binance) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	return response, common.ErrNotYetImplemented
 }
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Binance) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Binance) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Binance) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (b *Binance) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Binance) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Binance) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-
-	var sideType RequestParamsSideType
-	if side == exchange.Buy {
-		sideType = BinanceRequestParamsSideBuy
-	} else {
-		sideType = BinanceRequestParamsSideSell
-	}
-
-	var requestParamsOrderType RequestParamsOrderType
-	if orderType == exchange.Market {
-		requestParamsOrderType = BinanceRequestParamsOrderMarket
-	} else if orderType == exchange.Limit {
-		requestParamsOrderType = BinanceRequestParamsOrderLimit
-	} else {
-		submitOrderResponse.IsOrderPlaced = false
-		return submitOrderResponse, errors.New("Unsupported order type")
+	if err := b.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
 	}
 
-	var orderRequest = NewOrderRequest{
-		Symbol:    p.FirstCurrency.String() + p.SecondCurrency.String(),
-		Side:      sideType,
-		Price:     price,
-		Quantity:  amount,
-		TradeType: requestParamsOrderType,
+	orderRequest, err := orderParams(&order)
+	if err != nil {
+		return submitOrderResponse, err
 	}
 
-	response, err := b.NewOrder(orderRequest)
+	response, err := b.NewOrder(ctx, orderRequest)
 
 	if response.OrderID > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response.OrderID)
@@ -179,6 +198,7 @@ func (b *Binance) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orde
 
 	if err == nil {
 		submitOrderResponse.IsOrderPlaced = true
+		fillResponse(&submitOrderResponse, &response)
 	}
 
 	return submitOrderResponse, err
@@ -186,48 +206,75 @@ func (b *Binance) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orde
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Binance) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (b *Binance) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Binance) CancelOrder(order exchange.OrderCancellation) error {
+func (b *Binance) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = b.CancelExistingOrder(exchange.FormatExchangeCurrency(b.Name, order.CurrencyPair).String(), orderIDInt, order.AccountID)
+	_, err = b.CancelExistingOrder(ctx, exchange.FormatExchangeCurrency(b.Name, order.CurrencyPair).String(), orderIDInt, order.AccountID)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Binance) CancelAllOrders() error {
+func (b *Binance) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Binance) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (b *Binance) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Binance) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (b *Binance) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (b *Binance) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (b *Binance) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (b *Binance) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (b *Binance) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (b *Binance) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Binance) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Binance) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Binance) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Binance) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -244,10 +291,219 @@ func (b *Binance) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (b *Binance) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return b.GetFee(feeBuilder)
+	return b.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (b *Binance) GetWithdrawCapabilities() uint32 {
 	return b.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (b *Binance) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	var resp []QueryOrderData
+	if len(getOrdersRequest.Currencies) == 0 {
+		orders, err := b.OpenOrders(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		resp = orders
+	}
+
+	for _, c := range getOrdersRequest.Currencies {
+		orders, err := b.OpenOrders(ctx, exchange.FormatExchangeCurrency(b.Name, c).String())
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, orders...)
+	}
+
+	var orders []exchange.OrderDetail
+	for i := range resp {
+		orders = append(orders, b.formatOrderDetail(resp[i]))
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (b *Binance) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	if len(getOrdersRequest.Currencies) == 0 {
+		return nil, errors.New("at least one currency pair is required")
+	}
+
+	var orders []exchange.OrderDetail
+	for _, c := range getOrdersRequest.Currencies {
+		resp, err := b.AllOrders(ctx, exchange.FormatExchangeCurrency(b.Name, c).String(), "", "")
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp {
+			orders = append(orders, b.formatOrderDetail(resp[i]))
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// formatOrderDetail converts a Binance order into an exchange.OrderDetail
+func (b *Binance) formatOrderDetail(order QueryOrderData) exchange.OrderDetail {
+	p := b.GetPairFromSymbol(order.Symbol)
+	orderDate := time.Unix(0, int64(order.Time)*int64(time.Millisecond))
+	return exchange.OrderDetail{
+		Exchange:       b.Name,
+		ID:             strconv.FormatInt(order.OrderID, 10),
+		BaseCurrency:   p.FirstCurrency.String(),
+		QuoteCurrency:  p.SecondCurrency.String(),
+		OrderSide:      order.Side,
+		OrderType:      order.Type,
+		CreationTime:   orderDate.Unix(),
+		OrderDate:      orderDate,
+		Status:         order.Status,
+		Price:          order.Price,
+		Amount:         order.OrigQty,
+		OpenVolume:     order.OrigQty - order.ExecutedQty,
+		ExecutedAmount: order.ExecutedQty,
+	}
+}
+
+// binanceCandleIntervals maps the supported candle intervals to Binance
+// kline intervals
+var binanceCandleIntervals = map[exchange.CandleInterval]string{
+	exchange.OneMin:     string(TimeIntervalMinute),
+	exchange.ThreeMin:   string(TimeIntervalThreeMinutes),
+	exchange.FiveMin:    string(TimeIntervalFiveMinutes),
+	exchange.FifteenMin: string(TimeIntervalFifteenMinutes),
+	exchange.ThirtyMin:  string(TimeIntervalThirtyMinutes),
+	exchange.OneHour:    string(TimeIntervalHour),
+	exchange.TwoHour:    string(TimeIntervalTwoHours),
+	exchange.FourHour:   string(TimeIntervalFourHours),
+	exchange.SixHour:    string(TimeIntervalSixHours),
+	exchange.EightHour:  string(TimeIntervalEightHours),
+	exchange.TwelveHour: string(TimeIntervalTwelveHours),
+	exchange.OneDay:     string(TimeIntervalDay),
+	exchange.ThreeDay:   string(TimeIntervalThreeDays),
+	exchange.OneWeek:    string(TimeIntervalWeek),
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (b *Binance) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	binanceInterval, err := exchange.ValidateCandleRequest(binanceCandleIntervals,
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := exchange.FormatExchangeCurrency(b.Name, p).String()
+	return exchange.GetCandlesPaginated(interval, start, end, binanceMaxKlineLimit,
+		func(windowStart, windowEnd time.Time) ([]exchange.Candle, error) {
+			resp, err := b.GetSpotKline(ctx, KlinesRequestParams{
+				Symbol:    symbol,
+				Interval:  TimeInterval(binanceInterval),
+				Limit:     binanceMaxKlineLimit,
+				StartTime: windowStart.UnixNano() / int64(time.Millisecond),
+				EndTime:   windowEnd.UnixNano()/int64(time.Millisecond) - 1,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			var candles []exchange.Candle
+			for i := range resp {
+				candles = append(candles, exchange.Candle{
+					Time:   time.Unix(0, int64(resp[i].OpenTime)*int64(time.Millisecond)),
+					Open:   resp[i].Open,
+					High:   resp[i].High,
+					Low:    resp[i].Low,
+					Close:  resp[i].Close,
+					Volume: resp[i].Volume,
+				})
+			}
+			return candles, nil
+		})
+}
+
+// orderParams maps an order to a Binance new order request, post only limit
+// orders are placed as limit maker orders
+func orderParams(s *exchange.SubmitOrder) (NewOrderRequest, error) {
+	orderType, options := s.OrderType, s.Options
+	var orderRequest = NewOrderRequest{
+		Symbol:           s.Pair.FirstCurrency.String() + s.Pair.SecondCurrency.String(),
+		Side:             BinanceRequestParamsSideSell,
+		Price:            s.Price,
+		Quantity:         s.Amount,
+		StopPrice:        options.StopPrice,
+		NewOrderRespType: "FULL",
+	}
+
+	if s.Side == exchange.Buy {
+		orderRequest.Side = BinanceRequestParamsSideBuy
+	}
+
+	switch orderType {
+	case exchange.Market:
+		orderRequest.TradeType = BinanceRequestParamsOrderMarket
+	case exchange.Limit:
+		orderRequest.TradeType = BinanceRequestParamsOrderLimit
+		if options.PostOnly {
+			orderRequest.TradeType = BinanceRequestParamsOrderLimitMarker
+		}
+	case exchange.Stop:
+		orderRequest.TradeType = BinanceRequestParamsOrderStopLoss
+	case exchange.StopLimit:
+		orderRequest.TradeType = BinanceRequestParamsOrderStopLossLimit
+	case exchange.TakeProfit:
+		orderRequest.TradeType = BinanceRequestParamsOrderTakeProfit
+	case exchange.TakeProfitLimit:
+		orderRequest.TradeType = BinanceRequestParamsOrderTakeProfitLimit
+	default:
+		return orderRequest, errors.New("Unsupported order type")
+	}
+
+	if options.PostOnly && orderType != exchange.Limit {
+		return orderRequest, errors.New("post only is only supported for limit orders")
+	}
+
+	// Limit priced orders other than limit maker orders require a time in force
+	if orderType.IsLimitPriced() && !options.PostOnly {
+		switch options.GetTimeInForce() {
+		case exchange.GoodTillCancel:
+			orderRequest.TimeInForce = BinanceRequestParamsTimeGTC
+		case exchange.ImmediateOrCancel:
+			orderRequest.TimeInForce = BinanceRequestParamsTimeIOC
+		case exchange.FillOrKill:
+			orderRequest.TimeInForce = BinanceRequestParamsTimeFOK
+		default:
+			return orderRequest, errors.New("unsupported time in force")
+		}
+	} else if options.GetTimeInForce() != exchange.GoodTillCancel {
+		return orderRequest, errors.New("time in force is only supported for limit priced orders")
+	}
+	return orderRequest, nil
+}
+
+// fillResponse sets the filled amount, average price, fees and timestamps of
+// a full new order response
+func fillResponse(submitOrderResponse *exchange.SubmitOrderResponse, response *NewOrderResponse) {
+	var cost float64
+	for i := range response.Fills {
+		cost += response.Fills[i].Price * response.Fills[i].Qty
+		submitOrderResponse.Fee += response.Fills[i].Commission
+		submitOrderResponse.FeeCurrency = response.Fills[i].CommissionAsset
+	}
+
+	submitOrderResponse.FilledAmount = response.ExecutedQty
+	if response.ExecutedQty > 0 && cost > 0 {
+		submitOrderResponse.AveragePrice = cost / response.ExecutedQty
+	}
+
+	if response.TransactionTime > 0 {
+		submitOrderResponse.OrderDate = time.Unix(0, response.TransactionTime*int64(time.Millisecond))
+		submitOrderResponse.LastUpdated = submitOrderResponse.OrderDate
+	}
+}
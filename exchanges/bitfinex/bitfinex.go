@@ -1,6 +1,7 @@
 package bitfinex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -94,6 +95,13 @@ func (b *Bitfinex) SetDefaults() {
 	b.RESTPollingDelay = 10
 	b.WebsocketSubdChannels = make(map[int]WebsocketChanInfo)
 	b.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithAPIPermission | exchange.AutoWithdrawFiatWithAPIPermission
+	b.OrderSupport = exchange.OrderSupport{
+		OrderTypes: []exchange.OrderType{exchange.Limit, exchange.Market,
+			exchange.Stop, exchange.TrailingStop},
+		TimeInForce: []exchange.TimeInForce{exchange.GoodTillCancel,
+			exchange.FillOrKill},
+		PostOnly: true,
+	}
 	b.RequestCurrencyPairFormat.Delimiter = ""
 	b.RequestCurrencyPairFormat.Uppercase = true
 	b.ConfigCurrencyPairFormat.Delimiter = ""
@@ -154,16 +162,20 @@ func (b *Bitfinex) Setup(exch config.ExchangeConfig) {
 		if err != nil {
 			log.Fatal(err)
 		}
+
+		if b.AuthenticatedAPISupport {
+			b.Websocket.SetAuthenticator(b.WsSendAuth)
+		}
 	}
 }
 
 // GetPlatformStatus returns the Bifinex platform status
-func (b *Bitfinex) GetPlatformStatus() (int, error) {
+func (b *Bitfinex) GetPlatformStatus(ctx context.Context) (int, error) {
 	var response []interface{}
 	path := fmt.Sprintf("%s/v%s/%s", b.APIUrl, bitfinexAPIVersion2,
 		bitfinexPlatformStatus)
 
-	err := b.SendHTTPRequest(path, &response, b.Verbose)
+	err := b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 	if err != nil {
 		return 0, err
 	}
@@ -178,8 +190,8 @@ func (b *Bitfinex) GetPlatformStatus() (int, error) {
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
-func (b *Bitfinex) GetLatestSpotPrice(symbol string) (float64, error) {
-	res, err := b.GetTicker(symbol)
+func (b *Bitfinex) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	res, err := b.GetTicker(ctx, symbol)
 	if err != nil {
 		return 0, err
 	}
@@ -187,11 +199,11 @@ func (b *Bitfinex) GetLatestSpotPrice(symbol string) (float64, error) {
 }
 
 // GetTicker returns ticker information
-func (b *Bitfinex) GetTicker(symbol string) (Ticker, error) {
+func (b *Bitfinex) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	response := Ticker{}
 	path := common.EncodeURLValues(b.APIUrl+bitfinexAPIVersion+bitfinexTicker+symbol, url.Values{})
 
-	if err := b.SendHTTPRequest(path, &response, b.Verbose); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &response, b.Verbose); err != nil {
 		return response, err
 	}
 
@@ -203,12 +215,12 @@ func (b *Bitfinex) GetTicker(symbol string) (Ticker, error) {
 }
 
 // GetTickerV2 returns ticker information
-func (b *Bitfinex) GetTickerV2(symbol string) (Tickerv2, error) {
+func (b *Bitfinex) GetTickerV2(ctx context.Context, symbol string) (Tickerv2, error) {
 	var response []interface{}
 	var ticker Tickerv2
 
 	path := fmt.Sprintf("%s/v%s/%s/%s", b.APIUrl, bitfinexAPIVersion2, bitfinexTickerV2, symbol)
-	err := b.SendHTTPRequest(path, &response, b.Verbose)
+	err := b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 	if err != nil {
 		return ticker, err
 	}
@@ -243,7 +255,7 @@ func (b *Bitfinex) GetTickerV2(symbol string) (Tickerv2, error) {
 }
 
 // GetTickersV2 returns ticker information for multiple symbols
-func (b *Bitfinex) GetTickersV2(symbols string) ([]Tickersv2, error) {
+func (b *Bitfinex) GetTickersV2(ctx context.Context, symbols string) ([]Tickersv2, error) {
 	var response [][]interface{}
 	var tickers []Tickersv2
 
@@ -255,7 +267,7 @@ func (b *Bitfinex) GetTickersV2(symbols string) ([]Tickersv2, error) {
 		bitfinexAPIVersion2,
 		bitfinexTickersV2), v)
 
-	err := b.SendHTTPRequest(path, &response, b.Verbose)
+	err := b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 	if err != nil {
 		return nil, err
 	}
@@ -297,21 +309,21 @@ func (b *Bitfinex) GetTickersV2(symbols string) ([]Tickersv2, error) {
 }
 
 // GetStats returns various statistics about the requested pair
-func (b *Bitfinex) GetStats(symbol string) ([]Stat, error) {
+func (b *Bitfinex) GetStats(ctx context.Context, symbol string) ([]Stat, error) {
 	response := []Stat{}
 	path := fmt.Sprint(b.APIUrl + bitfinexAPIVersion + bitfinexStats + symbol)
 
-	return response, b.SendHTTPRequest(path, &response, b.Verbose)
+	return response, b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 }
 
 // GetFundingBook the entire margin funding book for both bids and asks sides
 // per currency string
 // symbol - example "USD"
-func (b *Bitfinex) GetFundingBook(symbol string) (FundingBook, error) {
+func (b *Bitfinex) GetFundingBook(ctx context.Context, symbol string) (FundingBook, error) {
 	response := FundingBook{}
 	path := fmt.Sprint(b.APIUrl + bitfinexAPIVersion + bitfinexLendbook + symbol)
 
-	if err := b.SendHTTPRequest(path, &response, b.Verbose); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &response, b.Verbose); err != nil {
 		return response, err
 	}
 
@@ -327,13 +339,13 @@ func (b *Bitfinex) GetFundingBook(symbol string) (FundingBook, error) {
 // CurrencyPair - Example "BTCUSD"
 // Values can contain limit amounts for both the asks and bids - Example
 // "limit_bids" = 1000
-func (b *Bitfinex) GetOrderbook(currencyPair string, values url.Values) (Orderbook, error) {
+func (b *Bitfinex) GetOrderbook(ctx context.Context, currencyPair string, values url.Values) (Orderbook, error) {
 	response := Orderbook{}
 	path := common.EncodeURLValues(
 		b.APIUrl+bitfinexAPIVersion+bitfinexOrderbook+currencyPair,
 		values,
 	)
-	return response, b.SendHTTPRequest(path, &response, b.Verbose)
+	return response, b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 }
 
 // GetOrderbookV2 retieves the orderbook bid and ask price points for a currency
@@ -342,12 +354,12 @@ func (b *Bitfinex) GetOrderbook(currencyPair string, values url.Values) (Orderbo
 // precision - P0,P1,P2,P3,R0
 // Values can contain limit amounts for both the asks and bids - Example
 // "len" = 1000
-func (b *Bitfinex) GetOrderbookV2(symbol, precision string, values url.Values) (OrderbookV2, error) {
+func (b *Bitfinex) GetOrderbookV2(ctx context.Context, symbol, precision string, values url.Values) (OrderbookV2, error) {
 	var response [][]interface{}
 	var book OrderbookV2
 	path := common.EncodeURLValues(fmt.Sprintf("%s/v%s/%s/%s/%s", b.APIUrl,
 		bitfinexAPIVersion2, bitfinexOrderbookV2, symbol, precision), values)
-	err := b.SendHTTPRequest(path, &response, b.Verbose)
+	err := b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 	if err != nil {
 		return book, err
 	}
@@ -389,13 +401,13 @@ func (b *Bitfinex) GetOrderbookV2(symbol, precision string, values url.Values) (
 // CurrencyPair - Example "BTCUSD"
 // Values can contain limit amounts for the number of trades returned - Example
 // "limit_trades" = 1000
-func (b *Bitfinex) GetTrades(currencyPair string, values url.Values) ([]TradeStructure, error) {
+func (b *Bitfinex) GetTrades(ctx context.Context, currencyPair string, values url.Values) ([]TradeStructure, error) {
 	response := []TradeStructure{}
 	path := common.EncodeURLValues(
 		b.APIUrl+bitfinexAPIVersion+bitfinexTrades+currencyPair,
 		values,
 	)
-	return response, b.SendHTTPRequest(path, &response, b.Verbose)
+	return response, b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 }
 
 // GetTradesV2 uses the V2 API to get historic trades that occurred on the
@@ -406,7 +418,7 @@ func (b *Bitfinex) GetTrades(currencyPair string, values url.Values) ([]TradeStr
 // timestampEnd is an int64 unix epoch time, make sure this is always there or
 // you will get the most recent trades.
 // reOrderResp reorders the returned data.
-func (b *Bitfinex) GetTradesV2(currencyPair string, timestampStart, timestampEnd int64, reOrderResp bool) ([]TradeStructureV2, error) {
+func (b *Bitfinex) GetTradesV2(ctx context.Context, currencyPair string, timestampStart, timestampEnd int64, reOrderResp bool) ([]TradeStructureV2, error) {
 	var resp [][]interface{}
 	var actualHistory []TradeStructureV2
 
@@ -415,7 +427,7 @@ func (b *Bitfinex) GetTradesV2(currencyPair string, timestampStart, timestampEnd
 		strconv.FormatInt(timestampStart, 10),
 		strconv.FormatInt(timestampEnd, 10))
 
-	err := b.SendHTTPRequest(path, &resp, b.Verbose)
+	err := b.SendHTTPRequest(ctx, path, &resp, b.Verbose)
 	if err != nil {
 		return actualHistory, err
 	}
@@ -452,48 +464,48 @@ func (b *Bitfinex) GetTradesV2(currencyPair string, timestampStart, timestampEnd
 // currency: total amount provided and Flash Return Rate (in % by 365 days) over
 // time
 // Symbol - example "USD"
-func (b *Bitfinex) GetLendbook(symbol string, values url.Values) (Lendbook, error) {
+func (b *Bitfinex) GetLendbook(ctx context.Context, symbol string, values url.Values) (Lendbook, error) {
 	response := Lendbook{}
 	if len(symbol) == 6 {
 		symbol = symbol[:3]
 	}
 	path := common.EncodeURLValues(b.APIUrl+bitfinexAPIVersion+bitfinexLendbook+symbol, values)
 
-	return response, b.SendHTTPRequest(path, &response, b.Verbose)
+	return response, b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 }
 
 // GetLends returns a list of the most recent funding data for the given
 // currency: total amount provided and Flash Return Rate (in % by 365 days)
 // over time
 // Symbol - example "USD"
-func (b *Bitfinex) GetLends(symbol string, values url.Values) ([]Lends, error) {
+func (b *Bitfinex) GetLends(ctx context.Context, symbol string, values url.Values) ([]Lends, error) {
 	response := []Lends{}
 	path := common.EncodeURLValues(b.APIUrl+bitfinexAPIVersion+bitfinexLends+symbol, values)
 
-	return response, b.SendHTTPRequest(path, &response, b.Verbose)
+	return response, b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 }
 
 // GetSymbols returns the available currency pairs on the exchange
-func (b *Bitfinex) GetSymbols() ([]string, error) {
+func (b *Bitfinex) GetSymbols(ctx context.Context) ([]string, error) {
 	products := []string{}
 	path := fmt.Sprint(b.APIUrl + bitfinexAPIVersion + bitfinexSymbols)
 
-	return products, b.SendHTTPRequest(path, &products, b.Verbose)
+	return products, b.SendHTTPRequest(ctx, path, &products, b.Verbose)
 }
 
 // GetSymbolsDetails a list of valid symbol IDs and the pair details
-func (b *Bitfinex) GetSymbolsDetails() ([]SymbolDetails, error) {
+func (b *Bitfinex) GetSymbolsDetails(ctx context.Context) ([]SymbolDetails, error) {
 	response := []SymbolDetails{}
 	path := fmt.Sprint(b.APIUrl + bitfinexAPIVersion + bitfinexSymbolsDetails)
 
-	return response, b.SendHTTPRequest(path, &response, b.Verbose)
+	return response, b.SendHTTPRequest(ctx, path, &response, b.Verbose)
 }
 
 // GetAccountInformation returns information about your account incl. trading fees
-func (b *Bitfinex) GetAccountInformation() ([]AccountInfo, error) {
+func (b *Bitfinex) GetAccountInformation(ctx context.Context) ([]AccountInfo, error) {
 
 	var responses []AccountInfo
-	err := b.SendAuthenticatedHTTPRequest("POST", bitfinexAccountInfo, nil, &responses)
+	err := b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexAccountInfo, nil, &responses)
 
 	if err != nil {
 		return responses, err
@@ -503,10 +515,10 @@ func (b *Bitfinex) GetAccountInformation() ([]AccountInfo, error) {
 }
 
 // GetAccountFees - Gets all fee rates for all currencies
-func (b *Bitfinex) GetAccountFees() (AccountFees, error) {
+func (b *Bitfinex) GetAccountFees(ctx context.Context) (AccountFees, error) {
 	response := AccountFees{}
 
-	err := b.SendAuthenticatedHTTPRequest("POST", bitfinexAccountFees, nil, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexAccountFees, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -515,21 +527,21 @@ func (b *Bitfinex) GetAccountFees() (AccountFees, error) {
 
 // GetAccountSummary returns a 30-day summary of your trading volume and return
 // on margin funding
-func (b *Bitfinex) GetAccountSummary() (AccountSummary, error) {
+func (b *Bitfinex) GetAccountSummary(ctx context.Context) (AccountSummary, error) {
 	response := AccountSummary{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(
+		b.SendAuthenticatedHTTPRequest(ctx,
 			"POST", bitfinexAccountSummary, nil, &response,
 		)
 }
 
 // NewDeposit returns a new deposit address
 // Method - Example methods accepted: “bitcoin”, “litecoin”, “ethereum”,
-//“tethers", "ethereumc", "zcash", "monero", "iota", "bcash"
+// “tethers", "ethereumc", "zcash", "monero", "iota", "bcash"
 // WalletName - accepted: “trading”, “exchange”, “deposit”
 // renew - Default is 0. If set to 1, will return a new unused deposit address
-func (b *Bitfinex) NewDeposit(method, walletName string, renew int) (DepositResponse, error) {
+func (b *Bitfinex) NewDeposit(ctx context.Context, method, walletName string, renew int) (DepositResponse, error) {
 	response := DepositResponse{}
 	request := make(map[string]interface{})
 	request["method"] = method
@@ -537,32 +549,32 @@ func (b *Bitfinex) NewDeposit(method, walletName string, renew int) (DepositResp
 	request["renew"] = renew
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexDeposit, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexDeposit, request, &response)
 }
 
 // GetKeyPermissions checks the permissions of the key being used to generate
 // this request.
-func (b *Bitfinex) GetKeyPermissions() (KeyPermissions, error) {
+func (b *Bitfinex) GetKeyPermissions(ctx context.Context) (KeyPermissions, error) {
 	response := KeyPermissions{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexKeyPermissions, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexKeyPermissions, nil, &response)
 }
 
 // GetMarginInfo shows your trading wallet information for margin trading
-func (b *Bitfinex) GetMarginInfo() ([]MarginInfo, error) {
+func (b *Bitfinex) GetMarginInfo(ctx context.Context) ([]MarginInfo, error) {
 	response := []MarginInfo{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexMarginInfo, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexMarginInfo, nil, &response)
 }
 
 // GetAccountBalance returns full wallet balance information
-func (b *Bitfinex) GetAccountBalance() ([]Balance, error) {
+func (b *Bitfinex) GetAccountBalance(ctx context.Context) ([]Balance, error) {
 	response := []Balance{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexBalances, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexBalances, nil, &response)
 }
 
 // WalletTransfer move available balances between your wallets
@@ -570,7 +582,7 @@ func (b *Bitfinex) GetAccountBalance() ([]Balance, error) {
 // Currency -  example "BTC"
 // WalletFrom - example "exchange"
 // WalletTo -  example "deposit"
-func (b *Bitfinex) WalletTransfer(amount float64, currency, walletFrom, walletTo string) ([]WalletTransfer, error) {
+func (b *Bitfinex) WalletTransfer(ctx context.Context, amount float64, currency, walletFrom, walletTo string) ([]WalletTransfer, error) {
 	response := []WalletTransfer{}
 	request := make(map[string]interface{})
 	request["amount"] = amount
@@ -579,12 +591,12 @@ func (b *Bitfinex) WalletTransfer(amount float64, currency, walletFrom, walletTo
 	request["walletTo"] = walletTo
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexTransfer, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexTransfer, request, &response)
 }
 
 // Withdrawal requests a withdrawal from one of your wallets.
 // Major Upgrade needed on this function to include all query params
-func (b *Bitfinex) Withdrawal(withdrawType, wallet, address string, amount float64) ([]Withdrawal, error) {
+func (b *Bitfinex) Withdrawal(ctx context.Context, withdrawType, wallet, address string, amount float64) ([]Withdrawal, error) {
 	response := []Withdrawal{}
 	request := make(map[string]interface{})
 	request["withdrawal_type"] = withdrawType
@@ -593,12 +605,12 @@ func (b *Bitfinex) Withdrawal(withdrawType, wallet, address string, amount float
 	request["address"] = address
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexWithdrawal, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexWithdrawal, request, &response)
 }
 
 // NewOrder submits a new order and returns a order information
 // Major Upgrade needed on this function to include all query params
-func (b *Bitfinex) NewOrder(currencyPair string, amount float64, price float64, buy bool, Type string, hidden bool) (Order, error) {
+func (b *Bitfinex) NewOrder(ctx context.Context, currencyPair string, amount float64, price float64, buy bool, Type string, hidden, postOnly bool) (Order, error) {
 	response := Order{}
 	request := make(map[string]interface{})
 	request["symbol"] = currencyPair
@@ -608,6 +620,10 @@ func (b *Bitfinex) NewOrder(currencyPair string, amount float64, price float64,
 	request["type"] = Type
 	request["is_hidden"] = hidden
 
+	if postOnly {
+		request["is_postonly"] = true
+	}
+
 	if buy {
 		request["side"] = "buy"
 	} else {
@@ -615,49 +631,49 @@ func (b *Bitfinex) NewOrder(currencyPair string, amount float64, price float64,
 	}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOrderNew, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOrderNew, request, &response)
 }
 
 // NewOrderMulti allows several new orders at once
-func (b *Bitfinex) NewOrderMulti(orders []PlaceOrder) (OrderMultiResponse, error) {
+func (b *Bitfinex) NewOrderMulti(ctx context.Context, orders []PlaceOrder) (OrderMultiResponse, error) {
 	response := OrderMultiResponse{}
 	request := make(map[string]interface{})
 	request["orders"] = orders
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOrderNewMulti, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOrderNewMulti, request, &response)
 }
 
 // CancelExistingOrder cancels a single order by OrderID
-func (b *Bitfinex) CancelExistingOrder(OrderID int64) (Order, error) {
+func (b *Bitfinex) CancelExistingOrder(ctx context.Context, OrderID int64) (Order, error) {
 	response := Order{}
 	request := make(map[string]interface{})
 	request["order_id"] = OrderID
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOrderCancel, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOrderCancel, request, &response)
 }
 
 // CancelMultipleOrders cancels multiple orders
-func (b *Bitfinex) CancelMultipleOrders(OrderIDs []int64) (string, error) {
+func (b *Bitfinex) CancelMultipleOrders(ctx context.Context, OrderIDs []int64) (string, error) {
 	response := GenericResponse{}
 	request := make(map[string]interface{})
 	request["order_ids"] = OrderIDs
 
 	return response.Result,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOrderCancelMulti, request, nil)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOrderCancelMulti, request, nil)
 }
 
 // CancelAllExistingOrders cancels all active and open orders
-func (b *Bitfinex) CancelAllExistingOrders() (string, error) {
+func (b *Bitfinex) CancelAllExistingOrders(ctx context.Context) (string, error) {
 	response := GenericResponse{}
 
 	return response.Result,
-		b.SendAuthenticatedHTTPRequest("GET", bitfinexOrderCancelAll, nil, nil)
+		b.SendAuthenticatedHTTPRequest(ctx, "GET", bitfinexOrderCancelAll, nil, nil)
 }
 
 // ReplaceOrder replaces an older order with a new order
-func (b *Bitfinex) ReplaceOrder(OrderID int64, Symbol string, Amount float64, Price float64, Buy bool, Type string, Hidden bool) (Order, error) {
+func (b *Bitfinex) ReplaceOrder(ctx context.Context, OrderID int64, Symbol string, Amount float64, Price float64, Buy bool, Type string, Hidden bool) (Order, error) {
 	response := Order{}
 	request := make(map[string]interface{})
 	request["order_id"] = OrderID
@@ -675,47 +691,47 @@ func (b *Bitfinex) ReplaceOrder(OrderID int64, Symbol string, Amount float64, Pr
 	}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOrderCancelReplace, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOrderCancelReplace, request, &response)
 }
 
 // GetOrderStatus returns order status information
-func (b *Bitfinex) GetOrderStatus(OrderID int64) (Order, error) {
+func (b *Bitfinex) GetOrderStatus(ctx context.Context, OrderID int64) (Order, error) {
 	orderStatus := Order{}
 	request := make(map[string]interface{})
 	request["order_id"] = OrderID
 
 	return orderStatus,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOrderStatus, request, &orderStatus)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOrderStatus, request, &orderStatus)
 }
 
-// GetActiveOrders returns all active orders and statuses
-func (b *Bitfinex) GetActiveOrders() ([]Order, error) {
+// GetOpenOrders returns all active orders and statuses
+func (b *Bitfinex) GetOpenOrders(ctx context.Context) ([]Order, error) {
 	response := []Order{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOrders, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOrders, nil, &response)
 }
 
 // GetActivePositions returns an array of active positions
-func (b *Bitfinex) GetActivePositions() ([]Position, error) {
+func (b *Bitfinex) GetActivePositions(ctx context.Context) ([]Position, error) {
 	response := []Position{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexPositions, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexPositions, nil, &response)
 }
 
 // ClaimPosition allows positions to be claimed
-func (b *Bitfinex) ClaimPosition(PositionID int) (Position, error) {
+func (b *Bitfinex) ClaimPosition(ctx context.Context, PositionID int) (Position, error) {
 	response := Position{}
 	request := make(map[string]interface{})
 	request["position_id"] = PositionID
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexClaimPosition, nil, nil)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexClaimPosition, nil, nil)
 }
 
 // GetBalanceHistory returns balance history for the account
-func (b *Bitfinex) GetBalanceHistory(symbol string, timeSince, timeUntil time.Time, limit int, wallet string) ([]BalanceHistory, error) {
+func (b *Bitfinex) GetBalanceHistory(ctx context.Context, symbol string, timeSince, timeUntil time.Time, limit int, wallet string) ([]BalanceHistory, error) {
 	response := []BalanceHistory{}
 	request := make(map[string]interface{})
 	request["currency"] = symbol
@@ -734,11 +750,11 @@ func (b *Bitfinex) GetBalanceHistory(symbol string, timeSince, timeUntil time.Ti
 	}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexHistory, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexHistory, request, &response)
 }
 
 // GetMovementHistory returns an array of past deposits and withdrawals
-func (b *Bitfinex) GetMovementHistory(symbol, method string, timeSince, timeUntil time.Time, limit int) ([]MovementHistory, error) {
+func (b *Bitfinex) GetMovementHistory(ctx context.Context, symbol, method string, timeSince, timeUntil time.Time, limit int) ([]MovementHistory, error) {
 	response := []MovementHistory{}
 	request := make(map[string]interface{})
 	request["currency"] = symbol
@@ -757,32 +773,34 @@ func (b *Bitfinex) GetMovementHistory(symbol, method string, timeSince, timeUnti
 	}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexHistoryMovements, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexHistoryMovements, request, &response)
 }
 
 // GetTradeHistory returns past executed trades
-func (b *Bitfinex) GetTradeHistory(currencyPair string, timestamp, until time.Time, limit, reverse int) ([]TradeHistory, error) {
+func (b *Bitfinex) GetTradeHistory(ctx context.Context, currencyPair string, timestamp, until time.Time, limit, reverse int) ([]TradeHistory, error) {
 	response := []TradeHistory{}
 	request := make(map[string]interface{})
-	request["currency"] = currencyPair
-	request["timestamp"] = timestamp
+	request["symbol"] = currencyPair
 
+	if !timestamp.IsZero() {
+		request["timestamp"] = strconv.FormatInt(timestamp.Unix(), 10)
+	}
 	if !until.IsZero() {
-		request["until"] = until
+		request["until"] = strconv.FormatInt(until.Unix(), 10)
 	}
 	if limit > 0 {
-		request["limit"] = limit
+		request["limit_trades"] = limit
 	}
 	if reverse > 0 {
 		request["reverse"] = reverse
 	}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexTradeHistory, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexTradeHistory, request, &response)
 }
 
 // NewOffer submits a new offer
-func (b *Bitfinex) NewOffer(symbol string, amount, rate float64, period int64, direction string) (Offer, error) {
+func (b *Bitfinex) NewOffer(ctx context.Context, symbol string, amount, rate float64, period int64, direction string) (Offer, error) {
 	response := Offer{}
 	request := make(map[string]interface{})
 	request["currency"] = symbol
@@ -792,90 +810,90 @@ func (b *Bitfinex) NewOffer(symbol string, amount, rate float64, period int64, d
 	request["direction"] = direction
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOfferNew, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOfferNew, request, &response)
 }
 
 // CancelOffer cancels offer by offerID
-func (b *Bitfinex) CancelOffer(OfferID int64) (Offer, error) {
+func (b *Bitfinex) CancelOffer(ctx context.Context, OfferID int64) (Offer, error) {
 	response := Offer{}
 	request := make(map[string]interface{})
 	request["offer_id"] = OfferID
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOfferCancel, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOfferCancel, request, &response)
 }
 
 // GetOfferStatus checks offer status whether it has been cancelled, execute or
 // is still active
-func (b *Bitfinex) GetOfferStatus(OfferID int64) (Offer, error) {
+func (b *Bitfinex) GetOfferStatus(ctx context.Context, OfferID int64) (Offer, error) {
 	response := Offer{}
 	request := make(map[string]interface{})
 	request["offer_id"] = OfferID
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOrderStatus, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOrderStatus, request, &response)
 }
 
 // GetActiveCredits returns all available credits
-func (b *Bitfinex) GetActiveCredits() ([]Offer, error) {
+func (b *Bitfinex) GetActiveCredits(ctx context.Context) ([]Offer, error) {
 	response := []Offer{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexActiveCredits, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexActiveCredits, nil, &response)
 }
 
 // GetActiveOffers returns all current active offers
-func (b *Bitfinex) GetActiveOffers() ([]Offer, error) {
+func (b *Bitfinex) GetActiveOffers(ctx context.Context) ([]Offer, error) {
 	response := []Offer{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexOffers, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexOffers, nil, &response)
 }
 
 // GetActiveMarginFunding returns an array of active margin funds
-func (b *Bitfinex) GetActiveMarginFunding() ([]MarginFunds, error) {
+func (b *Bitfinex) GetActiveMarginFunding(ctx context.Context) ([]MarginFunds, error) {
 	response := []MarginFunds{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexMarginActiveFunds, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexMarginActiveFunds, nil, &response)
 }
 
 // GetUnusedMarginFunds returns an array of funding borrowed but not currently
 // used
-func (b *Bitfinex) GetUnusedMarginFunds() ([]MarginFunds, error) {
+func (b *Bitfinex) GetUnusedMarginFunds(ctx context.Context) ([]MarginFunds, error) {
 	response := []MarginFunds{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexMarginUnusedFunds, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexMarginUnusedFunds, nil, &response)
 }
 
 // GetMarginTotalTakenFunds returns an array of active funding used in a
 // position
-func (b *Bitfinex) GetMarginTotalTakenFunds() ([]MarginTotalTakenFunds, error) {
+func (b *Bitfinex) GetMarginTotalTakenFunds(ctx context.Context) ([]MarginTotalTakenFunds, error) {
 	response := []MarginTotalTakenFunds{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexMarginTotalFunds, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexMarginTotalFunds, nil, &response)
 }
 
 // CloseMarginFunding closes an unused or used taken fund
-func (b *Bitfinex) CloseMarginFunding(SwapID int64) (Offer, error) {
+func (b *Bitfinex) CloseMarginFunding(ctx context.Context, SwapID int64) (Offer, error) {
 	response := Offer{}
 	request := make(map[string]interface{})
 	request["swap_id"] = SwapID
 
 	return response,
-		b.SendAuthenticatedHTTPRequest("POST", bitfinexMarginClose, request, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, "POST", bitfinexMarginClose, request, &response)
 }
 
 // SendHTTPRequest sends an unauthenticated request
-func (b *Bitfinex) SendHTTPRequest(path string, result interface{}, verbose bool) error {
-	return b.SendPayload("GET", path, nil, nil, result, false, verbose)
+func (b *Bitfinex) SendHTTPRequest(ctx context.Context, path string, result interface{}, verbose bool) error {
+	return b.SendPayload(ctx, "GET", path, nil, nil, result, false, verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an autheticated http request and json
 // unmarshals result to a supplied variable
-func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[string]interface{}, result interface{}) error {
+func (b *Bitfinex) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, params map[string]interface{}, result interface{}) error {
 	if !b.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -912,7 +930,7 @@ func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[
 	headers["X-BFX-PAYLOAD"] = PayloadBase64
 	headers["X-BFX-SIGNATURE"] = common.HexEncodeToString(hmac)
 
-	err = b.SendPayload(method, b.APIUrl+bitfinexAPIVersion+path, headers, nil, result, true, b.Verbose)
+	err = b.SendPayload(ctx, method, b.APIUrl+bitfinexAPIVersion+path, headers, nil, result, true, b.Verbose)
 	if err != nil {
 		return err
 	}
@@ -921,12 +939,12 @@ func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bitfinex) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *Bitfinex) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		accountInfos, err := b.GetAccountInformation()
+		accountInfos, err := b.GetAccountInformation(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -938,7 +956,7 @@ func (b *Bitfinex) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 		//TODO: fee is charged when < $1000USD is transferred, need to infer value in some way
 		fee = 0
 	case exchange.CryptocurrencyWithdrawalFee:
-		accountFees, err := b.GetAccountFees()
+		accountFees, err := b.GetAccountFees(ctx)
 		if err != nil {
 			return 0, err
 		}
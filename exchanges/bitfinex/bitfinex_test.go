@@ -1,6 +1,8 @@
 package bitfinex
 
 import (
+	"context"
+	"hash/crc32"
 	"net/url"
 	"reflect"
 	"testing"
@@ -11,6 +13,8 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply your own keys here to do better tests
@@ -22,6 +26,12 @@ const (
 
 var b Bitfinex
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetup(t *testing.T) {
 	b.SetDefaults()
 	cfg := config.GetConfig()
@@ -47,7 +57,7 @@ func TestSetup(t *testing.T) {
 func TestGetPlatformStatus(t *testing.T) {
 	t.Parallel()
 
-	result, err := b.GetPlatformStatus()
+	result, err := b.GetPlatformStatus(context.Background())
 	if err != nil {
 		t.Errorf("TestGetPlatformStatus error: %s", err)
 	}
@@ -59,7 +69,7 @@ func TestGetPlatformStatus(t *testing.T) {
 
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLatestSpotPrice("BTCUSD")
+	_, err := b.GetLatestSpotPrice(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error("Bitfinex GetLatestSpotPrice error: ", err)
 	}
@@ -67,12 +77,12 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker("BTCUSD")
+	_, err := b.GetTicker(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error("BitfinexGetTicker init error: ", err)
 	}
 
-	_, err = b.GetTicker("wigwham")
+	_, err = b.GetTicker(context.Background(), "wigwham")
 	if err == nil {
 		t.Error("Test Failed - GetTicker() error")
 	}
@@ -80,12 +90,12 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTickerV2(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTickerV2("tBTCUSD")
+	_, err := b.GetTickerV2(context.Background(), "tBTCUSD")
 	if err != nil {
 		t.Errorf("GetTickerV2 error: %s", err)
 	}
 
-	_, err = b.GetTickerV2("fUSD")
+	_, err = b.GetTickerV2(context.Background(), "fUSD")
 	if err != nil {
 		t.Errorf("GetTickerV2 error: %s", err)
 	}
@@ -93,7 +103,7 @@ func TestGetTickerV2(t *testing.T) {
 
 func TestGetTickersV2(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTickersV2("tBTCUSD,fUSD")
+	_, err := b.GetTickersV2(context.Background(), "tBTCUSD,fUSD")
 	if err != nil {
 		t.Errorf("GetTickersV2 error: %s", err)
 	}
@@ -101,12 +111,12 @@ func TestGetTickersV2(t *testing.T) {
 
 func TestGetStats(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetStats("BTCUSD")
+	_, err := b.GetStats(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error("BitfinexGetStatsTest init error: ", err)
 	}
 
-	_, err = b.GetStats("wigwham")
+	_, err = b.GetStats(context.Background(), "wigwham")
 	if err == nil {
 		t.Error("Test Failed - GetStats() error")
 	}
@@ -114,11 +124,11 @@ func TestGetStats(t *testing.T) {
 
 func TestGetFundingBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetFundingBook("USD")
+	_, err := b.GetFundingBook(context.Background(), "USD")
 	if err != nil {
 		t.Error("Testing Failed - GetFundingBook() error")
 	}
-	_, err = b.GetFundingBook("wigwham")
+	_, err = b.GetFundingBook(context.Background(), "wigwham")
 	if err == nil {
 		t.Error("Testing Failed - GetFundingBook() error")
 	}
@@ -127,7 +137,7 @@ func TestGetFundingBook(t *testing.T) {
 func TestGetLendbook(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetLendbook("BTCUSD", url.Values{})
+	_, err := b.GetLendbook(context.Background(), "BTCUSD", url.Values{})
 	if err != nil {
 		t.Error("Testing Failed - GetLendbook() error: ", err)
 	}
@@ -136,7 +146,7 @@ func TestGetLendbook(t *testing.T) {
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderbook("BTCUSD", url.Values{})
+	_, err := b.GetOrderbook(context.Background(), "BTCUSD", url.Values{})
 	if err != nil {
 		t.Error("BitfinexGetOrderbook init error: ", err)
 	}
@@ -145,12 +155,12 @@ func TestGetOrderbook(t *testing.T) {
 func TestGetOrderbookV2(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderbookV2("tBTCUSD", "P0", url.Values{})
+	_, err := b.GetOrderbookV2(context.Background(), "tBTCUSD", "P0", url.Values{})
 	if err != nil {
 		t.Errorf("GetOrderbookV2 error: %s", err)
 	}
 
-	_, err = b.GetOrderbookV2("fUSD", "P0", url.Values{})
+	_, err = b.GetOrderbookV2(context.Background(), "fUSD", "P0", url.Values{})
 	if err != nil {
 		t.Errorf("GetOrderbookV2 error: %s", err)
 	}
@@ -159,7 +169,7 @@ func TestGetOrderbookV2(t *testing.T) {
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTrades("BTCUSD", url.Values{})
+	_, err := b.GetTrades(context.Background(), "BTCUSD", url.Values{})
 	if err != nil {
 		t.Error("BitfinexGetTrades init error: ", err)
 	}
@@ -168,7 +178,7 @@ func TestGetTrades(t *testing.T) {
 func TestGetTradesv2(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTradesV2("tBTCUSD", 0, 0, true)
+	_, err := b.GetTradesV2(context.Background(), "tBTCUSD", 0, 0, true)
 	if err != nil {
 		t.Error("BitfinexGetTrades init error: ", err)
 	}
@@ -177,7 +187,7 @@ func TestGetTradesv2(t *testing.T) {
 func TestGetLends(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetLends("BTC", url.Values{})
+	_, err := b.GetLends(context.Background(), "BTC", url.Values{})
 	if err != nil {
 		t.Error("BitfinexGetLends init error: ", err)
 	}
@@ -186,7 +196,7 @@ func TestGetLends(t *testing.T) {
 func TestGetSymbols(t *testing.T) {
 	t.Parallel()
 
-	symbols, err := b.GetSymbols()
+	symbols, err := b.GetSymbols(context.Background())
 	if err != nil {
 		t.Fatal("BitfinexGetSymbols init error: ", err)
 	}
@@ -234,7 +244,7 @@ func TestGetSymbols(t *testing.T) {
 func TestGetSymbolsDetails(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetSymbolsDetails()
+	_, err := b.GetSymbolsDetails(context.Background())
 	if err != nil {
 		t.Error("BitfinexGetSymbolsDetails init error: ", err)
 	}
@@ -246,7 +256,7 @@ func TestGetAccountInfo(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetAccountInfo()
+	_, err := b.GetAccountInfo(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetAccountInfo error")
 	}
@@ -258,7 +268,7 @@ func TestGetAccountFees(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetAccountFees()
+	_, err := b.GetAccountFees(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetAccountFees error")
 	}
@@ -270,7 +280,7 @@ func TestGetAccountSummary(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetAccountSummary()
+	_, err := b.GetAccountSummary(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetAccountSummary() error:")
 	}
@@ -282,7 +292,7 @@ func TestNewDeposit(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.NewDeposit("blabla", "testwallet", 1)
+	_, err := b.NewDeposit(context.Background(), "blabla", "testwallet", 1)
 	if err == nil {
 		t.Error("Test Failed - NewDeposit() error:", err)
 	}
@@ -294,7 +304,7 @@ func TestGetKeyPermissions(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetKeyPermissions()
+	_, err := b.GetKeyPermissions(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetKeyPermissions() error:")
 	}
@@ -306,7 +316,7 @@ func TestGetMarginInfo(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetMarginInfo()
+	_, err := b.GetMarginInfo(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetMarginInfo() error")
 	}
@@ -318,7 +328,7 @@ func TestGetAccountBalance(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetAccountBalance()
+	_, err := b.GetAccountBalance(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetAccountBalance() error")
 	}
@@ -330,7 +340,7 @@ func TestWalletTransfer(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.WalletTransfer(0.01, "bla", "bla", "bla")
+	_, err := b.WalletTransfer(context.Background(), 0.01, "bla", "bla", "bla")
 	if err == nil {
 		t.Error("Test Failed - WalletTransfer() error")
 	}
@@ -342,7 +352,7 @@ func TestWithdrawal(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.Withdrawal("LITECOIN", "deposit", "1000", 0.01)
+	_, err := b.Withdrawal(context.Background(), "LITECOIN", "deposit", "1000", 0.01)
 	if err == nil {
 		t.Error("Test Failed - Withdrawal() error")
 	}
@@ -354,7 +364,7 @@ func TestNewOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.NewOrder("BTCUSD", 1, 2, true, "market", false)
+	_, err := b.NewOrder(context.Background(), "BTCUSD", 1, 2, true, "market", false, false)
 	if err == nil {
 		t.Error("Test Failed - NewOrder() error")
 	}
@@ -377,7 +387,7 @@ func TestNewOrderMulti(t *testing.T) {
 		},
 	}
 
-	_, err := b.NewOrderMulti(newOrder)
+	_, err := b.NewOrderMulti(context.Background(), newOrder)
 	if err == nil {
 		t.Error("Test Failed - NewOrderMulti() error")
 	}
@@ -389,7 +399,7 @@ func TestCancelExistingOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelExistingOrder(1337)
+	_, err := b.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - CancelExistingOrder() error")
 	}
@@ -401,7 +411,7 @@ func TestCancelMultipleOrders(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelMultipleOrders([]int64{1337, 1336})
+	_, err := b.CancelMultipleOrders(context.Background(), []int64{1337, 1336})
 	if err == nil {
 		t.Error("Test Failed - CancelMultipleOrders() error")
 	}
@@ -413,7 +423,7 @@ func TestCancelAllExistingOrders(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelAllExistingOrders()
+	_, err := b.CancelAllExistingOrders(context.Background())
 	if err == nil {
 		t.Error("Test Failed - CancelAllExistingOrders() error")
 	}
@@ -425,7 +435,7 @@ func TestReplaceOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.ReplaceOrder(1337, "BTCUSD", 1, 1, true, "market", false)
+	_, err := b.ReplaceOrder(context.Background(), 1337, "BTCUSD", 1, 1, true, "market", false)
 	if err == nil {
 		t.Error("Test Failed - ReplaceOrder() error")
 	}
@@ -437,21 +447,21 @@ func TestGetOrderStatus(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetOrderStatus(1337)
+	_, err := b.GetOrderStatus(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - GetOrderStatus() error")
 	}
 }
 
-func TestGetActiveOrders(t *testing.T) {
+func TestGetOpenOrders(t *testing.T) {
 	if b.APIKey == "" || b.APISecret == "" {
 		t.SkipNow()
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveOrders()
+	_, err := b.GetOpenOrders(context.Background())
 	if err == nil {
-		t.Error("Test Failed - GetActiveOrders() error")
+		t.Error("Test Failed - GetOpenOrders() error")
 	}
 }
 
@@ -461,7 +471,7 @@ func TestGetActivePositions(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActivePositions()
+	_, err := b.GetActivePositions(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetActivePositions() error")
 	}
@@ -473,7 +483,7 @@ func TestClaimPosition(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.ClaimPosition(1337)
+	_, err := b.ClaimPosition(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - ClaimPosition() error")
 	}
@@ -485,7 +495,7 @@ func TestGetBalanceHistory(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetBalanceHistory("USD", time.Time{}, time.Time{}, 1, "deposit")
+	_, err := b.GetBalanceHistory(context.Background(), "USD", time.Time{}, time.Time{}, 1, "deposit")
 	if err == nil {
 		t.Error("Test Failed - GetBalanceHistory() error")
 	}
@@ -497,7 +507,7 @@ func TestGetMovementHistory(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetMovementHistory("USD", "bitcoin", time.Time{}, time.Time{}, 1)
+	_, err := b.GetMovementHistory(context.Background(), "USD", "bitcoin", time.Time{}, time.Time{}, 1)
 	if err == nil {
 		t.Error("Test Failed - GetMovementHistory() error")
 	}
@@ -509,7 +519,7 @@ func TestGetTradeHistory(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetTradeHistory("BTCUSD", time.Time{}, time.Time{}, 1, 0)
+	_, err := b.GetTradeHistory(context.Background(), "BTCUSD", time.Time{}, time.Time{}, 1, 0)
 	if err == nil {
 		t.Error("Test Failed - GetTradeHistory() error")
 	}
@@ -521,7 +531,7 @@ func TestNewOffer(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.NewOffer("BTC", 1, 1, 1, "loan")
+	_, err := b.NewOffer(context.Background(), "BTC", 1, 1, 1, "loan")
 	if err == nil {
 		t.Error("Test Failed - NewOffer() error")
 	}
@@ -533,7 +543,7 @@ func TestCancelOffer(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelOffer(1337)
+	_, err := b.CancelOffer(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - CancelOffer() error")
 	}
@@ -545,7 +555,7 @@ func TestGetOfferStatus(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetOfferStatus(1337)
+	_, err := b.GetOfferStatus(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - NewOffer() error")
 	}
@@ -557,7 +567,7 @@ func TestGetActiveCredits(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveCredits()
+	_, err := b.GetActiveCredits(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetActiveCredits() error", err)
 	}
@@ -569,7 +579,7 @@ func TestGetActiveOffers(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveOffers()
+	_, err := b.GetActiveOffers(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetActiveOffers() error", err)
 	}
@@ -581,7 +591,7 @@ func TestGetActiveMarginFunding(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveMarginFunding()
+	_, err := b.GetActiveMarginFunding(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetActiveMarginFunding() error", err)
 	}
@@ -593,7 +603,7 @@ func TestGetUnusedMarginFunds(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetUnusedMarginFunds()
+	_, err := b.GetUnusedMarginFunds(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetUnusedMarginFunds() error", err)
 	}
@@ -605,7 +615,7 @@ func TestGetMarginTotalTakenFunds(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetMarginTotalTakenFunds()
+	_, err := b.GetMarginTotalTakenFunds(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetMarginTotalTakenFunds() error", err)
 	}
@@ -617,7 +627,7 @@ func TestCloseMarginFunding(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CloseMarginFunding(1337)
+	_, err := b.CloseMarginFunding(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - CloseMarginFunding() error")
 	}
@@ -643,7 +653,7 @@ func TestGetFee(t *testing.T) {
 
 	if testAPIKey != "" || testAPISecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.002), resp)
 		}
@@ -652,7 +662,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(2000) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(2000) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(2000), resp)
 			t.Error(err)
 		}
@@ -660,7 +670,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
 			t.Error(err)
 		}
@@ -668,7 +678,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -676,7 +686,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyWithdrawalFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.0004) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0004) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0004), resp)
 			t.Error(err)
 		}
@@ -685,7 +695,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -694,7 +704,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -703,7 +713,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -721,6 +731,75 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Bitfinex using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *Bitfinex {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	bfxConfig, err := cfg.GetExchangeConfig("Bitfinex")
+	if err != nil {
+		t.Fatal("Test Failed - Bitfinex Setup() init error")
+	}
+
+	bfxConfig.AuthenticatedAPISupport = true
+	bfxConfig.APIKey, bfxConfig.APISecret = request.FixtureCredentials(t, testAPIKey, testAPISecret)
+
+	var r Bitfinex
+	r.SetDefaults()
+	r.Setup(bfxConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - Bitfinex GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "448411365" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].QuoteCurrency != symbol.USD ||
+		orders[0].Price != 0.02 || orders[0].OpenVolume != 0.01 ||
+		orders[0].ExecutedAmount != 0.01 || orders[0].OrderDate.Unix() != 1444276597) {
+		t.Errorf("Test Failed - Bitfinex GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	_, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{})
+	if err == nil {
+		t.Error("Test Failed - Bitfinex GetOrderHistory() expected error without a currency pair")
+	}
+
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.BTC, symbol.USD)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Bitfinex GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "446913929" ||
+		orders[0].Status != "FILLED" || orders[0].Price != 246.94 ||
+		orders[0].ExecutedAmount != 1 || orders[0].OrderDate.Unix() != 1444141857) {
+		t.Errorf("Test Failed - Bitfinex GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
+func TestWsChecksum(t *testing.T) {
+	ob := orderbook.Base{
+		Bids: []orderbook.Item{{Price: 6500.5, Amount: 1.25}, {Price: 6500, Amount: 0.5}},
+		Asks: []orderbook.Item{{Price: 6501, Amount: 2}},
+	}
+
+	expected := crc32.ChecksumIEEE([]byte("6500.5:1.25:6501:-2:6500:0.5"))
+	if wsChecksum(ob) != expected {
+		t.Errorf("Test Failed - Bitfinex wsChecksum() expected %d, received %d",
+			expected, wsChecksum(ob))
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -744,7 +823,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.LTC,
 		SecondCurrency: symbol.BTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -770,7 +849,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
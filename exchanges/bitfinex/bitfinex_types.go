@@ -445,6 +445,8 @@ type WebsocketTradeExecuted struct {
 	OrderID        int64
 	AmountExecuted float64
 	PriceExecuted  float64
+	Fee            float64
+	FeeCurrency    string
 }
 
 // ErrorCapture is a simple type for returned errors from Bitfinex
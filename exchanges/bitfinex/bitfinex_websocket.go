@@ -3,7 +3,9 @@ package bitfinex
 import (
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"log"
+	"math"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -15,6 +17,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
 )
 
 const (
@@ -31,7 +34,11 @@ const (
 	bitfinexWebsocketOrderUpdate        = "ou"
 	bitfinexWebsocketOrderCancel        = "oc"
 	bitfinexWebsocketTradeExecuted      = "te"
+	bitfinexWebsocketTradeUpdate        = "tu"
 	bitfinexWebsocketHeartbeat          = "hb"
+	bitfinexWebsocketChecksum           = "cs"
+	bitfinexWebsocketChecksumFlag       = 131072
+	bitfinexWebsocketChecksumDepth      = 25
 	bitfinexWebsocketAlertRestarting    = "20051"
 	bitfinexWebsocketAlertRefreshing    = "20060"
 	bitfinexWebsocketAlertResume        = "20061"
@@ -109,6 +116,81 @@ func (b *Bitfinex) WsSendUnauth() error {
 	return b.WsSend(request)
 }
 
+// wsOrderUpdate converts an account channel order to a normalised order
+// update, a negative amount is a sell order
+func (b *Bitfinex) wsOrderUpdate(o WebsocketOrder) exchange.WebsocketOrderUpdate {
+	side := exchange.Buy
+	if o.OrigAmount < 0 {
+		side = exchange.Sell
+	}
+
+	orderType := exchange.Limit
+	if common.StringContains(o.OrderType, "MARKET") {
+		orderType = exchange.Market
+	}
+
+	// Statuses include the fill, such as "PARTIALLY FILLED @ 107.6(-0.2)"
+	status := common.SplitStrings(o.Status, " @")[0]
+	status = common.SplitStrings(status, " was:")[0]
+
+	timestamp, err := time.Parse(time.RFC3339, o.Timestamp)
+	if err != nil {
+		timestamp = time.Now()
+	}
+
+	return exchange.WebsocketOrderUpdate{
+		Exchange:     b.GetName(),
+		AssetType:    "SPOT",
+		Pair:         pair.NewCurrencyPairFromString(o.Pair),
+		OrderID:      strconv.FormatInt(o.OrderID, 10),
+		Side:         side,
+		OrderType:    orderType,
+		Status:       orders.ParseStatus(status),
+		Price:        o.Price,
+		Amount:       math.Abs(o.OrigAmount),
+		FilledAmount: math.Abs(o.OrigAmount) - math.Abs(o.Amount),
+		AveragePrice: o.PriceAvg,
+		Timestamp:    timestamp,
+	}
+}
+
+// wsOrderFill converts an account channel trade to a normalised order fill
+func (b *Bitfinex) wsOrderFill(t WebsocketTradeExecuted) exchange.WebsocketOrderFill {
+	side := exchange.Buy
+	if t.AmountExecuted < 0 {
+		side = exchange.Sell
+	}
+
+	return exchange.WebsocketOrderFill{
+		Exchange:    b.GetName(),
+		AssetType:   "SPOT",
+		Pair:        pair.NewCurrencyPairFromString(t.Pair),
+		OrderID:     strconv.FormatInt(t.OrderID, 10),
+		TradeID:     strconv.FormatInt(t.TradeID, 10),
+		Side:        side,
+		Price:       t.PriceExecuted,
+		Amount:      math.Abs(t.AmountExecuted),
+		Fee:         math.Abs(t.Fee),
+		FeeCurrency: t.FeeCurrency,
+		Timestamp:   time.Unix(t.Timestamp, 0),
+	}
+}
+
+// wsBalanceUpdate sends a normalised balance update for an exchange wallet,
+// the margin and funding wallets are not traded on the spot market
+func (b *Bitfinex) wsBalanceUpdate(w WebsocketWallet) {
+	if w.Name != "exchange" {
+		return
+	}
+
+	b.Websocket.DataHandler <- exchange.WebsocketBalanceUpdate{
+		Exchange:  b.GetName(),
+		Currency:  common.StringToUpper(w.Currency),
+		Total:     w.Balance,
+		Timestamp: time.Now(),
+	}
+}
+
 // WsAddSubscriptionChannel adds a new subscription channel to the
 // WebsocketSubdChannels map in bitfinex.go (Bitfinex struct)
 func (b *Bitfinex) WsAddSubscriptionChannel(chanID int, channel, pair string) {
@@ -164,6 +246,16 @@ func (b *Bitfinex) WsConnect() error {
 		}
 	}
 
+	// Ask for the book checksum to be sent after each book update
+	b.Websocket.Orderbook.SetChecksum(wsChecksum)
+	err = b.WsSend(map[string]interface{}{
+		"event": "conf",
+		"flags": bitfinexWebsocketChecksumFlag,
+	})
+	if err != nil {
+		return err
+	}
+
 	for _, x := range channels {
 		for _, y := range b.EnabledPairs {
 			params := make(map[string]string)
@@ -178,13 +270,6 @@ func (b *Bitfinex) WsConnect() error {
 		}
 	}
 
-	if b.AuthenticatedAPISupport {
-		err = b.WsSendAuth()
-		if err != nil {
-			return err
-		}
-	}
-
 	pongReceive = make(chan struct{}, 1)
 
 	go b.WsReadData()
@@ -266,6 +351,7 @@ func (b *Bitfinex) WsDataHandler() {
 								eventData["code"].(string))
 
 							b.AuthenticatedAPISupport = false
+							b.Websocket.SetAuthenticated(false)
 						}
 					}
 
@@ -290,6 +376,20 @@ func (b *Bitfinex) WsDataHandler() {
 							}
 						}
 
+						if len(chanData) == 3 && chanData[1] == bitfinexWebsocketChecksum {
+							// Bitfinex sends the checksum as a signed integer
+							err := b.Websocket.Orderbook.VerifyChecksum(pair.NewCurrencyPairFromString(chanInfo.Pair),
+								"SPOT",
+								b.GetName(),
+								uint32(int32(chanData[2].(float64))))
+
+							if err != nil {
+								b.Websocket.DataHandler <- fmt.Errorf("bitfinex_websocket.go verifying orderbook checksum error: %s",
+									err)
+							}
+							continue
+						}
+
 						switch chanInfo.Channel {
 						case "book":
 							newOrderbook := []WebsocketBook{}
@@ -339,6 +439,9 @@ func (b *Bitfinex) WsDataHandler() {
 								ClosePrice: chanData[7].(float64),
 								HighPrice:  chanData[9].(float64),
 								LowPrice:   chanData[10].(float64),
+								BidPrice:   chanData[1].(float64),
+								AskPrice:   chanData[3].(float64),
+								Timestamp:  time.Now(),
 								Pair:       pair.NewCurrencyPairFromString(chanInfo.Pair),
 								Exchange:   b.GetName(),
 								AssetType:  "SPOT",
@@ -392,7 +495,9 @@ func (b *Bitfinex) WsDataHandler() {
 											UnsettledInterest: y[3].(float64)})
 								}
 
-								b.Websocket.DataHandler <- walletSnapshot
+								for i := range walletSnapshot {
+									b.wsBalanceUpdate(walletSnapshot[i])
+								}
 
 							case bitfinexWebsocketWalletUpdate:
 								data := chanData[2].([]interface{})
@@ -402,7 +507,7 @@ func (b *Bitfinex) WsDataHandler() {
 									Balance:           data[2].(float64),
 									UnsettledInterest: data[3].(float64)}
 
-								b.Websocket.DataHandler <- wallet
+								b.wsBalanceUpdate(wallet)
 
 							case bitfinexWebsocketOrderSnapshot:
 								orderSnapshot := []WebsocketOrder{}
@@ -422,7 +527,9 @@ func (b *Bitfinex) WsDataHandler() {
 											Timestamp:  y[8].(string)})
 								}
 
-								b.Websocket.DataHandler <- orderSnapshot
+								for i := range orderSnapshot {
+									b.Websocket.DataHandler <- b.wsOrderUpdate(orderSnapshot[i])
+								}
 
 							case bitfinexWebsocketOrderNew, bitfinexWebsocketOrderUpdate, bitfinexWebsocketOrderCancel:
 								data := chanData[2].([]interface{})
@@ -438,19 +545,23 @@ func (b *Bitfinex) WsDataHandler() {
 									Timestamp:  data[8].(string),
 									Notify:     int(data[9].(float64))}
 
-								b.Websocket.DataHandler <- order
+								b.Websocket.DataHandler <- b.wsOrderUpdate(order)
 
-							case bitfinexWebsocketTradeExecuted:
+							case bitfinexWebsocketTradeUpdate:
+								// Trade executions are followed by a trade
+								// update which includes the fee
 								data := chanData[2].([]interface{})
 								trade := WebsocketTradeExecuted{
-									TradeID:        int64(data[0].(float64)),
-									Pair:           data[1].(string),
-									Timestamp:      int64(data[2].(float64)),
-									OrderID:        int64(data[3].(float64)),
-									AmountExecuted: data[4].(float64),
-									PriceExecuted:  data[5].(float64)}
-
-								b.Websocket.DataHandler <- trade
+									TradeID:        int64(data[1].(float64)),
+									Pair:           data[2].(string),
+									Timestamp:      int64(data[3].(float64)),
+									OrderID:        int64(data[4].(float64)),
+									AmountExecuted: data[5].(float64),
+									PriceExecuted:  data[6].(float64),
+									Fee:            data[9].(float64),
+									FeeCurrency:    data[10].(string)}
+
+								b.Websocket.DataHandler <- b.wsOrderFill(trade)
 							}
 
 						case "trades":
@@ -509,6 +620,26 @@ func (b *Bitfinex) WsDataHandler() {
 	}
 }
 
+// wsChecksum returns the checksum Bitfinex publishes for a book, the CRC32 of
+// the top 25 bids and asks interleaved as price:amount with negative ask
+// amounts
+func wsChecksum(ob orderbook.Base) uint32 {
+	var levels []string
+	for i := 0; i < bitfinexWebsocketChecksumDepth; i++ {
+		if i < len(ob.Bids) {
+			levels = append(levels,
+				strconv.FormatFloat(ob.Bids[i].Price, 'f', -1, 64),
+				strconv.FormatFloat(ob.Bids[i].Amount, 'f', -1, 64))
+		}
+		if i < len(ob.Asks) {
+			levels = append(levels,
+				strconv.FormatFloat(ob.Asks[i].Price, 'f', -1, 64),
+				strconv.FormatFloat(-ob.Asks[i].Amount, 'f', -1, 64))
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(common.JoinStrings(levels, ":")))
+}
+
 // WsInsertSnapshot add the initial orderbook snapshot when subscribed to a
 // channel
 func (b *Bitfinex) WsInsertSnapshot(p pair.CurrencyPair, assetType string, books []WebsocketBook) error {
@@ -1,11 +1,14 @@
 package bitfinex
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -31,7 +34,7 @@ func (b *Bitfinex) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", b.GetName(), len(b.EnabledPairs), b.EnabledPairs)
 	}
 
-	exchangeProducts, err := b.GetSymbols()
+	exchangeProducts, err := b.GetSymbols(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", b.GetName())
 	} else {
@@ -43,7 +46,7 @@ func (b *Bitfinex) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitfinex) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bitfinex) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	enabledPairs := b.GetEnabledCurrencies()
 
@@ -52,7 +55,7 @@ func (b *Bitfinex) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.P
 		pairs = append(pairs, "t"+enabledPairs[x].Pair().String())
 	}
 
-	tickerNew, err := b.GetTickersV2(common.JoinStrings(pairs, ","))
+	tickerNew, err := b.GetTickersV2(ctx, common.JoinStrings(pairs, ","))
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -73,30 +76,30 @@ func (b *Bitfinex) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.P
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bitfinex) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bitfinex) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(b.GetName(), p, ticker.Spot)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (b *Bitfinex) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bitfinex) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitfinex) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bitfinex) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	urlVals := url.Values{}
 	urlVals.Set("limit_bids", "100")
 	urlVals.Set("limit_asks", "100")
-	orderbookNew, err := b.GetOrderbook(p.Pair().String(), urlVals)
+	orderbookNew, err := b.GetOrderbook(ctx, p.Pair().String(), urlVals)
 	if err != nil {
 		return orderBook, err
 	}
@@ -115,10 +118,10 @@ func (b *Bitfinex) UpdateOrderbook(p pair.CurrencyPair, assetType string) (order
 
 // GetAccountInfo retrieves balances for all enabled currencies on the
 // Bitfinex exchange
-func (b *Bitfinex) GetAccountInfo() (exchange.AccountInfo, error) {
+func (b *Bitfinex) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = b.GetName()
-	accountBalance, err := b.GetAccountBalance()
+	accountBalance, err := b.GetAccountBalance(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -162,28 +165,38 @@ func (b *Bitfinex) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bitfinex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bitfinex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitfinex) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (b *Bitfinex) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bitfinex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitfinex) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := b.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
 	var isBuying bool
 
-	if side == exchange.Buy {
+	if order.Side == exchange.Buy {
 		isBuying = true
 	}
 
-	response, err := b.NewOrder(p.Pair().String(), amount, price, isBuying, orderType.ToString(), false)
+	bitfinexOrderType, price, err := orderParams(order.OrderType, order.Price,
+		order.Options)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
+	response, err := b.NewOrder(ctx, order.Pair.Pair().String(), order.Amount, price, isBuying, bitfinexOrderType, false, order.Options.PostOnly)
 
 	if response.OrderID > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response.OrderID)
@@ -191,6 +204,12 @@ func (b *Bitfinex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, ord
 
 	if err == nil {
 		submitOrderResponse.IsOrderPlaced = true
+		submitOrderResponse.FilledAmount = response.ExecutedAmount
+		submitOrderResponse.AveragePrice = response.AverageExecutionPrice
+		timestamp, tsErr := strconv.ParseFloat(response.Timestamp, 64)
+		if tsErr == nil && timestamp > 0 {
+			submitOrderResponse.OrderDate = time.Unix(0, int64(timestamp*float64(time.Second)))
+		}
 	}
 
 	return submitOrderResponse, err
@@ -198,47 +217,74 @@ func (b *Bitfinex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, ord
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bitfinex) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (b *Bitfinex) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bitfinex) CancelOrder(order exchange.OrderCancellation) error {
+func (b *Bitfinex) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = b.CancelExistingOrder(orderIDInt)
+	_, err = b.CancelExistingOrder(ctx, orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bitfinex) CancelAllOrders() error {
+func (b *Bitfinex) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bitfinex) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (b *Bitfinex) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitfinex) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (b *Bitfinex) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (b *Bitfinex) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (b *Bitfinex) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (b *Bitfinex) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (b *Bitfinex) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (b *Bitfinex) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is submitted
-func (b *Bitfinex) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bitfinex) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bitfinex) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bitfinex) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -255,10 +301,125 @@ func (b *Bitfinex) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (b *Bitfinex) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return b.GetFee(feeBuilder)
+	return b.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (b *Bitfinex) GetWithdrawCapabilities() uint32 {
 	return b.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (b *Bitfinex) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := b.GetOpenOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for i := range resp {
+		timestamp, err := strconv.ParseFloat(resp[i].Timestamp, 64)
+		if err != nil {
+			log.Printf("%s unable to parse order time %s: %s", b.Name,
+				resp[i].Timestamp, err)
+		}
+		orderDate := time.Unix(int64(timestamp), 0)
+
+		p := b.GetPairFromSymbol(resp[i].Symbol)
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:       b.Name,
+			ID:             strconv.FormatInt(resp[i].ID, 10),
+			BaseCurrency:   p.FirstCurrency.String(),
+			QuoteCurrency:  p.SecondCurrency.String(),
+			OrderSide:      resp[i].Side,
+			OrderType:      resp[i].Type,
+			CreationTime:   orderDate.Unix(),
+			OrderDate:      orderDate,
+			Price:          resp[i].Price,
+			Amount:         resp[i].OriginalAmount,
+			OpenVolume:     resp[i].RemainingAmount,
+			ExecutedAmount: resp[i].ExecutedAmount,
+		})
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (b *Bitfinex) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	if len(getOrdersRequest.Currencies) == 0 {
+		return nil, errors.New("at least one currency pair is required")
+	}
+
+	// Bitfinex only returns past trades, each fill is reported as a filled
+	// order
+	var orders []exchange.OrderDetail
+	for _, c := range getOrdersRequest.Currencies {
+		resp, err := b.GetTradeHistory(ctx,
+			exchange.FormatExchangeCurrency(b.Name, c).String(),
+			getOrdersRequest.StartTicks, getOrdersRequest.EndTicks, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp {
+			timestamp, err := strconv.ParseFloat(resp[i].Timestamp, 64)
+			if err != nil {
+				log.Printf("%s unable to parse trade time %s: %s", b.Name,
+					resp[i].Timestamp, err)
+			}
+			orderDate := time.Unix(int64(timestamp), 0)
+
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:       b.Name,
+				ID:             strconv.FormatInt(resp[i].OrderID, 10),
+				BaseCurrency:   c.FirstCurrency.String(),
+				QuoteCurrency:  c.SecondCurrency.String(),
+				OrderSide:      resp[i].Type,
+				OrderType:      exchange.Limit.ToString(),
+				CreationTime:   orderDate.Unix(),
+				OrderDate:      orderDate,
+				Status:         "FILLED",
+				Price:          resp[i].Price,
+				Amount:         resp[i].Amount,
+				ExecutedAmount: resp[i].Amount,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (b *Bitfinex) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// orderParams maps an order to a Bitfinex exchange wallet order type and its
+// price. Stops are priced at the stop price and trailing stops at the trailing
+// offset
+func orderParams(orderType exchange.OrderType, price float64, options exchange.OrderOptions) (string, float64, error) {
+	tif := options.GetTimeInForce()
+	if tif != exchange.GoodTillCancel &&
+		(tif != exchange.FillOrKill || orderType != exchange.Limit) {
+		return "", 0, fmt.Errorf("time in force %s is not supported for %s orders",
+			tif, orderType)
+	}
+
+	switch orderType {
+	case exchange.Market:
+		return "exchange market", price, nil
+	case exchange.Limit:
+		if tif == exchange.FillOrKill {
+			return "exchange fill-or-kill", price, nil
+		}
+		return "exchange limit", price, nil
+	case exchange.Stop:
+		return "exchange stop", options.StopPrice, nil
+	case exchange.TrailingStop:
+		return "exchange trailing-stop", options.TrailingOffset, nil
+	}
+	return "", 0, fmt.Errorf("order type %s is not supported", orderType)
+}
@@ -1,6 +1,7 @@
 package bitflyer
 
 import (
+	"context"
 	"log"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -20,6 +22,12 @@ const (
 
 var b Bitflyer
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	b.SetDefaults()
 }
@@ -41,7 +49,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetLatestBlockCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLatestBlockCA()
+	_, err := b.GetLatestBlockCA(context.Background())
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetLatestBlockCA() error:", err)
 	}
@@ -49,7 +57,7 @@ func TestGetLatestBlockCA(t *testing.T) {
 
 func TestGetBlockCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetBlockCA("000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f")
+	_, err := b.GetBlockCA(context.Background(), "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f")
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetBlockCA() error:", err)
 	}
@@ -57,7 +65,7 @@ func TestGetBlockCA(t *testing.T) {
 
 func TestGetBlockbyHeightCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetBlockbyHeightCA(0)
+	_, err := b.GetBlockbyHeightCA(context.Background(), 0)
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetBlockbyHeightCA() error:", err)
 	}
@@ -65,7 +73,7 @@ func TestGetBlockbyHeightCA(t *testing.T) {
 
 func TestGetTransactionByHashCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTransactionByHashCA("0562d1f063cd4127053d838b165630445af5e480ceb24e1fd9ecea52903cb772")
+	_, err := b.GetTransactionByHashCA(context.Background(), "0562d1f063cd4127053d838b165630445af5e480ceb24e1fd9ecea52903cb772")
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetTransactionByHashCA() error:", err)
 	}
@@ -73,7 +81,7 @@ func TestGetTransactionByHashCA(t *testing.T) {
 
 func TestGetAddressInfoCA(t *testing.T) {
 	t.Parallel()
-	v, err := b.GetAddressInfoCA("1F5zVDgNjorJ51oGebSvNCrSAHpwGkUdDB")
+	v, err := b.GetAddressInfoCA(context.Background(), "1F5zVDgNjorJ51oGebSvNCrSAHpwGkUdDB")
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetAddressInfoCA() error:", err)
 	}
@@ -84,7 +92,7 @@ func TestGetAddressInfoCA(t *testing.T) {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetMarkets() error:", err)
 	}
@@ -92,7 +100,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderBook("BTC_JPY")
+	_, err := b.GetOrderBook(context.Background(), "BTC_JPY")
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetOrderBook() error:", err)
 	}
@@ -100,7 +108,7 @@ func TestGetOrderBook(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker("BTC_JPY")
+	_, err := b.GetTicker(context.Background(), "BTC_JPY")
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetTicker() error:", err)
 	}
@@ -108,7 +116,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetExecutionHistory(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExecutionHistory("BTC_JPY")
+	_, err := b.GetExecutionHistory(context.Background(), "BTC_JPY")
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetExecutionHistory() error:", err)
 	}
@@ -116,7 +124,7 @@ func TestGetExecutionHistory(t *testing.T) {
 
 func TestGetExchangeStatus(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExchangeStatus()
+	_, err := b.GetExchangeStatus(context.Background())
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetExchangeStatus() error:", err)
 	}
@@ -143,7 +151,7 @@ func TestGetTickerPrice(t *testing.T) {
 		}
 	}
 
-	_, err := b.GetTickerPrice(p, b.AssetTypes[0])
+	_, err := b.GetTickerPrice(context.Background(), p, b.AssetTypes[0])
 	if err != nil {
 		t.Error("test failed - Bitflyer - GetTickerPrice() error", err)
 	}
@@ -271,7 +279,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.LTC,
 		SecondCurrency: symbol.BTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -297,7 +305,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
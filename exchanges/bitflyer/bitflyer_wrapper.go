@@ -1,9 +1,11 @@
 package bitflyer
 
 import (
+	"context"
 	"errors"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -49,12 +51,12 @@ func (b *Bitflyer) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitflyer) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bitflyer) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 
 	p = b.CheckFXString(p)
 
-	tickerNew, err := b.GetTicker(p.Pair().String())
+	tickerNew, err := b.GetTicker(ctx, p.Pair().String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -71,10 +73,10 @@ func (b *Bitflyer) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.P
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bitflyer) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bitflyer) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(b.GetName(), p, ticker.Spot)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
@@ -89,21 +91,21 @@ func (b *Bitflyer) CheckFXString(p pair.CurrencyPair) pair.CurrencyPair {
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (b *Bitflyer) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bitflyer) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitflyer) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bitflyer) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 
 	p = b.CheckFXString(p)
 
-	orderbookNew, err := b.GetOrderBook(p.Pair().String())
+	orderbookNew, err := b.GetOrderBook(ctx, p.Pair().String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -122,7 +124,7 @@ func (b *Bitflyer) UpdateOrderbook(p pair.CurrencyPair, assetType string) (order
 
 // GetAccountInfo retrieves balances for all enabled currencies on the
 // Bitflyer exchange
-func (b *Bitflyer) GetAccountInfo() (exchange.AccountInfo, error) {
+func (b *Bitflyer) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = b.GetName()
 	// accountBalance, err := b.GetAccountBalance()
@@ -140,20 +142,20 @@ func (b *Bitflyer) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bitflyer) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bitflyer) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitflyer) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (b *Bitflyer) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bitflyer) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitflyer) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
 
 	return submitOrderResponse, common.ErrNotYetImplemented
@@ -161,40 +163,67 @@ func (b *Bitflyer) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, ord
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bitflyer) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (b *Bitflyer) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bitflyer) CancelOrder(order exchange.OrderCancellation) error {
+func (b *Bitflyer) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	return common.ErrNotYetImplemented
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bitflyer) CancelAllOrders() error {
+func (b *Bitflyer) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bitflyer) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (b *Bitflyer) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitflyer) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (b *Bitflyer) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (b *Bitflyer) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (b *Bitflyer) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (b *Bitflyer) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (b *Bitflyer) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (b *Bitflyer) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitflyer) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bitflyer) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bitflyer) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bitflyer) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -213,3 +242,20 @@ func (b *Bitflyer) GetWebsocket() (*exchange.Websocket, error) {
 func (b *Bitflyer) GetWithdrawCapabilities() uint32 {
 	return b.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (b *Bitflyer) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (b *Bitflyer) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (b *Bitflyer) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
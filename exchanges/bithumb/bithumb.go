@@ -2,6 +2,7 @@ package bithumb
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -120,8 +121,8 @@ func (b *Bithumb) Setup(exch config.ExchangeConfig) {
 }
 
 // GetTradablePairs returns a list of tradable currencies
-func (b *Bithumb) GetTradablePairs() ([]string, error) {
-	result, err := b.GetAllTickers()
+func (b *Bithumb) GetTradablePairs(ctx context.Context) ([]string, error) {
+	result, err := b.GetAllTickers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -136,11 +137,11 @@ func (b *Bithumb) GetTradablePairs() ([]string, error) {
 // GetTicker returns ticker information
 //
 // symbol e.g. "btc"
-func (b *Bithumb) GetTicker(symbol string) (Ticker, error) {
+func (b *Bithumb) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	response := Ticker{}
 	path := fmt.Sprintf("%s%s%s", b.APIUrl, publicTicker, common.StringToUpper(symbol))
 
-	err := b.SendHTTPRequest(path, &response)
+	err := b.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return response, err
 	}
@@ -153,7 +154,7 @@ func (b *Bithumb) GetTicker(symbol string) (Ticker, error) {
 }
 
 // GetAllTickers returns all ticker information
-func (b *Bithumb) GetAllTickers() (map[string]Ticker, error) {
+func (b *Bithumb) GetAllTickers(ctx context.Context) (map[string]Ticker, error) {
 	type Response struct {
 		ActionStatus
 		Data map[string]interface{}
@@ -162,7 +163,7 @@ func (b *Bithumb) GetAllTickers() (map[string]Ticker, error) {
 	response := Response{}
 	path := fmt.Sprintf("%s%s%s", b.APIUrl, publicTicker, "all")
 
-	err := b.SendHTTPRequest(path, &response)
+	err := b.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -198,11 +199,11 @@ func (b *Bithumb) GetAllTickers() (map[string]Ticker, error) {
 // GetOrderBook returns current orderbook
 //
 // symbol e.g. "btc"
-func (b *Bithumb) GetOrderBook(symbol string) (Orderbook, error) {
+func (b *Bithumb) GetOrderBook(ctx context.Context, symbol string) (Orderbook, error) {
 	response := Orderbook{}
 	path := fmt.Sprintf("%s%s%s", b.APIUrl, publicOrderBook, common.StringToUpper(symbol))
 
-	err := b.SendHTTPRequest(path, &response)
+	err := b.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return response, err
 	}
@@ -217,11 +218,11 @@ func (b *Bithumb) GetOrderBook(symbol string) (Orderbook, error) {
 // GetTransactionHistory returns recent transactions
 //
 // symbol e.g. "btc"
-func (b *Bithumb) GetTransactionHistory(symbol string) (TransactionHistory, error) {
+func (b *Bithumb) GetTransactionHistory(ctx context.Context, symbol string) (TransactionHistory, error) {
 	response := TransactionHistory{}
 	path := fmt.Sprintf("%s%s%s", b.APIUrl, publicTransactionHistory, common.StringToUpper(symbol))
 
-	err := b.SendHTTPRequest(path, &response)
+	err := b.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return response, err
 	}
@@ -234,10 +235,10 @@ func (b *Bithumb) GetTransactionHistory(symbol string) (TransactionHistory, erro
 }
 
 // GetAccountInformation returns account information
-func (b *Bithumb) GetAccountInformation() (Account, error) {
+func (b *Bithumb) GetAccountInformation(ctx context.Context) (Account, error) {
 	response := Account{}
 
-	err := b.SendAuthenticatedHTTPRequest(privateAccInfo, nil, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateAccInfo, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -249,10 +250,10 @@ func (b *Bithumb) GetAccountInformation() (Account, error) {
 }
 
 // GetAccountBalance returns customer wallet information
-func (b *Bithumb) GetAccountBalance() (Balance, error) {
+func (b *Bithumb) GetAccountBalance(ctx context.Context) (Balance, error) {
 	response := Balance{}
 
-	err := b.SendAuthenticatedHTTPRequest(privateAccBalance, nil, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateAccBalance, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -266,12 +267,12 @@ func (b *Bithumb) GetAccountBalance() (Balance, error) {
 // GetWalletAddress returns customer wallet address
 //
 // currency e.g. btc, ltc or "", will default to btc without currency specified
-func (b *Bithumb) GetWalletAddress(currency string) (WalletAddressRes, error) {
+func (b *Bithumb) GetWalletAddress(ctx context.Context, currency string) (WalletAddressRes, error) {
 	response := WalletAddressRes{}
 	params := url.Values{}
 	params.Set("currency", common.StringToUpper(currency))
 
-	err := b.SendAuthenticatedHTTPRequest(privateWalletAdd, params, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateWalletAdd, params, &response)
 	if err != nil {
 		return response, err
 	}
@@ -283,10 +284,10 @@ func (b *Bithumb) GetWalletAddress(currency string) (WalletAddressRes, error) {
 }
 
 // GetLastTransaction returns customer last transaction
-func (b *Bithumb) GetLastTransaction() (LastTransactionTicker, error) {
+func (b *Bithumb) GetLastTransaction(ctx context.Context) (LastTransactionTicker, error) {
 	response := LastTransactionTicker{}
 
-	err := b.SendAuthenticatedHTTPRequest(privateTicker, nil, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateTicker, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -304,7 +305,7 @@ func (b *Bithumb) GetLastTransaction() (LastTransactionTicker, error) {
 // count: Value : 1 ~1000 (default : 100)
 // after: YYYY-MM-DD hh:mm:ss's UNIX Timestamp
 // (2014-11-28 16:40:01 = 1417160401000)
-func (b *Bithumb) GetOrders(orderID, transactionType, count, after, currency string) (Orders, error) {
+func (b *Bithumb) GetOrders(ctx context.Context, orderID, transactionType, count, after, currency string) (Orders, error) {
 	response := Orders{}
 
 	params := url.Values{}
@@ -314,7 +315,7 @@ func (b *Bithumb) GetOrders(orderID, transactionType, count, after, currency str
 	params.Set("after", after)
 	params.Set("currency", common.StringToUpper(currency))
 
-	err := b.SendAuthenticatedHTTPRequest(privateOrders, params, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateOrders, params, &response)
 	if err != nil {
 		return response, err
 	}
@@ -326,10 +327,10 @@ func (b *Bithumb) GetOrders(orderID, transactionType, count, after, currency str
 }
 
 // GetUserTransactions returns customer transactions
-func (b *Bithumb) GetUserTransactions() (UserTransactions, error) {
+func (b *Bithumb) GetUserTransactions(ctx context.Context) (UserTransactions, error) {
 	response := UserTransactions{}
 
-	err := b.SendAuthenticatedHTTPRequest(privateUserTrans, nil, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateUserTrans, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -347,7 +348,7 @@ func (b *Bithumb) GetUserTransactions() (UserTransactions, error) {
 // transactionType: Transaction type(bid : purchase, ask : sales)
 // units: Order quantity
 // price: Transaction amount per currency
-func (b *Bithumb) PlaceTrade(orderCurrency, transactionType string, units float64, price int64) (OrderPlace, error) {
+func (b *Bithumb) PlaceTrade(ctx context.Context, orderCurrency, transactionType string, units float64, price int64) (OrderPlace, error) {
 	response := OrderPlace{}
 
 	params := url.Values{}
@@ -357,7 +358,7 @@ func (b *Bithumb) PlaceTrade(orderCurrency, transactionType string, units float6
 	params.Set("units", strconv.FormatFloat(units, 'f', -1, 64))
 	params.Set("price", strconv.FormatInt(price, 10))
 
-	err := b.SendAuthenticatedHTTPRequest(privatePlaceTrade, params, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privatePlaceTrade, params, &response)
 	if err != nil {
 		return response, err
 	}
@@ -374,7 +375,7 @@ func (b *Bithumb) PlaceTrade(orderCurrency, transactionType string, units float6
 // transactionType: Transaction type(bid : purchase, ask : sales)
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
-func (b *Bithumb) GetOrderDetails(orderID, transactionType, currency string) (OrderDetails, error) {
+func (b *Bithumb) GetOrderDetails(ctx context.Context, orderID, transactionType, currency string) (OrderDetails, error) {
 	response := OrderDetails{}
 
 	params := url.Values{}
@@ -382,7 +383,7 @@ func (b *Bithumb) GetOrderDetails(orderID, transactionType, currency string) (Or
 	params.Set("type", common.StringToUpper(transactionType))
 	params.Set("currency", common.StringToUpper(currency))
 
-	err := b.SendAuthenticatedHTTPRequest(privateOrderDetail, params, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateOrderDetail, params, &response)
 	if err != nil {
 		return response, err
 	}
@@ -398,7 +399,7 @@ func (b *Bithumb) GetOrderDetails(orderID, transactionType, currency string) (Or
 // orderID: Order number registered for purchase/sales
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
-func (b *Bithumb) CancelTrade(transactionType, orderID, currency string) (ActionStatus, error) {
+func (b *Bithumb) CancelTrade(ctx context.Context, transactionType, orderID, currency string) (ActionStatus, error) {
 	response := ActionStatus{}
 
 	params := url.Values{}
@@ -406,7 +407,7 @@ func (b *Bithumb) CancelTrade(transactionType, orderID, currency string) (Action
 	params.Set("type", common.StringToUpper(transactionType))
 	params.Set("currency", common.StringToUpper(currency))
 
-	err := b.SendAuthenticatedHTTPRequest(privateCancelTrade, nil, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateCancelTrade, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -425,7 +426,7 @@ func (b *Bithumb) CancelTrade(transactionType, orderID, currency string) (Action
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM
 // (default value: BTC)
 // units: Quantity to withdraw currency
-func (b *Bithumb) WithdrawCrypto(address, destination, currency string, units float64) (ActionStatus, error) {
+func (b *Bithumb) WithdrawCrypto(ctx context.Context, address, destination, currency string, units float64) (ActionStatus, error) {
 	response := ActionStatus{}
 
 	params := url.Values{}
@@ -434,7 +435,7 @@ func (b *Bithumb) WithdrawCrypto(address, destination, currency string, units fl
 	params.Set("currency", common.StringToUpper(currency))
 	params.Set("units", strconv.FormatFloat(units, 'f', -1, 64))
 
-	err := b.SendAuthenticatedHTTPRequest(privateBTCWithdraw, params, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateBTCWithdraw, params, &response)
 	if err != nil {
 		return response, err
 	}
@@ -447,10 +448,10 @@ func (b *Bithumb) WithdrawCrypto(address, destination, currency string, units fl
 
 // RequestKRWDepositDetails returns Bithumb banking details for deposit
 // information
-func (b *Bithumb) RequestKRWDepositDetails() (KRWDeposit, error) {
+func (b *Bithumb) RequestKRWDepositDetails(ctx context.Context) (KRWDeposit, error) {
 	response := KRWDeposit{}
 
-	err := b.SendAuthenticatedHTTPRequest(privateKRWDeposit, nil, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateKRWDeposit, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -466,7 +467,7 @@ func (b *Bithumb) RequestKRWDepositDetails() (KRWDeposit, error) {
 // bank: Bankcode with bank name e.g. (bankcode)_(bankname)
 // account: Withdrawing bank account number
 // price: 	Withdrawing amount
-func (b *Bithumb) RequestKRWWithdraw(bank, account string, price int64) (ActionStatus, error) {
+func (b *Bithumb) RequestKRWWithdraw(ctx context.Context, bank, account string, price int64) (ActionStatus, error) {
 	response := ActionStatus{}
 
 	params := url.Values{}
@@ -474,7 +475,7 @@ func (b *Bithumb) RequestKRWWithdraw(bank, account string, price int64) (ActionS
 	params.Set("account", account)
 	params.Set("price", strconv.FormatInt(price, 10))
 
-	err := b.SendAuthenticatedHTTPRequest(privateKRWWithdraw, params, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateKRWWithdraw, params, &response)
 	if err != nil {
 		return response, err
 	}
@@ -490,14 +491,14 @@ func (b *Bithumb) RequestKRWWithdraw(bank, account string, price int64) (ActionS
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
 // units: Order quantity
-func (b *Bithumb) MarketBuyOrder(currency string, units float64) (MarketBuy, error) {
+func (b *Bithumb) MarketBuyOrder(ctx context.Context, currency string, units float64) (MarketBuy, error) {
 	response := MarketBuy{}
 
 	params := url.Values{}
 	params.Set("currency", common.StringToUpper(currency))
 	params.Set("units", strconv.FormatFloat(units, 'f', -1, 64))
 
-	err := b.SendAuthenticatedHTTPRequest(privateMarketBuy, params, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateMarketBuy, params, &response)
 	if err != nil {
 		return response, err
 	}
@@ -513,14 +514,14 @@ func (b *Bithumb) MarketBuyOrder(currency string, units float64) (MarketBuy, err
 // currency: BTC, ETH, DASH, LTC, ETC, XRP, BCH, XMR, ZEC, QTUM, BTG, EOS
 // (default value: BTC)
 // units: Order quantity
-func (b *Bithumb) MarketSellOrder(currency string, units float64) (MarketSell, error) {
+func (b *Bithumb) MarketSellOrder(ctx context.Context, currency string, units float64) (MarketSell, error) {
 	response := MarketSell{}
 
 	params := url.Values{}
 	params.Set("currency", common.StringToUpper(currency))
 	params.Set("units", strconv.FormatFloat(units, 'f', -1, 64))
 
-	err := b.SendAuthenticatedHTTPRequest(privateMarketSell, params, &response)
+	err := b.SendAuthenticatedHTTPRequest(ctx, privateMarketSell, params, &response)
 	if err != nil {
 		return response, err
 	}
@@ -532,12 +533,12 @@ func (b *Bithumb) MarketSellOrder(currency string, units float64) (MarketSell, e
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (b *Bithumb) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload("GET", path, nil, nil, result, false, b.Verbose)
+func (b *Bithumb) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return b.SendPayload(ctx, "GET", path, nil, nil, result, false, b.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to bithumb
-func (b *Bithumb) SendAuthenticatedHTTPRequest(path string, params url.Values, result interface{}) error {
+func (b *Bithumb) SendAuthenticatedHTTPRequest(ctx context.Context, path string, params url.Values, result interface{}) error {
 	if !b.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -564,7 +565,7 @@ func (b *Bithumb) SendAuthenticatedHTTPRequest(path string, params url.Values, r
 	headers["Api-Nonce"] = b.Nonce.String()
 	headers["Content-Type"] = "application/x-www-form-urlencoded"
 
-	return b.SendPayload("POST", b.APIUrl+path, headers, bytes.NewBufferString(payload), result, true, b.Verbose)
+	return b.SendPayload(ctx, "POST", b.APIUrl+path, headers, bytes.NewBufferString(payload), result, true, b.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
@@ -1,12 +1,14 @@
 package bithumb
 
 import (
+	"context"
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -18,6 +20,12 @@ const (
 
 var b Bithumb
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	b.SetDefaults()
 }
@@ -39,7 +47,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetTradablePairs(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTradablePairs()
+	_, err := b.GetTradablePairs(context.Background())
 	if err != nil {
 		t.Error("test failed - Bithumb GetTradablePairs() error", err)
 	}
@@ -47,7 +55,7 @@ func TestGetTradablePairs(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker("btc")
+	_, err := b.GetTicker(context.Background(), "btc")
 	if err != nil {
 		t.Error("test failed - Bithumb GetTicker() error", err)
 	}
@@ -55,7 +63,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetAllTickers(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetAllTickers()
+	_, err := b.GetAllTickers(context.Background())
 	if err != nil {
 		t.Error("test failed - Bithumb GetAllTickers() error", err)
 	}
@@ -63,7 +71,7 @@ func TestGetAllTickers(t *testing.T) {
 
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderBook("btc")
+	_, err := b.GetOrderBook(context.Background(), "btc")
 	if err != nil {
 		t.Error("test failed - Bithumb GetOrderBook() error", err)
 	}
@@ -71,7 +79,7 @@ func TestGetOrderBook(t *testing.T) {
 
 func TestGetTransactionHistory(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTransactionHistory("btc")
+	_, err := b.GetTransactionHistory(context.Background(), "btc")
 	if err != nil {
 		t.Error("test failed - Bithumb GetTransactionHistory() error", err)
 	}
@@ -79,7 +87,7 @@ func TestGetTransactionHistory(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetAccountInfo()
+	_, err := b.GetAccountInfo(context.Background())
 	if err == nil {
 		t.Error("test failed - Bithumb GetAccountInfo() error", err)
 	}
@@ -87,7 +95,7 @@ func TestGetAccountInfo(t *testing.T) {
 
 func TestGetAccountBalance(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetAccountBalance()
+	_, err := b.GetAccountBalance(context.Background())
 	if err == nil {
 		t.Error("test failed - Bithumb GetAccountBalance() error", err)
 	}
@@ -95,7 +103,7 @@ func TestGetAccountBalance(t *testing.T) {
 
 func TestGetWalletAddress(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetWalletAddress("")
+	_, err := b.GetWalletAddress(context.Background(), "")
 	if err == nil {
 		t.Error("test failed - Bithumb GetWalletAddress() error", err)
 	}
@@ -103,7 +111,7 @@ func TestGetWalletAddress(t *testing.T) {
 
 func TestGetLastTransaction(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLastTransaction()
+	_, err := b.GetLastTransaction(context.Background())
 	if err == nil {
 		t.Error("test failed - Bithumb GetLastTransaction() error", err)
 	}
@@ -111,7 +119,7 @@ func TestGetLastTransaction(t *testing.T) {
 
 func TestGetOrders(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrders("1337", "bid", "100", "", "BTC")
+	_, err := b.GetOrders(context.Background(), "1337", "bid", "100", "", "BTC")
 	if err == nil {
 		t.Error("test failed - Bithumb GetOrders() error", err)
 	}
@@ -119,7 +127,7 @@ func TestGetOrders(t *testing.T) {
 
 func TestGetUserTransactions(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetUserTransactions()
+	_, err := b.GetUserTransactions(context.Background())
 	if err == nil {
 		t.Error("test failed - Bithumb GetUserTransactions() error", err)
 	}
@@ -127,7 +135,7 @@ func TestGetUserTransactions(t *testing.T) {
 
 func TestPlaceTrade(t *testing.T) {
 	t.Parallel()
-	_, err := b.PlaceTrade("btc", "bid", 0, 0)
+	_, err := b.PlaceTrade(context.Background(), "btc", "bid", 0, 0)
 	if err == nil {
 		t.Error("test failed - Bithumb PlaceTrade() error", err)
 	}
@@ -135,7 +143,7 @@ func TestPlaceTrade(t *testing.T) {
 
 func TestGetOrderDetails(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderDetails("1337", "bid", "btc")
+	_, err := b.GetOrderDetails(context.Background(), "1337", "bid", "btc")
 	if err == nil {
 		t.Error("test failed - Bithumb GetOrderDetails() error", err)
 	}
@@ -143,7 +151,7 @@ func TestGetOrderDetails(t *testing.T) {
 
 func TestCancelTrade(t *testing.T) {
 	t.Parallel()
-	_, err := b.CancelTrade("", "", "")
+	_, err := b.CancelTrade(context.Background(), "", "", "")
 	if err == nil {
 		t.Error("test failed - Bithumb CancelTrade() error", err)
 	}
@@ -151,7 +159,7 @@ func TestCancelTrade(t *testing.T) {
 
 func TestWithdrawCrypto(t *testing.T) {
 	t.Parallel()
-	_, err := b.WithdrawCrypto("LQxiDhKU7idKiWQhx4ALKYkBx8xKEQVxJR", "", "ltc", 0)
+	_, err := b.WithdrawCrypto(context.Background(), "LQxiDhKU7idKiWQhx4ALKYkBx8xKEQVxJR", "", "ltc", 0)
 	if err == nil {
 		t.Error("test failed - Bithumb WithdrawCrypto() error", err)
 	}
@@ -159,7 +167,7 @@ func TestWithdrawCrypto(t *testing.T) {
 
 func TestRequestKRWDepositDetails(t *testing.T) {
 	t.Parallel()
-	_, err := b.RequestKRWDepositDetails()
+	_, err := b.RequestKRWDepositDetails(context.Background())
 	if err == nil {
 		t.Error("test failed - Bithumb RequestKRWDepositDetails() error", err)
 	}
@@ -167,7 +175,7 @@ func TestRequestKRWDepositDetails(t *testing.T) {
 
 func TestRequestKRWWithdraw(t *testing.T) {
 	t.Parallel()
-	_, err := b.RequestKRWWithdraw("102_bank", "1337", 1000)
+	_, err := b.RequestKRWWithdraw(context.Background(), "102_bank", "1337", 1000)
 	if err == nil {
 		t.Error("test failed - Bithumb RequestKRWWithdraw() error", err)
 	}
@@ -175,7 +183,7 @@ func TestRequestKRWWithdraw(t *testing.T) {
 
 func TestMarketBuyOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.MarketBuyOrder("btc", 0)
+	_, err := b.MarketBuyOrder(context.Background(), "btc", 0)
 	if err == nil {
 		t.Error("test failed - Bithumb MarketBuyOrder() error", err)
 	}
@@ -183,7 +191,7 @@ func TestMarketBuyOrder(t *testing.T) {
 
 func TestMarketSellOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.MarketSellOrder("btc", 0)
+	_, err := b.MarketSellOrder(context.Background(), "btc", 0)
 	if err == nil {
 		t.Error("test failed - Bithumb MarketSellOrder() error", err)
 	}
@@ -284,6 +292,65 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Bithumb using the credentials of the recorded
+// fixture
+func replayExchange(t *testing.T) *Bithumb {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	bitConfig, err := cfg.GetExchangeConfig("Bithumb")
+	if err != nil {
+		t.Fatal("Test Failed - Bithumb Setup() init error")
+	}
+
+	bitConfig.AuthenticatedAPISupport = true
+	bitConfig.APIKey, bitConfig.APISecret = request.FixtureCredentials(t, testAPIKey, testAPISecret)
+
+	var r Bithumb
+	r.SetDefaults()
+	r.Setup(bitConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	_, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err == nil {
+		t.Error("Test Failed - Bithumb GetActiveOrders() expected an error without a currency pair")
+	}
+
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.BTC, symbol.KRW)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Bithumb GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "1546300800123" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].QuoteCurrency != symbol.KRW ||
+		orders[0].OrderSide != exchange.Buy.ToString() || orders[0].Status != "PARTIALLY_FILLED" ||
+		orders[0].OpenVolume != 0.5 || orders[0].ExecutedAmount != 0.25 ||
+		orders[0].OrderDate.Unix() != 1546300800) {
+		t.Errorf("Test Failed - Bithumb GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.BTC, symbol.KRW)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Bithumb GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "1546214400456" ||
+		orders[0].OrderSide != exchange.Sell.ToString() || orders[0].Status != "FILLED" ||
+		orders[0].Price != 4250000 || orders[0].ExecutedAmount != 0.1) {
+		t.Errorf("Test Failed - Bithumb GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -308,7 +375,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -334,7 +401,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
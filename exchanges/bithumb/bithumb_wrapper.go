@@ -1,10 +1,13 @@
 package bithumb
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -30,7 +33,7 @@ func (b *Bithumb) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", b.GetName(), len(b.EnabledPairs), b.EnabledPairs)
 	}
 
-	exchangeProducts, err := b.GetTradingPairs()
+	exchangeProducts, err := b.GetTradingPairs(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", b.GetName())
 	} else {
@@ -42,8 +45,8 @@ func (b *Bithumb) Run() {
 }
 
 // GetTradingPairs gets the available trading currencies
-func (b *Bithumb) GetTradingPairs() ([]string, error) {
-	currencies, err := b.GetTradablePairs()
+func (b *Bithumb) GetTradingPairs(ctx context.Context) ([]string, error) {
+	currencies, err := b.GetTradablePairs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -56,10 +59,10 @@ func (b *Bithumb) GetTradingPairs() ([]string, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bithumb) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bithumb) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 
-	tickers, err := b.GetAllTickers()
+	tickers, err := b.GetAllTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -80,29 +83,29 @@ func (b *Bithumb) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pr
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bithumb) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bithumb) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (b *Bithumb) GetOrderbookEx(currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bithumb) GetOrderbookEx(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(b.GetName(), currency, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(currency, assetType)
+		return b.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bithumb) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bithumb) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	currency := p.FirstCurrency.String()
 
-	orderbookNew, err := b.GetOrderBook(currency)
+	orderbookNew, err := b.GetOrderBook(ctx, currency)
 	if err != nil {
 		return orderBook, err
 	}
@@ -121,37 +124,41 @@ func (b *Bithumb) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderb
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // Bithumb exchange
-func (b *Bithumb) GetAccountInfo() (exchange.AccountInfo, error) {
+func (b *Bithumb) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	return response, errors.New("not implemented")
 }
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bithumb) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bithumb) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bithumb) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (b *Bithumb) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bithumb) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bithumb) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := b.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
 	var err error
 	var orderID string
-	if side == exchange.Buy {
+	if order.Side == exchange.Buy {
 		var result MarketBuy
-		result, err = b.MarketBuyOrder(p.FirstCurrency.String(), amount)
+		result, err = b.MarketBuyOrder(ctx, order.Pair.FirstCurrency.String(), order.Amount)
 		orderID = result.OrderID
-	} else if side == exchange.Sell {
+	} else if order.Side == exchange.Sell {
 		var result MarketSell
-		result, err = b.MarketSellOrder(p.FirstCurrency.String(), amount)
+		result, err = b.MarketSellOrder(ctx, order.Pair.FirstCurrency.String(), order.Amount)
 		orderID = result.OrderID
 	}
 
@@ -168,41 +175,68 @@ func (b *Bithumb) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orde
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bithumb) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (b *Bithumb) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bithumb) CancelOrder(order exchange.OrderCancellation) error {
-	_, err := b.CancelTrade(order.Side.ToString(), order.OrderID, order.CurrencyPair.FirstCurrency.String())
+func (b *Bithumb) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
+	_, err := b.CancelTrade(ctx, order.Side.ToString(), order.OrderID, order.CurrencyPair.FirstCurrency.String())
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bithumb) CancelAllOrders() error {
+func (b *Bithumb) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bithumb) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (b *Bithumb) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bithumb) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (b *Bithumb) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (b *Bithumb) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (b *Bithumb) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (b *Bithumb) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (b *Bithumb) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (b *Bithumb) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bithumb) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bithumb) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bithumb) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bithumb) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -226,3 +260,88 @@ func (b *Bithumb) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error)
 func (b *Bithumb) GetWithdrawCapabilities() uint32 {
 	return b.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (b *Bithumb) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return b.getOrders(ctx, getOrdersRequest, true)
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (b *Bithumb) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return b.getOrders(ctx, getOrdersRequest, false)
+}
+
+// getOrders returns the open or closed orders of each requested currency pair,
+// Bithumb queries orders per order currency so at least one currency pair must
+// be requested
+func (b *Bithumb) getOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest, open bool) ([]exchange.OrderDetail, error) {
+	if len(getOrdersRequest.Currencies) == 0 {
+		return nil, errors.New("at least one currency pair is required")
+	}
+
+	var after string
+	if !getOrdersRequest.StartTicks.IsZero() {
+		after = strconv.FormatInt(getOrdersRequest.StartTicks.UnixNano()/int64(time.Millisecond), 10)
+	}
+
+	var orders []exchange.OrderDetail
+	for _, c := range getOrdersRequest.Currencies {
+		resp, err := b.GetOrders(ctx, "", "", "1000", after, c.FirstCurrency.String())
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp.Data {
+			// placed orders are still open, completed and cancelled orders
+			// are history
+			order := resp.Data[i]
+			if (order.Status == "placed") != open {
+				continue
+			}
+
+			side := exchange.Buy
+			if order.Type == "ask" {
+				side = exchange.Sell
+			}
+
+			executed := order.Units - order.UnitsRemaining
+			var status string
+			switch {
+			case order.Status == "cancel":
+				status = "CANCELLED"
+			case order.UnitsRemaining == 0:
+				status = "FILLED"
+			case executed > 0:
+				status = "PARTIALLY_FILLED"
+			default:
+				status = "NEW"
+			}
+
+			orderDate := time.Unix(0, order.OrderDate*int64(time.Millisecond))
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:       b.Name,
+				ID:             order.OrderID,
+				BaseCurrency:   common.StringToUpper(order.OrderCurrency),
+				QuoteCurrency:  common.StringToUpper(order.PaymentCurrency),
+				OrderSide:      side.ToString(),
+				OrderType:      exchange.Limit.ToString(),
+				CreationTime:   orderDate.Unix(),
+				OrderDate:      orderDate,
+				Status:         status,
+				Price:          order.Price,
+				Amount:         order.Units,
+				OpenVolume:     order.UnitsRemaining,
+				ExecutedAmount: executed,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (b *Bithumb) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
@@ -1,6 +1,7 @@
 package bitmex
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
@@ -9,6 +10,8 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply your own keys here for due diligence testing
@@ -20,6 +23,12 @@ const (
 
 var b Bitmex
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	b.SetDefaults()
 }
@@ -46,49 +55,49 @@ func TestStart(t *testing.T) {
 }
 
 func TestGetUrgentAnnouncement(t *testing.T) {
-	_, err := b.GetUrgentAnnouncement()
+	_, err := b.GetUrgentAnnouncement(context.Background())
 	if err == nil {
 		t.Error("test failed - GetUrgentAnnouncement() error", err)
 	}
 }
 
 func TestGetAPIKeys(t *testing.T) {
-	_, err := b.GetAPIKeys()
+	_, err := b.GetAPIKeys(context.Background())
 	if err == nil {
 		t.Error("test failed - GetAPIKeys() error", err)
 	}
 }
 
 func TestRemoveAPIKey(t *testing.T) {
-	_, err := b.RemoveAPIKey(APIKeyParams{APIKeyID: "1337"})
+	_, err := b.RemoveAPIKey(context.Background(), APIKeyParams{APIKeyID: "1337"})
 	if err == nil {
 		t.Error("test failed - RemoveAPIKey() error", err)
 	}
 }
 
 func TestDisableAPIKey(t *testing.T) {
-	_, err := b.DisableAPIKey(APIKeyParams{APIKeyID: "1337"})
+	_, err := b.DisableAPIKey(context.Background(), APIKeyParams{APIKeyID: "1337"})
 	if err == nil {
 		t.Error("test failed - DisableAPIKey() error", err)
 	}
 }
 
 func TestEnableAPIKey(t *testing.T) {
-	_, err := b.EnableAPIKey(APIKeyParams{APIKeyID: "1337"})
+	_, err := b.EnableAPIKey(context.Background(), APIKeyParams{APIKeyID: "1337"})
 	if err == nil {
 		t.Error("test failed - EnableAPIKey() error", err)
 	}
 }
 
 func TestGetTrollboxMessages(t *testing.T) {
-	_, err := b.GetTrollboxMessages(ChatGetParams{Count: 5})
+	_, err := b.GetTrollboxMessages(context.Background(), ChatGetParams{Count: 5})
 	if err != nil {
 		t.Error("test failed - GetTrollboxMessages() error", err)
 	}
 }
 
 func TestSendTrollboxMessage(t *testing.T) {
-	_, err := b.SendTrollboxMessage(ChatSendParams{
+	_, err := b.SendTrollboxMessage(context.Background(), ChatSendParams{
 		ChannelID: 1337,
 		Message:   "Hello,World!"})
 	if err == nil {
@@ -97,133 +106,133 @@ func TestSendTrollboxMessage(t *testing.T) {
 }
 
 func TestGetTrollboxChannels(t *testing.T) {
-	_, err := b.GetTrollboxChannels()
+	_, err := b.GetTrollboxChannels(context.Background())
 	if err != nil {
 		t.Error("test failed - GetTrollboxChannels() error", err)
 	}
 }
 
 func TestGetTrollboxConnectedUsers(t *testing.T) {
-	_, err := b.GetTrollboxConnectedUsers()
+	_, err := b.GetTrollboxConnectedUsers(context.Background())
 	if err == nil {
 		t.Error("test failed - GetTrollboxConnectedUsers() error", err)
 	}
 }
 
 func TestGetAccountExecutions(t *testing.T) {
-	_, err := b.GetAccountExecutions(GenericRequestParams{})
+	_, err := b.GetAccountExecutions(context.Background(), GenericRequestParams{})
 	if err == nil {
 		t.Error("test failed - GetAccountExecutions() error", err)
 	}
 }
 
 func TestGetAccountExecutionTradeHistory(t *testing.T) {
-	_, err := b.GetAccountExecutionTradeHistory(GenericRequestParams{})
+	_, err := b.GetAccountExecutionTradeHistory(context.Background(), GenericRequestParams{})
 	if err == nil {
 		t.Error("test failed - GetAccountExecutionTradeHistory() error", err)
 	}
 }
 
 func TestGetFundingHistory(t *testing.T) {
-	_, err := b.GetFundingHistory()
+	_, err := b.GetFundingHistory(context.Background())
 	if err == nil {
 		t.Error("test failed - GetFundingHistory() error", err)
 	}
 }
 
 func TestGetInstruments(t *testing.T) {
-	_, err := b.GetInstruments(GenericRequestParams{})
+	_, err := b.GetInstruments(context.Background(), GenericRequestParams{})
 	if err != nil {
 		t.Error("test failed - GetInstruments() error", err)
 	}
 }
 
 func TestGetActiveInstruments(t *testing.T) {
-	_, err := b.GetActiveInstruments(GenericRequestParams{})
+	_, err := b.GetActiveInstruments(context.Background(), GenericRequestParams{})
 	if err != nil {
 		t.Error("test failed - GetActiveInstruments() error", err)
 	}
 }
 
 func TestGetActiveAndIndexInstruments(t *testing.T) {
-	_, err := b.GetActiveAndIndexInstruments()
+	_, err := b.GetActiveAndIndexInstruments(context.Background())
 	if err != nil {
 		t.Error("test failed - GetActiveAndIndexInstruments() error", err)
 	}
 }
 
 func TestGetActiveIntervals(t *testing.T) {
-	_, err := b.GetActiveIntervals()
+	_, err := b.GetActiveIntervals(context.Background())
 	if err == nil {
 		t.Error("test failed - GetActiveIntervals() error", err)
 	}
 }
 
 func TestGetCompositeIndex(t *testing.T) {
-	_, err := b.GetCompositeIndex(GenericRequestParams{})
+	_, err := b.GetCompositeIndex(context.Background(), GenericRequestParams{})
 	if err == nil {
 		t.Error("test failed - GetCompositeIndex() error", err)
 	}
 }
 
 func TestGetIndices(t *testing.T) {
-	_, err := b.GetIndices()
+	_, err := b.GetIndices(context.Background())
 	if err != nil {
 		t.Error("test failed - GetIndices() error", err)
 	}
 }
 
 func TestGetInsuranceFundHistory(t *testing.T) {
-	_, err := b.GetInsuranceFundHistory(GenericRequestParams{})
+	_, err := b.GetInsuranceFundHistory(context.Background(), GenericRequestParams{})
 	if err != nil {
 		t.Error("test failed - GetInsuranceFundHistory() error", err)
 	}
 }
 
 func TestGetLeaderboard(t *testing.T) {
-	_, err := b.GetLeaderboard(LeaderboardGetParams{})
+	_, err := b.GetLeaderboard(context.Background(), LeaderboardGetParams{})
 	if err != nil {
 		t.Error("test failed - GetLeaderboard() error", err)
 	}
 }
 
 func TestGetAliasOnLeaderboard(t *testing.T) {
-	_, err := b.GetAliasOnLeaderboard()
+	_, err := b.GetAliasOnLeaderboard(context.Background())
 	if err == nil {
 		t.Error("test failed - GetAliasOnLeaderboard() error", err)
 	}
 }
 
 func TestGetLiquidationOrders(t *testing.T) {
-	_, err := b.GetLiquidationOrders(GenericRequestParams{})
+	_, err := b.GetLiquidationOrders(context.Background(), GenericRequestParams{})
 	if err != nil {
 		t.Error("test failed - GetLiquidationOrders() error", err)
 	}
 }
 
 func TestGetCurrentNotifications(t *testing.T) {
-	_, err := b.GetCurrentNotifications()
+	_, err := b.GetCurrentNotifications(context.Background())
 	if err == nil {
 		t.Error("test failed - GetCurrentNotifications() error", err)
 	}
 }
 
 func TestGetOrders(t *testing.T) {
-	_, err := b.GetOrders(GenericRequestParams{})
+	_, err := b.GetOrders(context.Background(), GenericRequestParams{})
 	if err == nil {
 		t.Error("test failed - GetOrders() error", err)
 	}
 }
 
 func TestAmendOrder(t *testing.T) {
-	_, err := b.AmendOrder(OrderAmendParams{})
+	_, err := b.AmendOrder(context.Background(), OrderAmendParams{})
 	if err == nil {
 		t.Error("test failed - AmendOrder() error", err)
 	}
 }
 
 func TestCreateOrder(t *testing.T) {
-	_, err := b.CreateOrder(OrderNewParams{Symbol: "XBTM15",
+	_, err := b.CreateOrder(context.Background(), OrderNewParams{Symbol: "XBTM15",
 		Price:    219.0,
 		ClOrdID:  "mm_bitmex_1a/oemUeQ4CAJZgP3fjHsA",
 		OrderQty: 98})
@@ -233,128 +242,128 @@ func TestCreateOrder(t *testing.T) {
 }
 
 func TestCancelOrders(t *testing.T) {
-	_, err := b.CancelOrders(OrderCancelParams{})
+	_, err := b.CancelOrders(context.Background(), OrderCancelParams{})
 	if err == nil {
 		t.Error("test failed - CancelOrders() error", err)
 	}
 }
 
 func TestCancelAllOrders(t *testing.T) {
-	_, err := b.CancelAllExistingOrders(OrderCancelAllParams{})
+	_, err := b.CancelAllExistingOrders(context.Background(), OrderCancelAllParams{})
 	if err == nil {
 		t.Error("test failed - CancelAllOrders() error", err)
 	}
 }
 
 func TestAmendBulkOrders(t *testing.T) {
-	_, err := b.AmendBulkOrders(OrderAmendBulkParams{})
+	_, err := b.AmendBulkOrders(context.Background(), OrderAmendBulkParams{})
 	if err == nil {
 		t.Error("test failed - AmendBulkOrders() error", err)
 	}
 }
 
 func TestCreateBulkOrders(t *testing.T) {
-	_, err := b.CreateBulkOrders(OrderNewBulkParams{})
+	_, err := b.CreateBulkOrders(context.Background(), OrderNewBulkParams{})
 	if err == nil {
 		t.Error("test failed - CreateBulkOrders() error", err)
 	}
 }
 
 func TestCancelAllOrdersAfterTime(t *testing.T) {
-	_, err := b.CancelAllOrdersAfterTime(OrderCancelAllAfterParams{})
+	_, err := b.CancelAllOrdersAfterTime(context.Background(), OrderCancelAllAfterParams{})
 	if err == nil {
 		t.Error("test failed - CancelAllOrdersAfterTime() error", err)
 	}
 }
 
 func TestClosePosition(t *testing.T) {
-	_, err := b.ClosePosition(OrderClosePositionParams{})
+	_, err := b.ClosePosition(context.Background(), OrderClosePositionParams{})
 	if err == nil {
 		t.Error("test failed - ClosePosition() error", err)
 	}
 }
 
 func TestGetOrderbook(t *testing.T) {
-	_, err := b.GetOrderbook(OrderBookGetL2Params{Symbol: "XBT"})
+	_, err := b.GetOrderbook(context.Background(), OrderBookGetL2Params{Symbol: "XBT"})
 	if err != nil {
 		t.Error("test failed - GetOrderbook() error", err)
 	}
 }
 
 func TestGetPositions(t *testing.T) {
-	_, err := b.GetPositions(PositionGetParams{})
+	_, err := b.GetPositions(context.Background(), PositionGetParams{})
 	if err == nil {
 		t.Error("test failed - GetPositions() error", err)
 	}
 }
 
 func TestIsolatePosition(t *testing.T) {
-	_, err := b.IsolatePosition(PositionIsolateMarginParams{Symbol: "XBT"})
+	_, err := b.IsolatePosition(context.Background(), PositionIsolateMarginParams{Symbol: "XBT"})
 	if err == nil {
 		t.Error("test failed - IsolatePosition() error", err)
 	}
 }
 
 func TestLeveragePosition(t *testing.T) {
-	_, err := b.LeveragePosition(PositionUpdateLeverageParams{})
+	_, err := b.LeveragePosition(context.Background(), PositionUpdateLeverageParams{})
 	if err == nil {
 		t.Error("test failed - LeveragePosition() error", err)
 	}
 }
 
 func TestUpdateRiskLimit(t *testing.T) {
-	_, err := b.UpdateRiskLimit(PositionUpdateRiskLimitParams{})
+	_, err := b.UpdateRiskLimit(context.Background(), PositionUpdateRiskLimitParams{})
 	if err == nil {
 		t.Error("test failed - UpdateRiskLimit() error", err)
 	}
 }
 
 func TestTransferMargin(t *testing.T) {
-	_, err := b.TransferMargin(PositionTransferIsolatedMarginParams{})
+	_, err := b.TransferMargin(context.Background(), PositionTransferIsolatedMarginParams{})
 	if err == nil {
 		t.Error("test failed - TransferMargin() error", err)
 	}
 }
 
 func TestGetQuotesByBuckets(t *testing.T) {
-	_, err := b.GetQuotesByBuckets(QuoteGetBucketedParams{})
+	_, err := b.GetQuotesByBuckets(context.Background(), QuoteGetBucketedParams{})
 	if err == nil {
 		t.Error("test failed - GetQuotesByBuckets() error", err)
 	}
 }
 
 func TestGetSettlementHistory(t *testing.T) {
-	_, err := b.GetSettlementHistory(GenericRequestParams{})
+	_, err := b.GetSettlementHistory(context.Background(), GenericRequestParams{})
 	if err != nil {
 		t.Error("test failed - GetSettlementHistory() error", err)
 	}
 }
 
 func TestGetStats(t *testing.T) {
-	_, err := b.GetStats()
+	_, err := b.GetStats(context.Background())
 	if err != nil {
 		t.Error("test failed - GetStats() error", err)
 	}
 }
 
 func TestGetStatsHistorical(t *testing.T) {
-	_, err := b.GetStatsHistorical()
+	_, err := b.GetStatsHistorical(context.Background())
 	if err != nil {
 		t.Error("test failed - GetStatsHistorical() error", err)
 	}
 }
 
 func TestGetStatSummary(t *testing.T) {
-	_, err := b.GetStatSummary()
+	_, err := b.GetStatSummary(context.Background())
 	if err != nil {
 		t.Error("test failed - GetStatSummary() error", err)
 	}
 }
 
 func TestGetTrade(t *testing.T) {
-	_, err := b.GetTrade(GenericRequestParams{
+	_, err := b.GetTrade(context.Background(), GenericRequestParams{
 		Symbol:    "XBTUSD",
-		StartTime: time.Now().Format(time.RFC3339),
+		StartTime: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
 		Reverse:   true})
 	if err != nil {
 		t.Error("test failed - GetTrade() error", err)
@@ -362,7 +371,7 @@ func TestGetTrade(t *testing.T) {
 }
 
 func TestGetPreviousTrades(t *testing.T) {
-	_, err := b.GetPreviousTrades(TradeGetBucketedParams{})
+	_, err := b.GetPreviousTrades(context.Background(), TradeGetBucketedParams{})
 	if err == nil {
 		t.Error("test failed - GetPreviousTrades() error", err)
 	}
@@ -464,6 +473,60 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Bitmex using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *Bitmex {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	bitmexConfig, err := cfg.GetExchangeConfig("Bitmex")
+	if err != nil {
+		t.Fatal("Test Failed - Bitmex Setup() init error")
+	}
+
+	bitmexConfig.AuthenticatedAPISupport = true
+	bitmexConfig.APIKey, bitmexConfig.APISecret = request.FixtureCredentials(t, testAPIKey, testAPISecret)
+
+	var r Bitmex
+	r.SetDefaults()
+	r.Setup(bitmexConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - Bitmex GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 ||
+		orders[0].ID != "f4a8c1b2-5b5d-4c7f-9f51-2d0a6b3e8c10" ||
+		orders[0].BaseCurrency != "XBT" || orders[0].QuoteCurrency != symbol.USD ||
+		orders[0].Price != 6400 || orders[0].Amount != 100 || orders[0].OpenVolume != 60 ||
+		orders[0].ExecutedAmount != 40 || orders[0].OrderDate.Unix() != 1541030400) {
+		t.Errorf("Test Failed - Bitmex GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		StartTicks: time.Unix(1541030400, 0),
+		OrderSide:  exchange.Sell,
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Bitmex GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 ||
+		orders[0].ID != "0b6e2f3a-8c1d-4e5f-a7b9-3c4d5e6f7a8b" ||
+		orders[0].Status != "Filled" || orders[0].OpenVolume != 0 ||
+		orders[0].ExecutedAmount != 250) {
+		t.Errorf("Test Failed - Bitmex GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -488,7 +551,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.XBT,
 		SecondCurrency: symbol.USD,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -514,10 +577,107 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
 		t.Errorf("Could not cancel order: %s", err)
 	}
 }
+
+func TestOrderParams(t *testing.T) {
+	p := pair.NewCurrencyPair(symbol.XBT, symbol.USD)
+	params, err := orderParams(&exchange.SubmitOrder{Pair: p, Side: exchange.Sell,
+		OrderType: exchange.TrailingStop, Amount: 1, ClientID: "id",
+		Options: exchange.OrderOptions{TrailingOffset: 10, ReduceOnly: true}})
+	if err != nil {
+		t.Fatal("Test failed. orderParams error", err)
+	}
+
+	if params.OrdType != "Stop" || params.PegPriceType != "TrailingStopPeg" ||
+		params.PegOffsetValue != -10 || params.ExecInst != "ReduceOnly" ||
+		params.TimeInForce != "GoodTillCancel" || params.ClOrdID != "id" {
+		t.Errorf("Test failed. Unexpected trailing stop params %+v", params)
+	}
+
+	params, err = orderParams(&exchange.SubmitOrder{Pair: p, Side: exchange.Buy,
+		OrderType: exchange.TakeProfitLimit, Amount: 1, Price: 90,
+		Options: exchange.OrderOptions{StopPrice: 95, PostOnly: true,
+			TimeInForce: exchange.ImmediateOrCancel}})
+	if err != nil || params.OrdType != "LimitIfTouched" || params.Price != 90 ||
+		params.StopPx != 95 || params.ExecInst != "ParticipateDoNotInitiate" ||
+		params.TimeInForce != "ImmediateOrCancel" {
+		t.Errorf("Test failed. Unexpected take profit params %+v %v", params, err)
+	}
+
+	_, err = orderParams(&exchange.SubmitOrder{Pair: p, Side: exchange.Buy,
+		OrderType: exchange.Limit, Amount: 1, Price: 90,
+		Options: exchange.OrderOptions{TimeInForce: exchange.GoodTillDate}})
+	if err == nil {
+		t.Error("Test failed. Expected unsupported time in force error")
+	}
+}
+
+func TestGetMarketInfo(t *testing.T) {
+	markets := getMarketInfo([]Instrument{
+		{Symbol: "XBTUSD", RootSymbol: "XBT", LotSize: 1, MaxOrderQty: 10000000, TickSize: 0.5},
+		{Symbol: ".BXBT", RootSymbol: "XBT"},
+	})
+
+	if len(markets) != 1 || markets[0].Pair.FirstCurrency != "XBT" ||
+		markets[0].Pair.SecondCurrency != "USD" || markets[0].MinAmount != 1 ||
+		markets[0].MaxAmount != 10000000 || markets[0].PriceStep != 0.5 {
+		t.Errorf("Test failed. Unexpected market info %+v", markets)
+	}
+}
+
+func TestGetNormalisedPositions(t *testing.T) {
+	positions := getPositions("Bitmex", []Position{
+		{Symbol: "XBTUSD", Underlying: "XBT", Currency: "XBt", IsOpen: true,
+			CurrentQty: -100, AvgEntryPrice: 6500, MarkPrice: 6400, Leverage: 5,
+			LiquidationPrice: 7700, UnrealisedPnl: 24000, CrossMargin: true,
+			Timestamp: "2018-10-16T10:00:00.000Z"},
+		{Symbol: "ETHUSD", Underlying: "ETH", IsOpen: false},
+	})
+
+	if len(positions) != 1 {
+		t.Fatalf("Test failed. Expected 1 open position, received %d", len(positions))
+	}
+
+	p := positions[0]
+	if p.Pair.FirstCurrency != "XBT" || p.Side != exchange.Short || p.Amount != 100 ||
+		p.MarginMode != exchange.CrossMargin || p.UnrealisedPNL != 0.00024 ||
+		p.LiquidationPrice != 7700 || p.LastUpdated.IsZero() {
+		t.Errorf("Test failed. Unexpected position %+v", p)
+	}
+}
+
+func TestWsProcessExecution(t *testing.T) {
+	var r Bitmex
+	r.SetDefaults()
+	r.Websocket.DataHandler = make(chan interface{}, 10)
+
+	r.wsProcessExecution(&Execution{ExecType: "Funding", Symbol: "XBTUSD"})
+	r.wsProcessExecution(&Execution{ExecType: "Trade", OrderID: "1", ExecID: "2",
+		Symbol: "XBTUSD", Side: "Sell", OrdType: "Limit", OrdStatus: "PartiallyFilled",
+		Price: 6500, OrderQty: 100, CumQty: 40, AvgPx: 6500, LastPx: 6500, LastQty: 40,
+		ExecComm: 1500, SettlCurrency: "XBt", TransactTime: "2018-10-16T10:00:00.000Z"})
+
+	if len(r.Websocket.DataHandler) != 2 {
+		t.Fatalf("Test failed. Expected an order update and fill, received %d messages",
+			len(r.Websocket.DataHandler))
+	}
+
+	update, ok := (<-r.Websocket.DataHandler).(exchange.WebsocketOrderUpdate)
+	if !ok || update.Status != orders.PartiallyFilled || update.Side != exchange.Sell ||
+		update.OrderType != exchange.Limit || update.Amount != 100 ||
+		update.FilledAmount != 40 || update.Pair.Pair().String() != "XBTUSD" {
+		t.Errorf("Test failed. Unexpected order update %+v", update)
+	}
+
+	fill, ok := (<-r.Websocket.DataHandler).(exchange.WebsocketOrderFill)
+	if !ok || fill.TradeID != "2" || fill.Amount != 40 || fill.Fee != 0.000015 ||
+		fill.FeeCurrency != "XBT" {
+		t.Errorf("Test failed. Unexpected order fill %+v", fill)
+	}
+}
@@ -484,6 +484,24 @@ type Trade struct {
 	TrdMatchID      string  `json:"trdMatchID"`
 }
 
+// TradeBucket holds trade data aggregated into time buckets, the timestamp is
+// the close of the bucket
+type TradeBucket struct {
+	Timestamp       string  `json:"timestamp"`
+	Symbol          string  `json:"symbol"`
+	Open            float64 `json:"open"`
+	High            float64 `json:"high"`
+	Low             float64 `json:"low"`
+	Close           float64 `json:"close"`
+	Trades          int64   `json:"trades"`
+	Volume          float64 `json:"volume"`
+	Vwap            float64 `json:"vwap"`
+	LastSize        float64 `json:"lastSize"`
+	Turnover        float64 `json:"turnover"`
+	HomeNotional    float64 `json:"homeNotional"`
+	ForeignNotional float64 `json:"foreignNotional"`
+}
+
 // User Account Operations
 type User struct {
 	TFAEnabled   string          `json:"TFAEnabled"`
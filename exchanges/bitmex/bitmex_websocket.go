@@ -1,6 +1,7 @@
 package bitmex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -15,6 +16,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
 )
 
 const (
@@ -116,13 +118,6 @@ func (b *Bitmex) WsConnector() error {
 		}
 		return err
 	}
-
-	if b.AuthenticatedAPISupport {
-		err := b.websocketSendAuth()
-		if err != nil {
-			return err
-		}
-	}
 	return nil
 }
 
@@ -196,6 +191,13 @@ func (b *Bitmex) wsHandleIncomingData() {
 				if err != nil {
 					log.Fatal(err)
 				}
+
+				if respError.Request.Command == "authKeyExpires" {
+					b.Websocket.SetAuthenticated(false)
+					b.Websocket.DataHandler <- fmt.Sprintf("bitmex_websocket.go - Websocket authentication failed. Error: %s",
+						respError.Error)
+					continue
+				}
 				b.Websocket.DataHandler <- errors.New(respError.Error)
 				continue
 			}
@@ -285,6 +287,48 @@ func (b *Bitmex) wsHandleIncomingData() {
 
 					b.Websocket.DataHandler <- announcement.Data
 
+				case bitmexWSExecution:
+					var executions ExecutionData
+					err = common.JSONDecode(resp.Raw, &executions)
+					if err != nil {
+						b.Websocket.DataHandler <- err
+						continue
+					}
+
+					// The initial data is recent history, open orders are
+					// reconciled over REST after authenticating
+					if executions.Action == bitmexActionInitialData {
+						continue
+					}
+
+					for i := range executions.Data {
+						b.wsProcessExecution(&executions.Data[i])
+					}
+
+				case bitmexWSMargin:
+					var margins MarginData
+					err = common.JSONDecode(resp.Raw, &margins)
+					if err != nil {
+						b.Websocket.DataHandler <- err
+						continue
+					}
+
+					for i := range margins.Data {
+						// Updates only hold the changed fields
+						if margins.Data[i].WalletBalance == nil {
+							continue
+						}
+
+						currency := common.StringToUpper(margins.Data[i].Currency)
+						b.Websocket.DataHandler <- exchange.WebsocketBalanceUpdate{
+							Exchange: b.GetName(),
+							Currency: currency,
+							Total: marginValue(*margins.Data[i].WalletBalance,
+								margins.Data[i].Currency),
+							Timestamp: time.Now(),
+						}
+					}
+
 				default:
 					log.Fatal("Bitmex websocket error: Table unknown -", decodedResp.Table)
 				}
@@ -295,6 +339,36 @@ func (b *Bitmex) wsHandleIncomingData() {
 
 var snapshotloaded = make(map[pair.CurrencyPair]map[string]bool)
 
+// SeedLocalCache loads a full depth REST snapshot into the websocket orderbook
+// cache, keeping the level IDs which websocket updates are applied by
+func (b *Bitmex) SeedLocalCache(ctx context.Context, p pair.CurrencyPair) error {
+	data, err := b.GetOrderbook(ctx, OrderBookGetL2Params{Symbol: p.Pair().String()})
+	if err != nil {
+		return err
+	}
+
+	var newOrderbook orderbook.Base
+	for _, orderbookItem := range data {
+		item := orderbook.Item{
+			Price:  orderbookItem.Price,
+			Amount: float64(orderbookItem.Size),
+			ID:     orderbookItem.ID,
+		}
+		if orderbookItem.Side == "Sell" {
+			newOrderbook.Asks = append(newOrderbook.Asks, item)
+			continue
+		}
+		newOrderbook.Bids = append(newOrderbook.Bids, item)
+	}
+
+	newOrderbook.AssetType = "CONTRACT"
+	newOrderbook.CurrencyPair = p.Pair().String()
+	newOrderbook.LastUpdated = time.Now()
+	newOrderbook.Pair = p
+
+	return b.Websocket.Orderbook.LoadSnapshot(newOrderbook, b.GetName())
+}
+
 // ProcessOrderbook processes orderbook updates
 func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPair pair.CurrencyPair, assetType string) error {
 	if len(data) < 1 {
@@ -322,12 +396,14 @@ func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPai
 					asks = append(asks, orderbook.Item{
 						Price:  orderbookItem.Price,
 						Amount: float64(orderbookItem.Size),
+						ID:     orderbookItem.ID,
 					})
 					continue
 				}
 				bids = append(bids, orderbook.Item{
 					Price:  orderbookItem.Price,
 					Amount: float64(orderbookItem.Size),
+					ID:     orderbookItem.ID,
 				})
 			}
 
@@ -363,12 +439,14 @@ func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPai
 					asks = append(asks, orderbook.Item{
 						Price:  orderbookItem.Price,
 						Amount: float64(orderbookItem.Size),
+						ID:     orderbookItem.ID,
 					})
 					continue
 				}
 				bids = append(bids, orderbook.Item{
 					Price:  orderbookItem.Price,
 					Amount: float64(orderbookItem.Size),
+					ID:     orderbookItem.ID,
 				})
 			}
 
@@ -394,6 +472,56 @@ func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPai
 	return nil
 }
 
+// wsProcessExecution sends the order state carried by an execution as an order
+// update and, when the execution is a trade, the trade as an order fill.
+// Funding and settlement executions do not belong to an order
+func (b *Bitmex) wsProcessExecution(e *Execution) {
+	if e.ExecType == "Funding" || e.ExecType == "Settlement" {
+		return
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, e.TransactTime)
+	if err != nil {
+		timestamp = time.Now()
+	}
+
+	p := pair.NewCurrencyPairFromString(e.Symbol)
+	b.Websocket.DataHandler <- exchange.WebsocketOrderUpdate{
+		Exchange:      b.GetName(),
+		AssetType:     "CONTRACT",
+		Pair:          p,
+		OrderID:       e.OrderID,
+		ClientOrderID: e.ClOrdID,
+		Side:          exchange.OrderSide(e.Side),
+		OrderType:     exchange.OrderType(e.OrdType),
+		Status:        orders.ParseStatus(e.OrdStatus),
+		Price:         e.Price,
+		Amount:        float64(e.OrderQty),
+		FilledAmount:  float64(e.CumQty),
+		AveragePrice:  e.AvgPx,
+		Timestamp:     timestamp,
+	}
+
+	if e.ExecType != "Trade" {
+		return
+	}
+
+	feeCurrency := common.StringToUpper(e.SettlCurrency)
+	b.Websocket.DataHandler <- exchange.WebsocketOrderFill{
+		Exchange:    b.GetName(),
+		AssetType:   "CONTRACT",
+		Pair:        p,
+		OrderID:     e.OrderID,
+		TradeID:     e.ExecID,
+		Side:        exchange.OrderSide(e.Side),
+		Price:       e.LastPx,
+		Amount:      float64(e.LastQty),
+		Fee:         marginValue(e.ExecComm, e.SettlCurrency),
+		FeeCurrency: feeCurrency,
+		Timestamp:   timestamp,
+	}
+}
+
 // WebsocketSubscribe subscribes to a websocket channel
 func (b *Bitmex) websocketSubscribe() error {
 	contracts := b.GetEnabledCurrencies()
@@ -425,6 +553,20 @@ func (b *Bitmex) websocketSubscribe() error {
 	return nil
 }
 
+// WsAuthenticate authenticates the connection and subscribes to the executions
+// and margin of the account, which carry order updates, fills and balances
+func (b *Bitmex) WsAuthenticate() error {
+	err := b.websocketSendAuth()
+	if err != nil {
+		return err
+	}
+
+	return b.WebsocketConn.WriteJSON(WebsocketRequest{
+		Command:   "subscribe",
+		Arguments: []interface{}{bitmexWSExecution, bitmexWSMargin},
+	})
+}
+
 // WebsocketSendAuth sends an authenticated subscription
 func (b *Bitmex) websocketSendAuth() error {
 	timestamp := time.Now().Add(time.Hour * 1).Unix()
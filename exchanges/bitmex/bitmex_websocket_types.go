@@ -53,6 +53,26 @@ type WebsocketMainResponse struct {
 	} `json:"Attributes"`
 }
 
+// ExecutionData contains execution resp data with action to be taken
+type ExecutionData struct {
+	Data   []Execution `json:"data"`
+	Action string      `json:"action"`
+}
+
+// WebsocketMargin is a margin table entry, updates only hold the changed fields
+// so the wallet balance is unset when it has not changed
+type WebsocketMargin struct {
+	Currency      string `json:"currency"`
+	WalletBalance *int64 `json:"walletBalance"`
+	Timestamp     string `json:"timestamp"`
+}
+
+// MarginData contains margin resp data with action to be taken
+type MarginData struct {
+	Data   []WebsocketMargin `json:"data"`
+	Action string            `json:"action"`
+}
+
 // OrderBookData contains orderbook resp data with action to be taken
 type OrderBookData struct {
 	Data   []OrderBookL2 `json:"data"`
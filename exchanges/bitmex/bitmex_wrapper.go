@@ -1,8 +1,11 @@
 package bitmex
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,7 +33,7 @@ func (b *Bitmex) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", b.GetName(), len(b.EnabledPairs), b.EnabledPairs)
 	}
 
-	marketInfo, err := b.GetActiveInstruments(GenericRequestParams{})
+	marketInfo, err := b.GetActiveInstruments(context.Background(), GenericRequestParams{})
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", b.GetName())
 
@@ -39,6 +42,7 @@ func (b *Bitmex) Run() {
 		for _, info := range marketInfo {
 			exchangeProducts = append(exchangeProducts, info.Symbol)
 		}
+		b.SetMarketInfo(getMarketInfo(marketInfo))
 
 		err = b.UpdateCurrencies(exchangeProducts, false, false)
 		if err != nil {
@@ -47,12 +51,98 @@ func (b *Bitmex) Run() {
 	}
 }
 
+// getMarketInfo returns the trading rules of the instruments, amounts are in
+// contracts which must be a multiple of the lot size. Instruments which are not
+// prefixed by their root symbol such as indices are skipped
+func getMarketInfo(instruments []Instrument) []exchange.MarketInfo {
+	var markets []exchange.MarketInfo
+	for i := range instruments {
+		p, ok := symbolPair(instruments[i].Symbol, instruments[i].RootSymbol)
+		if !ok {
+			continue
+		}
+
+		markets = append(markets, exchange.MarketInfo{
+			Pair:      p,
+			AssetType: ticker.Spot,
+			OrderLimits: exchange.OrderLimits{
+				MinAmount:  float64(instruments[i].LotSize),
+				MaxAmount:  float64(instruments[i].MaxOrderQty),
+				AmountStep: float64(instruments[i].LotSize),
+				PriceStep:  instruments[i].TickSize,
+			},
+		})
+	}
+	return markets
+}
+
+// symbolPair splits an instrument symbol into its root symbol and the rest of
+// the symbol, symbols which are not prefixed by the root symbol are rejected
+func symbolPair(symbol, root string) (pair.CurrencyPair, bool) {
+	if root == "" || !strings.HasPrefix(symbol, root) {
+		return pair.CurrencyPair{}, false
+	}
+	return pair.NewCurrencyPairFromIndex(symbol, root), true
+}
+
+// marginValue converts a value in the smallest unit of the margin currency,
+// satoshis for bitcoin margined contracts, to a value in the margin currency
+func marginValue(value int64, currency string) float64 {
+	if currency == "XBt" {
+		return float64(value) / 1e8
+	}
+	return float64(value)
+}
+
+// getPositions returns the open positions normalised, amounts are in
+// contracts and profit and loss are in the margin currency
+func getPositions(exchName string, positions []Position) []exchange.Position {
+	var normalised []exchange.Position
+	for i := range positions {
+		if !positions[i].IsOpen || positions[i].CurrentQty == 0 {
+			continue
+		}
+
+		p, ok := symbolPair(positions[i].Symbol, positions[i].Underlying)
+		if !ok {
+			continue
+		}
+
+		position := exchange.Position{
+			Exchange:         exchName,
+			Pair:             p,
+			AssetType:        ticker.Spot,
+			Side:             exchange.Long,
+			Amount:           float64(positions[i].CurrentQty),
+			EntryPrice:       positions[i].AvgEntryPrice,
+			MarkPrice:        positions[i].MarkPrice,
+			Leverage:         positions[i].Leverage,
+			MarginMode:       exchange.IsolatedMargin,
+			LiquidationPrice: positions[i].LiquidationPrice,
+			UnrealisedPNL:    marginValue(positions[i].UnrealisedPnl, positions[i].Currency),
+			RealisedPNL:      marginValue(positions[i].RealisedPnl, positions[i].Currency),
+		}
+		position.LastUpdated, _ = time.Parse(time.RFC3339, positions[i].Timestamp)
+
+		if positions[i].CurrentQty < 0 {
+			position.Side = exchange.Short
+			position.Amount = -position.Amount
+		}
+
+		if positions[i].CrossMargin {
+			position.MarginMode = exchange.CrossMargin
+		}
+		normalised = append(normalised, position)
+	}
+	return normalised
+}
+
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitmex) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bitmex) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	currency := exchange.FormatExchangeCurrency(b.Name, p)
 
-	tick, err := b.GetTrade(GenericRequestParams{
+	tick, err := b.GetTrade(ctx, GenericRequestParams{
 		Symbol:    currency.String(),
 		StartTime: time.Now().Format(time.RFC3339),
 		Reverse:   true,
@@ -77,28 +167,28 @@ func (b *Bitmex) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pri
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bitmex) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bitmex) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (b *Bitmex) GetOrderbookEx(currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bitmex) GetOrderbookEx(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(b.GetName(), currency, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(currency, assetType)
+		return b.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitmex) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bitmex) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 
-	orderbookNew, err := b.GetOrderbook(OrderBookGetL2Params{
+	orderbookNew, err := b.GetOrderbook(ctx, OrderBookGetL2Params{
 		Symbol: exchange.FormatExchangeCurrency(b.Name, p).String(),
 		Depth:  500})
 	if err != nil {
@@ -124,93 +214,182 @@ func (b *Bitmex) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbo
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // Bitmex exchange
-func (b *Bitmex) GetAccountInfo() (exchange.AccountInfo, error) {
+func (b *Bitmex) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	return response, common.ErrNotYetImplemented
 }
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bitmex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bitmex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	// b.GetFullFundingHistory()
 	return fundHistory, common.ErrNotYetImplemented
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitmex) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (b *Bitmex) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bitmex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitmex) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	var orderNewParams = OrderNewParams{
-		OrdType:  side.ToString(),
-		Symbol:   p.Pair().String(),
-		OrderQty: amount,
-		Side:     side.ToString(),
+	if err := b.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
 	}
 
-	if orderType == exchange.Limit {
-		orderNewParams.Price = price
+	orderNewParams, err := orderParams(&order)
+	if err != nil {
+		return submitOrderResponse, err
 	}
 
-	response, err := b.CreateOrder(orderNewParams)
+	response, err := b.CreateOrder(ctx, orderNewParams)
+	fillResponse(&submitOrderResponse, &response, err)
+	return submitOrderResponse, err
+}
+
+// fillResponse sets the order ID of a submitted order and, when it was placed,
+// the filled amount, average price and timestamps
+func fillResponse(submitOrderResponse *exchange.SubmitOrderResponse, response *Order, err error) {
 	if response.OrderID != "" {
 		submitOrderResponse.OrderID = response.OrderID
 	}
 
 	if err == nil {
 		submitOrderResponse.IsOrderPlaced = true
+		submitOrderResponse.FilledAmount = float64(response.CumQty)
+		submitOrderResponse.AveragePrice = response.AvgPx
+		submitOrderResponse.OrderDate, _ = time.Parse(time.RFC3339, response.TransactTime)
+		submitOrderResponse.LastUpdated, _ = time.Parse(time.RFC3339, response.Timestamp)
 	}
-
-	return submitOrderResponse, err
 }
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bitmex) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (b *Bitmex) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bitmex) CancelOrder(order exchange.OrderCancellation) error {
+func (b *Bitmex) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	var params = OrderCancelParams{
 		OrderID: order.OrderID,
 	}
-	_, err := b.CancelOrders(params)
+	_, err := b.CancelOrders(ctx, params)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bitmex) CancelAllOrders() error {
+func (b *Bitmex) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bitmex) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (b *Bitmex) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitmex) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (b *Bitmex) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (b *Bitmex) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	positions, err := b.GetPositions(ctx, PositionGetParams{})
+	if err != nil {
+		return nil, err
+	}
+	return getPositions(b.Name, positions), nil
+}
+
+// SetLeverage sets the leverage of a derivatives pair, which isolates the
+// margin of its position
+func (b *Bitmex) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	if leverage <= 0 {
+		return errors.New("leverage must be greater than zero")
+	}
+
+	_, err := b.LeveragePosition(ctx, PositionUpdateLeverageParams{
+		Symbol:   exchange.FormatExchangeCurrency(b.Name, p).String(),
+		Leverage: leverage,
+	})
+	return err
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (b *Bitmex) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	symbol := exchange.FormatExchangeCurrency(b.Name, p).String()
+
+	var err error
+	switch mode {
+	case exchange.CrossMargin:
+		// A leverage of zero enables cross margin
+		_, err = b.LeveragePosition(ctx, PositionUpdateLeverageParams{Symbol: symbol})
+	case exchange.IsolatedMargin:
+		_, err = b.IsolatePosition(ctx, PositionIsolateMarginParams{
+			Symbol:  symbol,
+			Enabled: true,
+		})
+	default:
+		err = fmt.Errorf("margin mode %s is not supported", mode)
+	}
+	return err
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (b *Bitmex) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	params := OrderNewParams{
+		Symbol:   exchange.FormatExchangeCurrency(b.Name, position.Pair).String(),
+		Side:     exchange.Sell.ToString(),
+		OrdType:  "Market",
+		ExecInst: "Close",
+	}
+
+	if position.Side == exchange.Short {
+		params.Side = exchange.Buy.ToString()
+	}
+
+	response, err := b.CreateOrder(ctx, params)
+	fillResponse(&submitOrderResponse, &response, err)
+	return submitOrderResponse, err
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (b *Bitmex) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	fundingRate := exchange.FundingRate{Pair: p, AssetType: assetType}
+	instruments, err := b.GetInstruments(ctx, GenericRequestParams{
+		Symbol: exchange.FormatExchangeCurrency(b.Name, p).String(),
+	})
+	if err != nil {
+		return fundingRate, err
+	}
+
+	if len(instruments) == 0 {
+		return fundingRate, errors.New("Bitmex REST error: no instrument returned")
+	}
+
+	fundingRate.Rate = instruments[0].FundingRate
+	fundingRate.PredictedRate = instruments[0].IndicativeFundingRate
+	fundingRate.NextFunding, _ = time.Parse(time.RFC3339, instruments[0].FundingTimestamp)
+	return fundingRate, nil
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitmex) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bitmex) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitmex) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bitmex) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -234,3 +413,186 @@ func (b *Bitmex) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (b *Bitmex) GetWithdrawCapabilities() uint32 {
 	return b.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (b *Bitmex) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := b.GetOrders(ctx, GenericRequestParams{
+		Filter: "{\"open\": true}",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for i := range resp {
+		orders = append(orders, b.formatOrderDetail(resp[i]))
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (b *Bitmex) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	params := GenericRequestParams{Reverse: true}
+	if !getOrdersRequest.StartTicks.IsZero() {
+		params.StartTime = getOrdersRequest.StartTicks.UTC().Format(time.RFC3339)
+	}
+	if !getOrdersRequest.EndTicks.IsZero() {
+		params.EndTime = getOrdersRequest.EndTicks.UTC().Format(time.RFC3339)
+	}
+
+	resp, err := b.GetOrders(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for i := range resp {
+		orders = append(orders, b.formatOrderDetail(resp[i]))
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// formatOrderDetail converts a Bitmex order into an exchange.OrderDetail
+func (b *Bitmex) formatOrderDetail(order Order) exchange.OrderDetail {
+	p := b.GetPairFromSymbol(order.Symbol)
+	orderDate, err := time.Parse(time.RFC3339, order.Timestamp)
+	if err != nil {
+		log.Printf("%s unable to parse order time %s: %s", b.Name,
+			order.Timestamp, err)
+	}
+
+	return exchange.OrderDetail{
+		Exchange:       b.Name,
+		ID:             order.OrderID,
+		BaseCurrency:   p.FirstCurrency.String(),
+		QuoteCurrency:  p.SecondCurrency.String(),
+		OrderSide:      order.Side,
+		OrderType:      order.OrdType,
+		CreationTime:   orderDate.Unix(),
+		OrderDate:      orderDate,
+		Status:         order.OrdStatus,
+		Price:          order.Price,
+		Amount:         float64(order.OrderQty),
+		OpenVolume:     float64(order.LeavesQty),
+		ExecutedAmount: float64(order.CumQty),
+	}
+}
+
+// bitmexCandleIntervals maps the supported candle intervals to Bitmex bucket
+// sizes
+var bitmexCandleIntervals = map[exchange.CandleInterval]string{
+	exchange.OneMin:  "1m",
+	exchange.FiveMin: "5m",
+	exchange.OneHour: "1h",
+	exchange.OneDay:  "1d",
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times using bucketed trades
+func (b *Bitmex) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	binSize, err := exchange.ValidateCandleRequest(bitmexCandleIntervals,
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := exchange.FormatExchangeCurrency(b.Name, p).String()
+	return exchange.GetCandlesPaginated(interval, start, end, bitmexMaxBucketCount,
+		func(windowStart, windowEnd time.Time) ([]exchange.Candle, error) {
+			// Bitmex timestamps buckets by their close time
+			resp, err := b.GetPreviousTrades(ctx, TradeGetBucketedParams{
+				BinSize:   binSize,
+				Count:     bitmexMaxBucketCount,
+				Symbol:    symbol,
+				StartTime: windowStart.Add(interval.Duration()).UTC().Format(time.RFC3339),
+				EndTime:   windowEnd.UTC().Format(time.RFC3339),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			var candles []exchange.Candle
+			for i := range resp {
+				closeTime, err := time.Parse(time.RFC3339, resp[i].Timestamp)
+				if err != nil {
+					return nil, err
+				}
+
+				candles = append(candles, exchange.Candle{
+					Time:   closeTime.Add(-interval.Duration()),
+					Open:   resp[i].Open,
+					High:   resp[i].High,
+					Low:    resp[i].Low,
+					Close:  resp[i].Close,
+					Volume: resp[i].Volume,
+				})
+			}
+			return candles, nil
+		})
+}
+
+// orderParams maps an order to Bitmex order parameters
+func orderParams(s *exchange.SubmitOrder) (OrderNewParams, error) {
+	orderType, options := s.OrderType, s.Options
+	params := OrderNewParams{
+		Symbol:   s.Pair.Pair().String(),
+		OrderQty: s.Amount,
+		Side:     s.Side.ToString(),
+		ClOrdID:  s.ClientID,
+	}
+
+	switch orderType {
+	case exchange.Market:
+		params.OrdType = "Market"
+	case exchange.Limit:
+		params.OrdType = "Limit"
+	case exchange.Stop:
+		params.OrdType = "Stop"
+	case exchange.StopLimit:
+		params.OrdType = "StopLimit"
+	case exchange.TakeProfit:
+		params.OrdType = "MarketIfTouched"
+	case exchange.TakeProfitLimit:
+		params.OrdType = "LimitIfTouched"
+	case exchange.TrailingStop:
+		params.OrdType = "Stop"
+		params.PegPriceType = "TrailingStopPeg"
+		params.PegOffsetValue = options.TrailingOffset
+		if s.Side == exchange.Sell {
+			params.PegOffsetValue = -options.TrailingOffset
+		}
+	default:
+		return params, errors.New("unsupported order type")
+	}
+
+	if orderType.IsLimitPriced() {
+		params.Price = s.Price
+	}
+	params.StopPx = options.StopPrice
+
+	switch options.GetTimeInForce() {
+	case exchange.GoodTillCancel:
+		params.TimeInForce = "GoodTillCancel"
+	case exchange.ImmediateOrCancel:
+		params.TimeInForce = "ImmediateOrCancel"
+	case exchange.FillOrKill:
+		params.TimeInForce = "FillOrKill"
+	default:
+		return params, errors.New("unsupported time in force")
+	}
+
+	var execInst []string
+	if options.PostOnly {
+		execInst = append(execInst, "ParticipateDoNotInitiate")
+	}
+	if options.ReduceOnly {
+		execInst = append(execInst, "ReduceOnly")
+	}
+	params.ExecInst = common.JoinStrings(execInst, ",")
+	return params, nil
+}
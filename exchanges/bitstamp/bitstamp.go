@@ -1,11 +1,11 @@
 package bitstamp
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"net/url"
-	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -47,8 +47,11 @@ const (
 	bitstampAPITransferFromMain   = "transfer-from-main"
 	bitstampAPIXrpWithdrawal      = "xrp_withdrawal"
 	bitstampAPIXrpDeposit         = "xrp_address"
-	bitstampAPIReturnType         = "string"
 	bitstampAPITradingPairsInfo   = "trading-pairs-info"
+	bitstampDateLayout            = "2006-01-02 15:04:05"
+
+	// bitstampMarketTrade is the user transaction type of a trade
+	bitstampMarketTrade = 2
 
 	bitstampAuthRate   = 600
 	bitstampUnauthRate = 600
@@ -136,14 +139,14 @@ func (b *Bitstamp) Setup(exch config.ExchangeConfig) {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bitstamp) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *Bitstamp) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
 		var err error
 
-		b.Balance, err = b.GetBalance()
+		b.Balance, err = b.GetBalance(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -206,7 +209,7 @@ func (b *Bitstamp) CalculateTradingFee(currency string, purchasePrice float64, a
 }
 
 // GetTicker returns ticker information
-func (b *Bitstamp) GetTicker(currency string, hourly bool) (Ticker, error) {
+func (b *Bitstamp) GetTicker(ctx context.Context, currency string, hourly bool) (Ticker, error) {
 	response := Ticker{}
 	tickerEndpoint := bitstampAPITicker
 
@@ -221,13 +224,13 @@ func (b *Bitstamp) GetTicker(currency string, hourly bool) (Ticker, error) {
 		tickerEndpoint,
 		common.StringToLower(currency),
 	)
-	return response, b.SendHTTPRequest(path, &response)
+	return response, b.SendHTTPRequest(ctx, path, &response)
 }
 
 // GetOrderbook Returns a JSON dictionary with "bids" and "asks". Each is a list
 // of open orders and each order is represented as a list holding the price and
-//the amount.
-func (b *Bitstamp) GetOrderbook(currency string) (Orderbook, error) {
+// the amount.
+func (b *Bitstamp) GetOrderbook(ctx context.Context, currency string) (Orderbook, error) {
 	type response struct {
 		Timestamp int64      `json:"timestamp,string"`
 		Bids      [][]string `json:"bids"`
@@ -243,7 +246,7 @@ func (b *Bitstamp) GetOrderbook(currency string) (Orderbook, error) {
 		common.StringToLower(currency),
 	)
 
-	err := b.SendHTTPRequest(path, &resp)
+	err := b.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -284,7 +287,7 @@ func (b *Bitstamp) GetOrderbook(currency string) (Orderbook, error) {
 
 // GetTradingPairs returns a list of trading pairs which Bitstamp
 // currently supports
-func (b *Bitstamp) GetTradingPairs() ([]TradingPair, error) {
+func (b *Bitstamp) GetTradingPairs(ctx context.Context) ([]TradingPair, error) {
 	var result []TradingPair
 
 	path := fmt.Sprintf("%s/v%s/%s",
@@ -292,13 +295,13 @@ func (b *Bitstamp) GetTradingPairs() ([]TradingPair, error) {
 		bitstampAPIVersion,
 		bitstampAPITradingPairsInfo)
 
-	return result, b.SendHTTPRequest(path, &result)
+	return result, b.SendHTTPRequest(ctx, path, &result)
 }
 
 // GetTransactions returns transaction information
 // value paramater ["time"] = "minute", "hour", "day" will collate your
 // response into time intervals. Implementation of value in test code.
-func (b *Bitstamp) GetTransactions(currencyPair string, values url.Values) ([]Transactions, error) {
+func (b *Bitstamp) GetTransactions(ctx context.Context, currencyPair string, values url.Values) ([]Transactions, error) {
 	transactions := []Transactions{}
 	path := common.EncodeURLValues(
 		fmt.Sprintf(
@@ -311,132 +314,122 @@ func (b *Bitstamp) GetTransactions(currencyPair string, values url.Values) ([]Tr
 		values,
 	)
 
-	return transactions, b.SendHTTPRequest(path, &transactions)
+	return transactions, b.SendHTTPRequest(ctx, path, &transactions)
 }
 
 // GetEURUSDConversionRate returns the conversion rate between Euro and USD
-func (b *Bitstamp) GetEURUSDConversionRate() (EURUSDConversionRate, error) {
+func (b *Bitstamp) GetEURUSDConversionRate(ctx context.Context) (EURUSDConversionRate, error) {
 	rate := EURUSDConversionRate{}
 	path := fmt.Sprintf("%s/%s", b.APIUrl, bitstampAPIEURUSD)
 
-	return rate, b.SendHTTPRequest(path, &rate)
+	return rate, b.SendHTTPRequest(ctx, path, &rate)
 }
 
 // GetBalance returns full balance of currency held on the exchange
-func (b *Bitstamp) GetBalance() (Balances, error) {
+func (b *Bitstamp) GetBalance(ctx context.Context) (Balances, error) {
 	balance := Balances{}
 	path := fmt.Sprintf("%s/%s", b.APIUrl, bitstampAPIBalance)
 
-	return balance, b.SendHTTPRequest(path, &balance)
+	return balance, b.SendHTTPRequest(ctx, path, &balance)
 }
 
-// GetUserTransactions returns an array of transactions
-func (b *Bitstamp) GetUserTransactions(currencyPair string) ([]UserTransactions, error) {
-	type Response struct {
-		Date    string      `json:"datetime"`
-		TransID int64       `json:"id"`
-		Type    int         `json:"type,string"`
-		USD     interface{} `json:"usd"`
-		EUR     float64     `json:"eur"`
-		XRP     float64     `json:"xrp"`
-		BTC     interface{} `json:"btc"`
-		BTCUSD  interface{} `json:"btc_usd"`
-		Fee     float64     `json:"fee,string"`
-		OrderID int64       `json:"order_id"`
-	}
-	response := []Response{}
+// GetUserTransactions returns an array of transactions, currencyPair limits
+// the transactions to a single pair e.g. "btcusd"
+func (b *Bitstamp) GetUserTransactions(ctx context.Context, currencyPair string) ([]UserTransactions, error) {
+	response := []map[string]interface{}{}
 
+	path := bitstampAPIUserTransactions
 	if currencyPair != "" {
-		if err := b.SendAuthenticatedHTTPRequest(bitstampAPIUserTransactions, true, url.Values{}, &response); err != nil {
-			return nil, err
-		}
-	} else {
-		if err := b.SendAuthenticatedHTTPRequest(bitstampAPIUserTransactions+"/"+currencyPair, true, url.Values{}, &response); err != nil {
-			return nil, err
-		}
+		path += "/" + currencyPair
+	}
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, true, url.Values{}, &response); err != nil {
+		return nil, err
 	}
 
 	transactions := []UserTransactions{}
-
 	for _, y := range response {
-		tx := UserTransactions{}
-		tx.Date = y.Date
-		tx.TransID = y.TransID
-		tx.Type = y.Type
-
-		/* Hack due to inconsistent JSON values... */
-		varType := reflect.TypeOf(y.USD).String()
-		if varType == bitstampAPIReturnType {
-			tx.USD, _ = strconv.ParseFloat(y.USD.(string), 64)
-		} else {
-			tx.USD = y.USD.(float64)
-		}
-
-		tx.EUR = y.EUR
-		tx.XRP = y.XRP
-
-		varType = reflect.TypeOf(y.BTC).String()
-		if varType == bitstampAPIReturnType {
-			tx.BTC, _ = strconv.ParseFloat(y.BTC.(string), 64)
-		} else {
-			tx.BTC = y.BTC.(float64)
+		tx := UserTransactions{Amounts: make(map[string]float64)}
+		for k, v := range y {
+			switch k {
+			case "datetime":
+				tx.Date, _ = v.(string)
+			case "id":
+				tx.TransID = int64(parseTransactionValue(v))
+			case "type":
+				tx.Type = int(parseTransactionValue(v))
+			case "fee":
+				tx.Fee = parseTransactionValue(v)
+			case "order_id":
+				tx.OrderID = int64(parseTransactionValue(v))
+			default:
+				tx.Amounts[k] = parseTransactionValue(v)
+			}
 		}
 
-		varType = reflect.TypeOf(y.BTCUSD).String()
-		if varType == bitstampAPIReturnType {
-			tx.BTCUSD, _ = strconv.ParseFloat(y.BTCUSD.(string), 64)
-		} else {
-			tx.BTCUSD = y.BTCUSD.(float64)
-		}
-
-		tx.Fee = y.Fee
-		tx.OrderID = y.OrderID
+		tx.USD = tx.Amounts["usd"]
+		tx.EUR = tx.Amounts["eur"]
+		tx.BTC = tx.Amounts["btc"]
+		tx.XRP = tx.Amounts["xrp"]
+		tx.BTCUSD = tx.Amounts["btc_usd"]
 		transactions = append(transactions, tx)
 	}
 
 	return transactions, nil
 }
 
+// parseTransactionValue returns a transaction field which may be a JSON
+// string or number as a float, zero if it is neither
+func parseTransactionValue(v interface{}) float64 {
+	switch t := v.(type) {
+	case float64:
+		return t
+	case string:
+		f, _ := strconv.ParseFloat(t, 64)
+		return f
+	}
+	return 0
+}
+
 // GetOpenOrders returns all open orders on the exchange
-func (b *Bitstamp) GetOpenOrders(currencyPair string) ([]Order, error) {
+func (b *Bitstamp) GetOpenOrders(ctx context.Context, currencyPair string) ([]Order, error) {
 	resp := []Order{}
 	path := fmt.Sprintf(
 		"%s/%s", bitstampAPIOpenOrders, common.StringToLower(currencyPair),
 	)
 
-	return resp, b.SendAuthenticatedHTTPRequest(path, true, nil, &resp)
+	return resp, b.SendAuthenticatedHTTPRequest(ctx, path, true, nil, &resp)
 }
 
 // GetOrderStatus returns an the status of an order by its ID
-func (b *Bitstamp) GetOrderStatus(OrderID int64) (OrderStatus, error) {
+func (b *Bitstamp) GetOrderStatus(ctx context.Context, OrderID int64) (OrderStatus, error) {
 	resp := OrderStatus{}
 	req := url.Values{}
 	req.Add("id", strconv.FormatInt(OrderID, 10))
 
 	return resp,
-		b.SendAuthenticatedHTTPRequest(bitstampAPIOrderStatus, false, req, &resp)
+		b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIOrderStatus, false, req, &resp)
 }
 
 // CancelExistingOrder cancels order by ID
-func (b *Bitstamp) CancelExistingOrder(OrderID int64) (bool, error) {
+func (b *Bitstamp) CancelExistingOrder(ctx context.Context, OrderID int64) (bool, error) {
 	result := false
 	var req = url.Values{}
 	req.Add("id", strconv.FormatInt(OrderID, 10))
 
 	return result,
-		b.SendAuthenticatedHTTPRequest(bitstampAPICancelOrder, true, req, &result)
+		b.SendAuthenticatedHTTPRequest(ctx, bitstampAPICancelOrder, true, req, &result)
 }
 
 // CancelAllExistingOrders cancels all open orders on the exchange
-func (b *Bitstamp) CancelAllExistingOrders() (bool, error) {
+func (b *Bitstamp) CancelAllExistingOrders(ctx context.Context) (bool, error) {
 	result := false
 
 	return result,
-		b.SendAuthenticatedHTTPRequest(bitstampAPICancelAllOrders, false, nil, &result)
+		b.SendAuthenticatedHTTPRequest(ctx, bitstampAPICancelAllOrders, false, nil, &result)
 }
 
 // PlaceOrder places an order on the exchange.
-func (b *Bitstamp) PlaceOrder(currencyPair string, price float64, amount float64, buy, market bool) (Order, error) {
+func (b *Bitstamp) PlaceOrder(ctx context.Context, currencyPair string, price float64, amount float64, buy, market bool) (Order, error) {
 	var req = url.Values{}
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	req.Add("price", strconv.FormatFloat(price, 'f', -1, 64))
@@ -454,13 +447,13 @@ func (b *Bitstamp) PlaceOrder(currencyPair string, price float64, amount float64
 	}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(path, true, req, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, path, true, req, &response)
 }
 
 // GetWithdrawalRequests returns withdrawal requests for the account
 // timedelta - positive integer with max value 50000000 which returns requests
 // from number of seconds ago to now.
-func (b *Bitstamp) GetWithdrawalRequests(timedelta int64) ([]WithdrawalRequests, error) {
+func (b *Bitstamp) GetWithdrawalRequests(ctx context.Context, timedelta int64) ([]WithdrawalRequests, error) {
 	resp := []WithdrawalRequests{}
 	if timedelta > 50000000 || timedelta < 0 {
 		return resp, errors.New("time delta exceeded, max: 50000000 min: 0")
@@ -474,7 +467,7 @@ func (b *Bitstamp) GetWithdrawalRequests(timedelta int64) ([]WithdrawalRequests,
 	}
 
 	return resp,
-		b.SendAuthenticatedHTTPRequest(bitstampAPIWithdrawalRequests, false, value, &resp)
+		b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIWithdrawalRequests, false, value, &resp)
 }
 
 // CryptoWithdrawal withdraws a cryptocurrency into a supplied wallet, returns ID
@@ -483,7 +476,7 @@ func (b *Bitstamp) GetWithdrawalRequests(timedelta int64) ([]WithdrawalRequests,
 // symbol - the type of crypto ie "ltc", "btc", "eth"
 // destTag - only for XRP  default to ""
 // instant - only for bitcoins
-func (b *Bitstamp) CryptoWithdrawal(amount float64, address, symbol, destTag string, instant bool) (string, error) {
+func (b *Bitstamp) CryptoWithdrawal(ctx context.Context, amount float64, address, symbol, destTag string, instant bool) (string, error) {
 	var req = url.Values{}
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	req.Add("address", address)
@@ -501,19 +494,19 @@ func (b *Bitstamp) CryptoWithdrawal(amount float64, address, symbol, destTag str
 			req.Add("instant", "0")
 		}
 		return resp.ID,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIBitcoinWithdrawal, false, req, &resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIBitcoinWithdrawal, false, req, &resp)
 	case "ltc":
 		return resp.ID,
-			b.SendAuthenticatedHTTPRequest(bitstampAPILTCWithdrawal, true, req, &resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPILTCWithdrawal, true, req, &resp)
 	case "eth":
 		return resp.ID,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIETHWithdrawal, true, req, &resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIETHWithdrawal, true, req, &resp)
 	case "xrp":
 		if destTag != "" {
 			req.Add("destination_tag", destTag)
 		}
 		return resp.ID,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIXrpWithdrawal, true, req, &resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIXrpWithdrawal, true, req, &resp)
 	}
 	return resp.ID,
 		errors.New("incorrect symbol")
@@ -521,7 +514,7 @@ func (b *Bitstamp) CryptoWithdrawal(amount float64, address, symbol, destTag str
 
 // GetCryptoDepositAddress returns a depositing address by crypto
 // crypto - example "btc", "ltc", "eth", or "xrp"
-func (b *Bitstamp) GetCryptoDepositAddress(crypto string) (string, error) {
+func (b *Bitstamp) GetCryptoDepositAddress(ctx context.Context, crypto string) (string, error) {
 	type response struct {
 		Address string `json:"address"`
 	}
@@ -530,27 +523,27 @@ func (b *Bitstamp) GetCryptoDepositAddress(crypto string) (string, error) {
 	switch common.StringToLower(crypto) {
 	case "btc":
 		return resp.Address,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIBitcoinDeposit, false, nil, &resp.Address)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIBitcoinDeposit, false, nil, &resp.Address)
 	case "ltc":
 		return resp.Address,
-			b.SendAuthenticatedHTTPRequest(bitstampAPILitecoinDeposit, true, nil, &resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPILitecoinDeposit, true, nil, &resp)
 	case "eth":
 		return resp.Address,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIEthereumDeposit, true, nil, &resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIEthereumDeposit, true, nil, &resp)
 	case "xrp":
 		return resp.Address,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIXrpDeposit, true, nil, &resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIXrpDeposit, true, nil, &resp)
 	}
 
 	return resp.Address, errors.New("incorrect cryptocurrency string")
 }
 
 // GetUnconfirmedBitcoinDeposits returns unconfirmed transactions
-func (b *Bitstamp) GetUnconfirmedBitcoinDeposits() ([]UnconfirmedBTCTransactions, error) {
+func (b *Bitstamp) GetUnconfirmedBitcoinDeposits(ctx context.Context) ([]UnconfirmedBTCTransactions, error) {
 	response := []UnconfirmedBTCTransactions{}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(bitstampAPIUnconfirmedBitcoin, false, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIUnconfirmedBitcoin, false, nil, &response)
 }
 
 // TransferAccountBalance transfers funds from either a main or sub account
@@ -558,7 +551,7 @@ func (b *Bitstamp) GetUnconfirmedBitcoinDeposits() ([]UnconfirmedBTCTransactions
 // currency - which currency to transfer
 // subaccount - name of account
 // toMain - bool either to or from account
-func (b *Bitstamp) TransferAccountBalance(amount float64, currency, subAccount string, toMain bool) (bool, error) {
+func (b *Bitstamp) TransferAccountBalance(ctx context.Context, amount float64, currency, subAccount string, toMain bool) (bool, error) {
 	var req = url.Values{}
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	req.Add("currency", currency)
@@ -569,7 +562,7 @@ func (b *Bitstamp) TransferAccountBalance(amount float64, currency, subAccount s
 		path = bitstampAPITransferFromMain
 	}
 
-	err := b.SendAuthenticatedHTTPRequest(path, true, req, nil)
+	err := b.SendAuthenticatedHTTPRequest(ctx, path, true, req, nil)
 	if err != nil {
 		return false, err
 	}
@@ -578,12 +571,12 @@ func (b *Bitstamp) TransferAccountBalance(amount float64, currency, subAccount s
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (b *Bitstamp) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload("GET", path, nil, nil, result, false, b.Verbose)
+func (b *Bitstamp) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return b.SendPayload(ctx, "GET", path, nil, nil, result, false, b.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated request
-func (b *Bitstamp) SendAuthenticatedHTTPRequest(path string, v2 bool, values url.Values, result interface{}) (err error) {
+func (b *Bitstamp) SendAuthenticatedHTTPRequest(ctx context.Context, path string, v2 bool, values url.Values, result interface{}) (err error) {
 	if !b.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -618,5 +611,5 @@ func (b *Bitstamp) SendAuthenticatedHTTPRequest(path string, v2 bool, values url
 
 	encodedValues := values.Encode()
 	readerValues := strings.NewReader(encodedValues)
-	return b.SendPayload("POST", path, headers, readerValues, result, true, b.Verbose)
+	return b.SendPayload(ctx, "POST", path, headers, readerValues, result, true, b.Verbose)
 }
@@ -1,6 +1,7 @@
 package bitstamp
 
 import (
+	"context"
 	"net/url"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	"github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 
 	"github.com/thrasher-/gocryptotrader/config"
 )
@@ -22,6 +24,12 @@ const (
 
 var b Bitstamp
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	b.SetDefaults()
 
@@ -81,7 +89,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 
 	// CryptocurrencyTradeFee Basic
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Error(err)
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 	}
@@ -90,7 +98,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -98,7 +106,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -106,7 +114,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -114,7 +122,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -122,7 +130,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -131,7 +139,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(7.5) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(7.5) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(7.5), resp)
 		t.Error(err)
 	}
@@ -140,7 +148,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(15) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(15) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(15), resp)
 		t.Error(err)
 	}
@@ -169,11 +177,11 @@ func TestCalculateTradingFee(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker(symbol.BTC+symbol.USD, false)
+	_, err := b.GetTicker(context.Background(), symbol.BTC+symbol.USD, false)
 	if err != nil {
 		t.Error("Test Failed - GetTicker() error", err)
 	}
-	_, err = b.GetTicker(symbol.BTC+symbol.USD, true)
+	_, err = b.GetTicker(context.Background(), symbol.BTC+symbol.USD, true)
 	if err != nil {
 		t.Error("Test Failed - GetTicker() error", err)
 	}
@@ -181,7 +189,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderbook(symbol.BTC + symbol.USD)
+	_, err := b.GetOrderbook(context.Background(), symbol.BTC+symbol.USD)
 	if err != nil {
 		t.Error("Test Failed - GetOrderbook() error", err)
 	}
@@ -189,7 +197,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTradingPairs(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTradingPairs()
+	_, err := b.GetTradingPairs(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetTradingPairs() error", err)
 	}
@@ -200,11 +208,11 @@ func TestGetTransactions(t *testing.T) {
 	value := url.Values{}
 	value.Set("time", "hour")
 
-	_, err := b.GetTransactions(symbol.BTC+symbol.USD, value)
+	_, err := b.GetTransactions(context.Background(), symbol.BTC+symbol.USD, value)
 	if err != nil {
 		t.Error("Test Failed - GetTransactions() error", err)
 	}
-	_, err = b.GetTransactions("wigwham", value)
+	_, err = b.GetTransactions(context.Background(), "wigwham", value)
 	if err == nil {
 		t.Error("Test Failed - GetTransactions() error")
 	}
@@ -212,7 +220,7 @@ func TestGetTransactions(t *testing.T) {
 
 func TestGetEURUSDConversionRate(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetEURUSDConversionRate()
+	_, err := b.GetEURUSDConversionRate(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetEURUSDConversionRate() error", err)
 	}
@@ -220,7 +228,7 @@ func TestGetEURUSDConversionRate(t *testing.T) {
 
 func TestGetBalance(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetBalance()
+	_, err := b.GetBalance(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetBalance() error", err)
 	}
@@ -228,12 +236,12 @@ func TestGetBalance(t *testing.T) {
 
 func TestGetUserTransactions(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetUserTransactions("")
+	_, err := b.GetUserTransactions(context.Background(), "")
 	if err == nil {
 		t.Error("Test Failed - GetUserTransactions() error", err)
 	}
 
-	_, err = b.GetUserTransactions("btcusd")
+	_, err = b.GetUserTransactions(context.Background(), "btcusd")
 	if err == nil {
 		t.Error("Test Failed - GetUserTransactions() error", err)
 	}
@@ -242,11 +250,11 @@ func TestGetUserTransactions(t *testing.T) {
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOpenOrders("btcusd")
+	_, err := b.GetOpenOrders(context.Background(), "btcusd")
 	if err == nil {
 		t.Error("Test Failed - GetOpenOrders() error", err)
 	}
-	_, err = b.GetOpenOrders("wigwham")
+	_, err = b.GetOpenOrders(context.Background(), "wigwham")
 	if err == nil {
 		t.Error("Test Failed - GetOpenOrders() error")
 	}
@@ -258,7 +266,7 @@ func TestGetOrderStatus(t *testing.T) {
 		b.APIKey == "Key" || b.APISecret == "Secret" {
 		t.Skip()
 	}
-	_, err := b.GetOrderStatus(1337)
+	_, err := b.GetOrderStatus(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - GetOpenOrders() error")
 	}
@@ -267,7 +275,7 @@ func TestGetOrderStatus(t *testing.T) {
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
 
-	resp, err := b.CancelExistingOrder(1337)
+	resp, err := b.CancelExistingOrder(context.Background(), 1337)
 	if err == nil || resp != false {
 		t.Error("Test Failed - CancelExistingOrder() error")
 	}
@@ -276,7 +284,7 @@ func TestCancelExistingOrder(t *testing.T) {
 func TestCancelAllExistingOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.CancelAllExistingOrders()
+	_, err := b.CancelAllExistingOrders(context.Background())
 	if err == nil {
 		t.Error("Test Failed - CancelAllExistingOrders() error", err)
 	}
@@ -288,7 +296,7 @@ func TestPlaceOrder(t *testing.T) {
 		b.APIKey == "Key" || b.APISecret == "Secret" {
 		t.Skip()
 	}
-	_, err := b.PlaceOrder("btcusd", 0.01, 1, true, true)
+	_, err := b.PlaceOrder(context.Background(), "btcusd", 0.01, 1, true, true)
 	if err == nil {
 		t.Error("Test Failed - PlaceOrder() error")
 	}
@@ -297,11 +305,11 @@ func TestPlaceOrder(t *testing.T) {
 func TestGetWithdrawalRequests(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetWithdrawalRequests(0)
+	_, err := b.GetWithdrawalRequests(context.Background(), 0)
 	if err == nil {
 		t.Error("Test Failed - GetWithdrawalRequests() error", err)
 	}
-	_, err = b.GetWithdrawalRequests(-1)
+	_, err = b.GetWithdrawalRequests(context.Background(), -1)
 	if err == nil {
 		t.Error("Test Failed - GetWithdrawalRequests() error")
 	}
@@ -314,7 +322,7 @@ func TestCryptoWithdrawal(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := b.CryptoWithdrawal(0, "bla", "btc", "", true)
+	_, err := b.CryptoWithdrawal(context.Background(), 0, "bla", "btc", "", true)
 	if err == nil {
 		t.Error("Test Failed - CryptoWithdrawal() error", err)
 	}
@@ -323,7 +331,7 @@ func TestCryptoWithdrawal(t *testing.T) {
 func TestGetBitcoinDepositAddress(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetCryptoDepositAddress("btc")
+	_, err := b.GetCryptoDepositAddress(context.Background(), "btc")
 	if err == nil {
 		t.Error("Test Failed - GetCryptoDepositAddress() error", err)
 	}
@@ -332,7 +340,7 @@ func TestGetBitcoinDepositAddress(t *testing.T) {
 func TestGetUnconfirmedBitcoinDeposits(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetUnconfirmedBitcoinDeposits()
+	_, err := b.GetUnconfirmedBitcoinDeposits(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetUnconfirmedBitcoinDeposits() error", err)
 	}
@@ -345,11 +353,11 @@ func TestTransferAccountBalance(t *testing.T) {
 		b.APIKey == "Key" || b.APISecret == "Secret" {
 		t.Skip()
 	}
-	_, err := b.TransferAccountBalance(1, "", "", true)
+	_, err := b.TransferAccountBalance(context.Background(), 1, "", "", true)
 	if err == nil {
 		t.Error("Test Failed - TransferAccountBalance() error", err)
 	}
-	_, err = b.TransferAccountBalance(1, "btc", "", false)
+	_, err = b.TransferAccountBalance(context.Background(), 1, "btc", "", false)
 	if err == nil {
 		t.Error("Test Failed - TransferAccountBalance() error", err)
 	}
@@ -367,6 +375,65 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Bitstamp using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *Bitstamp {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	bConfig, err := cfg.GetExchangeConfig("Bitstamp")
+	if err != nil {
+		t.Fatal("Test Failed - Bitstamp Setup() init error")
+	}
+
+	bConfig.AuthenticatedAPISupport = true
+	bConfig.APIKey, bConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+	bConfig.ClientID = customerID
+
+	var r Bitstamp
+	r.SetDefaults()
+	r.Setup(bConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - Bitstamp GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 2 || orders[0].ID != "1742356451" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].QuoteCurrency != symbol.USD ||
+		orders[0].OrderSide != exchange.Buy.ToString() || orders[1].OrderSide != exchange.Sell.ToString() ||
+		orders[0].Price != 6300 || orders[0].OpenVolume != 0.5 ||
+		orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - Bitstamp GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	_, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{})
+	if err == nil {
+		t.Error("Test Failed - Bitstamp GetOrderHistory() expected error without a currency pair")
+	}
+
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.ETH, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Bitstamp GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 2 || orders[0].ID != "2637413712" ||
+		orders[0].OrderSide != exchange.Buy.ToString() || orders[1].OrderSide != exchange.Sell.ToString() ||
+		orders[0].Status != "FILLED" || orders[0].Price != 0.0339 || orders[0].ExecutedAmount != 0.1 ||
+		orders[1].Price != 0.034 || orders[1].Amount != 0.2 || orders[0].OrderDate.Unix() != 1546300800) {
+		t.Errorf("Test Failed - Bitstamp GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -391,7 +458,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USD,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 1, "clientId")
+	response, err := b.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -417,7 +484,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
@@ -87,15 +87,19 @@ type UserTransactions struct {
 	BTCUSD  float64 `json:"btc_usd"`
 	Fee     float64 `json:"fee,string"`
 	OrderID int64   `json:"order_id"`
+	// Amounts holds every currency amount and pair price of the
+	// transaction keyed by lower case name e.g. "eth" or "eth_btc"
+	Amounts map[string]float64 `json:"-"`
 }
 
 // Order holds current open order data
 type Order struct {
-	ID     int64   `json:"id"`
-	Date   string  `json:"datetime"`
-	Type   int     `json:"type"`
-	Price  float64 `json:"price"`
-	Amount float64 `json:"amount"`
+	ID           int64   `json:"id"`
+	Date         string  `json:"datetime"`
+	Type         int     `json:"type"`
+	Price        float64 `json:"price"`
+	Amount       float64 `json:"amount"`
+	CurrencyPair string  `json:"currency_pair"`
 }
 
 // OrderStatus holds order status information
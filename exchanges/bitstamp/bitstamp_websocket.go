@@ -1,6 +1,7 @@
 package bitstamp
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -102,7 +103,7 @@ func (b *Bitstamp) WsConnect() error {
 	go b.WsReadData()
 
 	for _, p := range b.GetEnabledCurrencies() {
-		orderbookSeed, err := b.GetOrderbook(p.Pair().String())
+		orderbookSeed, err := b.GetOrderbook(context.Background(), p.Pair().String())
 		if err != nil {
 			return err
 		}
@@ -1,11 +1,14 @@
 package bitstamp
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -31,7 +34,7 @@ func (b *Bitstamp) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", b.GetName(), len(b.EnabledPairs), b.EnabledPairs)
 	}
 
-	pairs, err := b.GetTradingPairs()
+	pairs, err := b.GetTradingPairs(context.Background())
 	if err != nil {
 		log.Printf("%s failed to get trading pairs. Err: %s", b.Name, err)
 	} else {
@@ -51,9 +54,9 @@ func (b *Bitstamp) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitstamp) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bitstamp) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := b.GetTicker(p.Pair().String(), false)
+	tick, err := b.GetTicker(ctx, p.Pair().String(), false)
 	if err != nil {
 		return tickerPrice, err
 
@@ -70,33 +73,33 @@ func (b *Bitstamp) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.P
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bitstamp) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bitstamp) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (b *Bitstamp) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return b.GetFee(feeBuilder)
+	return b.GetFee(context.Background(), feeBuilder)
 
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (b *Bitstamp) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bitstamp) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitstamp) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bitstamp) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := b.GetOrderbook(p.Pair().String())
+	orderbookNew, err := b.GetOrderbook(ctx, p.Pair().String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -117,10 +120,10 @@ func (b *Bitstamp) UpdateOrderbook(p pair.CurrencyPair, assetType string) (order
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // Bitstamp exchange
-func (b *Bitstamp) GetAccountInfo() (exchange.AccountInfo, error) {
+func (b *Bitstamp) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = b.GetName()
-	accountBalance, err := b.GetBalance()
+	accountBalance, err := b.GetBalance(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -153,24 +156,28 @@ func (b *Bitstamp) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bitstamp) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bitstamp) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitstamp) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (b *Bitstamp) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bitstamp) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bitstamp) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	buy := side == exchange.Buy
-	market := orderType == exchange.Market
-	response, err := b.PlaceOrder(p.Pair().String(), price, amount, buy, market)
+	if err := b.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	buy := order.Side == exchange.Buy
+	market := order.OrderType == exchange.Market
+	response, err := b.PlaceOrder(ctx, order.Pair.Pair().String(), order.Price, order.Amount, buy, market)
 
 	if response.ID > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response.ID)
@@ -185,47 +192,74 @@ func (b *Bitstamp) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, ord
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bitstamp) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (b *Bitstamp) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bitstamp) CancelOrder(order exchange.OrderCancellation) error {
+func (b *Bitstamp) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
-	_, err = b.CancelExistingOrder(orderIDInt)
+	_, err = b.CancelExistingOrder(ctx, orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bitstamp) CancelAllOrders() error {
+func (b *Bitstamp) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bitstamp) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (b *Bitstamp) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitstamp) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (b *Bitstamp) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (b *Bitstamp) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (b *Bitstamp) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (b *Bitstamp) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (b *Bitstamp) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (b *Bitstamp) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitstamp) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bitstamp) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bitstamp) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bitstamp) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -244,3 +278,105 @@ func (b *Bitstamp) GetWebsocket() (*exchange.Websocket, error) {
 func (b *Bitstamp) GetWithdrawCapabilities() uint32 {
 	return b.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (b *Bitstamp) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := b.GetOpenOrders(ctx, "all")
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for i := range resp {
+		orderDate, err := time.Parse(bitstampDateLayout, resp[i].Date)
+		if err != nil {
+			log.Printf("%s unable to parse order time %s: %s", b.Name,
+				resp[i].Date, err)
+		}
+
+		side := exchange.Buy
+		if resp[i].Type == 1 {
+			side = exchange.Sell
+		}
+
+		p := b.GetPairFromSymbol(resp[i].CurrencyPair)
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:      b.Name,
+			ID:            strconv.FormatInt(resp[i].ID, 10),
+			BaseCurrency:  p.FirstCurrency.String(),
+			QuoteCurrency: p.SecondCurrency.String(),
+			OrderSide:     side.ToString(),
+			OrderType:     exchange.Limit.ToString(),
+			CreationTime:  orderDate.Unix(),
+			OrderDate:     orderDate,
+			Price:         resp[i].Price,
+			Amount:        resp[i].Amount,
+			OpenVolume:    resp[i].Amount,
+		})
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (b *Bitstamp) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	if len(getOrdersRequest.Currencies) == 0 {
+		return nil, errors.New("at least one currency pair is required")
+	}
+
+	// Bitstamp only returns past transactions, each trade is reported as a
+	// filled order
+	var orders []exchange.OrderDetail
+	for _, c := range getOrdersRequest.Currencies {
+		base := common.StringToLower(c.FirstCurrency.String())
+		quote := common.StringToLower(c.SecondCurrency.String())
+		resp, err := b.GetUserTransactions(ctx, base+quote)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp {
+			if resp[i].Type != bitstampMarketTrade {
+				continue
+			}
+
+			orderDate, err := time.Parse(bitstampDateLayout, resp[i].Date)
+			if err != nil {
+				log.Printf("%s unable to parse transaction time %s: %s", b.Name,
+					resp[i].Date, err)
+			}
+
+			// The base amount is negative when it was sold
+			amount := resp[i].Amounts[base]
+			side := exchange.Buy
+			if amount < 0 {
+				side = exchange.Sell
+				amount = -amount
+			}
+
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:       b.Name,
+				ID:             strconv.FormatInt(resp[i].OrderID, 10),
+				BaseCurrency:   c.FirstCurrency.String(),
+				QuoteCurrency:  c.SecondCurrency.String(),
+				OrderSide:      side.ToString(),
+				OrderType:      exchange.Limit.ToString(),
+				CreationTime:   orderDate.Unix(),
+				OrderDate:      orderDate,
+				Status:         "FILLED",
+				Price:          resp[i].Amounts[base+"_"+quote],
+				Amount:         amount,
+				ExecutedAmount: amount,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (b *Bitstamp) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
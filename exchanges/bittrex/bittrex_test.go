@@ -1,6 +1,7 @@
 package bittrex
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply you own test keys here to run better tests.
@@ -19,6 +21,12 @@ const (
 
 var b Bittrex
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	b.SetDefaults()
 	if b.GetName() != "Bittrex" {
@@ -49,7 +57,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Errorf("Test Failed - Bittrex - GetMarkets() error: %s", err)
 	}
@@ -57,7 +65,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetCurrencies()
+	_, err := b.GetCurrencies(context.Background())
 	if err != nil {
 		t.Errorf("Test Failed - Bittrex - GetCurrencies() error: %s", err)
 	}
@@ -67,7 +75,7 @@ func TestGetTicker(t *testing.T) {
 	t.Parallel()
 	btc := "btc-ltc"
 
-	_, err := b.GetTicker(btc)
+	_, err := b.GetTicker(context.Background(), btc)
 	if err != nil {
 		t.Errorf("Test Failed - Bittrex - GetTicker() error: %s", err)
 	}
@@ -75,7 +83,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetMarketSummaries(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketSummaries()
+	_, err := b.GetMarketSummaries(context.Background())
 	if err != nil {
 		t.Errorf("Test Failed - Bittrex - GetMarketSummaries() error: %s", err)
 	}
@@ -85,7 +93,7 @@ func TestGetMarketSummary(t *testing.T) {
 	t.Parallel()
 	pairOne := "BTC-LTC"
 
-	_, err := b.GetMarketSummary(pairOne)
+	_, err := b.GetMarketSummary(context.Background(), pairOne)
 	if err != nil {
 		t.Errorf("Test Failed - Bittrex - GetMarketSummary() error: %s", err)
 	}
@@ -94,7 +102,7 @@ func TestGetMarketSummary(t *testing.T) {
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderbook("btc-ltc")
+	_, err := b.GetOrderbook(context.Background(), "btc-ltc")
 	if err != nil {
 		t.Errorf("Test Failed - Bittrex - GetOrderbook() error: %s", err)
 	}
@@ -103,7 +111,7 @@ func TestGetOrderbook(t *testing.T) {
 func TestGetMarketHistory(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetMarketHistory("btc-ltc")
+	_, err := b.GetMarketHistory(context.Background(), "btc-ltc")
 	if err != nil {
 		t.Errorf("Test Failed - Bittrex - GetMarketHistory() error: %s", err)
 	}
@@ -112,7 +120,7 @@ func TestGetMarketHistory(t *testing.T) {
 func TestPlaceBuyLimit(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.PlaceBuyLimit("btc-ltc", 1, 1)
+	_, err := b.PlaceBuyLimit(context.Background(), "btc-ltc", 1, 1)
 	if err == nil {
 		t.Error("Test Failed - Bittrex - PlaceBuyLimit() error")
 	}
@@ -121,7 +129,7 @@ func TestPlaceBuyLimit(t *testing.T) {
 func TestPlaceSellLimit(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.PlaceSellLimit("btc-ltc", 1, 1)
+	_, err := b.PlaceSellLimit(context.Background(), "btc-ltc", 1, 1)
 	if err == nil {
 		t.Error("Test Failed - Bittrex - PlaceSellLimit() error")
 	}
@@ -130,11 +138,11 @@ func TestPlaceSellLimit(t *testing.T) {
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOpenOrders("")
+	_, err := b.GetOpenOrders(context.Background(), "")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetOrder() error")
 	}
-	_, err = b.GetOpenOrders("btc-ltc")
+	_, err = b.GetOpenOrders(context.Background(), "btc-ltc")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetOrder() error")
 	}
@@ -143,7 +151,7 @@ func TestGetOpenOrders(t *testing.T) {
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.CancelExistingOrder("blaaaaaaa")
+	_, err := b.CancelExistingOrder(context.Background(), "blaaaaaaa")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - CancelExistingOrder() error")
 	}
@@ -152,7 +160,7 @@ func TestCancelExistingOrder(t *testing.T) {
 func TestGetAccountBalances(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAccountBalances()
+	_, err := b.GetAccountBalances(context.Background())
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetAccountBalances() error")
 	}
@@ -161,7 +169,7 @@ func TestGetAccountBalances(t *testing.T) {
 func TestGetAccountBalanceByCurrency(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAccountBalanceByCurrency("btc")
+	_, err := b.GetAccountBalanceByCurrency(context.Background(), "btc")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetAccountBalanceByCurrency() error")
 	}
@@ -170,7 +178,7 @@ func TestGetAccountBalanceByCurrency(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetDepositAddress("btc")
+	_, err := b.GetDepositAddress(context.Background(), "btc")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetDepositAddress() error")
 	}
@@ -179,7 +187,7 @@ func TestGetDepositAddress(t *testing.T) {
 func TestWithdraw(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.Withdraw("btc", "something", "someplace", 1)
+	_, err := b.Withdraw(context.Background(), "btc", "something", "someplace", 1)
 	if err == nil {
 		t.Error("Test Failed - Bittrex - Withdraw() error")
 	}
@@ -188,37 +196,37 @@ func TestWithdraw(t *testing.T) {
 func TestGetOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrder("0cb4c4e4-bdc7-4e13-8c13-430e587d2cc1")
+	_, err := b.GetOrder(context.Background(), "0cb4c4e4-bdc7-4e13-8c13-430e587d2cc1")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetOrder() error")
 	}
-	_, err = b.GetOrder("")
+	_, err = b.GetOrder(context.Background(), "")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetOrder() error")
 	}
 }
 
-func TestGetOrderHistory(t *testing.T) {
+func TestGetOrderHistoryForCurrency(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderHistory("")
+	_, err := b.GetOrderHistoryForCurrency(context.Background(), "")
 	if err == nil {
-		t.Error("Test Failed - Bittrex - GetOrderHistory() error")
+		t.Error("Test Failed - Bittrex - GetOrderHistoryForCurrency() error")
 	}
-	_, err = b.GetOrderHistory("btc-ltc")
+	_, err = b.GetOrderHistoryForCurrency(context.Background(), "btc-ltc")
 	if err == nil {
-		t.Error("Test Failed - Bittrex - GetOrderHistory() error")
+		t.Error("Test Failed - Bittrex - GetOrderHistoryForCurrency() error")
 	}
 }
 
 func TestGetwithdrawalHistory(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetWithdrawalHistory("")
+	_, err := b.GetWithdrawalHistory(context.Background(), "")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetWithdrawalHistory() error")
 	}
-	_, err = b.GetWithdrawalHistory("btc-ltc")
+	_, err = b.GetWithdrawalHistory(context.Background(), "btc-ltc")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetWithdrawalHistory() error")
 	}
@@ -227,11 +235,11 @@ func TestGetwithdrawalHistory(t *testing.T) {
 func TestGetDepositHistory(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetDepositHistory("")
+	_, err := b.GetDepositHistory(context.Background(), "")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetDepositHistory() error")
 	}
-	_, err = b.GetDepositHistory("btc-ltc")
+	_, err = b.GetDepositHistory(context.Background(), "btc-ltc")
 	if err == nil {
 		t.Error("Test Failed - Bittrex - GetDepositHistory() error")
 	}
@@ -256,7 +264,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 
 	// CryptocurrencyTradeFee Basic
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0025) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0025) || err != nil {
 		t.Error(err)
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0025), resp)
 	}
@@ -265,7 +273,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(2500) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(2500) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(2500), resp)
 		t.Error(err)
 	}
@@ -273,7 +281,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0025) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0025) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0025), resp)
 		t.Error(err)
 	}
@@ -281,7 +289,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -289,7 +297,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -297,7 +305,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -306,7 +314,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -315,7 +323,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -333,6 +341,59 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Bittrex using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *Bittrex {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	bConfig, err := cfg.GetExchangeConfig("Bittrex")
+	if err != nil {
+		t.Fatal("Test Failed - Bittrex Setup() init error")
+	}
+
+	bConfig.AuthenticatedAPISupport = true
+	bConfig.APIKey, bConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r Bittrex
+	r.SetDefaults()
+	r.Setup(bConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - Bittrex GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 ||
+		orders[0].ID != "09aa5bb6-8232-41aa-9b78-a5a1093e0211" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].QuoteCurrency != symbol.LTC ||
+		orders[0].OrderSide != exchange.Sell.ToString() || orders[0].Price != 0.0125 ||
+		orders[0].Amount != 5 || orders[0].OpenVolume != 5 ||
+		orders[0].OrderDate.Unix() != 1404878148) {
+		t.Errorf("Test Failed - Bittrex GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		OrderSide: exchange.Buy,
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Bittrex GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 ||
+		orders[0].ID != "fd97d393-e9b9-4dd1-9dbf-f288fc72a185" ||
+		orders[0].Amount != 100 || orders[0].OpenVolume != 0) {
+		t.Errorf("Test Failed - Bittrex GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -357,7 +418,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Limit, 1, 1, "clientId")
+	response, err := b.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -383,7 +444,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
@@ -1,9 +1,11 @@
 package bittrex
 
 import (
+	"context"
 	"errors"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -28,7 +30,7 @@ func (b *Bittrex) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", b.GetName(), len(b.EnabledPairs), b.EnabledPairs)
 	}
 
-	exchangeProducts, err := b.GetMarkets()
+	exchangeProducts, err := b.GetMarkets(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", b.GetName())
 	} else {
@@ -62,10 +64,10 @@ func (b *Bittrex) Run() {
 
 // GetAccountInfo Retrieves balances for all enabled currencies for the
 // Bittrex exchange
-func (b *Bittrex) GetAccountInfo() (exchange.AccountInfo, error) {
+func (b *Bittrex) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = b.GetName()
-	accountBalance, err := b.GetAccountBalances()
+	accountBalance, err := b.GetAccountBalances(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -81,9 +83,9 @@ func (b *Bittrex) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bittrex) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bittrex) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := b.GetMarketSummaries()
+	tick, err := b.GetMarketSummaries(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -107,27 +109,27 @@ func (b *Bittrex) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pr
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *Bittrex) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *Bittrex) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(b.GetName(), p, ticker.Spot)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (b *Bittrex) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bittrex) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bittrex) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *Bittrex) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := b.GetOrderbook(exchange.FormatExchangeCurrency(b.GetName(), p).String())
+	orderbookNew, err := b.GetOrderbook(ctx, exchange.FormatExchangeCurrency(b.GetName(), p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -156,33 +158,37 @@ func (b *Bittrex) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderb
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bittrex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bittrex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bittrex) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (b *Bittrex) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bittrex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *Bittrex) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	buy := side == exchange.Buy
+	if err := b.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	buy := order.Side == exchange.Buy
 	var response UUID
 	var err error
 
-	if orderType != exchange.Limit {
+	if order.OrderType != exchange.Limit {
 		return submitOrderResponse, errors.New("not supported on exchange")
 	}
 
 	if buy {
-		response, err = b.PlaceBuyLimit(p.Pair().String(), amount, price)
+		response, err = b.PlaceBuyLimit(ctx, order.Pair.Pair().String(), order.Amount, order.Price)
 	} else {
-		response, err = b.PlaceSellLimit(p.Pair().String(), amount, price)
+		response, err = b.PlaceSellLimit(ctx, order.Pair.Pair().String(), order.Amount, order.Price)
 	}
 
 	if response.Result.ID != "" {
@@ -198,42 +204,69 @@ func (b *Bittrex) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orde
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bittrex) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (b *Bittrex) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bittrex) CancelOrder(order exchange.OrderCancellation) error {
-	_, err := b.CancelExistingOrder(order.OrderID)
+func (b *Bittrex) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
+	_, err := b.CancelExistingOrder(ctx, order.OrderID)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bittrex) CancelAllOrders() error {
+func (b *Bittrex) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bittrex) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (b *Bittrex) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bittrex) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (b *Bittrex) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (b *Bittrex) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (b *Bittrex) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (b *Bittrex) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (b *Bittrex) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (b *Bittrex) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bittrex) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bittrex) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bittrex) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (b *Bittrex) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -250,7 +283,7 @@ func (b *Bittrex) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (b *Bittrex) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return b.GetFee(feeBuilder)
+	return b.GetFee(context.Background(), feeBuilder)
 
 }
 
@@ -258,3 +291,64 @@ func (b *Bittrex) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error)
 func (b *Bittrex) GetWithdrawCapabilities() uint32 {
 	return b.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (b *Bittrex) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := b.GetOpenOrders(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return exchange.FilterOrders(b.formatOrderDetails(resp), getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (b *Bittrex) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := b.GetOrderHistoryForCurrency(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return exchange.FilterOrders(b.formatOrderDetails(resp), getOrdersRequest), nil
+}
+
+// formatOrderDetails converts a Bittrex order response into a list of
+// exchange.OrderDetail
+func (b *Bittrex) formatOrderDetails(resp Order) []exchange.OrderDetail {
+	var orders []exchange.OrderDetail
+	for i := range resp.Result {
+		orderDate, err := time.Parse(bittrexDateLayout, resp.Result[i].Opened)
+		if err != nil {
+			log.Printf("%s unable to parse order time %s: %s", b.Name,
+				resp.Result[i].Opened, err)
+		}
+
+		side := exchange.Buy
+		if common.StringContains(resp.Result[i].Type, "SELL") {
+			side = exchange.Sell
+		}
+
+		p := pair.NewCurrencyPairDelimiter(resp.Result[i].Exchange, "-")
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:      b.Name,
+			ID:            resp.Result[i].OrderUUID,
+			BaseCurrency:  p.FirstCurrency.String(),
+			QuoteCurrency: p.SecondCurrency.String(),
+			OrderSide:     side.ToString(),
+			OrderType:     exchange.Limit.ToString(),
+			CreationTime:  orderDate.Unix(),
+			OrderDate:     orderDate,
+			Price:         resp.Result[i].Limit,
+			Amount:        resp.Result[i].Quantity,
+			OpenVolume:    resp.Result[i].QuantityRemaining,
+		})
+	}
+	return orders
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (b *Bittrex) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
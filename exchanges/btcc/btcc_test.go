@@ -1,6 +1,7 @@
 package btcc
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -68,13 +69,13 @@ func TestSetup(t *testing.T) {
 // 	}
 // }
 
-// func TestGetAccountInfo(t *testing.T) {
-// 	t.Skip()
-// 	err := b.GetAccountInfo("")
-// 	if err == nil {
-// 		t.Error("Test failed - GetAccountInfo() error", err)
-// 	}
-// }
+//	func TestGetAccountInfo(t *testing.T) {
+//		t.Skip()
+//		err := b.GetAccountInfo("")
+//		if err == nil {
+//			t.Error("Test failed - GetAccountInfo() error", err)
+//		}
+//	}
 func setFeeBuilder() exchange.FeeBuilder {
 	return exchange.FeeBuilder{
 		Amount:         1,
@@ -195,7 +196,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Limit, 1, 1, "clientId")
+	response, err := b.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -221,7 +222,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
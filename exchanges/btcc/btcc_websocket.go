@@ -223,13 +223,15 @@ func (b *BTCC) WsHandleData() {
 
 				tick := exchange.TickerData{}
 				tick.AssetType = "SPOT"
-				tick.ClosePrice = ticker.PrevCls
+				tick.ClosePrice = ticker.Last
 				tick.Exchange = b.GetName()
 				tick.HighPrice = ticker.High
 				tick.LowPrice = ticker.Low
 				tick.OpenPrice = ticker.Open
 				tick.Pair = pair.NewCurrencyPairFromString(ticker.Symbol)
 				tick.Quantity = ticker.Volume
+				tick.BidPrice = ticker.BidPrice
+				tick.AskPrice = ticker.AskPrice
 				timestamp := time.Unix(ticker.Timestamp, 0)
 				tick.Timestamp = timestamp
 
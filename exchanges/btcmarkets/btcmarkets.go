@@ -2,6 +2,7 @@ package btcmarkets
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -114,7 +115,7 @@ func (b *BTCMarkets) Setup(exch config.ExchangeConfig) {
 }
 
 // GetMarkets returns the BTCMarkets instruments
-func (b *BTCMarkets) GetMarkets() ([]Market, error) {
+func (b *BTCMarkets) GetMarkets(ctx context.Context) ([]Market, error) {
 	type marketsResp struct {
 		Response
 		Markets []Market `json:"markets"`
@@ -123,7 +124,7 @@ func (b *BTCMarkets) GetMarkets() ([]Market, error) {
 	var resp marketsResp
 	path := fmt.Sprintf("%s/v2/market/active", b.APIUrl)
 
-	err := b.SendHTTPRequest(path, &resp)
+	err := b.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -137,38 +138,38 @@ func (b *BTCMarkets) GetMarkets() ([]Market, error) {
 
 // GetTicker returns a ticker
 // symbol - example "btc" or "ltc"
-func (b *BTCMarkets) GetTicker(firstPair, secondPair string) (Ticker, error) {
+func (b *BTCMarkets) GetTicker(ctx context.Context, firstPair, secondPair string) (Ticker, error) {
 	ticker := Ticker{}
 	path := fmt.Sprintf("%s/market/%s/%s/tick",
 		b.APIUrl,
 		common.StringToUpper(firstPair),
 		common.StringToUpper(secondPair))
 
-	return ticker, b.SendHTTPRequest(path, &ticker)
+	return ticker, b.SendHTTPRequest(ctx, path, &ticker)
 }
 
 // GetOrderbook returns current orderbook
 // symbol - example "btc" or "ltc"
-func (b *BTCMarkets) GetOrderbook(firstPair, secondPair string) (Orderbook, error) {
+func (b *BTCMarkets) GetOrderbook(ctx context.Context, firstPair, secondPair string) (Orderbook, error) {
 	orderbook := Orderbook{}
 	path := fmt.Sprintf("%s/market/%s/%s/orderbook",
 		b.APIUrl,
 		common.StringToUpper(firstPair),
 		common.StringToUpper(secondPair))
 
-	return orderbook, b.SendHTTPRequest(path, &orderbook)
+	return orderbook, b.SendHTTPRequest(ctx, path, &orderbook)
 }
 
 // GetTrades returns executed trades on the exchange
 // symbol - example "btc" or "ltc"
 // values - optional paramater "since" example values.Set(since, "59868345231")
-func (b *BTCMarkets) GetTrades(firstPair, secondPair string, values url.Values) ([]Trade, error) {
+func (b *BTCMarkets) GetTrades(ctx context.Context, firstPair, secondPair string, values url.Values) ([]Trade, error) {
 	trades := []Trade{}
 	path := common.EncodeURLValues(fmt.Sprintf("%s/market/%s/%s/trades",
 		b.APIUrl, common.StringToUpper(firstPair),
 		common.StringToUpper(secondPair)), values)
 
-	return trades, b.SendHTTPRequest(path, &trades)
+	return trades, b.SendHTTPRequest(ctx, path, &trades)
 }
 
 // NewOrder requests a new order and returns an ID
@@ -179,7 +180,7 @@ func (b *BTCMarkets) GetTrades(firstPair, secondPair string, values url.Values)
 // orderside - example "Bid" or "Ask"
 // orderType - example "limit"
 // clientReq - example "abc-cdf-1000"
-func (b *BTCMarkets) NewOrder(currency, instrument string, price, amount float64, orderSide, orderType, clientReq string) (int64, error) {
+func (b *BTCMarkets) NewOrder(ctx context.Context, currency, instrument string, price, amount float64, orderSide, orderType, clientReq string) (int64, error) {
 	newPrice := int64(price * float64(common.SatoshisPerBTC))
 	newVolume := int64(amount * float64(common.SatoshisPerBTC))
 
@@ -195,7 +196,7 @@ func (b *BTCMarkets) NewOrder(currency, instrument string, price, amount float64
 
 	resp := Response{}
 
-	err := b.SendAuthenticatedRequest("POST", btcMarketsOrderCreate, order, &resp)
+	err := b.SendAuthenticatedRequest(ctx, "POST", btcMarketsOrderCreate, order, &resp)
 	if err != nil {
 		return 0, err
 	}
@@ -208,7 +209,7 @@ func (b *BTCMarkets) NewOrder(currency, instrument string, price, amount float64
 
 // CancelExistingOrder cancels an order by its ID
 // orderID - id for order example "1337"
-func (b *BTCMarkets) CancelExistingOrder(orderID []int64) (bool, error) {
+func (b *BTCMarkets) CancelExistingOrder(ctx context.Context, orderID []int64) (bool, error) {
 	resp := Response{}
 	type CancelOrder struct {
 		OrderIDs []int64 `json:"orderIds"`
@@ -216,7 +217,7 @@ func (b *BTCMarkets) CancelExistingOrder(orderID []int64) (bool, error) {
 	orders := CancelOrder{}
 	orders.OrderIDs = append(orders.OrderIDs, orderID...)
 
-	err := b.SendAuthenticatedRequest("POST", btcMarketsOrderCancel, orders, &resp)
+	err := b.SendAuthenticatedRequest(ctx, "POST", btcMarketsOrderCancel, orders, &resp)
 	if err != nil {
 		return false, err
 	}
@@ -248,7 +249,7 @@ func (b *BTCMarkets) CancelExistingOrder(orderID []int64) (bool, error) {
 // limit - example "10"
 // since - since a time example "33434568724"
 // historic - if false just normal Orders open
-func (b *BTCMarkets) GetOrders(currency, instrument string, limit, since int64, historic bool) ([]Order, error) {
+func (b *BTCMarkets) GetOrders(ctx context.Context, currency, instrument string, limit, since int64, historic bool) ([]Order, error) {
 	request := make(map[string]interface{})
 
 	if currency != "" {
@@ -271,7 +272,7 @@ func (b *BTCMarkets) GetOrders(currency, instrument string, limit, since int64,
 
 	resp := Response{}
 
-	err := b.SendAuthenticatedRequest("POST", path, request, &resp)
+	err := b.SendAuthenticatedRequest(ctx, "POST", path, request, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +297,7 @@ func (b *BTCMarkets) GetOrders(currency, instrument string, limit, since int64,
 
 // GetOrderDetail returns order information an a specific order
 // orderID - example "1337"
-func (b *BTCMarkets) GetOrderDetail(orderID []int64) ([]Order, error) {
+func (b *BTCMarkets) GetOrderDetail(ctx context.Context, orderID []int64) ([]Order, error) {
 	type OrderDetail struct {
 		OrderIDs []int64 `json:"orderIds"`
 	}
@@ -305,7 +306,7 @@ func (b *BTCMarkets) GetOrderDetail(orderID []int64) ([]Order, error) {
 
 	resp := Response{}
 
-	err := b.SendAuthenticatedRequest("POST", btcMarketsOrderDetail, orders, &resp)
+	err := b.SendAuthenticatedRequest(ctx, "POST", btcMarketsOrderDetail, orders, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -329,10 +330,10 @@ func (b *BTCMarkets) GetOrderDetail(orderID []int64) ([]Order, error) {
 }
 
 // GetAccountBalance returns the full account balance
-func (b *BTCMarkets) GetAccountBalance() ([]AccountBalance, error) {
+func (b *BTCMarkets) GetAccountBalance(ctx context.Context) ([]AccountBalance, error) {
 	balance := []AccountBalance{}
 
-	err := b.SendAuthenticatedRequest("GET", btcMarketsAccountBalance, nil, &balance)
+	err := b.SendAuthenticatedRequest(ctx, "GET", btcMarketsAccountBalance, nil, &balance)
 	if err != nil {
 		return nil, err
 	}
@@ -346,14 +347,14 @@ func (b *BTCMarkets) GetAccountBalance() ([]AccountBalance, error) {
 }
 
 // GetTradingFee returns the account's trading fee for a currency pair
-func (b *BTCMarkets) GetTradingFee(firstPair, secondPair string) (TradingFee, error) {
+func (b *BTCMarkets) GetTradingFee(ctx context.Context, firstPair, secondPair string) (TradingFee, error) {
 	var tradingFee TradingFee
 	path := fmt.Sprintf(btcMarketsTradingFee, firstPair, secondPair)
-	return tradingFee, b.SendAuthenticatedRequest("GET", path, nil, &tradingFee)
+	return tradingFee, b.SendAuthenticatedRequest(ctx, "GET", path, nil, &tradingFee)
 }
 
 // WithdrawCrypto withdraws cryptocurrency into a designated address
-func (b *BTCMarkets) WithdrawCrypto(amount float64, currency, address string) (string, error) {
+func (b *BTCMarkets) WithdrawCrypto(ctx context.Context, amount float64, currency, address string) (string, error) {
 	newAmount := int64(amount * float64(common.SatoshisPerBTC))
 
 	req := WithdrawRequestCrypto{
@@ -363,7 +364,7 @@ func (b *BTCMarkets) WithdrawCrypto(amount float64, currency, address string) (s
 	}
 
 	resp := Response{}
-	err := b.SendAuthenticatedRequest("POST", btcMarketsWithdrawCrypto, req, &resp)
+	err := b.SendAuthenticatedRequest(ctx, "POST", btcMarketsWithdrawCrypto, req, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -377,7 +378,7 @@ func (b *BTCMarkets) WithdrawCrypto(amount float64, currency, address string) (s
 
 // WithdrawAUD withdraws AUD into a designated bank address
 // Does not return a TxID!
-func (b *BTCMarkets) WithdrawAUD(accountName, accountNumber, bankName, bsbNumber string, amount float64) (string, error) {
+func (b *BTCMarkets) WithdrawAUD(ctx context.Context, accountName, accountNumber, bankName, bsbNumber string, amount float64) (string, error) {
 	newAmount := int64(amount * float64(common.SatoshisPerBTC))
 
 	req := WithdrawRequestAUD{
@@ -390,7 +391,7 @@ func (b *BTCMarkets) WithdrawAUD(accountName, accountNumber, bankName, bsbNumber
 	}
 
 	resp := Response{}
-	err := b.SendAuthenticatedRequest("POST", btcMarketsWithdrawAud, req, &resp)
+	err := b.SendAuthenticatedRequest(ctx, "POST", btcMarketsWithdrawAud, req, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -403,12 +404,12 @@ func (b *BTCMarkets) WithdrawAUD(accountName, accountNumber, bankName, bsbNumber
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (b *BTCMarkets) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload("GET", path, nil, nil, result, false, b.Verbose)
+func (b *BTCMarkets) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return b.SendPayload(ctx, "GET", path, nil, nil, result, false, b.Verbose)
 }
 
 // SendAuthenticatedRequest sends an authenticated HTTP request
-func (b *BTCMarkets) SendAuthenticatedRequest(reqType, path string, data interface{}, result interface{}) (err error) {
+func (b *BTCMarkets) SendAuthenticatedRequest(ctx context.Context, reqType, path string, data interface{}, result interface{}) (err error) {
 	if !b.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -445,16 +446,16 @@ func (b *BTCMarkets) SendAuthenticatedRequest(reqType, path string, data interfa
 	headers["timestamp"] = b.Nonce.String()[0:13]
 	headers["signature"] = common.Base64Encode(hmac)
 
-	return b.SendPayload(reqType, b.APIUrl+path, headers, bytes.NewBuffer(payload), result, true, b.Verbose)
+	return b.SendPayload(ctx, reqType, b.APIUrl+path, headers, bytes.NewBuffer(payload), result, true, b.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *BTCMarkets) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (b *BTCMarkets) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		tradingFee, err := b.GetTradingFee(feeBuilder.FirstCurrency, feeBuilder.SecondCurrency)
+		tradingFee, err := b.GetTradingFee(ctx, feeBuilder.FirstCurrency, feeBuilder.SecondCurrency)
 		if err != nil {
 			return 0, err
 		}
@@ -1,17 +1,26 @@
 package btcmarkets
 
 import (
+	"context"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var b BTCMarkets
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 // Please supply your own keys here to do better tests
 const (
 	apiKey                  = ""
@@ -42,7 +51,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Error("Test failed - GetMarkets() error", err)
 	}
@@ -50,7 +59,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker("BTC", "AUD")
+	_, err := b.GetTicker(context.Background(), "BTC", "AUD")
 	if err != nil {
 		t.Error("Test failed - GetTicker() error", err)
 	}
@@ -58,7 +67,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderbook("BTC", "AUD")
+	_, err := b.GetOrderbook(context.Background(), "BTC", "AUD")
 	if err != nil {
 		t.Error("Test failed - GetOrderbook() error", err)
 	}
@@ -66,14 +75,14 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTrades("BTC", "AUD", nil)
+	_, err := b.GetTrades(context.Background(), "BTC", "AUD", nil)
 	if err != nil {
 		t.Error("Test failed - GetTrades() error", err)
 	}
 
 	val := url.Values{}
 	val.Set("since", "0")
-	_, err = b.GetTrades("BTC", "AUD", val)
+	_, err = b.GetTrades(context.Background(), "BTC", "AUD", val)
 	if err != nil {
 		t.Error("Test failed - GetTrades() error", err)
 	}
@@ -81,7 +90,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestNewOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.NewOrder("AUD", "BTC", 0, 0, "Bid", "limit", "testTest")
+	_, err := b.NewOrder(context.Background(), "AUD", "BTC", 0, 0, "Bid", "limit", "testTest")
 	if err == nil {
 		t.Error("Test failed - NewOrder() error", err)
 	}
@@ -89,7 +98,7 @@ func TestNewOrder(t *testing.T) {
 
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.CancelExistingOrder([]int64{1337})
+	_, err := b.CancelExistingOrder(context.Background(), []int64{1337})
 	if err == nil {
 		t.Error("Test failed - CancelExistingOrder() error", err)
 	}
@@ -97,11 +106,11 @@ func TestCancelExistingOrder(t *testing.T) {
 
 func TestGetOrders(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrders("AUD", "BTC", 10, 0, false)
+	_, err := b.GetOrders(context.Background(), "AUD", "BTC", 10, 0, false)
 	if err == nil {
 		t.Error("Test failed - GetOrders() error", err)
 	}
-	_, err = b.GetOrders("AUD", "BTC", 10, 0, true)
+	_, err = b.GetOrders(context.Background(), "AUD", "BTC", 10, 0, true)
 	if err == nil {
 		t.Error("Test failed - GetOrders() error", err)
 	}
@@ -109,7 +118,7 @@ func TestGetOrders(t *testing.T) {
 
 func TestGetOrderDetail(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderDetail([]int64{1337})
+	_, err := b.GetOrderDetail(context.Background(), []int64{1337})
 	if err == nil {
 		t.Error("Test failed - GetOrderDetail() error", err)
 	}
@@ -117,7 +126,7 @@ func TestGetOrderDetail(t *testing.T) {
 
 func TestGetAccountBalance(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetAccountBalance()
+	_, err := b.GetAccountBalance(context.Background())
 	if err == nil {
 		t.Error("Test failed - GetAccountBalance() error", err)
 	}
@@ -125,7 +134,7 @@ func TestGetAccountBalance(t *testing.T) {
 
 func TestWithdrawCrypto(t *testing.T) {
 	t.Parallel()
-	_, err := b.WithdrawCrypto(0, "BTC", "LOLOLOL")
+	_, err := b.WithdrawCrypto(context.Background(), 0, "BTC", "LOLOLOL")
 	if err == nil {
 		t.Error("Test failed - WithdrawCrypto() error", err)
 	}
@@ -133,28 +142,28 @@ func TestWithdrawCrypto(t *testing.T) {
 
 func TestWithdrawAUD(t *testing.T) {
 	t.Parallel()
-	_, err := b.WithdrawAUD("BLA", "1337", "blawest", "1336", 10000000)
+	_, err := b.WithdrawAUD(context.Background(), "BLA", "1337", "blawest", "1336", 10000000)
 	if err == nil {
 		t.Error("Test failed - WithdrawAUD() error", err)
 	}
 }
 
 func TestGetAccountInfo(t *testing.T) {
-	_, err := b.GetAccountInfo()
+	_, err := b.GetAccountInfo(context.Background())
 	if err == nil {
 		t.Error("Test failed - GetAccountInfo() error", err)
 	}
 }
 
 func TestGetFundingHistory(t *testing.T) {
-	_, err := b.GetFundingHistory()
+	_, err := b.GetFundingHistory(context.Background())
 	if err == nil {
 		t.Error("Test failed - GetAccountInfo() error", err)
 	}
 }
 
 func TestModifyOrder(t *testing.T) {
-	_, err := b.ModifyOrder(1337, exchange.ModifyOrder{})
+	_, err := b.ModifyOrder(context.Background(), "1337", exchange.ModifyOrder{})
 	if err == nil {
 		t.Error("Test failed - ModifyOrder() error", err)
 	}
@@ -162,7 +171,7 @@ func TestModifyOrder(t *testing.T) {
 
 func TestCancelOrder(t *testing.T) {
 
-	_, err := b.CancelExistingOrder([]int64{1337})
+	_, err := b.CancelExistingOrder(context.Background(), []int64{1337})
 
 	if err == nil {
 		t.Error("Test failed - CancelgOrder() error", err)
@@ -170,28 +179,28 @@ func TestCancelOrder(t *testing.T) {
 }
 
 func TestCancelAllOrders(t *testing.T) {
-	err := b.CancelAllOrders()
+	err := b.CancelAllOrders(context.Background())
 	if err == nil {
 		t.Error("Test failed - CancelAllOrders() error", err)
 	}
 }
 
 func TestGetOrderInfo(t *testing.T) {
-	_, err := b.GetOrderInfo(1337)
+	_, err := b.GetOrderInfo(context.Background(), "1337")
 	if err == nil {
 		t.Error("Test failed - GetOrderInfo() error", err)
 	}
 }
 
 func TestWithdrawCryptocurrencyFunds(t *testing.T) {
-	_, err := b.WithdrawCryptocurrencyFunds("someaddress", "ltc", 0)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), "someaddress", "ltc", 0)
 	if err == nil {
 		t.Error("Test failed - WithdrawExchangeFunds() error", err)
 	}
 }
 
 func TestWithdrawFiatFunds(t *testing.T) {
-	_, err := b.WithdrawFiatFunds("AUD", 0)
+	_, err := b.WithdrawFiatFunds(context.Background(), "AUD", 0)
 	if err == nil {
 		t.Error("Test failed - WithdrawFiatFunds() error", err)
 	}
@@ -219,14 +228,14 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Fiat
 		feeBuilder = setFeeBuilder()
 		feeBuilder.SecondCurrency = symbol.USD
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.00849999) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.00849999) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.00849999), resp)
 		}
 
 		// CryptocurrencyTradeFee Basic
 		feeBuilder = setFeeBuilder()
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.0022) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0022) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0022), resp)
 		}
@@ -235,7 +244,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(2200) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(2200) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(22000), resp)
 			t.Error(err)
 		}
@@ -243,7 +252,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.0022) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0022) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0022), resp)
 			t.Error(err)
 		}
@@ -251,7 +260,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -260,7 +269,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -268,7 +277,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -277,7 +286,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.AUD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -286,7 +295,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.AUD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -304,6 +313,65 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a BTCMarkets using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *BTCMarkets {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	bConfig, err := cfg.GetExchangeConfig("BTC Markets")
+	if err != nil {
+		t.Fatal("Test Failed - BTC Markets Setup() init error")
+	}
+
+	bConfig.AuthenticatedAPISupport = true
+	bConfig.APIKey, bConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r BTCMarkets
+	r.SetDefaults()
+	r.Setup(bConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	_, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err == nil {
+		t.Error("Test Failed - BTC Markets GetActiveOrders() expected an error without currencies")
+	}
+
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.BTC, symbol.AUD)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - BTC Markets GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "4345675" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].QuoteCurrency != symbol.AUD ||
+		orders[0].Price != 8500 || orders[0].Amount != 0.5 || orders[0].OpenVolume != 0.2 ||
+		orders[0].ExecutedAmount != 0.3 || orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - BTC Markets GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.BTC, symbol.AUD)},
+		StartTicks: time.Unix(1530000000, 0),
+	})
+	if err != nil {
+		t.Fatal("Test Failed - BTC Markets GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "4345611" ||
+		orders[0].Status != "Fully Matched" || orders[0].OpenVolume != 0 ||
+		orders[0].ExecutedAmount != 1.25) {
+		t.Errorf("Test Failed - BTC Markets GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -328,7 +396,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 	}
-	response, err := b.SubmitOrder(p, exchange.Buy, exchange.Limit, 1, 1, "clientId")
+	response, err := b.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -354,7 +422,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
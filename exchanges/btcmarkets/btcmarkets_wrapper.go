@@ -1,11 +1,13 @@
 package btcmarkets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 
@@ -31,7 +33,7 @@ func (b *BTCMarkets) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", b.GetName(), len(b.EnabledPairs), b.EnabledPairs)
 	}
 
-	markets, err := b.GetMarkets()
+	markets, err := b.GetMarkets(context.Background())
 	if err != nil {
 		log.Printf("%s failed to get active market. Err: %s", b.Name, err)
 	} else {
@@ -62,9 +64,9 @@ func (b *BTCMarkets) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *BTCMarkets) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *BTCMarkets) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := b.GetTicker(p.FirstCurrency.String(),
+	tick, err := b.GetTicker(ctx, p.FirstCurrency.String(),
 		p.SecondCurrency.String())
 	if err != nil {
 		return tickerPrice, err
@@ -78,27 +80,27 @@ func (b *BTCMarkets) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (b *BTCMarkets) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (b *BTCMarkets) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (b *BTCMarkets) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *BTCMarkets) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(b.GetName(), p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *BTCMarkets) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (b *BTCMarkets) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := b.GetOrderbook(p.FirstCurrency.String(),
+	orderbookNew, err := b.GetOrderbook(ctx, p.FirstCurrency.String(),
 		p.SecondCurrency.String())
 	if err != nil {
 		return orderBook, err
@@ -120,11 +122,11 @@ func (b *BTCMarkets) UpdateOrderbook(p pair.CurrencyPair, assetType string) (ord
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // BTCMarkets exchange
-func (b *BTCMarkets) GetAccountInfo() (exchange.AccountInfo, error) {
+func (b *BTCMarkets) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = b.GetName()
 
-	accountBalance, err := b.GetAccountBalance()
+	accountBalance, err := b.GetAccountBalance(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -142,22 +144,26 @@ func (b *BTCMarkets) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *BTCMarkets) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *BTCMarkets) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *BTCMarkets) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (b *BTCMarkets) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *BTCMarkets) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (b *BTCMarkets) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	response, err := b.NewOrder(p.FirstCurrency.Upper().String(), p.SecondCurrency.Upper().String(), price, amount, side.ToString(), orderType.ToString(), clientID)
+	if err := b.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	response, err := b.NewOrder(ctx, order.Pair.FirstCurrency.Upper().String(), order.Pair.SecondCurrency.Upper().String(), order.Price, order.Amount, order.Side.ToString(), order.OrderType.ToString(), order.ClientID)
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -172,38 +178,32 @@ func (b *BTCMarkets) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, o
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *BTCMarkets) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrFunctionNotSupported
+func (b *BTCMarkets) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *BTCMarkets) CancelOrder(order exchange.OrderCancellation) error {
+func (b *BTCMarkets) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
-	_, err = b.CancelExistingOrder([]int64{orderIDInt})
+	_, err = b.CancelExistingOrder(ctx, []int64{orderIDInt})
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *BTCMarkets) CancelAllOrders() error {
-	orders, err := b.GetOrders("", "", 0, 0, true)
+func (b *BTCMarkets) CancelAllOrders(ctx context.Context) error {
+	orders, err := b.GetOrders(ctx, "", "", 0, 0, true)
 	if err != nil {
 		return err
 	}
 
 	var orderList []int64
 	for _, order := range orders {
-		orderIDInt, strconvErr := strconv.ParseInt(order.ID, 10, 64)
-
-		if strconvErr != nil {
-			return strconvErr
-		}
-
-		orderList = append(orderList, orderIDInt)
+		orderList = append(orderList, order.ID)
 	}
 
-	_, err = b.CancelExistingOrder(orderList)
+	_, err = b.CancelExistingOrder(ctx, orderList)
 	if err != nil {
 		return err
 	}
@@ -211,10 +211,15 @@ func (b *BTCMarkets) CancelAllOrders() error {
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *BTCMarkets) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (b *BTCMarkets) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var OrderDetail exchange.OrderDetail
 
-	orders, err := b.GetOrderDetail([]int64{orderID})
+	id, err := strconv.ParseInt(orderID, 10, 64)
+	if err != nil {
+		return OrderDetail, err
+	}
+
+	orders, err := b.GetOrderDetail(ctx, []int64{id})
 	if err != nil {
 		return OrderDetail, err
 	}
@@ -227,41 +232,54 @@ func (b *BTCMarkets) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
 		return OrderDetail, errors.New("no orders found")
 	}
 
-	for _, order := range orders {
-		OrderDetail.Amount = order.Volume
-		OrderDetail.BaseCurrency = order.Currency
-		OrderDetail.CreationTime = int64(order.CreationTime)
-		OrderDetail.Exchange = b.GetName()
-		OrderDetail.ID = order.ID
-		OrderDetail.OpenVolume = order.OpenVolume
-		OrderDetail.OrderSide = order.OrderSide
-		OrderDetail.OrderType = order.OrderType
-		OrderDetail.Price = order.Price
-		OrderDetail.QuoteCurrency = order.Instrument
-		OrderDetail.Status = order.Status
-	}
-
-	return OrderDetail, nil
+	return b.formatOrderDetail(&orders[0]), nil
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *BTCMarkets) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (b *BTCMarkets) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (b *BTCMarkets) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (b *BTCMarkets) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (b *BTCMarkets) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (b *BTCMarkets) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (b *BTCMarkets) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is submitted
-func (b *BTCMarkets) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
-	return b.WithdrawCrypto(amount, cryptocurrency.String(), address)
+func (b *BTCMarkets) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+	return b.WithdrawCrypto(ctx, amount, cryptocurrency.String(), address)
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *BTCMarkets) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (b *BTCMarkets) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	bd, err := b.GetClientBankAccounts(b.Name, currency.Upper().String())
 	if err != nil {
 		return "", err
 	}
-	return b.WithdrawAUD(bd.AccountName, bd.AccountNumber, bd.BankName, bd.BSBNumber, amount)
+	return b.WithdrawAUD(ctx, bd.AccountName, bd.AccountNumber, bd.BankName, bd.BSBNumber, amount)
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
@@ -277,10 +295,77 @@ func (b *BTCMarkets) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (b *BTCMarkets) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return b.GetFee(feeBuilder)
+	return b.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (b *BTCMarkets) GetWithdrawCapabilities() uint32 {
 	return b.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (b *BTCMarkets) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return b.getOrders(ctx, getOrdersRequest, false)
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (b *BTCMarkets) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return b.getOrders(ctx, getOrdersRequest, true)
+}
+
+// getOrders returns the open or historic orders of each requested currency
+// pair, BTC Markets requires the currency and instrument so at least one
+// currency pair must be requested
+func (b *BTCMarkets) getOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest, historic bool) ([]exchange.OrderDetail, error) {
+	if len(getOrdersRequest.Currencies) == 0 {
+		return nil, errors.New("at least one currency pair is required")
+	}
+
+	var since int64
+	if !getOrdersRequest.StartTicks.IsZero() {
+		since = getOrdersRequest.StartTicks.UnixNano() / int64(time.Millisecond)
+	}
+
+	var orders []exchange.OrderDetail
+	for _, c := range getOrdersRequest.Currencies {
+		resp, err := b.GetOrders(ctx, c.SecondCurrency.String(),
+			c.FirstCurrency.String(), 200, since, historic)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp {
+			orders = append(orders, b.formatOrderDetail(&resp[i]))
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// formatOrderDetail converts a BTC Markets order into an exchange.OrderDetail,
+// the instrument is the base currency and the currency is the quote currency
+func (b *BTCMarkets) formatOrderDetail(order *Order) exchange.OrderDetail {
+	orderDate := time.Unix(0, int64(order.CreationTime)*int64(time.Millisecond))
+	return exchange.OrderDetail{
+		Exchange:       b.Name,
+		ID:             strconv.FormatInt(order.ID, 10),
+		BaseCurrency:   order.Instrument,
+		QuoteCurrency:  order.Currency,
+		OrderSide:      order.OrderSide,
+		OrderType:      order.OrderType,
+		CreationTime:   orderDate.Unix(),
+		OrderDate:      orderDate,
+		Status:         order.Status,
+		Price:          order.Price,
+		Amount:         order.Volume,
+		OpenVolume:     order.OpenVolume,
+		ExecutedAmount: order.Volume - order.OpenVolume,
+	}
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (b *BTCMarkets) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
@@ -2,6 +2,7 @@ package coinbasepro
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -54,12 +55,19 @@ const (
 
 	coinbaseproAuthRate   = 5
 	coinbaseproUnauthRate = 3
+
+	// coinbaseproMaxHistoricRates is the maximum amount of historic rates
+	// returned per request
+	coinbaseproMaxHistoricRates = 300
 )
 
 // CoinbasePro is the overarching type across the coinbasepro package
 type CoinbasePro struct {
 	exchange.Base
 	WebsocketConn *websocket.Conn
+
+	// wsSequences holds the last websocket sequence seen for each product
+	wsSequences map[string]int64
 }
 
 // SetDefaults sets default values for the exchange
@@ -71,6 +79,14 @@ func (c *CoinbasePro) SetDefaults() {
 	c.MakerFee = 0
 	c.RESTPollingDelay = 10
 	c.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithAPIPermission | exchange.AutoWithdrawFiatWithAPIPermission
+	c.OrderSupport = exchange.OrderSupport{
+		OrderTypes: []exchange.OrderType{exchange.Limit, exchange.Market,
+			exchange.Stop, exchange.StopLimit, exchange.TakeProfit,
+			exchange.TakeProfitLimit},
+		TimeInForce: []exchange.TimeInForce{exchange.GoodTillCancel,
+			exchange.ImmediateOrCancel, exchange.FillOrKill},
+		PostOnly: true,
+	}
 	c.RequestCurrencyPairFormat.Delimiter = "-"
 	c.RequestCurrencyPairFormat.Uppercase = true
 	c.ConfigCurrencyPairFormat.Delimiter = ""
@@ -134,19 +150,23 @@ func (c *CoinbasePro) Setup(exch config.ExchangeConfig) {
 		if err != nil {
 			log.Fatal(err)
 		}
+
+		if c.AuthenticatedAPISupport {
+			c.Websocket.SetAuthenticator(c.WsAuthenticate)
+		}
 	}
 }
 
 // GetProducts returns supported currency pairs on the exchange with specific
 // information about the pair
-func (c *CoinbasePro) GetProducts() ([]Product, error) {
+func (c *CoinbasePro) GetProducts(ctx context.Context) ([]Product, error) {
 	products := []Product{}
 
-	return products, c.SendHTTPRequest(c.APIUrl+coinbaseproProducts, &products)
+	return products, c.SendHTTPRequest(ctx, c.APIUrl+coinbaseproProducts, &products)
 }
 
 // GetOrderbook returns orderbook by currency pair and level
-func (c *CoinbasePro) GetOrderbook(symbol string, level int) (interface{}, error) {
+func (c *CoinbasePro) GetOrderbook(ctx context.Context, symbol string, level int) (interface{}, error) {
 	orderbook := OrderbookResponse{}
 
 	path := fmt.Sprintf("%s/%s/%s", c.APIUrl+coinbaseproProducts, symbol, coinbaseproOrderbook)
@@ -155,7 +175,7 @@ func (c *CoinbasePro) GetOrderbook(symbol string, level int) (interface{}, error
 		path = fmt.Sprintf("%s/%s/%s?level=%s", c.APIUrl+coinbaseproProducts, symbol, coinbaseproOrderbook, levelStr)
 	}
 
-	if err := c.SendHTTPRequest(path, &orderbook); err != nil {
+	if err := c.SendHTTPRequest(ctx, path, &orderbook); err != nil {
 		return nil, err
 	}
 
@@ -219,27 +239,27 @@ func (c *CoinbasePro) GetOrderbook(symbol string, level int) (interface{}, error
 
 // GetTicker returns ticker by currency pair
 // currencyPair - example "BTC-USD"
-func (c *CoinbasePro) GetTicker(currencyPair string) (Ticker, error) {
+func (c *CoinbasePro) GetTicker(ctx context.Context, currencyPair string) (Ticker, error) {
 	ticker := Ticker{}
 	path := fmt.Sprintf(
 		"%s/%s/%s", c.APIUrl+coinbaseproProducts, currencyPair, coinbaseproTicker)
 
-	return ticker, c.SendHTTPRequest(path, &ticker)
+	return ticker, c.SendHTTPRequest(ctx, path, &ticker)
 }
 
 // GetTrades listd the latest trades for a product
 // currencyPair - example "BTC-USD"
-func (c *CoinbasePro) GetTrades(currencyPair string) ([]Trade, error) {
+func (c *CoinbasePro) GetTrades(ctx context.Context, currencyPair string) ([]Trade, error) {
 	trades := []Trade{}
 	path := fmt.Sprintf(
 		"%s/%s/%s", c.APIUrl+coinbaseproProducts, currencyPair, coinbaseproTrades)
 
-	return trades, c.SendHTTPRequest(path, &trades)
+	return trades, c.SendHTTPRequest(ctx, path, &trades)
 }
 
 // GetHistoricRates returns historic rates for a product. Rates are returned in
 // grouped buckets based on requested granularity.
-func (c *CoinbasePro) GetHistoricRates(currencyPair string, start, end, granularity int64) ([]History, error) {
+func (c *CoinbasePro) GetHistoricRates(ctx context.Context, currencyPair string, start, end, granularity int64) ([]History, error) {
 	var resp [][]interface{}
 	history := []History{}
 	values := url.Values{}
@@ -260,7 +280,7 @@ func (c *CoinbasePro) GetHistoricRates(currencyPair string, start, end, granular
 		fmt.Sprintf("%s/%s/%s", c.APIUrl+coinbaseproProducts, currencyPair, coinbaseproHistory),
 		values)
 
-	if err := c.SendHTTPRequest(path, &resp); err != nil {
+	if err := c.SendHTTPRequest(ctx, path, &resp); err != nil {
 		return history, err
 	}
 
@@ -286,65 +306,65 @@ func (c *CoinbasePro) GetHistoricRates(currencyPair string, start, end, granular
 
 // GetStats returns a 24 hr stat for the product. Volume is in base currency
 // units. open, high, low are in quote currency units.
-func (c *CoinbasePro) GetStats(currencyPair string) (Stats, error) {
+func (c *CoinbasePro) GetStats(ctx context.Context, currencyPair string) (Stats, error) {
 	stats := Stats{}
 	path := fmt.Sprintf(
 		"%s/%s/%s", c.APIUrl+coinbaseproProducts, currencyPair, coinbaseproStats)
 
-	return stats, c.SendHTTPRequest(path, &stats)
+	return stats, c.SendHTTPRequest(ctx, path, &stats)
 }
 
 // GetCurrencies returns a list of supported currency on the exchange
 // Warning: Not all currencies may be currently in use for tradinc.
-func (c *CoinbasePro) GetCurrencies() ([]Currency, error) {
+func (c *CoinbasePro) GetCurrencies(ctx context.Context) ([]Currency, error) {
 	currencies := []Currency{}
 
-	return currencies, c.SendHTTPRequest(c.APIUrl+coinbaseproCurrencies, &currencies)
+	return currencies, c.SendHTTPRequest(ctx, c.APIUrl+coinbaseproCurrencies, &currencies)
 }
 
 // GetServerTime returns the API server time
-func (c *CoinbasePro) GetServerTime() (ServerTime, error) {
+func (c *CoinbasePro) GetServerTime(ctx context.Context) (ServerTime, error) {
 	serverTime := ServerTime{}
 
-	return serverTime, c.SendHTTPRequest(c.APIUrl+coinbaseproTime, &serverTime)
+	return serverTime, c.SendHTTPRequest(ctx, c.APIUrl+coinbaseproTime, &serverTime)
 }
 
 // GetAccounts returns a list of trading accounts associated with the APIKEYS
-func (c *CoinbasePro) GetAccounts() ([]AccountResponse, error) {
+func (c *CoinbasePro) GetAccounts(ctx context.Context) ([]AccountResponse, error) {
 	resp := []AccountResponse{}
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("GET", coinbaseproAccounts, nil, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "GET", coinbaseproAccounts, nil, &resp)
 }
 
 // GetAccount returns information for a single account. Use this endpoint when
 // account_id is known
-func (c *CoinbasePro) GetAccount(accountID string) (AccountResponse, error) {
+func (c *CoinbasePro) GetAccount(ctx context.Context, accountID string) (AccountResponse, error) {
 	resp := AccountResponse{}
 	path := fmt.Sprintf("%s/%s", coinbaseproAccounts, accountID)
 
-	return resp, c.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	return resp, c.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 }
 
 // GetAccountHistory returns a list of account activity. Account activity either
 // increases or decreases your account balance. Items are paginated and sorted
 // latest first.
-func (c *CoinbasePro) GetAccountHistory(accountID string) ([]AccountLedgerResponse, error) {
+func (c *CoinbasePro) GetAccountHistory(ctx context.Context, accountID string) ([]AccountLedgerResponse, error) {
 	resp := []AccountLedgerResponse{}
 	path := fmt.Sprintf("%s/%s/%s", coinbaseproAccounts, accountID, coinbaseproLedger)
 
-	return resp, c.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	return resp, c.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 }
 
 // GetHolds returns the holds that are placed on an account for any active
 // orders or pending withdraw requests. As an order is filled, the hold amount
 // is updated. If an order is canceled, any remaining hold is removed. For a
 // withdraw, once it is completed, the hold is removed.
-func (c *CoinbasePro) GetHolds(accountID string) ([]AccountHolds, error) {
+func (c *CoinbasePro) GetHolds(ctx context.Context, accountID string) ([]AccountHolds, error) {
 	resp := []AccountHolds{}
 	path := fmt.Sprintf("%s/%s/%s", coinbaseproAccounts, accountID, coinbaseproHolds)
 
-	return resp, c.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	return resp, c.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 }
 
 // PlaceLimitOrder places a new limit order. Orders can only be placed if the
@@ -364,7 +384,7 @@ func (c *CoinbasePro) GetHolds(accountID string) ([]AccountHolds, error) {
 // timeInforce - [optional] GTC, GTT, IOC, or FOK (default is GTC)
 // cancelAfter - [optional] min, hour, day * Requires time_in_force to be GTT
 // postOnly - [optional] Post only flag Invalid when time_in_force is IOC or FOK
-func (c *CoinbasePro) PlaceLimitOrder(clientRef string, price, amount float64, side, timeInforce, cancelAfter, productID, stp string, postOnly bool) (string, error) {
+func (c *CoinbasePro) PlaceLimitOrder(ctx context.Context, clientRef string, price, amount float64, side, timeInforce, cancelAfter, productID, stp string, postOnly bool) (GeneralizedOrderResponse, error) {
 	resp := GeneralizedOrderResponse{}
 	request := make(map[string]interface{})
 	request["type"] = "limit"
@@ -377,7 +397,7 @@ func (c *CoinbasePro) PlaceLimitOrder(clientRef string, price, amount float64, s
 		request["cancel_after"] = cancelAfter
 	}
 	if timeInforce != "" {
-		request["time_in_foce"] = timeInforce
+		request["time_in_force"] = timeInforce
 	}
 	if clientRef != "" {
 		request["client_oid"] = clientRef
@@ -389,12 +409,12 @@ func (c *CoinbasePro) PlaceLimitOrder(clientRef string, price, amount float64, s
 		request["post_only"] = postOnly
 	}
 
-	err := c.SendAuthenticatedHTTPRequest("POST", coinbaseproOrders, request, &resp)
+	err := c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproOrders, request, &resp)
 	if err != nil {
-		return "", err
+		return resp, err
 	}
 
-	return resp.ID, nil
+	return resp, nil
 }
 
 // PlaceMarketOrder places a new market order.
@@ -413,7 +433,7 @@ func (c *CoinbasePro) PlaceLimitOrder(clientRef string, price, amount float64, s
 // size - [optional]* Desired amount in BTC
 // funds	[optional]* Desired amount of quote currency to use
 // * One of size or funds is required.
-func (c *CoinbasePro) PlaceMarketOrder(clientRef string, size, funds float64, side string, productID, stp string) (string, error) {
+func (c *CoinbasePro) PlaceMarketOrder(ctx context.Context, clientRef string, size, funds float64, side string, productID, stp string) (GeneralizedOrderResponse, error) {
 	resp := GeneralizedOrderResponse{}
 	request := make(map[string]interface{})
 	request["side"] = side
@@ -433,12 +453,42 @@ func (c *CoinbasePro) PlaceMarketOrder(clientRef string, size, funds float64, si
 		request["stp"] = stp
 	}
 
-	err := c.SendAuthenticatedHTTPRequest("POST", coinbaseproOrders, request, &resp)
+	err := c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproOrders, request, &resp)
 	if err != nil {
-		return "", err
+		return resp, err
 	}
 
-	return resp.ID, nil
+	return resp, nil
+}
+
+// PlaceStopOrder places a market order, or a limit order when the price is
+// set, which is triggered when the last trade price reaches the stop price. A
+// loss stop triggers at or below the stop price and an entry stop at or above
+// it
+func (c *CoinbasePro) PlaceStopOrder(ctx context.Context, clientRef string, stopPrice, price, amount float64, side, stop, productID string) (GeneralizedOrderResponse, error) {
+	resp := GeneralizedOrderResponse{}
+	request := make(map[string]interface{})
+	request["type"] = "market"
+	request["size"] = strconv.FormatFloat(amount, 'f', -1, 64)
+	request["side"] = side
+	request["product_id"] = productID
+	request["stop"] = stop
+	request["stop_price"] = strconv.FormatFloat(stopPrice, 'f', -1, 64)
+
+	if price != 0 {
+		request["type"] = "limit"
+		request["price"] = strconv.FormatFloat(price, 'f', -1, 64)
+	}
+	if clientRef != "" {
+		request["client_oid"] = clientRef
+	}
+
+	err := c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproOrders, request, &resp)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
 }
 
 // PlaceMarginOrder places a new market order.
@@ -456,7 +506,7 @@ func (c *CoinbasePro) PlaceMarketOrder(clientRef string, size, funds float64, si
 // MARGIN ORDER PARAMS
 // size - [optional]* Desired amount in BTC
 // funds - [optional]* Desired amount of quote currency to use
-func (c *CoinbasePro) PlaceMarginOrder(clientRef string, size, funds float64, side string, productID, stp string) (string, error) {
+func (c *CoinbasePro) PlaceMarginOrder(ctx context.Context, clientRef string, size, funds float64, side string, productID, stp string) (string, error) {
 	resp := GeneralizedOrderResponse{}
 	request := make(map[string]interface{})
 	request["side"] = side
@@ -476,7 +526,7 @@ func (c *CoinbasePro) PlaceMarginOrder(clientRef string, size, funds float64, si
 		request["stp"] = stp
 	}
 
-	err := c.SendAuthenticatedHTTPRequest("POST", coinbaseproOrders, request, &resp)
+	err := c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproOrders, request, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -485,24 +535,24 @@ func (c *CoinbasePro) PlaceMarginOrder(clientRef string, size, funds float64, si
 }
 
 // CancelExistingOrder cancels order by orderID
-func (c *CoinbasePro) CancelExistingOrder(orderID string) error {
+func (c *CoinbasePro) CancelExistingOrder(ctx context.Context, orderID string) error {
 	path := fmt.Sprintf("%s/%s", coinbaseproOrders, orderID)
 
-	return c.SendAuthenticatedHTTPRequest("DELETE", path, nil, nil)
+	return c.SendAuthenticatedHTTPRequest(ctx, "DELETE", path, nil, nil)
 }
 
 // CancelAllExistingOrders cancels all open orders on the exchange and returns
 // and array of order IDs
 // currencyPair - [optional] all orders for a currencyPair string will be
 // canceled
-func (c *CoinbasePro) CancelAllExistingOrders(currencyPair string) ([]string, error) {
+func (c *CoinbasePro) CancelAllExistingOrders(ctx context.Context, currencyPair string) ([]string, error) {
 	var resp []string
 	request := make(map[string]interface{})
 
 	if len(currencyPair) != 0 {
 		request["product_id"] = currencyPair
 	}
-	return resp, c.SendAuthenticatedHTTPRequest("DELETE", coinbaseproOrders, request, &resp)
+	return resp, c.SendAuthenticatedHTTPRequest(ctx, "DELETE", coinbaseproOrders, request, &resp)
 }
 
 // GetOrders lists current open orders. Only open or un-settled orders are
@@ -510,7 +560,7 @@ func (c *CoinbasePro) CancelAllExistingOrders(currencyPair string) ([]string, er
 // longer appear in the default request.
 // status - can be a range of "open", "pending", "done" or "active"
 // currencyPair - [optional] for example "BTC-USD"
-func (c *CoinbasePro) GetOrders(status []string, currencyPair string) ([]GeneralizedOrderResponse, error) {
+func (c *CoinbasePro) GetOrders(ctx context.Context, status []string, currencyPair string) ([]GeneralizedOrderResponse, error) {
 	resp := []GeneralizedOrderResponse{}
 	params := url.Values{}
 
@@ -525,19 +575,19 @@ func (c *CoinbasePro) GetOrders(status []string, currencyPair string) ([]General
 	path = common.GetURIPath(path)
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("GET", path[1:], nil, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "GET", path[1:], nil, &resp)
 }
 
 // GetOrder returns a single order by order id.
-func (c *CoinbasePro) GetOrder(orderID string) (GeneralizedOrderResponse, error) {
+func (c *CoinbasePro) GetOrder(ctx context.Context, orderID string) (GeneralizedOrderResponse, error) {
 	resp := GeneralizedOrderResponse{}
 	path := fmt.Sprintf("%s/%s", coinbaseproOrders, orderID)
 
-	return resp, c.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	return resp, c.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 }
 
 // GetFills returns a list of recent fills
-func (c *CoinbasePro) GetFills(orderID, currencyPair string) ([]FillResponse, error) {
+func (c *CoinbasePro) GetFills(ctx context.Context, orderID, currencyPair string) ([]FillResponse, error) {
 	resp := []FillResponse{}
 	params := url.Values{}
 
@@ -555,14 +605,14 @@ func (c *CoinbasePro) GetFills(orderID, currencyPair string) ([]FillResponse, er
 	uri := common.GetURIPath(path)
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("GET", uri[1:], nil, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "GET", uri[1:], nil, &resp)
 }
 
 // GetFundingRecords every order placed with a margin profile that draws funding
 // will create a funding record.
 //
 // status - "outstanding", "settled", or "rejected"
-func (c *CoinbasePro) GetFundingRecords(status string) ([]Funding, error) {
+func (c *CoinbasePro) GetFundingRecords(ctx context.Context, status string) ([]Funding, error) {
 	resp := []Funding{}
 	params := url.Values{}
 	params.Set("status", status)
@@ -571,7 +621,7 @@ func (c *CoinbasePro) GetFundingRecords(status string) ([]Funding, error) {
 	uri := common.GetURIPath(path)
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("GET", uri[1:], nil, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "GET", uri[1:], nil, &resp)
 }
 
 ////////////////////////// Not receiving reply from server /////////////////
@@ -600,7 +650,7 @@ func (c *CoinbasePro) GetFundingRecords(status string) ([]Funding, error) {
 // transferType - either "deposit" or "withdraw"
 // profileID - The id of the margin profile to deposit or withdraw from
 // currency - currency to transfer, currently on "BTC" or "USD"
-func (c *CoinbasePro) MarginTransfer(amount float64, transferType, profileID, currency string) (MarginTransfer, error) {
+func (c *CoinbasePro) MarginTransfer(ctx context.Context, amount float64, transferType, profileID, currency string) (MarginTransfer, error) {
 	resp := MarginTransfer{}
 	request := make(map[string]interface{})
 	request["type"] = transferType
@@ -609,34 +659,34 @@ func (c *CoinbasePro) MarginTransfer(amount float64, transferType, profileID, cu
 	request["margin_profile_id"] = profileID
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("POST", coinbaseproMarginTransfer, request, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproMarginTransfer, request, &resp)
 }
 
 // GetPosition returns an overview of account profile.
-func (c *CoinbasePro) GetPosition() (AccountOverview, error) {
+func (c *CoinbasePro) GetPosition(ctx context.Context) (AccountOverview, error) {
 	resp := AccountOverview{}
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("GET", coinbaseproPosition, nil, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "GET", coinbaseproPosition, nil, &resp)
 }
 
 // ClosePosition closes a position and allowing you to repay position as well
 // repayOnly -  allows the position to be repaid
-func (c *CoinbasePro) ClosePosition(repayOnly bool) (AccountOverview, error) {
+func (c *CoinbasePro) ClosePosition(ctx context.Context, repayOnly bool) (AccountOverview, error) {
 	resp := AccountOverview{}
 	request := make(map[string]interface{})
 	request["repay_only"] = repayOnly
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("POST", coinbaseproPositionClose, request, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproPositionClose, request, &resp)
 }
 
 // GetPayMethods returns a full list of payment methods
-func (c *CoinbasePro) GetPayMethods() ([]PaymentMethod, error) {
+func (c *CoinbasePro) GetPayMethods(ctx context.Context) ([]PaymentMethod, error) {
 	resp := []PaymentMethod{}
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("GET", coinbaseproPaymentMethod, nil, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "GET", coinbaseproPaymentMethod, nil, &resp)
 }
 
 // DepositViaPaymentMethod deposits funds from a payment method. See the Payment
@@ -645,7 +695,7 @@ func (c *CoinbasePro) GetPayMethods() ([]PaymentMethod, error) {
 // amount - The amount to deposit
 // currency - The type of currency
 // paymentID - ID of the payment method
-func (c *CoinbasePro) DepositViaPaymentMethod(amount float64, currency, paymentID string) (DepositWithdrawalInfo, error) {
+func (c *CoinbasePro) DepositViaPaymentMethod(ctx context.Context, amount float64, currency, paymentID string) (DepositWithdrawalInfo, error) {
 	resp := DepositWithdrawalInfo{}
 	req := make(map[string]interface{})
 	req["amount"] = amount
@@ -653,7 +703,7 @@ func (c *CoinbasePro) DepositViaPaymentMethod(amount float64, currency, paymentI
 	req["payment_method_id"] = paymentID
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("POST", coinbaseproPaymentMethodDeposit, req, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproPaymentMethodDeposit, req, &resp)
 }
 
 // DepositViaCoinbase deposits funds from a coinbase account. Move funds between
@@ -664,7 +714,7 @@ func (c *CoinbasePro) DepositViaPaymentMethod(amount float64, currency, paymentI
 // amount - The amount to deposit
 // currency - The type of currency
 // accountID - ID of the coinbase account
-func (c *CoinbasePro) DepositViaCoinbase(amount float64, currency, accountID string) (DepositWithdrawalInfo, error) {
+func (c *CoinbasePro) DepositViaCoinbase(ctx context.Context, amount float64, currency, accountID string) (DepositWithdrawalInfo, error) {
 	resp := DepositWithdrawalInfo{}
 	req := make(map[string]interface{})
 	req["amount"] = amount
@@ -672,7 +722,7 @@ func (c *CoinbasePro) DepositViaCoinbase(amount float64, currency, accountID str
 	req["coinbase_account_id"] = accountID
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("POST", coinbaseproDepositCoinbase, req, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproDepositCoinbase, req, &resp)
 }
 
 // WithdrawViaPaymentMethod withdraws funds to a payment method
@@ -680,7 +730,7 @@ func (c *CoinbasePro) DepositViaCoinbase(amount float64, currency, accountID str
 // amount - The amount to withdraw
 // currency - The type of currency
 // paymentID - ID of the payment method
-func (c *CoinbasePro) WithdrawViaPaymentMethod(amount float64, currency, paymentID string) (DepositWithdrawalInfo, error) {
+func (c *CoinbasePro) WithdrawViaPaymentMethod(ctx context.Context, amount float64, currency, paymentID string) (DepositWithdrawalInfo, error) {
 	resp := DepositWithdrawalInfo{}
 	req := make(map[string]interface{})
 	req["amount"] = amount
@@ -688,7 +738,7 @@ func (c *CoinbasePro) WithdrawViaPaymentMethod(amount float64, currency, payment
 	req["payment_method_id"] = paymentID
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("POST", coinbaseproWithdrawalPaymentMethod, req, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproWithdrawalPaymentMethod, req, &resp)
 }
 
 ///////////////////////// NO ROUTE FOUND ERROR ////////////////////////////////
@@ -713,7 +763,7 @@ func (c *CoinbasePro) WithdrawViaPaymentMethod(amount float64, currency, payment
 // amount - The amount to withdraw
 // currency - The type of currency
 // cryptoAddress - 	A crypto address of the recipient
-func (c *CoinbasePro) WithdrawCrypto(amount float64, currency, cryptoAddress string) (DepositWithdrawalInfo, error) {
+func (c *CoinbasePro) WithdrawCrypto(ctx context.Context, amount float64, currency, cryptoAddress string) (DepositWithdrawalInfo, error) {
 	resp := DepositWithdrawalInfo{}
 	req := make(map[string]interface{})
 	req["amount"] = amount
@@ -721,15 +771,15 @@ func (c *CoinbasePro) WithdrawCrypto(amount float64, currency, cryptoAddress str
 	req["crypto_address"] = cryptoAddress
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("POST", coinbaseproWithdrawalCrypto, req, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproWithdrawalCrypto, req, &resp)
 }
 
 // GetCoinbaseAccounts returns a list of coinbase accounts
-func (c *CoinbasePro) GetCoinbaseAccounts() ([]CoinbaseAccounts, error) {
+func (c *CoinbasePro) GetCoinbaseAccounts(ctx context.Context) ([]CoinbaseAccounts, error) {
 	resp := []CoinbaseAccounts{}
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("GET", coinbaseproCoinbaseAccounts, nil, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "GET", coinbaseproCoinbaseAccounts, nil, &resp)
 }
 
 // GetReport returns batches of historic information about your account in
@@ -744,7 +794,7 @@ func (c *CoinbasePro) GetCoinbaseAccounts() ([]CoinbaseAccounts, error) {
 // if type is account
 // format - 	pdf or csv (default is pdf)
 // email - [optional] Email address to send the report to
-func (c *CoinbasePro) GetReport(reportType, startDate, endDate, currencyPair, accountID, format, email string) (Report, error) {
+func (c *CoinbasePro) GetReport(ctx context.Context, reportType, startDate, endDate, currencyPair, accountID, format, email string) (Report, error) {
 	resp := Report{}
 	request := make(map[string]interface{})
 	request["type"] = reportType
@@ -766,34 +816,34 @@ func (c *CoinbasePro) GetReport(reportType, startDate, endDate, currencyPair, ac
 	}
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("POST", coinbaseproReports, request, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "POST", coinbaseproReports, request, &resp)
 }
 
 // GetReportStatus once a report request has been accepted for processing, the
 // status is available by polling the report resource endpoint.
-func (c *CoinbasePro) GetReportStatus(reportID string) (Report, error) {
+func (c *CoinbasePro) GetReportStatus(ctx context.Context, reportID string) (Report, error) {
 	resp := Report{}
 	path := fmt.Sprintf("%s/%s", coinbaseproReports, reportID)
 
-	return resp, c.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	return resp, c.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 }
 
 // GetTrailingVolume this request will return your 30-day trailing volume for
 // all products.
-func (c *CoinbasePro) GetTrailingVolume() ([]Volume, error) {
+func (c *CoinbasePro) GetTrailingVolume(ctx context.Context) ([]Volume, error) {
 	resp := []Volume{}
 
 	return resp,
-		c.SendAuthenticatedHTTPRequest("GET", coinbaseproTrailingVolume, nil, &resp)
+		c.SendAuthenticatedHTTPRequest(ctx, "GET", coinbaseproTrailingVolume, nil, &resp)
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (c *CoinbasePro) SendHTTPRequest(path string, result interface{}) error {
-	return c.SendPayload("GET", path, nil, nil, result, false, c.Verbose)
+func (c *CoinbasePro) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return c.SendPayload(ctx, "GET", path, nil, nil, result, false, c.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP reque
-func (c *CoinbasePro) SendAuthenticatedHTTPRequest(method, path string, params map[string]interface{}, result interface{}) (err error) {
+func (c *CoinbasePro) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, params map[string]interface{}, result interface{}) (err error) {
 	if !c.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, c.Name)
 	}
@@ -821,15 +871,15 @@ func (c *CoinbasePro) SendAuthenticatedHTTPRequest(method, path string, params m
 	headers["CB-ACCESS-PASSPHRASE"] = c.ClientID
 	headers["Content-Type"] = "application/json"
 
-	return c.SendPayload(method, c.APIUrl+path, headers, bytes.NewBuffer(payload), result, true, c.Verbose)
+	return c.SendPayload(ctx, method, c.APIUrl+path, headers, bytes.NewBuffer(payload), result, true, c.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (c *CoinbasePro) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (c *CoinbasePro) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		trailingVolume, err := c.GetTrailingVolume()
+		trailingVolume, err := c.GetTrailingVolume(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -1,6 +1,7 @@
 package coinbasepro
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -8,10 +9,17 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var c CoinbasePro
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 // Please supply your APIKeys here for better testing
 const (
 	apiKey                  = ""
@@ -39,49 +47,49 @@ func TestSetup(t *testing.T) {
 }
 
 func TestGetProducts(t *testing.T) {
-	_, err := c.GetProducts()
+	_, err := c.GetProducts(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Coinbase, GetProducts() Error: %s", err)
 	}
 }
 
 func TestGetTicker(t *testing.T) {
-	_, err := c.GetTicker("BTC-USD")
+	_, err := c.GetTicker(context.Background(), "BTC-USD")
 	if err != nil {
 		t.Error("Test failed - GetTicker() error", err)
 	}
 }
 
 func TestGetTrades(t *testing.T) {
-	_, err := c.GetTrades("BTC-USD")
+	_, err := c.GetTrades(context.Background(), "BTC-USD")
 	if err != nil {
 		t.Error("Test failed - GetTrades() error", err)
 	}
 }
 
 func TestGetHistoricRates(t *testing.T) {
-	_, err := c.GetHistoricRates("BTC-USD", 0, 0, 0)
+	_, err := c.GetHistoricRates(context.Background(), "BTC-USD", 0, 0, 0)
 	if err != nil {
 		t.Error("Test failed - GetHistoricRates() error", err)
 	}
 }
 
 func TestGetStats(t *testing.T) {
-	_, err := c.GetStats("BTC-USD")
+	_, err := c.GetStats(context.Background(), "BTC-USD")
 	if err != nil {
 		t.Error("Test failed - GetStats() error", err)
 	}
 }
 
 func TestGetCurrencies(t *testing.T) {
-	_, err := c.GetCurrencies()
+	_, err := c.GetCurrencies(context.Background())
 	if err != nil {
 		t.Error("Test failed - GetCurrencies() error", err)
 	}
 }
 
 func TestGetServerTime(t *testing.T) {
-	_, err := c.GetServerTime()
+	_, err := c.GetServerTime(context.Background())
 	if err != nil {
 		t.Error("Test failed - GetServerTime() error", err)
 	}
@@ -91,66 +99,66 @@ func TestAuthRequests(t *testing.T) {
 
 	if c.APIKey != "" && c.APISecret != "" && c.ClientID != "" {
 
-		_, err := c.GetAccounts()
+		_, err := c.GetAccounts(context.Background())
 		if err == nil {
 			t.Error("Test failed - GetAccounts() error", err)
 		}
 
-		_, err = c.GetAccount("234cb213-ac6f-4ed8-b7b6-e62512930945")
+		_, err = c.GetAccount(context.Background(), "234cb213-ac6f-4ed8-b7b6-e62512930945")
 		if err == nil {
 			t.Error("Test failed - GetAccount() error", err)
 		}
 
-		_, err = c.GetAccountHistory("234cb213-ac6f-4ed8-b7b6-e62512930945")
+		_, err = c.GetAccountHistory(context.Background(), "234cb213-ac6f-4ed8-b7b6-e62512930945")
 		if err == nil {
 			t.Error("Test failed - GetAccountHistory() error", err)
 		}
 
-		_, err = c.GetHolds("234cb213-ac6f-4ed8-b7b6-e62512930945")
+		_, err = c.GetHolds(context.Background(), "234cb213-ac6f-4ed8-b7b6-e62512930945")
 		if err == nil {
 			t.Error("Test failed - GetHolds() error", err)
 		}
 
-		_, err = c.PlaceLimitOrder("", 0, 0, "buy", "", "", "BTC-USD", "", false)
+		_, err = c.PlaceLimitOrder(context.Background(), "", 0, 0, "buy", "", "", "BTC-USD", "", false)
 		if err == nil {
 			t.Error("Test failed - PlaceLimitOrder() error", err)
 		}
 
-		_, err = c.PlaceMarketOrder("", 1, 0, "buy", "BTC-USD", "")
+		_, err = c.PlaceMarketOrder(context.Background(), "", 1, 0, "buy", "BTC-USD", "")
 		if err == nil {
 			t.Error("Test failed - PlaceMarketOrder() error", err)
 		}
 
-		err = c.CancelExistingOrder("1337")
+		err = c.CancelExistingOrder(context.Background(), "1337")
 		if err == nil {
 			t.Error("Test failed - CancelExistingOrder() error", err)
 		}
 
-		_, err = c.CancelAllExistingOrders("BTC-USD")
+		_, err = c.CancelAllExistingOrders(context.Background(), "BTC-USD")
 		if err == nil {
 			t.Error("Test failed - CancelAllExistingOrders() error", err)
 		}
 
-		_, err = c.GetOrders([]string{"open", "done"}, "BTC-USD")
+		_, err = c.GetOrders(context.Background(), []string{"open", "done"}, "BTC-USD")
 		if err == nil {
 			t.Error("Test failed - GetOrders() error", err)
 		}
 
-		_, err = c.GetOrder("1337")
+		_, err = c.GetOrder(context.Background(), "1337")
 		if err == nil {
 			t.Error("Test failed - GetOrders() error", err)
 		}
 
-		_, err = c.GetFills("1337", "BTC-USD")
+		_, err = c.GetFills(context.Background(), "1337", "BTC-USD")
 		if err == nil {
 			t.Error("Test failed - GetFills() error", err)
 		}
-		_, err = c.GetFills("", "")
+		_, err = c.GetFills(context.Background(), "", "")
 		if err == nil {
 			t.Error("Test failed - GetFills() error", err)
 		}
 
-		_, err = c.GetFundingRecords("rejected")
+		_, err = c.GetFundingRecords(context.Background(), "rejected")
 		if err == nil {
 			t.Error("Test failed - GetFundingRecords() error", err)
 		}
@@ -160,37 +168,37 @@ func TestAuthRequests(t *testing.T) {
 		// 		t.Error("Test failed - RepayFunding() error", err)
 		// 	}
 
-		_, err = c.MarginTransfer(1, "withdraw", "45fa9e3b-00ba-4631-b907-8a98cbdf21be", "BTC")
+		_, err = c.MarginTransfer(context.Background(), 1, "withdraw", "45fa9e3b-00ba-4631-b907-8a98cbdf21be", "BTC")
 		if err == nil {
 			t.Error("Test failed - MarginTransfer() error", err)
 		}
 
-		_, err = c.GetPosition()
+		_, err = c.GetPosition(context.Background())
 		if err == nil {
 			t.Error("Test failed - GetPosition() error", err)
 		}
 
-		_, err = c.ClosePosition(false)
+		_, err = c.ClosePosition(context.Background(), false)
 		if err == nil {
 			t.Error("Test failed - ClosePosition() error", err)
 		}
 
-		_, err = c.GetPayMethods()
+		_, err = c.GetPayMethods(context.Background())
 		if err == nil {
 			t.Error("Test failed - GetPayMethods() error", err)
 		}
 
-		_, err = c.DepositViaPaymentMethod(1, "BTC", "1337")
+		_, err = c.DepositViaPaymentMethod(context.Background(), 1, "BTC", "1337")
 		if err == nil {
 			t.Error("Test failed - DepositViaPaymentMethod() error", err)
 		}
 
-		_, err = c.DepositViaCoinbase(1, "BTC", "1337")
+		_, err = c.DepositViaCoinbase(context.Background(), 1, "BTC", "1337")
 		if err == nil {
 			t.Error("Test failed - DepositViaCoinbase() error", err)
 		}
 
-		_, err = c.WithdrawViaPaymentMethod(1, "BTC", "1337")
+		_, err = c.WithdrawViaPaymentMethod(context.Background(), 1, "BTC", "1337")
 		if err == nil {
 			t.Error("Test failed - WithdrawViaPaymentMethod() error", err)
 		}
@@ -200,22 +208,22 @@ func TestAuthRequests(t *testing.T) {
 		// 		t.Error("Test failed - WithdrawViaCoinbase() error", err)
 		// 	}
 
-		_, err = c.WithdrawCrypto(1, "BTC", "1337")
+		_, err = c.WithdrawCrypto(context.Background(), 1, "BTC", "1337")
 		if err == nil {
 			t.Error("Test failed - WithdrawViaCoinbase() error", err)
 		}
 
-		_, err = c.GetCoinbaseAccounts()
+		_, err = c.GetCoinbaseAccounts(context.Background())
 		if err == nil {
 			t.Error("Test failed - GetCoinbaseAccounts() error", err)
 		}
 
-		_, err = c.GetReportStatus("1337")
+		_, err = c.GetReportStatus(context.Background(), "1337")
 		if err == nil {
 			t.Error("Test failed - GetReportStatus() error", err)
 		}
 
-		_, err = c.GetTrailingVolume()
+		_, err = c.GetTrailingVolume(context.Background())
 		if err == nil {
 			t.Error("Test failed - GetTrailingVolume() error", err)
 		}
@@ -242,7 +250,7 @@ func TestGetFee(t *testing.T) {
 
 	if apiKey != "" || apiSecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := c.GetFee(feeBuilder); resp != float64(0.003) || err != nil {
+		if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0.003) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.003), resp)
 		}
@@ -251,7 +259,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := c.GetFee(feeBuilder); resp != float64(3000) || err != nil {
+		if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(3000) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(3000), resp)
 			t.Error(err)
 		}
@@ -259,7 +267,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.01), resp)
 			t.Error(err)
 		}
@@ -267,7 +275,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -276,7 +284,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -284,7 +292,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -293,7 +301,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.CurrencyItem = symbol.EUR
-	if resp, err := c.GetFee(feeBuilder); resp != float64(0.15) || err != nil {
+	if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0.15) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -302,7 +310,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.USD
-	if resp, err := c.GetFee(feeBuilder); resp != float64(25) || err != nil {
+	if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(25) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -407,6 +415,86 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a CoinbasePro using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *CoinbasePro {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	gdxConfig, err := cfg.GetExchangeConfig("CoinbasePro")
+	if err != nil {
+		t.Fatal("Test Failed - coinbasepro Setup() init error")
+	}
+
+	gdxConfig.AuthenticatedAPISupport = true
+	gdxConfig.APIKey, gdxConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+	gdxConfig.ClientID = clientID
+
+	var r CoinbasePro
+	r.SetDefaults()
+	r.Setup(gdxConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - Coinbase GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 ||
+		orders[0].ID != "d0c5340b-6d6c-49d9-b567-48c4bfca13d2" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].QuoteCurrency != symbol.USD ||
+		orders[0].Status != "open" || orders[0].Price != 6200 || orders[0].Amount != 1 ||
+		orders[0].OpenVolume != 0.75 || orders[0].ExecutedAmount != 0.25 ||
+		orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - Coinbase GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - Coinbase GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 2 || orders[0].Status != "filled" ||
+		orders[0].OpenVolume != 0 || orders[1].Status != "canceled" ||
+		orders[1].ExecutedAmount != 0.1) {
+		t.Errorf("Test Failed - Coinbase GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
+func TestWsSequenced(t *testing.T) {
+	var r CoinbasePro
+	if !r.wsSequenced("BTC-USD", 10) || !r.wsSequenced("ETH-USD", 5) {
+		t.Error("Test Failed - wsSequenced() expected new sequences to be kept")
+	}
+
+	if r.wsSequenced("BTC-USD", 10) || r.wsSequenced("BTC-USD", 9) {
+		t.Error("Test Failed - wsSequenced() expected stale sequences to be dropped")
+	}
+
+	if !r.wsSequenced("BTC-USD", 12) {
+		t.Error("Test Failed - wsSequenced() expected later sequence to be kept")
+	}
+}
+
+func TestFillResponse(t *testing.T) {
+	var response exchange.SubmitOrderResponse
+	fillResponse(&response, &GeneralizedOrderResponse{FilledSize: 2,
+		ExecutedValue: 13000, FillFees: 39, CreatedAt: "2018-10-16T10:00:00.538Z"},
+		pair.NewCurrencyPairDelimiter("BTC-USD", "-"))
+
+	if response.FilledAmount != 2 || response.AveragePrice != 6500 ||
+		response.Fee != 39 || response.FeeCurrency != "USD" ||
+		response.OrderDate.IsZero() {
+		t.Errorf("Test Failed - fillResponse() unexpected response %+v", response)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -431,7 +519,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 	}
-	response, err := c.SubmitOrder(p, exchange.Buy, exchange.Limit, 1, 1, "clientId")
+	response, err := c.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 1, ClientID: "clientId"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -457,7 +545,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := c.CancelOrder(orderCancellation)
+	err := c.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
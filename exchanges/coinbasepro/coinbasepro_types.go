@@ -339,11 +339,16 @@ type FillResponse struct {
 	Side      string  `json:"side"`
 }
 
-// WebsocketSubscribe takes in subscription information
+// WebsocketSubscribe takes in subscription information, the signature fields
+// authenticate a subscription to the user channel
 type WebsocketSubscribe struct {
-	Type      string       `json:"type"`
-	ProductID string       `json:"product_id,omitempty"`
-	Channels  []WsChannels `json:"channels,omitempty"`
+	Type       string       `json:"type"`
+	ProductID  string       `json:"product_id,omitempty"`
+	Channels   []WsChannels `json:"channels,omitempty"`
+	Signature  string       `json:"signature,omitempty"`
+	Key        string       `json:"key,omitempty"`
+	Passphrase string       `json:"passphrase,omitempty"`
+	Timestamp  string       `json:"timestamp,omitempty"`
 }
 
 // WsChannels defines outgoing channels for subscription purposes
@@ -397,6 +402,8 @@ type WebsocketMatch struct {
 	TradeID      int     `json:"trade_id"`
 	MakerOrderID string  `json:"maker_order_id"`
 	TakerOrderID string  `json:"taker_order_id"`
+	UserID       string  `json:"user_id"`
+	TakerUserID  string  `json:"taker_user_id"`
 	Side         string  `json:"side"`
 	Size         float64 `json:"size,string"`
 	Price        float64 `json:"price,string"`
@@ -433,7 +440,7 @@ type WebsocketTicker struct {
 	ProductID string  `json:"product_id"`
 	Price     float64 `json:"price,string"`
 	Open24H   float64 `json:"open_24h,string"`
-	Volume24H float64 `json:"volumen_24h,string"`
+	Volume24H float64 `json:"volume_24h,string"`
 	Low24H    float64 `json:"low_24h,string"`
 	High24H   float64 `json:"high_24h,string"`
 	Volume30D float64 `json:"volume_30d,string"`
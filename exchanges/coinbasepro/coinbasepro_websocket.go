@@ -14,20 +14,27 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
 )
 
 const (
 	coinbaseproWebsocketURL = "wss://ws-feed.pro.coinbase.com"
 )
 
-// WebsocketSubscriber subscribes to websocket channels with respect to enabled
-// currencies
-func (c *CoinbasePro) WebsocketSubscriber() error {
+// wsProductIDs returns the product IDs of the enabled currencies
+func (c *CoinbasePro) wsProductIDs() []string {
 	currencies := []string{}
 	for _, x := range c.EnabledPairs {
 		currency := x[0:3] + "-" + x[3:]
 		currencies = append(currencies, currency)
 	}
+	return currencies
+}
+
+// WebsocketSubscriber subscribes to websocket channels with respect to enabled
+// currencies
+func (c *CoinbasePro) WebsocketSubscriber() error {
+	currencies := c.wsProductIDs()
 
 	var channels []WsChannels
 	channels = append(channels, WsChannels{
@@ -55,6 +62,31 @@ func (c *CoinbasePro) WebsocketSubscriber() error {
 	return c.WebsocketConn.WriteMessage(websocket.TextMessage, json)
 }
 
+// WsAuthenticate subscribes to the authenticated user channel, which sends the
+// received, open, done and match messages of the account orders
+func (c *CoinbasePro) WsAuthenticate() error {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	hmac := common.GetHMAC(common.HashSHA256,
+		[]byte(timestamp+"GET/users/self/verify"),
+		[]byte(c.APISecret))
+
+	subscribe := WebsocketSubscribe{
+		Type:       "subscribe",
+		Channels:   []WsChannels{{Name: "user", ProductIDs: c.wsProductIDs()}},
+		Signature:  common.Base64Encode(hmac),
+		Key:        c.APIKey,
+		Passphrase: c.ClientID,
+		Timestamp:  timestamp,
+	}
+
+	json, err := common.JSONEncode(subscribe)
+	if err != nil {
+		return err
+	}
+
+	return c.WebsocketConn.WriteMessage(websocket.TextMessage, json)
+}
+
 // WsConnect initiates a websocket connection
 func (c *CoinbasePro) WsConnect() error {
 	if !c.Websocket.IsEnabled() || !c.IsEnabled() {
@@ -146,6 +178,10 @@ func (c *CoinbasePro) WsHandleData() {
 				log.Fatal(err)
 			}
 
+			if msgType.Sequence != 0 && !c.wsSequenced(msgType.ProductID, msgType.Sequence) {
+				continue
+			}
+
 			if msgType.Type == "subscriptions" || msgType.Type == "heartbeat" {
 				continue
 			}
@@ -162,14 +198,17 @@ func (c *CoinbasePro) WsHandleData() {
 				}
 
 				c.Websocket.DataHandler <- exchange.TickerData{
-					Timestamp: time.Now(),
-					Pair:      pair.NewCurrencyPairFromString(ticker.ProductID),
-					AssetType: "SPOT",
-					Exchange:  c.GetName(),
-					OpenPrice: ticker.Price,
-					HighPrice: ticker.High24H,
-					LowPrice:  ticker.Low24H,
-					Quantity:  ticker.Volume24H,
+					Timestamp:  time.Now(),
+					Pair:       pair.NewCurrencyPairFromString(ticker.ProductID),
+					AssetType:  "SPOT",
+					Exchange:   c.GetName(),
+					ClosePrice: ticker.Price,
+					OpenPrice:  ticker.Open24H,
+					HighPrice:  ticker.High24H,
+					LowPrice:   ticker.Low24H,
+					Quantity:   ticker.Volume24H,
+					BidPrice:   ticker.BestBid,
+					AskPrice:   ticker.BestAsk,
 				}
 
 			case "snapshot":
@@ -196,6 +235,102 @@ func (c *CoinbasePro) WsHandleData() {
 					log.Fatal(err)
 				}
 
+			case "received":
+				received := WebsocketReceived{}
+				err := common.JSONDecode(resp.Raw, &received)
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				orderType := exchange.Limit
+				if received.OrderType == "market" {
+					orderType = exchange.Market
+				}
+
+				c.Websocket.DataHandler <- exchange.WebsocketOrderUpdate{
+					Exchange:      c.GetName(),
+					AssetType:     "SPOT",
+					Pair:          pair.NewCurrencyPairFromString(received.ProductID),
+					OrderID:       received.OrderID,
+					ClientOrderID: received.ClientOID,
+					Side:          wsOrderSide(received.Side),
+					OrderType:     orderType,
+					Status:        orders.New,
+					Price:         received.Price,
+					Amount:        received.Size,
+					Timestamp:     wsTime(received.Time),
+				}
+
+			case "open":
+				open := WebsocketOpen{}
+				err := common.JSONDecode(resp.Raw, &open)
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				c.Websocket.DataHandler <- exchange.WebsocketOrderUpdate{
+					Exchange:  c.GetName(),
+					AssetType: "SPOT",
+					Pair:      pair.NewCurrencyPairFromString(open.ProductID),
+					OrderID:   open.OrderID,
+					Side:      wsOrderSide(open.Side),
+					Status:    orders.New,
+					Price:     open.Price,
+					Timestamp: wsTime(open.Time),
+				}
+
+			case "done":
+				done := WebsocketDone{}
+				err := common.JSONDecode(resp.Raw, &done)
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				c.Websocket.DataHandler <- exchange.WebsocketOrderUpdate{
+					Exchange:  c.GetName(),
+					AssetType: "SPOT",
+					Pair:      pair.NewCurrencyPairFromString(done.ProductID),
+					OrderID:   done.OrderID,
+					Side:      wsOrderSide(done.Side),
+					Status:    orders.ParseStatus(done.Reason),
+					Price:     done.Price,
+					Timestamp: wsTime(done.Time),
+				}
+
+			case "match":
+				match := WebsocketMatch{}
+				err := common.JSONDecode(resp.Raw, &match)
+				if err != nil {
+					log.Fatal(err)
+				}
+
+				// The side is the maker side, the taker traded the other way
+				orderID, side := match.MakerOrderID, wsOrderSide(match.Side)
+				if match.TakerUserID != "" && match.TakerUserID == match.UserID {
+					orderID = match.TakerOrderID
+					side = exchange.Buy
+					if match.Side == "buy" {
+						side = exchange.Sell
+					}
+				}
+
+				p := pair.NewCurrencyPairFromString(match.ProductID)
+				c.Websocket.DataHandler <- exchange.WebsocketOrderFill{
+					Exchange:    c.GetName(),
+					AssetType:   "SPOT",
+					Pair:        p,
+					OrderID:     orderID,
+					TradeID:     strconv.Itoa(match.TradeID),
+					Side:        side,
+					Price:       match.Price,
+					Amount:      match.Size,
+					FeeCurrency: p.SecondCurrency.String(),
+					Timestamp:   wsTime(match.Time),
+				}
+
+			case "change":
+				// Order size changes are reflected in the next done message
+
 			default:
 				log.Fatal("Edge test", string(resp.Raw))
 			}
@@ -203,6 +338,41 @@ func (c *CoinbasePro) WsHandleData() {
 	}
 }
 
+// wsSequenced records the sequence of a product message and returns whether
+// it is new. Messages of a product share one sequence across channels, so a
+// sequence at or before the last one seen is a message delivered out of order
+// or sent again after reconnecting and is dropped. The level2 channel is not
+// sequenced, its snapshot is sent again on each connection
+func (c *CoinbasePro) wsSequenced(productID string, sequence int64) bool {
+	if c.wsSequences == nil {
+		c.wsSequences = make(map[string]int64)
+	}
+
+	if sequence <= c.wsSequences[productID] {
+		return false
+	}
+	c.wsSequences[productID] = sequence
+	return true
+}
+
+// wsOrderSide converts a websocket order side to an exchange order side
+func wsOrderSide(side string) exchange.OrderSide {
+	if side == "sell" {
+		return exchange.Sell
+	}
+	return exchange.Buy
+}
+
+// wsTime parses a websocket message time, returning the current time when it
+// cannot be parsed
+func wsTime(t string) time.Time {
+	parsed, err := time.Parse(time.RFC3339Nano, t)
+	if err != nil {
+		return time.Now()
+	}
+	return parsed
+}
+
 // ProcessSnapshot processes the intial orderbook snap shot
 func (c *CoinbasePro) ProcessSnapshot(snapshot WebsocketOrderbookSnapshot) error {
 	var base orderbook.Base
@@ -1,9 +1,12 @@
 package coinbasepro
 
 import (
+	"context"
 	"errors"
 	"log"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -29,7 +32,7 @@ func (c *CoinbasePro) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", c.GetName(), len(c.EnabledPairs), c.EnabledPairs)
 	}
 
-	exchangeProducts, err := c.GetProducts()
+	exchangeProducts, err := c.GetProducts(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available products.\n", c.GetName())
 	} else {
@@ -48,10 +51,10 @@ func (c *CoinbasePro) Run() {
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // coinbasepro exchange
-func (c *CoinbasePro) GetAccountInfo() (exchange.AccountInfo, error) {
+func (c *CoinbasePro) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = c.GetName()
-	accountBalance, err := c.GetAccounts()
+	accountBalance, err := c.GetAccounts(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -67,14 +70,14 @@ func (c *CoinbasePro) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (c *CoinbasePro) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (c *CoinbasePro) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := c.GetTicker(exchange.FormatExchangeCurrency(c.Name, p).String())
+	tick, err := c.GetTicker(ctx, exchange.FormatExchangeCurrency(c.Name, p).String())
 	if err != nil {
 		return ticker.Price{}, err
 	}
 
-	stats, err := c.GetStats(exchange.FormatExchangeCurrency(c.Name, p).String())
+	stats, err := c.GetStats(ctx, exchange.FormatExchangeCurrency(c.Name, p).String())
 
 	if err != nil {
 		return ticker.Price{}, err
@@ -90,27 +93,27 @@ func (c *CoinbasePro) UpdateTicker(p pair.CurrencyPair, assetType string) (ticke
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (c *CoinbasePro) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (c *CoinbasePro) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(c.GetName(), p, assetType)
 	if err != nil {
-		return c.UpdateTicker(p, assetType)
+		return c.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (c *CoinbasePro) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (c *CoinbasePro) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(c.GetName(), p, assetType)
 	if err != nil {
-		return c.UpdateOrderbook(p, assetType)
+		return c.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (c *CoinbasePro) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (c *CoinbasePro) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := c.GetOrderbook(exchange.FormatExchangeCurrency(c.Name, p).String(), 2)
+	orderbookNew, err := c.GetOrderbook(ctx, exchange.FormatExchangeCurrency(c.Name, p).String(), 2)
 	if err != nil {
 		return orderBook, err
 	}
@@ -131,79 +134,149 @@ func (c *CoinbasePro) UpdateOrderbook(p pair.CurrencyPair, assetType string) (or
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (c *CoinbasePro) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (c *CoinbasePro) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (c *CoinbasePro) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (c *CoinbasePro) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (c *CoinbasePro) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (c *CoinbasePro) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	var response string
+	if err := c.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	var response GeneralizedOrderResponse
 	var err error
-	if orderType == exchange.Market {
-		response, err = c.PlaceMarginOrder("", amount, amount, side.ToString(), p.Pair().String(), "")
+	orderSide := common.StringToLower(order.Side.ToString())
+	productID := order.Pair.Pair().String()
 
-	} else if orderType == exchange.Limit {
-		response, err = c.PlaceLimitOrder("", price, amount, side.ToString(), "", "", p.Pair().String(), "", false)
-	} else {
+	if order.OrderType != exchange.Limit && order.Options.GetTimeInForce() != exchange.GoodTillCancel {
+		return submitOrderResponse, errors.New("time in force is only supported for limit orders")
+	}
+
+	switch order.OrderType {
+	case exchange.Market:
+		response, err = c.PlaceMarketOrder(ctx, order.ClientID, order.Amount, 0, orderSide, productID, "")
+	case exchange.Limit:
+		response, err = c.PlaceLimitOrder(ctx, order.ClientID, order.Price, order.Amount, orderSide,
+			string(order.Options.GetTimeInForce()), "", productID, "", order.Options.PostOnly)
+	case exchange.Stop, exchange.StopLimit, exchange.TakeProfit, exchange.TakeProfitLimit:
+		price := order.Price
+		if !order.OrderType.IsLimitPriced() {
+			price = 0
+		}
+		response, err = c.PlaceStopOrder(ctx, order.ClientID, order.Options.StopPrice, price,
+			order.Amount, orderSide, stopDirection(order.Side, order.OrderType), productID)
+	default:
 		err = errors.New("not supported")
 	}
 
-	if response != "" {
-		submitOrderResponse.OrderID = response
+	if response.ID != "" {
+		submitOrderResponse.OrderID = response.ID
 	}
 
 	if err == nil {
 		submitOrderResponse.IsOrderPlaced = true
+		fillResponse(&submitOrderResponse, &response, order.Pair)
 	}
 
 	return submitOrderResponse, err
 }
 
+// fillResponse sets the filled amount, average price, fees and timestamp of a
+// placed order, fees are charged in the quote currency
+func fillResponse(submitOrderResponse *exchange.SubmitOrderResponse, response *GeneralizedOrderResponse, p pair.CurrencyPair) {
+	submitOrderResponse.FilledAmount = response.FilledSize
+	if response.FilledSize > 0 {
+		submitOrderResponse.AveragePrice = response.ExecutedValue / response.FilledSize
+	}
+
+	submitOrderResponse.Fee = response.FillFees
+	submitOrderResponse.FeeCurrency = p.SecondCurrency.String()
+	submitOrderResponse.OrderDate, _ = time.Parse(time.RFC3339, response.CreatedAt)
+}
+
+// stopDirection returns the stop type of a triggered order, stops sell as the
+// price falls or buy as it rises while take profits do the reverse
+func stopDirection(side exchange.OrderSide, orderType exchange.OrderType) string {
+	isStop := orderType == exchange.Stop || orderType == exchange.StopLimit
+	if (side == exchange.Sell) == isStop {
+		return "loss"
+	}
+	return "entry"
+}
+
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (c *CoinbasePro) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (c *CoinbasePro) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (c *CoinbasePro) CancelOrder(order exchange.OrderCancellation) error {
-	return c.CancelExistingOrder(order.OrderID)
+func (c *CoinbasePro) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
+	return c.CancelExistingOrder(ctx, order.OrderID)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (c *CoinbasePro) CancelAllOrders() error {
+func (c *CoinbasePro) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (c *CoinbasePro) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (c *CoinbasePro) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (c *CoinbasePro) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (c *CoinbasePro) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (c *CoinbasePro) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (c *CoinbasePro) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (c *CoinbasePro) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (c *CoinbasePro) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (c *CoinbasePro) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *CoinbasePro) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (c *CoinbasePro) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *CoinbasePro) WithdrawFiatFunds(cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (c *CoinbasePro) WithdrawFiatFunds(ctx context.Context, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -214,10 +287,120 @@ func (c *CoinbasePro) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (c *CoinbasePro) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return c.GetFee(feeBuilder)
+	return c.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (c *CoinbasePro) GetWithdrawCapabilities() uint32 {
 	return c.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (c *CoinbasePro) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := c.GetOrders(ctx, []string{"open", "pending", "active"}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return exchange.FilterOrders(c.formatOrderDetails(resp), getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (c *CoinbasePro) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := c.GetOrders(ctx, []string{"done"}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return exchange.FilterOrders(c.formatOrderDetails(resp), getOrdersRequest), nil
+}
+
+// formatOrderDetails converts Coinbase Pro orders into a list of
+// exchange.OrderDetail
+func (c *CoinbasePro) formatOrderDetails(resp []GeneralizedOrderResponse) []exchange.OrderDetail {
+	var orders []exchange.OrderDetail
+	for i := range resp {
+		orderDate, err := time.Parse(time.RFC3339, resp[i].CreatedAt)
+		if err != nil {
+			log.Printf("%s unable to parse order time %s: %s", c.Name,
+				resp[i].CreatedAt, err)
+		}
+
+		status := resp[i].Status
+		if status == "done" && resp[i].DoneReason != "" {
+			status = resp[i].DoneReason
+		}
+
+		p := pair.NewCurrencyPairDelimiter(resp[i].ProductID, "-")
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:       c.Name,
+			ID:             resp[i].ID,
+			BaseCurrency:   p.FirstCurrency.String(),
+			QuoteCurrency:  p.SecondCurrency.String(),
+			OrderSide:      resp[i].Side,
+			OrderType:      resp[i].Type,
+			CreationTime:   orderDate.Unix(),
+			OrderDate:      orderDate,
+			Status:         status,
+			Price:          resp[i].Price,
+			Amount:         resp[i].Size,
+			OpenVolume:     resp[i].Size - resp[i].FilledSize,
+			ExecutedAmount: resp[i].FilledSize,
+		})
+	}
+	return orders
+}
+
+// coinbaseproCandleIntervals maps the supported candle intervals to Coinbase
+// Pro historic rate granularities in seconds
+var coinbaseproCandleIntervals = map[exchange.CandleInterval]string{
+	exchange.OneMin:     "60",
+	exchange.FiveMin:    "300",
+	exchange.FifteenMin: "900",
+	exchange.OneHour:    "3600",
+	exchange.SixHour:    "21600",
+	exchange.OneDay:     "86400",
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (c *CoinbasePro) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	coinbaseproInterval, err := exchange.ValidateCandleRequest(coinbaseproCandleIntervals,
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	granularity, err := strconv.ParseInt(coinbaseproInterval, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := exchange.FormatExchangeCurrency(c.Name, p).String()
+	return exchange.GetCandlesPaginated(interval, start, end, coinbaseproMaxHistoricRates,
+		func(windowStart, windowEnd time.Time) ([]exchange.Candle, error) {
+			resp, err := c.GetHistoricRates(ctx, symbol,
+				windowStart.Unix(),
+				windowEnd.Unix(),
+				granularity)
+			if err != nil {
+				return nil, err
+			}
+
+			var candles []exchange.Candle
+			for i := range resp {
+				candles = append(candles, exchange.Candle{
+					Time:   time.Unix(resp[i].Time, 0),
+					Open:   resp[i].Open,
+					High:   resp[i].High,
+					Low:    resp[i].Low,
+					Close:  resp[i].Close,
+					Volume: resp[i].Volume,
+				})
+			}
+			return candles, nil
+		})
+}
@@ -1,6 +1,7 @@
 package coinut
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -8,10 +9,17 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var c COINUT
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 // Please supply your own keys here to do better tests
 const (
 	apiKey                  = ""
@@ -44,7 +52,7 @@ func TestSetup(t *testing.T) {
 }
 
 func TestGetInstruments(t *testing.T) {
-	_, err := c.GetInstruments()
+	_, err := c.GetInstruments(context.Background())
 	if err != nil {
 		t.Error("Test failed - GetInstruments() error", err)
 	}
@@ -192,6 +200,61 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a COINUT using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *COINUT {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	bConfig, err := cfg.GetExchangeConfig("COINUT")
+	if err != nil {
+		t.Fatal("Test Failed - Coinut Setup() init error")
+	}
+
+	bConfig.AuthenticatedAPISupport = true
+	bConfig.APIKey, bConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r COINUT
+	r.SetDefaults()
+	r.Setup(bConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	r.InstrumentMap = map[string]int{"LTCBTC": 490}
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.LTC, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Coinut GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "39131" ||
+		orders[0].BaseCurrency != symbol.LTC || orders[0].QuoteCurrency != symbol.BTC ||
+		orders[0].Price != 0.0085 || orders[0].Amount != 2 || orders[0].OpenVolume != 1.5 ||
+		orders[0].ExecutedAmount != 0.5 || orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - Coinut GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	r.InstrumentMap = map[string]int{"LTCBTC": 490}
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.LTC, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Coinut GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "39131" ||
+		orders[0].Status != "FILLED" || orders[0].Price != 0.0084 ||
+		orders[0].Amount != 0.5 || orders[0].OpenVolume != 0) {
+		t.Errorf("Test Failed - Coinut GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -217,7 +280,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USD,
 	}
-	response, err := c.SubmitOrder(p, exchange.Buy, exchange.Limit, 1, 10, "1234234")
+	response, err := c.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 10, ClientID: "1234234"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -243,7 +306,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := c.CancelOrder(orderCancellation)
+	err := c.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
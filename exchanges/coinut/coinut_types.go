@@ -134,6 +134,12 @@ type OrdersResponse struct {
 	Data []OrdersBase
 }
 
+// OpenOrders holds the open orders of an instrument
+type OpenOrders struct {
+	GenericResponse
+	Orders []OrderResponse `json:"orders"`
+}
+
 // CancelOrders holds information about a cancelled order
 type CancelOrders struct {
 	InstrumentID int   `json:"int"`
@@ -87,14 +87,17 @@ func (c *COINUT) WsHandleData() {
 					log.Fatal(err)
 				}
 
+				currencyPair := instrumentListByCode[ticker.InstID]
+
 				c.Websocket.DataHandler <- exchange.TickerData{
-					Timestamp:  time.Unix(0, ticker.Timestamp),
+					Timestamp:  time.Unix(0, ticker.Timestamp*int64(time.Microsecond)),
 					Exchange:   c.GetName(),
 					AssetType:  "SPOT",
-					HighPrice:  ticker.HighestBuy,
-					LowPrice:   ticker.LowestSell,
+					Pair:       pair.NewCurrencyPairFromString(currencyPair),
+					BidPrice:   ticker.HighestBuy,
+					AskPrice:   ticker.LowestSell,
 					ClosePrice: ticker.Last,
-					Quantity:   ticker.Volume,
+					Quantity:   ticker.Volume24H,
 				}
 
 			case "inst_order_book":
@@ -154,7 +157,7 @@ func (c *COINUT) WsHandleData() {
 				currencyPair := instrumentListByCode[tradeUpdate.InstID]
 
 				c.Websocket.DataHandler <- exchange.TradeData{
-					Timestamp:    time.Unix(tradeUpdate.Timestamp, 0),
+					Timestamp:    time.Unix(0, tradeUpdate.Timestamp*int64(time.Microsecond)),
 					CurrencyPair: pair.NewCurrencyPairFromString(currencyPair),
 					AssetType:    "SPOT",
 					Exchange:     c.GetName(),
@@ -1,11 +1,13 @@
 package coinut
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -31,7 +33,7 @@ func (c *COINUT) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", c.GetName(), len(c.EnabledPairs), c.EnabledPairs)
 	}
 
-	exchangeProducts, err := c.GetInstruments()
+	exchangeProducts, err := c.GetInstruments(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available products.\n", c.GetName())
 		return
@@ -52,7 +54,7 @@ func (c *COINUT) Run() {
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // COINUT exchange
-func (c *COINUT) GetAccountInfo() (exchange.AccountInfo, error) {
+func (c *COINUT) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	/*
 		response.ExchangeName = e.GetName()
@@ -73,9 +75,9 @@ func (c *COINUT) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (c *COINUT) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (c *COINUT) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := c.GetInstrumentTicker(c.InstrumentMap[p.Pair().String()])
+	tick, err := c.GetInstrumentTicker(ctx, c.InstrumentMap[p.Pair().String()])
 	if err != nil {
 		return ticker.Price{}, err
 	}
@@ -91,27 +93,27 @@ func (c *COINUT) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pri
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (c *COINUT) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (c *COINUT) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(c.GetName(), p, assetType)
 	if err != nil {
-		return c.UpdateTicker(p, assetType)
+		return c.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (c *COINUT) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (c *COINUT) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(c.GetName(), p, assetType)
 	if err != nil {
-		return c.UpdateOrderbook(p, assetType)
+		return c.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (c *COINUT) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (c *COINUT) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := c.GetInstrumentOrderbook(c.InstrumentMap[p.Pair().String()], 200)
+	orderbookNew, err := c.GetInstrumentOrderbook(ctx, c.InstrumentMap[p.Pair().String()], 200)
 	if err != nil {
 		return orderBook, err
 	}
@@ -130,44 +132,48 @@ func (c *COINUT) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbo
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (c *COINUT) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (c *COINUT) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (c *COINUT) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (c *COINUT) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (c *COINUT) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (c *COINUT) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := c.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
 	var err error
 	var APIresponse interface{}
-	isBuyOrder := side == exchange.Buy
-	clientIDInt, err := strconv.ParseUint(clientID, 0, 32)
+	isBuyOrder := order.Side == exchange.Buy
+	clientIDInt, err := strconv.ParseUint(order.ClientID, 0, 32)
 	clientIDUint := uint32(clientIDInt)
 
 	if err != nil {
 		return submitOrderResponse, err
 	}
 	// Need to get the ID of the currency sent
-	instruments, err := c.GetInstruments()
+	instruments, err := c.GetInstruments(ctx)
 	if err != nil {
 		return submitOrderResponse, err
 	}
 
-	currencyArray := instruments.Instruments[p.Pair().String()]
+	currencyArray := instruments.Instruments[order.Pair.Pair().String()]
 	currencyID := currencyArray[0].InstID
 
-	if orderType == exchange.Limit {
-		APIresponse, err = c.NewOrder(currencyID, amount, price, isBuyOrder, clientIDUint)
-	} else if orderType == exchange.Market {
-		APIresponse, err = c.NewOrder(currencyID, amount, 0, isBuyOrder, clientIDUint)
+	if order.OrderType == exchange.Limit {
+		APIresponse, err = c.NewOrder(ctx, currencyID, order.Amount, order.Price, isBuyOrder, clientIDUint)
+	} else if order.OrderType == exchange.Market {
+		APIresponse, err = c.NewOrder(ctx, currencyID, order.Amount, 0, isBuyOrder, clientIDUint)
 	} else {
 		return submitOrderResponse, errors.New("unsupported order type")
 	}
@@ -194,12 +200,12 @@ func (c *COINUT) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, order
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (c *COINUT) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (c *COINUT) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (c *COINUT) CancelOrder(order exchange.OrderCancellation) error {
+func (c *COINUT) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
@@ -207,7 +213,7 @@ func (c *COINUT) CancelOrder(order exchange.OrderCancellation) error {
 	}
 
 	// Need to get the ID of the currency sent
-	instruments, err := c.GetInstruments()
+	instruments, err := c.GetInstruments(ctx)
 
 	if err != nil {
 		return err
@@ -215,36 +221,63 @@ func (c *COINUT) CancelOrder(order exchange.OrderCancellation) error {
 
 	currencyArray := instruments.Instruments[exchange.FormatExchangeCurrency(c.Name, order.CurrencyPair).String()]
 	currencyID := currencyArray[0].InstID
-	_, err = c.CancelExistingOrder(currencyID, int(orderIDInt))
+	_, err = c.CancelExistingOrder(ctx, currencyID, int(orderIDInt))
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (c *COINUT) CancelAllOrders() error {
+func (c *COINUT) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (c *COINUT) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (c *COINUT) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (c *COINUT) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (c *COINUT) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (c *COINUT) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (c *COINUT) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (c *COINUT) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (c *COINUT) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (c *COINUT) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *COINUT) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (c *COINUT) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (c *COINUT) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (c *COINUT) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -268,3 +301,80 @@ func (c *COINUT) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (c *COINUT) GetWithdrawCapabilities() uint32 {
 	return c.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (c *COINUT) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	var orders []exchange.OrderDetail
+	for _, currency := range c.orderCurrencies(getOrdersRequest) {
+		resp, err := c.GetOpenOrders(ctx, c.InstrumentMap[currency.Pair().String()])
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp.Orders {
+			orders = append(orders, c.formatOrderDetail(currency,
+				&resp.Orders[i], "OPEN"))
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (c *COINUT) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	// COINUT only returns past trades, each fill is reported as a filled order
+	var orders []exchange.OrderDetail
+	for _, currency := range c.orderCurrencies(getOrdersRequest) {
+		resp, err := c.GetTradeHistory(ctx, c.InstrumentMap[currency.Pair().String()], 0, 100)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp.Trades {
+			detail := c.formatOrderDetail(currency, &resp.Trades[i].Order, "FILLED")
+			detail.Price = resp.Trades[i].FillPrice
+			detail.Amount = resp.Trades[i].FillQuantity
+			detail.OpenVolume = 0
+			detail.ExecutedAmount = resp.Trades[i].FillQuantity
+			orders = append(orders, detail)
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// orderCurrencies returns the requested currency pairs, or the enabled pairs
+// when none are requested as COINUT orders are queried per instrument
+func (c *COINUT) orderCurrencies(getOrdersRequest exchange.GetOrdersRequest) []pair.CurrencyPair {
+	if len(getOrdersRequest.Currencies) > 0 {
+		return getOrdersRequest.Currencies
+	}
+	return c.GetEnabledCurrencies()
+}
+
+// formatOrderDetail converts a COINUT order into an exchange.OrderDetail
+func (c *COINUT) formatOrderDetail(p pair.CurrencyPair, order *OrderResponse, status string) exchange.OrderDetail {
+	orderDate := time.Unix(0, order.Timestamp*int64(time.Microsecond))
+	return exchange.OrderDetail{
+		Exchange:       c.Name,
+		ID:             strconv.FormatInt(order.OrderID, 10),
+		BaseCurrency:   p.FirstCurrency.String(),
+		QuoteCurrency:  p.SecondCurrency.String(),
+		OrderSide:      order.Side,
+		OrderType:      exchange.Limit.ToString(),
+		CreationTime:   orderDate.Unix(),
+		OrderDate:      orderDate,
+		Status:         status,
+		Price:          order.Price,
+		Amount:         order.Quantity,
+		OpenVolume:     order.OpenQuantity,
+		ExecutedAmount: order.Quantity - order.OpenQuantity,
+	}
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (c *COINUT) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
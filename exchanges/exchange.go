@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -71,6 +72,17 @@ const (
 type SubmitOrderResponse struct {
 	IsOrderPlaced bool
 	OrderID       string
+	// FilledAmount is the amount filled when the order was placed
+	FilledAmount float64
+	// AveragePrice is the average price of the filled amount
+	AveragePrice float64
+	// Fee is the fee paid on the filled amount in FeeCurrency
+	Fee         float64
+	FeeCurrency string
+	// OrderDate and LastUpdated are the exchange timestamps of when the order
+	// was placed and last changed, zero when not reported
+	OrderDate   time.Time
+	LastUpdated time.Time
 }
 
 // FeeBuilder is the type which holds all parameters required to calculate a fee for an exchange
@@ -176,10 +188,23 @@ type OrderDetail struct {
 	OrderSide     string
 	OrderType     string
 	CreationTime  int64
+	OrderDate     time.Time
 	Status        string
 	Price         float64
 	Amount        float64
 	OpenVolume    float64
+	// ExecutedAmount is the filled amount of the order, zero when the
+	// exchange does not report it
+	ExecutedAmount float64
+}
+
+// GetOrdersRequest used for GetOrderHistory and GetActiveOrders wrapper
+// functions, unset fields are not filtered on
+type GetOrdersRequest struct {
+	OrderSide  OrderSide
+	StartTicks time.Time
+	EndTicks   time.Time
+	Currencies []pair.CurrencyPair
 }
 
 // FundHistory holds exchange funding history data
@@ -208,6 +233,7 @@ type Base struct {
 	RESTPollingDelay                           time.Duration
 	AuthenticatedAPISupport                    bool
 	APIWithdrawPermissions                     uint32
+	OrderSupport                               OrderSupport
 	APIAuthPEMKeySupport                       bool
 	APISecret, APIKey, APIAuthPEMKey, ClientID string
 	Nonce                                      nonce.Nonce
@@ -230,6 +256,9 @@ type Base struct {
 	ConfigCurrencyPairFormat                   config.CurrencyPairFormatConfig
 	Websocket                                  *Websocket
 	*request.Requester
+
+	marketInfo    map[string]MarketInfo
+	marketInfoMtx sync.RWMutex
 }
 
 // IBotExchange enforces standard functions for all exchanges supported in
@@ -241,17 +270,18 @@ type IBotExchange interface {
 	GetName() string
 	IsEnabled() bool
 	SetEnabled(bool)
-	GetTickerPrice(currency pair.CurrencyPair, assetType string) (ticker.Price, error)
-	UpdateTicker(currency pair.CurrencyPair, assetType string) (ticker.Price, error)
-	GetOrderbookEx(currency pair.CurrencyPair, assetType string) (orderbook.Base, error)
-	UpdateOrderbook(currency pair.CurrencyPair, assetType string) (orderbook.Base, error)
+	GetTickerPrice(ctx context.Context, currency pair.CurrencyPair, assetType string) (ticker.Price, error)
+	UpdateTicker(ctx context.Context, currency pair.CurrencyPair, assetType string) (ticker.Price, error)
+	GetOrderbookEx(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error)
+	UpdateOrderbook(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error)
 	GetEnabledCurrencies() []pair.CurrencyPair
 	GetAvailableCurrencies() []pair.CurrencyPair
 	GetAssetTypes() []string
-	GetAccountInfo() (AccountInfo, error)
+	GetAccountInfo(ctx context.Context) (AccountInfo, error)
 	GetAuthenticatedAPISupport() bool
 	SetCurrencies(pairs []pair.CurrencyPair, enabledPairs bool) error
-	GetExchangeHistory(pair.CurrencyPair, string) ([]TradeHistory, error)
+	GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]TradeHistory, error)
+	GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval CandleInterval, start, end time.Time) ([]Candle, error)
 	SupportsAutoPairUpdates() bool
 	GetLastPairsUpdateTime() int64
 	SupportsRESTTickerBatchUpdates() bool
@@ -260,16 +290,30 @@ type IBotExchange interface {
 	FormatWithdrawPermissions() string
 	SupportsWithdrawPermissions(permissions uint32) bool
 
-	GetFundingHistory() ([]FundHistory, error)
-	SubmitOrder(p pair.CurrencyPair, side OrderSide, orderType OrderType, amount, price float64, clientID string) (SubmitOrderResponse, error)
-	ModifyOrder(orderID int64, modify ModifyOrder) (int64, error)
-	CancelOrder(order OrderCancellation) error
-	CancelAllOrders() error
-	GetOrderInfo(orderID int64) (OrderDetail, error)
-	GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error)
-
-	WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error)
-	WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error)
+	GetFundingHistory(ctx context.Context) ([]FundHistory, error)
+	SubmitOrder(ctx context.Context, order SubmitOrder) (SubmitOrderResponse, error)
+	GetOrderSupport() OrderSupport
+	SupportsOrder(orderType OrderType, options OrderOptions) error
+	ValidateOrder(order *SubmitOrder) error
+	RoundOrder(order *SubmitOrder)
+	GetPairMarketInfo(p pair.CurrencyPair, assetType string) (MarketInfo, bool)
+	GetAllMarketInfo() []MarketInfo
+	ModifyOrder(ctx context.Context, orderID string, modify ModifyOrder) (string, error)
+	CancelOrder(ctx context.Context, order OrderCancellation) error
+	CancelAllOrders(ctx context.Context) error
+	GetOrderInfo(ctx context.Context, orderID string) (OrderDetail, error)
+	GetActiveOrders(ctx context.Context, getOrdersRequest GetOrdersRequest) ([]OrderDetail, error)
+	GetOrderHistory(ctx context.Context, getOrdersRequest GetOrdersRequest) ([]OrderDetail, error)
+	GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error)
+
+	GetDerivativesPositions(ctx context.Context) ([]Position, error)
+	SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error
+	SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode MarginMode) error
+	CloseDerivativesPosition(ctx context.Context, position Position) (SubmitOrderResponse, error)
+	GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (FundingRate, error)
+
+	WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error)
+	WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error)
 
 	GetWebsocket() (*Websocket, error)
 }
@@ -545,6 +589,31 @@ func (e *Base) GetAvailableCurrencies() []pair.CurrencyPair {
 		e.ConfigCurrencyPairFormat.Index)
 }
 
+// GetPairFromSymbol returns the available currency pair which matches an
+// exchange symbol with its delimiter removed, if there is no match the symbol
+// is split using the default currency pair rules
+func (e *Base) GetPairFromSymbol(symbol string) pair.CurrencyPair {
+	stripped := common.StringToUpper(symbol)
+	for _, d := range []string{"-", "_", "/"} {
+		stripped = common.ReplaceString(stripped, d, "", -1)
+	}
+
+	pairs := append(e.GetEnabledCurrencies(), e.GetAvailableCurrencies()...)
+	for i := range pairs {
+		if pairs[i].FirstCurrency.Upper().String()+
+			pairs[i].SecondCurrency.Upper().String() == stripped {
+			return pair.NewCurrencyPair(pairs[i].FirstCurrency.Upper().String(),
+				pairs[i].SecondCurrency.Upper().String())
+		}
+	}
+
+	if len(symbol) < 3 {
+		return pair.NewCurrencyPair(common.StringToUpper(symbol), "")
+	}
+	p := pair.NewCurrencyPairFromString(common.StringToUpper(symbol))
+	return pair.NewCurrencyPair(p.FirstCurrency.String(), p.SecondCurrency.String())
+}
+
 // SupportsCurrency returns true or not whether a currency pair exists in the
 // exchange available currencies or not
 func (e *Base) SupportsCurrency(p pair.CurrencyPair, enabledPairs bool) bool {
@@ -751,6 +820,21 @@ type OrderType string
 const (
 	Limit  OrderType = "Limit"
 	Market OrderType = "Market"
+	// Stop is a market order triggered when the price moves through the stop
+	// price against the position
+	Stop OrderType = "Stop"
+	// StopLimit is a limit order placed when the price moves through the stop
+	// price against the position
+	StopLimit OrderType = "StopLimit"
+	// TakeProfit is a market order triggered when the price moves through the
+	// stop price in favour of the position
+	TakeProfit OrderType = "TakeProfit"
+	// TakeProfitLimit is a limit order placed when the price moves through the
+	// stop price in favour of the position
+	TakeProfitLimit OrderType = "TakeProfitLimit"
+	// TrailingStop is a stop order whose stop price trails the market by the
+	// trailing offset
+	TrailingStop OrderType = "TrailingStop"
 )
 
 // ToString changes the ordertype to the exchange standard and returns a string
@@ -772,6 +856,70 @@ func (o OrderSide) ToString() string {
 	return fmt.Sprintf("%v", o)
 }
 
+// FilterOrdersBySide removes any orders that do not match the order side,
+// an empty side does not filter
+func FilterOrdersBySide(orders []OrderDetail, side OrderSide) []OrderDetail {
+	if side == "" {
+		return orders
+	}
+
+	var filteredOrders []OrderDetail
+	for i := range orders {
+		if strings.EqualFold(orders[i].OrderSide, side.ToString()) {
+			filteredOrders = append(filteredOrders, orders[i])
+		}
+	}
+	return filteredOrders
+}
+
+// FilterOrdersByTickRange removes any orders placed outside of the start and
+// end times, a zero start or end time is treated as unbounded
+func FilterOrdersByTickRange(orders []OrderDetail, startTicks, endTicks time.Time) []OrderDetail {
+	if startTicks.IsZero() && endTicks.IsZero() {
+		return orders
+	}
+
+	var filteredOrders []OrderDetail
+	for i := range orders {
+		if !startTicks.IsZero() && orders[i].OrderDate.Before(startTicks) {
+			continue
+		}
+		if !endTicks.IsZero() && orders[i].OrderDate.After(endTicks) {
+			continue
+		}
+		filteredOrders = append(filteredOrders, orders[i])
+	}
+	return filteredOrders
+}
+
+// FilterOrdersByCurrencies removes any orders that do not match one of the
+// supplied currency pairs, an empty list does not filter
+func FilterOrdersByCurrencies(orders []OrderDetail, currencies []pair.CurrencyPair) []OrderDetail {
+	if len(currencies) == 0 {
+		return orders
+	}
+
+	var filteredOrders []OrderDetail
+	for i := range orders {
+		for j := range currencies {
+			if strings.EqualFold(orders[i].BaseCurrency, currencies[j].FirstCurrency.String()) &&
+				strings.EqualFold(orders[i].QuoteCurrency, currencies[j].SecondCurrency.String()) {
+				filteredOrders = append(filteredOrders, orders[i])
+				break
+			}
+		}
+	}
+	return filteredOrders
+}
+
+// FilterOrders applies all filters contained in the GetOrdersRequest
+func FilterOrders(orders []OrderDetail, getOrdersRequest GetOrdersRequest) []OrderDetail {
+	orders = FilterOrdersBySide(orders, getOrdersRequest.OrderSide)
+	orders = FilterOrdersByTickRange(orders, getOrdersRequest.StartTicks,
+		getOrdersRequest.EndTicks)
+	return FilterOrdersByCurrencies(orders, getOrdersRequest.Currencies)
+}
+
 // SetAPIURL sets configuration API URL for an exchange
 func (e *Base) SetAPIURL(ec config.ExchangeConfig) error {
 	if ec.APIURL == "" || ec.APIURLSecondary == "" {
@@ -0,0 +1,103 @@
+package exchange
+
+import (
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+)
+
+// PositionSide is the direction of a derivatives position
+type PositionSide string
+
+// Position sides
+const (
+	Long  PositionSide = "LONG"
+	Short PositionSide = "SHORT"
+)
+
+// MarginMode defines whether a position shares the account margin or holds
+// margin of its own
+type MarginMode string
+
+// Margin modes
+const (
+	CrossMargin    MarginMode = "CROSS"
+	IsolatedMargin MarginMode = "ISOLATED"
+)
+
+// Position holds a normalised open derivatives position
+type Position struct {
+	Exchange  string            `json:"exchange"`
+	Pair      pair.CurrencyPair `json:"pair"`
+	AssetType string            `json:"assetType"`
+	Side      PositionSide      `json:"side"`
+	// Amount is the size of the position in contracts and is always positive
+	Amount     float64    `json:"amount"`
+	EntryPrice float64    `json:"entryPrice"`
+	MarkPrice  float64    `json:"markPrice"`
+	Leverage   float64    `json:"leverage"`
+	MarginMode MarginMode `json:"marginMode"`
+	// LiquidationPrice is zero when the exchange does not report it
+	LiquidationPrice float64 `json:"liquidationPrice"`
+	// UnrealisedPNL and RealisedPNL are in the settlement currency and are
+	// zero when the exchange does not report them
+	UnrealisedPNL float64 `json:"unrealisedPNL"`
+	RealisedPNL   float64 `json:"realisedPNL"`
+	// ContractValue is the size of one contract, in the base currency for
+	// linear contracts and the quote currency for inverse contracts. Zero is
+	// treated as one
+	ContractValue float64 `json:"contractValue"`
+	// Inverse is set for contracts margined and settled in the base currency
+	Inverse     bool      `json:"inverse"`
+	LastUpdated time.Time `json:"lastUpdated"`
+}
+
+// FundingRate holds the funding rate of a perpetual contract
+type FundingRate struct {
+	Pair      pair.CurrencyPair `json:"pair"`
+	AssetType string            `json:"assetType"`
+	// Rate is the rate of the current funding interval
+	Rate float64 `json:"rate"`
+	// PredictedRate is the estimated rate of the next funding interval
+	PredictedRate float64 `json:"predictedRate"`
+	// NextFunding is when the current rate is next charged
+	NextFunding time.Time `json:"nextFunding"`
+}
+
+// GetDirection returns one for long positions and minus one for short
+// positions
+func (p *Position) GetDirection() float64 {
+	if p.Side == Short {
+		return -1
+	}
+	return 1
+}
+
+// CalculateUnrealisedPNL returns the unrealised profit or loss of the position
+// at the price, in the quote currency for linear contracts and the base
+// currency for inverse contracts
+func (p *Position) CalculateUnrealisedPNL(price float64) float64 {
+	if price <= 0 || p.EntryPrice <= 0 {
+		return 0
+	}
+
+	size := p.Amount
+	if p.ContractValue > 0 {
+		size *= p.ContractValue
+	}
+
+	if p.Inverse {
+		return (1/p.EntryPrice - 1/price) * size * p.GetDirection()
+	}
+	return (price - p.EntryPrice) * size * p.GetDirection()
+}
+
+// LiquidationDistance returns how far the price can move against the position
+// before it is liquidated as a percentage of the price, zero when the
+// liquidation price is unknown
+func (p *Position) LiquidationDistance(price float64) float64 {
+	if price <= 0 || p.LiquidationPrice <= 0 {
+		return 0
+	}
+	return (price - p.LiquidationPrice) / price * 100 * p.GetDirection()
+}
@@ -0,0 +1,40 @@
+package exchange
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPositionCalculations(t *testing.T) {
+	long := Position{Side: Long, Amount: 2, EntryPrice: 100, LiquidationPrice: 80}
+	short := Position{Side: Short, Amount: 2, EntryPrice: 100, LiquidationPrice: 125}
+
+	if long.CalculateUnrealisedPNL(110) != 20 || short.CalculateUnrealisedPNL(110) != -20 {
+		t.Errorf("Test failed. Unexpected unrealised PNL %f and %f",
+			long.CalculateUnrealisedPNL(110), short.CalculateUnrealisedPNL(110))
+	}
+
+	if long.CalculateUnrealisedPNL(0) != 0 {
+		t.Error("Test failed. Expected no unrealised PNL without a price")
+	}
+
+	inverse := Position{Side: Short, Amount: 2, EntryPrice: 100, ContractValue: 100,
+		Inverse: true}
+	if pnl := inverse.CalculateUnrealisedPNL(125); math.Abs(pnl+0.4) > 1e-9 {
+		t.Errorf("Test failed. Expected inverse unrealised PNL -0.4, received %f", pnl)
+	}
+
+	if long.LiquidationDistance(100) != 20 || short.LiquidationDistance(100) != 25 {
+		t.Errorf("Test failed. Unexpected liquidation distance %f and %f",
+			long.LiquidationDistance(100), short.LiquidationDistance(100))
+	}
+
+	if long.LiquidationDistance(75) >= 0 {
+		t.Error("Test failed. Expected negative distance past the liquidation price")
+	}
+
+	long.LiquidationPrice = 0
+	if long.LiquidationDistance(100) != 0 {
+		t.Error("Test failed. Expected no distance without a liquidation price")
+	}
+}
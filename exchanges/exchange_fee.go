@@ -0,0 +1,65 @@
+package exchange
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+)
+
+// FeeModel calculates the fee of a transaction, all exchange wrappers satisfy
+// this interface through their GetFeeByType function
+type FeeModel interface {
+	GetFeeByType(feeBuilder FeeBuilder) (float64, error)
+}
+
+// FeeRateCache holds trading fee rates derived from fee models. The maker or
+// taker rate of each exchange and currency pair is derived once and reused, so
+// fee models which query the account are not called for every trade. The zero
+// value is ready to use
+type FeeRateCache struct {
+	rates map[string]float64
+	m     sync.Mutex
+}
+
+// Rate returns the maker or taker trading fee rate as a fraction of the trade
+// value, deriving it from the fee of the trade if it is not cached
+func (f *FeeRateCache) Rate(fees FeeModel, exchName string, p pair.CurrencyPair, isMaker bool, price, amount float64) (float64, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if f.rates == nil {
+		f.rates = make(map[string]float64)
+	}
+
+	key := fmt.Sprintf("%s-%s-%v", exchName, p.Pair(), isMaker)
+	if rate, ok := f.rates[key]; ok {
+		return rate, nil
+	}
+
+	fee, err := fees.GetFeeByType(FeeBuilder{
+		FeeType:        CryptocurrencyTradeFee,
+		FirstCurrency:  p.FirstCurrency.Upper().String(),
+		SecondCurrency: p.SecondCurrency.Upper().String(),
+		IsMaker:        isMaker,
+		PurchasePrice:  price,
+		Amount:         amount,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	rate := fee / (price * amount)
+	f.rates[key] = rate
+	return rate, nil
+}
+
+// Fee returns the maker or taker trading fee of a trade using the cached fee
+// rate
+func (f *FeeRateCache) Fee(fees FeeModel, exchName string, p pair.CurrencyPair, isMaker bool, price, amount float64) (float64, error) {
+	rate, err := f.Rate(fees, exchName, p, isMaker, price, amount)
+	if err != nil {
+		return 0, err
+	}
+	return price * amount * rate, nil
+}
@@ -0,0 +1,52 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+)
+
+type countingFees struct {
+	calls int
+}
+
+func (c *countingFees) GetFeeByType(feeBuilder FeeBuilder) (float64, error) {
+	c.calls++
+	rate := 0.002
+	if feeBuilder.IsMaker {
+		rate = 0.001
+	}
+	return feeBuilder.PurchasePrice * feeBuilder.Amount * rate, nil
+}
+
+func TestFeeRateCache(t *testing.T) {
+	var cache FeeRateCache
+	fees := &countingFees{}
+	p := pair.NewCurrencyPair("BTC", "USD")
+
+	fee, err := cache.Fee(fees, "Bitstamp", p, false, 100, 2)
+	if err != nil {
+		t.Fatal("Test failed. Fee error", err)
+	}
+	if fee != 0.4 {
+		t.Errorf("Test failed. Expected taker fee of 0.4, received %f", fee)
+	}
+
+	fee, err = cache.Fee(fees, "Bitstamp", p, false, 200, 1)
+	if err != nil {
+		t.Fatal("Test failed. Fee error", err)
+	}
+	if fee != 0.4 || fees.calls != 1 {
+		t.Errorf("Test failed. Expected cached taker rate, received fee %f after %d calls",
+			fee, fees.calls)
+	}
+
+	rate, err := cache.Rate(fees, "Bitstamp", p, true, 100, 1)
+	if err != nil {
+		t.Fatal("Test failed. Rate error", err)
+	}
+	if rate != 0.001 || fees.calls != 2 {
+		t.Errorf("Test failed. Expected maker rate of 0.001, received %f after %d calls",
+			rate, fees.calls)
+	}
+}
@@ -0,0 +1,120 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// CandleInterval defines the time period covered by a single candle
+type CandleInterval time.Duration
+
+// Supported candle intervals, an exchange will only support a subset of these
+const (
+	OneMin     = CandleInterval(time.Minute)
+	ThreeMin   = CandleInterval(3 * time.Minute)
+	FiveMin    = CandleInterval(5 * time.Minute)
+	FifteenMin = CandleInterval(15 * time.Minute)
+	ThirtyMin  = CandleInterval(30 * time.Minute)
+	OneHour    = CandleInterval(time.Hour)
+	TwoHour    = CandleInterval(2 * time.Hour)
+	FourHour   = CandleInterval(4 * time.Hour)
+	SixHour    = CandleInterval(6 * time.Hour)
+	EightHour  = CandleInterval(8 * time.Hour)
+	TwelveHour = CandleInterval(12 * time.Hour)
+	OneDay     = CandleInterval(24 * time.Hour)
+	ThreeDay   = CandleInterval(72 * time.Hour)
+	OneWeek    = CandleInterval(168 * time.Hour)
+	FifteenDay = CandleInterval(360 * time.Hour)
+)
+
+var (
+	errCandleStartAfterEnd = errors.New("candle start time must be before end time")
+	errCandleNoStart       = errors.New("candle start time must be set")
+)
+
+// Candle holds a single normalised open, high, low, close and volume period,
+// Time is the opening time of the candle
+type Candle struct {
+	Time   time.Time `json:"time"`
+	Open   float64   `json:"open"`
+	High   float64   `json:"high"`
+	Low    float64   `json:"low"`
+	Close  float64   `json:"close"`
+	Volume float64   `json:"volume"`
+}
+
+// Duration returns the interval as a time.Duration
+func (c CandleInterval) Duration() time.Duration {
+	return time.Duration(c)
+}
+
+// String returns a readable version of the interval
+func (c CandleInterval) String() string {
+	return time.Duration(c).String()
+}
+
+// ValidateCandleRequest checks the requested time range and returns the
+// exchange specific representation of the interval from the supported
+// intervals map
+func ValidateCandleRequest(supported map[CandleInterval]string, interval CandleInterval, start, end time.Time) (string, error) {
+	if start.IsZero() {
+		return "", errCandleNoStart
+	}
+
+	if !end.IsZero() && !start.Before(end) {
+		return "", errCandleStartAfterEnd
+	}
+
+	i, ok := supported[interval]
+	if !ok {
+		return "", fmt.Errorf("candle interval %s is not supported", interval)
+	}
+	return i, nil
+}
+
+// GetCandlesPaginated splits the requested time range into windows of at most
+// limit candles and calls fetch for each window. The returned candles are
+// sorted, de-duplicated and limited to the range [start, end). A zero end time
+// is treated as now
+func GetCandlesPaginated(interval CandleInterval, start, end time.Time, limit int, fetch func(start, end time.Time) ([]Candle, error)) ([]Candle, error) {
+	if limit <= 0 {
+		return nil, errors.New("candle request limit must be greater than zero")
+	}
+
+	if end.IsZero() {
+		end = time.Now()
+	}
+
+	window := time.Duration(limit) * interval.Duration()
+	seen := make(map[int64]bool)
+	var candles []Candle
+	for windowStart := start; windowStart.Before(end); windowStart = windowStart.Add(window) {
+		windowEnd := windowStart.Add(window)
+		if windowEnd.After(end) {
+			windowEnd = end
+		}
+
+		resp, err := fetch(windowStart, windowEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp {
+			if resp[i].Time.Before(start) || !resp[i].Time.Before(end) {
+				continue
+			}
+			if seen[resp[i].Time.Unix()] {
+				continue
+			}
+			seen[resp[i].Time.Unix()] = true
+			candles = append(candles, resp[i])
+		}
+	}
+
+	sort.Slice(candles, func(i, j int) bool {
+		return candles[i].Time.Before(candles[j].Time)
+	})
+	return candles, nil
+}
@@ -0,0 +1,77 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateCandleRequest(t *testing.T) {
+	supported := map[CandleInterval]string{OneMin: "1m"}
+	start := time.Now().Add(-time.Hour)
+
+	i, err := ValidateCandleRequest(supported, OneMin, start, time.Now())
+	if err != nil {
+		t.Fatal("Test failed. ValidateCandleRequest error", err)
+	}
+	if i != "1m" {
+		t.Errorf("Test failed. Expected 1m, received %s", i)
+	}
+
+	_, err = ValidateCandleRequest(supported, OneHour, start, time.Now())
+	if err == nil {
+		t.Error("Test failed. Expected unsupported interval error")
+	}
+
+	_, err = ValidateCandleRequest(supported, OneMin, time.Now(), start)
+	if err != errCandleStartAfterEnd {
+		t.Errorf("Test failed. Expected %s, received %v", errCandleStartAfterEnd, err)
+	}
+
+	_, err = ValidateCandleRequest(supported, OneMin, time.Time{}, time.Now())
+	if err != errCandleNoStart {
+		t.Errorf("Test failed. Expected %s, received %v", errCandleNoStart, err)
+	}
+}
+
+func TestGetCandlesPaginated(t *testing.T) {
+	start := time.Unix(1540000000, 0)
+	end := start.Add(25 * time.Minute)
+
+	var requests int
+	candles, err := GetCandlesPaginated(OneMin, start, end, 10,
+		func(windowStart, windowEnd time.Time) ([]Candle, error) {
+			requests++
+			if windowEnd.Sub(windowStart) > 10*time.Minute {
+				t.Errorf("Test failed. Window exceeds limit %s", windowEnd.Sub(windowStart))
+			}
+
+			// Return an overlapping candle to check de-duplication
+			var resp []Candle
+			for c := windowStart.Add(-time.Minute); c.Before(windowEnd); c = c.Add(time.Minute) {
+				resp = append(resp, Candle{Time: c, Close: float64(c.Unix())})
+			}
+			return resp, nil
+		})
+	if err != nil {
+		t.Fatal("Test failed. GetCandlesPaginated error", err)
+	}
+
+	if requests != 3 {
+		t.Errorf("Test failed. Expected 3 requests, received %d", requests)
+	}
+
+	if len(candles) != 25 {
+		t.Fatalf("Test failed. Expected 25 candles, received %d", len(candles))
+	}
+
+	for i := range candles {
+		if !candles[i].Time.Equal(start.Add(time.Duration(i) * time.Minute)) {
+			t.Fatalf("Test failed. Unexpected candle time %s at %d", candles[i].Time, i)
+		}
+	}
+
+	_, err = GetCandlesPaginated(OneMin, start, end, 0, nil)
+	if err == nil {
+		t.Error("Test failed. Expected limit error")
+	}
+}
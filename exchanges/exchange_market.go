@@ -0,0 +1,176 @@
+package exchange
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+// MarketInfo holds the normalised trading rules of a currency pair as
+// published by the exchange
+type MarketInfo struct {
+	Pair      pair.CurrencyPair `json:"pair"`
+	AssetType string            `json:"assetType"`
+	OrderLimits
+	LastUpdated time.Time `json:"lastUpdated"`
+}
+
+// PricePrecision returns the number of decimal places of the tick size
+func (m *MarketInfo) PricePrecision() int {
+	return stepPrecision(m.PriceStep)
+}
+
+// AmountPrecision returns the number of decimal places of the amount step
+func (m *MarketInfo) AmountPrecision() int {
+	return stepPrecision(m.AmountStep)
+}
+
+// StepFromPrecision returns the step of a value with the number of decimal
+// places, used by exchanges which publish precision instead of a tick size
+func StepFromPrecision(decimals int) float64 {
+	return math.Pow10(-decimals)
+}
+
+// stepPrecision returns the number of decimal places of a step, zero when the
+// step is not set or is a whole number
+func stepPrecision(step float64) int {
+	if step <= 0 {
+		return 0
+	}
+	decimals := math.Ceil(-math.Log10(step) - 1e-9)
+	if decimals < 0 {
+		return 0
+	}
+	return int(decimals)
+}
+
+// nearestStep returns the number of steps nearest to the value and whether the
+// value is that multiple of the step. The floating point error of the quotient
+// grows with its size so the tolerance is relative to it
+func nearestStep(value, step float64) (float64, bool) {
+	quotient := value / step
+	n := math.Round(quotient)
+	return n, math.Abs(quotient-n) <= math.Max(1e-9, math.Abs(n)*1e-12)
+}
+
+// roundToStep rounds the value to a multiple of the step using the rounding
+// function, values which are already a multiple are kept. The value is
+// returned unchanged when it or the step is not set
+func roundToStep(value, step float64, round func(float64) float64) float64 {
+	if value == 0 || step <= 0 {
+		return value
+	}
+
+	n, ok := nearestStep(value, step)
+	if !ok {
+		n = round(value / step)
+	}
+	rounded := n * step
+	shift := math.Pow10(stepPrecision(step))
+	return math.Round(rounded*shift) / shift
+}
+
+// marketInfoKey returns the key market info is stored under, the pair
+// delimiter is ignored so pairs match regardless of their format
+func marketInfoKey(p pair.CurrencyPair, assetType string) string {
+	if assetType == "" {
+		assetType = ticker.Spot
+	}
+	return p.Display("", true).String() + "_" + assetType
+}
+
+// SetMarketInfo replaces the stored trading rules of the exchange, pairs which
+// are no longer listed are removed
+func (e *Base) SetMarketInfo(markets []MarketInfo) {
+	e.marketInfoMtx.Lock()
+	defer e.marketInfoMtx.Unlock()
+
+	e.marketInfo = make(map[string]MarketInfo)
+	for i := range markets {
+		if markets[i].AssetType == "" {
+			markets[i].AssetType = ticker.Spot
+		}
+		if markets[i].LastUpdated.IsZero() {
+			markets[i].LastUpdated = time.Now()
+		}
+		e.marketInfo[marketInfoKey(markets[i].Pair, markets[i].AssetType)] = markets[i]
+	}
+}
+
+// GetPairMarketInfo returns the trading rules of a currency pair and whether
+// they are known
+func (e *Base) GetPairMarketInfo(p pair.CurrencyPair, assetType string) (MarketInfo, bool) {
+	e.marketInfoMtx.RLock()
+	defer e.marketInfoMtx.RUnlock()
+
+	info, ok := e.marketInfo[marketInfoKey(p, assetType)]
+	return info, ok
+}
+
+// GetAllMarketInfo returns the trading rules of all currency pairs sorted by
+// pair and asset type
+func (e *Base) GetAllMarketInfo() []MarketInfo {
+	e.marketInfoMtx.RLock()
+	defer e.marketInfoMtx.RUnlock()
+
+	keys := make([]string, 0, len(e.marketInfo))
+	for k := range e.marketInfo {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	markets := make([]MarketInfo, 0, len(keys))
+	for i := range keys {
+		markets = append(markets, e.marketInfo[keys[i]])
+	}
+	return markets
+}
+
+// SetOrderLimits stores the trading rules of a single currency pair
+func (e *Base) SetOrderLimits(p pair.CurrencyPair, assetType string, limits OrderLimits) {
+	if assetType == "" {
+		assetType = ticker.Spot
+	}
+
+	e.marketInfoMtx.Lock()
+	defer e.marketInfoMtx.Unlock()
+
+	if e.marketInfo == nil {
+		e.marketInfo = make(map[string]MarketInfo)
+	}
+	e.marketInfo[marketInfoKey(p, assetType)] = MarketInfo{
+		Pair:        p,
+		AssetType:   assetType,
+		OrderLimits: limits,
+		LastUpdated: time.Now(),
+	}
+}
+
+// GetOrderLimits returns the trading rules of a currency pair and whether they
+// are known
+func (e *Base) GetOrderLimits(p pair.CurrencyPair, assetType string) (OrderLimits, bool) {
+	info, ok := e.GetPairMarketInfo(p, assetType)
+	return info.OrderLimits, ok
+}
+
+// RoundOrder rounds the amount and prices of an order to the trading rules of
+// its currency pair. The amount is rounded down and limit prices are rounded
+// in the direction which never gives a worse price than requested
+func (e *Base) RoundOrder(s *SubmitOrder) {
+	limits, ok := e.GetOrderLimits(s.Pair, s.AssetType)
+	if !ok {
+		return
+	}
+
+	s.Amount = roundToStep(s.Amount, limits.AmountStep, math.Floor)
+
+	priceRound := math.Floor
+	if s.Side == Sell {
+		priceRound = math.Ceil
+	}
+	s.Price = roundToStep(s.Price, limits.PriceStep, priceRound)
+	s.Options.StopPrice = roundToStep(s.Options.StopPrice, limits.PriceStep, math.Round)
+}
@@ -0,0 +1,108 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+func TestSetMarketInfo(t *testing.T) {
+	var b Base
+	b.SetOrderLimits(pair.NewCurrencyPair("LTC", "BTC"), "", OrderLimits{MinAmount: 1})
+	b.SetMarketInfo([]MarketInfo{
+		{Pair: pair.NewCurrencyPairDelimiter("ETH-BTC", "-"), OrderLimits: OrderLimits{PriceStep: 0.00001}},
+		{Pair: pair.NewCurrencyPair("BTC", "USDT"), AssetType: "FUTURES", OrderLimits: OrderLimits{AmountStep: 1}},
+	})
+
+	if _, ok := b.GetPairMarketInfo(pair.NewCurrencyPair("LTC", "BTC"), ticker.Spot); ok {
+		t.Error("Test failed. Expected unlisted pair to be removed")
+	}
+
+	info, ok := b.GetPairMarketInfo(pair.NewCurrencyPairDelimiter("eth_btc", "_"), "")
+	if !ok || info.AssetType != ticker.Spot || info.LastUpdated.IsZero() {
+		t.Fatalf("Test failed. Expected market info regardless of pair format, received %+v", info)
+	}
+
+	if info.PricePrecision() != 5 || info.AmountPrecision() != 0 {
+		t.Errorf("Test failed. Unexpected precision %d and %d",
+			info.PricePrecision(), info.AmountPrecision())
+	}
+
+	if _, ok = b.GetPairMarketInfo(pair.NewCurrencyPair("BTC", "USDT"), ticker.Spot); ok {
+		t.Error("Test failed. Expected market info to be stored by asset type")
+	}
+
+	markets := b.GetAllMarketInfo()
+	if len(markets) != 2 || markets[0].AssetType != "FUTURES" {
+		t.Errorf("Test failed. Unexpected market info %+v", markets)
+	}
+}
+
+func TestStepPrecision(t *testing.T) {
+	tests := []struct {
+		step     float64
+		expected int
+	}{
+		{0, 0},
+		{1, 0},
+		{25, 0},
+		{0.5, 1},
+		{0.01, 2},
+		{0.00000100, 6},
+		{StepFromPrecision(8), 8},
+	}
+
+	for i := range tests {
+		if stepPrecision(tests[i].step) != tests[i].expected {
+			t.Errorf("Test failed. Step %v expected precision %d, received %d",
+				tests[i].step, tests[i].expected, stepPrecision(tests[i].step))
+		}
+	}
+}
+
+func TestRoundOrder(t *testing.T) {
+	var b Base
+	p := pair.NewCurrencyPair("BTC", "USD")
+	order := SubmitOrder{Pair: p, Side: Buy, OrderType: StopLimit, Amount: 1.23456,
+		Price: 100.37, Options: OrderOptions{StopPrice: 100.26}}
+
+	b.RoundOrder(&order)
+	if order.Amount != 1.23456 || order.Price != 100.37 {
+		t.Error("Test failed. Expected order without market info to be unchanged")
+	}
+
+	b.SetOrderLimits(p, ticker.Spot, OrderLimits{AmountStep: 0.001, PriceStep: 0.5})
+	b.RoundOrder(&order)
+	if order.Amount != 1.234 || order.Price != 100 || order.Options.StopPrice != 100.5 {
+		t.Errorf("Test failed. Unexpected rounded buy order %+v", order)
+	}
+
+	order = SubmitOrder{Pair: p, Side: Sell, OrderType: Limit, Amount: 0.3, Price: 100.1}
+	b.RoundOrder(&order)
+	if order.Amount != 0.3 || order.Price != 100.5 {
+		t.Errorf("Test failed. Unexpected rounded sell order %+v", order)
+	}
+
+	if b.ValidateOrder(&order) != nil {
+		t.Error("Test failed. Expected rounded order to be valid")
+	}
+	b.SetOrderLimits(p, ticker.Spot, OrderLimits{AmountStep: 1e-8, PriceStep: 0.01})
+	for _, side := range []OrderSide{Buy, Sell} {
+		order = SubmitOrder{Pair: p, Side: side, OrderType: Limit,
+			Amount: 1.23456789, Price: 6543.21}
+		b.RoundOrder(&order)
+		if order.Amount != 1.23456789 || order.Price != 6543.21 {
+			t.Errorf("Test failed. Expected exact %s order to be unchanged, received %+v",
+				side, order)
+		}
+
+		order = SubmitOrder{Pair: p, Side: side, OrderType: Limit,
+			Amount: 38.12345678, Price: 0.07}
+		b.RoundOrder(&order)
+		if order.Amount != 38.12345678 || order.Price != 0.07 {
+			t.Errorf("Test failed. Expected exact %s order to be unchanged, received %+v",
+				side, order)
+		}
+	}
+}
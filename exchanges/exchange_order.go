@@ -0,0 +1,318 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+// TimeInForce defines how long an order remains active before it is executed
+// or expires
+type TimeInForce string
+
+// Time in force values
+const (
+	GoodTillCancel    TimeInForce = "GTC"
+	GoodTillDate      TimeInForce = "GTD"
+	ImmediateOrCancel TimeInForce = "IOC"
+	FillOrKill        TimeInForce = "FOK"
+)
+
+var (
+	errOrderNoStopPrice        = errors.New("stop and take profit orders require a stop price")
+	errOrderNoTrailingOffset   = errors.New("trailing stop orders require a trailing offset")
+	errOrderStopPrice          = errors.New("stop price only applies to stop and take profit orders")
+	errOrderTrailingOffset     = errors.New("trailing offset only applies to trailing stop orders")
+	errOrderNoExpireTime       = errors.New("good till date orders require an expire time")
+	errOrderExpireTime         = errors.New("expire time only applies to good till date orders")
+	errOrderPostOnlyNotLimit   = errors.New("post only orders require a limit price")
+	errOrderPostOnlyImmediate  = errors.New("post only orders cannot be immediate or cancel or fill or kill")
+	errOrderPostOnlyNotAllowed = errors.New("post only orders are not supported")
+	errOrderReduceOnly         = errors.New("reduce only orders are not supported")
+	errOrderNoPair             = errors.New("orders require a currency pair")
+	errOrderNoType             = errors.New("orders require an order type")
+	errOrderNoAmount           = errors.New("order amount must be greater than zero")
+	errOrderNoPrice            = errors.New("limit priced orders require a price")
+)
+
+// OrderOptions holds the parameters of an order beyond its side, type, amount
+// and price
+type OrderOptions struct {
+	// StopPrice is the trigger price of stop and take profit orders
+	StopPrice float64
+	// TrailingOffset is the distance a trailing stop keeps from the market
+	// price in the quote currency
+	TrailingOffset float64
+	// TimeInForce defaults to GoodTillCancel when empty
+	TimeInForce TimeInForce
+	// ExpireTime is when a GoodTillDate order expires
+	ExpireTime time.Time
+	// PostOnly rejects a limit order which would take liquidity
+	PostOnly bool
+	// ReduceOnly rejects an order which would increase a position
+	ReduceOnly bool
+}
+
+// GetTimeInForce returns the time in force of the order, GoodTillCancel when
+// it is not set
+func (o *OrderOptions) GetTimeInForce() TimeInForce {
+	if o.TimeInForce == "" {
+		return GoodTillCancel
+	}
+	return o.TimeInForce
+}
+
+// IsPlain returns whether the options hold no parameters beyond those of a
+// plain good till cancel order
+func (o *OrderOptions) IsPlain() bool {
+	return o.StopPrice == 0 && o.TrailingOffset == 0 &&
+		o.GetTimeInForce() == GoodTillCancel && o.ExpireTime.IsZero() &&
+		!o.PostOnly && !o.ReduceOnly
+}
+
+// IsLimitPriced returns whether the order type is placed at a limit price
+func (o OrderType) IsLimitPriced() bool {
+	return o == Limit || o == StopLimit || o == TakeProfitLimit
+}
+
+// IsTriggered returns whether the order type waits for the market to reach a
+// stop price
+func (o OrderType) IsTriggered() bool {
+	return o == Stop || o == StopLimit || o == TakeProfit || o == TakeProfitLimit
+}
+
+// OrderSupport advertises the order types, time in force values and flags an
+// exchange supports natively
+type OrderSupport struct {
+	OrderTypes  []OrderType
+	TimeInForce []TimeInForce
+	PostOnly    bool
+	ReduceOnly  bool
+}
+
+// DefaultOrderSupport is the order support of exchanges which only accept
+// plain limit and market orders
+var DefaultOrderSupport = OrderSupport{
+	OrderTypes:  []OrderType{Limit, Market},
+	TimeInForce: []TimeInForce{GoodTillCancel},
+}
+
+// SupportsOrderType returns whether the order type is supported
+func (s *OrderSupport) SupportsOrderType(orderType OrderType) bool {
+	for i := range s.OrderTypes {
+		if s.OrderTypes[i] == orderType {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsTimeInForce returns whether the time in force is supported
+func (s *OrderSupport) SupportsTimeInForce(tif TimeInForce) bool {
+	for i := range s.TimeInForce {
+		if s.TimeInForce[i] == tif {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error when the order type and options are not supported
+// or are not a valid combination
+func (s *OrderSupport) Validate(orderType OrderType, options OrderOptions) error {
+	if !s.SupportsOrderType(orderType) {
+		return fmt.Errorf("order type %s is not supported", orderType)
+	}
+
+	tif := options.GetTimeInForce()
+	if !s.SupportsTimeInForce(tif) {
+		return fmt.Errorf("time in force %s is not supported", tif)
+	}
+
+	if options.PostOnly && !s.PostOnly {
+		return errOrderPostOnlyNotAllowed
+	}
+
+	if options.ReduceOnly && !s.ReduceOnly {
+		return errOrderReduceOnly
+	}
+
+	if orderType.IsTriggered() {
+		if options.StopPrice <= 0 {
+			return errOrderNoStopPrice
+		}
+	} else if options.StopPrice != 0 {
+		return errOrderStopPrice
+	}
+
+	if orderType == TrailingStop {
+		if options.TrailingOffset <= 0 {
+			return errOrderNoTrailingOffset
+		}
+	} else if options.TrailingOffset != 0 {
+		return errOrderTrailingOffset
+	}
+
+	if tif == GoodTillDate {
+		if options.ExpireTime.IsZero() {
+			return errOrderNoExpireTime
+		}
+	} else if !options.ExpireTime.IsZero() {
+		return errOrderExpireTime
+	}
+
+	if options.PostOnly {
+		if !orderType.IsLimitPriced() {
+			return errOrderPostOnlyNotLimit
+		}
+
+		if tif == ImmediateOrCancel || tif == FillOrKill {
+			return errOrderPostOnlyImmediate
+		}
+	}
+	return nil
+}
+
+// GetOrderSupport returns the order types, time in force values and flags the
+// exchange supports natively
+func (e *Base) GetOrderSupport() OrderSupport {
+	if len(e.OrderSupport.OrderTypes) == 0 {
+		return DefaultOrderSupport
+	}
+	return e.OrderSupport
+}
+
+// SupportsOrder returns an error when the exchange cannot natively place an
+// order of the type with the options
+func (e *Base) SupportsOrder(orderType OrderType, options OrderOptions) error {
+	support := e.GetOrderSupport()
+	return support.Validate(orderType, options)
+}
+
+// SubmitOrder holds the parameters of an order to be submitted to an exchange
+type SubmitOrder struct {
+	Pair pair.CurrencyPair
+	// AssetType defaults to spot when empty
+	AssetType string
+	Side      OrderSide
+	OrderType OrderType
+	Amount    float64
+	// Price is the limit price of limit priced order types
+	Price    float64
+	ClientID string
+	Options  OrderOptions
+}
+
+// GetAssetType returns the asset type of the order, spot when it is not set
+func (s *SubmitOrder) GetAssetType() string {
+	if s.AssetType == "" {
+		return ticker.Spot
+	}
+	return s.AssetType
+}
+
+// Validate returns an error when the order is missing a required parameter
+func (s *SubmitOrder) Validate() error {
+	if s.Pair.Pair() == "" {
+		return errOrderNoPair
+	}
+
+	if s.Side != Buy && s.Side != Sell {
+		return fmt.Errorf("order side %s is not supported", s.Side)
+	}
+
+	if s.OrderType == "" {
+		return errOrderNoType
+	}
+
+	if s.Amount <= 0 {
+		return errOrderNoAmount
+	}
+
+	if s.OrderType.IsLimitPriced() && s.Price <= 0 {
+		return errOrderNoPrice
+	}
+	return nil
+}
+
+// OrderLimits holds the trading rules of a currency pair an order must
+// satisfy, zero values are not checked
+type OrderLimits struct {
+	MinAmount  float64 `json:"minAmount"`
+	MaxAmount  float64 `json:"maxAmount"`
+	AmountStep float64 `json:"amountStep"`
+	// PriceStep is the tick size, prices must be a multiple of it which also
+	// enforces the price precision
+	PriceStep float64 `json:"priceStep"`
+	// MinNotional is the minimum order value in the quote currency
+	MinNotional float64 `json:"minNotional"`
+}
+
+// Check returns an error when the order breaks the trading rules, the notional
+// value of orders without a price is not checked
+func (l *OrderLimits) Check(s *SubmitOrder) error {
+	if l.MinAmount > 0 && s.Amount < l.MinAmount {
+		return fmt.Errorf("amount %v is below the minimum amount %v",
+			s.Amount, l.MinAmount)
+	}
+
+	if l.MaxAmount > 0 && s.Amount > l.MaxAmount {
+		return fmt.Errorf("amount %v is above the maximum amount %v",
+			s.Amount, l.MaxAmount)
+	}
+
+	if !isMultiple(s.Amount, l.AmountStep) {
+		return fmt.Errorf("amount %v is not a multiple of the amount step %v",
+			s.Amount, l.AmountStep)
+	}
+
+	if !isMultiple(s.Price, l.PriceStep) {
+		return fmt.Errorf("price %v is not a multiple of the tick size %v",
+			s.Price, l.PriceStep)
+	}
+
+	if !isMultiple(s.Options.StopPrice, l.PriceStep) {
+		return fmt.Errorf("stop price %v is not a multiple of the tick size %v",
+			s.Options.StopPrice, l.PriceStep)
+	}
+
+	if l.MinNotional > 0 && s.Price > 0 && s.Amount*s.Price < l.MinNotional {
+		return fmt.Errorf("order value %v is below the minimum notional %v",
+			s.Amount*s.Price, l.MinNotional)
+	}
+	return nil
+}
+
+// isMultiple returns whether the value is a multiple of the step allowing for
+// floating point error, zero values and steps always match
+func isMultiple(value, step float64) bool {
+	if value == 0 || step <= 0 {
+		return true
+	}
+	_, ok := nearestStep(value, step)
+	return ok
+}
+
+// ValidateOrder returns an error when the order is missing a required
+// parameter, is not supported natively by the exchange or breaks the trading
+// rules of its currency pair
+func (e *Base) ValidateOrder(s *SubmitOrder) error {
+	err := s.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = e.SupportsOrder(s.OrderType, s.Options)
+	if err != nil {
+		return err
+	}
+
+	limits, ok := e.GetOrderLimits(s.Pair, s.AssetType)
+	if !ok {
+		return nil
+	}
+	return limits.Check(s)
+}
@@ -0,0 +1,161 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+func TestOrderSupportValidate(t *testing.T) {
+	support := OrderSupport{
+		OrderTypes:  []OrderType{Limit, Market, Stop, StopLimit, TrailingStop},
+		TimeInForce: []TimeInForce{GoodTillCancel, GoodTillDate, ImmediateOrCancel},
+		PostOnly:    true,
+	}
+
+	tests := []struct {
+		orderType OrderType
+		options   OrderOptions
+		valid     bool
+	}{
+		{Limit, OrderOptions{}, true},
+		{TakeProfit, OrderOptions{StopPrice: 1}, false},
+		{Limit, OrderOptions{TimeInForce: FillOrKill}, false},
+		{Limit, OrderOptions{ReduceOnly: true}, false},
+		{Stop, OrderOptions{}, false},
+		{StopLimit, OrderOptions{StopPrice: 1, PostOnly: true}, true},
+		{Limit, OrderOptions{StopPrice: 1}, false},
+		{TrailingStop, OrderOptions{TrailingOffset: 5}, true},
+		{Market, OrderOptions{TrailingOffset: 5}, false},
+		{Limit, OrderOptions{TimeInForce: GoodTillDate}, false},
+		{Limit, OrderOptions{TimeInForce: GoodTillDate, ExpireTime: time.Now()}, true},
+		{Limit, OrderOptions{ExpireTime: time.Now()}, false},
+		{Market, OrderOptions{PostOnly: true}, false},
+		{Limit, OrderOptions{PostOnly: true, TimeInForce: ImmediateOrCancel}, false},
+	}
+
+	for i := range tests {
+		err := support.Validate(tests[i].orderType, tests[i].options)
+		if (err == nil) != tests[i].valid {
+			t.Errorf("Test failed. Order %d %s %+v expected valid %v, received %v",
+				i, tests[i].orderType, tests[i].options, tests[i].valid, err)
+		}
+	}
+}
+
+func TestGetOrderSupport(t *testing.T) {
+	var b Base
+	if b.SupportsOrder(Limit, OrderOptions{}) != nil {
+		t.Error("Test failed. Expected default support for limit orders")
+	}
+
+	if b.SupportsOrder(Stop, OrderOptions{StopPrice: 1}) == nil {
+		t.Error("Test failed. Expected stop orders not to be supported by default")
+	}
+
+	b.OrderSupport = OrderSupport{
+		OrderTypes:  []OrderType{Stop},
+		TimeInForce: []TimeInForce{GoodTillCancel},
+	}
+	if b.SupportsOrder(Stop, OrderOptions{StopPrice: 1}) != nil {
+		t.Error("Test failed. Expected stop orders to be supported")
+	}
+}
+
+func TestSubmitOrderValidate(t *testing.T) {
+	p := pair.NewCurrencyPair("BTC", "USD")
+	tests := []struct {
+		order SubmitOrder
+		valid bool
+	}{
+		{SubmitOrder{Pair: p, Side: Buy, OrderType: Limit, Amount: 1, Price: 90}, true},
+		{SubmitOrder{Pair: p, Side: Sell, OrderType: Market, Amount: 1}, true},
+		{SubmitOrder{Side: Buy, OrderType: Market, Amount: 1}, false},
+		{SubmitOrder{Pair: p, Side: "HOLD", OrderType: Market, Amount: 1}, false},
+		{SubmitOrder{Pair: p, Side: Buy, Amount: 1}, false},
+		{SubmitOrder{Pair: p, Side: Buy, OrderType: Market}, false},
+		{SubmitOrder{Pair: p, Side: Buy, OrderType: StopLimit, Amount: 1}, false},
+	}
+
+	for i := range tests {
+		err := tests[i].order.Validate()
+		if (err == nil) != tests[i].valid {
+			t.Errorf("Test failed. Order %d %+v expected valid %v, received %v",
+				i, tests[i].order, tests[i].valid, err)
+		}
+	}
+
+	if (&SubmitOrder{}).GetAssetType() != ticker.Spot {
+		t.Error("Test failed. Expected spot asset type by default")
+	}
+}
+
+func TestOrderLimitsCheck(t *testing.T) {
+	limits := OrderLimits{
+		MinAmount:   0.01,
+		MaxAmount:   100,
+		AmountStep:  0.001,
+		PriceStep:   0.1,
+		MinNotional: 10,
+	}
+
+	tests := []struct {
+		order SubmitOrder
+		valid bool
+	}{
+		{SubmitOrder{Amount: 0.3, Price: 100.3}, true},
+		{SubmitOrder{Amount: 0.3}, true},
+		{SubmitOrder{Amount: 0.001, Price: 100000}, false},
+		{SubmitOrder{Amount: 101, Price: 100}, false},
+		{SubmitOrder{Amount: 0.0105, Price: 1000}, false},
+		{SubmitOrder{Amount: 1, Price: 100.05}, false},
+		{SubmitOrder{Amount: 1, Price: 100, Options: OrderOptions{StopPrice: 99.99}}, false},
+		{SubmitOrder{Amount: 0.05, Price: 100}, false},
+	}
+
+	for i := range tests {
+		err := limits.Check(&tests[i].order)
+		if (err == nil) != tests[i].valid {
+			t.Errorf("Test failed. Order %d %+v expected valid %v, received %v",
+				i, tests[i].order, tests[i].valid, err)
+		}
+	}
+
+	limits = OrderLimits{AmountStep: 1e-8, PriceStep: 0.01}
+	for _, amount := range []float64{1.23456789, 38.12345678, 12345.6789} {
+		if err := limits.Check(&SubmitOrder{Amount: amount, Price: 6543.21}); err != nil {
+			t.Errorf("Test failed. Expected amount %v to be valid, received %v",
+				amount, err)
+		}
+	}
+
+	if limits.Check(&SubmitOrder{Amount: 1.234567891}) == nil {
+		t.Error("Test failed. Expected amount below the step to be invalid")
+	}
+}
+
+func TestValidateOrder(t *testing.T) {
+	var b Base
+	p := pair.NewCurrencyPair("BTC", "USD")
+	order := SubmitOrder{Pair: p, Side: Buy, OrderType: Limit, Amount: 0.5, Price: 90}
+	if b.ValidateOrder(&order) != nil {
+		t.Error("Test failed. Expected order to be valid without limits")
+	}
+
+	b.SetOrderLimits(pair.NewCurrencyPair("btc", "usd"), "", OrderLimits{MinAmount: 1})
+	if _, ok := b.GetOrderLimits(p, ticker.Spot); !ok {
+		t.Error("Test failed. Expected limits to be stored regardless of format")
+	}
+
+	if b.ValidateOrder(&order) == nil {
+		t.Error("Test failed. Expected minimum amount error")
+	}
+
+	order.Amount = 1
+	order.OrderType = Stop
+	if b.ValidateOrder(&order) == nil {
+		t.Error("Test failed. Expected unsupported order type error")
+	}
+}
@@ -928,3 +928,102 @@ func TestOrderTypes(t *testing.T) {
 		t.Errorf("test failed - unexpected string %s", os.ToString())
 	}
 }
+
+func TestFilterOrdersBySide(t *testing.T) {
+	orders := []OrderDetail{
+		{ID: "1", OrderSide: "buy"},
+		{ID: "2", OrderSide: Sell.ToString()},
+		{ID: "3", OrderSide: "BUY"},
+	}
+
+	result := FilterOrdersBySide(orders, Buy)
+	if len(result) != 2 {
+		t.Fatalf("Test failed. Expected 2 orders, received %d", len(result))
+	}
+
+	result = FilterOrdersBySide(orders, "")
+	if len(result) != 3 {
+		t.Errorf("Test failed. Expected 3 orders, received %d", len(result))
+	}
+}
+
+func TestFilterOrdersByTickRange(t *testing.T) {
+	now := time.Now()
+	orders := []OrderDetail{
+		{ID: "1", OrderDate: now.Add(-time.Hour * 2)},
+		{ID: "2", OrderDate: now.Add(-time.Hour)},
+		{ID: "3", OrderDate: now},
+	}
+
+	result := FilterOrdersByTickRange(orders, now.Add(-time.Minute*90), time.Time{})
+	if len(result) != 2 {
+		t.Fatalf("Test failed. Expected 2 orders, received %d", len(result))
+	}
+
+	result = FilterOrdersByTickRange(orders, now.Add(-time.Minute*90), now.Add(-time.Minute))
+	if len(result) != 1 || result[0].ID != "2" {
+		t.Errorf("Test failed. Unexpected filter result %v", result)
+	}
+
+	result = FilterOrdersByTickRange(orders, time.Time{}, time.Time{})
+	if len(result) != 3 {
+		t.Errorf("Test failed. Expected 3 orders, received %d", len(result))
+	}
+}
+
+func TestFilterOrdersByCurrencies(t *testing.T) {
+	orders := []OrderDetail{
+		{ID: "1", BaseCurrency: "BTC", QuoteCurrency: "USD"},
+		{ID: "2", BaseCurrency: "ltc", QuoteCurrency: "usd"},
+		{ID: "3", BaseCurrency: "ETH", QuoteCurrency: "BTC"},
+	}
+
+	result := FilterOrdersByCurrencies(orders, []pair.CurrencyPair{
+		pair.NewCurrencyPair("BTC", "USD"),
+		pair.NewCurrencyPair("LTC", "USD"),
+	})
+	if len(result) != 2 {
+		t.Fatalf("Test failed. Expected 2 orders, received %d", len(result))
+	}
+
+	result = FilterOrdersByCurrencies(orders, nil)
+	if len(result) != 3 {
+		t.Errorf("Test failed. Expected 3 orders, received %d", len(result))
+	}
+}
+
+func TestFilterOrders(t *testing.T) {
+	now := time.Now()
+	orders := []OrderDetail{
+		{ID: "1", OrderSide: "buy", BaseCurrency: "BTC", QuoteCurrency: "USD", OrderDate: now},
+		{ID: "2", OrderSide: "sell", BaseCurrency: "BTC", QuoteCurrency: "USD", OrderDate: now},
+		{ID: "3", OrderSide: "buy", BaseCurrency: "BTC", QuoteCurrency: "USD", OrderDate: now.Add(-time.Hour)},
+		{ID: "4", OrderSide: "buy", BaseCurrency: "LTC", QuoteCurrency: "USD", OrderDate: now},
+	}
+
+	result := FilterOrders(orders, GetOrdersRequest{
+		OrderSide:  Buy,
+		StartTicks: now.Add(-time.Minute),
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair("BTC", "USD")},
+	})
+	if len(result) != 1 || result[0].ID != "1" {
+		t.Errorf("Test failed. Unexpected filter result %v", result)
+	}
+}
+
+func TestGetPairFromSymbol(t *testing.T) {
+	b := Base{
+		AvailablePairs: []string{"DASH-BTC", "XBT-USD"},
+	}
+	b.ConfigCurrencyPairFormat.Delimiter = "-"
+
+	p := b.GetPairFromSymbol("dashbtc")
+	if p.FirstCurrency != "DASH" || p.SecondCurrency != "BTC" {
+		t.Errorf("Test failed. Unexpected pair %s", p.Pair())
+	}
+
+	p = b.GetPairFromSymbol("LTCUSD")
+	if p.FirstCurrency != "LTC" || p.SecondCurrency != "USD" {
+		t.Errorf("Test failed. Unexpected pair %s", p.Pair())
+	}
+}
@@ -1,14 +1,18 @@
 package exchange
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log"
 	"sync"
 	"time"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
 )
 
 const (
@@ -47,6 +51,7 @@ func (e *Base) WebsocketSetup(connector func() error,
 	e.Websocket.Disconnected = make(chan struct{}, 1)
 	e.Websocket.Intercomm = make(chan WebsocketResponse, 1)
 	e.Websocket.TrafficAlert = make(chan struct{}, 1)
+	e.Websocket.Orderbook.dataHandler = e.Websocket.DataHandler
 
 	err := e.Websocket.SetEnabled(wsEnabled)
 	if err != nil {
@@ -66,15 +71,21 @@ func (e *Base) WebsocketSetup(connector func() error,
 // Websocket defines a return type for websocket connections via the interface
 // wrapper for routine processing in routines.go
 type Websocket struct {
-	proxyAddr    string
-	defaultURL   string
-	runningURL   string
-	exchangeName string
-	enabled      bool
-	init         bool
-	connected    bool
-	connector    func() error
-	m            sync.Mutex
+	proxyAddr     string
+	defaultURL    string
+	runningURL    string
+	exchangeName  string
+	enabled       bool
+	init          bool
+	connected     bool
+	authenticated bool
+	authCount     int64
+	connector     func() error
+	authenticator func() error
+	m             sync.Mutex
+	// stateMtx guards connected, authenticated and authCount, which are read
+	// and written by the traffic monitor and exchange routines outside of m
+	stateMtx sync.RWMutex
 
 	// Connected denotes a channel switch for diversion of request flow
 	Connected chan struct{}
@@ -109,7 +120,7 @@ func (w *Websocket) trafficMonitor(wg *sync.WaitGroup) {
 	wg.Done() // Makes sure we are unlocking after we add to waitgroup
 
 	defer func() {
-		if w.connected {
+		if w.IsConnected() {
 			w.Disconnected <- struct{}{}
 		}
 		w.Wg.Done()
@@ -125,19 +136,19 @@ func (w *Websocket) trafficMonitor(wg *sync.WaitGroup) {
 			return
 
 		case <-w.TrafficAlert: // Resets timer on traffic
-			if !w.connected {
+			if !w.IsConnected() {
 				w.Connected <- struct{}{}
-				w.connected = true
+				w.setConnected(true)
 			}
 
 			trafficTimer.Reset(WebsocketTrafficLimitTime)
 
 		case <-trafficTimer.C: // Falls through when timer runs out
 			newtimer := time.NewTimer(10 * time.Second) // New secondary timer set
-			if w.connected {
+			if w.IsConnected() {
 				// If connected divert traffic to rest
 				w.Disconnected <- struct{}{}
-				w.connected = false
+				w.setConnected(false)
 			}
 
 			select {
@@ -150,10 +161,10 @@ func (w *Websocket) trafficMonitor(wg *sync.WaitGroup) {
 
 			case <-w.TrafficAlert: // If in this time response traffic comes through
 				trafficTimer.Reset(WebsocketTrafficLimitTime)
-				if !w.connected {
+				if !w.IsConnected() {
 					// If not connected divert traffic from REST to websocket
 					w.Connected <- struct{}{}
-					w.connected = true
+					w.setConnected(true)
 				}
 			}
 		}
@@ -171,7 +182,7 @@ func (w *Websocket) Connect() error {
 			w.GetName())
 	}
 
-	if w.connected {
+	if w.IsConnected() {
 		return errors.New("exchange_websocket.go error - already connected, cannot connect again")
 	}
 
@@ -190,7 +201,17 @@ func (w *Websocket) Connect() error {
 
 	// Divert for incoming websocket traffic
 	w.Connected <- struct{}{}
-	w.connected = true
+	w.setConnected(true)
+
+	// A failed private subscription leaves the public feed running
+	if w.authenticator != nil {
+		err = w.authenticator()
+		if err != nil {
+			log.Printf("exchange_websocket.go %s authenticated subscription error %s",
+				w.GetName(), err)
+		}
+		w.SetAuthenticated(err == nil)
+	}
 
 	return nil
 }
@@ -205,7 +226,7 @@ func (w *Websocket) Shutdown() error {
 		w.m.Unlock()
 	}()
 
-	if !w.connected {
+	if !w.IsConnected() {
 		return errors.New("exchange_websocket.go error - System not connected to shut down")
 	}
 
@@ -220,7 +241,10 @@ func (w *Websocket) Shutdown() error {
 
 	select {
 	case <-c:
+		w.stateMtx.Lock()
 		w.connected = false
+		w.authenticated = false
+		w.stateMtx.Unlock()
 		return nil
 	case <-timer.C:
 		return fmt.Errorf("%s - Websocket routines failed to shutdown",
@@ -256,13 +280,13 @@ func (w *Websocket) SetEnabled(enabled bool) error {
 
 	if !w.init {
 		if enabled {
-			if w.connected {
+			if w.IsConnected() {
 				return nil
 			}
 			return w.Connect()
 		}
 
-		if !w.connected {
+		if !w.IsConnected() {
 			return nil
 		}
 		return w.Shutdown()
@@ -284,7 +308,7 @@ func (w *Websocket) SetProxyAddress(URL string) error {
 	w.proxyAddr = URL
 
 	if !w.init && w.enabled {
-		if w.connected {
+		if w.IsConnected() {
 			err := w.Shutdown()
 			if err != nil {
 				return err
@@ -316,6 +340,53 @@ func (w *Websocket) SetConnector(connector func() error) {
 	w.connector = connector
 }
 
+// SetAuthenticator sets the function which authenticates the connection and
+// subscribes to the private order and balance channels after connecting
+func (w *Websocket) SetAuthenticator(authenticator func() error) {
+	w.authenticator = authenticator
+}
+
+// SetAuthenticated sets whether the private channels are subscribed, used by
+// exchanges which are told of a failed authentication after subscribing
+func (w *Websocket) SetAuthenticated(authenticated bool) {
+	w.stateMtx.Lock()
+	w.authenticated = authenticated
+	if authenticated {
+		w.authCount++
+	}
+	w.stateMtx.Unlock()
+}
+
+// GetAuthenticationCount returns the number of times the private channels have
+// been subscribed, letting callers detect a reauthentication
+func (w *Websocket) GetAuthenticationCount() int64 {
+	w.stateMtx.RLock()
+	defer w.stateMtx.RUnlock()
+	return w.authCount
+}
+
+// IsAuthenticated returns whether the connection is up with the private order
+// and balance channels subscribed
+func (w *Websocket) IsAuthenticated() bool {
+	w.stateMtx.RLock()
+	defer w.stateMtx.RUnlock()
+	return w.connected && w.authenticated
+}
+
+// IsConnected returns whether the connection is up
+func (w *Websocket) IsConnected() bool {
+	w.stateMtx.RLock()
+	defer w.stateMtx.RUnlock()
+	return w.connected
+}
+
+// setConnected sets whether the connection is up
+func (w *Websocket) setConnected(connected bool) {
+	w.stateMtx.Lock()
+	w.connected = connected
+	w.stateMtx.Unlock()
+}
+
 // SetExchangeName sets exchange name
 func (w *Websocket) SetExchangeName(exchName string) {
 	w.exchangeName = exchName
@@ -326,23 +397,82 @@ func (w *Websocket) GetName() string {
 	return w.exchangeName
 }
 
+// Websocket orderbook resync reasons
+const (
+	OrderbookSequenceGap      = "sequence gap"
+	OrderbookChecksumMismatch = "checksum mismatch"
+	OrderbookMissingLevel     = "missing price level"
+)
+
+// OrderbookChecksum calculates the checksum of an orderbook in the format an
+// exchange publishes, so books can be verified against the exchange checksum
+type OrderbookChecksum func(ob orderbook.Base) uint32
+
 // WebsocketOrderbookLocal defines a local cache of orderbooks for ammending,
-// appending and deleting changes and updates the main store in orderbook.go
+// appending and deleting changes and updates the main store in orderbook.go.
+// Books updated with sequence IDs are checked for gaps, books updated by level
+// ID are checked for missing levels and books are verified against exchange
+// checksums when a checksum function is set. A book found out of sync is
+// dropped from the cache, updates for it are ignored until a new snapshot is
+// loaded and a WebsocketOrderbookResync is sent to the data handler so the book
+// can be resynced from REST
 type WebsocketOrderbookLocal struct {
 	ob          []orderbook.Base
 	lastUpdated time.Time
+	sequences   map[string]int64
+	resyncing   map[string]bool
+	resyncs     map[string]int64
+	checksum    OrderbookChecksum
+	dataHandler chan interface{}
 	m           sync.Mutex
 }
 
+// orderbookKey returns the key of a book in the sequence and resync maps
+func orderbookKey(p pair.CurrencyPair, assetType string) string {
+	return assetType + " " + p.Pair().String()
+}
+
+// find returns the cached book for the pair and asset type
+func (w *WebsocketOrderbookLocal) find(p pair.CurrencyPair, assetType string) *orderbook.Base {
+	for i := range w.ob {
+		if w.ob[i].Pair == p && w.ob[i].AssetType == assetType {
+			return &w.ob[i]
+		}
+	}
+	return nil
+}
+
+// SetChecksum sets the function used to verify books against exchange
+// checksums
+func (w *WebsocketOrderbookLocal) SetChecksum(checksum OrderbookChecksum) {
+	w.m.Lock()
+	w.checksum = checksum
+	w.m.Unlock()
+}
+
 // Update updates a local cache using bid targets and ask targets then updates
 // main cache in orderbook.go
 // Volume == 0; deletion at price target
-// Price target not found; append of price target
+// Price target not found; insertion of price target in sorted order
 // Price target found; ammend volume of price target
 func (w *WebsocketOrderbookLocal) Update(bidTargets, askTargets []orderbook.Item,
 	p pair.CurrencyPair,
 	updated time.Time,
 	exchName, assetType string) error {
+	return w.UpdateWithSequence(bidTargets, askTargets, p, updated, exchName,
+		assetType, 0, 0)
+}
+
+// UpdateWithSequence updates a local cache like Update, checking the sequence
+// IDs of the update against the last update applied to the book. Updates at or
+// before the last sequence ID are dropped and a gap triggers a resync.
+// firstSequence is set by exchanges which cover a range of sequence IDs with
+// each update and is otherwise zero, a zero sequence skips the check
+func (w *WebsocketOrderbookLocal) UpdateWithSequence(bidTargets, askTargets []orderbook.Item,
+	p pair.CurrencyPair,
+	updated time.Time,
+	exchName, assetType string,
+	firstSequence, sequence int64) error {
 	if bidTargets == nil && askTargets == nil {
 		return errors.New("exchange.go websocket orderbook cache Update() error - cannot have bids and ask targets both nil")
 	}
@@ -352,16 +482,15 @@ func (w *WebsocketOrderbookLocal) Update(bidTargets, askTargets []orderbook.Item
 	}
 
 	w.m.Lock()
-	defer w.m.Unlock()
-
-	var orderbookAddress *orderbook.Base
-	for i := range w.ob {
-		if w.ob[i].Pair == p && w.ob[i].AssetType == assetType {
-			orderbookAddress = &w.ob[i]
-		}
+	key := orderbookKey(p, assetType)
+	if w.resyncing[key] {
+		w.m.Unlock()
+		return nil
 	}
 
+	orderbookAddress := w.find(p, assetType)
 	if orderbookAddress == nil {
+		w.m.Unlock()
 		return fmt.Errorf("exchange.go WebsocketOrderbookLocal Update() - orderbook.Base could not be found for Exchange %s CurrencyPair: %s AssetType: %s",
 			exchName,
 			p.Pair().String(),
@@ -369,80 +498,145 @@ func (w *WebsocketOrderbookLocal) Update(bidTargets, askTargets []orderbook.Item
 	}
 
 	if len(orderbookAddress.Asks) == 0 || len(orderbookAddress.Bids) == 0 {
+		w.m.Unlock()
 		return errors.New("exchange.go websocket orderbook cache Update() error - snapshot incorrectly loaded")
 	}
 
-	if orderbookAddress.Pair == (pair.CurrencyPair{}) {
-		return fmt.Errorf("exchange.go websocket orderbook cache Update() error - snapshot not found %v",
-			p)
-	}
+	if sequence != 0 {
+		last := w.sequences[key]
+		if last != 0 {
+			if sequence <= last {
+				// Drop update, already applied
+				w.m.Unlock()
+				return nil
+			}
 
-	for x := range bidTargets {
-		// bid targets
-		func() {
-			for y := range orderbookAddress.Bids {
-				if orderbookAddress.Bids[y].Price == bidTargets[x].Price {
-					if bidTargets[x].Amount == 0 {
-						// Delete
-						orderbookAddress.Asks = append(orderbookAddress.Bids[:y],
-							orderbookAddress.Bids[y+1:]...)
-						return
-					}
-					// Ammend
-					orderbookAddress.Bids[y].Amount = bidTargets[x].Amount
-					return
-				}
+			if firstSequence == 0 {
+				firstSequence = sequence
 			}
 
-			if bidTargets[x].Amount == 0 {
-				// Makes sure we dont append things we missed
-				return
+			if firstSequence > last+1 {
+				event := w.resync(key, p, assetType, exchName, OrderbookSequenceGap)
+				w.m.Unlock()
+				w.send(event)
+				return nil
 			}
+		}
+		w.sequences[key] = sequence
+	}
 
-			// Append
-			orderbookAddress.Bids = append(orderbookAddress.Bids, orderbook.Item{
-				Price:  bidTargets[x].Price,
-				Amount: bidTargets[x].Amount,
-			})
-		}()
-		// bid targets
+	for x := range bidTargets {
+		orderbookAddress.UpdateBid(bidTargets[x])
 	}
 
 	for x := range askTargets {
-		func() {
-			for y := range orderbookAddress.Asks {
-				if orderbookAddress.Asks[y].Price == askTargets[x].Price {
-					if askTargets[x].Amount == 0 {
-						// Delete
-						orderbookAddress.Asks = append(orderbookAddress.Asks[:y],
-							orderbookAddress.Asks[y+1:]...)
-						return
-					}
-					// Ammend
-					orderbookAddress.Asks[y].Amount = askTargets[x].Amount
-					return
-				}
-			}
+		orderbookAddress.UpdateAsk(askTargets[x])
+	}
 
-			if askTargets[x].Amount == 0 {
-				// Makes sure we dont append things we missed
-				return
-			}
+	orderbookAddress.LastUpdated = updated
+	orderbook.ProcessOrderbook(exchName, p, *orderbookAddress, assetType)
+	w.m.Unlock()
+	return nil
+}
+
+// VerifyChecksum compares the checksum of a book to the exchange checksum,
+// triggering a resync on a mismatch. It does nothing when no checksum function
+// is set or the book is being resynced
+func (w *WebsocketOrderbookLocal) VerifyChecksum(p pair.CurrencyPair, assetType, exchName string, checksum uint32) error {
+	w.m.Lock()
+	key := orderbookKey(p, assetType)
+	if w.checksum == nil || w.resyncing[key] {
+		w.m.Unlock()
+		return nil
+	}
 
-			// Append
-			orderbookAddress.Asks = append(orderbookAddress.Asks, orderbook.Item{
-				Price:  askTargets[x].Price,
-				Amount: askTargets[x].Amount,
-			})
-		}()
+	orderbookAddress := w.find(p, assetType)
+	if orderbookAddress == nil {
+		w.m.Unlock()
+		return fmt.Errorf("exchange.go WebsocketOrderbookLocal VerifyChecksum() - orderbook.Base could not be found for Exchange %s CurrencyPair: %s AssetType: %s",
+			exchName,
+			p.Pair().String(),
+			assetType)
 	}
 
-	orderbook.ProcessOrderbook(exchName, p, *orderbookAddress, assetType)
+	if w.checksum(*orderbookAddress) == checksum {
+		w.m.Unlock()
+		return nil
+	}
+
+	event := w.resync(key, p, assetType, exchName, OrderbookChecksumMismatch)
+	w.m.Unlock()
+	w.send(event)
 	return nil
 }
 
+// resync drops an out of sync book until a new snapshot is loaded and returns
+// the event to send, it must be called with the lock held
+func (w *WebsocketOrderbookLocal) resync(key string, p pair.CurrencyPair, assetType, exchName, reason string) WebsocketOrderbookResync {
+	for i := range w.ob {
+		if w.ob[i].Pair == p && w.ob[i].AssetType == assetType {
+			w.ob = append(w.ob[:i], w.ob[i+1:]...)
+			break
+		}
+	}
+
+	if w.resyncing == nil {
+		w.resyncing = make(map[string]bool)
+	}
+	if w.resyncs == nil {
+		w.resyncs = make(map[string]int64)
+	}
+
+	delete(w.sequences, key)
+	w.resyncing[key] = true
+	w.resyncs[key]++
+
+	return WebsocketOrderbookResync{
+		Pair:     p,
+		Asset:    assetType,
+		Exchange: exchName,
+		Reason:   reason,
+		Resyncs:  w.resyncs[key],
+	}
+}
+
+// send sends a resync event to the data handler when one is set
+func (w *WebsocketOrderbookLocal) send(event WebsocketOrderbookResync) {
+	if w.dataHandler != nil {
+		w.dataHandler <- event
+	}
+}
+
+// GetResyncCount returns the number of times a book has been resynced
+func (w *WebsocketOrderbookLocal) GetResyncCount(p pair.CurrencyPair, assetType string) int64 {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.resyncs[orderbookKey(p, assetType)]
+}
+
+// IsResyncing returns whether a book is waiting for a new snapshot
+func (w *WebsocketOrderbookLocal) IsResyncing(p pair.CurrencyPair, assetType string) bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.resyncing[orderbookKey(p, assetType)]
+}
+
+// GetSequence returns the sequence ID of the last update applied to a book
+func (w *WebsocketOrderbookLocal) GetSequence(p pair.CurrencyPair, assetType string) int64 {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.sequences[orderbookKey(p, assetType)]
+}
+
 // LoadSnapshot loads initial snapshot of orderbook data
 func (w *WebsocketOrderbookLocal) LoadSnapshot(newOrderbook orderbook.Base, exchName string) error {
+	return w.LoadSnapshotWithSequence(newOrderbook, exchName, 0)
+}
+
+// LoadSnapshotWithSequence loads a snapshot of orderbook data with the
+// sequence ID it was taken at, later updates are checked against it. Loading a
+// snapshot completes the resync of an out of sync book
+func (w *WebsocketOrderbookLocal) LoadSnapshotWithSequence(newOrderbook orderbook.Base, exchName string, sequence int64) error {
 	if len(newOrderbook.Asks) == 0 || len(newOrderbook.Bids) == 0 {
 		return errors.New("exchange.go websocket orderbook cache LoadSnapshot() error - snapshot ask and bids are nil")
 	}
@@ -450,12 +644,18 @@ func (w *WebsocketOrderbookLocal) LoadSnapshot(newOrderbook orderbook.Base, exch
 	w.m.Lock()
 	defer w.m.Unlock()
 
-	for i := range w.ob {
-		if w.ob[i].Pair == newOrderbook.Pair && w.ob[i].AssetType == newOrderbook.AssetType {
-			return errors.New("exchange.go websocket orderbook cache LoadSnapshot() error - Snapshot instance already found")
-		}
+	if w.find(newOrderbook.Pair, newOrderbook.AssetType) != nil {
+		return errors.New("exchange.go websocket orderbook cache LoadSnapshot() error - Snapshot instance already found")
 	}
 
+	key := orderbookKey(newOrderbook.Pair, newOrderbook.AssetType)
+	if w.sequences == nil {
+		w.sequences = make(map[string]int64)
+	}
+	w.sequences[key] = sequence
+	delete(w.resyncing, key)
+
+	newOrderbook.Sort()
 	w.ob = append(w.ob, newOrderbook)
 	w.lastUpdated = newOrderbook.LastUpdated
 
@@ -467,89 +667,105 @@ func (w *WebsocketOrderbookLocal) LoadSnapshot(newOrderbook orderbook.Base, exch
 	return nil
 }
 
-// UpdateUsingID updates orderbooks using specified ID
+// UpdateUsingID updates orderbooks using specified ID. Exchanges which key
+// levels by ID do not sequence their updates, so an update or delete for a
+// level missing from the book is treated as a gap and triggers a resync
 func (w *WebsocketOrderbookLocal) UpdateUsingID(bidTargets, askTargets []orderbook.Item,
 	p pair.CurrencyPair,
 	updated time.Time,
 	exchName, assetType, action string) error {
 	w.m.Lock()
-	defer w.m.Unlock()
-
-	var orderbookAddress *orderbook.Base
-	for i := range w.ob {
-		if w.ob[i].Pair == p && w.ob[i].AssetType == assetType {
-			orderbookAddress = &w.ob[i]
-		}
+	key := orderbookKey(p, assetType)
+	if w.resyncing[key] {
+		w.m.Unlock()
+		return nil
 	}
 
+	orderbookAddress := w.find(p, assetType)
 	if orderbookAddress == nil {
+		w.m.Unlock()
 		return fmt.Errorf("exchange.go WebsocketOrderbookLocal Update() - orderbook.Base could not be found for Exchange %s CurrencyPair: %s AssetType: %s",
 			exchName,
 			assetType,
 			p.Pair().String())
 	}
 
+	var missing bool
 	switch action {
 	case "update":
 		for _, target := range bidTargets {
-			for i := range orderbookAddress.Bids {
-				if orderbookAddress.Bids[i].ID == target.ID {
-					orderbookAddress.Bids[i].Amount = target.Amount
-					break
-				}
-			}
+			missing = !amendLevel(orderbookAddress.Bids, target) || missing
 		}
 
 		for _, target := range askTargets {
-			for i := range orderbookAddress.Asks {
-				if orderbookAddress.Asks[i].ID == target.ID {
-					orderbookAddress.Asks[i].Amount = target.Amount
-					break
-				}
-			}
+			missing = !amendLevel(orderbookAddress.Asks, target) || missing
 		}
 
 	case "delete":
+		var found bool
 		for _, target := range bidTargets {
-			for i := range orderbookAddress.Bids {
-				if orderbookAddress.Bids[i].ID == target.ID {
-					orderbookAddress.Bids = append(orderbookAddress.Bids[:i],
-						orderbookAddress.Bids[i+1:]...)
-					break
-				}
-			}
+			orderbookAddress.Bids, found = deleteLevel(orderbookAddress.Bids, target)
+			missing = !found || missing
 		}
 
 		for _, target := range askTargets {
-			for i := range orderbookAddress.Asks {
-				if orderbookAddress.Asks[i].ID == target.ID {
-					orderbookAddress.Asks = append(orderbookAddress.Asks[:i],
-						orderbookAddress.Asks[i+1:]...)
-					break
-				}
-			}
+			orderbookAddress.Asks, found = deleteLevel(orderbookAddress.Asks, target)
+			missing = !found || missing
 		}
 
 	case "insert":
 		for _, target := range bidTargets {
-			orderbookAddress.Bids = append(orderbookAddress.Bids, target)
+			orderbookAddress.UpdateBid(target)
 		}
 
 		for _, target := range askTargets {
-			orderbookAddress.Asks = append(orderbookAddress.Asks, target)
+			orderbookAddress.UpdateAsk(target)
 		}
 	}
 
-	orderbook.ProcessOrderbook(exchName, p, *orderbookAddress, assetType)
+	if missing {
+		event := w.resync(key, p, assetType, exchName, OrderbookMissingLevel)
+		w.m.Unlock()
+		w.send(event)
+		return nil
+	}
 
+	orderbookAddress.LastUpdated = updated
+	orderbook.ProcessOrderbook(exchName, p, *orderbookAddress, assetType)
+	w.m.Unlock()
 	return nil
 }
 
+// amendLevel sets the amount of the level with the ID of the target, returning
+// false when the level is not found
+func amendLevel(levels []orderbook.Item, target orderbook.Item) bool {
+	for i := range levels {
+		if levels[i].ID == target.ID {
+			levels[i].Amount = target.Amount
+			return true
+		}
+	}
+	return false
+}
+
+// deleteLevel removes the level with the ID of the target, returning false
+// when the level is not found
+func deleteLevel(levels []orderbook.Item, target orderbook.Item) ([]orderbook.Item, bool) {
+	for i := range levels {
+		if levels[i].ID == target.ID {
+			return append(levels[:i], levels[i+1:]...), true
+		}
+	}
+	return levels, false
+}
+
 // FlushCache flushes w.ob data to be garbage collected and refreshed when a
 // connection is lost and reconnected
 func (w *WebsocketOrderbookLocal) FlushCache() {
 	w.m.Lock()
 	w.ob = nil
+	w.sequences = nil
+	w.resyncing = nil
 	w.m.Unlock()
 }
 
@@ -567,6 +783,94 @@ type WebsocketOrderbookUpdate struct {
 	Exchange string
 }
 
+// WebsocketOrderbookResync defines a websocket event in which an orderbook
+// has fallen out of sync and needs a new snapshot
+type WebsocketOrderbookResync struct {
+	Pair     pair.CurrencyPair
+	Asset    string
+	Exchange string
+	Reason   string
+	Resyncs  int64
+}
+
+// WebsocketOrderbookSeeder is implemented by exchanges which seed their local
+// orderbook cache with a sequenced REST snapshot
+type WebsocketOrderbookSeeder interface {
+	SeedLocalCache(ctx context.Context, p pair.CurrencyPair) error
+}
+
+// ResyncOrderbook loads a new snapshot for an out of sync orderbook, using the
+// exchange seeder when implemented and UpdateOrderbook otherwise. It does
+// nothing when the book has already been reloaded
+func ResyncOrderbook(ctx context.Context, exch IBotExchange, r WebsocketOrderbookResync) error {
+	ws, err := exch.GetWebsocket()
+	if err != nil {
+		return err
+	}
+
+	if !ws.Orderbook.IsResyncing(r.Pair, r.Asset) {
+		return nil
+	}
+
+	if seeder, ok := exch.(WebsocketOrderbookSeeder); ok {
+		return seeder.SeedLocalCache(ctx, r.Pair)
+	}
+
+	ob, err := exch.UpdateOrderbook(ctx, r.Pair, r.Asset)
+	if err != nil {
+		return err
+	}
+
+	ob.Pair = r.Pair
+	ob.AssetType = r.Asset
+	return ws.Orderbook.LoadSnapshot(ob, exch.GetName())
+}
+
+// WebsocketOrderUpdate defines a private websocket event in which the status
+// or filled amount of an order has changed. FilledAmount is the cumulative
+// amount filled and is zero when the exchange does not report it
+type WebsocketOrderUpdate struct {
+	Exchange      string
+	AssetType     string
+	Pair          pair.CurrencyPair
+	OrderID       string
+	ClientOrderID string
+	Side          OrderSide
+	OrderType     OrderType
+	Status        orders.Status
+	Price         float64
+	Amount        float64
+	FilledAmount  float64
+	AveragePrice  float64
+	Timestamp     time.Time
+}
+
+// WebsocketOrderFill defines a private websocket event in which a trade has
+// filled part or all of an order
+type WebsocketOrderFill struct {
+	Exchange    string
+	AssetType   string
+	Pair        pair.CurrencyPair
+	OrderID     string
+	TradeID     string
+	Side        OrderSide
+	Price       float64
+	Amount      float64
+	Fee         float64
+	FeeCurrency string
+	Timestamp   time.Time
+}
+
+// WebsocketBalanceUpdate defines a private websocket event in which the
+// balance of a currency has changed, Total includes the amount on hold
+type WebsocketBalanceUpdate struct {
+	Exchange  string
+	Currency  string
+	Total     float64
+	Hold      float64
+	Timestamp time.Time
+}
+
 // TradeData defines trade data
 type TradeData struct {
 	Timestamp    time.Time
@@ -580,6 +884,11 @@ type TradeData struct {
 	Side         string
 }
 
+// BusTopic returns the data bus topic trades are published on
+func (t TradeData) BusTopic() string {
+	return databus.Trade
+}
+
 // TickerData defines ticker feed
 type TickerData struct {
 	Timestamp  time.Time
@@ -591,6 +900,8 @@ type TickerData struct {
 	OpenPrice  float64
 	HighPrice  float64
 	LowPrice   float64
+	BidPrice   float64
+	AskPrice   float64
 }
 
 // KlineData defines kline feed
@@ -609,6 +920,11 @@ type KlineData struct {
 	Volume     float64
 }
 
+// BusTopic returns the data bus topic klines are published on
+func (k KlineData) BusTopic() string {
+	return databus.Kline
+}
+
 // WebsocketPositionUpdated reflects a change in orders/contracts on an exchange
 type WebsocketPositionUpdated struct {
 	Timestamp time.Time
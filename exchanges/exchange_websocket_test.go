@@ -22,6 +22,25 @@ func TestWebsocketInit(t *testing.T) {
 	}
 }
 
+func TestWebsocketAuthentication(t *testing.T) {
+	w := Websocket{connected: true}
+	w.SetAuthenticated(true)
+	if !w.IsAuthenticated() || w.GetAuthenticationCount() != 1 {
+		t.Error("Test failed. Expected websocket to be authenticated once")
+	}
+
+	w.SetAuthenticated(false)
+	w.SetAuthenticated(true)
+	if !w.IsAuthenticated() || w.GetAuthenticationCount() != 2 {
+		t.Error("Test failed. Expected reauthentication to be counted")
+	}
+
+	w.setConnected(false)
+	if w.IsAuthenticated() {
+		t.Error("Test failed. Expected disconnected websocket not to be authenticated")
+	}
+}
+
 func TestWebsocket(t *testing.T) {
 	if err := wsTest.Websocket.SetProxyAddress("testProxy"); err != nil {
 		t.Error("test failed - SetProxyAddress", err)
@@ -309,3 +328,146 @@ func TestUpdate(t *testing.T) {
 		t.Error("test failed - OrderbookUpdate error", err)
 	}
 }
+
+func TestUpdateWithSequence(t *testing.T) {
+	var local WebsocketOrderbookLocal
+	local.dataHandler = make(chan interface{}, 1)
+	p := pair.NewCurrencyPairFromString("ETHUSD")
+
+	snapshot := orderbook.Base{
+		Pair:        p,
+		AssetType:   "SPOT",
+		Bids:        []orderbook.Item{{Price: 99, Amount: 1}},
+		Asks:        []orderbook.Item{{Price: 101, Amount: 1}},
+		LastUpdated: time.Now(),
+	}
+
+	err := local.LoadSnapshotWithSequence(snapshot, "ExchangeTest", 10)
+	if err != nil {
+		t.Fatal("test failed - LoadSnapshotWithSequence error", err)
+	}
+
+	bids := []orderbook.Item{{Price: 98, Amount: 2}}
+	err = local.UpdateWithSequence(bids, nil, p, time.Now(), "ExchangeTest", "SPOT", 0, 9)
+	if err != nil || local.GetSequence(p, "SPOT") != 10 {
+		t.Error("test failed - expected stale update to be dropped", err)
+	}
+
+	err = local.UpdateWithSequence(bids, nil, p, time.Now(), "ExchangeTest", "SPOT", 8, 11)
+	if err != nil || local.GetSequence(p, "SPOT") != 11 {
+		t.Error("test failed - expected update covering the next sequence to apply", err)
+	}
+
+	err = local.UpdateWithSequence(bids, nil, p, time.Now(), "ExchangeTest", "SPOT", 0, 13)
+	if err != nil {
+		t.Error("test failed - UpdateWithSequence error", err)
+	}
+
+	select {
+	case data := <-local.dataHandler:
+		resync, ok := data.(WebsocketOrderbookResync)
+		if !ok || resync.Reason != OrderbookSequenceGap || resync.Resyncs != 1 {
+			t.Errorf("test failed - unexpected resync event %+v", data)
+		}
+	default:
+		t.Fatal("test failed - expected resync event for sequence gap")
+	}
+
+	if !local.IsResyncing(p, "SPOT") || len(local.ob) != 0 {
+		t.Error("test failed - expected out of sync book to be dropped")
+	}
+
+	err = local.UpdateWithSequence(bids, nil, p, time.Now(), "ExchangeTest", "SPOT", 0, 14)
+	if err != nil || len(local.dataHandler) != 0 {
+		t.Error("test failed - expected updates to be ignored while resyncing", err)
+	}
+
+	err = local.LoadSnapshotWithSequence(snapshot, "ExchangeTest", 20)
+	if err != nil || local.IsResyncing(p, "SPOT") || local.GetSequence(p, "SPOT") != 20 {
+		t.Error("test failed - expected snapshot to complete resync", err)
+	}
+
+	if local.GetResyncCount(p, "SPOT") != 1 {
+		t.Errorf("test failed - expected resync count 1, received %d",
+			local.GetResyncCount(p, "SPOT"))
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	var local WebsocketOrderbookLocal
+	local.dataHandler = make(chan interface{}, 1)
+	p := pair.NewCurrencyPairFromString("ETHUSD")
+
+	err := local.LoadSnapshot(orderbook.Base{
+		Pair:      p,
+		AssetType: "SPOT",
+		Bids:      []orderbook.Item{{Price: 99, Amount: 1}},
+		Asks:      []orderbook.Item{{Price: 101, Amount: 1}},
+	}, "ExchangeTest")
+	if err != nil {
+		t.Fatal("test failed - LoadSnapshot error", err)
+	}
+
+	err = local.VerifyChecksum(p, "SPOT", "ExchangeTest", 1337)
+	if err != nil || len(local.dataHandler) != 0 {
+		t.Error("test failed - expected no verification without a checksum function", err)
+	}
+
+	local.SetChecksum(func(ob orderbook.Base) uint32 {
+		return uint32(ob.Bids[0].Price + ob.Asks[0].Price)
+	})
+
+	err = local.VerifyChecksum(p, "SPOT", "ExchangeTest", 200)
+	if err != nil || len(local.dataHandler) != 0 {
+		t.Error("test failed - expected matching checksum to pass", err)
+	}
+
+	err = local.VerifyChecksum(p, "SPOT", "ExchangeTest", 1337)
+	if err != nil {
+		t.Error("test failed - VerifyChecksum error", err)
+	}
+
+	resync, ok := (<-local.dataHandler).(WebsocketOrderbookResync)
+	if !ok || resync.Reason != OrderbookChecksumMismatch || !local.IsResyncing(p, "SPOT") {
+		t.Errorf("test failed - expected checksum mismatch resync, received %+v", resync)
+	}
+}
+
+func TestUpdateUsingID(t *testing.T) {
+	var local WebsocketOrderbookLocal
+	local.dataHandler = make(chan interface{}, 1)
+	p := pair.NewCurrencyPairFromString("XBTUSD")
+
+	err := local.LoadSnapshot(orderbook.Base{
+		Pair:      p,
+		AssetType: "CONTRACT",
+		Bids:      []orderbook.Item{{Price: 99, Amount: 1, ID: 1}},
+		Asks:      []orderbook.Item{{Price: 101, Amount: 1, ID: 2}, {Price: 102, Amount: 1, ID: 3}},
+	}, "ExchangeTest")
+	if err != nil {
+		t.Fatal("test failed - LoadSnapshot error", err)
+	}
+
+	err = local.UpdateUsingID(nil, []orderbook.Item{{Amount: 5, ID: 2}}, p,
+		time.Now(), "ExchangeTest", "CONTRACT", "update")
+	if err != nil || local.ob[0].Asks[0].Amount != 5 {
+		t.Error("test failed - expected level to be updated by ID", err)
+	}
+
+	err = local.UpdateUsingID(nil, []orderbook.Item{{ID: 3}}, p,
+		time.Now(), "ExchangeTest", "CONTRACT", "delete")
+	if err != nil || len(local.ob[0].Asks) != 1 || len(local.dataHandler) != 0 {
+		t.Error("test failed - expected level to be deleted by ID", err)
+	}
+
+	err = local.UpdateUsingID([]orderbook.Item{{Amount: 2, ID: 4}}, nil, p,
+		time.Now(), "ExchangeTest", "CONTRACT", "update")
+	if err != nil {
+		t.Error("test failed - UpdateUsingID error", err)
+	}
+
+	resync, ok := (<-local.dataHandler).(WebsocketOrderbookResync)
+	if !ok || resync.Reason != OrderbookMissingLevel || !local.IsResyncing(p, "CONTRACT") {
+		t.Errorf("test failed - expected missing level resync, received %+v", resync)
+	}
+}
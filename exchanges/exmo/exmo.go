@@ -1,6 +1,7 @@
 package exmo
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -114,62 +115,62 @@ func (e *EXMO) Setup(exch config.ExchangeConfig) {
 }
 
 // GetTrades returns the trades for a symbol or symbols
-func (e *EXMO) GetTrades(symbol string) (map[string][]Trades, error) {
+func (e *EXMO) GetTrades(ctx context.Context, symbol string) (map[string][]Trades, error) {
 	v := url.Values{}
 	v.Set("pair", symbol)
 	result := make(map[string][]Trades)
 	url := fmt.Sprintf("%s/v%s/%s", e.APIUrl, exmoAPIVersion, exmoTrades)
 
-	return result, e.SendHTTPRequest(common.EncodeURLValues(url, v), &result)
+	return result, e.SendHTTPRequest(ctx, common.EncodeURLValues(url, v), &result)
 }
 
 // GetOrderbook returns the orderbook for a symbol or symbols
-func (e *EXMO) GetOrderbook(symbol string) (map[string]Orderbook, error) {
+func (e *EXMO) GetOrderbook(ctx context.Context, symbol string) (map[string]Orderbook, error) {
 	v := url.Values{}
 	v.Set("pair", symbol)
 	result := make(map[string]Orderbook)
 	url := fmt.Sprintf("%s/v%s/%s", e.APIUrl, exmoAPIVersion, exmoOrderbook)
 
-	return result, e.SendHTTPRequest(common.EncodeURLValues(url, v), &result)
+	return result, e.SendHTTPRequest(ctx, common.EncodeURLValues(url, v), &result)
 }
 
 // GetTicker returns the ticker for a symbol or symbols
-func (e *EXMO) GetTicker(symbol string) (map[string]Ticker, error) {
+func (e *EXMO) GetTicker(ctx context.Context, symbol string) (map[string]Ticker, error) {
 	v := url.Values{}
 	v.Set("pair", symbol)
 	result := make(map[string]Ticker)
 	url := fmt.Sprintf("%s/v%s/%s", e.APIUrl, exmoAPIVersion, exmoTicker)
 
-	return result, e.SendHTTPRequest(common.EncodeURLValues(url, v), &result)
+	return result, e.SendHTTPRequest(ctx, common.EncodeURLValues(url, v), &result)
 }
 
 // GetPairSettings returns the pair settings for a symbol or symbols
-func (e *EXMO) GetPairSettings() (map[string]PairSettings, error) {
+func (e *EXMO) GetPairSettings(ctx context.Context) (map[string]PairSettings, error) {
 	result := make(map[string]PairSettings)
 	url := fmt.Sprintf("%s/v%s/%s", e.APIUrl, exmoAPIVersion, exmoPairSettings)
 
-	return result, e.SendHTTPRequest(url, &result)
+	return result, e.SendHTTPRequest(ctx, url, &result)
 }
 
 // GetCurrency returns a list of currencies
-func (e *EXMO) GetCurrency() ([]string, error) {
+func (e *EXMO) GetCurrency(ctx context.Context) ([]string, error) {
 	result := []string{}
 	url := fmt.Sprintf("%s/v%s/%s", e.APIUrl, exmoAPIVersion, exmoCurrency)
 
-	return result, e.SendHTTPRequest(url, &result)
+	return result, e.SendHTTPRequest(ctx, url, &result)
 }
 
 // GetUserInfo returns the user info
-func (e *EXMO) GetUserInfo() (UserInfo, error) {
+func (e *EXMO) GetUserInfo(ctx context.Context) (UserInfo, error) {
 	var result UserInfo
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoUserInfo, url.Values{}, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoUserInfo, url.Values{}, &result)
 	return result, err
 }
 
 // CreateOrder creates an order
 // Params: pair, quantity, price and type
 // Type can be buy, sell, market_buy, market_sell, market_buy_total and market_sell_total
-func (e *EXMO) CreateOrder(pair, orderType string, price, amount float64) (int64, error) {
+func (e *EXMO) CreateOrder(ctx context.Context, pair, orderType string, price, amount float64) (int64, error) {
 	type response struct {
 		OrderID int64 `json:"order_id"`
 	}
@@ -181,27 +182,27 @@ func (e *EXMO) CreateOrder(pair, orderType string, price, amount float64) (int64
 	v.Set("quantity", strconv.FormatFloat(amount, 'f', -1, 64))
 
 	var result response
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoOrderCreate, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoOrderCreate, v, &result)
 	return result.OrderID, err
 }
 
 // CancelExistingOrder cancels an order by the orderID
-func (e *EXMO) CancelExistingOrder(orderID int64) error {
+func (e *EXMO) CancelExistingOrder(ctx context.Context, orderID int64) error {
 	v := url.Values{}
 	v.Set("order_id", strconv.FormatInt(orderID, 10))
 	var result interface{}
-	return e.SendAuthenticatedHTTPRequest("POST", exmoOrderCancel, v, &result)
+	return e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoOrderCancel, v, &result)
 }
 
 // GetOpenOrders returns the users open orders
-func (e *EXMO) GetOpenOrders() (map[string]OpenOrders, error) {
-	result := make(map[string]OpenOrders)
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoOpenOrders, url.Values{}, &result)
+func (e *EXMO) GetOpenOrders(ctx context.Context) (map[string][]OpenOrders, error) {
+	result := make(map[string][]OpenOrders)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoOpenOrders, url.Values{}, &result)
 	return result, err
 }
 
 // GetUserTrades returns the user trades
-func (e *EXMO) GetUserTrades(pair, offset, limit string) (map[string][]UserTrades, error) {
+func (e *EXMO) GetUserTrades(ctx context.Context, pair, offset, limit string) (map[string][]UserTrades, error) {
 	result := make(map[string][]UserTrades)
 	v := url.Values{}
 	v.Set("pair", pair)
@@ -214,12 +215,12 @@ func (e *EXMO) GetUserTrades(pair, offset, limit string) (map[string][]UserTrade
 		v.Set("limit", limit)
 	}
 
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoUserTrades, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoUserTrades, v, &result)
 	return result, err
 }
 
 // GetCancelledOrders returns a list of cancelled orders
-func (e *EXMO) GetCancelledOrders(offset, limit string) ([]CancelledOrder, error) {
+func (e *EXMO) GetCancelledOrders(ctx context.Context, offset, limit string) ([]CancelledOrder, error) {
 	var result []CancelledOrder
 	v := url.Values{}
 
@@ -231,42 +232,42 @@ func (e *EXMO) GetCancelledOrders(offset, limit string) ([]CancelledOrder, error
 		v.Set("limit", limit)
 	}
 
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoCancelledOrders, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoCancelledOrders, v, &result)
 	return result, err
 }
 
 // GetOrderTrades returns a history of order trade details for the specific orderID
-func (e *EXMO) GetOrderTrades(orderID int64) (OrderTrades, error) {
+func (e *EXMO) GetOrderTrades(ctx context.Context, orderID int64) (OrderTrades, error) {
 	var result OrderTrades
 	v := url.Values{}
 	v.Set("order_id", strconv.FormatInt(orderID, 10))
 
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoOrderTrades, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoOrderTrades, v, &result)
 	return result, err
 }
 
 // GetRequiredAmount calculates the sum of buying a certain amount of currency
 // for the particular currency pair
-func (e *EXMO) GetRequiredAmount(pair string, amount float64) (RequiredAmount, error) {
+func (e *EXMO) GetRequiredAmount(ctx context.Context, pair string, amount float64) (RequiredAmount, error) {
 	v := url.Values{}
 	v.Set("pair", pair)
 	v.Set("quantity", strconv.FormatFloat(amount, 'f', -1, 64))
 	var result RequiredAmount
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoRequiredAmount, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoRequiredAmount, v, &result)
 	return result, err
 }
 
 // GetCryptoDepositAddress returns a list of addresses for cryptocurrency deposits
-func (e *EXMO) GetCryptoDepositAddress() (map[string]string, error) {
+func (e *EXMO) GetCryptoDepositAddress(ctx context.Context) (map[string]string, error) {
 	result := make(map[string]string)
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoDepositAddress, url.Values{}, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoDepositAddress, url.Values{}, &result)
 	log.Println(reflect.TypeOf(result).String())
 	return result, err
 }
 
 // WithdrawCryptocurrency withdraws a cryptocurrency from the exchange to the desired address
 // NOTE: This API function is available only after request to their tech support team
-func (e *EXMO) WithdrawCryptocurrency(currency, address, invoice string, amount float64) (int64, error) {
+func (e *EXMO) WithdrawCryptocurrency(ctx context.Context, currency, address, invoice string, amount float64) (int64, error) {
 	type response struct {
 		TaskID int64 `json:"task_id,string"`
 	}
@@ -281,12 +282,12 @@ func (e *EXMO) WithdrawCryptocurrency(currency, address, invoice string, amount
 
 	v.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	var result response
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoWithdrawCrypt, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoWithdrawCrypt, v, &result)
 	return result.TaskID, err
 }
 
 // GetWithdrawTXID gets the result of a withdrawal request
-func (e *EXMO) GetWithdrawTXID(taskID int64) (string, error) {
+func (e *EXMO) GetWithdrawTXID(ctx context.Context, taskID int64) (string, error) {
 	type response struct {
 		Status bool   `json:"status"`
 		TXID   string `json:"txid"`
@@ -296,48 +297,48 @@ func (e *EXMO) GetWithdrawTXID(taskID int64) (string, error) {
 	v.Set("task_id", strconv.FormatInt(taskID, 10))
 
 	var result response
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoGetWithdrawTXID, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoGetWithdrawTXID, v, &result)
 	return result.TXID, err
 }
 
 // ExcodeCreate creates an EXMO coupon
-func (e *EXMO) ExcodeCreate(currency string, amount float64) (ExcodeCreate, error) {
+func (e *EXMO) ExcodeCreate(ctx context.Context, currency string, amount float64) (ExcodeCreate, error) {
 	v := url.Values{}
 	v.Set("currency", currency)
 	v.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 
 	var result ExcodeCreate
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoExcodeCreate, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoExcodeCreate, v, &result)
 	return result, err
 }
 
 // ExcodeLoad loads an EXMO coupon
-func (e *EXMO) ExcodeLoad(excode string) (ExcodeLoad, error) {
+func (e *EXMO) ExcodeLoad(ctx context.Context, excode string) (ExcodeLoad, error) {
 	v := url.Values{}
 	v.Set("code", excode)
 
 	var result ExcodeLoad
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoExcodeLoad, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoExcodeLoad, v, &result)
 	return result, err
 }
 
 // GetWalletHistory returns the users deposit/withdrawal history
-func (e *EXMO) GetWalletHistory(date int64) (WalletHistory, error) {
+func (e *EXMO) GetWalletHistory(ctx context.Context, date int64) (WalletHistory, error) {
 	v := url.Values{}
 	v.Set("date", strconv.FormatInt(date, 10))
 
 	var result WalletHistory
-	err := e.SendAuthenticatedHTTPRequest("POST", exmoWalletHistory, v, &result)
+	err := e.SendAuthenticatedHTTPRequest(ctx, "POST", exmoWalletHistory, v, &result)
 	return result, err
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (e *EXMO) SendHTTPRequest(path string, result interface{}) error {
-	return e.SendPayload("GET", path, nil, nil, result, false, e.Verbose)
+func (e *EXMO) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return e.SendPayload(ctx, "GET", path, nil, nil, result, false, e.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request
-func (e *EXMO) SendAuthenticatedHTTPRequest(method, endpoint string, vals url.Values, result interface{}) error {
+func (e *EXMO) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, vals url.Values, result interface{}) error {
 	if !e.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, e.Name)
 	}
@@ -363,7 +364,7 @@ func (e *EXMO) SendAuthenticatedHTTPRequest(method, endpoint string, vals url.Va
 
 	path := fmt.Sprintf("%s/v%s/%s", e.APIUrl, exmoAPIVersion, endpoint)
 
-	return e.SendPayload(method, path, headers, strings.NewReader(payload), result, true, e.Verbose)
+	return e.SendPayload(ctx, method, path, headers, strings.NewReader(payload), result, true, e.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
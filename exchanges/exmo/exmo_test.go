@@ -1,11 +1,14 @@
 package exmo
 
 import (
+	"context"
 	"testing"
 
+	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 const (
@@ -18,6 +21,12 @@ var (
 	e EXMO
 )
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestDefault(t *testing.T) {
 	e.SetDefaults()
 }
@@ -30,7 +39,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetTrades("BTC_USD")
+	_, err := e.GetTrades(context.Background(), "BTC_USD")
 	if err != nil {
 		t.Errorf("Test failed. Err: %s", err)
 	}
@@ -38,7 +47,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetOrderbook("BTC_USD")
+	_, err := e.GetOrderbook(context.Background(), "BTC_USD")
 	if err != nil {
 		t.Errorf("Test failed. Err: %s", err)
 	}
@@ -46,7 +55,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetTicker("BTC_USD")
+	_, err := e.GetTicker(context.Background(), "BTC_USD")
 	if err != nil {
 		t.Errorf("Test failed. Err: %s", err)
 	}
@@ -54,7 +63,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetPairSettings(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetPairSettings()
+	_, err := e.GetPairSettings(context.Background())
 	if err != nil {
 		t.Errorf("Test failed. Err: %s", err)
 	}
@@ -62,7 +71,7 @@ func TestGetPairSettings(t *testing.T) {
 
 func TestGetCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetCurrency()
+	_, err := e.GetCurrency(context.Background())
 	if err != nil {
 		t.Errorf("Test failed. Err: %s", err)
 	}
@@ -74,7 +83,7 @@ func TestGetUserInfo(t *testing.T) {
 		t.Skip()
 	}
 	TestSetup(t)
-	_, err := e.GetUserInfo()
+	_, err := e.GetUserInfo(context.Background())
 	if err != nil {
 		t.Errorf("Test failed. Err: %s", err)
 	}
@@ -86,7 +95,7 @@ func TestGetRequiredAmount(t *testing.T) {
 		t.Skip()
 	}
 	TestSetup(t)
-	_, err := e.GetRequiredAmount("BTC_USD", 100)
+	_, err := e.GetRequiredAmount(context.Background(), "BTC_USD", 100)
 	if err != nil {
 		t.Errorf("Test failed. Err: %s", err)
 	}
@@ -98,7 +107,7 @@ func TestGetCryptoDepositAddress(t *testing.T) {
 		t.Skip()
 	}
 	TestSetup(t)
-	_, err := e.GetCryptoDepositAddress()
+	_, err := e.GetCryptoDepositAddress(context.Background())
 	if err == nil {
 		t.Errorf("Test failed. Err: %s", err)
 	}
@@ -248,6 +257,57 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns an EXMO using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *EXMO {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	exmoConfig, err := cfg.GetExchangeConfig("EXMO")
+	if err != nil {
+		t.Fatal("Test Failed - EXMO Setup() init error")
+	}
+
+	exmoConfig.AuthenticatedAPISupport = true
+	exmoConfig.APIKey, exmoConfig.APISecret = request.FixtureCredentials(t, APIKey, APISecret)
+
+	var r EXMO
+	r.SetDefaults()
+	r.Setup(exmoConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - EXMO GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "14" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].QuoteCurrency != symbol.USD ||
+		orders[0].OrderSide != "buy" || orders[0].Price != 6300 ||
+		orders[0].OpenVolume != 0.5 || orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - EXMO GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.BTC, symbol.USD)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - EXMO GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 2 || orders[0].ID != "12345" ||
+		orders[0].Status != "FILLED" || orders[0].ExecutedAmount != 0.25 ||
+		orders[1].ID != "15" || orders[1].Status != "CANCELLED") {
+		t.Errorf("Test Failed - EXMO GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -273,7 +333,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USD,
 	}
-	response, err := e.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "1234234")
+	response, err := e.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "1234234"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -299,7 +359,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := e.CancelOrder(orderCancellation)
+	err := e.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
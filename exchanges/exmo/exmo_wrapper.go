@@ -1,11 +1,13 @@
 package exmo
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -30,7 +32,7 @@ func (e *EXMO) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", e.GetName(), len(e.EnabledPairs), e.EnabledPairs)
 	}
 
-	exchangeProducts, err := e.GetPairSettings()
+	exchangeProducts, err := e.GetPairSettings(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available products.\n", e.GetName())
 	} else {
@@ -46,14 +48,14 @@ func (e *EXMO) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (e *EXMO) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (e *EXMO) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	pairsCollated, err := exchange.GetAndFormatExchangeCurrencies(e.Name, e.GetEnabledCurrencies())
 	if err != nil {
 		return tickerPrice, err
 	}
 
-	result, err := e.GetTicker(pairsCollated.String())
+	result, err := e.GetTicker(ctx, pairsCollated.String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -75,32 +77,32 @@ func (e *EXMO) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (e *EXMO) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (e *EXMO) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(e.GetName(), p, assetType)
 	if err != nil {
-		return e.UpdateTicker(p, assetType)
+		return e.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (e *EXMO) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (e *EXMO) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(e.GetName(), p, assetType)
 	if err != nil {
-		return e.UpdateOrderbook(p, assetType)
+		return e.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (e *EXMO) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (e *EXMO) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	pairsCollated, err := exchange.GetAndFormatExchangeCurrencies(e.Name, e.GetEnabledCurrencies())
 	if err != nil {
 		return orderBook, err
 	}
 
-	result, err := e.GetOrderbook(pairsCollated.String())
+	result, err := e.GetOrderbook(ctx, pairsCollated.String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -138,10 +140,10 @@ func (e *EXMO) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // Exmo exchange
-func (e *EXMO) GetAccountInfo() (exchange.AccountInfo, error) {
+func (e *EXMO) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = e.GetName()
-	result, err := e.GetUserInfo()
+	result, err := e.GetUserInfo(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -164,26 +166,30 @@ func (e *EXMO) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (e *EXMO) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (e *EXMO) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (e *EXMO) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (e *EXMO) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (e *EXMO) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (e *EXMO) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := e.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
 	var oT string
-	if orderType == exchange.Limit {
+	if order.OrderType == exchange.Limit {
 		return submitOrderResponse, errors.New("Unsupported order type")
-	} else if orderType == exchange.Market {
-		if side == exchange.Buy {
+	} else if order.OrderType == exchange.Market {
+		if order.Side == exchange.Buy {
 			oT = "market_buy"
 		} else {
 			oT = "market_sell"
@@ -192,7 +198,7 @@ func (e *EXMO) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderTy
 		return submitOrderResponse, errors.New("Unsupported order type")
 	}
 
-	response, err := e.CreateOrder(p.Pair().String(), oT, price, amount)
+	response, err := e.CreateOrder(ctx, order.Pair.Pair().String(), oT, order.Price, order.Amount)
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -207,46 +213,73 @@ func (e *EXMO) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderTy
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (e *EXMO) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (e *EXMO) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (e *EXMO) CancelOrder(order exchange.OrderCancellation) error {
+func (e *EXMO) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	return e.CancelExistingOrder(orderIDInt)
+	return e.CancelExistingOrder(ctx, orderIDInt)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (e *EXMO) CancelAllOrders() error {
+func (e *EXMO) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (e *EXMO) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (e *EXMO) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (e *EXMO) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (e *EXMO) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (e *EXMO) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (e *EXMO) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (e *EXMO) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (e *EXMO) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (e *EXMO) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (e *EXMO) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (e *EXMO) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (e *EXMO) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (e *EXMO) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -270,3 +303,93 @@ func (e *EXMO) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (e *EXMO) GetWithdrawCapabilities() uint32 {
 	return e.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (e *EXMO) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := e.GetOpenOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for _, pairOrders := range resp {
+		for i := range pairOrders {
+			orders = append(orders, e.formatOrderDetail(pairOrders[i].OrderID,
+				pairOrders[i].Created, pairOrders[i].Type, pairOrders[i].Pair,
+				"OPEN", pairOrders[i].Price, pairOrders[i].Quantity, 0))
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (e *EXMO) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	if len(getOrdersRequest.Currencies) == 0 {
+		return nil, errors.New("at least one currency pair is required")
+	}
+
+	var symbols []string
+	for _, c := range getOrdersRequest.Currencies {
+		symbols = append(symbols, exchange.FormatExchangeCurrency(e.Name, c).String())
+	}
+
+	// EXMO only returns past trades and cancelled orders, each fill is
+	// reported as a filled order
+	trades, err := e.GetUserTrades(ctx, common.JoinStrings(symbols, ","), "", "1000")
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for _, pairTrades := range trades {
+		for i := range pairTrades {
+			orders = append(orders, e.formatOrderDetail(pairTrades[i].OrderID,
+				pairTrades[i].Date, pairTrades[i].Type, pairTrades[i].Pair,
+				"FILLED", pairTrades[i].Price, pairTrades[i].Quantity,
+				pairTrades[i].Quantity))
+		}
+	}
+
+	cancelled, err := e.GetCancelledOrders(ctx, "", "1000")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range cancelled {
+		orders = append(orders, e.formatOrderDetail(cancelled[i].OrderID,
+			cancelled[i].Date, cancelled[i].Type, cancelled[i].Pair,
+			"CANCELLED", cancelled[i].Price, cancelled[i].Quantity, 0))
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// formatOrderDetail converts an EXMO order or trade into an
+// exchange.OrderDetail
+func (e *EXMO) formatOrderDetail(orderID, created int64, side, symbol, status string, price, quantity, executed float64) exchange.OrderDetail {
+	p := pair.NewCurrencyPairDelimiter(symbol, "_")
+	orderDate := time.Unix(created, 0)
+	return exchange.OrderDetail{
+		Exchange:       e.Name,
+		ID:             strconv.FormatInt(orderID, 10),
+		BaseCurrency:   p.FirstCurrency.String(),
+		QuoteCurrency:  p.SecondCurrency.String(),
+		OrderSide:      side,
+		OrderType:      exchange.Limit.ToString(),
+		CreationTime:   orderDate.Unix(),
+		OrderDate:      orderDate,
+		Status:         status,
+		Price:          price,
+		Amount:         quantity,
+		OpenVolume:     quantity - executed,
+		ExecutedAmount: executed,
+	}
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (e *EXMO) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
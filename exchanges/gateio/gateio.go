@@ -1,6 +1,7 @@
 package gateio
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -105,12 +106,12 @@ func (g *Gateio) Setup(exch config.ExchangeConfig) {
 }
 
 // GetSymbols returns all supported symbols
-func (g *Gateio) GetSymbols() ([]string, error) {
+func (g *Gateio) GetSymbols(ctx context.Context) ([]string, error) {
 	var result []string
 
 	url := fmt.Sprintf("%s/%s/%s", g.APIUrlSecondary, gateioAPIVersion, gateioSymbol)
 
-	err := g.SendHTTPRequest(url, &result)
+	err := g.SendHTTPRequest(ctx, url, &result)
 	if err != nil {
 		return nil, nil
 	}
@@ -119,7 +120,7 @@ func (g *Gateio) GetSymbols() ([]string, error) {
 
 // GetMarketInfo returns information about all trading pairs, including
 // transaction fee, minimum order quantity, price accuracy and so on
-func (g *Gateio) GetMarketInfo() (MarketInfoResponse, error) {
+func (g *Gateio) GetMarketInfo(ctx context.Context) (MarketInfoResponse, error) {
 	type response struct {
 		Result string        `json:"result"`
 		Pairs  []interface{} `json:"pairs"`
@@ -129,7 +130,7 @@ func (g *Gateio) GetMarketInfo() (MarketInfoResponse, error) {
 
 	var res response
 	var result MarketInfoResponse
-	err := g.SendHTTPRequest(url, &res)
+	err := g.SendHTTPRequest(ctx, url, &res)
 	if err != nil {
 		return result, err
 	}
@@ -154,8 +155,8 @@ func (g *Gateio) GetMarketInfo() (MarketInfoResponse, error) {
 // updated every 10 seconds
 //
 // symbol: string of currency pair
-func (g *Gateio) GetLatestSpotPrice(symbol string) (float64, error) {
-	res, err := g.GetTicker(symbol)
+func (g *Gateio) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	res, err := g.GetTicker(ctx, symbol)
 	if err != nil {
 		return 0, err
 	}
@@ -165,11 +166,11 @@ func (g *Gateio) GetLatestSpotPrice(symbol string) (float64, error) {
 
 // GetTicker returns a ticker for the supplied symbol
 // updated every 10 seconds
-func (g *Gateio) GetTicker(symbol string) (TickerResponse, error) {
+func (g *Gateio) GetTicker(ctx context.Context, symbol string) (TickerResponse, error) {
 	url := fmt.Sprintf("%s/%s/%s/%s", g.APIUrlSecondary, gateioAPIVersion, gateioTicker, symbol)
 
 	var res TickerResponse
-	err := g.SendHTTPRequest(url, &res)
+	err := g.SendHTTPRequest(ctx, url, &res)
 	if err != nil {
 		return res, err
 	}
@@ -177,11 +178,11 @@ func (g *Gateio) GetTicker(symbol string) (TickerResponse, error) {
 }
 
 // GetTickers returns tickers for all symbols
-func (g *Gateio) GetTickers() (map[string]TickerResponse, error) {
+func (g *Gateio) GetTickers(ctx context.Context) (map[string]TickerResponse, error) {
 	url := fmt.Sprintf("%s/%s/%s", g.APIUrlSecondary, gateioAPIVersion, gateioTickers)
 
 	resp := make(map[string]TickerResponse)
-	err := g.SendHTTPRequest(url, &resp)
+	err := g.SendHTTPRequest(ctx, url, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -189,11 +190,11 @@ func (g *Gateio) GetTickers() (map[string]TickerResponse, error) {
 }
 
 // GetOrderbook returns the orderbook data for a suppled symbol
-func (g *Gateio) GetOrderbook(symbol string) (Orderbook, error) {
+func (g *Gateio) GetOrderbook(ctx context.Context, symbol string) (Orderbook, error) {
 	url := fmt.Sprintf("%s/%s/%s/%s", g.APIUrlSecondary, gateioAPIVersion, gateioOrderbook, symbol)
 
 	var resp OrderbookResponse
-	err := g.SendHTTPRequest(url, &resp)
+	err := g.SendHTTPRequest(ctx, url, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -251,7 +252,7 @@ func (g *Gateio) GetOrderbook(symbol string) (Orderbook, error) {
 }
 
 // GetSpotKline returns kline data for the most recent time period
-func (g *Gateio) GetSpotKline(arg KlinesRequestParams) ([]*KLineResponse, error) {
+func (g *Gateio) GetSpotKline(ctx context.Context, arg KlinesRequestParams) ([]*KLineResponse, error) {
 	url := fmt.Sprintf("%s/%s/%s/%s?group_sec=%d&range_hour=%d",
 		g.APIUrlSecondary,
 		gateioAPIVersion,
@@ -261,7 +262,7 @@ func (g *Gateio) GetSpotKline(arg KlinesRequestParams) ([]*KLineResponse, error)
 		arg.HourSize)
 
 	var rawKlines map[string]interface{}
-	err := g.SendHTTPRequest(url, &rawKlines)
+	err := g.SendHTTPRequest(ctx, url, &rawKlines)
 	if err != nil {
 		return nil, err
 	}
@@ -321,11 +322,11 @@ func (g *Gateio) GetSpotKline(arg KlinesRequestParams) ([]*KLineResponse, error)
 }
 
 // GetBalances obtains the users account balance
-func (g *Gateio) GetBalances() (BalancesResponse, error) {
+func (g *Gateio) GetBalances(ctx context.Context) (BalancesResponse, error) {
 
 	var result BalancesResponse
 
-	err := g.SendAuthenticatedHTTPRequest("POST", gateioBalances, "", &result)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", gateioBalances, "", &result)
 	if err != nil {
 		return result, err
 	}
@@ -334,7 +335,7 @@ func (g *Gateio) GetBalances() (BalancesResponse, error) {
 }
 
 // SpotNewOrder places a new order
-func (g *Gateio) SpotNewOrder(arg SpotNewOrderRequestParams) (SpotNewOrderResponse, error) {
+func (g *Gateio) SpotNewOrder(ctx context.Context, arg SpotNewOrderRequestParams) (SpotNewOrderResponse, error) {
 	var result SpotNewOrderResponse
 
 	// Be sure to use the correct price precision before calling this
@@ -346,7 +347,7 @@ func (g *Gateio) SpotNewOrder(arg SpotNewOrderRequestParams) (SpotNewOrderRespon
 
 	strRequestURL := fmt.Sprintf("%s/%s", gateioOrder, arg.Type)
 
-	err := g.SendAuthenticatedHTTPRequest("POST", strRequestURL, params, &result)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", strRequestURL, params, &result)
 	if err != nil {
 		return result, err
 	}
@@ -357,7 +358,7 @@ func (g *Gateio) SpotNewOrder(arg SpotNewOrderRequestParams) (SpotNewOrderRespon
 // CancelExistingOrder cancels an order given the supplied orderID and symbol
 // orderID order ID number
 // symbol trade pair (ltc_btc)
-func (g *Gateio) CancelExistingOrder(orderID int64, symbol string) (bool, error) {
+func (g *Gateio) CancelExistingOrder(ctx context.Context, orderID int64, symbol string) (bool, error) {
 	type response struct {
 		Result  bool   `json:"result"`
 		Code    int    `json:"code"`
@@ -370,7 +371,7 @@ func (g *Gateio) CancelExistingOrder(orderID int64, symbol string) (bool, error)
 		orderID,
 		symbol,
 	)
-	err := g.SendAuthenticatedHTTPRequest("POST", gateioCancelOrder, params, &result)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", gateioCancelOrder, params, &result)
 	if err != nil {
 		return false, err
 	}
@@ -382,13 +383,13 @@ func (g *Gateio) CancelExistingOrder(orderID int64, symbol string) (bool, error)
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (g *Gateio) SendHTTPRequest(path string, result interface{}) error {
-	return g.SendPayload("GET", path, nil, nil, result, false, g.Verbose)
+func (g *Gateio) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return g.SendPayload(ctx, "GET", path, nil, nil, result, false, g.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends authenticated requests to the Gateio API
 // To use this you must setup an APIKey and APISecret from the exchange
-func (g *Gateio) SendAuthenticatedHTTPRequest(method, endpoint, param string, result interface{}) error {
+func (g *Gateio) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint, param string, result interface{}) error {
 	if !g.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, g.Name)
 	}
@@ -402,14 +403,14 @@ func (g *Gateio) SendAuthenticatedHTTPRequest(method, endpoint, param string, re
 
 	url := fmt.Sprintf("%s/%s/%s", g.APIUrl, gateioAPIVersion, endpoint)
 
-	return g.SendPayload(method, url, headers, strings.NewReader(param), result, true, g.Verbose)
+	return g.SendPayload(ctx, method, url, headers, strings.NewReader(param), result, true, g.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (g *Gateio) GetFee(feeBuilder exchange.FeeBuilder) (fee float64, err error) {
+func (g *Gateio) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (fee float64, err error) {
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		feePairs, err := g.GetMarketInfo()
+		feePairs, err := g.GetMarketInfo(ctx)
 		if err != nil {
 			return 0, err
 		}
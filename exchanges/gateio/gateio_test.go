@@ -1,12 +1,14 @@
 package gateio
 
 import (
+	"context"
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply your own APIKEYS here for due diligence testing
@@ -19,6 +21,12 @@ const (
 
 var g Gateio
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	g.SetDefaults()
 }
@@ -40,7 +48,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetSymbols(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetSymbols()
+	_, err := g.GetSymbols(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Gateio TestGetSymbols: %s", err)
 	}
@@ -48,7 +56,7 @@ func TestGetSymbols(t *testing.T) {
 
 func TestGetMarketInfo(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetMarketInfo()
+	_, err := g.GetMarketInfo(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Gateio GetMarketInfo: %s", err)
 	}
@@ -61,7 +69,7 @@ func TestSpotNewOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := g.SpotNewOrder(SpotNewOrderRequestParams{
+	_, err := g.SpotNewOrder(context.Background(), SpotNewOrderRequestParams{
 		Symbol: "btc_usdt",
 		Amount: 1.1,
 		Price:  10.1,
@@ -79,7 +87,7 @@ func TestCancelExistingOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := g.CancelExistingOrder(917591554, "btc_usdt")
+	_, err := g.CancelExistingOrder(context.Background(), 917591554, "btc_usdt")
 	if err != nil {
 		t.Errorf("Test failed - Gateio CancelExistingOrder: %s", err)
 	}
@@ -92,7 +100,7 @@ func TestGetBalances(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := g.GetBalances()
+	_, err := g.GetBalances(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Gateio GetBalances: %s", err)
 	}
@@ -100,7 +108,7 @@ func TestGetBalances(t *testing.T) {
 
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetLatestSpotPrice("btc_usdt")
+	_, err := g.GetLatestSpotPrice(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("Test failed - Gateio GetLatestSpotPrice: %s", err)
 	}
@@ -108,7 +116,7 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetTicker("btc_usdt")
+	_, err := g.GetTicker(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("Test failed - Gateio GetTicker: %s", err)
 	}
@@ -116,7 +124,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetTickers()
+	_, err := g.GetTickers(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Gateio GetTicker: %s", err)
 	}
@@ -124,7 +132,7 @@ func TestGetTickers(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetOrderbook("btc_usdt")
+	_, err := g.GetOrderbook(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("Test failed - Gateio GetTicker: %s", err)
 	}
@@ -133,7 +141,7 @@ func TestGetOrderbook(t *testing.T) {
 func TestGetSpotKline(t *testing.T) {
 	t.Parallel()
 
-	_, err := g.GetSpotKline(KlinesRequestParams{
+	_, err := g.GetSpotKline(context.Background(), KlinesRequestParams{
 		Symbol:   "btc_usdt",
 		GroupSec: TimeIntervalFiveMinutes, // 5 minutes or less
 		HourSize: 1,                       // 1 hour data
@@ -165,7 +173,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 	if apiKey != "" && apiSecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := g.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.002), resp)
 		}
@@ -174,7 +182,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := g.GetFee(feeBuilder); resp != float64(2000) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(2000) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(2000), resp)
 			t.Error(err)
 		}
@@ -182,7 +190,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := g.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.002), resp)
 			t.Error(err)
 		}
@@ -190,7 +198,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -198,7 +206,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -207,7 +215,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FirstCurrency = "hello"
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -215,7 +223,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -223,7 +231,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -232,7 +240,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.USD
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -275,7 +283,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.LTC,
 		SecondCurrency: symbol.BTC,
 	}
-	response, err := g.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "1234234")
+	response, err := g.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "1234234"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -301,7 +309,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := g.CancelOrder(orderCancellation)
+	err := g.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
@@ -1,11 +1,14 @@
 package gateio
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -31,7 +34,7 @@ func (g *Gateio) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", g.GetName(), len(g.EnabledPairs), g.EnabledPairs)
 	}
 
-	symbols, err := g.GetSymbols()
+	symbols, err := g.GetSymbols(context.Background())
 	if err != nil {
 		log.Printf("%s Unable to fetch symbols.\n", g.GetName())
 	} else {
@@ -43,9 +46,9 @@ func (g *Gateio) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (g *Gateio) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (g *Gateio) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	result, err := g.GetTickers()
+	result, err := g.GetTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -66,29 +69,29 @@ func (g *Gateio) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pri
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (g *Gateio) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (g *Gateio) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(g.GetName(), p, assetType)
 	if err != nil {
-		return g.UpdateTicker(p, assetType)
+		return g.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (g *Gateio) GetOrderbookEx(currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (g *Gateio) GetOrderbookEx(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(g.GetName(), currency, assetType)
 	if err != nil {
-		return g.UpdateOrderbook(currency, assetType)
+		return g.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (g *Gateio) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (g *Gateio) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	currency := exchange.FormatExchangeCurrency(g.Name, p).String()
 
-	orderbookNew, err := g.GetOrderbook(currency)
+	orderbookNew, err := g.GetOrderbook(ctx, currency)
 	if err != nil {
 		return orderBook, err
 	}
@@ -109,44 +112,48 @@ func (g *Gateio) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbo
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // ZB exchange
-func (g *Gateio) GetAccountInfo() (exchange.AccountInfo, error) {
+func (g *Gateio) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	return response, errors.New("not implemented")
 }
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (g *Gateio) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (g *Gateio) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (g *Gateio) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (g *Gateio) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (g *Gateio) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (g *Gateio) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := g.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
 	var orderTypeFormat SpotNewOrderRequestParamsType
 
-	if side == exchange.Buy {
+	if order.Side == exchange.Buy {
 		orderTypeFormat = SpotNewOrderRequestParamsTypeBuy
 	} else {
 		orderTypeFormat = SpotNewOrderRequestParamsTypeSell
 	}
 
 	var spotNewOrderRequestParams = SpotNewOrderRequestParams{
-		Amount: amount,
-		Price:  price,
-		Symbol: p.Pair().String(),
+		Amount: order.Amount,
+		Price:  order.Price,
+		Symbol: order.Pair.Pair().String(),
 		Type:   orderTypeFormat,
 	}
 
-	response, err := g.SpotNewOrder(spotNewOrderRequestParams)
+	response, err := g.SpotNewOrder(ctx, spotNewOrderRequestParams)
 
 	if response.OrderNumber > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -161,47 +168,74 @@ func (g *Gateio) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, order
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (g *Gateio) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (g *Gateio) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (g *Gateio) CancelOrder(order exchange.OrderCancellation) error {
+func (g *Gateio) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
-	_, err = g.CancelExistingOrder(orderIDInt, exchange.FormatExchangeCurrency(g.Name, order.CurrencyPair).String())
+	_, err = g.CancelExistingOrder(ctx, orderIDInt, exchange.FormatExchangeCurrency(g.Name, order.CurrencyPair).String())
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (g *Gateio) CancelAllOrders() error {
+func (g *Gateio) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (g *Gateio) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (g *Gateio) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (g *Gateio) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (g *Gateio) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (g *Gateio) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (g *Gateio) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (g *Gateio) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (g *Gateio) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (g *Gateio) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (g *Gateio) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (g *Gateio) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (g *Gateio) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (g *Gateio) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -218,10 +252,83 @@ func (g *Gateio) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (g *Gateio) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return g.GetFee(feeBuilder)
+	return g.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (g *Gateio) GetWithdrawCapabilities() uint32 {
 	return g.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (g *Gateio) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (g *Gateio) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// gateioCandleIntervals maps the supported candle intervals to Gateio kline
+// group sizes in seconds
+var gateioCandleIntervals = map[exchange.CandleInterval]string{
+	exchange.OneMin:     "60",
+	exchange.ThreeMin:   "180",
+	exchange.FiveMin:    "300",
+	exchange.FifteenMin: "900",
+	exchange.ThirtyMin:  "1800",
+	exchange.OneHour:    "3600",
+	exchange.TwoHour:    "7200",
+	exchange.FourHour:   "14400",
+	exchange.SixHour:    "21600",
+	exchange.OneDay:     "86400",
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (g *Gateio) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	gateioInterval, err := exchange.ValidateCandleRequest(gateioCandleIntervals,
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	groupSec, err := strconv.Atoi(gateioInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gateio klines can only be requested as a range of hours counted back
+	// from now, so the whole period is fetched in a single request and
+	// trimmed to the requested times
+	limit := int(time.Since(start)/interval.Duration()) + 1
+	symbol := exchange.FormatExchangeCurrency(g.Name, p).String()
+	return exchange.GetCandlesPaginated(interval, start, end, limit,
+		func(windowStart, windowEnd time.Time) ([]exchange.Candle, error) {
+			resp, err := g.GetSpotKline(ctx, KlinesRequestParams{
+				Symbol:   symbol,
+				HourSize: int(math.Ceil(time.Since(windowStart).Hours())),
+				GroupSec: TimeInterval(groupSec),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			var candles []exchange.Candle
+			for i := range resp {
+				candles = append(candles, exchange.Candle{
+					Time:   resp[i].KlineTime,
+					Open:   resp[i].Open,
+					High:   resp[i].High,
+					Low:    resp[i].Low,
+					Close:  resp[i].Close,
+					Volume: resp[i].Volume,
+				})
+			}
+			return candles, nil
+		})
+}
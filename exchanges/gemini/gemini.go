@@ -1,6 +1,7 @@
 package gemini
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -162,15 +163,15 @@ func (g *Gemini) Setup(exch config.ExchangeConfig) {
 }
 
 // GetSymbols returns all available symbols for trading
-func (g *Gemini) GetSymbols() ([]string, error) {
+func (g *Gemini) GetSymbols(ctx context.Context) ([]string, error) {
 	symbols := []string{}
 	path := fmt.Sprintf("%s/v%s/%s", g.APIUrl, geminiAPIVersion, geminiSymbols)
 
-	return symbols, g.SendHTTPRequest(path, &symbols)
+	return symbols, g.SendHTTPRequest(ctx, path, &symbols)
 }
 
 // GetTicker returns information about recent trading activity for the symbol
-func (g *Gemini) GetTicker(currencyPair string) (Ticker, error) {
+func (g *Gemini) GetTicker(ctx context.Context, currencyPair string) (Ticker, error) {
 
 	type TickerResponse struct {
 		Ask     float64 `json:"ask,string"`
@@ -184,7 +185,7 @@ func (g *Gemini) GetTicker(currencyPair string) (Ticker, error) {
 	resp := TickerResponse{}
 	path := fmt.Sprintf("%s/v%s/%s/%s", g.APIUrl, geminiAPIVersion, geminiTicker, currencyPair)
 
-	err := g.SendHTTPRequest(path, &resp)
+	err := g.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return ticker, err
 	}
@@ -222,11 +223,11 @@ func (g *Gemini) GetTicker(currencyPair string) (Ticker, error) {
 //
 // params - limit_bids or limit_asks [OPTIONAL] default 50, 0 returns all Values
 // Type is an integer ie "params.Set("limit_asks", 30)"
-func (g *Gemini) GetOrderbook(currencyPair string, params url.Values) (Orderbook, error) {
+func (g *Gemini) GetOrderbook(ctx context.Context, currencyPair string, params url.Values) (Orderbook, error) {
 	path := common.EncodeURLValues(fmt.Sprintf("%s/v%s/%s/%s", g.APIUrl, geminiAPIVersion, geminiOrderbook, currencyPair), params)
 	orderbook := Orderbook{}
 
-	return orderbook, g.SendHTTPRequest(path, &orderbook)
+	return orderbook, g.SendHTTPRequest(ctx, path, &orderbook)
 }
 
 // GetTrades eturn the trades that have executed since the specified timestamp.
@@ -238,19 +239,19 @@ func (g *Gemini) GetOrderbook(currencyPair string, params url.Values) (Orderbook
 // limit_trades	integer	Optional. The maximum number of trades to return.
 // include_breaks	boolean	Optional. Whether to display broken trades. False by
 // default. Can be '1' or 'true' to activate
-func (g *Gemini) GetTrades(currencyPair string, params url.Values) ([]Trade, error) {
+func (g *Gemini) GetTrades(ctx context.Context, currencyPair string, params url.Values) ([]Trade, error) {
 	path := common.EncodeURLValues(fmt.Sprintf("%s/v%s/%s/%s", g.APIUrl, geminiAPIVersion, geminiTrades, currencyPair), params)
 	trades := []Trade{}
 
-	return trades, g.SendHTTPRequest(path, &trades)
+	return trades, g.SendHTTPRequest(ctx, path, &trades)
 }
 
 // GetAuction returns auction information
-func (g *Gemini) GetAuction(currencyPair string) (Auction, error) {
+func (g *Gemini) GetAuction(ctx context.Context, currencyPair string) (Auction, error) {
 	path := fmt.Sprintf("%s/v%s/%s/%s", g.APIUrl, geminiAPIVersion, geminiAuction, currencyPair)
 	auction := Auction{}
 
-	return auction, g.SendHTTPRequest(path, &auction)
+	return auction, g.SendHTTPRequest(ctx, path, &auction)
 }
 
 // GetAuctionHistory returns the auction events, optionally including
@@ -258,17 +259,23 @@ func (g *Gemini) GetAuction(currencyPair string) (Auction, error) {
 //
 // currencyPair - example "btcusd"
 // params -- [optional]
-//          since - [timestamp] Only returns auction events after the specified
+//
+//	since - [timestamp] Only returns auction events after the specified
+//
 // timestamp.
-//          limit_auction_results - [integer] The maximum number of auction
+//
+//	limit_auction_results - [integer] The maximum number of auction
+//
 // events to return.
-//          include_indicative - [bool] Whether to include publication of
+//
+//	include_indicative - [bool] Whether to include publication of
+//
 // indicative prices and quantities.
-func (g *Gemini) GetAuctionHistory(currencyPair string, params url.Values) ([]AuctionHistory, error) {
+func (g *Gemini) GetAuctionHistory(ctx context.Context, currencyPair string, params url.Values) ([]AuctionHistory, error) {
 	path := common.EncodeURLValues(fmt.Sprintf("%s/v%s/%s/%s/%s", g.APIUrl, geminiAPIVersion, geminiAuction, currencyPair, geminiAuctionHistory), params)
 	auctionHist := []AuctionHistory{}
 
-	return auctionHist, g.SendHTTPRequest(path, &auctionHist)
+	return auctionHist, g.SendHTTPRequest(ctx, path, &auctionHist)
 }
 
 func (g *Gemini) isCorrectSession(role string) error {
@@ -280,7 +287,7 @@ func (g *Gemini) isCorrectSession(role string) error {
 
 // NewOrder Only limit orders are supported through the API at present.
 // returns order ID if successful
-func (g *Gemini) NewOrder(symbol string, amount, price float64, side, orderType string) (int64, error) {
+func (g *Gemini) NewOrder(ctx context.Context, symbol string, amount, price float64, side, orderType string) (int64, error) {
 	if err := g.isCorrectSession(geminiRoleTrader); err != nil {
 		return 0, err
 	}
@@ -293,7 +300,7 @@ func (g *Gemini) NewOrder(symbol string, amount, price float64, side, orderType
 	request["type"] = orderType
 
 	response := Order{}
-	err := g.SendAuthenticatedHTTPRequest("POST", geminiOrderNew, request, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiOrderNew, request, &response)
 	if err != nil {
 		return 0, err
 	}
@@ -302,12 +309,12 @@ func (g *Gemini) NewOrder(symbol string, amount, price float64, side, orderType
 
 // CancelExistingOrder will cancel an order. If the order is already canceled, the
 // message will succeed but have no effect.
-func (g *Gemini) CancelExistingOrder(OrderID int64) (Order, error) {
+func (g *Gemini) CancelExistingOrder(ctx context.Context, OrderID int64) (Order, error) {
 	request := make(map[string]interface{})
 	request["order_id"] = OrderID
 
 	response := Order{}
-	err := g.SendAuthenticatedHTTPRequest("POST", geminiOrderCancel, request, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiOrderCancel, request, &response)
 	if err != nil {
 		return Order{}, err
 	}
@@ -322,14 +329,14 @@ func (g *Gemini) CancelExistingOrder(OrderID int64) (Order, error) {
 // sessions owned by this account, including interactive orders placed through
 // the UI. If sessions = true will only cancel the order that is called on this
 // session asssociated with the APIKEY
-func (g *Gemini) CancelExistingOrders(CancelBySession bool) (OrderResult, error) {
+func (g *Gemini) CancelExistingOrders(ctx context.Context, CancelBySession bool) (OrderResult, error) {
 	response := OrderResult{}
 	path := geminiOrderCancelAll
 	if CancelBySession {
 		path = geminiOrderCancelSession
 	}
 
-	err := g.SendAuthenticatedHTTPRequest("POST", path, nil, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", path, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -340,13 +347,13 @@ func (g *Gemini) CancelExistingOrders(CancelBySession bool) (OrderResult, error)
 }
 
 // GetOrderStatus returns the status for an order
-func (g *Gemini) GetOrderStatus(orderID int64) (Order, error) {
+func (g *Gemini) GetOrderStatus(ctx context.Context, orderID int64) (Order, error) {
 	request := make(map[string]interface{})
 	request["order_id"] = orderID
 
 	response := Order{}
 
-	err := g.SendAuthenticatedHTTPRequest("POST", geminiOrderStatus, request, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiOrderStatus, request, &response)
 	if err != nil {
 		return response, err
 	}
@@ -358,27 +365,18 @@ func (g *Gemini) GetOrderStatus(orderID int64) (Order, error) {
 }
 
 // GetOrders returns active orders in the market
-func (g *Gemini) GetOrders() ([]Order, error) {
-	var response struct {
-		orders  []Order
-		Message string `json:"message"`
-	}
+func (g *Gemini) GetOrders(ctx context.Context) ([]Order, error) {
+	response := []Order{}
 
-	err := g.SendAuthenticatedHTTPRequest("POST", geminiOrders, nil, &response)
-	if err != nil {
-		return response.orders, err
-	}
-	if response.Message != "" {
-		return response.orders, errors.New(response.Message)
-	}
-	return response.orders, nil
+	return response,
+		g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiOrders, nil, &response)
 }
 
 // GetTradeHistory returns an array of trades that have been on the exchange
 //
 // currencyPair - example "btcusd"
 // timestamp - [optional] Only return trades on or after this timestamp.
-func (g *Gemini) GetTradeHistory(currencyPair string, timestamp int64) ([]TradeHistory, error) {
+func (g *Gemini) GetTradeHistory(ctx context.Context, currencyPair string, timestamp int64) ([]TradeHistory, error) {
 	response := []TradeHistory{}
 	request := make(map[string]interface{})
 	request["symbol"] = currencyPair
@@ -388,38 +386,38 @@ func (g *Gemini) GetTradeHistory(currencyPair string, timestamp int64) ([]TradeH
 	}
 
 	return response,
-		g.SendAuthenticatedHTTPRequest("POST", geminiMyTrades, request, &response)
+		g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiMyTrades, request, &response)
 }
 
 // GetNotionalVolume returns  the volume in price currency that has been traded across all pairs over a period of 30 days
-func (g *Gemini) GetNotionalVolume() (NotionalVolume, error) {
+func (g *Gemini) GetNotionalVolume(ctx context.Context) (NotionalVolume, error) {
 	response := NotionalVolume{}
 
 	return response,
-		g.SendAuthenticatedHTTPRequest("POST", geminiVolume, nil, &response)
+		g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiVolume, nil, &response)
 }
 
 // GetTradeVolume returns a multi-arrayed volume response
-func (g *Gemini) GetTradeVolume() ([][]TradeVolume, error) {
+func (g *Gemini) GetTradeVolume(ctx context.Context) ([][]TradeVolume, error) {
 	response := [][]TradeVolume{}
 
 	return response,
-		g.SendAuthenticatedHTTPRequest("POST", geminiTradeVolume, nil, &response)
+		g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiTradeVolume, nil, &response)
 }
 
 // GetBalances returns available balances in the supported currencies
-func (g *Gemini) GetBalances() ([]Balance, error) {
+func (g *Gemini) GetBalances(ctx context.Context) ([]Balance, error) {
 	response := []Balance{}
 
 	return response,
-		g.SendAuthenticatedHTTPRequest("POST", geminiBalances, nil, &response)
+		g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiBalances, nil, &response)
 }
 
 // GetCryptoDepositAddress returns a deposit address
-func (g *Gemini) GetCryptoDepositAddress(depositAddlabel, currency string) (DepositAddress, error) {
+func (g *Gemini) GetCryptoDepositAddress(ctx context.Context, depositAddlabel, currency string) (DepositAddress, error) {
 	response := DepositAddress{}
 
-	err := g.SendAuthenticatedHTTPRequest("POST", geminiDeposit+"/"+currency+"/"+geminiNewAddress, nil, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiDeposit+"/"+currency+"/"+geminiNewAddress, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -430,13 +428,13 @@ func (g *Gemini) GetCryptoDepositAddress(depositAddlabel, currency string) (Depo
 }
 
 // WithdrawCrypto withdraws crypto currency to a whitelisted address
-func (g *Gemini) WithdrawCrypto(address, currency string, amount float64) (WithdrawalAddress, error) {
+func (g *Gemini) WithdrawCrypto(ctx context.Context, address, currency string, amount float64) (WithdrawalAddress, error) {
 	response := WithdrawalAddress{}
 	request := make(map[string]interface{})
 	request["address"] = address
 	request["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
 
-	err := g.SendAuthenticatedHTTPRequest("POST", geminiWithdraw+currency, nil, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiWithdraw+currency, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -448,14 +446,14 @@ func (g *Gemini) WithdrawCrypto(address, currency string, amount float64) (Withd
 
 // PostHeartbeat sends a maintenance heartbeat to the exchange for all heartbeat
 // maintaned sessions
-func (g *Gemini) PostHeartbeat() (string, error) {
+func (g *Gemini) PostHeartbeat(ctx context.Context) (string, error) {
 	type Response struct {
 		Result  string `json:"result"`
 		Message string `json:"message"`
 	}
 	response := Response{}
 
-	err := g.SendAuthenticatedHTTPRequest("POST", geminiHeartbeat, nil, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, "POST", geminiHeartbeat, nil, &response)
 	if err != nil {
 		return response.Result, err
 	}
@@ -466,13 +464,13 @@ func (g *Gemini) PostHeartbeat() (string, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated request
-func (g *Gemini) SendHTTPRequest(path string, result interface{}) error {
-	return g.SendPayload("GET", path, nil, nil, result, false, g.Verbose)
+func (g *Gemini) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return g.SendPayload(ctx, "GET", path, nil, nil, result, false, g.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to the
 // exchange and returns an error
-func (g *Gemini) SendAuthenticatedHTTPRequest(method, path string, params map[string]interface{}, result interface{}) (err error) {
+func (g *Gemini) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, params map[string]interface{}, result interface{}) (err error) {
 	if !g.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, g.Name)
 	}
@@ -504,15 +502,15 @@ func (g *Gemini) SendAuthenticatedHTTPRequest(method, path string, params map[st
 	headers["X-GEMINI-PAYLOAD"] = PayloadBase64
 	headers["X-GEMINI-SIGNATURE"] = common.HexEncodeToString(hmac)
 
-	return g.SendPayload(method, g.APIUrl+"/v1/"+path, headers, strings.NewReader(""), result, true, g.Verbose)
+	return g.SendPayload(ctx, method, g.APIUrl+"/v1/"+path, headers, strings.NewReader(""), result, true, g.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (g *Gemini) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (g *Gemini) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		notionVolume, err := g.GetNotionalVolume()
+		notionVolume, err := g.GetNotionalVolume(ctx)
 		if err != nil {
 			return 0, err
 		}
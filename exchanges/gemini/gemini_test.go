@@ -1,6 +1,7 @@
 package gemini
 
 import (
+	"context"
 	"net/url"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please enter sandbox API keys & assigned roles for better testing procedures
@@ -26,6 +28,12 @@ const (
 	canManipulateRealOrders = false
 )
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestAddSession(t *testing.T) {
 	var g1 Gemini
 	err := AddSession(&g1, 1, apiKey1, apiSecret1, apiKeyRole1, true, false)
@@ -65,7 +73,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetSymbols(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].GetSymbols()
+	_, err := Session[1].GetSymbols(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetSymbols() error", err)
 	}
@@ -73,11 +81,11 @@ func TestGetSymbols(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := Session[2].GetTicker("BTCUSD")
+	_, err := Session[2].GetTicker(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error("Test Failed - GetTicker() error", err)
 	}
-	_, err = Session[1].GetTicker("bla")
+	_, err = Session[1].GetTicker(context.Background(), "bla")
 	if err == nil {
 		t.Error("Test Failed - GetTicker() error", err)
 	}
@@ -85,7 +93,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].GetOrderbook("btcusd", url.Values{})
+	_, err := Session[1].GetOrderbook(context.Background(), "btcusd", url.Values{})
 	if err != nil {
 		t.Error("Test Failed - GetOrderbook() error", err)
 	}
@@ -93,7 +101,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := Session[2].GetTrades("btcusd", url.Values{})
+	_, err := Session[2].GetTrades(context.Background(), "btcusd", url.Values{})
 	if err != nil {
 		t.Error("Test Failed - GetTrades() error", err)
 	}
@@ -102,7 +110,7 @@ func TestGetTrades(t *testing.T) {
 func TestGetNotionalVolume(t *testing.T) {
 	if apiKey2 != "" && apiSecret2 != "" {
 		t.Parallel()
-		_, err := Session[2].GetNotionalVolume()
+		_, err := Session[2].GetNotionalVolume(context.Background())
 		if err != nil {
 			t.Error("Test Failed - GetNotionalVolume() error", err)
 		}
@@ -111,7 +119,7 @@ func TestGetNotionalVolume(t *testing.T) {
 
 func TestGetAuction(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].GetAuction("btcusd")
+	_, err := Session[1].GetAuction(context.Background(), "btcusd")
 	if err != nil {
 		t.Error("Test Failed - GetAuction() error", err)
 	}
@@ -119,7 +127,7 @@ func TestGetAuction(t *testing.T) {
 
 func TestGetAuctionHistory(t *testing.T) {
 	t.Parallel()
-	_, err := Session[2].GetAuctionHistory("btcusd", url.Values{})
+	_, err := Session[2].GetAuctionHistory(context.Background(), "btcusd", url.Values{})
 	if err != nil {
 		t.Error("Test Failed - GetAuctionHistory() error", err)
 	}
@@ -127,11 +135,11 @@ func TestGetAuctionHistory(t *testing.T) {
 
 func TestNewOrder(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].NewOrder("btcusd", 1, 4500, "buy", "exchange limit")
+	_, err := Session[1].NewOrder(context.Background(), "btcusd", 1, 4500, "buy", "exchange limit")
 	if err == nil {
 		t.Error("Test Failed - NewOrder() error", err)
 	}
-	_, err = Session[2].NewOrder("btcusd", 1, 4500, "buy", "exchange limit")
+	_, err = Session[2].NewOrder(context.Background(), "btcusd", 1, 4500, "buy", "exchange limit")
 	if err == nil {
 		t.Error("Test Failed - NewOrder() error", err)
 	}
@@ -139,7 +147,7 @@ func TestNewOrder(t *testing.T) {
 
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].CancelExistingOrder(1337)
+	_, err := Session[1].CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - CancelExistingOrder() error", err)
 	}
@@ -147,11 +155,11 @@ func TestCancelExistingOrder(t *testing.T) {
 
 func TestCancelExistingOrders(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].CancelExistingOrders(false)
+	_, err := Session[1].CancelExistingOrders(context.Background(), false)
 	if err == nil {
 		t.Error("Test Failed - CancelExistingOrders() error", err)
 	}
-	_, err = Session[2].CancelExistingOrders(true)
+	_, err = Session[2].CancelExistingOrders(context.Background(), true)
 	if err == nil {
 		t.Error("Test Failed - CancelExistingOrders() error", err)
 	}
@@ -159,7 +167,7 @@ func TestCancelExistingOrders(t *testing.T) {
 
 func TestGetOrderStatus(t *testing.T) {
 	t.Parallel()
-	_, err := Session[2].GetOrderStatus(1337)
+	_, err := Session[2].GetOrderStatus(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - GetOrderStatus() error", err)
 	}
@@ -167,7 +175,7 @@ func TestGetOrderStatus(t *testing.T) {
 
 func TestGetOrders(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].GetOrders()
+	_, err := Session[1].GetOrders(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetOrders() error", err)
 	}
@@ -175,7 +183,7 @@ func TestGetOrders(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].GetTradeHistory("btcusd", 0)
+	_, err := Session[1].GetTradeHistory(context.Background(), "btcusd", 0)
 	if err == nil {
 		t.Error("Test Failed - GetTradeHistory() error", err)
 	}
@@ -183,7 +191,7 @@ func TestGetTradeHistory(t *testing.T) {
 
 func TestGetTradeVolume(t *testing.T) {
 	t.Parallel()
-	_, err := Session[2].GetTradeVolume()
+	_, err := Session[2].GetTradeVolume(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetTradeVolume() error", err)
 	}
@@ -191,7 +199,7 @@ func TestGetTradeVolume(t *testing.T) {
 
 func TestGetBalances(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].GetBalances()
+	_, err := Session[1].GetBalances(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetBalances() error", err)
 	}
@@ -199,7 +207,7 @@ func TestGetBalances(t *testing.T) {
 
 func TestGetCryptoDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].GetCryptoDepositAddress("LOL123", "btc")
+	_, err := Session[1].GetCryptoDepositAddress(context.Background(), "LOL123", "btc")
 	if err == nil {
 		t.Error("Test Failed - GetCryptoDepositAddress() error", err)
 	}
@@ -207,7 +215,7 @@ func TestGetCryptoDepositAddress(t *testing.T) {
 
 func TestWithdrawCrypto(t *testing.T) {
 	t.Parallel()
-	_, err := Session[1].WithdrawCrypto("LOL123", "btc", 1)
+	_, err := Session[1].WithdrawCrypto(context.Background(), "LOL123", "btc", 1)
 	if err == nil {
 		t.Error("Test Failed - WithdrawCrypto() error", err)
 	}
@@ -215,7 +223,7 @@ func TestWithdrawCrypto(t *testing.T) {
 
 func TestPostHeartbeat(t *testing.T) {
 	t.Parallel()
-	_, err := Session[2].PostHeartbeat()
+	_, err := Session[2].PostHeartbeat(context.Background())
 	if err == nil {
 		t.Error("Test Failed - PostHeartbeat() error", err)
 	}
@@ -240,7 +248,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 	if apiKey1 != "" && apiSecret1 != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := Session[1].GetFee(feeBuilder); resp != float64(0.01) || err != nil {
+		if resp, err := Session[1].GetFee(context.Background(), feeBuilder); resp != float64(0.01) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.01), resp)
 		}
@@ -249,7 +257,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := Session[1].GetFee(feeBuilder); resp != float64(100) || err != nil {
+		if resp, err := Session[1].GetFee(context.Background(), feeBuilder); resp != float64(100) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(100), resp)
 			t.Error(err)
 		}
@@ -257,7 +265,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := Session[1].GetFee(feeBuilder); resp != float64(0.01) || err != nil {
+		if resp, err := Session[1].GetFee(context.Background(), feeBuilder); resp != float64(0.01) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.01), resp)
 			t.Error(err)
 		}
@@ -265,7 +273,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := Session[1].GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := Session[1].GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -273,7 +281,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := Session[1].GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := Session[1].GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -282,7 +290,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FirstCurrency = "hello"
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := Session[1].GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := Session[1].GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -290,7 +298,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := Session[1].GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := Session[1].GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -298,7 +306,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := Session[1].GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := Session[1].GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -307,7 +315,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.USD
-	if resp, err := Session[1].GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := Session[1].GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -324,6 +332,62 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Gemini using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *Gemini {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	geminiConfig, err := cfg.GetExchangeConfig("Gemini")
+	if err != nil {
+		t.Fatal("Test Failed - Gemini Setup() init error")
+	}
+
+	geminiConfig.AuthenticatedAPISupport = true
+	geminiConfig.APIKey, geminiConfig.APISecret = request.FixtureCredentials(t, apiKey1, apiSecret1)
+
+	var r Gemini
+	r.SetDefaults()
+	r.Setup(geminiConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - Gemini GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "107421210" ||
+		orders[0].BaseCurrency != symbol.ETH || orders[0].QuoteCurrency != symbol.USD ||
+		orders[0].Price != 400 || orders[0].Amount != 1 || orders[0].OpenVolume != 0.6 ||
+		orders[0].ExecutedAmount != 0.4 || orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - Gemini GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	_, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{})
+	if err == nil {
+		t.Error("Test Failed - Gemini GetOrderHistory() expected an error without currencies")
+	}
+
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.BTC, symbol.USD)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Gemini GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 2 || orders[0].ID != "107317526" ||
+		orders[0].Status != "FILLED" || orders[0].OrderSide != "Buy" ||
+		orders[0].Price != 6500 || orders[0].ExecutedAmount != 0.01) {
+		t.Errorf("Test Failed - Gemini GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -349,7 +413,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.LTC,
 		SecondCurrency: symbol.BTC,
 	}
-	response, err := Session[1].SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "1234234")
+	response, err := Session[1].SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "1234234"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -375,7 +439,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := Session[1].CancelOrder(orderCancellation)
+	err := Session[1].CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
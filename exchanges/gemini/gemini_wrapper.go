@@ -1,11 +1,14 @@
 package gemini
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -30,7 +33,7 @@ func (g *Gemini) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", g.GetName(), len(g.EnabledPairs), g.EnabledPairs)
 	}
 
-	exchangeProducts, err := g.GetSymbols()
+	exchangeProducts, err := g.GetSymbols(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", g.GetName())
 	} else {
@@ -43,10 +46,10 @@ func (g *Gemini) Run() {
 
 // GetAccountInfo Retrieves balances for all enabled currencies for the
 // Gemini exchange
-func (g *Gemini) GetAccountInfo() (exchange.AccountInfo, error) {
+func (g *Gemini) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = g.GetName()
-	accountBalance, err := g.GetBalances()
+	accountBalance, err := g.GetBalances(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -61,9 +64,9 @@ func (g *Gemini) GetAccountInfo() (exchange.AccountInfo, error) {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (g *Gemini) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (g *Gemini) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := g.GetTicker(p.Pair().String())
+	tick, err := g.GetTicker(ctx, p.Pair().String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -77,27 +80,27 @@ func (g *Gemini) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pri
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (g *Gemini) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (g *Gemini) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(g.GetName(), p, assetType)
 	if err != nil {
-		return g.UpdateTicker(p, assetType)
+		return g.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (g *Gemini) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (g *Gemini) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(g.GetName(), p, assetType)
 	if err != nil {
-		return g.UpdateOrderbook(p, assetType)
+		return g.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (g *Gemini) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (g *Gemini) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := g.GetOrderbook(p.Pair().String(), url.Values{})
+	orderbookNew, err := g.GetOrderbook(ctx, p.Pair().String(), url.Values{})
 	if err != nil {
 		return orderBook, err
 	}
@@ -116,22 +119,26 @@ func (g *Gemini) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbo
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (g *Gemini) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (g *Gemini) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (g *Gemini) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (g *Gemini) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (g *Gemini) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (g *Gemini) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	response, err := g.NewOrder(p.Pair().String(), amount, price, side.ToString(), orderType.ToString())
+	if err := g.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	response, err := g.NewOrder(ctx, order.Pair.Pair().String(), order.Amount, order.Price, order.Side.ToString(), order.OrderType.ToString())
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -146,48 +153,75 @@ func (g *Gemini) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, order
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (g *Gemini) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (g *Gemini) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (g *Gemini) CancelOrder(order exchange.OrderCancellation) error {
+func (g *Gemini) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = g.CancelExistingOrder(orderIDInt)
+	_, err = g.CancelExistingOrder(ctx, orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (g *Gemini) CancelAllOrders() error {
+func (g *Gemini) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (g *Gemini) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (g *Gemini) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (g *Gemini) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (g *Gemini) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (g *Gemini) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (g *Gemini) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (g *Gemini) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (g *Gemini) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (g *Gemini) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (g *Gemini) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (g *Gemini) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (g *Gemini) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (g *Gemini) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -204,10 +238,89 @@ func (g *Gemini) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (g *Gemini) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return g.GetFee(feeBuilder)
+	return g.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (g *Gemini) GetWithdrawCapabilities() uint32 {
 	return g.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (g *Gemini) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := g.GetOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for i := range resp {
+		p := g.GetPairFromSymbol(resp[i].Symbol)
+		orderDate := time.Unix(0, resp[i].TimestampMS*int64(time.Millisecond))
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:       g.Name,
+			ID:             strconv.FormatInt(resp[i].OrderID, 10),
+			BaseCurrency:   p.FirstCurrency.String(),
+			QuoteCurrency:  p.SecondCurrency.String(),
+			OrderSide:      resp[i].Side,
+			OrderType:      resp[i].Type,
+			CreationTime:   orderDate.Unix(),
+			OrderDate:      orderDate,
+			Price:          resp[i].Price,
+			Amount:         resp[i].OriginalAmount,
+			OpenVolume:     resp[i].RemainingAmount,
+			ExecutedAmount: resp[i].ExecutedAmount,
+		})
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (g *Gemini) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	if len(getOrdersRequest.Currencies) == 0 {
+		return nil, errors.New("at least one currency pair is required")
+	}
+
+	var timestamp int64
+	if !getOrdersRequest.StartTicks.IsZero() {
+		timestamp = getOrdersRequest.StartTicks.Unix()
+	}
+
+	// Gemini only returns past trades, each fill is reported as a filled order
+	var orders []exchange.OrderDetail
+	for _, c := range getOrdersRequest.Currencies {
+		resp, err := g.GetTradeHistory(ctx,
+			exchange.FormatExchangeCurrency(g.Name, c).String(), timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp {
+			orderDate := time.Unix(0, resp[i].TimestampMS*int64(time.Millisecond))
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:       g.Name,
+				ID:             strconv.FormatInt(resp[i].OrderID, 10),
+				BaseCurrency:   c.FirstCurrency.String(),
+				QuoteCurrency:  c.SecondCurrency.String(),
+				OrderSide:      resp[i].Type,
+				OrderType:      exchange.Limit.ToString(),
+				CreationTime:   orderDate.Unix(),
+				OrderDate:      orderDate,
+				Status:         "FILLED",
+				Price:          resp[i].Price,
+				Amount:         resp[i].Amount,
+				ExecutedAmount: resp[i].Amount,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (g *Gemini) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
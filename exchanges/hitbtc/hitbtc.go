@@ -2,6 +2,7 @@ package hitbtc
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -35,8 +36,8 @@ const (
 	apiV2CryptoAddress  = "api/2/account/crypto/address"
 	apiV2CryptoWithdraw = "api/2/account/crypto/withdraw"
 	apiV2TradeHistory   = "api/2/history/trades"
+	apiV2OrderHistory   = "api/2/history/order"
 	apiV2FeeInfo        = "api/2/trading/fee"
-	orders              = "order"
 	orderBuy            = "api/2/order"
 	orderSell           = "sell"
 	orderMove           = "moveOrder"
@@ -45,6 +46,10 @@ const (
 
 	hitbtcAuthRate   = 0
 	hitbtcUnauthRate = 0
+
+	// hitbtcMaxCandlesLimit is the maximum amount of candles returned per
+	// request
+	hitbtcMaxCandlesLimit = 1000
 )
 
 // HitBTC is the overarching type across the hitbtc package
@@ -129,7 +134,7 @@ func (h *HitBTC) Setup(exch config.ExchangeConfig) {
 
 // GetCurrencies returns the actual list of available currencies, tokens, ICO
 // etc.
-func (h *HitBTC) GetCurrencies() (map[string]Currencies, error) {
+func (h *HitBTC) GetCurrencies(ctx context.Context) (map[string]Currencies, error) {
 	type Response struct {
 		Data []Currencies
 	}
@@ -137,7 +142,7 @@ func (h *HitBTC) GetCurrencies() (map[string]Currencies, error) {
 	path := fmt.Sprintf("%s/%s", h.APIUrl, apiV2Currency)
 
 	ret := make(map[string]Currencies)
-	err := h.SendHTTPRequest(path, &resp.Data)
+	err := h.SendHTTPRequest(ctx, path, &resp.Data)
 	if err != nil {
 		return ret, err
 	}
@@ -150,14 +155,14 @@ func (h *HitBTC) GetCurrencies() (map[string]Currencies, error) {
 
 // GetCurrency returns the actual list of available currencies, tokens, ICO
 // etc.
-func (h *HitBTC) GetCurrency(currency string) (Currencies, error) {
+func (h *HitBTC) GetCurrency(ctx context.Context, currency string) (Currencies, error) {
 	type Response struct {
 		Data Currencies
 	}
 	resp := Response{}
 	path := fmt.Sprintf("%s/%s/%s", h.APIUrl, apiV2Currency, currency)
 
-	return resp.Data, h.SendHTTPRequest(path, &resp.Data)
+	return resp.Data, h.SendHTTPRequest(ctx, path, &resp.Data)
 }
 
 // GetSymbols Return the actual list of currency symbols (currency pairs) traded
@@ -165,12 +170,12 @@ func (h *HitBTC) GetCurrency(currency string) (Currencies, error) {
 // currency, and the second currency is called the quote currency. The currency
 // pair indicates how much of the quote currency is needed to purchase one unit
 // of the base currency.
-func (h *HitBTC) GetSymbols(symbol string) ([]string, error) {
+func (h *HitBTC) GetSymbols(ctx context.Context, symbol string) ([]string, error) {
 	resp := []Symbol{}
 	path := fmt.Sprintf("%s/%s/%s", h.APIUrl, apiV2Symbol, symbol)
 
 	ret := make([]string, 0, len(resp))
-	err := h.SendHTTPRequest(path, &resp)
+	err := h.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return ret, err
 	}
@@ -183,15 +188,15 @@ func (h *HitBTC) GetSymbols(symbol string) ([]string, error) {
 
 // GetSymbolsDetailed is the same as above but returns an array of symbols with
 // all their details.
-func (h *HitBTC) GetSymbolsDetailed() ([]Symbol, error) {
+func (h *HitBTC) GetSymbolsDetailed(ctx context.Context) ([]Symbol, error) {
 	resp := []Symbol{}
 	path := fmt.Sprintf("%s/%s", h.APIUrl, apiV2Symbol)
 
-	return resp, h.SendHTTPRequest(path, &resp)
+	return resp, h.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTicker returns ticker information
-func (h *HitBTC) GetTicker(symbol string) (map[string]Ticker, error) {
+func (h *HitBTC) GetTicker(ctx context.Context, symbol string) (map[string]Ticker, error) {
 	resp1 := []TickerResponse{}
 	resp2 := TickerResponse{}
 	ret := make(map[string]TickerResponse)
@@ -200,7 +205,7 @@ func (h *HitBTC) GetTicker(symbol string) (map[string]Ticker, error) {
 	var err error
 
 	if symbol == "" {
-		err = h.SendHTTPRequest(path, &resp1)
+		err = h.SendHTTPRequest(ctx, path, &resp1)
 		if err != nil {
 			return nil, err
 		}
@@ -211,7 +216,7 @@ func (h *HitBTC) GetTicker(symbol string) (map[string]Ticker, error) {
 			}
 		}
 	} else {
-		err = h.SendHTTPRequest(path, &resp2)
+		err = h.SendHTTPRequest(ctx, path, &resp2)
 		ret[resp2.Symbol] = resp2
 	}
 
@@ -253,7 +258,7 @@ func (h *HitBTC) GetTicker(symbol string) (map[string]Ticker, error) {
 }
 
 // GetTrades returns trades from hitbtc
-func (h *HitBTC) GetTrades(currencyPair, from, till, limit, offset, by, sort string) ([]TradeHistory, error) {
+func (h *HitBTC) GetTrades(ctx context.Context, currencyPair, from, till, limit, offset, by, sort string) ([]TradeHistory, error) {
 	// start   Number or Datetime
 	// end     Number or Datetime
 	// limit   Number
@@ -289,12 +294,12 @@ func (h *HitBTC) GetTrades(currencyPair, from, till, limit, offset, by, sort str
 	resp := []TradeHistory{}
 	path := fmt.Sprintf("%s/%s/%s?%s", h.APIUrl, apiV2Trades, currencyPair, vals.Encode())
 
-	return resp, h.SendHTTPRequest(path, &resp)
+	return resp, h.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetOrderbook an order book is an electronic list of buy and sell orders for a
 // specific symbol, organized by price level.
-func (h *HitBTC) GetOrderbook(currencyPair string, limit int) (Orderbook, error) {
+func (h *HitBTC) GetOrderbook(ctx context.Context, currencyPair string, limit int) (Orderbook, error) {
 	// limit Limit of orderbook levels, default 100. Set 0 to view full orderbook levels
 	vals := url.Values{}
 
@@ -305,7 +310,7 @@ func (h *HitBTC) GetOrderbook(currencyPair string, limit int) (Orderbook, error)
 	resp := OrderbookResponse{}
 	path := fmt.Sprintf("%s/%s/%s?%s", h.APIUrl, apiV2Orderbook, currencyPair, vals.Encode())
 
-	err := h.SendHTTPRequest(path, &resp)
+	err := h.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -323,9 +328,11 @@ func (h *HitBTC) GetOrderbook(currencyPair string, limit int) (Orderbook, error)
 
 // GetCandles returns candles which is used for OHLC a specific symbol.
 // Note: Result contain candles only with non zero volume.
-func (h *HitBTC) GetCandles(currencyPair, limit, period string) ([]ChartData, error) {
+func (h *HitBTC) GetCandles(ctx context.Context, currencyPair, limit, period string, from, till time.Time) ([]ChartData, error) {
 	// limit   Limit of candles, default 100.
 	// period  One of: M1 (one minute), M3, M5, M15, M30, H1, H4, D1, D7, 1M (one month). Default is M30 (30 minutes).
+	// from    Interval initial value, optional.
+	// till    Interval end value, optional.
 	vals := url.Values{}
 
 	if limit != "" {
@@ -336,19 +343,27 @@ func (h *HitBTC) GetCandles(currencyPair, limit, period string) ([]ChartData, er
 		vals.Set("period", period)
 	}
 
+	if !from.IsZero() {
+		vals.Set("from", from.UTC().Format(time.RFC3339))
+	}
+
+	if !till.IsZero() {
+		vals.Set("till", till.UTC().Format(time.RFC3339))
+	}
+
 	resp := []ChartData{}
 	path := fmt.Sprintf("%s/%s/%s?%s", h.APIUrl, apiV2Candles, currencyPair, vals.Encode())
 
-	return resp, h.SendHTTPRequest(path, &resp)
+	return resp, h.SendHTTPRequest(ctx, path, &resp)
 }
 
 // Authenticated Market Data
 // https://api.hitbtc.com/?python#market-data
 
 // GetBalances returns full balance for your account
-func (h *HitBTC) GetBalances() (map[string]Balance, error) {
+func (h *HitBTC) GetBalances(ctx context.Context) (map[string]Balance, error) {
 	result := []Balance{}
-	err := h.SendAuthenticatedHTTPRequest("GET", apiV2Balance, url.Values{}, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", apiV2Balance, url.Values{}, &result)
 	ret := make(map[string]Balance)
 
 	if err != nil {
@@ -363,31 +378,49 @@ func (h *HitBTC) GetBalances() (map[string]Balance, error) {
 }
 
 // GetDepositAddresses returns a deposit address for a specific currency
-func (h *HitBTC) GetDepositAddresses(currency string) (DepositCryptoAddresses, error) {
+func (h *HitBTC) GetDepositAddresses(ctx context.Context, currency string) (DepositCryptoAddresses, error) {
 	resp := DepositCryptoAddresses{}
-	err := h.SendAuthenticatedHTTPRequest("GET", apiV2CryptoAddress+"/"+currency, url.Values{}, &resp)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", apiV2CryptoAddress+"/"+currency, url.Values{}, &resp)
 
 	return resp, err
 }
 
 // GenerateNewAddress generates a new deposit address for a currency
-func (h *HitBTC) GenerateNewAddress(currency string) (DepositCryptoAddresses, error) {
+func (h *HitBTC) GenerateNewAddress(ctx context.Context, currency string) (DepositCryptoAddresses, error) {
 	resp := DepositCryptoAddresses{}
-	err := h.SendAuthenticatedHTTPRequest("POST", apiV2CryptoAddress+"/"+currency, url.Values{}, &resp)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", apiV2CryptoAddress+"/"+currency, url.Values{}, &resp)
+
+	return resp, err
+}
+
+// GetActiveorders returns all your active orders, the orders of every symbol
+// are returned when currency is empty
+func (h *HitBTC) GetActiveorders(ctx context.Context, currency string) ([]Order, error) {
+	resp := []Order{}
+	path := orderBuy
+	if currency != "" {
+		path += "?symbol=" + currency
+	}
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", path, url.Values{}, &resp)
 
 	return resp, err
 }
 
-// GetActiveorders returns all your active orders
-func (h *HitBTC) GetActiveorders(currency string) ([]Order, error) {
+// GetOrders returns your closed orders, the orders of every symbol are
+// returned when currency is empty
+func (h *HitBTC) GetOrders(ctx context.Context, currency string) ([]Order, error) {
 	resp := []Order{}
-	err := h.SendAuthenticatedHTTPRequest("GET", orders+"?symbol="+currency, url.Values{}, &resp)
+	path := apiV2OrderHistory
+	if currency != "" {
+		path += "?symbol=" + currency
+	}
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", path, url.Values{}, &resp)
 
 	return resp, err
 }
 
 // GetAuthenticatedTradeHistory returns your trade history
-func (h *HitBTC) GetAuthenticatedTradeHistory(currency, start, end string) (interface{}, error) {
+func (h *HitBTC) GetAuthenticatedTradeHistory(ctx context.Context, currency, start, end string) (interface{}, error) {
 	values := url.Values{}
 
 	if start != "" {
@@ -402,17 +435,17 @@ func (h *HitBTC) GetAuthenticatedTradeHistory(currency, start, end string) (inte
 		values.Set("currencyPair", currency)
 		result := AuthenticatedTradeHistoryResponse{}
 
-		return result, h.SendAuthenticatedHTTPRequest("POST", apiV2TradeHistory, values, &result.Data)
+		return result, h.SendAuthenticatedHTTPRequest(ctx, "POST", apiV2TradeHistory, values, &result.Data)
 	}
 
 	values.Set("currencyPair", "all")
 	result := AuthenticatedTradeHistoryAll{}
 
-	return result, h.SendAuthenticatedHTTPRequest("POST", apiV2TradeHistory, values, &result.Data)
+	return result, h.SendAuthenticatedHTTPRequest(ctx, "POST", apiV2TradeHistory, values, &result.Data)
 }
 
 // PlaceOrder places an order on the exchange
-func (h *HitBTC) PlaceOrder(currency string, rate, amount float64, orderType, side string) (OrderResponse, error) {
+func (h *HitBTC) PlaceOrder(ctx context.Context, currency string, rate, amount float64, orderType, side string) (OrderResponse, error) {
 	result := OrderResponse{}
 	values := url.Values{}
 
@@ -422,7 +455,7 @@ func (h *HitBTC) PlaceOrder(currency string, rate, amount float64, orderType, si
 	values.Set("side", side)
 	values.Set("price", strconv.FormatFloat(rate, 'f', -1, 64))
 
-	err := h.SendAuthenticatedHTTPRequest("POST", orderBuy, values, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", orderBuy, values, &result)
 
 	if err != nil {
 		return result, err
@@ -432,11 +465,11 @@ func (h *HitBTC) PlaceOrder(currency string, rate, amount float64, orderType, si
 }
 
 // CancelExistingOrder cancels a specific order by OrderID
-func (h *HitBTC) CancelExistingOrder(orderID int64) (bool, error) {
+func (h *HitBTC) CancelExistingOrder(ctx context.Context, orderID int64) (bool, error) {
 	result := GenericResponse{}
 	values := url.Values{}
 
-	err := h.SendAuthenticatedHTTPRequest("DELETE", orderBuy+"/"+strconv.FormatInt(orderID, 10), values, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "DELETE", orderBuy+"/"+strconv.FormatInt(orderID, 10), values, &result)
 
 	if err != nil {
 		return false, err
@@ -450,7 +483,7 @@ func (h *HitBTC) CancelExistingOrder(orderID int64) (bool, error) {
 }
 
 // MoveOrder generates a new move order
-func (h *HitBTC) MoveOrder(orderID int64, rate, amount float64) (MoveOrderResponse, error) {
+func (h *HitBTC) MoveOrder(ctx context.Context, orderID int64, rate, amount float64) (MoveOrderResponse, error) {
 	result := MoveOrderResponse{}
 	values := url.Values{}
 	values.Set("orderNumber", strconv.FormatInt(orderID, 10))
@@ -460,7 +493,7 @@ func (h *HitBTC) MoveOrder(orderID int64, rate, amount float64) (MoveOrderRespon
 		values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	}
 
-	err := h.SendAuthenticatedHTTPRequest("POST", orderMove, values, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", orderMove, values, &result)
 
 	if err != nil {
 		return result, err
@@ -474,7 +507,7 @@ func (h *HitBTC) MoveOrder(orderID int64, rate, amount float64) (MoveOrderRespon
 }
 
 // Withdraw allows for the withdrawal to a specific address
-func (h *HitBTC) Withdraw(currency, address string, amount float64) (bool, error) {
+func (h *HitBTC) Withdraw(ctx context.Context, currency, address string, amount float64) (bool, error) {
 	result := Withdraw{}
 	values := url.Values{}
 
@@ -482,7 +515,7 @@ func (h *HitBTC) Withdraw(currency, address string, amount float64) (bool, error
 	values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	values.Set("address", address)
 
-	err := h.SendAuthenticatedHTTPRequest("POST", apiV2CryptoWithdraw, values, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", apiV2CryptoWithdraw, values, &result)
 
 	if err != nil {
 		return false, err
@@ -496,21 +529,21 @@ func (h *HitBTC) Withdraw(currency, address string, amount float64) (bool, error
 }
 
 // GetFeeInfo returns current fee information
-func (h *HitBTC) GetFeeInfo(currencyPair string) (Fee, error) {
+func (h *HitBTC) GetFeeInfo(ctx context.Context, currencyPair string) (Fee, error) {
 	result := Fee{}
-	err := h.SendAuthenticatedHTTPRequest("GET", apiV2FeeInfo+"/"+currencyPair, url.Values{}, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", apiV2FeeInfo+"/"+currencyPair, url.Values{}, &result)
 
 	return result, err
 }
 
 // GetTradableBalances returns current tradable balances
-func (h *HitBTC) GetTradableBalances() (map[string]map[string]float64, error) {
+func (h *HitBTC) GetTradableBalances(ctx context.Context) (map[string]map[string]float64, error) {
 	type Response struct {
 		Data map[string]map[string]interface{}
 	}
 	result := Response{}
 
-	err := h.SendAuthenticatedHTTPRequest("POST", tradableBalances, url.Values{}, &result.Data)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", tradableBalances, url.Values{}, &result.Data)
 
 	if err != nil {
 		return nil, err
@@ -529,7 +562,7 @@ func (h *HitBTC) GetTradableBalances() (map[string]map[string]float64, error) {
 }
 
 // TransferBalance transfers a balance
-func (h *HitBTC) TransferBalance(currency, from, to string, amount float64) (bool, error) {
+func (h *HitBTC) TransferBalance(ctx context.Context, currency, from, to string, amount float64) (bool, error) {
 	values := url.Values{}
 	result := GenericResponse{}
 
@@ -538,7 +571,7 @@ func (h *HitBTC) TransferBalance(currency, from, to string, amount float64) (boo
 	values.Set("fromAccount", from)
 	values.Set("toAccount", to)
 
-	err := h.SendAuthenticatedHTTPRequest("POST", transferBalance, values, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", transferBalance, values, &result)
 
 	if err != nil {
 		return false, err
@@ -552,12 +585,12 @@ func (h *HitBTC) TransferBalance(currency, from, to string, amount float64) (boo
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (h *HitBTC) SendHTTPRequest(path string, result interface{}) error {
-	return h.SendPayload("GET", path, nil, nil, result, false, h.Verbose)
+func (h *HitBTC) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return h.SendPayload(ctx, "GET", path, nil, nil, result, false, h.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated http request
-func (h *HitBTC) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Values, result interface{}) error {
+func (h *HitBTC) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, result interface{}) error {
 	if !h.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, h.Name)
 	}
@@ -566,21 +599,21 @@ func (h *HitBTC) SendAuthenticatedHTTPRequest(method, endpoint string, values ur
 
 	path := fmt.Sprintf("%s/%s", h.APIUrl, endpoint)
 
-	return h.SendPayload(method, path, headers, bytes.NewBufferString(values.Encode()), result, true, h.Verbose)
+	return h.SendPayload(ctx, method, path, headers, bytes.NewBufferString(values.Encode()), result, true, h.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (h *HitBTC) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (h *HitBTC) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		feeInfo, err := h.GetFeeInfo(feeBuilder.FirstCurrency + feeBuilder.Delimiter + feeBuilder.SecondCurrency)
+		feeInfo, err := h.GetFeeInfo(ctx, feeBuilder.FirstCurrency+feeBuilder.Delimiter+feeBuilder.SecondCurrency)
 		if err != nil {
 			return 0, err
 		}
 		fee = calculateTradingFee(feeInfo, feeBuilder.PurchasePrice, feeBuilder.Amount, feeBuilder.IsMaker)
 	case exchange.CryptocurrencyWithdrawalFee:
-		currencyInfo, err := h.GetCurrency(feeBuilder.FirstCurrency)
+		currencyInfo, err := h.GetCurrency(ctx, feeBuilder.FirstCurrency)
 		if err != nil {
 			return 0, err
 		}
@@ -1,12 +1,15 @@
 package hitbtc
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var h HitBTC
@@ -19,6 +22,12 @@ const (
 	canManipulateRealOrders = false
 )
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	h.SetDefaults()
 }
@@ -39,28 +48,28 @@ func TestSetup(t *testing.T) {
 }
 
 func TestGetOrderbook(t *testing.T) {
-	_, err := h.GetOrderbook("BTCUSD", 50)
+	_, err := h.GetOrderbook(context.Background(), "BTCUSD", 50)
 	if err != nil {
 		t.Error("Test faild - HitBTC GetOrderbook() error", err)
 	}
 }
 
 func TestGetTrades(t *testing.T) {
-	_, err := h.GetTrades("BTCUSD", "", "", "", "", "", "")
+	_, err := h.GetTrades(context.Background(), "BTCUSD", "", "", "", "", "", "")
 	if err != nil {
 		t.Error("Test faild - HitBTC GetTradeHistory() error", err)
 	}
 }
 
 func TestGetChartCandles(t *testing.T) {
-	_, err := h.GetCandles("BTCUSD", "", "")
+	_, err := h.GetCandles(context.Background(), "BTCUSD", "", "", time.Time{}, time.Time{})
 	if err != nil {
 		t.Error("Test faild - HitBTC GetChartData() error", err)
 	}
 }
 
 func TestGetCurrencies(t *testing.T) {
-	_, err := h.GetCurrencies()
+	_, err := h.GetCurrencies(context.Background())
 	if err != nil {
 		t.Error("Test faild - HitBTC GetCurrencies() error", err)
 	}
@@ -87,7 +96,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 	if apiKey != "" && apiSecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := h.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
 		}
@@ -96,7 +105,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := h.GetFee(feeBuilder); resp != float64(1000) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(1000) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(1000), resp)
 			t.Error(err)
 		}
@@ -104,7 +113,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := h.GetFee(feeBuilder); resp != float64(-0.0001) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(-0.0001) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(-0.0001), resp)
 			t.Error(err)
 		}
@@ -112,7 +121,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := h.GetFee(feeBuilder); resp != float64(-1) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(-1) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(-1), resp)
 			t.Error(err)
 		}
@@ -120,7 +129,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyWithdrawalFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-		if resp, err := h.GetFee(feeBuilder); resp != float64(0.009580) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0.009580) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.009580), resp)
 			t.Error(err)
 		}
@@ -129,7 +138,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FirstCurrency = "hello"
 		feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-		if resp, err := h.GetFee(feeBuilder); resp != float64(0) || err == nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0) || err == nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -140,7 +149,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
 	feeBuilder.FirstCurrency = symbol.BTC
 	feeBuilder.SecondCurrency = symbol.LTC
-	if resp, err := h.GetFee(feeBuilder); resp != float64(0.0006) || err != nil {
+	if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0.0006) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0006), resp)
 		t.Error(err)
 	}
@@ -148,7 +157,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := h.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -157,7 +166,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.USD
-	if resp, err := h.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -175,6 +184,57 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a HitBTC using the credentials of the recorded
+// fixture
+func replayExchange(t *testing.T) *HitBTC {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	hitbtcConfig, err := cfg.GetExchangeConfig("HitBTC")
+	if err != nil {
+		t.Fatal("Test Failed - HitBTC Setup() init error")
+	}
+
+	hitbtcConfig.AuthenticatedAPISupport = true
+	hitbtcConfig.APIKey, hitbtcConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r HitBTC
+	r.SetDefaults()
+	r.Setup(hitbtcConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - HitBTC GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "840450210" ||
+		orders[0].BaseCurrency != symbol.ETH || orders[0].QuoteCurrency != symbol.BTC ||
+		orders[0].Status != "PARTIALLY_FILLED" || orders[0].OpenVolume != 0.5 ||
+		orders[0].ExecutedAmount != 0.25 || orders[0].OrderDate.Unix() != 1546300800) {
+		t.Errorf("Test Failed - HitBTC GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.ETH, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - HitBTC GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "828680665" ||
+		orders[0].OrderSide != "sell" || orders[0].Status != "FILLED" ||
+		orders[0].Price != 0.035 || orders[0].ExecutedAmount != 0.1) {
+		t.Errorf("Test Failed - HitBTC GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -200,7 +260,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.DGD,
 		SecondCurrency: symbol.BTC,
 	}
-	response, err := h.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "1234234")
+	response, err := h.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "1234234"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -226,7 +286,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := h.CancelOrder(orderCancellation)
+	err := h.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
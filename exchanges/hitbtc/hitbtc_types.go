@@ -125,7 +125,7 @@ type DepositCryptoAddresses struct {
 
 // Order contains information about an order
 type Order struct {
-	ID            int64  `json:"id,string"`     //  Unique identifier for Order as assigned by exchange
+	ID            int64  `json:"id"`            //  Unique identifier for Order as assigned by exchange
 	ClientOrderID string `json:"clientOrderId"` // Unique identifier for Order as assigned by trader. Uniqueness must be
 	// guaranteed within a single trading day, including all active orders.
 	Symbol      string `json:"symbol"`      // Trading symbol
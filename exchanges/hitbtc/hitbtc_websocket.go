@@ -177,14 +177,17 @@ func (h *HitBTC) WsHandleData() {
 				}
 
 				h.Websocket.DataHandler <- exchange.TickerData{
-					Exchange:  h.GetName(),
-					AssetType: "SPOT",
-					Pair:      pair.NewCurrencyPairFromString(ticker.Params.Symbol),
-					Quantity:  ticker.Params.Volume,
-					Timestamp: ts,
-					OpenPrice: ticker.Params.Open,
-					HighPrice: ticker.Params.High,
-					LowPrice:  ticker.Params.Low,
+					Exchange:   h.GetName(),
+					AssetType:  "SPOT",
+					Pair:       pair.NewCurrencyPairFromString(ticker.Params.Symbol),
+					Quantity:   ticker.Params.Volume,
+					Timestamp:  ts,
+					ClosePrice: ticker.Params.Last,
+					OpenPrice:  ticker.Params.Open,
+					HighPrice:  ticker.Params.High,
+					LowPrice:   ticker.Params.Low,
+					BidPrice:   ticker.Params.Bid,
+					AskPrice:   ticker.Params.Ask,
 				}
 
 			case "snapshotOrderbook":
@@ -1,10 +1,12 @@
 package hitbtc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -30,7 +32,7 @@ func (h *HitBTC) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", h.GetName(), len(h.EnabledPairs), h.EnabledPairs)
 	}
 
-	exchangeProducts, err := h.GetSymbolsDetailed()
+	exchangeProducts, err := h.GetSymbolsDetailed(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", h.GetName())
 	} else {
@@ -60,8 +62,8 @@ func (h *HitBTC) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (h *HitBTC) UpdateTicker(currencyPair pair.CurrencyPair, assetType string) (ticker.Price, error) {
-	tick, err := h.GetTicker("")
+func (h *HitBTC) UpdateTicker(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) (ticker.Price, error) {
+	tick, err := h.GetTicker(ctx, "")
 	if err != nil {
 		return ticker.Price{}, err
 	}
@@ -82,27 +84,27 @@ func (h *HitBTC) UpdateTicker(currencyPair pair.CurrencyPair, assetType string)
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (h *HitBTC) GetTickerPrice(currencyPair pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (h *HitBTC) GetTickerPrice(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(h.GetName(), currencyPair, assetType)
 	if err != nil {
-		return h.UpdateTicker(currencyPair, assetType)
+		return h.UpdateTicker(ctx, currencyPair, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (h *HitBTC) GetOrderbookEx(currencyPair pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (h *HitBTC) GetOrderbookEx(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(h.GetName(), currencyPair, assetType)
 	if err != nil {
-		return h.UpdateOrderbook(currencyPair, assetType)
+		return h.UpdateOrderbook(ctx, currencyPair, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (h *HitBTC) UpdateOrderbook(currencyPair pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (h *HitBTC) UpdateOrderbook(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := h.GetOrderbook(exchange.FormatExchangeCurrency(h.GetName(), currencyPair).String(), 1000)
+	orderbookNew, err := h.GetOrderbook(ctx, exchange.FormatExchangeCurrency(h.GetName(), currencyPair).String(), 1000)
 	if err != nil {
 		return orderBook, err
 	}
@@ -123,10 +125,10 @@ func (h *HitBTC) UpdateOrderbook(currencyPair pair.CurrencyPair, assetType strin
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // HitBTC exchange
-func (h *HitBTC) GetAccountInfo() (exchange.AccountInfo, error) {
+func (h *HitBTC) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = h.GetName()
-	accountBalance, err := h.GetBalances()
+	accountBalance, err := h.GetBalances(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -143,22 +145,26 @@ func (h *HitBTC) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (h *HitBTC) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (h *HitBTC) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (h *HitBTC) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (h *HitBTC) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (h *HitBTC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (h *HitBTC) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	response, err := h.PlaceOrder(p.Pair().String(), price, amount, common.StringToLower(orderType.ToString()), common.StringToLower(side.ToString()))
+	if err := h.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	response, err := h.PlaceOrder(ctx, order.Pair.Pair().String(), order.Price, order.Amount, common.StringToLower(order.OrderType.ToString()), common.StringToLower(order.Side.ToString()))
 
 	if response.OrderNumber > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response.OrderNumber)
@@ -173,48 +179,75 @@ func (h *HitBTC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, order
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (h *HitBTC) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (h *HitBTC) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (h *HitBTC) CancelOrder(order exchange.OrderCancellation) error {
+func (h *HitBTC) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = h.CancelExistingOrder(orderIDInt)
+	_, err = h.CancelExistingOrder(ctx, orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (h *HitBTC) CancelAllOrders() error {
+func (h *HitBTC) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (h *HitBTC) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (h *HitBTC) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (h *HitBTC) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (h *HitBTC) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (h *HitBTC) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (h *HitBTC) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (h *HitBTC) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (h *HitBTC) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (h *HitBTC) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (h *HitBTC) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (h *HitBTC) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (h *HitBTC) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (h *HitBTC) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -231,10 +264,124 @@ func (h *HitBTC) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (h *HitBTC) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return h.GetFee(feeBuilder)
+	return h.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (h *HitBTC) GetWithdrawCapabilities() uint32 {
 	return h.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (h *HitBTC) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return h.getOrders(ctx, getOrdersRequest, h.GetActiveorders)
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (h *HitBTC) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return h.getOrders(ctx, getOrdersRequest, h.GetOrders)
+}
+
+// orderStatus maps HitBTC order statuses to order statuses
+var orderStatus = map[string]string{
+	"new":             "NEW",
+	"suspended":       "NEW",
+	"partiallyFilled": "PARTIALLY_FILLED",
+	"filled":          "FILLED",
+	"canceled":        "CANCELLED",
+	"expired":         "CANCELLED",
+}
+
+// getOrders returns the orders of each requested currency pair from the
+// endpoint, or of every pair when none are requested
+func (h *HitBTC) getOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest, get func(ctx context.Context, currency string) ([]Order, error)) ([]exchange.OrderDetail, error) {
+	symbols := []string{""}
+	if len(getOrdersRequest.Currencies) > 0 {
+		symbols = symbols[:0]
+		for _, c := range getOrdersRequest.Currencies {
+			symbols = append(symbols, exchange.FormatExchangeCurrency(h.Name, c).String())
+		}
+	}
+
+	var orders []exchange.OrderDetail
+	for _, symbol := range symbols {
+		resp, err := get(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp {
+			p := h.GetPairFromSymbol(resp[i].Symbol)
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:       h.Name,
+				ID:             strconv.FormatInt(resp[i].ID, 10),
+				BaseCurrency:   p.FirstCurrency.String(),
+				QuoteCurrency:  p.SecondCurrency.String(),
+				OrderSide:      resp[i].Side,
+				OrderType:      resp[i].Type,
+				CreationTime:   resp[i].CreatedAt.Unix(),
+				OrderDate:      resp[i].CreatedAt,
+				Status:         orderStatus[resp[i].Status],
+				Price:          resp[i].Price,
+				Amount:         resp[i].Quantity,
+				OpenVolume:     resp[i].Quantity - resp[i].CumQuantity,
+				ExecutedAmount: resp[i].CumQuantity,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// hitbtcCandleIntervals maps the supported candle intervals to HitBTC candle
+// periods
+var hitbtcCandleIntervals = map[exchange.CandleInterval]string{
+	exchange.OneMin:     "M1",
+	exchange.ThreeMin:   "M3",
+	exchange.FiveMin:    "M5",
+	exchange.FifteenMin: "M15",
+	exchange.ThirtyMin:  "M30",
+	exchange.OneHour:    "H1",
+	exchange.FourHour:   "H4",
+	exchange.OneDay:     "D1",
+	exchange.OneWeek:    "D7",
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (h *HitBTC) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	period, err := exchange.ValidateCandleRequest(hitbtcCandleIntervals,
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := exchange.FormatExchangeCurrency(h.Name, p).String()
+	return exchange.GetCandlesPaginated(interval, start, end, hitbtcMaxCandlesLimit,
+		func(windowStart, windowEnd time.Time) ([]exchange.Candle, error) {
+			resp, err := h.GetCandles(ctx, symbol,
+				strconv.Itoa(hitbtcMaxCandlesLimit),
+				period,
+				windowStart,
+				windowEnd)
+			if err != nil {
+				return nil, err
+			}
+
+			var candles []exchange.Candle
+			for i := range resp {
+				candles = append(candles, exchange.Candle{
+					Time:   resp[i].Timestamp,
+					Open:   resp[i].Open,
+					High:   resp[i].Max,
+					Low:    resp[i].Min,
+					Close:  resp[i].Close,
+					Volume: resp[i].Volume,
+				})
+			}
+			return candles, nil
+		})
+}
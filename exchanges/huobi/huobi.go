@@ -2,6 +2,7 @@ package huobi
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/x509"
@@ -141,7 +142,7 @@ func (h *HUOBI) Setup(exch config.ExchangeConfig) {
 
 // GetSpotKline returns kline data
 // KlinesRequestParams contains symbol, period and size
-func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
+func (h *HUOBI) GetSpotKline(ctx context.Context, arg KlinesRequestParams) ([]KlineItem, error) {
 	vals := url.Values{}
 	vals.Set("symbol", arg.Symbol)
 	vals.Set("period", string(arg.Period))
@@ -158,7 +159,7 @@ func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobiMarketHistoryKline)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -166,7 +167,7 @@ func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
 }
 
 // GetMarketDetailMerged returns the ticker for the specified symbol
-func (h *HUOBI) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
+func (h *HUOBI) GetMarketDetailMerged(ctx context.Context, symbol string) (DetailMerged, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -178,7 +179,7 @@ func (h *HUOBI) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobiMarketDetailMerged)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Tick, errors.New(result.ErrorMessage)
 	}
@@ -186,7 +187,7 @@ func (h *HUOBI) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
 }
 
 // GetDepth returns the depth for the specified symbol
-func (h *HUOBI) GetDepth(obd OrderBookDataRequestParams) (Orderbook, error) {
+func (h *HUOBI) GetDepth(ctx context.Context, obd OrderBookDataRequestParams) (Orderbook, error) {
 	vals := url.Values{}
 	vals.Set("symbol", obd.Symbol)
 
@@ -202,7 +203,7 @@ func (h *HUOBI) GetDepth(obd OrderBookDataRequestParams) (Orderbook, error) {
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobiMarketDepth)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Depth, errors.New(result.ErrorMessage)
 	}
@@ -210,7 +211,7 @@ func (h *HUOBI) GetDepth(obd OrderBookDataRequestParams) (Orderbook, error) {
 }
 
 // GetTrades returns the trades for the specified symbol
-func (h *HUOBI) GetTrades(symbol string) ([]Trade, error) {
+func (h *HUOBI) GetTrades(ctx context.Context, symbol string) ([]Trade, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -224,7 +225,7 @@ func (h *HUOBI) GetTrades(symbol string) ([]Trade, error) {
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobiMarketTrade)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -234,8 +235,8 @@ func (h *HUOBI) GetTrades(symbol string) ([]Trade, error) {
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
-func (h *HUOBI) GetLatestSpotPrice(symbol string) (float64, error) {
-	list, err := h.GetTradeHistory(symbol, "1")
+func (h *HUOBI) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	list, err := h.GetTradeHistory(ctx, symbol, "1")
 
 	if err != nil {
 		return 0, err
@@ -248,7 +249,7 @@ func (h *HUOBI) GetLatestSpotPrice(symbol string) (float64, error) {
 }
 
 // GetTradeHistory returns the trades for the specified symbol
-func (h *HUOBI) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
+func (h *HUOBI) GetTradeHistory(ctx context.Context, symbol, size string) ([]TradeHistory, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -264,7 +265,7 @@ func (h *HUOBI) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobiMarketTradeHistory)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -272,7 +273,7 @@ func (h *HUOBI) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
 }
 
 // GetMarketDetail returns the ticker for the specified symbol
-func (h *HUOBI) GetMarketDetail(symbol string) (Detail, error) {
+func (h *HUOBI) GetMarketDetail(ctx context.Context, symbol string) (Detail, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -284,7 +285,7 @@ func (h *HUOBI) GetMarketDetail(symbol string) (Detail, error) {
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobiMarketDetail)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Tick, errors.New(result.ErrorMessage)
 	}
@@ -292,7 +293,7 @@ func (h *HUOBI) GetMarketDetail(symbol string) (Detail, error) {
 }
 
 // GetSymbols returns an array of symbols supported by Huobi
-func (h *HUOBI) GetSymbols() ([]Symbol, error) {
+func (h *HUOBI) GetSymbols(ctx context.Context) ([]Symbol, error) {
 	type response struct {
 		Response
 		Symbols []Symbol `json:"data"`
@@ -301,7 +302,7 @@ func (h *HUOBI) GetSymbols() ([]Symbol, error) {
 	var result response
 	url := fmt.Sprintf("%s/v%s/%s", h.APIUrl, huobiAPIVersion, huobiSymbols)
 
-	err := h.SendHTTPRequest(url, &result)
+	err := h.SendHTTPRequest(ctx, url, &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -309,7 +310,7 @@ func (h *HUOBI) GetSymbols() ([]Symbol, error) {
 }
 
 // GetCurrencies returns a list of currencies supported by Huobi
-func (h *HUOBI) GetCurrencies() ([]string, error) {
+func (h *HUOBI) GetCurrencies(ctx context.Context) ([]string, error) {
 	type response struct {
 		Response
 		Currencies []string `json:"data"`
@@ -318,7 +319,7 @@ func (h *HUOBI) GetCurrencies() ([]string, error) {
 	var result response
 	url := fmt.Sprintf("%s/v%s/%s", h.APIUrl, huobiAPIVersion, huobiCurrencies)
 
-	err := h.SendHTTPRequest(url, &result)
+	err := h.SendHTTPRequest(ctx, url, &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -326,7 +327,7 @@ func (h *HUOBI) GetCurrencies() ([]string, error) {
 }
 
 // GetTimestamp returns the Huobi server time
-func (h *HUOBI) GetTimestamp() (int64, error) {
+func (h *HUOBI) GetTimestamp(ctx context.Context) (int64, error) {
 	type response struct {
 		Response
 		Timestamp int64 `json:"data"`
@@ -335,7 +336,7 @@ func (h *HUOBI) GetTimestamp() (int64, error) {
 	var result response
 	url := fmt.Sprintf("%s/v%s/%s", h.APIUrl, huobiAPIVersion, huobiTimestamp)
 
-	err := h.SendHTTPRequest(url, &result)
+	err := h.SendHTTPRequest(ctx, url, &result)
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
 	}
@@ -343,14 +344,14 @@ func (h *HUOBI) GetTimestamp() (int64, error) {
 }
 
 // GetAccounts returns the Huobi user accounts
-func (h *HUOBI) GetAccounts() ([]Account, error) {
+func (h *HUOBI) GetAccounts(ctx context.Context) ([]Account, error) {
 	type response struct {
 		Response
 		AccountData []Account `json:"data"`
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobiAccounts, url.Values{}, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobiAccounts, url.Values{}, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -359,7 +360,7 @@ func (h *HUOBI) GetAccounts() ([]Account, error) {
 }
 
 // GetAccountBalance returns the users Huobi account balance
-func (h *HUOBI) GetAccountBalance(accountID string) ([]AccountBalanceDetail, error) {
+func (h *HUOBI) GetAccountBalance(ctx context.Context, accountID string) ([]AccountBalanceDetail, error) {
 	type response struct {
 		Response
 		AccountBalanceData AccountBalance `json:"data"`
@@ -367,7 +368,7 @@ func (h *HUOBI) GetAccountBalance(accountID string) ([]AccountBalanceDetail, err
 
 	var result response
 	endpoint := fmt.Sprintf(huobiAccountBalance, accountID)
-	err := h.SendAuthenticatedHTTPRequest("GET", endpoint, url.Values{}, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", endpoint, url.Values{}, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -376,7 +377,7 @@ func (h *HUOBI) GetAccountBalance(accountID string) ([]AccountBalanceDetail, err
 }
 
 // SpotNewOrder submits an order to Huobi
-func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
+func (h *HUOBI) SpotNewOrder(ctx context.Context, arg SpotNewOrderRequestParams) (int64, error) {
 	data := struct {
 		AccountID int    `json:"account-id,string"`
 		Amount    string `json:"amount"`
@@ -406,7 +407,7 @@ func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("POST", huobiOrderPlace, nil, data, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", huobiOrderPlace, nil, data, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -415,7 +416,7 @@ func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 }
 
 // CancelExistingOrder cancels an order on Huobi
-func (h *HUOBI) CancelExistingOrder(orderID int64) (int64, error) {
+func (h *HUOBI) CancelExistingOrder(ctx context.Context, orderID int64) (int64, error) {
 	type response struct {
 		Response
 		OrderID int64 `json:"data,string"`
@@ -423,7 +424,7 @@ func (h *HUOBI) CancelExistingOrder(orderID int64) (int64, error) {
 
 	var result response
 	endpoint := fmt.Sprintf(huobiOrderCancel, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest("POST", endpoint, url.Values{}, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", endpoint, url.Values{}, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -432,14 +433,14 @@ func (h *HUOBI) CancelExistingOrder(orderID int64) (int64, error) {
 }
 
 // CancelOrderBatch cancels a batch of orders -- to-do
-func (h *HUOBI) CancelOrderBatch(orderIDs []int64) ([]CancelOrderBatch, error) {
+func (h *HUOBI) CancelOrderBatch(ctx context.Context, orderIDs []int64) ([]CancelOrderBatch, error) {
 	type response struct {
 		Response
 		Data []CancelOrderBatch `json:"data"`
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("POST", huobiOrderCancelBatch, url.Values{}, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", huobiOrderCancelBatch, url.Values{}, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -448,7 +449,7 @@ func (h *HUOBI) CancelOrderBatch(orderIDs []int64) ([]CancelOrderBatch, error) {
 }
 
 // GetOrder returns order information for the specified order
-func (h *HUOBI) GetOrder(orderID int64) (OrderInfo, error) {
+func (h *HUOBI) GetOrder(ctx context.Context, orderID int64) (OrderInfo, error) {
 	type response struct {
 		Response
 		Order OrderInfo `json:"data"`
@@ -456,7 +457,7 @@ func (h *HUOBI) GetOrder(orderID int64) (OrderInfo, error) {
 
 	var result response
 	endpoint := fmt.Sprintf(huobiGetOrder, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest("GET", endpoint, url.Values{}, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", endpoint, url.Values{}, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return result.Order, errors.New(result.ErrorMessage)
@@ -465,7 +466,7 @@ func (h *HUOBI) GetOrder(orderID int64) (OrderInfo, error) {
 }
 
 // GetOrderMatchResults returns matched order info for the specified order
-func (h *HUOBI) GetOrderMatchResults(orderID int64) ([]OrderMatchInfo, error) {
+func (h *HUOBI) GetOrderMatchResults(ctx context.Context, orderID int64) ([]OrderMatchInfo, error) {
 	type response struct {
 		Response
 		Orders []OrderMatchInfo `json:"data"`
@@ -473,7 +474,7 @@ func (h *HUOBI) GetOrderMatchResults(orderID int64) ([]OrderMatchInfo, error) {
 
 	var result response
 	endpoint := fmt.Sprintf(huobiGetOrderMatch, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest("GET", endpoint, url.Values{}, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", endpoint, url.Values{}, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -482,7 +483,7 @@ func (h *HUOBI) GetOrderMatchResults(orderID int64) ([]OrderMatchInfo, error) {
 }
 
 // GetOrders returns a list of orders
-func (h *HUOBI) GetOrders(symbol, types, start, end, states, from, direct, size string) ([]OrderInfo, error) {
+func (h *HUOBI) GetOrders(ctx context.Context, symbol, types, start, end, states, from, direct, size string) ([]OrderInfo, error) {
 	type response struct {
 		Response
 		Orders []OrderInfo `json:"data"`
@@ -517,7 +518,7 @@ func (h *HUOBI) GetOrders(symbol, types, start, end, states, from, direct, size
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobiGetOrders, vals, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobiGetOrders, vals, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -526,7 +527,7 @@ func (h *HUOBI) GetOrders(symbol, types, start, end, states, from, direct, size
 }
 
 // GetOrdersMatch returns a list of matched orders
-func (h *HUOBI) GetOrdersMatch(symbol, types, start, end, from, direct, size string) ([]OrderMatchInfo, error) {
+func (h *HUOBI) GetOrdersMatch(ctx context.Context, symbol, types, start, end, from, direct, size string) ([]OrderMatchInfo, error) {
 	type response struct {
 		Response
 		Orders []OrderMatchInfo `json:"data"`
@@ -560,7 +561,7 @@ func (h *HUOBI) GetOrdersMatch(symbol, types, start, end, from, direct, size str
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobiGetOrdersMatch, vals, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobiGetOrdersMatch, vals, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -569,7 +570,7 @@ func (h *HUOBI) GetOrdersMatch(symbol, types, start, end, from, direct, size str
 }
 
 // MarginTransfer transfers assets into or out of the margin account
-func (h *HUOBI) MarginTransfer(symbol, currency string, amount float64, in bool) (int64, error) {
+func (h *HUOBI) MarginTransfer(ctx context.Context, symbol, currency string, amount float64, in bool) (int64, error) {
 	data := struct {
 		Symbol   string `json:"symbol"`
 		Currency string `json:"currency"`
@@ -591,7 +592,7 @@ func (h *HUOBI) MarginTransfer(symbol, currency string, amount float64, in bool)
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("POST", path, nil, data, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", path, nil, data, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -600,7 +601,7 @@ func (h *HUOBI) MarginTransfer(symbol, currency string, amount float64, in bool)
 }
 
 // MarginOrder submits a margin order application
-func (h *HUOBI) MarginOrder(symbol, currency string, amount float64) (int64, error) {
+func (h *HUOBI) MarginOrder(ctx context.Context, symbol, currency string, amount float64) (int64, error) {
 	data := struct {
 		Symbol   string `json:"symbol"`
 		Currency string `json:"currency"`
@@ -617,7 +618,7 @@ func (h *HUOBI) MarginOrder(symbol, currency string, amount float64) (int64, err
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("POST", huobiMarginOrders, nil, data, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", huobiMarginOrders, nil, data, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -626,7 +627,7 @@ func (h *HUOBI) MarginOrder(symbol, currency string, amount float64) (int64, err
 }
 
 // MarginRepayment repays a margin amount for a margin ID
-func (h *HUOBI) MarginRepayment(orderID int64, amount float64) (int64, error) {
+func (h *HUOBI) MarginRepayment(ctx context.Context, orderID int64, amount float64) (int64, error) {
 	data := struct {
 		Amount string `json:"amount"`
 	}{
@@ -640,7 +641,7 @@ func (h *HUOBI) MarginRepayment(orderID int64, amount float64) (int64, error) {
 
 	var result response
 	endpoint := fmt.Sprintf(huobiMarginRepay, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest("POST", endpoint, nil, data, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", endpoint, nil, data, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -649,7 +650,7 @@ func (h *HUOBI) MarginRepayment(orderID int64, amount float64) (int64, error) {
 }
 
 // GetMarginLoanOrders returns the margin loan orders
-func (h *HUOBI) GetMarginLoanOrders(symbol, currency, start, end, states, from, direct, size string) ([]MarginOrder, error) {
+func (h *HUOBI) GetMarginLoanOrders(ctx context.Context, symbol, currency, start, end, states, from, direct, size string) ([]MarginOrder, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	vals.Set("currency", currency)
@@ -684,7 +685,7 @@ func (h *HUOBI) GetMarginLoanOrders(symbol, currency, start, end, states, from,
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobiMarginLoanOrders, vals, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobiMarginLoanOrders, vals, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -693,7 +694,7 @@ func (h *HUOBI) GetMarginLoanOrders(symbol, currency, start, end, states, from,
 }
 
 // GetMarginAccountBalance returns the margin account balances
-func (h *HUOBI) GetMarginAccountBalance(symbol string) ([]MarginAccountBalance, error) {
+func (h *HUOBI) GetMarginAccountBalance(ctx context.Context, symbol string) ([]MarginAccountBalance, error) {
 	type response struct {
 		Response
 		Balances []MarginAccountBalance `json:"data"`
@@ -705,7 +706,7 @@ func (h *HUOBI) GetMarginAccountBalance(symbol string) ([]MarginAccountBalance,
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobiMarginAccountBalance, vals, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobiMarginAccountBalance, vals, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -714,7 +715,7 @@ func (h *HUOBI) GetMarginAccountBalance(symbol string) ([]MarginAccountBalance,
 }
 
 // Withdraw withdraws the desired amount and currency
-func (h *HUOBI) Withdraw(address, currency, addrTag string, amount, fee float64) (int64, error) {
+func (h *HUOBI) Withdraw(ctx context.Context, address, currency, addrTag string, amount, fee float64) (int64, error) {
 	type response struct {
 		Response
 		WithdrawID int64 `json:"data"`
@@ -741,7 +742,7 @@ func (h *HUOBI) Withdraw(address, currency, addrTag string, amount, fee float64)
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("POST", huobiWithdrawCreate, nil, data, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", huobiWithdrawCreate, nil, data, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -750,7 +751,7 @@ func (h *HUOBI) Withdraw(address, currency, addrTag string, amount, fee float64)
 }
 
 // CancelWithdraw cancels a withdraw request
-func (h *HUOBI) CancelWithdraw(withdrawID int64) (int64, error) {
+func (h *HUOBI) CancelWithdraw(ctx context.Context, withdrawID int64) (int64, error) {
 	type response struct {
 		Response
 		WithdrawID int64 `json:"data"`
@@ -761,7 +762,7 @@ func (h *HUOBI) CancelWithdraw(withdrawID int64) (int64, error) {
 
 	var result response
 	endpoint := fmt.Sprintf(huobiWithdrawCancel, strconv.FormatInt(withdrawID, 10))
-	err := h.SendAuthenticatedHTTPRequest("POST", endpoint, vals, nil, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", endpoint, vals, nil, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -770,12 +771,12 @@ func (h *HUOBI) CancelWithdraw(withdrawID int64) (int64, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (h *HUOBI) SendHTTPRequest(path string, result interface{}) error {
-	return h.SendPayload("GET", path, nil, nil, result, false, h.Verbose)
+func (h *HUOBI) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return h.SendPayload(ctx, "GET", path, nil, nil, result, false, h.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends authenticated requests to the HUOBI API
-func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Values, data interface{}, result interface{}) error {
+func (h *HUOBI) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, data interface{}, result interface{}) error {
 	if !h.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, h.Name)
 	}
@@ -847,7 +848,7 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url
 		body = encoded
 	}
 
-	return h.SendPayload(method, url, headers, bytes.NewReader(body), result, true, h.Verbose)
+	return h.SendPayload(ctx, method, url, headers, bytes.NewReader(body), result, true, h.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
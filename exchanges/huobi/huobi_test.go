@@ -1,6 +1,7 @@
 package huobi
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/x509"
@@ -9,12 +10,14 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply you own test keys here for due diligence testing.
@@ -26,6 +29,12 @@ const (
 
 var h HUOBI
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 // getDefaultConfig returns a default huobi config
 func getDefaultConfig() config.ExchangeConfig {
 	return config.ExchangeConfig{
@@ -76,7 +85,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetSpotKline(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetSpotKline(KlinesRequestParams{
+	_, err := h.GetSpotKline(context.Background(), KlinesRequestParams{
 		Symbol: "btcusdt",
 		Period: TimeIntervalHour,
 		Size:   0,
@@ -88,7 +97,7 @@ func TestGetSpotKline(t *testing.T) {
 
 func TestGetMarketDetailMerged(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetMarketDetailMerged("btcusdt")
+	_, err := h.GetMarketDetailMerged(context.Background(), "btcusdt")
 	if err != nil {
 		t.Errorf("Test failed - Huobi TestGetMarketDetailMerged: %s", err)
 	}
@@ -96,7 +105,7 @@ func TestGetMarketDetailMerged(t *testing.T) {
 
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetDepth(OrderBookDataRequestParams{
+	_, err := h.GetDepth(context.Background(), OrderBookDataRequestParams{
 		Symbol: "btcusdt",
 		Type:   OrderBookDataRequestParamsTypeStep1,
 	})
@@ -108,7 +117,7 @@ func TestGetDepth(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetTrades("btcusdt")
+	_, err := h.GetTrades(context.Background(), "btcusdt")
 	if err != nil {
 		t.Errorf("Test failed - Huobi TestGetTrades: %s", err)
 	}
@@ -116,7 +125,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetLatestSpotPrice("btcusdt")
+	_, err := h.GetLatestSpotPrice(context.Background(), "btcusdt")
 	if err != nil {
 		t.Errorf("Test failed - Huobi GetLatestSpotPrice: %s", err)
 	}
@@ -124,7 +133,7 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetTradeHistory("btcusdt", "50")
+	_, err := h.GetTradeHistory(context.Background(), "btcusdt", "50")
 	if err != nil {
 		t.Errorf("Test failed - Huobi TestGetTradeHistory: %s", err)
 	}
@@ -132,7 +141,7 @@ func TestGetTradeHistory(t *testing.T) {
 
 func TestGetMarketDetail(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetMarketDetail("btcusdt")
+	_, err := h.GetMarketDetail(context.Background(), "btcusdt")
 	if err != nil {
 		t.Errorf("Test failed - Huobi TestGetTradeHistory: %s", err)
 	}
@@ -140,7 +149,7 @@ func TestGetMarketDetail(t *testing.T) {
 
 func TestGetSymbols(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetSymbols()
+	_, err := h.GetSymbols(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Huobi TestGetSymbols: %s", err)
 	}
@@ -148,7 +157,7 @@ func TestGetSymbols(t *testing.T) {
 
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetCurrencies()
+	_, err := h.GetCurrencies(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Huobi TestGetCurrencies: %s", err)
 	}
@@ -156,7 +165,7 @@ func TestGetCurrencies(t *testing.T) {
 
 func TestGetTimestamp(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetTimestamp()
+	_, err := h.GetTimestamp(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Huobi TestGetTimestamp: %s", err)
 	}
@@ -169,7 +178,7 @@ func TestGetAccounts(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetAccounts()
+	_, err := h.GetAccounts(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Huobi GetAccounts: %s", err)
 	}
@@ -182,13 +191,13 @@ func TestGetAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	result, err := h.GetAccounts()
+	result, err := h.GetAccounts(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - Huobi GetAccounts: %s", err)
 	}
 
 	userID := strconv.FormatInt(result[0].ID, 10)
-	_, err = h.GetAccountBalance(userID)
+	_, err = h.GetAccountBalance(context.Background(), userID)
 	if err != nil {
 		t.Errorf("Test failed - Huobi GetAccountBalance: %s", err)
 	}
@@ -209,7 +218,7 @@ func TestSpotNewOrder(t *testing.T) {
 		Type:      SpotNewOrderRequestTypeBuyLimit,
 	}
 
-	_, err := h.SpotNewOrder(arg)
+	_, err := h.SpotNewOrder(context.Background(), arg)
 	if err != nil {
 		t.Errorf("Test failed - Huobi SpotNewOrder: %s", err)
 	}
@@ -218,7 +227,7 @@ func TestSpotNewOrder(t *testing.T) {
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := h.CancelExistingOrder(1337)
+	_, err := h.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test failed - Huobi TestCancelExistingOrder: Invalid orderID returned true")
 	}
@@ -227,7 +236,7 @@ func TestCancelExistingOrder(t *testing.T) {
 func TestGetOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := h.GetOrder(1337)
+	_, err := h.GetOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test failed - Huobi TestCancelOrder: Invalid orderID returned true")
 	}
@@ -240,7 +249,7 @@ func TestGetMarginLoanOrders(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetMarginLoanOrders("btcusdt", "", "", "", "", "", "", "")
+	_, err := h.GetMarginLoanOrders(context.Background(), "btcusdt", "", "", "", "", "", "", "")
 	if err != nil {
 		t.Errorf("Test failed - Huobi TestGetMarginLoanOrders: %s", err)
 	}
@@ -253,7 +262,7 @@ func TestGetMarginAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetMarginAccountBalance("btcusdt")
+	_, err := h.GetMarginAccountBalance(context.Background(), "btcusdt")
 	if err != nil {
 		t.Errorf("Test failed - Huobi TestGetMarginAccountBalance: %s", err)
 	}
@@ -262,7 +271,7 @@ func TestGetMarginAccountBalance(t *testing.T) {
 func TestCancelWithdraw(t *testing.T) {
 	t.Parallel()
 
-	_, err := h.CancelWithdraw(1337)
+	_, err := h.CancelWithdraw(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test failed - Huobi TestCancelWithdraw: Invalid withdraw-ID was valid")
 	}
@@ -396,6 +405,67 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a HUOBI using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *HUOBI {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	hConfig, err := cfg.GetExchangeConfig("Huobi")
+	if err != nil {
+		t.Fatal("Test Failed - Huobi Setup() init error")
+	}
+
+	hConfig.AuthenticatedAPISupport = true
+	hConfig.APIKey, hConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r HUOBI
+	r.SetDefaults()
+	r.Setup(hConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	_, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err == nil {
+		t.Error("Test Failed - Huobi GetActiveOrders() expected an error without currencies")
+	}
+
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.BTC, symbol.USDT)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Huobi GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "59378" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].QuoteCurrency != symbol.USDT ||
+		orders[0].OrderSide != "buy" || orders[0].OrderType != "limit" ||
+		orders[0].Status != "PARTIALLY_FILLED" || orders[0].Price != 6300 ||
+		orders[0].Amount != 0.5 || orders[0].OpenVolume != 0.3 ||
+		orders[0].ExecutedAmount != 0.2 || orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - Huobi GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.BTC, symbol.USDT)},
+		StartTicks: time.Date(2018, 6, 25, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Huobi GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 2 || orders[0].Status != "FILLED" ||
+		orders[0].OpenVolume != 0 || orders[1].Status != "CANCELLED" ||
+		orders[1].OrderSide != "sell") {
+		t.Errorf("Test Failed - Huobi GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -421,9 +491,9 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USDT,
 	}
-	accounts, err := h.GetAccounts()
+	accounts, err := h.GetAccounts(context.Background())
 
-	response, err := h.SubmitOrder(p, exchange.Buy, exchange.Limit, 1, 10, strconv.FormatInt(accounts[0].ID, 10))
+	response, err := h.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 10, ClientID: strconv.FormatInt(accounts[0].ID, 10)})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -449,7 +519,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := h.CancelOrder(orderCancellation)
+	err := h.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
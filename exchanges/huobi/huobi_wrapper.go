@@ -1,11 +1,13 @@
 package huobi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
@@ -32,7 +34,7 @@ func (h *HUOBI) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", h.GetName(), len(h.EnabledPairs), h.EnabledPairs)
 	}
 
-	exchangeProducts, err := h.GetSymbols()
+	exchangeProducts, err := h.GetSymbols(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", h.GetName())
 	} else {
@@ -81,9 +83,9 @@ func (h *HUOBI) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (h *HUOBI) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (h *HUOBI) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := h.GetMarketDetailMerged(exchange.FormatExchangeCurrency(h.Name, p).String())
+	tick, err := h.GetMarketDetailMerged(ctx, exchange.FormatExchangeCurrency(h.Name, p).String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -107,27 +109,27 @@ func (h *HUOBI) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pric
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (h *HUOBI) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (h *HUOBI) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(h.GetName(), p, assetType)
 	if err != nil {
-		return h.UpdateTicker(p, assetType)
+		return h.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (h *HUOBI) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (h *HUOBI) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(h.GetName(), p, assetType)
 	if err != nil {
-		return h.UpdateOrderbook(p, assetType)
+		return h.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (h *HUOBI) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (h *HUOBI) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := h.GetDepth(OrderBookDataRequestParams{
+	orderbookNew, err := h.GetDepth(ctx, OrderBookDataRequestParams{
 		Symbol: exchange.FormatExchangeCurrency(h.Name, p).String(),
 		Type:   OrderBookDataRequestParamsTypeStep1,
 	})
@@ -149,9 +151,9 @@ func (h *HUOBI) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderboo
 	return orderbook.GetOrderbook(h.Name, p, assetType)
 }
 
-//GetAccountInfo retrieves balances for all enabled currencies for the
+// GetAccountInfo retrieves balances for all enabled currencies for the
 // HUOBI exchange - to-do
-func (h *HUOBI) GetAccountInfo() (exchange.AccountInfo, error) {
+func (h *HUOBI) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = h.GetName()
 	return response, nil
@@ -159,47 +161,51 @@ func (h *HUOBI) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (h *HUOBI) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (h *HUOBI) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (h *HUOBI) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (h *HUOBI) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (h *HUOBI) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (h *HUOBI) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	accountID, err := strconv.ParseInt(clientID, 10, 64)
+	if err := h.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	accountID, err := strconv.ParseInt(order.ClientID, 10, 64)
 	var formattedType SpotNewOrderRequestParamsType
 	var params = SpotNewOrderRequestParams{
-		Amount:    amount,
+		Amount:    order.Amount,
 		Source:    "api",
-		Symbol:    common.StringToLower(p.Pair().String()),
+		Symbol:    common.StringToLower(order.Pair.Pair().String()),
 		AccountID: int(accountID),
 	}
 
-	if side == exchange.Buy && orderType == exchange.Market {
+	if order.Side == exchange.Buy && order.OrderType == exchange.Market {
 		formattedType = SpotNewOrderRequestTypeBuyMarket
-	} else if side == exchange.Sell && orderType == exchange.Market {
+	} else if order.Side == exchange.Sell && order.OrderType == exchange.Market {
 		formattedType = SpotNewOrderRequestTypeSellMarket
-	} else if side == exchange.Buy && orderType == exchange.Limit {
+	} else if order.Side == exchange.Buy && order.OrderType == exchange.Limit {
 		formattedType = SpotNewOrderRequestTypeBuyLimit
-		params.Price = price
-	} else if side == exchange.Sell && orderType == exchange.Limit {
+		params.Price = order.Price
+	} else if order.Side == exchange.Sell && order.OrderType == exchange.Limit {
 		formattedType = SpotNewOrderRequestTypeSellLimit
-		params.Price = price
+		params.Price = order.Price
 	} else {
 		return submitOrderResponse, errors.New("Unsupported order type")
 	}
 
 	params.Type = formattedType
 
-	response, err := h.SpotNewOrder(params)
+	response, err := h.SpotNewOrder(ctx, params)
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -214,48 +220,75 @@ func (h *HUOBI) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderT
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (h *HUOBI) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (h *HUOBI) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (h *HUOBI) CancelOrder(order exchange.OrderCancellation) error {
+func (h *HUOBI) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = h.CancelExistingOrder(orderIDInt)
+	_, err = h.CancelExistingOrder(ctx, orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (h *HUOBI) CancelAllOrders() error {
+func (h *HUOBI) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (h *HUOBI) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (h *HUOBI) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (h *HUOBI) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (h *HUOBI) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (h *HUOBI) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (h *HUOBI) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (h *HUOBI) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (h *HUOBI) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (h *HUOBI) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (h *HUOBI) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (h *HUOBI) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (h *HUOBI) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (h *HUOBI) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -279,3 +312,87 @@ func (h *HUOBI) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (h *HUOBI) GetWithdrawCapabilities() uint32 {
 	return h.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (h *HUOBI) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return h.getOrders(ctx, getOrdersRequest, "submitted,partial-filled")
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (h *HUOBI) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return h.getOrders(ctx, getOrdersRequest, "partial-canceled,filled,canceled")
+}
+
+// orderStatus maps HUOBI order states to order statuses
+var orderStatus = map[string]string{
+	"pre-submitted":    "PENDING",
+	"submitting":       "PENDING",
+	"submitted":        "NEW",
+	"partial-filled":   "PARTIALLY_FILLED",
+	"partial-canceled": "PARTIALLY_CANCELLED",
+	"filled":           "FILLED",
+	"canceled":         "CANCELLED",
+}
+
+// getOrders returns the orders in the states for each requested currency pair,
+// HUOBI requires a symbol so at least one currency pair must be requested
+func (h *HUOBI) getOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest, states string) ([]exchange.OrderDetail, error) {
+	if len(getOrdersRequest.Currencies) == 0 {
+		return nil, errors.New("at least one currency pair is required")
+	}
+
+	var start, end string
+	if !getOrdersRequest.StartTicks.IsZero() {
+		start = getOrdersRequest.StartTicks.Format("2006-01-02")
+	}
+	if !getOrdersRequest.EndTicks.IsZero() {
+		end = getOrdersRequest.EndTicks.Format("2006-01-02")
+	}
+
+	var orders []exchange.OrderDetail
+	for _, c := range getOrdersRequest.Currencies {
+		resp, err := h.GetOrders(ctx, exchange.FormatExchangeCurrency(h.Name, c).String(),
+			"", start, end, states, "", "", "")
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp {
+			price, _ := strconv.ParseFloat(resp[i].Price, 64)
+			amount, _ := strconv.ParseFloat(resp[i].Amount, 64)
+			executed, _ := strconv.ParseFloat(resp[i].FieldAmount, 64)
+
+			// Order types combine the side and type, for example buy-limit
+			side, orderType := resp[i].Type, ""
+			if split := common.SplitStrings(resp[i].Type, "-"); len(split) > 1 {
+				side, orderType = split[0], split[1]
+			}
+
+			orderDate := time.Unix(0, resp[i].CreatedAt*int64(time.Millisecond))
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:       h.Name,
+				ID:             strconv.Itoa(resp[i].ID),
+				BaseCurrency:   c.FirstCurrency.String(),
+				QuoteCurrency:  c.SecondCurrency.String(),
+				OrderSide:      side,
+				OrderType:      orderType,
+				CreationTime:   orderDate.Unix(),
+				OrderDate:      orderDate,
+				Status:         orderStatus[resp[i].State],
+				Price:          price,
+				Amount:         amount,
+				OpenVolume:     amount - executed,
+				ExecutedAmount: executed,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (h *HUOBI) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
@@ -2,6 +2,7 @@ package huobihadax
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -124,7 +125,7 @@ func (h *HUOBIHADAX) Setup(exch config.ExchangeConfig) {
 
 // GetSpotKline returns kline data
 // KlinesRequestParams holds the Kline request params
-func (h *HUOBIHADAX) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
+func (h *HUOBIHADAX) GetSpotKline(ctx context.Context, arg KlinesRequestParams) ([]KlineItem, error) {
 	vals := url.Values{}
 	vals.Set("symbol", arg.Symbol)
 	vals.Set("period", string(arg.Period))
@@ -141,7 +142,7 @@ func (h *HUOBIHADAX) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error)
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobihadaxMarketHistoryKline)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -149,7 +150,7 @@ func (h *HUOBIHADAX) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error)
 }
 
 // GetMarketDetailMerged returns the ticker for the specified symbol
-func (h *HUOBIHADAX) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
+func (h *HUOBIHADAX) GetMarketDetailMerged(ctx context.Context, symbol string) (DetailMerged, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -161,7 +162,7 @@ func (h *HUOBIHADAX) GetMarketDetailMerged(symbol string) (DetailMerged, error)
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobihadaxMarketDetailMerged)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Tick, errors.New(result.ErrorMessage)
 	}
@@ -169,7 +170,7 @@ func (h *HUOBIHADAX) GetMarketDetailMerged(symbol string) (DetailMerged, error)
 }
 
 // GetDepth returns the depth for the specified symbol
-func (h *HUOBIHADAX) GetDepth(symbol, depthType string) (Orderbook, error) {
+func (h *HUOBIHADAX) GetDepth(ctx context.Context, symbol, depthType string) (Orderbook, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -185,7 +186,7 @@ func (h *HUOBIHADAX) GetDepth(symbol, depthType string) (Orderbook, error) {
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobihadaxMarketDepth)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Depth, errors.New(result.ErrorMessage)
 	}
@@ -193,7 +194,7 @@ func (h *HUOBIHADAX) GetDepth(symbol, depthType string) (Orderbook, error) {
 }
 
 // GetTrades returns the trades for the specified symbol
-func (h *HUOBIHADAX) GetTrades(symbol string) ([]Trade, error) {
+func (h *HUOBIHADAX) GetTrades(ctx context.Context, symbol string) ([]Trade, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -207,7 +208,7 @@ func (h *HUOBIHADAX) GetTrades(symbol string) ([]Trade, error) {
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobihadaxMarketTrade)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -217,8 +218,8 @@ func (h *HUOBIHADAX) GetTrades(symbol string) ([]Trade, error) {
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
-func (h *HUOBIHADAX) GetLatestSpotPrice(symbol string) (float64, error) {
-	list, err := h.GetTradeHistory(symbol, "1")
+func (h *HUOBIHADAX) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	list, err := h.GetTradeHistory(ctx, symbol, "1")
 
 	if err != nil {
 		return 0, err
@@ -231,7 +232,7 @@ func (h *HUOBIHADAX) GetLatestSpotPrice(symbol string) (float64, error) {
 }
 
 // GetTradeHistory returns the trades for the specified symbol
-func (h *HUOBIHADAX) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
+func (h *HUOBIHADAX) GetTradeHistory(ctx context.Context, symbol, size string) ([]TradeHistory, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -247,7 +248,7 @@ func (h *HUOBIHADAX) GetTradeHistory(symbol, size string) ([]TradeHistory, error
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobihadaxMarketTradeHistory)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -255,7 +256,7 @@ func (h *HUOBIHADAX) GetTradeHistory(symbol, size string) ([]TradeHistory, error
 }
 
 // GetMarketDetail returns the ticker for the specified symbol
-func (h *HUOBIHADAX) GetMarketDetail(symbol string) (Detail, error) {
+func (h *HUOBIHADAX) GetMarketDetail(ctx context.Context, symbol string) (Detail, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -267,7 +268,7 @@ func (h *HUOBIHADAX) GetMarketDetail(symbol string) (Detail, error) {
 	var result response
 	url := fmt.Sprintf("%s/%s", h.APIUrl, huobihadaxMarketDetail)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(url, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(url, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Tick, errors.New(result.ErrorMessage)
 	}
@@ -275,7 +276,7 @@ func (h *HUOBIHADAX) GetMarketDetail(symbol string) (Detail, error) {
 }
 
 // GetSymbols returns an array of symbols supported by Huobi
-func (h *HUOBIHADAX) GetSymbols() ([]Symbol, error) {
+func (h *HUOBIHADAX) GetSymbols(ctx context.Context) ([]Symbol, error) {
 	type response struct {
 		Response
 		Symbols []Symbol `json:"data"`
@@ -284,7 +285,7 @@ func (h *HUOBIHADAX) GetSymbols() ([]Symbol, error) {
 	var result response
 	url := fmt.Sprintf("%s/v%s/%s/%s", h.APIUrl, huobihadaxAPIVersion, huobihadaxAPIName, huobihadaxSymbols)
 
-	err := h.SendHTTPRequest(url, &result)
+	err := h.SendHTTPRequest(ctx, url, &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -292,7 +293,7 @@ func (h *HUOBIHADAX) GetSymbols() ([]Symbol, error) {
 }
 
 // GetCurrencies returns a list of currencies supported by Huobi
-func (h *HUOBIHADAX) GetCurrencies() ([]string, error) {
+func (h *HUOBIHADAX) GetCurrencies(ctx context.Context) ([]string, error) {
 	type response struct {
 		Response
 		Currencies []string `json:"data"`
@@ -301,7 +302,7 @@ func (h *HUOBIHADAX) GetCurrencies() ([]string, error) {
 	var result response
 	url := fmt.Sprintf("%s/v%s/%s/%s", h.APIUrl, huobihadaxAPIVersion, huobihadaxAPIName, huobihadaxCurrencies)
 
-	err := h.SendHTTPRequest(url, &result)
+	err := h.SendHTTPRequest(ctx, url, &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -309,7 +310,7 @@ func (h *HUOBIHADAX) GetCurrencies() ([]string, error) {
 }
 
 // GetTimestamp returns the Huobi server time
-func (h *HUOBIHADAX) GetTimestamp() (int64, error) {
+func (h *HUOBIHADAX) GetTimestamp(ctx context.Context) (int64, error) {
 	type response struct {
 		Response
 		Timestamp int64 `json:"data"`
@@ -318,7 +319,7 @@ func (h *HUOBIHADAX) GetTimestamp() (int64, error) {
 	var result response
 	url := fmt.Sprintf("%s/v%s/%s", h.APIUrl, huobihadaxAPIVersion, huobihadaxTimestamp)
 
-	err := h.SendHTTPRequest(url, &result)
+	err := h.SendHTTPRequest(ctx, url, &result)
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
 	}
@@ -326,14 +327,14 @@ func (h *HUOBIHADAX) GetTimestamp() (int64, error) {
 }
 
 // GetAccounts returns the Huobi user accounts
-func (h *HUOBIHADAX) GetAccounts() ([]Account, error) {
+func (h *HUOBIHADAX) GetAccounts(ctx context.Context) ([]Account, error) {
 	type response struct {
 		Response
 		AccountData []Account `json:"data"`
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobihadaxAccounts, url.Values{}, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobihadaxAccounts, url.Values{}, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -342,7 +343,7 @@ func (h *HUOBIHADAX) GetAccounts() ([]Account, error) {
 }
 
 // GetAccountBalance returns the users Huobi account balance
-func (h *HUOBIHADAX) GetAccountBalance(accountID string) ([]AccountBalanceDetail, error) {
+func (h *HUOBIHADAX) GetAccountBalance(ctx context.Context, accountID string) ([]AccountBalanceDetail, error) {
 	type response struct {
 		Response
 		AccountBalanceData AccountBalance `json:"data"`
@@ -350,7 +351,7 @@ func (h *HUOBIHADAX) GetAccountBalance(accountID string) ([]AccountBalanceDetail
 
 	var result response
 	endpoint := fmt.Sprintf("%s/%s", huobihadaxAPIName, fmt.Sprintf(huobihadaxAccountBalance, accountID))
-	err := h.SendAuthenticatedHTTPRequest("GET", endpoint, url.Values{}, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", endpoint, url.Values{}, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -359,7 +360,7 @@ func (h *HUOBIHADAX) GetAccountBalance(accountID string) ([]AccountBalanceDetail
 }
 
 // SpotNewOrder submits an order to Huobi
-func (h *HUOBIHADAX) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
+func (h *HUOBIHADAX) SpotNewOrder(ctx context.Context, arg SpotNewOrderRequestParams) (int64, error) {
 	vals := make(map[string]string)
 	vals["account-id"] = fmt.Sprintf("%d", arg.AccountID)
 	vals["amount"] = strconv.FormatFloat(arg.Amount, 'f', -1, 64)
@@ -391,7 +392,7 @@ func (h *HUOBIHADAX) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error)
 
 	var result response
 	endpoint := fmt.Sprintf("%s/%s", huobihadaxAPIName, huobihadaxOrderPlace)
-	err := h.SendAuthenticatedHTTPPostRequest("POST", endpoint, postBodyParams, &result)
+	err := h.SendAuthenticatedHTTPPostRequest(ctx, "POST", endpoint, postBodyParams, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -400,7 +401,7 @@ func (h *HUOBIHADAX) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error)
 }
 
 // CancelExistingOrder cancels an order on Huobi
-func (h *HUOBIHADAX) CancelExistingOrder(orderID int64) (int64, error) {
+func (h *HUOBIHADAX) CancelExistingOrder(ctx context.Context, orderID int64) (int64, error) {
 	type response struct {
 		Response
 		OrderID int64 `json:"data,string"`
@@ -408,7 +409,7 @@ func (h *HUOBIHADAX) CancelExistingOrder(orderID int64) (int64, error) {
 
 	var result response
 	endpoint := fmt.Sprintf(huobihadaxOrderCancel, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest("POST", endpoint, url.Values{}, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", endpoint, url.Values{}, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -417,7 +418,7 @@ func (h *HUOBIHADAX) CancelExistingOrder(orderID int64) (int64, error) {
 }
 
 // CancelOrderBatch cancels a batch of orders -- to-do
-func (h *HUOBIHADAX) CancelOrderBatch(orderIDs []int64) (CancelOrderBatch, error) {
+func (h *HUOBIHADAX) CancelOrderBatch(ctx context.Context, orderIDs []int64) (CancelOrderBatch, error) {
 	type response struct {
 		Status string           `json:"status"`
 		Data   CancelOrderBatch `json:"data"`
@@ -433,7 +434,7 @@ func (h *HUOBIHADAX) CancelOrderBatch(orderIDs []int64) (CancelOrderBatch, error
 	postBodyParams := string(bytesParams)
 
 	var result response
-	err := h.SendAuthenticatedHTTPPostRequest("POST", huobihadaxOrderCancelBatch, postBodyParams, &result)
+	err := h.SendAuthenticatedHTTPPostRequest(ctx, "POST", huobihadaxOrderCancelBatch, postBodyParams, &result)
 
 	if len(result.Data.Failed) != 0 {
 		errJSON, _ := common.JSONEncode(result.Data.Failed)
@@ -443,7 +444,7 @@ func (h *HUOBIHADAX) CancelOrderBatch(orderIDs []int64) (CancelOrderBatch, error
 }
 
 // GetOrder returns order information for the specified order
-func (h *HUOBIHADAX) GetOrder(orderID int64) (OrderInfo, error) {
+func (h *HUOBIHADAX) GetOrder(ctx context.Context, orderID int64) (OrderInfo, error) {
 	type response struct {
 		Response
 		Order OrderInfo `json:"data"`
@@ -451,7 +452,7 @@ func (h *HUOBIHADAX) GetOrder(orderID int64) (OrderInfo, error) {
 
 	var result response
 	endpoint := fmt.Sprintf(huobihadaxGetOrder, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest("GET", endpoint, url.Values{}, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", endpoint, url.Values{}, &result)
 
 	if result.ErrorMessage != "" {
 		return result.Order, errors.New(result.ErrorMessage)
@@ -460,7 +461,7 @@ func (h *HUOBIHADAX) GetOrder(orderID int64) (OrderInfo, error) {
 }
 
 // GetOrderMatchResults returns matched order info for the specified order
-func (h *HUOBIHADAX) GetOrderMatchResults(orderID int64) ([]OrderMatchInfo, error) {
+func (h *HUOBIHADAX) GetOrderMatchResults(ctx context.Context, orderID int64) ([]OrderMatchInfo, error) {
 	type response struct {
 		Response
 		Orders []OrderMatchInfo `json:"data"`
@@ -468,7 +469,7 @@ func (h *HUOBIHADAX) GetOrderMatchResults(orderID int64) ([]OrderMatchInfo, erro
 
 	var result response
 	endpoint := fmt.Sprintf(huobihadaxGetOrderMatch, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest("GET", endpoint, url.Values{}, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", endpoint, url.Values{}, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -477,7 +478,7 @@ func (h *HUOBIHADAX) GetOrderMatchResults(orderID int64) ([]OrderMatchInfo, erro
 }
 
 // GetOrders returns a list of orders
-func (h *HUOBIHADAX) GetOrders(symbol, types, start, end, states, from, direct, size string) ([]OrderInfo, error) {
+func (h *HUOBIHADAX) GetOrders(ctx context.Context, symbol, types, start, end, states, from, direct, size string) ([]OrderInfo, error) {
 	type response struct {
 		Response
 		Orders []OrderInfo `json:"data"`
@@ -512,7 +513,7 @@ func (h *HUOBIHADAX) GetOrders(symbol, types, start, end, states, from, direct,
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobihadaxGetOrders, vals, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobihadaxGetOrders, vals, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -521,7 +522,7 @@ func (h *HUOBIHADAX) GetOrders(symbol, types, start, end, states, from, direct,
 }
 
 // GetOrdersMatch returns a list of matched orders
-func (h *HUOBIHADAX) GetOrdersMatch(symbol, types, start, end, from, direct, size string) ([]OrderMatchInfo, error) {
+func (h *HUOBIHADAX) GetOrdersMatch(ctx context.Context, symbol, types, start, end, from, direct, size string) ([]OrderMatchInfo, error) {
 	type response struct {
 		Response
 		Orders []OrderMatchInfo `json:"data"`
@@ -555,7 +556,7 @@ func (h *HUOBIHADAX) GetOrdersMatch(symbol, types, start, end, from, direct, siz
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobihadaxGetOrdersMatch, vals, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobihadaxGetOrdersMatch, vals, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -564,7 +565,7 @@ func (h *HUOBIHADAX) GetOrdersMatch(symbol, types, start, end, from, direct, siz
 }
 
 // MarginTransfer transfers assets into or out of the margin account
-func (h *HUOBIHADAX) MarginTransfer(symbol, currency string, amount float64, in bool) (int64, error) {
+func (h *HUOBIHADAX) MarginTransfer(ctx context.Context, symbol, currency string, amount float64, in bool) (int64, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	vals.Set("currency", currency)
@@ -581,7 +582,7 @@ func (h *HUOBIHADAX) MarginTransfer(symbol, currency string, amount float64, in
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("POST", path, vals, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", path, vals, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -590,7 +591,7 @@ func (h *HUOBIHADAX) MarginTransfer(symbol, currency string, amount float64, in
 }
 
 // MarginOrder submits a margin order application
-func (h *HUOBIHADAX) MarginOrder(symbol, currency string, amount float64) (int64, error) {
+func (h *HUOBIHADAX) MarginOrder(ctx context.Context, symbol, currency string, amount float64) (int64, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	vals.Set("currency", currency)
@@ -602,7 +603,7 @@ func (h *HUOBIHADAX) MarginOrder(symbol, currency string, amount float64) (int64
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("POST", huobihadaxMarginOrders, vals, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", huobihadaxMarginOrders, vals, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -611,7 +612,7 @@ func (h *HUOBIHADAX) MarginOrder(symbol, currency string, amount float64) (int64
 }
 
 // MarginRepayment repays a margin amount for a margin ID
-func (h *HUOBIHADAX) MarginRepayment(orderID int64, amount float64) (int64, error) {
+func (h *HUOBIHADAX) MarginRepayment(ctx context.Context, orderID int64, amount float64) (int64, error) {
 	vals := url.Values{}
 	vals.Set("order-id", strconv.FormatInt(orderID, 10))
 	vals.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
@@ -623,7 +624,7 @@ func (h *HUOBIHADAX) MarginRepayment(orderID int64, amount float64) (int64, erro
 
 	var result response
 	endpoint := fmt.Sprintf(huobihadaxMarginRepay, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest("POST", endpoint, vals, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", endpoint, vals, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -632,7 +633,7 @@ func (h *HUOBIHADAX) MarginRepayment(orderID int64, amount float64) (int64, erro
 }
 
 // GetMarginLoanOrders returns the margin loan orders
-func (h *HUOBIHADAX) GetMarginLoanOrders(symbol, currency, start, end, states, from, direct, size string) ([]MarginOrder, error) {
+func (h *HUOBIHADAX) GetMarginLoanOrders(ctx context.Context, symbol, currency, start, end, states, from, direct, size string) ([]MarginOrder, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	vals.Set("currency", currency)
@@ -667,7 +668,7 @@ func (h *HUOBIHADAX) GetMarginLoanOrders(symbol, currency, start, end, states, f
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobihadaxMarginLoanOrders, vals, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobihadaxMarginLoanOrders, vals, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -676,7 +677,7 @@ func (h *HUOBIHADAX) GetMarginLoanOrders(symbol, currency, start, end, states, f
 }
 
 // GetMarginAccountBalance returns the margin account balances
-func (h *HUOBIHADAX) GetMarginAccountBalance(symbol string) ([]MarginAccountBalance, error) {
+func (h *HUOBIHADAX) GetMarginAccountBalance(ctx context.Context, symbol string) ([]MarginAccountBalance, error) {
 	type response struct {
 		Response
 		Balances []MarginAccountBalance `json:"data"`
@@ -688,7 +689,7 @@ func (h *HUOBIHADAX) GetMarginAccountBalance(symbol string) ([]MarginAccountBala
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("GET", huobihadaxMarginAccountBalance, vals, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "GET", huobihadaxMarginAccountBalance, vals, &result)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -697,7 +698,7 @@ func (h *HUOBIHADAX) GetMarginAccountBalance(symbol string) ([]MarginAccountBala
 }
 
 // Withdraw withdraws the desired amount and currency
-func (h *HUOBIHADAX) Withdraw(address, currency, addrTag string, amount, fee float64) (int64, error) {
+func (h *HUOBIHADAX) Withdraw(ctx context.Context, address, currency, addrTag string, amount, fee float64) (int64, error) {
 	type response struct {
 		Response
 		WithdrawID int64 `json:"data"`
@@ -717,7 +718,7 @@ func (h *HUOBIHADAX) Withdraw(address, currency, addrTag string, amount, fee flo
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest("POST", huobihadaxWithdrawCreate, vals, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", huobihadaxWithdrawCreate, vals, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -726,7 +727,7 @@ func (h *HUOBIHADAX) Withdraw(address, currency, addrTag string, amount, fee flo
 }
 
 // CancelWithdraw cancels a withdraw request
-func (h *HUOBIHADAX) CancelWithdraw(withdrawID int64) (int64, error) {
+func (h *HUOBIHADAX) CancelWithdraw(ctx context.Context, withdrawID int64) (int64, error) {
 	type response struct {
 		Response
 		WithdrawID int64 `json:"data"`
@@ -737,7 +738,7 @@ func (h *HUOBIHADAX) CancelWithdraw(withdrawID int64) (int64, error) {
 
 	var result response
 	endpoint := fmt.Sprintf(huobihadaxWithdrawCancel, strconv.FormatInt(withdrawID, 10))
-	err := h.SendAuthenticatedHTTPRequest("POST", endpoint, vals, &result)
+	err := h.SendAuthenticatedHTTPRequest(ctx, "POST", endpoint, vals, &result)
 
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
@@ -746,12 +747,12 @@ func (h *HUOBIHADAX) CancelWithdraw(withdrawID int64) (int64, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (h *HUOBIHADAX) SendHTTPRequest(path string, result interface{}) error {
-	return h.SendPayload("GET", path, nil, nil, result, false, h.Verbose)
+func (h *HUOBIHADAX) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return h.SendPayload(ctx, "GET", path, nil, nil, result, false, h.Verbose)
 }
 
 // SendAuthenticatedHTTPPostRequest sends authenticated requests to the HUOBI API
-func (h *HUOBIHADAX) SendAuthenticatedHTTPPostRequest(method, endpoint, postBodyValues string, result interface{}) error {
+func (h *HUOBIHADAX) SendAuthenticatedHTTPPostRequest(ctx context.Context, method, endpoint, postBodyValues string, result interface{}) error {
 	if !h.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, h.Name)
 	}
@@ -776,11 +777,11 @@ func (h *HUOBIHADAX) SendAuthenticatedHTTPPostRequest(method, endpoint, postBody
 	url := fmt.Sprintf("%s%s", h.APIUrl, endpoint)
 	url = common.EncodeURLValues(url, signatureParams)
 
-	return h.SendPayload(method, url, headers, bytes.NewBufferString(postBodyValues), result, true, h.Verbose)
+	return h.SendPayload(ctx, method, url, headers, bytes.NewBufferString(postBodyValues), result, true, h.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends authenticated requests to the HUOBI API
-func (h *HUOBIHADAX) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Values, result interface{}) error {
+func (h *HUOBIHADAX) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, result interface{}) error {
 	if !h.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, h.Name)
 	}
@@ -803,7 +804,7 @@ func (h *HUOBIHADAX) SendAuthenticatedHTTPRequest(method, endpoint string, value
 	url := fmt.Sprintf("%s%s", h.APIUrl, endpoint)
 	url = common.EncodeURLValues(url, values)
 
-	return h.SendPayload(method, url, headers, bytes.NewBufferString(""), result, true, h.Verbose)
+	return h.SendPayload(ctx, method, url, headers, bytes.NewBufferString(""), result, true, h.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
@@ -2,6 +2,7 @@ package itbit
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -105,55 +106,56 @@ func (i *ItBit) Setup(exch config.ExchangeConfig) {
 
 // GetTicker returns ticker info for a specified market.
 // currencyPair - example "XBTUSD" "XBTSGD" "XBTEUR"
-func (i *ItBit) GetTicker(currencyPair string) (Ticker, error) {
+func (i *ItBit) GetTicker(ctx context.Context, currencyPair string) (Ticker, error) {
 	var response Ticker
 	path := fmt.Sprintf("%s/%s/%s/%s", i.APIUrl, itbitMarkets, currencyPair, itbitTicker)
 
-	return response, i.SendHTTPRequest(path, &response)
+	return response, i.SendHTTPRequest(ctx, path, &response)
 }
 
 // GetOrderbook returns full order book for the specified market.
 // currencyPair - example "XBTUSD" "XBTSGD" "XBTEUR"
-func (i *ItBit) GetOrderbook(currencyPair string) (OrderbookResponse, error) {
+func (i *ItBit) GetOrderbook(ctx context.Context, currencyPair string) (OrderbookResponse, error) {
 	response := OrderbookResponse{}
 	path := fmt.Sprintf("%s/%s/%s/%s", i.APIUrl, itbitMarkets, currencyPair, itbitOrderbook)
 
-	return response, i.SendHTTPRequest(path, &response)
+	return response, i.SendHTTPRequest(ctx, path, &response)
 }
 
 // GetTradeHistory returns recent trades for a specified market.
 //
 // currencyPair - example "XBTUSD" "XBTSGD" "XBTEUR"
 // timestamp - matchNumber, only executions after this will be returned
-func (i *ItBit) GetTradeHistory(currencyPair, timestamp string) (Trades, error) {
+func (i *ItBit) GetTradeHistory(ctx context.Context, currencyPair, timestamp string) (Trades, error) {
 	response := Trades{}
 	req := "trades?since=" + timestamp
 	path := fmt.Sprintf("%s/%s/%s/%s", i.APIUrl, itbitMarkets, currencyPair, req)
 
-	return response, i.SendHTTPRequest(path, &response)
+	return response, i.SendHTTPRequest(ctx, path, &response)
 }
 
 // GetWallets returns information about all wallets associated with the account.
 //
 // params --
-// 					page - [optional] page to return example 1. default 1
-//					perPage - [optional] items per page example 50, default 50 max 50
-func (i *ItBit) GetWallets(params url.Values) ([]Wallet, error) {
+//
+//	page - [optional] page to return example 1. default 1
+//	perPage - [optional] items per page example 50, default 50 max 50
+func (i *ItBit) GetWallets(ctx context.Context, params url.Values) ([]Wallet, error) {
 	resp := []Wallet{}
 	params.Set("userId", i.ClientID)
 	path := fmt.Sprintf("/%s?%s", itbitWallets, params.Encode())
 
-	return resp, i.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	return resp, i.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 }
 
 // CreateWallet creates a new wallet with a specified name.
-func (i *ItBit) CreateWallet(walletName string) (Wallet, error) {
+func (i *ItBit) CreateWallet(ctx context.Context, walletName string) (Wallet, error) {
 	resp := Wallet{}
 	params := make(map[string]interface{})
 	params["userId"] = i.ClientID
 	params["name"] = walletName
 
-	err := i.SendAuthenticatedHTTPRequest("POST", "/"+itbitWallets, params, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, "POST", "/"+itbitWallets, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -164,11 +166,11 @@ func (i *ItBit) CreateWallet(walletName string) (Wallet, error) {
 }
 
 // GetWallet returns wallet information by walletID
-func (i *ItBit) GetWallet(walletID string) (Wallet, error) {
+func (i *ItBit) GetWallet(ctx context.Context, walletID string) (Wallet, error) {
 	resp := Wallet{}
 	path := fmt.Sprintf("/%s/%s", itbitWallets, walletID)
 
-	err := i.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -180,11 +182,11 @@ func (i *ItBit) GetWallet(walletID string) (Wallet, error) {
 
 // GetWalletBalance returns balance information for a specific currency in a
 // wallet.
-func (i *ItBit) GetWalletBalance(walletID, currency string) (Balance, error) {
+func (i *ItBit) GetWalletBalance(ctx context.Context, walletID, currency string) (Balance, error) {
 	resp := Balance{}
 	path := fmt.Sprintf("/%s/%s/%s/%s", itbitWallets, walletID, itbitBalances, currency)
 
-	err := i.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -195,12 +197,12 @@ func (i *ItBit) GetWalletBalance(walletID, currency string) (Balance, error) {
 }
 
 // GetWalletTrades returns all trades for a specified wallet.
-func (i *ItBit) GetWalletTrades(walletID string, params url.Values) (Records, error) {
+func (i *ItBit) GetWalletTrades(ctx context.Context, walletID string, params url.Values) (Records, error) {
 	resp := Records{}
 	url := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitTrades)
 	path := common.EncodeURLValues(url, params)
 
-	err := i.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -211,12 +213,12 @@ func (i *ItBit) GetWalletTrades(walletID string, params url.Values) (Records, er
 }
 
 // GetFundingHistoryForWallet returns all funding history for a specified wallet.
-func (i *ItBit) GetFundingHistoryForWallet(walletID string, params url.Values) (FundingRecords, error) {
+func (i *ItBit) GetFundingHistoryForWallet(ctx context.Context, walletID string, params url.Values) (FundingRecords, error) {
 	resp := FundingRecords{}
 	url := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitFundingHistory)
 	path := common.EncodeURLValues(url, params)
 
-	err := i.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -227,7 +229,7 @@ func (i *ItBit) GetFundingHistoryForWallet(walletID string, params url.Values) (
 }
 
 // PlaceOrder places a new order
-func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, price float64, instrument, clientRef string) (Order, error) {
+func (i *ItBit) PlaceOrder(ctx context.Context, walletID, side, orderType, currency string, amount, price float64, instrument, clientRef string) (Order, error) {
 	resp := Order{}
 	path := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitOrders)
 
@@ -243,7 +245,7 @@ func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, p
 		params["clientOrderIdentifier"] = clientRef
 	}
 
-	err := i.SendAuthenticatedHTTPRequest("POST", path, params, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, "POST", path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -254,12 +256,12 @@ func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, p
 }
 
 // GetOrder returns an order by id.
-func (i *ItBit) GetOrder(walletID string, params url.Values) (Order, error) {
+func (i *ItBit) GetOrder(ctx context.Context, walletID string, params url.Values) (Order, error) {
 	resp := Order{}
 	url := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitOrders)
 	path := common.EncodeURLValues(url, params)
 
-	err := i.SendAuthenticatedHTTPRequest("GET", path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, "GET", path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -271,20 +273,20 @@ func (i *ItBit) GetOrder(walletID string, params url.Values) (Order, error) {
 
 // CancelExistingOrder cancels and open order. *This is not a guarantee that the
 // order has been cancelled!*
-func (i *ItBit) CancelExistingOrder(walletID, orderID string) error {
+func (i *ItBit) CancelExistingOrder(ctx context.Context, walletID, orderID string) error {
 	path := fmt.Sprintf("/%s/%s/%s/%s", itbitWallets, walletID, itbitOrders, orderID)
 
-	return i.SendAuthenticatedHTTPRequest("DELETE", path, nil, nil)
+	return i.SendAuthenticatedHTTPRequest(ctx, "DELETE", path, nil, nil)
 }
 
 // GetCryptoDepositAddress returns a deposit address to send cryptocurrency to.
-func (i *ItBit) GetCryptoDepositAddress(walletID, currency string) (CryptoCurrencyDeposit, error) {
+func (i *ItBit) GetCryptoDepositAddress(ctx context.Context, walletID, currency string) (CryptoCurrencyDeposit, error) {
 	resp := CryptoCurrencyDeposit{}
 	path := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitCryptoDeposits)
 	params := make(map[string]interface{})
 	params["currency"] = currency
 
-	err := i.SendAuthenticatedHTTPRequest("POST", path, params, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, "POST", path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -295,7 +297,7 @@ func (i *ItBit) GetCryptoDepositAddress(walletID, currency string) (CryptoCurren
 }
 
 // WalletTransfer transfers funds between wallets.
-func (i *ItBit) WalletTransfer(walletID, sourceWallet, destWallet string, amount float64, currency string) (WalletTransfer, error) {
+func (i *ItBit) WalletTransfer(ctx context.Context, walletID, sourceWallet, destWallet string, amount float64, currency string) (WalletTransfer, error) {
 	resp := WalletTransfer{}
 	path := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitWalletTransfer)
 
@@ -305,7 +307,7 @@ func (i *ItBit) WalletTransfer(walletID, sourceWallet, destWallet string, amount
 	params["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
 	params["currencyCode"] = currency
 
-	err := i.SendAuthenticatedHTTPRequest("POST", path, params, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, "POST", path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -316,12 +318,12 @@ func (i *ItBit) WalletTransfer(walletID, sourceWallet, destWallet string, amount
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (i *ItBit) SendHTTPRequest(path string, result interface{}) error {
-	return i.SendPayload("GET", path, nil, nil, result, false, i.Verbose)
+func (i *ItBit) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return i.SendPayload(ctx, "GET", path, nil, nil, result, false, i.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated request to itBit
-func (i *ItBit) SendAuthenticatedHTTPRequest(method string, path string, params map[string]interface{}, result interface{}) error {
+func (i *ItBit) SendAuthenticatedHTTPRequest(ctx context.Context, method string, path string, params map[string]interface{}, result interface{}) error {
 	if !i.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, i.Name)
 	}
@@ -368,7 +370,7 @@ func (i *ItBit) SendAuthenticatedHTTPRequest(method string, path string, params
 	headers["X-Auth-Nonce"] = nonce
 	headers["Content-Type"] = "application/json"
 
-	return i.SendPayload(method, url, headers, bytes.NewBuffer([]byte(PayloadJSON)), result, true, i.Verbose)
+	return i.SendPayload(ctx, method, url, headers, bytes.NewBuffer([]byte(PayloadJSON)), result, true, i.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
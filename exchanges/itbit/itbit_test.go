@@ -1,6 +1,7 @@
 package itbit
 
 import (
+	"context"
 	"net/url"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var i ItBit
@@ -20,6 +22,12 @@ const (
 	canManipulateRealOrders = false
 )
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	i.SetDefaults()
 }
@@ -42,7 +50,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := i.GetTicker("XBTUSD")
+	_, err := i.GetTicker(context.Background(), "XBTUSD")
 	if err != nil {
 		t.Error("Test Failed - GetTicker() error", err)
 	}
@@ -50,7 +58,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := i.GetOrderbook("XBTSGD")
+	_, err := i.GetOrderbook(context.Background(), "XBTSGD")
 	if err != nil {
 		t.Error("Test Failed - GetOrderbook() error", err)
 	}
@@ -58,63 +66,63 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := i.GetTradeHistory("XBTUSD", "0")
+	_, err := i.GetTradeHistory(context.Background(), "XBTUSD", "0")
 	if err != nil {
 		t.Error("Test Failed - GetTradeHistory() error", err)
 	}
 }
 
 func TestGetWallets(t *testing.T) {
-	_, err := i.GetWallets(url.Values{})
+	_, err := i.GetWallets(context.Background(), url.Values{})
 	if err == nil {
 		t.Error("Test Failed - GetWallets() error", err)
 	}
 }
 
 func TestCreateWallet(t *testing.T) {
-	_, err := i.CreateWallet("test")
+	_, err := i.CreateWallet(context.Background(), "test")
 	if err == nil {
 		t.Error("Test Failed - CreateWallet() error", err)
 	}
 }
 
 func TestGetWallet(t *testing.T) {
-	_, err := i.GetWallet("1337")
+	_, err := i.GetWallet(context.Background(), "1337")
 	if err == nil {
 		t.Error("Test Failed - GetWallet() error", err)
 	}
 }
 
 func TestGetWalletBalance(t *testing.T) {
-	_, err := i.GetWalletBalance("1337", "XRT")
+	_, err := i.GetWalletBalance(context.Background(), "1337", "XRT")
 	if err == nil {
 		t.Error("Test Failed - GetWalletBalance() error", err)
 	}
 }
 
 func TestGetWalletTrades(t *testing.T) {
-	_, err := i.GetWalletTrades("1337", url.Values{})
+	_, err := i.GetWalletTrades(context.Background(), "1337", url.Values{})
 	if err == nil {
 		t.Error("Test Failed - GetWalletTrades() error", err)
 	}
 }
 
 func TestGetFundingHistory(t *testing.T) {
-	_, err := i.GetFundingHistoryForWallet("1337", url.Values{})
+	_, err := i.GetFundingHistoryForWallet(context.Background(), "1337", url.Values{})
 	if err == nil {
 		t.Error("Test Failed - GetFundingHistory() error", err)
 	}
 }
 
 func TestPlaceOrder(t *testing.T) {
-	_, err := i.PlaceOrder("1337", "buy", "limit", "USD", 1, 0.2, "banjo", "sauce")
+	_, err := i.PlaceOrder(context.Background(), "1337", "buy", "limit", "USD", 1, 0.2, "banjo", "sauce")
 	if err == nil {
 		t.Error("Test Failed - PlaceOrder() error", err)
 	}
 }
 
 func TestGetOrder(t *testing.T) {
-	_, err := i.GetOrder("1337", url.Values{})
+	_, err := i.GetOrder(context.Background(), "1337", url.Values{})
 	if err == nil {
 		t.Error("Test Failed - GetOrder() error", err)
 	}
@@ -122,21 +130,21 @@ func TestGetOrder(t *testing.T) {
 
 func TestCancelExistingOrder(t *testing.T) {
 	t.Skip()
-	err := i.CancelExistingOrder("1337", "1337order")
+	err := i.CancelExistingOrder(context.Background(), "1337", "1337order")
 	if err == nil {
 		t.Error("Test Failed - CancelOrder() error", err)
 	}
 }
 
 func TestGetCryptoDepositAddress(t *testing.T) {
-	_, err := i.GetCryptoDepositAddress("1337", "AUD")
+	_, err := i.GetCryptoDepositAddress(context.Background(), "1337", "AUD")
 	if err == nil {
 		t.Error("Test Failed - GetCryptoDepositAddress() error", err)
 	}
 }
 
 func TestWalletTransfer(t *testing.T) {
-	_, err := i.WalletTransfer("1337", "mywallet", "anotherwallet", 200, "USD")
+	_, err := i.WalletTransfer(context.Background(), "1337", "mywallet", "anotherwallet", 200, "USD")
 	if err == nil {
 		t.Error("Test Failed - WalletTransfer() error", err)
 	}
@@ -269,7 +277,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USDT,
 	}
-	response, err := i.SubmitOrder(p, exchange.Buy, exchange.Limit, 1, 10, "hi")
+	response, err := i.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -295,7 +303,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := i.CancelOrder(orderCancellation)
+	err := i.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
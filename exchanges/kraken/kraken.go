@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -8,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/exchanges"
@@ -42,14 +44,31 @@ const (
 	krakenWithdrawInfo   = "WithdrawInfo"
 	krakenDepositMethods = "DepositMethods"
 
-	krakenAuthRate   = 0
-	krakenUnauthRate = 0
+	krakenAuthRate    = 0
+	krakenUnauthRate  = 1
+	krakenUnauthBurst = 5
+
+	// krakenAPICounter is the private API call counter, which holds up to 15
+	// calls and decreases by one every 3 seconds. Ledger and trade history
+	// calls count twice and orders are limited separately by the matching
+	// engine
+	krakenAPICounter         = "apiCounter"
+	krakenAPICounterMax      = 15
+	krakenAPICounterInterval = 3 * time.Second
+
+	// krakenMaxOHLCLimit is the maximum amount of OHLC periods returned per
+	// request
+	krakenMaxOHLCLimit = 720
 )
 
 // Kraken is the overarching type across the alphapoint package
 type Kraken struct {
 	exchange.Base
+	WebsocketConn      *websocket.Conn
 	CryptoFee, FiatFee float64
+
+	// wsDecimals holds the precision of the websocket books for checksums
+	wsDecimals map[string]wsDecimals
 }
 
 // SetDefaults sets current default settings
@@ -61,6 +80,14 @@ func (k *Kraken) SetDefaults() {
 	k.Verbose = false
 	k.RESTPollingDelay = 10
 	k.APIWithdrawPermissions = exchange.AutoWithdrawCryptoWithSetup | exchange.WithdrawCryptoWith2FA | exchange.AutoWithdrawFiatWithSetup | exchange.WithdrawFiatWith2FA
+	k.OrderSupport = exchange.OrderSupport{
+		OrderTypes: []exchange.OrderType{exchange.Limit, exchange.Market,
+			exchange.Stop, exchange.StopLimit, exchange.TakeProfit,
+			exchange.TakeProfitLimit, exchange.TrailingStop},
+		TimeInForce: []exchange.TimeInForce{exchange.GoodTillCancel,
+			exchange.GoodTillDate},
+		PostOnly: true,
+	}
 	k.RequestCurrencyPairFormat.Delimiter = ""
 	k.RequestCurrencyPairFormat.Uppercase = true
 	k.RequestCurrencyPairFormat.Separator = ","
@@ -71,8 +98,10 @@ func (k *Kraken) SetDefaults() {
 	k.SupportsRESTTickerBatching = true
 	k.Requester = request.New(k.Name,
 		request.NewRateLimit(time.Second, krakenAuthRate),
-		request.NewRateLimit(time.Second, krakenUnauthRate),
+		request.NewRateLimitWithBurst(time.Second, krakenUnauthRate, krakenUnauthBurst),
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout))
+	k.Requester.AddRateLimit(krakenAPICounter,
+		request.NewRateLimitWithBurst(krakenAPICounterInterval, 1, krakenAPICounterMax))
 	k.APIUrlDefault = krakenAPIURL
 	k.APIUrl = k.APIUrlDefault
 	k.WebsocketInit()
@@ -113,11 +142,19 @@ func (k *Kraken) Setup(exch config.ExchangeConfig) {
 		if err != nil {
 			log.Fatal(err)
 		}
+		err = k.WebsocketSetup(k.WsConnect,
+			exch.Name,
+			exch.Websocket,
+			krakenWebsocketURL,
+			exch.WebsocketURL)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
 }
 
 // GetServerTime returns current server time
-func (k *Kraken) GetServerTime() (TimeResponse, error) {
+func (k *Kraken) GetServerTime(ctx context.Context) (TimeResponse, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.APIUrl, krakenAPIVersion, krakenServerTime)
 
 	var response struct {
@@ -125,7 +162,7 @@ func (k *Kraken) GetServerTime() (TimeResponse, error) {
 		Result TimeResponse `json:"result"`
 	}
 
-	if err := k.SendHTTPRequest(path, &response); err != nil {
+	if err := k.SendHTTPRequest(ctx, path, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -133,7 +170,7 @@ func (k *Kraken) GetServerTime() (TimeResponse, error) {
 }
 
 // GetAssets returns a full asset list
-func (k *Kraken) GetAssets() (map[string]Asset, error) {
+func (k *Kraken) GetAssets(ctx context.Context) (map[string]Asset, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.APIUrl, krakenAPIVersion, krakenAssets)
 
 	var response struct {
@@ -141,7 +178,7 @@ func (k *Kraken) GetAssets() (map[string]Asset, error) {
 		Result map[string]Asset `json:"result"`
 	}
 
-	if err := k.SendHTTPRequest(path, &response); err != nil {
+	if err := k.SendHTTPRequest(ctx, path, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -149,7 +186,7 @@ func (k *Kraken) GetAssets() (map[string]Asset, error) {
 }
 
 // GetAssetPairs returns a full asset pair list
-func (k *Kraken) GetAssetPairs() (map[string]AssetPairs, error) {
+func (k *Kraken) GetAssetPairs(ctx context.Context) (map[string]AssetPairs, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.APIUrl, krakenAPIVersion, krakenAssetPairs)
 
 	var response struct {
@@ -157,7 +194,7 @@ func (k *Kraken) GetAssetPairs() (map[string]AssetPairs, error) {
 		Result map[string]AssetPairs `json:"result"`
 	}
 
-	if err := k.SendHTTPRequest(path, &response); err != nil {
+	if err := k.SendHTTPRequest(ctx, path, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -165,7 +202,7 @@ func (k *Kraken) GetAssetPairs() (map[string]AssetPairs, error) {
 }
 
 // GetTicker returns ticker information from kraken
-func (k *Kraken) GetTicker(symbol string) (Ticker, error) {
+func (k *Kraken) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	ticker := Ticker{}
 	values := url.Values{}
 	values.Set("pair", symbol)
@@ -178,7 +215,7 @@ func (k *Kraken) GetTicker(symbol string) (Ticker, error) {
 	resp := Response{}
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.APIUrl, krakenAPIVersion, krakenTicker, values.Encode())
 
-	err := k.SendHTTPRequest(path, &resp)
+	err := k.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return ticker, err
 	}
@@ -204,7 +241,7 @@ func (k *Kraken) GetTicker(symbol string) (Ticker, error) {
 // GetTickers supports fetching multiple tickers from Kraken
 // pairList must be in the format pairs separated by commas
 // ("LTCUSD,ETCUSD")
-func (k *Kraken) GetTickers(pairList string) (Tickers, error) {
+func (k *Kraken) GetTickers(ctx context.Context, pairList string) (Tickers, error) {
 	values := url.Values{}
 	values.Set("pair", pairList)
 
@@ -216,7 +253,7 @@ func (k *Kraken) GetTickers(pairList string) (Tickers, error) {
 	resp := Response{}
 	path := fmt.Sprintf("%s/%s/public/%s?%s", krakenAPIURL, krakenAPIVersion, krakenTicker, values.Encode())
 
-	err := k.SendHTTPRequest(path, &resp)
+	err := k.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -244,10 +281,21 @@ func (k *Kraken) GetTickers(pairList string) (Tickers, error) {
 }
 
 // GetOHLC returns an array of open high low close values of a currency pair
-func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
+// the optional OHLCOptions sets the interval in minutes and the since time
+func (k *Kraken) GetOHLC(ctx context.Context, symbol string, args ...OHLCOptions) ([]OpenHighLowClose, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
+	if args != nil {
+		if args[0].Interval != 0 {
+			values.Set("interval", strconv.FormatInt(args[0].Interval, 10))
+		}
+
+		if args[0].Since != 0 {
+			values.Set("since", strconv.FormatInt(args[0].Since, 10))
+		}
+	}
+
 	type Response struct {
 		Error []interface{}          `json:"error"`
 		Data  map[string]interface{} `json:"result"`
@@ -258,7 +306,7 @@ func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.APIUrl, krakenAPIVersion, krakenOHLC, values.Encode())
 
-	err := k.SendHTTPRequest(path, &result)
+	err := k.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return OHLC, err
 	}
@@ -267,7 +315,19 @@ func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
 		return OHLC, fmt.Errorf("GetOHLC error: %s", result.Error)
 	}
 
-	for _, y := range result.Data[symbol].([]interface{}) {
+	// The result is keyed by Krakens internal pair name which can differ from
+	// the requested symbol, alongside a "last" value for polling
+	var data []interface{}
+	for key, value := range result.Data {
+		if key == "last" {
+			continue
+		}
+		if d, ok := value.([]interface{}); ok {
+			data = d
+		}
+	}
+
+	for _, y := range data {
 		o := OpenHighLowClose{}
 		for i, x := range y.([]interface{}) {
 			switch i {
@@ -295,7 +355,7 @@ func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
 }
 
 // GetDepth returns the orderbook for a particular currency
-func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
+func (k *Kraken) GetDepth(ctx context.Context, symbol string) (Orderbook, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -304,7 +364,7 @@ func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.APIUrl, krakenAPIVersion, krakenDepth, values.Encode())
 
-	err := k.SendHTTPRequest(path, &result)
+	err := k.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return orderBook, err
 	}
@@ -354,7 +414,7 @@ func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
 }
 
 // GetTrades returns current trades on Kraken
-func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
+func (k *Kraken) GetTrades(ctx context.Context, symbol string) ([]RecentTrades, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -363,7 +423,7 @@ func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.APIUrl, krakenAPIVersion, krakenTrades, values.Encode())
 
-	err := k.SendHTTPRequest(path, &result)
+	err := k.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return recentTrades, err
 	}
@@ -395,7 +455,7 @@ func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
 }
 
 // GetSpread returns the full spread on Kraken
-func (k *Kraken) GetSpread(symbol string) ([]Spread, error) {
+func (k *Kraken) GetSpread(ctx context.Context, symbol string) ([]Spread, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -404,7 +464,7 @@ func (k *Kraken) GetSpread(symbol string) ([]Spread, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.APIUrl, krakenAPIVersion, krakenSpread, values.Encode())
 
-	err := k.SendHTTPRequest(path, &response)
+	err := k.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return peanutButter, err
 	}
@@ -430,13 +490,13 @@ func (k *Kraken) GetSpread(symbol string) ([]Spread, error) {
 }
 
 // GetBalance returns your balance associated with your keys
-func (k *Kraken) GetBalance() (map[string]float64, error) {
+func (k *Kraken) GetBalance(ctx context.Context) (map[string]float64, error) {
 	var response struct {
 		Error  []string          `json:"error"`
 		Result map[string]string `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenBalance, url.Values{}, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenBalance, url.Values{}, &response); err != nil {
 		return nil, err
 	}
 
@@ -452,7 +512,7 @@ func (k *Kraken) GetBalance() (map[string]float64, error) {
 }
 
 // GetWithdrawInfo gets withdrawal fees
-func (k *Kraken) GetWithdrawInfo(currency string, amount float64) (WithdrawInformation, error) {
+func (k *Kraken) GetWithdrawInfo(ctx context.Context, currency string, amount float64) (WithdrawInformation, error) {
 	var response struct {
 		Error  []string            `json:"error"`
 		Result WithdrawInformation `json:"result"`
@@ -462,7 +522,7 @@ func (k *Kraken) GetWithdrawInfo(currency string, amount float64) (WithdrawInfor
 	params.Set("key  ", "")
 	params.Set("amount ", fmt.Sprintf("%f", amount))
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenWithdrawInfo, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWithdrawInfo, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -470,7 +530,7 @@ func (k *Kraken) GetWithdrawInfo(currency string, amount float64) (WithdrawInfor
 }
 
 // GetDepositMethods gets withdrawal fees
-func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
+func (k *Kraken) GetDepositMethods(ctx context.Context, currency string) ([]DepositMethods, error) {
 	var response struct {
 		Error  []string         `json:"error"`
 		Result []DepositMethods `json:"result"`
@@ -478,7 +538,7 @@ func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
 	params := url.Values{}
 	params.Set("asset ", currency)
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenDepositMethods, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenDepositMethods, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -486,7 +546,7 @@ func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
 }
 
 // GetTradeBalance returns full information about your trades on Kraken
-func (k *Kraken) GetTradeBalance(args ...TradeBalanceOptions) (TradeBalanceInfo, error) {
+func (k *Kraken) GetTradeBalance(ctx context.Context, args ...TradeBalanceOptions) (TradeBalanceInfo, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -505,7 +565,7 @@ func (k *Kraken) GetTradeBalance(args ...TradeBalanceOptions) (TradeBalanceInfo,
 		Result TradeBalanceInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenTradeBalance, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenTradeBalance, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -513,7 +573,7 @@ func (k *Kraken) GetTradeBalance(args ...TradeBalanceOptions) (TradeBalanceInfo,
 }
 
 // GetOpenOrders returns all current open orders
-func (k *Kraken) GetOpenOrders(args ...OrderInfoOptions) (OpenOrders, error) {
+func (k *Kraken) GetOpenOrders(ctx context.Context, args ...OrderInfoOptions) (OpenOrders, error) {
 	params := url.Values{}
 
 	if args[0].Trades {
@@ -529,7 +589,7 @@ func (k *Kraken) GetOpenOrders(args ...OrderInfoOptions) (OpenOrders, error) {
 		Result OpenOrders `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOpenOrders, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOpenOrders, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -537,7 +597,7 @@ func (k *Kraken) GetOpenOrders(args ...OrderInfoOptions) (OpenOrders, error) {
 }
 
 // GetClosedOrders returns a list of closed orders
-func (k *Kraken) GetClosedOrders(args ...GetClosedOrdersOptions) (ClosedOrders, error) {
+func (k *Kraken) GetClosedOrders(ctx context.Context, args ...GetClosedOrdersOptions) (ClosedOrders, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -571,7 +631,7 @@ func (k *Kraken) GetClosedOrders(args ...GetClosedOrdersOptions) (ClosedOrders,
 		Result ClosedOrders `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenClosedOrders, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenClosedOrders, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -579,7 +639,7 @@ func (k *Kraken) GetClosedOrders(args ...GetClosedOrdersOptions) (ClosedOrders,
 }
 
 // QueryOrdersInfo returns order information
-func (k *Kraken) QueryOrdersInfo(args OrderInfoOptions, txid string, txids ...string) (map[string]OrderInfo, error) {
+func (k *Kraken) QueryOrdersInfo(ctx context.Context, args OrderInfoOptions, txid string, txids ...string) (map[string]OrderInfo, error) {
 	params := url.Values{
 		"txid": {txid},
 	}
@@ -601,7 +661,7 @@ func (k *Kraken) QueryOrdersInfo(args OrderInfoOptions, txid string, txids ...st
 		Result map[string]OrderInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenQueryOrders, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenQueryOrders, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -609,7 +669,7 @@ func (k *Kraken) QueryOrdersInfo(args OrderInfoOptions, txid string, txids ...st
 }
 
 // GetTradesHistory returns trade history information
-func (k *Kraken) GetTradesHistory(args ...GetTradesHistoryOptions) (TradesHistory, error) {
+func (k *Kraken) GetTradesHistory(ctx context.Context, args ...GetTradesHistoryOptions) (TradesHistory, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -639,7 +699,7 @@ func (k *Kraken) GetTradesHistory(args ...GetTradesHistoryOptions) (TradesHistor
 		Result TradesHistory `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenTradeHistory, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenTradeHistory, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -647,7 +707,7 @@ func (k *Kraken) GetTradesHistory(args ...GetTradesHistoryOptions) (TradesHistor
 }
 
 // QueryTrades returns information on a specific trade
-func (k *Kraken) QueryTrades(trades bool, txid string, txids ...string) (map[string]TradeInfo, error) {
+func (k *Kraken) QueryTrades(ctx context.Context, trades bool, txid string, txids ...string) (map[string]TradeInfo, error) {
 	params := url.Values{
 		"txid": {txid},
 	}
@@ -665,7 +725,7 @@ func (k *Kraken) QueryTrades(trades bool, txid string, txids ...string) (map[str
 		Result map[string]TradeInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenQueryTrades, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenQueryTrades, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -673,7 +733,7 @@ func (k *Kraken) QueryTrades(trades bool, txid string, txids ...string) (map[str
 }
 
 // OpenPositions returns current open positions
-func (k *Kraken) OpenPositions(docalcs bool, txids ...string) (map[string]Position, error) {
+func (k *Kraken) OpenPositions(ctx context.Context, docalcs bool, txids ...string) (map[string]Position, error) {
 	params := url.Values{}
 
 	if txids != nil {
@@ -689,7 +749,7 @@ func (k *Kraken) OpenPositions(docalcs bool, txids ...string) (map[string]Positi
 		Result map[string]Position `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOpenPositions, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOpenPositions, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -697,7 +757,7 @@ func (k *Kraken) OpenPositions(docalcs bool, txids ...string) (map[string]Positi
 }
 
 // GetLedgers returns current ledgers
-func (k *Kraken) GetLedgers(args ...GetLedgersOptions) (Ledgers, error) {
+func (k *Kraken) GetLedgers(ctx context.Context, args ...GetLedgersOptions) (Ledgers, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -731,7 +791,7 @@ func (k *Kraken) GetLedgers(args ...GetLedgersOptions) (Ledgers, error) {
 		Result Ledgers  `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenLedgers, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenLedgers, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -739,7 +799,7 @@ func (k *Kraken) GetLedgers(args ...GetLedgersOptions) (Ledgers, error) {
 }
 
 // QueryLedgers queries an individual ledger by ID
-func (k *Kraken) QueryLedgers(id string, ids ...string) (map[string]LedgerInfo, error) {
+func (k *Kraken) QueryLedgers(ctx context.Context, id string, ids ...string) (map[string]LedgerInfo, error) {
 	params := url.Values{
 		"id": {id},
 	}
@@ -753,7 +813,7 @@ func (k *Kraken) QueryLedgers(id string, ids ...string) (map[string]LedgerInfo,
 		Result map[string]LedgerInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenQueryLedgers, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenQueryLedgers, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -761,7 +821,7 @@ func (k *Kraken) QueryLedgers(id string, ids ...string) (map[string]LedgerInfo,
 }
 
 // GetTradeVolume returns your trade volume by currency
-func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResponse, error) {
+func (k *Kraken) GetTradeVolume(ctx context.Context, feeinfo bool, symbol ...string) (TradeVolumeResponse, error) {
 	params := url.Values{}
 
 	if symbol != nil {
@@ -777,7 +837,7 @@ func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResp
 		Result TradeVolumeResponse `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenTradeVolume, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenTradeVolume, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -785,7 +845,7 @@ func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResp
 }
 
 // AddOrder adds a new order for Kraken exchange
-func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2, leverage float64, args AddOrderOptions) (AddOrderResponse, error) {
+func (k *Kraken) AddOrder(ctx context.Context, symbol, side, orderType string, volume, price, price2, leverage float64, args AddOrderOptions) (AddOrderResponse, error) {
 	params := url.Values{
 		"pair":      {symbol},
 		"type":      {common.StringToLower(side)},
@@ -838,7 +898,7 @@ func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2,
 		Result AddOrderResponse `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOrderPlace, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOrderPlace, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -846,7 +906,7 @@ func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2,
 }
 
 // CancelExistingOrder cancels order by orderID
-func (k *Kraken) CancelExistingOrder(txid string) (CancelOrderResponse, error) {
+func (k *Kraken) CancelExistingOrder(ctx context.Context, txid string) (CancelOrderResponse, error) {
 	values := url.Values{
 		"txid": {txid},
 	}
@@ -856,7 +916,7 @@ func (k *Kraken) CancelExistingOrder(txid string) (CancelOrderResponse, error) {
 		Result CancelOrderResponse `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOrderCancel, values, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOrderCancel, values, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -865,9 +925,10 @@ func (k *Kraken) CancelExistingOrder(txid string) (CancelOrderResponse, error) {
 
 // GetError parse Exchange errors in response and return the first one
 // Error format from API doc:
-//   error = array of error messages in the format of:
-//       <char-severity code><string-error category>:<string-error type>[:<string-extra info>]
-//       severity code can be E for error or W for warning
+//
+//	error = array of error messages in the format of:
+//	    <char-severity code><string-error category>:<string-error type>[:<string-extra info>]
+//	    severity code can be E for error or W for warning
 func GetError(errors []string) error {
 
 	for _, e := range errors {
@@ -883,12 +944,13 @@ func GetError(errors []string) error {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP requests
-func (k *Kraken) SendHTTPRequest(path string, result interface{}) error {
-	return k.SendPayload("GET", path, nil, nil, result, false, k.Verbose)
+func (k *Kraken) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return k.SendPayload(ctx, "GET", path, nil, nil, result, false, k.Verbose)
 }
 
-// SendAuthenticatedHTTPRequest sends an authenticated HTTP request
-func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values, result interface{}) (err error) {
+// SendAuthenticatedHTTPRequest sends an authenticated HTTP request, charging
+// the API counter
+func (k *Kraken) SendAuthenticatedHTTPRequest(ctx context.Context, method string, params url.Values, result interface{}) (err error) {
 	if !k.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, k.Name)
 	}
@@ -919,17 +981,34 @@ func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values,
 	headers["API-Key"] = k.APIKey
 	headers["API-Sign"] = signature
 
-	return k.SendPayload("POST", k.APIUrl+path, headers, strings.NewReader(encoded), result, true, k.Verbose)
+	// requests keep their order so nonces are always received in sequence,
+	// orders don't use the counter but still queue behind counted calls
+	return k.SendPayloadWithOptions(ctx, "POST", k.APIUrl+path, headers, strings.NewReader(encoded), result, true, k.Verbose,
+		request.Options{
+			Limits:   map[string]int{krakenAPICounter: getAPICounterWeight(method)},
+			Priority: request.NormalPriority,
+		})
+}
+
+// getAPICounterWeight returns the API counter increase of a private method
+func getAPICounterWeight(method string) int {
+	switch method {
+	case krakenLedgers, krakenQueryLedgers, krakenTradeHistory, krakenQueryTrades:
+		return 2
+	case krakenOrderPlace, krakenOrderCancel:
+		return 0
+	}
+	return 1
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (k *Kraken) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (k *Kraken) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	currency := feeBuilder.FirstCurrency + feeBuilder.Delimiter + feeBuilder.SecondCurrency
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		feePair, err := k.GetTradeVolume(true, currency)
+		feePair, err := k.GetTradeVolume(ctx, true, currency)
 		if err != nil {
 			return 0, err
 		}
@@ -941,7 +1020,7 @@ func (k *Kraken) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.FirstCurrency)
 	case exchange.InternationalBankDepositFee:
-		depositMethods, err := k.GetDepositMethods(feeBuilder.CurrencyItem)
+		depositMethods, err := k.GetDepositMethods(ctx, feeBuilder.CurrencyItem)
 		if err != nil {
 			return 0, err
 		}
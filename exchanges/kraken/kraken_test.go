@@ -1,16 +1,28 @@
 package kraken
 
 import (
+	"context"
+	"hash/crc32"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
 )
 
 var k Kraken
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 // Please add your own APIkeys to do correct due diligence testing.
 const (
 	apiKey                  = ""
@@ -41,7 +53,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetServerTime(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetServerTime()
+	_, err := k.GetServerTime(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetServerTime() error", err)
 	}
@@ -49,7 +61,7 @@ func TestGetServerTime(t *testing.T) {
 
 func TestGetAssets(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetAssets()
+	_, err := k.GetAssets(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetAssets() error", err)
 	}
@@ -57,7 +69,7 @@ func TestGetAssets(t *testing.T) {
 
 func TestGetAssetPairs(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetAssetPairs()
+	_, err := k.GetAssetPairs(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetAssetPairs() error", err)
 	}
@@ -65,7 +77,7 @@ func TestGetAssetPairs(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTicker("BCHEUR")
+	_, err := k.GetTicker(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("Test Failed - GetTicker() error", err)
 	}
@@ -73,7 +85,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTickers("LTCUSD,ETCUSD")
+	_, err := k.GetTickers(context.Background(), "LTCUSD,ETCUSD")
 	if err != nil {
 		t.Error("Test failed - GetTickers() error", err)
 	}
@@ -81,7 +93,7 @@ func TestGetTickers(t *testing.T) {
 
 func TestGetOHLC(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetOHLC("BCHEUR")
+	_, err := k.GetOHLC(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("Test Failed - GetOHLC() error", err)
 	}
@@ -89,7 +101,7 @@ func TestGetOHLC(t *testing.T) {
 
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetDepth("BCHEUR")
+	_, err := k.GetDepth(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("Test Failed - GetDepth() error", err)
 	}
@@ -97,7 +109,7 @@ func TestGetDepth(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTrades("BCHEUR")
+	_, err := k.GetTrades(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("Test Failed - GetTrades() error", err)
 	}
@@ -105,7 +117,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetSpread(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetSpread("BCHEUR")
+	_, err := k.GetSpread(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("Test Failed - GetSpread() error", err)
 	}
@@ -113,7 +125,7 @@ func TestGetSpread(t *testing.T) {
 
 func TestGetBalance(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetBalance()
+	_, err := k.GetBalance(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetBalance() error", err)
 	}
@@ -122,7 +134,7 @@ func TestGetBalance(t *testing.T) {
 func TestGetTradeBalance(t *testing.T) {
 	t.Parallel()
 	args := TradeBalanceOptions{Asset: "ZEUR"}
-	_, err := k.GetTradeBalance(args)
+	_, err := k.GetTradeBalance(context.Background(), args)
 	if err == nil {
 		t.Error("Test Failed - GetTradeBalance() error", err)
 	}
@@ -131,7 +143,7 @@ func TestGetTradeBalance(t *testing.T) {
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
 	args := OrderInfoOptions{Trades: true}
-	_, err := k.GetOpenOrders(args)
+	_, err := k.GetOpenOrders(context.Background(), args)
 	if err == nil {
 		t.Error("Test Failed - GetOpenOrders() error", err)
 	}
@@ -140,7 +152,7 @@ func TestGetOpenOrders(t *testing.T) {
 func TestGetClosedOrders(t *testing.T) {
 	t.Parallel()
 	args := GetClosedOrdersOptions{Trades: true, Start: "OE4KV4-4FVQ5-V7XGPU"}
-	_, err := k.GetClosedOrders(args)
+	_, err := k.GetClosedOrders(context.Background(), args)
 	if err == nil {
 		t.Error("Test Failed - GetClosedOrders() error", err)
 	}
@@ -149,7 +161,7 @@ func TestGetClosedOrders(t *testing.T) {
 func TestQueryOrdersInfo(t *testing.T) {
 	t.Parallel()
 	args := OrderInfoOptions{Trades: true}
-	_, err := k.QueryOrdersInfo(args, "OR6ZFV-AA6TT-CKFFIW", "OAMUAJ-HLVKG-D3QJ5F")
+	_, err := k.QueryOrdersInfo(context.Background(), args, "OR6ZFV-AA6TT-CKFFIW", "OAMUAJ-HLVKG-D3QJ5F")
 	if err == nil {
 		t.Error("Test Failed - QueryOrdersInfo() error", err)
 	}
@@ -158,7 +170,7 @@ func TestQueryOrdersInfo(t *testing.T) {
 func TestGetTradesHistory(t *testing.T) {
 	t.Parallel()
 	args := GetTradesHistoryOptions{Trades: true, Start: "TMZEDR-VBJN2-NGY6DX", End: "TVRXG2-R62VE-RWP3UW"}
-	_, err := k.GetTradesHistory(args)
+	_, err := k.GetTradesHistory(context.Background(), args)
 	if err == nil {
 		t.Error("Test Failed - GetTradesHistory() error", err)
 	}
@@ -166,7 +178,7 @@ func TestGetTradesHistory(t *testing.T) {
 
 func TestQueryTrades(t *testing.T) {
 	t.Parallel()
-	_, err := k.QueryTrades(true, "TMZEDR-VBJN2-NGY6DX", "TFLWIB-KTT7L-4TWR3L", "TDVRAH-2H6OS-SLSXRX")
+	_, err := k.QueryTrades(context.Background(), true, "TMZEDR-VBJN2-NGY6DX", "TFLWIB-KTT7L-4TWR3L", "TDVRAH-2H6OS-SLSXRX")
 	if err == nil {
 		t.Error("Test Failed - QueryTrades() error", err)
 	}
@@ -174,7 +186,7 @@ func TestQueryTrades(t *testing.T) {
 
 func TestOpenPositions(t *testing.T) {
 	t.Parallel()
-	_, err := k.OpenPositions(false)
+	_, err := k.OpenPositions(context.Background(), false)
 	if err == nil {
 		t.Error("Test Failed - OpenPositions() error", err)
 	}
@@ -183,7 +195,7 @@ func TestOpenPositions(t *testing.T) {
 func TestGetLedgers(t *testing.T) {
 	t.Parallel()
 	args := GetLedgersOptions{Start: "LRUHXI-IWECY-K4JYGO", End: "L5NIY7-JZQJD-3J4M2V", Ofs: 15}
-	_, err := k.GetLedgers(args)
+	_, err := k.GetLedgers(context.Background(), args)
 	if err == nil {
 		t.Error("Test Failed - GetLedgers() error", err)
 	}
@@ -191,7 +203,7 @@ func TestGetLedgers(t *testing.T) {
 
 func TestQueryLedgers(t *testing.T) {
 	t.Parallel()
-	_, err := k.QueryLedgers("LVTSFS-NHZVM-EXNZ5M")
+	_, err := k.QueryLedgers(context.Background(), "LVTSFS-NHZVM-EXNZ5M")
 	if err == nil {
 		t.Error("Test Failed - QueryLedgers() error", err)
 	}
@@ -199,7 +211,7 @@ func TestQueryLedgers(t *testing.T) {
 
 func TestGetTradeVolume(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTradeVolume(true, "OAVY7T-MV5VK-KHDF5X")
+	_, err := k.GetTradeVolume(context.Background(), true, "OAVY7T-MV5VK-KHDF5X")
 	if err == nil {
 		t.Error("Test Failed - GetTradeVolume() error", err)
 	}
@@ -208,7 +220,7 @@ func TestGetTradeVolume(t *testing.T) {
 func TestAddOrder(t *testing.T) {
 	t.Parallel()
 	args := AddOrderOptions{Oflags: "fcib"}
-	_, err := k.AddOrder("XXBTZUSD", "sell", "market", 0.00000001, 0, 0, 0, args)
+	_, err := k.AddOrder(context.Background(), "XXBTZUSD", "sell", "market", 0.00000001, 0, 0, 0, args)
 	if err == nil {
 		t.Error("Test Failed - AddOrder() error", err)
 	}
@@ -216,7 +228,7 @@ func TestAddOrder(t *testing.T) {
 
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
-	_, err := k.CancelExistingOrder("OAVY7T-MV5VK-KHDF5X")
+	_, err := k.CancelExistingOrder(context.Background(), "OAVY7T-MV5VK-KHDF5X")
 	if err == nil {
 		t.Error("Test Failed - CancelExistingOrder() error", err)
 	}
@@ -243,7 +255,7 @@ func TestGetFee(t *testing.T) {
 
 	if apiKey != "" && apiSecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := k.GetFee(feeBuilder); resp != float64(0.0026) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0.0026) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0026), resp)
 		}
@@ -252,7 +264,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := k.GetFee(feeBuilder); resp != float64(2600) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(2600) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(2600), resp)
 			t.Error(err)
 		}
@@ -260,7 +272,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := k.GetFee(feeBuilder); resp != float64(0.0016) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0.0016) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0016), resp)
 			t.Error(err)
 		}
@@ -268,7 +280,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := k.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -276,7 +288,7 @@ func TestGetFee(t *testing.T) {
 		// InternationalBankDepositFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.InternationalBankDepositFee
-		if resp, err := k.GetFee(feeBuilder); resp != float64(5) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(5) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(5), resp)
 			t.Error(err)
 		}
@@ -286,7 +298,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
 	feeBuilder.FirstCurrency = symbol.XXBT
-	if resp, err := k.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(5), resp)
 		t.Error(err)
 	}
@@ -294,7 +306,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := k.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -303,7 +315,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FirstCurrency = "hello"
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := k.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -312,7 +324,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.USD
-	if resp, err := k.GetFee(feeBuilder); resp != float64(5) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(5) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(5), resp)
 		t.Error(err)
 	}
@@ -330,6 +342,97 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Kraken using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *Kraken {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	krakenConfig, err := cfg.GetExchangeConfig("Kraken")
+	if err != nil {
+		t.Fatal("Test Failed - Kraken Setup() init error")
+	}
+
+	krakenConfig.AuthenticatedAPISupport = true
+	krakenConfig.APIKey, krakenConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+	krakenConfig.ClientID = clientID
+
+	var r Kraken
+	r.SetDefaults()
+	r.Setup(krakenConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - Kraken GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "OQCLML-BW3P3-BUCMWZ" ||
+		orders[0].BaseCurrency != "XBT" || orders[0].QuoteCurrency != symbol.USD ||
+		orders[0].OrderSide != "buy" || orders[0].Status != "open" ||
+		orders[0].Price != 6300 || orders[0].Amount != 1.25 || orders[0].OpenVolume != 1 ||
+		orders[0].ExecutedAmount != 0.25 || orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - Kraken GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		StartTicks: time.Unix(1529900000, 0),
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Kraken GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "OB5VMB-B4U2U-DK2WRW" ||
+		orders[0].Status != "closed" || orders[0].OrderSide != "sell" ||
+		orders[0].OpenVolume != 0 || orders[0].ExecutedAmount != 0.5) {
+		t.Errorf("Test Failed - Kraken GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
+func TestWsProcessBook(t *testing.T) {
+	var r Kraken
+	r.SetDefaults()
+	r.Websocket.DataHandler = make(chan interface{}, 10)
+	r.Websocket.Orderbook.SetChecksum(r.wsChecksum)
+
+	err := r.wsProcessBook([]byte(`[1234,{"as":[["5541.30000","2.50700000","1534614248.123678"]],"bs":[["5541.20000","1.52900000","1534614248.765567"]]},"book-100","XBT/USD"]`))
+	if err != nil {
+		t.Fatal("Test Failed - Kraken wsProcessBook() snapshot error", err)
+	}
+
+	checksum := crc32.ChecksumIEEE([]byte("554130000100000000554120000152900000"))
+	err = r.wsProcessBook([]byte(`[1234,{"a":[["5541.30000","1.00000000","1534614335.345903"]]},{"c":"` +
+		strconv.FormatUint(uint64(checksum), 10) + `"},"book-100","XBT/USD"]`))
+
+	p := pair.NewCurrencyPairDelimiter("XBT/USD", "/")
+	if err != nil || r.Websocket.Orderbook.IsResyncing(p, ticker.Spot) {
+		t.Fatal("Test Failed - Kraken wsProcessBook() expected update to match checksum", err)
+	}
+
+	err = r.wsProcessBook([]byte(`[1234,{"b":[["5541.20000","0.50000000","1534614335.345903"]],"c":"1337"},"book-100","XBT/USD"]`))
+	if err != nil || !r.Websocket.Orderbook.IsResyncing(p, ticker.Spot) {
+		t.Error("Test Failed - Kraken wsProcessBook() expected checksum mismatch to resync", err)
+	}
+}
+
+func TestFillResponse(t *testing.T) {
+	var response exchange.SubmitOrderResponse
+	fillResponse(&response, &OrderInfo{VolExec: 0.5, Price: 6500, Fee: 4.2,
+		OpenTm: 1539684000.1234}, pair.NewCurrencyPair("XBT", "USD"))
+
+	if response.FilledAmount != 0.5 || response.AveragePrice != 6500 ||
+		response.Fee != 4.2 || response.FeeCurrency != "USD" ||
+		response.OrderDate.Unix() != 1539684000 {
+		t.Errorf("Test Failed - fillResponse() unexpected response %+v", response)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -355,7 +458,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.XBT,
 		SecondCurrency: symbol.CAD,
 	}
-	response, err := k.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := k.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -381,10 +484,60 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := k.CancelOrder(orderCancellation)
+	err := k.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
 		t.Errorf("Could not cancel order: %s", err)
 	}
 }
+
+func TestGetAPICounterWeight(t *testing.T) {
+	if getAPICounterWeight(krakenLedgers) != 2 ||
+		getAPICounterWeight(krakenBalance) != 1 ||
+		getAPICounterWeight(krakenOrderCancel) != 0 {
+		t.Error("Test failed. Unexpected API counter weight")
+	}
+}
+
+func TestOrderParams(t *testing.T) {
+	orderType, price, price2, args, err := orderParams(exchange.StopLimit, 90,
+		exchange.OrderOptions{StopPrice: 95, PostOnly: true})
+	if err != nil || orderType != "stop-loss-limit" || price != 95 ||
+		price2 != 90 || args.Oflags != "post" {
+		t.Errorf("Test failed. Unexpected stop limit params %s %f %f %+v %v",
+			orderType, price, price2, args, err)
+	}
+
+	orderType, price, _, _, err = orderParams(exchange.TrailingStop, 0,
+		exchange.OrderOptions{TrailingOffset: 5})
+	if err != nil || orderType != "trailing-stop" || price != 5 {
+		t.Errorf("Test failed. Unexpected trailing stop params %s %f %v",
+			orderType, price, err)
+	}
+
+	_, _, _, args, err = orderParams(exchange.Limit, 90,
+		exchange.OrderOptions{TimeInForce: exchange.GoodTillDate,
+			ExpireTime: time.Unix(1500000000, 0)})
+	if err != nil || args.ExpireTm != "1500000000" {
+		t.Errorf("Test failed. Unexpected good till date params %+v %v", args, err)
+	}
+
+	_, _, _, _, err = orderParams(exchange.Limit, 90,
+		exchange.OrderOptions{TimeInForce: exchange.FillOrKill})
+	if err == nil {
+		t.Error("Test failed. Expected unsupported time in force error")
+	}
+}
+
+func TestGetMarketInfo(t *testing.T) {
+	markets := getMarketInfo(map[string]AssetPairs{
+		"XXBTZUSD":   {Altname: "XBTUSD", Base: "XXBT", Quote: "ZUSD", PairDecimals: 1, LotDecimals: 8},
+		"XXBTZUSD.d": {Altname: "XBTUSD.d", Base: "XXBT", Quote: "ZUSD", PairDecimals: 1, LotDecimals: 8},
+	})
+
+	if len(markets) != 1 || markets[0].Pair.Pair() != "XBT-USD" ||
+		markets[0].PriceStep != 0.1 || markets[0].AmountStep != 0.00000001 {
+		t.Errorf("Test failed. Unexpected market info %+v", markets)
+	}
+}
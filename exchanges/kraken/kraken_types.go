@@ -77,6 +77,12 @@ type OpenHighLowClose struct {
 	Count  float64
 }
 
+// OHLCOptions type
+type OHLCOptions struct {
+	Interval int64
+	Since    int64
+}
+
 // RecentTrades holds recent trade data
 type RecentTrades struct {
 	Price         float64
@@ -365,3 +371,43 @@ var WithdrawalFees = map[string]float64{
 	symbol.XTZ:  0.05,
 	symbol.ZEC:  0.0001,
 }
+
+// WebsocketSubscribe is a websocket subscription request
+type WebsocketSubscribe struct {
+	Event        string                `json:"event"`
+	Pairs        []string              `json:"pair"`
+	Subscription WebsocketSubscription `json:"subscription"`
+}
+
+// WebsocketSubscription holds the channel subscribed to
+type WebsocketSubscription struct {
+	Name  string `json:"name"`
+	Depth int    `json:"depth,omitempty"`
+}
+
+// WebsocketEvent holds the websocket heartbeat, status and subscription
+// status events
+type WebsocketEvent struct {
+	Event        string `json:"event"`
+	Status       string `json:"status"`
+	Pair         string `json:"pair"`
+	ChannelID    int64  `json:"channelID"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// WebsocketBook holds a book snapshot or update, levels are arrays of the
+// price, volume and timestamp
+type WebsocketBook struct {
+	Asks       [][]string `json:"as"`
+	Bids       [][]string `json:"bs"`
+	AskUpdates [][]string `json:"a"`
+	BidUpdates [][]string `json:"b"`
+	Checksum   string     `json:"c"`
+}
+
+// wsDecimals holds the decimal places Kraken sends book prices and volumes of a
+// currency pair with
+type wsDecimals struct {
+	Price  int
+	Volume int
+}
@@ -0,0 +1,335 @@
+package kraken
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+const (
+	krakenWebsocketURL = "wss://ws.kraken.com"
+	// krakenWebsocketBookDepth is deeper than the checksum depth so levels
+	// which fall out of the subscribed depth never reach the checksummed levels
+	krakenWebsocketBookDepth     = 100
+	krakenWebsocketChecksumDepth = 10
+)
+
+// WsConnect initiates a websocket connection and subscribes to the books of
+// the enabled currencies
+func (k *Kraken) WsConnect() error {
+	if !k.Websocket.IsEnabled() || !k.IsEnabled() {
+		return errors.New(exchange.WebsocketNotEnabled)
+	}
+
+	var dialer websocket.Dialer
+
+	if k.Websocket.GetProxyAddress() != "" {
+		proxy, err := url.Parse(k.Websocket.GetProxyAddress())
+		if err != nil {
+			return fmt.Errorf("kraken_websocket.go error - proxy address %s",
+				err)
+		}
+
+		dialer.Proxy = http.ProxyURL(proxy)
+	}
+
+	var err error
+	k.WebsocketConn, _, err = dialer.Dial(k.Websocket.GetWebsocketURL(),
+		http.Header{})
+	if err != nil {
+		return fmt.Errorf("kraken_websocket.go error - unable to connect to websocket %s",
+			err)
+	}
+
+	k.Websocket.Orderbook.SetChecksum(k.wsChecksum)
+
+	err = k.WsSubscribe()
+	if err != nil {
+		return err
+	}
+
+	go k.WsReadData()
+	go k.WsHandleData()
+
+	return nil
+}
+
+// WsSubscribe subscribes to the book channel of the enabled currencies
+func (k *Kraken) WsSubscribe() error {
+	var pairs []string
+	for _, x := range k.EnabledPairs {
+		pairs = append(pairs, common.ReplaceString(x, "-", "/", 1))
+	}
+
+	subscribe := WebsocketSubscribe{
+		Event: "subscribe",
+		Pairs: pairs,
+		Subscription: WebsocketSubscription{
+			Name:  "book",
+			Depth: krakenWebsocketBookDepth,
+		},
+	}
+
+	json, err := common.JSONEncode(subscribe)
+	if err != nil {
+		return err
+	}
+
+	return k.WebsocketConn.WriteMessage(websocket.TextMessage, json)
+}
+
+// WsReadData reads data from the websocket connection
+func (k *Kraken) WsReadData() {
+	k.Websocket.Wg.Add(1)
+
+	defer func() {
+		err := k.WebsocketConn.Close()
+		if err != nil {
+			k.Websocket.DataHandler <- fmt.Errorf("kraken_websocket.go - Unable to to close Websocket connection. Error: %s",
+				err)
+		}
+		k.Websocket.Wg.Done()
+	}()
+
+	for {
+		select {
+		case <-k.Websocket.ShutdownC:
+			return
+
+		default:
+			_, resp, err := k.WebsocketConn.ReadMessage()
+			if err != nil {
+				k.Websocket.DataHandler <- err
+				return
+			}
+
+			k.Websocket.TrafficAlert <- struct{}{}
+			k.Websocket.Intercomm <- exchange.WebsocketResponse{Raw: resp}
+		}
+	}
+}
+
+// WsHandleData handles read data from websocket connection
+func (k *Kraken) WsHandleData() {
+	k.Websocket.Wg.Add(1)
+	defer k.Websocket.Wg.Done()
+
+	for {
+		select {
+		case <-k.Websocket.ShutdownC:
+			return
+
+		case resp := <-k.Websocket.Intercomm:
+			if len(resp.Raw) == 0 || resp.Raw[0] != '[' {
+				var event WebsocketEvent
+				err := common.JSONDecode(resp.Raw, &event)
+				if err != nil {
+					k.Websocket.DataHandler <- err
+					continue
+				}
+
+				if event.Status == "error" {
+					k.Websocket.DataHandler <- fmt.Errorf("kraken_websocket.go error - %s %s",
+						event.Pair, event.ErrorMessage)
+				}
+				continue
+			}
+
+			err := k.wsProcessBook(resp.Raw)
+			if err != nil {
+				k.Websocket.DataHandler <- fmt.Errorf("kraken_websocket.go processing book error: %s",
+					err)
+			}
+		}
+	}
+}
+
+// wsProcessBook loads a book snapshot or applies a book update, updates are
+// verified against the checksum sent with them. Channel messages are arrays of
+// the channel ID, one or two book objects, the channel name and the pair
+func (k *Kraken) wsProcessBook(raw []byte) error {
+	var fields []json.RawMessage
+	err := common.JSONDecode(raw, &fields)
+	if err != nil {
+		return err
+	}
+
+	if len(fields) < 4 {
+		return fmt.Errorf("unexpected message %s", raw)
+	}
+
+	var symbol string
+	err = common.JSONDecode(fields[len(fields)-1], &symbol)
+	if err != nil {
+		return err
+	}
+
+	var book WebsocketBook
+	for _, field := range fields[1 : len(fields)-2] {
+		err = common.JSONDecode(field, &book)
+		if err != nil {
+			return err
+		}
+	}
+
+	p := pair.NewCurrencyPairDelimiter(symbol, "/")
+	if len(book.Asks) > 0 || len(book.Bids) > 0 {
+		return k.wsLoadSnapshot(p, book)
+	}
+
+	bids, err := wsBookLevels(book.BidUpdates)
+	if err != nil {
+		return err
+	}
+
+	asks, err := wsBookLevels(book.AskUpdates)
+	if err != nil {
+		return err
+	}
+
+	err = k.Websocket.Orderbook.Update(bids,
+		asks,
+		p,
+		time.Now(),
+		k.GetName(),
+		ticker.Spot)
+	if err != nil {
+		return err
+	}
+
+	if book.Checksum != "" {
+		checksum, err := strconv.ParseUint(book.Checksum, 10, 32)
+		if err != nil {
+			return err
+		}
+
+		err = k.Websocket.Orderbook.VerifyChecksum(p,
+			ticker.Spot,
+			k.GetName(),
+			uint32(checksum))
+		if err != nil {
+			return err
+		}
+	}
+
+	k.Websocket.DataHandler <- exchange.WebsocketOrderbookUpdate{
+		Pair:     p,
+		Asset:    ticker.Spot,
+		Exchange: k.GetName(),
+	}
+	return nil
+}
+
+// wsLoadSnapshot loads a book snapshot and records the decimal places of its
+// prices and volumes, which the checksum is calculated with
+func (k *Kraken) wsLoadSnapshot(p pair.CurrencyPair, book WebsocketBook) error {
+	bids, err := wsBookLevels(book.Bids)
+	if err != nil {
+		return err
+	}
+
+	asks, err := wsBookLevels(book.Asks)
+	if err != nil {
+		return err
+	}
+
+	level := book.Bids
+	if len(book.Asks) > 0 {
+		level = book.Asks
+	}
+
+	if k.wsDecimals == nil {
+		k.wsDecimals = make(map[string]wsDecimals)
+	}
+	k.wsDecimals[p.Pair().String()] = wsDecimals{
+		Price:  decimalPlaces(level[0][0]),
+		Volume: decimalPlaces(level[0][1]),
+	}
+
+	err = k.Websocket.Orderbook.LoadSnapshot(orderbook.Base{
+		Pair:         p,
+		CurrencyPair: p.Pair().String(),
+		Bids:         bids,
+		Asks:         asks,
+		AssetType:    ticker.Spot,
+		LastUpdated:  time.Now(),
+	}, k.GetName())
+	if err != nil {
+		return err
+	}
+
+	k.Websocket.DataHandler <- exchange.WebsocketOrderbookUpdate{
+		Pair:     p,
+		Asset:    ticker.Spot,
+		Exchange: k.GetName(),
+	}
+	return nil
+}
+
+// wsBookLevels converts price, volume and timestamp string arrays to orderbook
+// levels
+func wsBookLevels(levels [][]string) ([]orderbook.Item, error) {
+	var items []orderbook.Item
+	for i := range levels {
+		if len(levels[i]) < 2 {
+			return nil, errors.New("malformed book level")
+		}
+
+		price, err := strconv.ParseFloat(levels[i][0], 64)
+		if err != nil {
+			return nil, err
+		}
+
+		volume, err := strconv.ParseFloat(levels[i][1], 64)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, orderbook.Item{Price: price, Amount: volume})
+	}
+	return items, nil
+}
+
+// decimalPlaces returns the number of digits after the decimal point
+func decimalPlaces(value string) int {
+	i := strings.Index(value, ".")
+	if i == -1 {
+		return 0
+	}
+	return len(value) - i - 1
+}
+
+// wsChecksum returns the checksum Kraken publishes for a book, the CRC32 of
+// the top 10 asks followed by the top 10 bids, each level written as its price
+// then volume at the precision Kraken sends them with the decimal point and
+// leading zeros removed
+func (k *Kraken) wsChecksum(ob orderbook.Base) uint32 {
+	decimals := k.wsDecimals[ob.Pair.Pair().String()]
+
+	var checksum strings.Builder
+	for _, levels := range [][]orderbook.Item{ob.Asks, ob.Bids} {
+		for i := 0; i < krakenWebsocketChecksumDepth && i < len(levels); i++ {
+			checksum.WriteString(checksumValue(levels[i].Price, decimals.Price))
+			checksum.WriteString(checksumValue(levels[i].Amount, decimals.Volume))
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(checksum.String()))
+}
+
+// checksumValue formats a value for the book checksum
+func checksumValue(value float64, decimals int) string {
+	formatted := strconv.FormatFloat(value, 'f', decimals, 64)
+	return strings.TrimLeft(common.ReplaceString(formatted, ".", "", 1), "0")
+}
@@ -1,9 +1,13 @@
 package kraken
 
 import (
+	"context"
+	"errors"
 	"log"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -28,7 +32,7 @@ func (k *Kraken) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", k.GetName(), len(k.EnabledPairs), k.EnabledPairs)
 	}
 
-	assetPairs, err := k.GetAssetPairs()
+	assetPairs, err := k.GetAssetPairs(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", k.GetName())
 	} else {
@@ -38,20 +42,11 @@ func (k *Kraken) Run() {
 		}
 
 		var exchangeProducts []string
-		for _, v := range assetPairs {
-			if common.StringContains(v.Altname, ".d") {
-				continue
-			}
-			if v.Base[0] == 'X' {
-				if len(v.Base) > 3 {
-					v.Base = v.Base[1:]
-				}
-			}
-			if v.Quote[0] == 'Z' || v.Quote[0] == 'X' {
-				v.Quote = v.Quote[1:]
-			}
-			exchangeProducts = append(exchangeProducts, v.Base+"-"+v.Quote)
+		markets := getMarketInfo(assetPairs)
+		for i := range markets {
+			exchangeProducts = append(exchangeProducts, markets[i].Pair.Pair().String())
 		}
+		k.SetMarketInfo(markets)
 
 		if forceUpgrade {
 			enabledPairs := []string{"XBT-USD"}
@@ -69,15 +64,44 @@ func (k *Kraken) Run() {
 	}
 }
 
+// getMarketInfo returns the trading rules of the asset pairs, dark pool pairs
+// are skipped and the asset class prefixes are removed from the currencies
+func getMarketInfo(assetPairs map[string]AssetPairs) []exchange.MarketInfo {
+	var markets []exchange.MarketInfo
+	for _, v := range assetPairs {
+		if common.StringContains(v.Altname, ".d") {
+			continue
+		}
+		if v.Base[0] == 'X' {
+			if len(v.Base) > 3 {
+				v.Base = v.Base[1:]
+			}
+		}
+		if v.Quote[0] == 'Z' || v.Quote[0] == 'X' {
+			v.Quote = v.Quote[1:]
+		}
+
+		markets = append(markets, exchange.MarketInfo{
+			Pair:      pair.NewCurrencyPairDelimiter(v.Base+"-"+v.Quote, "-"),
+			AssetType: ticker.Spot,
+			OrderLimits: exchange.OrderLimits{
+				AmountStep: exchange.StepFromPrecision(v.LotDecimals),
+				PriceStep:  exchange.StepFromPrecision(v.PairDecimals),
+			},
+		})
+	}
+	return markets
+}
+
 // UpdateTicker updates and returns the ticker for a currency pair
-func (k *Kraken) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (k *Kraken) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	pairs := k.GetEnabledCurrencies()
 	pairsCollated, err := exchange.GetAndFormatExchangeCurrencies(k.Name, pairs)
 	if err != nil {
 		return tickerPrice, err
 	}
-	tickers, err := k.GetTickers(pairsCollated.String())
+	tickers, err := k.GetTickers(ctx, pairsCollated.String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -101,27 +125,27 @@ func (k *Kraken) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pri
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (k *Kraken) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (k *Kraken) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(k.GetName(), p, assetType)
 	if err != nil {
-		return k.UpdateTicker(p, assetType)
+		return k.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (k *Kraken) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (k *Kraken) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(k.GetName(), p, assetType)
 	if err != nil {
-		return k.UpdateOrderbook(p, assetType)
+		return k.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (k *Kraken) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (k *Kraken) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := k.GetDepth(exchange.FormatExchangeCurrency(k.GetName(), p).String())
+	orderbookNew, err := k.GetDepth(ctx, exchange.FormatExchangeCurrency(k.GetName(), p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -140,7 +164,7 @@ func (k *Kraken) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbo
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // Kraken exchange - to-do
-func (k *Kraken) GetAccountInfo() (exchange.AccountInfo, error) {
+func (k *Kraken) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = k.GetName()
 	return response, nil
@@ -148,24 +172,32 @@ func (k *Kraken) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (k *Kraken) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (k *Kraken) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (k *Kraken) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (k *Kraken) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (k *Kraken) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (k *Kraken) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	var args = AddOrderOptions{}
+	if err := k.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	krakenOrderType, price, price2, args, err := orderParams(order.OrderType,
+		order.Price, order.Options)
+	if err != nil {
+		return submitOrderResponse, err
+	}
 
-	response, err := k.AddOrder(p.Pair().String(), side.ToString(), orderType.ToString(), amount, price, 0, 0, args)
+	response, err := k.AddOrder(ctx, order.Pair.Pair().String(), order.Side.ToString(), krakenOrderType, order.Amount, price, price2, 0, args)
 
 	if len(response.TransactionIds) > 0 {
 		submitOrderResponse.OrderID = strings.Join(response.TransactionIds, ", ")
@@ -173,49 +205,103 @@ func (k *Kraken) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, order
 
 	if err == nil {
 		submitOrderResponse.IsOrderPlaced = true
+
+		// The add order response only holds the transaction IDs, the fill
+		// details are left unset when the order cannot be queried
+		if len(response.TransactionIds) > 0 {
+			info, queryErr := k.QueryOrdersInfo(ctx, OrderInfoOptions{},
+				response.TransactionIds[0])
+			if queryErr == nil {
+				if o, ok := info[response.TransactionIds[0]]; ok {
+					fillResponse(&submitOrderResponse, &o, order.Pair)
+				}
+			} else if k.Verbose {
+				log.Printf("%s unable to query placed order %s: %s", k.Name,
+					response.TransactionIds[0], queryErr)
+			}
+		}
 	}
 
 	return submitOrderResponse, err
 }
 
+// fillResponse sets the filled amount, average price, fees and timestamp of a
+// placed order, fees are charged in the quote currency
+func fillResponse(submitOrderResponse *exchange.SubmitOrderResponse, o *OrderInfo, p pair.CurrencyPair) {
+	submitOrderResponse.FilledAmount = o.VolExec
+	submitOrderResponse.AveragePrice = o.Price
+	submitOrderResponse.Fee = o.Fee
+	submitOrderResponse.FeeCurrency = p.SecondCurrency.String()
+	if o.OpenTm > 0 {
+		submitOrderResponse.OrderDate = time.Unix(int64(o.OpenTm), 0)
+	}
+}
+
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (k *Kraken) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (k *Kraken) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (k *Kraken) CancelOrder(order exchange.OrderCancellation) error {
-	_, err := k.CancelExistingOrder(order.OrderID)
+func (k *Kraken) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
+	_, err := k.CancelExistingOrder(ctx, order.OrderID)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (k *Kraken) CancelAllOrders() error {
+func (k *Kraken) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (k *Kraken) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (k *Kraken) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (k *Kraken) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (k *Kraken) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (k *Kraken) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (k *Kraken) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (k *Kraken) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (k *Kraken) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (k *Kraken) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (k *Kraken) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (k *Kraken) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (k *Kraken) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (k *Kraken) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -227,15 +313,180 @@ func (k *Kraken) WithdrawFiatFundsToInternationalBank(currency pair.CurrencyItem
 
 // GetWebsocket returns a pointer to the exchange websocket
 func (k *Kraken) GetWebsocket() (*exchange.Websocket, error) {
-	return nil, common.ErrNotYetImplemented
+	return k.Websocket, nil
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (k *Kraken) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return k.GetFee(feeBuilder)
+	return k.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (k *Kraken) GetWithdrawCapabilities() uint32 {
 	return k.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (k *Kraken) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := k.GetOpenOrders(ctx, OrderInfoOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for ID, order := range resp.Open {
+		orders = append(orders, k.formatOrderDetail(ID, order))
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (k *Kraken) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	req := GetClosedOrdersOptions{}
+	if !getOrdersRequest.StartTicks.IsZero() {
+		req.Start = strconv.FormatInt(getOrdersRequest.StartTicks.Unix(), 10)
+	}
+	if !getOrdersRequest.EndTicks.IsZero() {
+		req.End = strconv.FormatInt(getOrdersRequest.EndTicks.Unix(), 10)
+	}
+
+	resp, err := k.GetClosedOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for ID, order := range resp.Closed {
+		orders = append(orders, k.formatOrderDetail(ID, order))
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// formatOrderDetail converts a Kraken order into an exchange.OrderDetail
+func (k *Kraken) formatOrderDetail(orderID string, order OrderInfo) exchange.OrderDetail {
+	p := k.GetPairFromSymbol(order.Descr.Pair)
+	orderDate := time.Unix(int64(order.OpenTm), 0)
+	return exchange.OrderDetail{
+		Exchange:       k.Name,
+		ID:             orderID,
+		BaseCurrency:   p.FirstCurrency.String(),
+		QuoteCurrency:  p.SecondCurrency.String(),
+		OrderSide:      order.Descr.Type,
+		OrderType:      order.Descr.OrderType,
+		CreationTime:   orderDate.Unix(),
+		OrderDate:      orderDate,
+		Status:         order.Status,
+		Price:          order.Descr.Price,
+		Amount:         order.Vol,
+		OpenVolume:     order.Vol - order.VolExec,
+		ExecutedAmount: order.VolExec,
+	}
+}
+
+// krakenCandleIntervals maps the supported candle intervals to Kraken OHLC
+// intervals in minutes
+var krakenCandleIntervals = map[exchange.CandleInterval]string{
+	exchange.OneMin:     "1",
+	exchange.FiveMin:    "5",
+	exchange.FifteenMin: "15",
+	exchange.ThirtyMin:  "30",
+	exchange.OneHour:    "60",
+	exchange.FourHour:   "240",
+	exchange.OneDay:     "1440",
+	exchange.OneWeek:    "10080",
+	exchange.FifteenDay: "21600",
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times. Kraken only serves the most recent 720 periods for an interval
+func (k *Kraken) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	krakenInterval, err := exchange.ValidateCandleRequest(krakenCandleIntervals,
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	minutes, err := strconv.ParseInt(krakenInterval, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := exchange.FormatExchangeCurrency(k.Name, p).String()
+	return exchange.GetCandlesPaginated(interval, start, end, krakenMaxOHLCLimit,
+		func(windowStart, windowEnd time.Time) ([]exchange.Candle, error) {
+			resp, err := k.GetOHLC(ctx, symbol, OHLCOptions{
+				Interval: minutes,
+				Since:    windowStart.Add(-interval.Duration()).Unix(),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			var candles []exchange.Candle
+			for i := range resp {
+				candles = append(candles, exchange.Candle{
+					Time:   time.Unix(int64(resp[i].Time), 0),
+					Open:   resp[i].Open,
+					High:   resp[i].High,
+					Low:    resp[i].Low,
+					Close:  resp[i].Close,
+					Volume: resp[i].Volume,
+				})
+			}
+			return candles, nil
+		})
+}
+
+// orderParams maps an order to a Kraken order type, its price and secondary
+// price and order options. Triggered orders are priced at the stop price with
+// the limit price as the secondary price, trailing stops are priced at the
+// trailing offset
+func orderParams(orderType exchange.OrderType, price float64, options exchange.OrderOptions) (string, float64, float64, AddOrderOptions, error) {
+	var args AddOrderOptions
+	var krakenOrderType string
+	var price2 float64
+	switch orderType {
+	case exchange.Market:
+		krakenOrderType = "market"
+	case exchange.Limit:
+		krakenOrderType = "limit"
+	case exchange.Stop:
+		krakenOrderType = "stop-loss"
+	case exchange.StopLimit:
+		krakenOrderType = "stop-loss-limit"
+	case exchange.TakeProfit:
+		krakenOrderType = "take-profit"
+	case exchange.TakeProfitLimit:
+		krakenOrderType = "take-profit-limit"
+	case exchange.TrailingStop:
+		krakenOrderType = "trailing-stop"
+		price = options.TrailingOffset
+	default:
+		return "", 0, 0, args, errors.New("unsupported order type")
+	}
+
+	if orderType.IsTriggered() {
+		if orderType.IsLimitPriced() {
+			price2 = price
+		}
+		price = options.StopPrice
+	}
+
+	switch options.GetTimeInForce() {
+	case exchange.GoodTillCancel:
+	case exchange.GoodTillDate:
+		args.ExpireTm = strconv.FormatInt(options.ExpireTime.Unix(), 10)
+	default:
+		return "", 0, 0, args, errors.New("unsupported time in force")
+	}
+
+	if options.PostOnly {
+		args.Oflags = "post"
+	}
+	return krakenOrderType, price, price2, args, nil
+}
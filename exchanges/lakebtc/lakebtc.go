@@ -1,6 +1,7 @@
 package lakebtc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -105,8 +106,8 @@ func (l *LakeBTC) Setup(exch config.ExchangeConfig) {
 }
 
 // GetTradablePairs returns a list of available pairs from the exchange
-func (l *LakeBTC) GetTradablePairs() ([]string, error) {
-	result, err := l.GetTicker()
+func (l *LakeBTC) GetTradablePairs(ctx context.Context) ([]string, error) {
+	result, err := l.GetTicker(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -120,11 +121,11 @@ func (l *LakeBTC) GetTradablePairs() ([]string, error) {
 }
 
 // GetTicker returns the current ticker from lakeBTC
-func (l *LakeBTC) GetTicker() (map[string]Ticker, error) {
+func (l *LakeBTC) GetTicker(ctx context.Context) (map[string]Ticker, error) {
 	response := make(map[string]TickerResponse)
 	path := fmt.Sprintf("%s/%s", l.APIUrl, lakeBTCTicker)
 
-	if err := l.SendHTTPRequest(path, &response); err != nil {
+	if err := l.SendHTTPRequest(ctx, path, &response); err != nil {
 		return nil, err
 	}
 
@@ -159,14 +160,14 @@ func (l *LakeBTC) GetTicker() (map[string]Ticker, error) {
 }
 
 // GetOrderBook returns the order book from LakeBTC
-func (l *LakeBTC) GetOrderBook(currency string) (Orderbook, error) {
+func (l *LakeBTC) GetOrderBook(ctx context.Context, currency string) (Orderbook, error) {
 	type Response struct {
 		Bids [][]string `json:"bids"`
 		Asks [][]string `json:"asks"`
 	}
 	path := fmt.Sprintf("%s/%s?symbol=%s", l.APIUrl, lakeBTCOrderbook, common.StringToLower(currency))
 	resp := Response{}
-	err := l.SendHTTPRequest(path, &resp)
+	err := l.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -203,32 +204,32 @@ func (l *LakeBTC) GetOrderBook(currency string) (Orderbook, error) {
 }
 
 // GetTradeHistory returns the trade history for a given currency pair
-func (l *LakeBTC) GetTradeHistory(currency string) ([]TradeHistory, error) {
+func (l *LakeBTC) GetTradeHistory(ctx context.Context, currency string) ([]TradeHistory, error) {
 	path := fmt.Sprintf("%s/%s?symbol=%s", l.APIUrl, lakeBTCTrades, common.StringToLower(currency))
 	resp := []TradeHistory{}
 
-	return resp, l.SendHTTPRequest(path, &resp)
+	return resp, l.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetAccountInformation returns your current account information
-func (l *LakeBTC) GetAccountInformation() (AccountInfo, error) {
+func (l *LakeBTC) GetAccountInformation(ctx context.Context) (AccountInfo, error) {
 	resp := AccountInfo{}
 
-	return resp, l.SendAuthenticatedHTTPRequest(lakeBTCGetAccountInfo, "", &resp)
+	return resp, l.SendAuthenticatedHTTPRequest(ctx, lakeBTCGetAccountInfo, "", &resp)
 }
 
 // Trade executes an order on the exchange and returns trade inforamtion or an
 // error
-func (l *LakeBTC) Trade(isBuyOrder bool, amount, price float64, currency string) (Trade, error) {
+func (l *LakeBTC) Trade(ctx context.Context, isBuyOrder bool, amount, price float64, currency string) (Trade, error) {
 	resp := Trade{}
 	params := strconv.FormatFloat(price, 'f', -1, 64) + "," + strconv.FormatFloat(amount, 'f', -1, 64) + "," + currency
 
 	if isBuyOrder {
-		if err := l.SendAuthenticatedHTTPRequest(lakeBTCBuyOrder, params, &resp); err != nil {
+		if err := l.SendAuthenticatedHTTPRequest(ctx, lakeBTCBuyOrder, params, &resp); err != nil {
 			return resp, err
 		}
 	} else {
-		if err := l.SendAuthenticatedHTTPRequest(lakeBTCSellOrder, params, &resp); err != nil {
+		if err := l.SendAuthenticatedHTTPRequest(ctx, lakeBTCSellOrder, params, &resp); err != nil {
 			return resp, err
 		}
 	}
@@ -241,14 +242,14 @@ func (l *LakeBTC) Trade(isBuyOrder bool, amount, price float64, currency string)
 }
 
 // GetOpenOrders returns all open orders associated with your account
-func (l *LakeBTC) GetOpenOrders() ([]OpenOrders, error) {
+func (l *LakeBTC) GetOpenOrders(ctx context.Context) ([]OpenOrders, error) {
 	orders := []OpenOrders{}
 
-	return orders, l.SendAuthenticatedHTTPRequest(lakeBTCOpenOrders, "", &orders)
+	return orders, l.SendAuthenticatedHTTPRequest(ctx, lakeBTCOpenOrders, "", &orders)
 }
 
 // GetOrders returns your orders
-func (l *LakeBTC) GetOrders(orders []int64) ([]Orders, error) {
+func (l *LakeBTC) GetOrders(ctx context.Context, orders []int64) ([]Orders, error) {
 	var ordersStr []string
 	for _, x := range orders {
 		ordersStr = append(ordersStr, strconv.FormatInt(x, 10))
@@ -256,18 +257,18 @@ func (l *LakeBTC) GetOrders(orders []int64) ([]Orders, error) {
 
 	resp := []Orders{}
 	return resp,
-		l.SendAuthenticatedHTTPRequest(lakeBTCGetOrders, common.JoinStrings(ordersStr, ","), &resp)
+		l.SendAuthenticatedHTTPRequest(ctx, lakeBTCGetOrders, common.JoinStrings(ordersStr, ","), &resp)
 }
 
 // CancelExistingOrder cancels an order by ID number and returns an error
-func (l *LakeBTC) CancelExistingOrder(orderID int64) error {
+func (l *LakeBTC) CancelExistingOrder(ctx context.Context, orderID int64) error {
 	type Response struct {
 		Result bool `json:"Result"`
 	}
 
 	resp := Response{}
 	params := strconv.FormatInt(orderID, 10)
-	err := l.SendAuthenticatedHTTPRequest(lakeBTCCancelOrder, params, &resp)
+	err := l.SendAuthenticatedHTTPRequest(ctx, lakeBTCCancelOrder, params, &resp)
 	if err != nil {
 		return err
 	}
@@ -279,39 +280,39 @@ func (l *LakeBTC) CancelExistingOrder(orderID int64) error {
 }
 
 // GetTrades returns trades associated with your account by timestamp
-func (l *LakeBTC) GetTrades(timestamp int64) ([]AuthenticatedTradeHistory, error) {
+func (l *LakeBTC) GetTrades(ctx context.Context, timestamp int64) ([]AuthenticatedTradeHistory, error) {
 	params := ""
 	if timestamp != 0 {
 		params = strconv.FormatInt(timestamp, 10)
 	}
 
 	trades := []AuthenticatedTradeHistory{}
-	return trades, l.SendAuthenticatedHTTPRequest(lakeBTCGetTrades, params, &trades)
+	return trades, l.SendAuthenticatedHTTPRequest(ctx, lakeBTCGetTrades, params, &trades)
 }
 
 // GetExternalAccounts returns your external accounts WARNING: Only for BTC!
-func (l *LakeBTC) GetExternalAccounts() ([]ExternalAccounts, error) {
+func (l *LakeBTC) GetExternalAccounts(ctx context.Context) ([]ExternalAccounts, error) {
 	resp := []ExternalAccounts{}
 
-	return resp, l.SendAuthenticatedHTTPRequest(lakeBTCGetExternalAccounts, "", &resp)
+	return resp, l.SendAuthenticatedHTTPRequest(ctx, lakeBTCGetExternalAccounts, "", &resp)
 }
 
 // CreateWithdraw allows your to withdraw to external account WARNING: Only for
 // BTC!
-func (l *LakeBTC) CreateWithdraw(amount float64, accountID int64) (Withdraw, error) {
+func (l *LakeBTC) CreateWithdraw(ctx context.Context, amount float64, accountID int64) (Withdraw, error) {
 	resp := Withdraw{}
 	params := strconv.FormatFloat(amount, 'f', -1, 64) + ",btc," + strconv.FormatInt(accountID, 10)
 
-	return resp, l.SendAuthenticatedHTTPRequest(lakeBTCCreateWithdraw, params, &resp)
+	return resp, l.SendAuthenticatedHTTPRequest(ctx, lakeBTCCreateWithdraw, params, &resp)
 }
 
 // SendHTTPRequest sends an unauthenticated http request
-func (l *LakeBTC) SendHTTPRequest(path string, result interface{}) error {
-	return l.SendPayload("GET", path, nil, nil, result, false, l.Verbose)
+func (l *LakeBTC) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return l.SendPayload(ctx, "GET", path, nil, nil, result, false, l.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an autheticated HTTP request to a LakeBTC
-func (l *LakeBTC) SendAuthenticatedHTTPRequest(method, params string, result interface{}) (err error) {
+func (l *LakeBTC) SendAuthenticatedHTTPRequest(ctx context.Context, method, params string, result interface{}) (err error) {
 	if !l.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, l.Name)
 	}
@@ -344,7 +345,7 @@ func (l *LakeBTC) SendAuthenticatedHTTPRequest(method, params string, result int
 	headers["Authorization"] = "Basic " + common.Base64Encode([]byte(l.APIKey+":"+common.HexEncodeToString(hmac)))
 	headers["Content-Type"] = "application/json-rpc"
 
-	return l.SendPayload("POST", l.APIUrl, headers, strings.NewReader(string(data)), result, true, l.Verbose)
+	return l.SendPayload(ctx, "POST", l.APIUrl, headers, strings.NewReader(string(data)), result, true, l.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
@@ -1,16 +1,25 @@
 package lakebtc
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var l LakeBTC
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 // Please add your own APIkeys to do correct due diligence testing.
 const (
 	apiKey                  = ""
@@ -39,7 +48,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetTradablePairs(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTradablePairs()
+	_, err := l.GetTradablePairs(context.Background())
 	if err != nil {
 		t.Fatalf("Test failed. GetTradablePairs err: %s", err)
 	}
@@ -47,7 +56,7 @@ func TestGetTradablePairs(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTicker()
+	_, err := l.GetTicker(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetTicker() error", err)
 	}
@@ -55,7 +64,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetOrderBook("BTCUSD")
+	_, err := l.GetOrderBook(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error("Test Failed - GetOrderBook() error", err)
 	}
@@ -63,7 +72,7 @@ func TestGetOrderBook(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTradeHistory("BTCUSD")
+	_, err := l.GetTradeHistory(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error("Test Failed - GetTradeHistory() error", err)
 	}
@@ -74,7 +83,7 @@ func TestTrade(t *testing.T) {
 	if l.APIKey == "" || l.APISecret == "" {
 		t.Skip()
 	}
-	_, err := l.Trade(false, 0, 0, "USD")
+	_, err := l.Trade(context.Background(), false, 0, 0, "USD")
 	if err == nil {
 		t.Error("Test Failed - Trade() error", err)
 	}
@@ -85,7 +94,7 @@ func TestGetOpenOrders(t *testing.T) {
 	if l.APIKey == "" || l.APISecret == "" {
 		t.Skip()
 	}
-	_, err := l.GetOpenOrders()
+	_, err := l.GetOpenOrders(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetOpenOrders() error", err)
 	}
@@ -96,7 +105,7 @@ func TestGetOrders(t *testing.T) {
 	if l.APIKey == "" || l.APISecret == "" {
 		t.Skip()
 	}
-	_, err := l.GetOrders([]int64{1, 2})
+	_, err := l.GetOrders(context.Background(), []int64{1, 2})
 	if err == nil {
 		t.Error("Test Failed - GetOrders() error", err)
 	}
@@ -107,7 +116,7 @@ func TestCancelOrder(t *testing.T) {
 	if l.APIKey == "" || l.APISecret == "" {
 		t.Skip()
 	}
-	err := l.CancelExistingOrder(1337)
+	err := l.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - CancelExistingOrder() error", err)
 	}
@@ -118,7 +127,7 @@ func TestGetTrades(t *testing.T) {
 	if l.APIKey == "" || l.APISecret == "" {
 		t.Skip()
 	}
-	_, err := l.GetTrades(1337)
+	_, err := l.GetTrades(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - GetTrades() error", err)
 	}
@@ -129,7 +138,7 @@ func TestGetExternalAccounts(t *testing.T) {
 	if l.APIKey == "" || l.APISecret == "" {
 		t.Skip()
 	}
-	_, err := l.GetExternalAccounts()
+	_, err := l.GetExternalAccounts(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetExternalAccounts() error", err)
 	}
@@ -140,7 +149,7 @@ func TestCreateWithdraw(t *testing.T) {
 	if l.APIKey == "" || l.APISecret == "" {
 		t.Skip()
 	}
-	_, err := l.CreateWithdraw(0, 1337)
+	_, err := l.CreateWithdraw(context.Background(), 0, 1337)
 	if err == nil {
 		t.Error("Test Failed - CreateWithdraw() error", err)
 	}
@@ -248,6 +257,57 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a LakeBTC using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *LakeBTC {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	lakebtcConfig, err := cfg.GetExchangeConfig("LakeBTC")
+	if err != nil {
+		t.Fatal("Test Failed - LakeBTC Setup() init error")
+	}
+
+	lakebtcConfig.AuthenticatedAPISupport = true
+	lakebtcConfig.APIKey, lakebtcConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r LakeBTC
+	r.SetDefaults()
+	r.Setup(lakebtcConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test Failed - LakeBTC GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "5051" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].QuoteCurrency != symbol.USD ||
+		orders[0].OrderSide != "buy" || orders[0].Price != 6300 ||
+		orders[0].OpenVolume != 0.5 || orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - LakeBTC GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		StartTicks: time.Unix(1529900000, 0),
+	})
+	if err != nil {
+		t.Fatal("Test Failed - LakeBTC GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].Status != "FILLED" ||
+		orders[0].OrderSide != "sell" || orders[0].Price != 6400 ||
+		orders[0].ExecutedAmount != 0.25) {
+		t.Errorf("Test Failed - LakeBTC GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -273,7 +333,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.EUR,
 	}
-	response, err := l.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := l.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -299,7 +359,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := l.CancelOrder(orderCancellation)
+	err := l.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
@@ -1,10 +1,12 @@
 package lakebtc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -29,7 +31,7 @@ func (l *LakeBTC) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", l.GetName(), len(l.EnabledPairs), l.EnabledPairs)
 	}
 
-	exchangeProducts, err := l.GetTradablePairs()
+	exchangeProducts, err := l.GetTradablePairs(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available products.\n", l.GetName())
 	} else {
@@ -41,8 +43,8 @@ func (l *LakeBTC) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (l *LakeBTC) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
-	tick, err := l.GetTicker()
+func (l *LakeBTC) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+	tick, err := l.GetTicker(ctx)
 	if err != nil {
 		return ticker.Price{}, err
 	}
@@ -63,27 +65,27 @@ func (l *LakeBTC) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pr
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (l *LakeBTC) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (l *LakeBTC) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(l.GetName(), p, assetType)
 	if err != nil {
-		return l.UpdateTicker(p, assetType)
+		return l.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (l *LakeBTC) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (l *LakeBTC) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(l.GetName(), p, assetType)
 	if err != nil {
-		return l.UpdateOrderbook(p, assetType)
+		return l.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (l *LakeBTC) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (l *LakeBTC) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := l.GetOrderBook(p.Pair().String())
+	orderbookNew, err := l.GetOrderBook(ctx, p.Pair().String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -102,10 +104,10 @@ func (l *LakeBTC) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderb
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // LakeBTC exchange
-func (l *LakeBTC) GetAccountInfo() (exchange.AccountInfo, error) {
+func (l *LakeBTC) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = l.GetName()
-	accountInfo, err := l.GetAccountInformation()
+	accountInfo, err := l.GetAccountInformation(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -126,23 +128,27 @@ func (l *LakeBTC) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (l *LakeBTC) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (l *LakeBTC) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (l *LakeBTC) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (l *LakeBTC) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (l *LakeBTC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (l *LakeBTC) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	isBuyOrder := side == exchange.Buy
-	response, err := l.Trade(isBuyOrder, amount, price, common.StringToLower(p.Pair().String()))
+	if err := l.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	isBuyOrder := order.Side == exchange.Buy
+	response, err := l.Trade(ctx, isBuyOrder, order.Amount, order.Price, common.StringToLower(order.Pair.Pair().String()))
 
 	if response.ID > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response.ID)
@@ -157,46 +163,73 @@ func (l *LakeBTC) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orde
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (l *LakeBTC) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (l *LakeBTC) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (l *LakeBTC) CancelOrder(order exchange.OrderCancellation) error {
+func (l *LakeBTC) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	return l.CancelExistingOrder(orderIDInt)
+	return l.CancelExistingOrder(ctx, orderIDInt)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (l *LakeBTC) CancelAllOrders() error {
+func (l *LakeBTC) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (l *LakeBTC) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (l *LakeBTC) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (l *LakeBTC) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (l *LakeBTC) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (l *LakeBTC) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (l *LakeBTC) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (l *LakeBTC) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (l *LakeBTC) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (l *LakeBTC) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *LakeBTC) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (l *LakeBTC) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (l *LakeBTC) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (l *LakeBTC) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -220,3 +253,81 @@ func (l *LakeBTC) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error)
 func (l *LakeBTC) GetWithdrawCapabilities() uint32 {
 	return l.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (l *LakeBTC) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := l.GetOpenOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for i := range resp {
+		p := l.GetPairFromSymbol(resp[i].Symbol)
+		orderDate := time.Unix(resp[i].At, 0)
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:      l.Name,
+			ID:            strconv.FormatInt(resp[i].ID, 10),
+			BaseCurrency:  p.FirstCurrency.String(),
+			QuoteCurrency: p.SecondCurrency.String(),
+			OrderSide:     resp[i].Type,
+			OrderType:     exchange.Limit.ToString(),
+			CreationTime:  orderDate.Unix(),
+			OrderDate:     orderDate,
+			Status:        "OPEN",
+			Price:         resp[i].Price,
+			Amount:        resp[i].Amount,
+			OpenVolume:    resp[i].Amount,
+		})
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (l *LakeBTC) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	var timestamp int64
+	if !getOrdersRequest.StartTicks.IsZero() {
+		timestamp = getOrdersRequest.StartTicks.Unix()
+	}
+
+	resp, err := l.GetTrades(ctx, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	// LakeBTC only returns past trades without order IDs, each fill is
+	// reported as a filled order priced at its average price
+	var orders []exchange.OrderDetail
+	for i := range resp {
+		var price float64
+		if resp[i].Amount > 0 {
+			price = resp[i].Total / resp[i].Amount
+		}
+
+		p := l.GetPairFromSymbol(resp[i].Symbol)
+		orderDate := time.Unix(resp[i].At, 0)
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:       l.Name,
+			BaseCurrency:   p.FirstCurrency.String(),
+			QuoteCurrency:  p.SecondCurrency.String(),
+			OrderSide:      resp[i].Type,
+			OrderType:      exchange.Limit.ToString(),
+			CreationTime:   orderDate.Unix(),
+			OrderDate:      orderDate,
+			Status:         "FILLED",
+			Price:          price,
+			Amount:         resp[i].Amount,
+			ExecutedAmount: resp[i].Amount,
+		})
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (l *LakeBTC) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
@@ -1,6 +1,8 @@
 package liqui
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
@@ -125,11 +127,11 @@ func (l *Liqui) GetAvailablePairs(nonHidden bool) []string {
 // the maximum number of digits after the decimal point, the minimum price, the
 // maximum price, the minimum transaction size, whether the pair is hidden, the
 // commission for each pair.
-func (l *Liqui) GetInfo() (Info, error) {
+func (l *Liqui) GetInfo(ctx context.Context) (Info, error) {
 	resp := Info{}
 	req := fmt.Sprintf("%s/%s/%s/", l.APIUrl, liquiAPIPublicVersion, liquiInfo)
 
-	return resp, l.SendHTTPRequest(req, &resp)
+	return resp, l.SendHTTPRequest(ctx, req, &resp)
 }
 
 // GetTicker returns information about currently active pairs, such as: the
@@ -138,7 +140,7 @@ func (l *Liqui) GetInfo() (Info, error) {
 // over the past 24 hours.
 //
 // currencyPair - example "eth_btc"
-func (l *Liqui) GetTicker(currencyPair string) (map[string]Ticker, error) {
+func (l *Liqui) GetTicker(ctx context.Context, currencyPair string) (map[string]Ticker, error) {
 	type Response struct {
 		Data    map[string]Ticker
 		Success int    `json:"success"`
@@ -148,13 +150,13 @@ func (l *Liqui) GetTicker(currencyPair string) (map[string]Ticker, error) {
 	response := Response{Data: make(map[string]Ticker)}
 	req := fmt.Sprintf("%s/%s/%s/%s", l.APIUrl, liquiAPIPublicVersion, liquiTicker, currencyPair)
 
-	return response.Data, l.SendHTTPRequest(req, &response.Data)
+	return response.Data, l.SendHTTPRequest(ctx, req, &response.Data)
 }
 
 // GetDepth information about active orders on the pair. Additionally it accepts
 // an optional GET-parameter limit, which indicates how many orders should be
 // displayed (150 by default). Is set to less than 2000.
-func (l *Liqui) GetDepth(currencyPair string) (Orderbook, error) {
+func (l *Liqui) GetDepth(ctx context.Context, currencyPair string) (Orderbook, error) {
 	type Response struct {
 		Data    map[string]Orderbook
 		Success int    `json:"success"`
@@ -164,13 +166,13 @@ func (l *Liqui) GetDepth(currencyPair string) (Orderbook, error) {
 	response := Response{Data: make(map[string]Orderbook)}
 	req := fmt.Sprintf("%s/%s/%s/%s", l.APIUrl, liquiAPIPublicVersion, liquiDepth, currencyPair)
 
-	return response.Data[currencyPair], l.SendHTTPRequest(req, &response.Data)
+	return response.Data[currencyPair], l.SendHTTPRequest(ctx, req, &response.Data)
 }
 
 // GetTrades returns information about the last trades. Additionally it accepts
 // an optional GET-parameter limit, which indicates how many orders should be
 // displayed (150 by default). The maximum allowable value is 2000.
-func (l *Liqui) GetTrades(currencyPair string) ([]Trades, error) {
+func (l *Liqui) GetTrades(ctx context.Context, currencyPair string) ([]Trades, error) {
 	type Response struct {
 		Data    map[string][]Trades
 		Success int    `json:"success"`
@@ -180,22 +182,22 @@ func (l *Liqui) GetTrades(currencyPair string) ([]Trades, error) {
 	response := Response{Data: make(map[string][]Trades)}
 	req := fmt.Sprintf("%s/%s/%s/%s", l.APIUrl, liquiAPIPublicVersion, liquiTrades, currencyPair)
 
-	return response.Data[currencyPair], l.SendHTTPRequest(req, &response.Data)
+	return response.Data[currencyPair], l.SendHTTPRequest(ctx, req, &response.Data)
 }
 
 // GetAccountInformation returns information about the user’s current balance, API-key
 // privileges, the number of open orders and Server Time. To use this method you
 // need a privilege of the key info.
-func (l *Liqui) GetAccountInformation() (AccountInfo, error) {
+func (l *Liqui) GetAccountInformation(ctx context.Context) (AccountInfo, error) {
 	var result AccountInfo
 
 	return result,
-		l.SendAuthenticatedHTTPRequest(liquiAccountInfo, url.Values{}, &result)
+		l.SendAuthenticatedHTTPRequest(ctx, liquiAccountInfo, url.Values{}, &result)
 }
 
 // Trade creates orders on the exchange.
 // to-do: convert orderid to int64
-func (l *Liqui) Trade(pair, orderType string, amount, price float64) (float64, error) {
+func (l *Liqui) Trade(ctx context.Context, pair, orderType string, amount, price float64) (float64, error) {
 	req := url.Values{}
 	req.Add("pair", pair)
 	req.Add("type", orderType)
@@ -204,37 +206,39 @@ func (l *Liqui) Trade(pair, orderType string, amount, price float64) (float64, e
 
 	var result Trade
 
-	return result.OrderID, l.SendAuthenticatedHTTPRequest(liquiTrade, req, &result)
+	return result.OrderID, l.SendAuthenticatedHTTPRequest(ctx, liquiTrade, req, &result)
 }
 
-// GetActiveOrders returns the list of your active orders.
-func (l *Liqui) GetActiveOrders(pair string) (map[string]ActiveOrders, error) {
+// GetOpenOrders returns the list of your active orders.
+func (l *Liqui) GetOpenOrders(ctx context.Context, pair string) (map[string]ActiveOrders, error) {
 	result := make(map[string]ActiveOrders)
 
 	req := url.Values{}
-	req.Add("pair", pair)
+	if pair != "" {
+		req.Add("pair", pair)
+	}
 
-	return result, l.SendAuthenticatedHTTPRequest(liquiActiveOrders, req, &result)
+	return result, l.SendAuthenticatedHTTPRequest(ctx, liquiActiveOrders, req, &result)
 }
 
 // GetOrderInfoByID returns the information on particular order.
-func (l *Liqui) GetOrderInfoByID(OrderID int64) (map[string]OrderInfo, error) {
+func (l *Liqui) GetOrderInfoByID(ctx context.Context, OrderID int64) (map[string]OrderInfo, error) {
 	result := make(map[string]OrderInfo)
 
 	req := url.Values{}
 	req.Add("order_id", strconv.FormatInt(OrderID, 10))
 
-	return result, l.SendAuthenticatedHTTPRequest(liquiOrderInfo, req, &result)
+	return result, l.SendAuthenticatedHTTPRequest(ctx, liquiOrderInfo, req, &result)
 }
 
 // CancelExistingOrder method is used for order cancelation.
-func (l *Liqui) CancelExistingOrder(OrderID int64) (bool, error) {
+func (l *Liqui) CancelExistingOrder(ctx context.Context, OrderID int64) (bool, error) {
 	req := url.Values{}
 	req.Add("order_id", strconv.FormatInt(OrderID, 10))
 
 	var result CancelOrder
 
-	err := l.SendAuthenticatedHTTPRequest(liquiCancelOrder, req, &result)
+	err := l.SendAuthenticatedHTTPRequest(ctx, liquiCancelOrder, req, &result)
 	if err != nil {
 		return false, err
 	}
@@ -243,36 +247,36 @@ func (l *Liqui) CancelExistingOrder(OrderID int64) (bool, error) {
 }
 
 // GetTradeHistory returns trade history
-func (l *Liqui) GetTradeHistory(vals url.Values, pair string) (map[string]TradeHistory, error) {
+func (l *Liqui) GetTradeHistory(ctx context.Context, vals url.Values, pair string) (map[string]TradeHistory, error) {
 	result := make(map[string]TradeHistory)
 
 	if pair != "" {
 		vals.Add("pair", pair)
 	}
 
-	return result, l.SendAuthenticatedHTTPRequest(liquiTradeHistory, vals, &result)
+	return result, l.SendAuthenticatedHTTPRequest(ctx, liquiTradeHistory, vals, &result)
 }
 
 // WithdrawCoins is designed for cryptocurrency withdrawals.
 // API mentions that this isn't active now, but will be soon - you must provide the first 8 characters of the key
 // in your ticket to support.
-func (l *Liqui) WithdrawCoins(coin string, amount float64, address string) (WithdrawCoins, error) {
+func (l *Liqui) WithdrawCoins(ctx context.Context, coin string, amount float64, address string) (WithdrawCoins, error) {
 	req := url.Values{}
 	req.Add("coinName", coin)
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	req.Add("address", address)
 
 	var result WithdrawCoins
-	return result, l.SendAuthenticatedHTTPRequest(liquiWithdrawCoin, req, &result)
+	return result, l.SendAuthenticatedHTTPRequest(ctx, liquiWithdrawCoin, req, &result)
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (l *Liqui) SendHTTPRequest(path string, result interface{}) error {
-	return l.SendPayload("GET", path, nil, nil, result, false, l.Verbose)
+func (l *Liqui) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return l.SendPayload(ctx, "GET", path, nil, nil, result, false, l.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated http request to liqui
-func (l *Liqui) SendAuthenticatedHTTPRequest(method string, values url.Values, result interface{}) (err error) {
+func (l *Liqui) SendAuthenticatedHTTPRequest(ctx context.Context, method string, values url.Values, result interface{}) (err error) {
 	if !l.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, l.Name)
 	}
@@ -298,12 +302,21 @@ func (l *Liqui) SendAuthenticatedHTTPRequest(method string, values url.Values, r
 	headers["Sign"] = common.HexEncodeToString(hmac)
 	headers["Content-Type"] = "application/x-www-form-urlencoded"
 
-	return l.SendPayload("POST",
+	response := Response{Return: result}
+	err = l.SendPayload(ctx, "POST",
 		l.APIUrlSecondary, headers,
 		strings.NewReader(encoded),
-		result,
+		&response,
 		true,
 		l.Verbose)
+	if err != nil {
+		return err
+	}
+
+	if response.Success != 1 {
+		return errors.New(response.Error)
+	}
+	return nil
 }
 
 // GetFee returns an estimate of fee based on type of transaction
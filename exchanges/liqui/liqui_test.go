@@ -1,17 +1,26 @@
 package liqui
 
 import (
+	"context"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var l Liqui
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 const (
 	apiKey                  = ""
 	apiSecret               = ""
@@ -47,7 +56,7 @@ func TestGetAvailablePairs(t *testing.T) {
 
 func TestGetInfo(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetInfo()
+	_, err := l.GetInfo(context.Background())
 	if err != nil {
 		t.Error("Test Failed - liqui GetInfo() error", err)
 	}
@@ -55,7 +64,7 @@ func TestGetInfo(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTicker("eth_btc")
+	_, err := l.GetTicker(context.Background(), "eth_btc")
 	if err != nil {
 		t.Error("Test Failed - liqui GetTicker() error", err)
 	}
@@ -63,7 +72,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetDepth("eth_btc")
+	_, err := l.GetDepth(context.Background(), "eth_btc")
 	if err != nil {
 		t.Error("Test Failed - liqui GetDepth() error", err)
 	}
@@ -71,7 +80,7 @@ func TestGetDepth(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTrades("eth_btc")
+	_, err := l.GetTrades(context.Background(), "eth_btc")
 	if err != nil {
 		t.Error("Test Failed - liqui GetTrades() error", err)
 	}
@@ -79,37 +88,37 @@ func TestGetTrades(t *testing.T) {
 
 func TestAuthRequests(t *testing.T) {
 	if l.APIKey != "" && l.APISecret != "" {
-		_, err := l.GetAccountInfo()
+		_, err := l.GetAccountInfo(context.Background())
 		if err == nil {
 			t.Error("Test Failed - liqui GetAccountInfo() error", err)
 		}
 
-		_, err = l.Trade("", "", 0, 1)
+		_, err = l.Trade(context.Background(), "", "", 0, 1)
 		if err == nil {
 			t.Error("Test Failed - liqui Trade() error", err)
 		}
 
-		_, err = l.GetActiveOrders("eth_btc")
+		_, err = l.GetOpenOrders(context.Background(), "eth_btc")
 		if err == nil {
-			t.Error("Test Failed - liqui GetActiveOrders() error", err)
+			t.Error("Test Failed - liqui GetOpenOrders() error", err)
 		}
 
-		_, err = l.GetOrderInfo(1337)
+		_, err = l.GetOrderInfo(context.Background(), "1337")
 		if err == nil {
 			t.Error("Test Failed - liqui GetOrderInfo() error", err)
 		}
 
-		_, err = l.CancelExistingOrder(1337)
+		_, err = l.CancelExistingOrder(context.Background(), 1337)
 		if err == nil {
 			t.Error("Test Failed - liqui CancelExistingOrder() error", err)
 		}
 
-		_, err = l.GetTradeHistory(url.Values{}, "")
+		_, err = l.GetTradeHistory(context.Background(), url.Values{}, "")
 		if err == nil {
 			t.Error("Test Failed - liqui GetTradeHistory() error", err)
 		}
 
-		_, err = l.WithdrawCoins("btc", 1337, "someaddr")
+		_, err = l.WithdrawCoins(context.Background(), "btc", 1337, "someaddr")
 		if err == nil {
 			t.Error("Test Failed - liqui WithdrawCoins() error", err)
 		}
@@ -118,7 +127,7 @@ func TestAuthRequests(t *testing.T) {
 
 func TestUpdateTicker(t *testing.T) {
 	p := pair.NewCurrencyPairDelimiter("ETH_BTC", "_")
-	_, err := l.UpdateTicker(p, "SPOT")
+	_, err := l.UpdateTicker(context.Background(), p, "SPOT")
 	if err != nil {
 		t.Error("Test Failed - liqui UpdateTicker() error", err)
 	}
@@ -126,7 +135,7 @@ func TestUpdateTicker(t *testing.T) {
 
 func TestUpdateOrderbook(t *testing.T) {
 	p := pair.NewCurrencyPairDelimiter("ETH_BTC", "_")
-	_, err := l.UpdateOrderbook(p, "SPOT")
+	_, err := l.UpdateOrderbook(context.Background(), p, "SPOT")
 	if err != nil {
 		t.Error("Test Failed - liqui UpdateOrderbook() error", err)
 	}
@@ -233,6 +242,60 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Liqui using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *Liqui {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	liquiConfig, err := cfg.GetExchangeConfig("Liqui")
+	if err != nil {
+		t.Fatal("Test Failed - Liqui Setup() init error")
+	}
+
+	liquiConfig.AuthenticatedAPISupport = true
+	liquiConfig.APIKey, liquiConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r Liqui
+	r.SetDefaults()
+	r.Setup(liquiConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.LTC, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Liqui GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "343152" ||
+		orders[0].BaseCurrency != symbol.LTC || orders[0].QuoteCurrency != symbol.BTC ||
+		orders[0].OrderSide != "sell" || orders[0].Status != "ACTIVE" ||
+		orders[0].Price != 0.015 || orders[0].OpenVolume != 1.5 ||
+		orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - Liqui GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		StartTicks: time.Unix(1529900000, 0),
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Liqui GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "343148" ||
+		orders[0].Status != "EXECUTED" || orders[0].OrderSide != "buy" ||
+		orders[0].Price != 0.0148 || orders[0].ExecutedAmount != 2) {
+		t.Errorf("Test Failed - Liqui GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -258,7 +321,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.EUR,
 	}
-	response, err := l.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := l.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -284,7 +347,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := l.CancelOrder(orderCancellation)
+	err := l.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
@@ -66,7 +66,7 @@ type AccountInfo struct {
 // ActiveOrders holds active order information
 type ActiveOrders struct {
 	Pair             string  `json:"pair"`
-	Type             string  `json:"sell"`
+	Type             string  `json:"type"`
 	Amount           float64 `json:"amount"`
 	Rate             float64 `json:"rate"`
 	TimestampCreated float64 `json:"time_created"`
@@ -78,7 +78,7 @@ type ActiveOrders struct {
 // OrderInfo holds specific order information
 type OrderInfo struct {
 	Pair             string  `json:"pair"`
-	Type             string  `json:"sell"`
+	Type             string  `json:"type"`
 	StartAmount      float64 `json:"start_amount"`
 	Amount           float64 `json:"amount"`
 	Rate             float64 `json:"rate"`
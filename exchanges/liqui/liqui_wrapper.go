@@ -1,10 +1,13 @@
 package liqui
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -13,6 +16,14 @@ import (
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
 )
 
+// orderStatus maps the Liqui order status codes to order statuses
+var orderStatus = map[int]string{
+	0: "ACTIVE",
+	1: "EXECUTED",
+	2: "CANCELLED",
+	3: "PARTIALLY_CANCELLED",
+}
+
 // Start starts the Liqui go routine
 func (l *Liqui) Start(wg *sync.WaitGroup) {
 	wg.Add(1)
@@ -30,7 +41,7 @@ func (l *Liqui) Run() {
 	}
 
 	var err error
-	l.Info, err = l.GetInfo()
+	l.Info, err = l.GetInfo(context.Background())
 	if err != nil {
 		log.Printf("%s Unable to fetch info.\n", l.GetName())
 	} else {
@@ -43,7 +54,7 @@ func (l *Liqui) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (l *Liqui) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (l *Liqui) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	pairsString, err := exchange.GetAndFormatExchangeCurrencies(l.Name,
 		l.GetEnabledCurrencies())
@@ -51,7 +62,7 @@ func (l *Liqui) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pric
 		return tickerPrice, err
 	}
 
-	result, err := l.GetTicker(pairsString.String())
+	result, err := l.GetTicker(ctx, pairsString.String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -74,27 +85,27 @@ func (l *Liqui) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pric
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (l *Liqui) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (l *Liqui) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(l.Name, p, assetType)
 	if err != nil {
-		return l.UpdateTicker(p, assetType)
+		return l.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (l *Liqui) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (l *Liqui) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(l.Name, p, assetType)
 	if err != nil {
-		return l.UpdateOrderbook(p, assetType)
+		return l.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (l *Liqui) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (l *Liqui) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := l.GetDepth(exchange.FormatExchangeCurrency(l.Name, p).String())
+	orderbookNew, err := l.GetDepth(ctx, exchange.FormatExchangeCurrency(l.Name, p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -115,10 +126,10 @@ func (l *Liqui) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderboo
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // Liqui exchange
-func (l *Liqui) GetAccountInfo() (exchange.AccountInfo, error) {
+func (l *Liqui) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = l.GetName()
-	accountBalance, err := l.GetAccountInformation()
+	accountBalance, err := l.GetAccountInformation(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -136,22 +147,26 @@ func (l *Liqui) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (l *Liqui) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (l *Liqui) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (l *Liqui) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (l *Liqui) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (l *Liqui) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (l *Liqui) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	response, err := l.Trade(p.Pair().String(), fmt.Sprintf("%s", orderType), amount, price)
+	if err := l.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	response, err := l.Trade(ctx, order.Pair.Pair().String(), fmt.Sprintf("%s", order.OrderType), order.Amount, order.Price)
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -166,48 +181,75 @@ func (l *Liqui) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderT
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (l *Liqui) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (l *Liqui) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (l *Liqui) CancelOrder(order exchange.OrderCancellation) error {
+func (l *Liqui) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = l.CancelExistingOrder(orderIDInt)
+	_, err = l.CancelExistingOrder(ctx, orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (l *Liqui) CancelAllOrders() error {
+func (l *Liqui) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (l *Liqui) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (l *Liqui) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (l *Liqui) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (l *Liqui) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (l *Liqui) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (l *Liqui) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (l *Liqui) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (l *Liqui) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (l *Liqui) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *Liqui) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (l *Liqui) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (l *Liqui) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (l *Liqui) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -231,3 +273,90 @@ func (l *Liqui) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (l *Liqui) GetWithdrawCapabilities() uint32 {
 	return l.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (l *Liqui) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	symbols := []string{""}
+	if len(getOrdersRequest.Currencies) > 0 {
+		symbols = symbols[:0]
+		for _, c := range getOrdersRequest.Currencies {
+			symbols = append(symbols,
+				exchange.FormatExchangeCurrency(l.Name, c).String())
+		}
+	}
+
+	var orders []exchange.OrderDetail
+	for _, symbol := range symbols {
+		resp, err := l.GetOpenOrders(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		for ID, order := range resp {
+			p := pair.NewCurrencyPairDelimiter(order.Pair, "_")
+			orderDate := time.Unix(int64(order.TimestampCreated), 0)
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:      l.Name,
+				ID:            ID,
+				BaseCurrency:  p.FirstCurrency.Upper().String(),
+				QuoteCurrency: p.SecondCurrency.Upper().String(),
+				OrderSide:     order.Type,
+				OrderType:     exchange.Limit.ToString(),
+				CreationTime:  orderDate.Unix(),
+				OrderDate:     orderDate,
+				Status:        orderStatus[order.Status],
+				Price:         order.Rate,
+				Amount:        order.Amount,
+				OpenVolume:    order.Amount,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (l *Liqui) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	vals := url.Values{}
+	if !getOrdersRequest.StartTicks.IsZero() {
+		vals.Set("since", strconv.FormatInt(getOrdersRequest.StartTicks.Unix(), 10))
+	}
+	if !getOrdersRequest.EndTicks.IsZero() {
+		vals.Set("end", strconv.FormatInt(getOrdersRequest.EndTicks.Unix(), 10))
+	}
+
+	resp, err := l.GetTradeHistory(ctx, vals, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Liqui only returns past trades, each fill is reported as a filled order
+	var orders []exchange.OrderDetail
+	for _, trade := range resp {
+		p := pair.NewCurrencyPairDelimiter(trade.Pair, "_")
+		orderDate := time.Unix(int64(trade.Timestamp), 0)
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:       l.Name,
+			ID:             strconv.FormatFloat(trade.OrderID, 'f', -1, 64),
+			BaseCurrency:   p.FirstCurrency.Upper().String(),
+			QuoteCurrency:  p.SecondCurrency.Upper().String(),
+			OrderSide:      trade.Type,
+			OrderType:      exchange.Limit.ToString(),
+			CreationTime:   orderDate.Unix(),
+			OrderDate:      orderDate,
+			Status:         orderStatus[1],
+			Price:          trade.Rate,
+			Amount:         trade.Amount,
+			ExecutedAmount: trade.Amount,
+		})
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (l *Liqui) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
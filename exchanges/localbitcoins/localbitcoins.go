@@ -1,6 +1,7 @@
 package localbitcoins
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -170,20 +171,20 @@ func (l *LocalBitcoins) Setup(exch config.ExchangeConfig) {
 // GetAccountInformation lets you retrieve the public user information on a
 // LocalBitcoins user. The response contains the same information that is found
 // on an account's public profile page.
-func (l *LocalBitcoins) GetAccountInformation(username string, self bool) (AccountInfo, error) {
+func (l *LocalBitcoins) GetAccountInformation(ctx context.Context, username string, self bool) (AccountInfo, error) {
 	type response struct {
 		Data AccountInfo `json:"data"`
 	}
 	resp := response{}
 
 	if self {
-		err := l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPIMyself, nil, &resp)
+		err := l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPIMyself, nil, &resp)
 		if err != nil {
 			return resp.Data, err
 		}
 	} else {
 		path := fmt.Sprintf("%s/%s/%s/", l.APIUrl, localbitcoinsAPIAccountInfo, username)
-		err := l.SendHTTPRequest(path, &resp)
+		err := l.SendHTTPRequest(ctx, path, &resp)
 		if err != nil {
 			return resp.Data, err
 		}
@@ -196,25 +197,25 @@ func (l *LocalBitcoins) GetAccountInformation(username string, self bool) (Accou
 // adID omitted.
 //
 // adID - [optional] string if omitted returns all ads
-func (l *LocalBitcoins) Getads(args ...string) (AdData, error) {
+func (l *LocalBitcoins) Getads(ctx context.Context, args ...string) (AdData, error) {
 	var resp struct {
 		Data AdData `json:"data"`
 	}
 
 	if len(args) == 0 {
-		return resp.Data, l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPIAds, nil, &resp)
+		return resp.Data, l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPIAds, nil, &resp)
 	}
 
 	params := url.Values{"ads": {strings.Join(args, ",")}}
 
-	return resp.Data, l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPIAdGet, params, &resp)
+	return resp.Data, l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPIAdGet, params, &resp)
 }
 
 // EditAd updates set advertisements
 //
 // params - see localbitcoins_types.go AdEdit for reference
 // adID - string for the ad you already created
-func (l *LocalBitcoins) EditAd(params AdEdit, adID string) error {
+func (l *LocalBitcoins) EditAd(ctx context.Context, params AdEdit, adID string) error {
 	type response struct {
 		Data AdData `json:"data"`
 	}
@@ -222,14 +223,14 @@ func (l *LocalBitcoins) EditAd(params AdEdit, adID string) error {
 	resp := response{}
 	//request := make(map[string]interface{})
 
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIAdEdit+adID+"/", nil, &resp)
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIAdEdit+adID+"/", nil, &resp)
 }
 
 // CreateAd creates a new advertisement
 //
 // params - see localbitcoins_types.go AdCreate for reference
-func (l *LocalBitcoins) CreateAd(params AdCreate) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIAdCreate, nil, nil)
+func (l *LocalBitcoins) CreateAd(ctx context.Context, params AdCreate) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIAdCreate, nil, nil)
 }
 
 // UpdatePriceEquation updates price equation of an advertisement. If there are
@@ -238,106 +239,106 @@ func (l *LocalBitcoins) CreateAd(params AdCreate) error {
 //
 // equation - string of equation
 // adID - string of specific ad identification
-func (l *LocalBitcoins) UpdatePriceEquation(equation, adID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIUpdateEquation+adID, nil, nil)
+func (l *LocalBitcoins) UpdatePriceEquation(ctx context.Context, equation, adID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIUpdateEquation+adID, nil, nil)
 }
 
 // DeleteAd deletes the advertisement by adID.
 //
 // adID - string of specific ad identification
-func (l *LocalBitcoins) DeleteAd(adID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIDeleteAd+adID, nil, nil)
+func (l *LocalBitcoins) DeleteAd(ctx context.Context, adID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIDeleteAd+adID, nil, nil)
 }
 
 // ReleaseFunds releases Bitcoin trades specified by ID {contact_id}. If the
 // release was successful a message is returned on the data key.
-func (l *LocalBitcoins) ReleaseFunds(contactID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIRelease+contactID, nil, nil)
+func (l *LocalBitcoins) ReleaseFunds(ctx context.Context, contactID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIRelease+contactID, nil, nil)
 }
 
 // ReleaseFundsByPin releases Bitcoin trades specified by ID {contact_id}. if
 // the current pincode is provided. If the release was successful a message is
 // returned on the data key.
-func (l *LocalBitcoins) ReleaseFundsByPin(pin int, contactID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIReleaseByPin+contactID, nil, nil)
+func (l *LocalBitcoins) ReleaseFundsByPin(ctx context.Context, pin int, contactID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIReleaseByPin+contactID, nil, nil)
 }
 
 // MarkAsPaid marks a trade as paid.
-func (l *LocalBitcoins) MarkAsPaid(contactID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIMarkAsPaid+contactID, nil, nil)
+func (l *LocalBitcoins) MarkAsPaid(ctx context.Context, contactID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIMarkAsPaid+contactID, nil, nil)
 }
 
 // GetMessages returns all chat messages from the trade. Messages are on the message_list key.
-func (l *LocalBitcoins) GetMessages(contactID string) (Message, error) {
+func (l *LocalBitcoins) GetMessages(ctx context.Context, contactID string) (Message, error) {
 	type response struct {
 		MessageList Message `json:"message_list"`
 	}
 	resp := response{}
 
 	return resp.MessageList,
-		l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIMessages+contactID, nil, &resp)
+		l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIMessages+contactID, nil, &resp)
 }
 
 // SendMessage posts a message and/or uploads an image to the trade. Encode
 // images with multipart/form-data encoding.
-func (l *LocalBitcoins) SendMessage(msg, contactID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPISendMessage+contactID, nil, nil)
+func (l *LocalBitcoins) SendMessage(ctx context.Context, msg, contactID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPISendMessage+contactID, nil, nil)
 }
 
 // Dispute starts a dispute on the specified trade ID if the requirements for
 // starting the dispute has been fulfilled.
 //
 // topic - [optional] String	Short description of issue to LocalBitcoins customer support.
-func (l *LocalBitcoins) Dispute(topic, contactID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIDispute+contactID, nil, nil)
+func (l *LocalBitcoins) Dispute(ctx context.Context, topic, contactID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIDispute+contactID, nil, nil)
 }
 
 // CancelTrade cancels the trade if the token owner is the Bitcoin buyer.
 // Bitcoin sellers cannot cancel trades.
-func (l *LocalBitcoins) CancelTrade(contactID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPICancelTrade+contactID, nil, nil)
+func (l *LocalBitcoins) CancelTrade(ctx context.Context, contactID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPICancelTrade+contactID, nil, nil)
 }
 
 // FundTrade attempts to fund an unfunded local trade from the token owners
 // wallet. Works only if the token owner is the Bitcoin seller in the trade.
-func (l *LocalBitcoins) FundTrade(contactID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIFundTrade+contactID, nil, nil)
+func (l *LocalBitcoins) FundTrade(ctx context.Context, contactID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIFundTrade+contactID, nil, nil)
 }
 
 // ConfirmRealName creates or updates real name confirmation.
-func (l *LocalBitcoins) ConfirmRealName(contactID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIConfirmRealName+contactID, nil, nil)
+func (l *LocalBitcoins) ConfirmRealName(ctx context.Context, contactID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIConfirmRealName+contactID, nil, nil)
 }
 
 // VerifyIdentity marks the identity of trade partner as verified. You must be
 // the advertiser in this trade.
-func (l *LocalBitcoins) VerifyIdentity(contactID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIVerifyIdentity+contactID, nil, nil)
+func (l *LocalBitcoins) VerifyIdentity(ctx context.Context, contactID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIVerifyIdentity+contactID, nil, nil)
 }
 
 // InitiateTrade sttempts to start a Bitcoin trade from the specified
 // advertisement ID.
-func (l *LocalBitcoins) InitiateTrade(amount int, message, adID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIInitiateTrade+adID, nil, nil)
+func (l *LocalBitcoins) InitiateTrade(ctx context.Context, amount int, message, adID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIInitiateTrade+adID, nil, nil)
 }
 
 // GetTradeInfo returns information about a single trade that the token owner is
 // part in.
-func (l *LocalBitcoins) GetTradeInfo(contactID string) (dbi DashBoardInfo, err error) {
-	err = l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPITradeInfo+contactID+"/", nil, &dbi)
+func (l *LocalBitcoins) GetTradeInfo(ctx context.Context, contactID string) (dbi DashBoardInfo, err error) {
+	err = l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPITradeInfo+contactID+"/", nil, &dbi)
 	return
 }
 
 // GetCountryCodes returns a list of valid and recognized countrycodes
-func (l *LocalBitcoins) GetCountryCodes() error {
-	return l.SendHTTPRequest(l.APIUrl+localbitcoinsAPICountryCodes, nil)
+func (l *LocalBitcoins) GetCountryCodes(ctx context.Context) error {
+	return l.SendHTTPRequest(ctx, l.APIUrl+localbitcoinsAPICountryCodes, nil)
 }
 
 // GetCurrencies returns a list of valid and recognized fiat currencies. Also
 // contains human readable name for every currency and boolean that tells if
 // currency is an altcoin.
-func (l *LocalBitcoins) GetCurrencies() error {
-	return l.SendHTTPRequest(l.APIUrl+localbitcoinsAPICurrencies, nil)
+func (l *LocalBitcoins) GetCurrencies(ctx context.Context) error {
+	return l.SendHTTPRequest(ctx, l.APIUrl+localbitcoinsAPICurrencies, nil)
 }
 
 // GetDashboardInfo returns a list of trades on the data key contact_list. This
@@ -347,7 +348,7 @@ func (l *LocalBitcoins) GetCurrencies() error {
 // view contacts where the token owner is either buying or selling, respectively.
 // E.g. /api/dashboard/buyer/. All contacts where the token owner is
 // participating are returned.
-func (l *LocalBitcoins) GetDashboardInfo() ([]DashBoardInfo, error) {
+func (l *LocalBitcoins) GetDashboardInfo(ctx context.Context) ([]DashBoardInfo, error) {
 	var resp struct {
 		Data struct {
 			ContactList  []DashBoardInfo `json:"contact_list"`
@@ -356,12 +357,12 @@ func (l *LocalBitcoins) GetDashboardInfo() ([]DashBoardInfo, error) {
 	}
 
 	return resp.Data.ContactList,
-		l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPIDashboard, nil, &resp)
+		l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPIDashboard, nil, &resp)
 }
 
 // GetDashboardReleasedTrades returns a list of all released trades where the
 // token owner is either a buyer or seller.
-func (l *LocalBitcoins) GetDashboardReleasedTrades() ([]DashBoardInfo, error) {
+func (l *LocalBitcoins) GetDashboardReleasedTrades(ctx context.Context) ([]DashBoardInfo, error) {
 	var resp struct {
 		Data struct {
 			ContactList  []DashBoardInfo `json:"contact_list"`
@@ -370,12 +371,12 @@ func (l *LocalBitcoins) GetDashboardReleasedTrades() ([]DashBoardInfo, error) {
 	}
 
 	return resp.Data.ContactList,
-		l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPIDashboardReleased, nil, &resp)
+		l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPIDashboardReleased, nil, &resp)
 }
 
 // GetDashboardCancelledTrades returns a list of all canceled trades where the
 // token owner is either a buyer or seller.
-func (l *LocalBitcoins) GetDashboardCancelledTrades() ([]DashBoardInfo, error) {
+func (l *LocalBitcoins) GetDashboardCancelledTrades(ctx context.Context) ([]DashBoardInfo, error) {
 	var resp struct {
 		Data struct {
 			ContactList  []DashBoardInfo `json:"contact_list"`
@@ -384,12 +385,12 @@ func (l *LocalBitcoins) GetDashboardCancelledTrades() ([]DashBoardInfo, error) {
 	}
 
 	return resp.Data.ContactList,
-		l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPIDashboardCancelled, nil, &resp)
+		l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPIDashboardCancelled, nil, &resp)
 }
 
 // GetDashboardClosedTrades returns a list of all closed trades where the token
 // owner is either a buyer or seller.
-func (l *LocalBitcoins) GetDashboardClosedTrades() ([]DashBoardInfo, error) {
+func (l *LocalBitcoins) GetDashboardClosedTrades(ctx context.Context) ([]DashBoardInfo, error) {
 	var resp struct {
 		Data struct {
 			ContactList  []DashBoardInfo `json:"contact_list"`
@@ -398,7 +399,7 @@ func (l *LocalBitcoins) GetDashboardClosedTrades() ([]DashBoardInfo, error) {
 	}
 
 	return resp.Data.ContactList,
-		l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPIDashboardClosed, nil, &resp)
+		l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPIDashboardClosed, nil, &resp)
 }
 
 // SetFeedback gives feedback to user. Possible feedback values are: trust,
@@ -413,60 +414,60 @@ func (l *LocalBitcoins) GetDashboardClosedTrades() ([]DashBoardInfo, error) {
 // msg - [optional] Feedback message displayed alongside feedback on receivers
 // profile page.
 // username - username of trade contact
-func (l *LocalBitcoins) SetFeedback(msg, feedback, username string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIFeedback, nil, nil)
+func (l *LocalBitcoins) SetFeedback(ctx context.Context, msg, feedback, username string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIFeedback, nil, nil)
 }
 
 // Logout expires the current access token immediately. To get a new token
 // afterwards, public apps will need to re-authenticate, confidential apps can
 // turn in a refresh token.
-func (l *LocalBitcoins) Logout() error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPILogout, nil, nil)
+func (l *LocalBitcoins) Logout(ctx context.Context) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPILogout, nil, nil)
 }
 
 // CreateNewInvoice creates a new invoice.
-func (l *LocalBitcoins) CreateNewInvoice(currency, description, returnURL string, amount float64, internal bool) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPICreateInvoice, nil, nil)
+func (l *LocalBitcoins) CreateNewInvoice(ctx context.Context, currency, description, returnURL string, amount float64, internal bool) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPICreateInvoice, nil, nil)
 }
 
 // GetInvoice returns information about a specific invoice created by the token
 // owner.
-func (l *LocalBitcoins) GetInvoice(invoiceID string) (Invoice, error) {
+func (l *LocalBitcoins) GetInvoice(ctx context.Context, invoiceID string) (Invoice, error) {
 	resp := Invoice{}
-	return resp, l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPICreateInvoice, nil, &resp)
+	return resp, l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPICreateInvoice, nil, &resp)
 }
 
 // DeleteInvoice deletes a specific invoice. Deleting invoices is possible when
 // it is sure that receiver cannot accidentally pay the invoice at the same time
 // as the merchant is deleting it. You can use the API request
 // /api/merchant/invoice/{invoice_id}/ to check if deleting is possible.
-func (l *LocalBitcoins) DeleteInvoice(invoiceID string) (Invoice, error) {
+func (l *LocalBitcoins) DeleteInvoice(ctx context.Context, invoiceID string) (Invoice, error) {
 	resp := Invoice{}
-	return resp, l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPICreateInvoice, nil, &resp)
+	return resp, l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPICreateInvoice, nil, &resp)
 }
 
 // GetNotifications returns recent notifications.
-func (l *LocalBitcoins) GetNotifications() ([]NotificationInfo, error) {
+func (l *LocalBitcoins) GetNotifications(ctx context.Context) ([]NotificationInfo, error) {
 	resp := []NotificationInfo{}
-	return resp, l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIGetNotification, nil, &resp)
+	return resp, l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIGetNotification, nil, &resp)
 }
 
 // MarkNotifications marks a specific notification as read.
-func (l *LocalBitcoins) MarkNotifications(notificationID string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIMarkNotification, nil, nil)
+func (l *LocalBitcoins) MarkNotifications(ctx context.Context, notificationID string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIMarkNotification, nil, nil)
 }
 
 // GetPaymentMethods returns a list of valid payment methods. Also contains name
 // and code for payment methods, and possible limitations in currencies and bank
 // name choices.
-func (l *LocalBitcoins) GetPaymentMethods() error {
-	return l.SendHTTPRequest(l.APIUrl+localbitcoinsAPIPaymentMethods, nil)
+func (l *LocalBitcoins) GetPaymentMethods(ctx context.Context) error {
+	return l.SendHTTPRequest(ctx, l.APIUrl+localbitcoinsAPIPaymentMethods, nil)
 }
 
 // GetPaymentMethodsByCountry returns a list of valid payment methods filtered
 // by countrycodes.
-func (l *LocalBitcoins) GetPaymentMethodsByCountry(countryCode string) error {
-	return l.SendHTTPRequest(l.APIUrl+localbitcoinsAPIPaymentMethods+countryCode, nil)
+func (l *LocalBitcoins) GetPaymentMethodsByCountry(ctx context.Context, countryCode string) error {
+	return l.SendHTTPRequest(ctx, l.APIUrl+localbitcoinsAPIPaymentMethods+countryCode, nil)
 }
 
 // CheckPincode checks the given PIN code against the token owners currently
@@ -475,7 +476,7 @@ func (l *LocalBitcoins) GetPaymentMethodsByCountry(countryCode string) error {
 // Due to only requiring the read scope, the user is not guaranteed to have set
 // a PIN code. If you protect your application using this request, please make
 // the user has set a PIN code for his account.
-func (l *LocalBitcoins) CheckPincode(pin int) (bool, error) {
+func (l *LocalBitcoins) CheckPincode(ctx context.Context, pin int) (bool, error) {
 	type response struct {
 		Data struct {
 			PinOK bool `json:"pincode_ok"`
@@ -484,7 +485,7 @@ func (l *LocalBitcoins) CheckPincode(pin int) (bool, error) {
 	resp := response{}
 	values := url.Values{}
 	values.Set("pincode", strconv.Itoa(pin))
-	err := l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIPinCode, values, &resp)
+	err := l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIPinCode, values, &resp)
 
 	if err != nil {
 		return false, err
@@ -499,30 +500,30 @@ func (l *LocalBitcoins) CheckPincode(pin int) (bool, error) {
 
 // GetPlaces Looks up places near lat, lon and provides full URLs to buy and
 // sell listings for each.
-func (l *LocalBitcoins) GetPlaces(lat, lon int, location, countryCode string) error {
-	return l.SendHTTPRequest(l.APIUrl+localbitcoinsAPIPlaces, nil)
+func (l *LocalBitcoins) GetPlaces(ctx context.Context, lat, lon int, location, countryCode string) error {
+	return l.SendHTTPRequest(ctx, l.APIUrl+localbitcoinsAPIPlaces, nil)
 }
 
 // VerifyUsername returns list of real name verifiers for the user. Returns a
 // list only when you have a trade with the user where you are the seller.
-func (l *LocalBitcoins) VerifyUsername() error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIVerifyUsername, nil, nil)
+func (l *LocalBitcoins) VerifyUsername(ctx context.Context) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIVerifyUsername, nil, nil)
 }
 
 // GetRecentMessages returns maximum of 25 newest trade messages. Does not
 // return messages older than one month. Messages are ordered by sending time,
 // and the newest one is first.
-func (l *LocalBitcoins) GetRecentMessages(after string) error {
-	return l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIVerifyUsername, nil, nil)
+func (l *LocalBitcoins) GetRecentMessages(ctx context.Context, after string) error {
+	return l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIVerifyUsername, nil, nil)
 }
 
 // GetWalletInfo gets information about the token owner's wallet balance.
-func (l *LocalBitcoins) GetWalletInfo() (WalletInfo, error) {
+func (l *LocalBitcoins) GetWalletInfo(ctx context.Context) (WalletInfo, error) {
 	type response struct {
 		Data WalletInfo `json:"data"`
 	}
 	resp := response{}
-	err := l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPIWallet, nil, &resp)
+	err := l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPIWallet, nil, &resp)
 
 	if err != nil {
 		return WalletInfo{}, err
@@ -538,12 +539,12 @@ func (l *LocalBitcoins) GetWalletInfo() (WalletInfo, error) {
 // GetWalletBalance Same as GetWalletInfo(), but only returns the message,
 // receiving_address and total fields.
 // Use this instead if you don't care about transactions at the moment.
-func (l *LocalBitcoins) GetWalletBalance() (WalletBalanceInfo, error) {
+func (l *LocalBitcoins) GetWalletBalance(ctx context.Context) (WalletBalanceInfo, error) {
 	type response struct {
 		Data WalletBalanceInfo `json:"data"`
 	}
 	resp := response{}
-	err := l.SendAuthenticatedHTTPRequest("GET", localbitcoinsAPIWalletBalance, nil, &resp)
+	err := l.SendAuthenticatedHTTPRequest(ctx, "GET", localbitcoinsAPIWalletBalance, nil, &resp)
 
 	if err != nil {
 		return WalletBalanceInfo{}, err
@@ -560,7 +561,7 @@ func (l *LocalBitcoins) GetWalletBalance() (WalletBalanceInfo, error) {
 // On success, the response returns a message indicating success. It is highly
 // recommended to minimize the lifetime of access tokens with the money
 // permission. Use Logout() to make the current token expire instantly.
-func (l *LocalBitcoins) WalletSend(address string, amount float64, pin int) (bool, error) {
+func (l *LocalBitcoins) WalletSend(ctx context.Context, address string, amount float64, pin int) (bool, error) {
 	values := url.Values{}
 	values.Set("address", address)
 	values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
@@ -578,7 +579,7 @@ func (l *LocalBitcoins) WalletSend(address string, amount float64, pin int) (boo
 	}
 
 	resp := response{}
-	err := l.SendAuthenticatedHTTPRequest("POST", path, values, &resp)
+	err := l.SendAuthenticatedHTTPRequest(ctx, "POST", path, values, &resp)
 	if err != nil {
 		return false, err
 	}
@@ -593,7 +594,7 @@ func (l *LocalBitcoins) WalletSend(address string, amount float64, pin int) (boo
 // GetWalletAddress returns an unused receiving address from the token owner's
 // wallet. The address is returned in the address key of the response. Note that
 // this API may keep returning the same (unused) address if requested repeatedly.
-func (l *LocalBitcoins) GetWalletAddress() (string, error) {
+func (l *LocalBitcoins) GetWalletAddress(ctx context.Context) (string, error) {
 	type response struct {
 		Data struct {
 			Message string `json:"message"`
@@ -601,7 +602,7 @@ func (l *LocalBitcoins) GetWalletAddress() (string, error) {
 		}
 	}
 	resp := response{}
-	err := l.SendAuthenticatedHTTPRequest("POST", localbitcoinsAPIWalletAddress, nil, &resp)
+	err := l.SendAuthenticatedHTTPRequest(ctx, "POST", localbitcoinsAPIWalletAddress, nil, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -614,25 +615,25 @@ func (l *LocalBitcoins) GetWalletAddress() (string, error) {
 }
 
 // GetBitcoinsWithCashAd returns buy or sell as cash local advertisements.
-func (l *LocalBitcoins) GetBitcoinsWithCashAd(locationID, locationSlug string, BuySide bool) error {
-	return l.SendHTTPRequest(l.APIUrl+localbitcoinsAPICashBuy, nil)
+func (l *LocalBitcoins) GetBitcoinsWithCashAd(ctx context.Context, locationID, locationSlug string, BuySide bool) error {
+	return l.SendHTTPRequest(ctx, l.APIUrl+localbitcoinsAPICashBuy, nil)
 }
 
 // GetBitcoinsOnlineAd this API returns buy or sell Bitcoin online ads.
-func (l *LocalBitcoins) GetBitcoinsOnlineAd(countryCode, countryName, paymentMethod string, BuySide bool) error {
-	return l.SendHTTPRequest(l.APIUrl+localbitcoinsAPIOnlineBuy, nil)
+func (l *LocalBitcoins) GetBitcoinsOnlineAd(ctx context.Context, countryCode, countryName, paymentMethod string, BuySide bool) error {
+	return l.SendHTTPRequest(ctx, l.APIUrl+localbitcoinsAPIOnlineBuy, nil)
 }
 
 // GetTicker returns list of all completed trades.
-func (l *LocalBitcoins) GetTicker() (map[string]Ticker, error) {
+func (l *LocalBitcoins) GetTicker(ctx context.Context) (map[string]Ticker, error) {
 	result := make(map[string]Ticker)
 
-	return result, l.SendHTTPRequest(l.APIUrl+localbitcoinsAPITicker, &result)
+	return result, l.SendHTTPRequest(ctx, l.APIUrl+localbitcoinsAPITicker, &result)
 }
 
 // GetTradableCurrencies returns a list of tradable fiat currencies
-func (l *LocalBitcoins) GetTradableCurrencies() ([]string, error) {
-	resp, err := l.GetTicker()
+func (l *LocalBitcoins) GetTradableCurrencies(ctx context.Context) ([]string, error) {
+	resp, err := l.GetTicker(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -647,18 +648,18 @@ func (l *LocalBitcoins) GetTradableCurrencies() ([]string, error) {
 
 // GetTrades returns all closed trades in online buy and online sell categories,
 // updated every 15 minutes.
-func (l *LocalBitcoins) GetTrades(currency string, values url.Values) ([]Trade, error) {
+func (l *LocalBitcoins) GetTrades(ctx context.Context, currency string, values url.Values) ([]Trade, error) {
 	path := common.EncodeURLValues(fmt.Sprintf("%s/%s/trades.json", l.APIUrl+localbitcoinsAPIBitcoincharts, currency), values)
 	result := []Trade{}
 
-	return result, l.SendHTTPRequest(path, &result)
+	return result, l.SendHTTPRequest(ctx, path, &result)
 }
 
 // GetOrderbook returns buy and sell bitcoin online advertisements. Amount is
 // the maximum amount available for the trade request. Price is the hourly
 // updated price. The price is based on the price equation and commission %
 // entered by the ad author.
-func (l *LocalBitcoins) GetOrderbook(currency string) (Orderbook, error) {
+func (l *LocalBitcoins) GetOrderbook(ctx context.Context, currency string) (Orderbook, error) {
 	type response struct {
 		Bids [][]string `json:"bids"`
 		Asks [][]string `json:"asks"`
@@ -666,7 +667,7 @@ func (l *LocalBitcoins) GetOrderbook(currency string) (Orderbook, error) {
 
 	path := fmt.Sprintf("%s/%s/orderbook.json", l.APIUrl+localbitcoinsAPIBitcoincharts, currency)
 	resp := response{}
-	err := l.SendHTTPRequest(path, &resp)
+	err := l.SendHTTPRequest(ctx, path, &resp)
 
 	if err != nil {
 		return Orderbook{}, err
@@ -706,13 +707,13 @@ func (l *LocalBitcoins) GetOrderbook(currency string) (Orderbook, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (l *LocalBitcoins) SendHTTPRequest(path string, result interface{}) error {
-	return l.SendPayload("GET", path, nil, nil, result, false, l.Verbose)
+func (l *LocalBitcoins) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return l.SendPayload(ctx, "GET", path, nil, nil, result, false, l.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to
 // localbitcoins
-func (l *LocalBitcoins) SendAuthenticatedHTTPRequest(method, path string, params url.Values, result interface{}) (err error) {
+func (l *LocalBitcoins) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, params url.Values, result interface{}) (err error) {
 	if !l.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, l.Name)
 	}
@@ -741,7 +742,7 @@ func (l *LocalBitcoins) SendAuthenticatedHTTPRequest(method, path string, params
 		path += "?" + encoded
 	}
 
-	return l.SendPayload(method, l.APIUrl+path, headers, strings.NewReader(encoded), result, true, l.Verbose)
+	return l.SendPayload(ctx, method, l.APIUrl+path, headers, strings.NewReader(encoded), result, true, l.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
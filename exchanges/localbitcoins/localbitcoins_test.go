@@ -1,12 +1,14 @@
 package localbitcoins
 
 import (
+	"context"
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var l LocalBitcoins
@@ -19,6 +21,12 @@ const (
 	canManipulateRealOrders = false
 )
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	l.SetDefaults()
 }
@@ -40,7 +48,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTicker()
+	_, err := l.GetTicker(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - GetTicker() returned: %s", err)
 	}
@@ -48,7 +56,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTradableCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTradableCurrencies()
+	_, err := l.GetTradableCurrencies(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - GetTradableCurrencies() returned: %s", err)
 	}
@@ -59,11 +67,11 @@ func TestGetAccountInfo(t *testing.T) {
 	if l.APIKey == "" || l.APISecret == "" {
 		t.Skip()
 	}
-	_, err := l.GetAccountInformation("", true)
+	_, err := l.GetAccountInformation(context.Background(), "", true)
 	if err == nil {
 		t.Error("Test Failed - GetAccountInformation() error", err)
 	}
-	_, err = l.GetAccountInformation("bitcoinbaron", false)
+	_, err = l.GetAccountInformation(context.Background(), "bitcoinbaron", false)
 	if err != nil {
 		t.Error("Test Failed - GetAccountInformation() error", err)
 	}
@@ -74,11 +82,11 @@ func TestGetads(t *testing.T) {
 	if l.APIKey == "" || l.APISecret == "" {
 		t.Skip()
 	}
-	_, err := l.Getads("")
+	_, err := l.Getads(context.Background(), "")
 	if err == nil {
 		t.Error("Test Failed - Getads() - Full list, error", err)
 	}
-	_, err = l.Getads("1337")
+	_, err = l.Getads(context.Background(), "1337")
 	if err == nil {
 		t.Error("Test Failed - Getads() error", err)
 	}
@@ -90,7 +98,7 @@ func TestEditAd(t *testing.T) {
 		t.Skip()
 	}
 	edit := AdEdit{}
-	err := l.EditAd(edit, "1337")
+	err := l.EditAd(context.Background(), edit, "1337")
 	if err == nil {
 		t.Error("Test Failed - EditAd() error", err)
 	}
@@ -223,7 +231,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.EUR,
 	}
-	response, err := l.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := l.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -248,7 +256,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := l.CancelOrder(orderCancellation)
+	err := l.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
@@ -0,0 +1,247 @@
+package mock
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+const (
+	mockAPIURL = "http://127.0.0.1:8090"
+
+	mockAuthRate   = 0
+	mockUnauthRate = 0
+)
+
+// Mock is the overarching type across the mock package, it trades against a
+// local mock exchange server
+type Mock struct {
+	exchange.Base
+	WebsocketConn *websocket.Conn
+}
+
+// SetDefaults sets default settings for the mock exchange
+func (m *Mock) SetDefaults() {
+	m.Name = "Mock"
+	m.Enabled = false
+	m.Fee = 0
+	m.Verbose = false
+	m.RESTPollingDelay = 10
+	m.APIWithdrawPermissions = exchange.NoAPIWithdrawalMethods
+	m.RequestCurrencyPairFormat.Delimiter = symbolDelimiter
+	m.RequestCurrencyPairFormat.Uppercase = true
+	m.ConfigCurrencyPairFormat.Delimiter = symbolDelimiter
+	m.ConfigCurrencyPairFormat.Uppercase = true
+	m.AssetTypes = []string{ticker.Spot}
+	m.SupportsAutoPairUpdating = true
+	m.SupportsRESTTickerBatching = false
+	m.Requester = request.New(m.Name,
+		request.NewRateLimit(time.Second, mockAuthRate),
+		request.NewRateLimit(time.Second, mockUnauthRate),
+		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout))
+	m.APIUrlDefault = mockAPIURL
+	m.APIUrl = m.APIUrlDefault
+	m.WebsocketInit()
+	m.Websocket.Orderbook.SetChecksum(orderbookChecksum)
+}
+
+// Setup sets user exchange configuration settings
+func (m *Mock) Setup(exch config.ExchangeConfig) {
+	if !exch.Enabled {
+		m.SetEnabled(false)
+	} else {
+		m.Enabled = true
+		m.AuthenticatedAPISupport = exch.AuthenticatedAPISupport
+		m.SetAPIKeys(exch.APIKey, exch.APISecret, "", false)
+		m.SetHTTPClientTimeout(exch.HTTPTimeout)
+		m.SetHTTPClientUserAgent(exch.HTTPUserAgent)
+		m.RESTPollingDelay = exch.RESTPollingDelay
+		m.Verbose = exch.Verbose
+		m.Websocket.SetEnabled(exch.Websocket)
+		m.BaseCurrencies = common.SplitStrings(exch.BaseCurrencies, ",")
+		m.AvailablePairs = common.SplitStrings(exch.AvailablePairs, ",")
+		m.EnabledPairs = common.SplitStrings(exch.EnabledPairs, ",")
+		err := m.SetCurrencyPairFormat()
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = m.SetAssetTypes()
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = m.SetAutoPairDefaults()
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = m.SetAPIURL(exch)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = m.SetClientProxyAddress(exch.ProxyAddress)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = m.WebsocketSetup(m.WsConnect,
+			exch.Name,
+			exch.Websocket,
+			mockWebsocketURL,
+			exch.WebsocketURL)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if m.AuthenticatedAPISupport {
+			m.Websocket.SetAuthenticator(m.WsAuthenticate)
+		}
+	}
+}
+
+// GetSymbols returns the symbols traded on the mock exchange
+func (m *Mock) GetSymbols(ctx context.Context) ([]string, error) {
+	var resp []string
+	return resp, m.SendHTTPRequest(ctx, m.APIUrl+serverSymbols, &resp)
+}
+
+// GetTicker returns the ticker of a symbol
+func (m *Mock) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
+	var resp Ticker
+	values := url.Values{}
+	values.Set("symbol", symbol)
+	path := common.EncodeURLValues(m.APIUrl+serverTicker, values)
+	return resp, m.SendHTTPRequest(ctx, path, &resp)
+}
+
+// GetOrderbook returns the orderbook of a symbol, a depth of zero returns all
+// levels
+func (m *Mock) GetOrderbook(ctx context.Context, symbol string, depth int) (Orderbook, error) {
+	var resp Orderbook
+	values := url.Values{}
+	values.Set("symbol", symbol)
+	if depth > 0 {
+		values.Set("depth", strconv.Itoa(depth))
+	}
+	path := common.EncodeURLValues(m.APIUrl+serverOrderbook, values)
+	return resp, m.SendHTTPRequest(ctx, path, &resp)
+}
+
+// GetTrades returns the recent trades of a symbol
+func (m *Mock) GetTrades(ctx context.Context, symbol string) ([]Trade, error) {
+	var resp []Trade
+	values := url.Values{}
+	values.Set("symbol", symbol)
+	path := common.EncodeURLValues(m.APIUrl+serverTrades, values)
+	return resp, m.SendHTTPRequest(ctx, path, &resp)
+}
+
+// GetBalances returns the account balances
+func (m *Mock) GetBalances(ctx context.Context) ([]Balance, error) {
+	var resp []Balance
+	return resp, m.SendAuthenticatedHTTPRequest(ctx, "GET", serverAccount, nil, nil, &resp)
+}
+
+// PlaceOrder places a new order
+func (m *Mock) PlaceOrder(ctx context.Context, req OrderRequest) (Order, error) {
+	var resp Order
+	return resp, m.SendAuthenticatedHTTPRequest(ctx, "POST", serverOrders, nil, req, &resp)
+}
+
+// GetOrders returns the orders matching the status and symbol, empty values
+// return all orders
+func (m *Mock) GetOrders(ctx context.Context, status, symbol string) ([]Order, error) {
+	var resp []Order
+	values := url.Values{}
+	if status != "" {
+		values.Set("status", status)
+	}
+	if symbol != "" {
+		values.Set("symbol", symbol)
+	}
+	return resp, m.SendAuthenticatedHTTPRequest(ctx, "GET", serverOrders, values, nil, &resp)
+}
+
+// GetOrder returns an order by ID
+func (m *Mock) GetOrder(ctx context.Context, orderID string) (Order, error) {
+	var resp Order
+	return resp, m.SendAuthenticatedHTTPRequest(ctx, "GET", serverOrders+"/"+orderID, nil, nil, &resp)
+}
+
+// CancelExistingOrder cancels an open order by ID
+func (m *Mock) CancelExistingOrder(ctx context.Context, orderID string) (Order, error) {
+	var resp Order
+	return resp, m.SendAuthenticatedHTTPRequest(ctx, "DELETE", serverOrders+"/"+orderID, nil, nil, &resp)
+}
+
+// CancelOrders cancels the open orders of a symbol, an empty symbol cancels
+// all open orders
+func (m *Mock) CancelOrders(ctx context.Context, symbol string) ([]Order, error) {
+	var resp []Order
+	values := url.Values{}
+	if symbol != "" {
+		values.Set("symbol", symbol)
+	}
+	return resp, m.SendAuthenticatedHTTPRequest(ctx, "DELETE", serverOrders, values, nil, &resp)
+}
+
+// SendHTTPRequest sends an unauthenticated HTTP request
+func (m *Mock) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return m.SendPayload(ctx, "GET", path, nil, nil, result, false, m.Verbose)
+}
+
+// SendAuthenticatedHTTPRequest sends an authenticated HTTP request, the data
+// is sent as a JSON body when set
+func (m *Mock) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, data, result interface{}) error {
+	if !m.AuthenticatedAPISupport {
+		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, m.Name)
+	}
+
+	var body []byte
+	if data != nil {
+		var err error
+		body, err = common.JSONEncode(data)
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.Nonce.Get() == 0 {
+		m.Nonce.Set(time.Now().UnixNano())
+	} else {
+		m.Nonce.Inc()
+	}
+
+	path := endpoint
+	if len(values) > 0 {
+		path = common.EncodeURLValues(endpoint, values)
+	}
+
+	payload := m.Nonce.String() + method + path + string(body)
+	hmac := common.GetHMAC(common.HashSHA256, []byte(payload), []byte(m.APISecret))
+
+	headers := make(map[string]string)
+	headers["Content-Type"] = "application/json"
+	headers[headerKey] = m.APIKey
+	headers[headerNonce] = m.Nonce.String()
+	headers[headerSignature] = common.HexEncodeToString(hmac)
+
+	return m.SendPayload(ctx, method, m.APIUrl+path, headers, bytes.NewReader(body), result, true, m.Verbose)
+}
+
+// GetFee returns an estimate of fee based on type of transaction
+func (m *Mock) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+	var fee float64
+	if feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
+		fee = m.Fee * feeBuilder.PurchasePrice * feeBuilder.Amount
+	}
+	return fee, nil
+}
@@ -0,0 +1,339 @@
+package mock
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+var (
+	m          Mock
+	server     *Server
+	testServer *httptest.Server
+	testPair   = pair.NewCurrencyPairDelimiter("BTC-USD", symbolDelimiter)
+)
+
+const (
+	apiKey    = "key"
+	apiSecret = "secret"
+)
+
+func TestMain(t *testing.M) {
+	server = NewServer(ServerConfig{
+		Prices:    map[string][]float64{"BTC-USD": {10000, 10100, 9900}},
+		Fee:       0.001,
+		APIKey:    apiKey,
+		APISecret: apiSecret,
+		Balances:  map[string]float64{"USD": 100000, "BTC": 10},
+	})
+	testServer = httptest.NewServer(server)
+
+	exch := config.ExchangeConfig{
+		Name:                    "Mock",
+		Enabled:                 true,
+		Websocket:               true,
+		AuthenticatedAPISupport: true,
+		APIKey:                  apiKey,
+		APISecret:               apiSecret,
+		APIURL:                  testServer.URL,
+		APIURLSecondary:         config.APIURLNonDefaultMessage,
+		WebsocketURL:            "ws" + strings.TrimPrefix(testServer.URL, "http") + serverWebsocket,
+		HTTPTimeout:             exchange.DefaultHTTPTimeout,
+		AvailablePairs:          "BTC-USD",
+		EnabledPairs:            "BTC-USD",
+		BaseCurrencies:          "USD",
+	}
+	cfg := config.GetConfig()
+	cfg.Exchanges = append(cfg.Exchanges, exch)
+
+	m.SetDefaults()
+	m.Setup(exch)
+
+	code := t.Run()
+	testServer.Close()
+	server.Stop()
+	os.Exit(code)
+}
+
+func TestUpdateTicker(t *testing.T) {
+	tick, err := m.UpdateTicker(context.Background(), testPair, ticker.Spot)
+	if err != nil {
+		t.Fatal("Test failed. UpdateTicker error", err)
+	}
+
+	if tick.Last != 10000 || tick.Bid >= tick.Ask {
+		t.Errorf("Test failed. Unexpected ticker %+v", tick)
+	}
+}
+
+func TestUpdateOrderbook(t *testing.T) {
+	ob, err := m.UpdateOrderbook(context.Background(), testPair, ticker.Spot)
+	if err != nil {
+		t.Fatal("Test failed. UpdateOrderbook error", err)
+	}
+
+	if len(ob.Bids) != defaultDepth || len(ob.Asks) != defaultDepth ||
+		ob.Bids[0].Price >= ob.Asks[0].Price {
+		t.Errorf("Test failed. Unexpected orderbook %+v", ob)
+	}
+}
+
+func TestOrders(t *testing.T) {
+	ctx := context.Background()
+	resp, err := m.SubmitOrder(ctx, exchange.SubmitOrder{Pair: testPair, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 9000})
+	if err != nil || !resp.IsOrderPlaced {
+		t.Fatal("Test failed. SubmitOrder error", err)
+	}
+
+	_, err = m.SubmitOrder(ctx, exchange.SubmitOrder{Pair: testPair, Side: exchange.Sell, OrderType: exchange.Market, Amount: 1})
+	if err != nil {
+		t.Error("Test failed. SubmitOrder error", err)
+	}
+
+	orders, err := m.GetActiveOrders(ctx, exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal("Test failed. GetActiveOrders error", err)
+	}
+
+	if len(orders) != 1 || orders[0].ID != resp.OrderID || orders[0].OpenVolume != 1 {
+		t.Errorf("Test failed. Expected the limit order to be active, received %+v", orders)
+	}
+
+	history, err := m.GetOrderHistory(ctx, exchange.GetOrdersRequest{OrderSide: exchange.Sell})
+	if err != nil || len(history) != 1 || history[0].Status != OrderStatusFilled {
+		t.Errorf("Test failed. Expected the filled market order, received %+v %v", history, err)
+	}
+
+	info, err := m.GetAccountInfo(ctx)
+	if err != nil {
+		t.Fatal("Test failed. GetAccountInfo error", err)
+	}
+
+	for _, c := range info.Currencies {
+		if c.CurrencyName == "USD" && math.Abs(c.Hold-9009) > 1e-6 {
+			t.Errorf("Test failed. Expected the limit order to hold funds, received %+v", c)
+		}
+	}
+
+	err = m.CancelOrder(ctx, exchange.OrderCancellation{OrderID: resp.OrderID})
+	if err != nil {
+		t.Error("Test failed. CancelOrder error", err)
+	}
+
+	err = m.CancelOrder(ctx, exchange.OrderCancellation{OrderID: resp.OrderID})
+	if err == nil {
+		t.Error("Test failed. Expected error cancelling a cancelled order")
+	}
+
+	err = m.CancelAllOrders(ctx)
+	if err != nil {
+		t.Error("Test failed. CancelAllOrders error", err)
+	}
+}
+
+func TestAuthenticatedRequestWithoutKeys(t *testing.T) {
+	var unauthenticated Mock
+	unauthenticated.SetDefaults()
+	unauthenticated.APIUrl = testServer.URL
+
+	_, err := unauthenticated.GetBalances(context.Background())
+	if err == nil {
+		t.Error("Test failed. Expected error without API support")
+	}
+
+	unauthenticated.AuthenticatedAPISupport = true
+	unauthenticated.SetAPIKeys(apiKey, "wrong", "", false)
+	_, err = unauthenticated.GetBalances(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("Test failed. Expected 401 with the wrong secret, received %v", err)
+	}
+}
+
+func TestFaults(t *testing.T) {
+	ctx := context.Background()
+	symbol := m.formatPair(testPair)
+
+	server.InjectFault(serverTicker, Fault{StatusCode: http.StatusInternalServerError})
+	_, err := m.GetTicker(ctx, symbol)
+	if err == nil || !strings.Contains(err.Error(), "500") {
+		t.Errorf("Test failed. Expected 500 error, received %v", err)
+	}
+
+	server.InjectFault(serverTicker, Fault{Malformed: true})
+	_, err = m.GetTicker(ctx, symbol)
+	if err == nil {
+		t.Error("Test failed. Expected malformed JSON error")
+	}
+
+	m.SetHTTPClientTimeout(100 * time.Millisecond)
+	defer m.SetHTTPClientTimeout(exchange.DefaultHTTPTimeout)
+	server.InjectFault(serverTicker, Fault{Latency: 300 * time.Millisecond})
+	_, err = m.GetTicker(ctx, symbol)
+	if err != nil {
+		t.Error("Test failed. Expected timed out request to be retried", err)
+	}
+
+	server.InjectFault(serverTicker, Fault{StatusCode: http.StatusTooManyRequests, RetryAfter: 1})
+	_, err = m.GetTicker(ctx, symbol)
+	if err == nil || !strings.Contains(err.Error(), "rate limited") {
+		t.Errorf("Test failed. Expected rate limited error, received %v", err)
+	}
+
+	if m.Requester.GetBackoff() <= 0 {
+		t.Error("Test failed. Expected requester to back off")
+	}
+
+	_, err = m.GetTicker(ctx, symbol)
+	if err != nil {
+		t.Error("Test failed. Expected request after backoff to succeed", err)
+	}
+}
+
+func TestWebsocket(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-m.Websocket.Connected:
+			case <-m.Websocket.Disconnected:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err := m.Websocket.Connect()
+	if err != nil {
+		t.Fatal("Test failed. Websocket Connect error", err)
+	}
+
+	expect := func(check func(interface{}) bool) interface{} {
+		timer := time.NewTimer(5 * time.Second)
+		defer timer.Stop()
+		for {
+			select {
+			case data := <-m.Websocket.DataHandler:
+				if check(data) {
+					return data
+				}
+			case <-timer.C:
+				t.Fatal("Test failed. Timed out waiting for websocket data")
+			}
+		}
+	}
+
+	expect(func(data interface{}) bool {
+		_, ok := data.(exchange.WebsocketOrderbookUpdate)
+		return ok
+	})
+
+	if !m.Websocket.IsAuthenticated() {
+		t.Error("Test failed. Expected websocket to be authenticated")
+	}
+
+	resp, err := m.SubmitOrder(context.Background(), exchange.SubmitOrder{
+		Pair: testPair, Side: exchange.Buy, OrderType: exchange.Limit,
+		Amount: 1, Price: 9000})
+	if err != nil {
+		t.Fatal("Test failed. SubmitOrder error", err)
+	}
+
+	data := expect(func(data interface{}) bool {
+		_, ok := data.(exchange.WebsocketOrderUpdate)
+		return ok
+	})
+	if update := data.(exchange.WebsocketOrderUpdate); update.OrderID != resp.OrderID ||
+		update.Status != orders.New || update.Side != exchange.Buy || update.Pair != testPair {
+		t.Errorf("Test failed. Unexpected order update %+v", update)
+	}
+
+	data = expect(func(data interface{}) bool {
+		_, ok := data.(exchange.WebsocketBalanceUpdate)
+		return ok
+	})
+	if balance := data.(exchange.WebsocketBalanceUpdate); balance.Currency != "USD" ||
+		math.Abs(balance.Hold-9009) > 1e-6 {
+		t.Errorf("Test failed. Unexpected balance update %+v", balance)
+	}
+
+	_, err = m.SubmitOrder(context.Background(), exchange.SubmitOrder{
+		Pair: testPair, Side: exchange.Sell, OrderType: exchange.Market,
+		Amount: 0.5})
+	if err != nil {
+		t.Fatal("Test failed. SubmitOrder error", err)
+	}
+
+	data = expect(func(data interface{}) bool {
+		_, ok := data.(exchange.WebsocketOrderFill)
+		return ok
+	})
+	if fill := data.(exchange.WebsocketOrderFill); fill.Amount != 0.5 ||
+		fill.Side != exchange.Sell || fill.Fee <= 0 || fill.FeeCurrency != "USD" {
+		t.Errorf("Test failed. Unexpected order fill %+v", fill)
+	}
+
+	err = m.CancelAllOrders(context.Background())
+	if err != nil {
+		t.Error("Test failed. CancelAllOrders error", err)
+	}
+
+	server.Step()
+	data = expect(func(data interface{}) bool {
+		_, ok := data.(exchange.TradeData)
+		return ok
+	})
+	if trade := data.(exchange.TradeData); trade.Price != 10100 || trade.Side != orderSideBuy {
+		t.Errorf("Test failed. Unexpected trade %+v", trade)
+	}
+
+	server.DropUpdates(1)
+	server.Step()
+	server.Step()
+	data = expect(func(data interface{}) bool {
+		_, ok := data.(exchange.WebsocketOrderbookResync)
+		return ok
+	})
+	resync := data.(exchange.WebsocketOrderbookResync)
+	if resync.Reason != exchange.OrderbookSequenceGap || resync.Pair != testPair {
+		t.Errorf("Test failed. Unexpected resync %+v", resync)
+	}
+
+	err = exchange.ResyncOrderbook(context.Background(), &m, resync)
+	if err != nil {
+		t.Fatal("Test failed. ResyncOrderbook error", err)
+	}
+
+	if m.Websocket.Orderbook.IsResyncing(testPair, ticker.Spot) ||
+		m.Websocket.Orderbook.GetResyncCount(testPair, ticker.Spot) != 1 {
+		t.Error("Test failed. Expected orderbook to be resynced")
+	}
+
+	server.Step()
+	expect(func(data interface{}) bool {
+		_, ok := data.(exchange.WebsocketOrderbookUpdate)
+		return ok
+	})
+
+	server.DropConnections()
+	expect(func(data interface{}) bool {
+		err, ok := data.(error)
+		return ok && strings.Contains(err.Error(), "close 1006")
+	})
+
+	err = m.Websocket.Shutdown()
+	if err != nil {
+		t.Error("Test failed. Websocket Shutdown error", err)
+	}
+}
@@ -0,0 +1,153 @@
+package mock
+
+import "time"
+
+// Ticker holds the ticker of a symbol
+type Ticker struct {
+	Symbol    string  `json:"symbol"`
+	Last      float64 `json:"last"`
+	Bid       float64 `json:"bid"`
+	Ask       float64 `json:"ask"`
+	High      float64 `json:"high"`
+	Low       float64 `json:"low"`
+	Volume    float64 `json:"volume"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+// Orderbook holds the bids and asks of a symbol as price and amount pairs
+type Orderbook struct {
+	Symbol    string       `json:"symbol"`
+	Sequence  int64        `json:"sequence"`
+	Bids      [][2]float64 `json:"bids"`
+	Asks      [][2]float64 `json:"asks"`
+	Checksum  uint32       `json:"checksum"`
+	Timestamp int64        `json:"timestamp"`
+}
+
+// Trade holds a public trade
+type Trade struct {
+	ID        int64   `json:"id"`
+	Symbol    string  `json:"symbol"`
+	Side      string  `json:"side"`
+	Price     float64 `json:"price"`
+	Amount    float64 `json:"amount"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+// Balance holds the balance of a currency
+type Balance struct {
+	Currency  string  `json:"currency"`
+	Available float64 `json:"available"`
+	Hold      float64 `json:"hold"`
+}
+
+// Order statuses
+const (
+	OrderStatusOpen      = "open"
+	OrderStatusFilled    = "filled"
+	OrderStatusCancelled = "cancelled"
+)
+
+// OrderRequest holds the parameters of a new order
+type OrderRequest struct {
+	Symbol   string  `json:"symbol"`
+	Side     string  `json:"side"`
+	Type     string  `json:"type"`
+	Amount   float64 `json:"amount"`
+	Price    float64 `json:"price,omitempty"`
+	ClientID string  `json:"clientId,omitempty"`
+}
+
+// Order holds an order and its fill state
+type Order struct {
+	ID           string  `json:"id"`
+	ClientID     string  `json:"clientId,omitempty"`
+	Symbol       string  `json:"symbol"`
+	Side         string  `json:"side"`
+	Type         string  `json:"type"`
+	Price        float64 `json:"price"`
+	Amount       float64 `json:"amount"`
+	FilledAmount float64 `json:"filledAmount"`
+	AveragePrice float64 `json:"averagePrice"`
+	Fee          float64 `json:"fee"`
+	Status       string  `json:"status"`
+	Timestamp    int64   `json:"timestamp"`
+}
+
+// Fill holds a trade which filled an order
+type Fill struct {
+	OrderID   string  `json:"orderId"`
+	TradeID   int64   `json:"tradeId"`
+	Symbol    string  `json:"symbol"`
+	Side      string  `json:"side"`
+	Price     float64 `json:"price"`
+	Amount    float64 `json:"amount"`
+	Fee       float64 `json:"fee"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+// ErrorResponse is returned by the server with a non 200 status code
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Websocket channels, the orders, fills and balances channels are private and
+// require an authenticated connection
+const (
+	ChannelTicker    = "ticker"
+	ChannelOrderbook = "orderbook"
+	ChannelTrades    = "trades"
+	ChannelAuth      = "auth"
+	ChannelOrders    = "orders"
+	ChannelFills     = "fills"
+	ChannelBalances  = "balances"
+)
+
+// Websocket orderbook actions, a snapshot replaces the book and an update
+// holds the changed levels with removed levels at a zero amount
+const (
+	ActionSnapshot = "snapshot"
+	ActionUpdate   = "update"
+)
+
+// WsRequest subscribes to websocket channels for the symbols or authenticates
+// the connection. The auth signature is the hex encoded HMAC-SHA256 of the
+// nonce and op using the API secret
+type WsRequest struct {
+	Op        string   `json:"op"`
+	Channels  []string `json:"channels,omitempty"`
+	Symbols   []string `json:"symbols,omitempty"`
+	Key       string   `json:"key,omitempty"`
+	Nonce     string   `json:"nonce,omitempty"`
+	Signature string   `json:"signature,omitempty"`
+}
+
+// WsAuthResponse is pushed on the auth channel in reply to an auth request
+type WsAuthResponse struct {
+	Authenticated bool   `json:"authenticated"`
+	Error         string `json:"error,omitempty"`
+}
+
+// WsMessage is pushed to websocket subscribers, Data holds a Ticker,
+// Orderbook, Trade, WsAuthResponse, Order, Fill or Balance depending on the
+// channel
+type WsMessage struct {
+	Channel string      `json:"channel"`
+	Action  string      `json:"action,omitempty"`
+	Symbol  string      `json:"symbol"`
+	Data    interface{} `json:"data"`
+}
+
+// Fault is an error response injected by the server for requests to a path
+type Fault struct {
+	// StatusCode is the HTTP status returned, such as 429 or 503
+	StatusCode int `json:"statusCode"`
+	// RetryAfter sets the Retry-After header in seconds
+	RetryAfter int `json:"retryAfter"`
+	// Malformed returns a truncated JSON body with a 200 status code
+	Malformed bool `json:"malformed"`
+	// Latency delays the response, in nanoseconds when encoded as JSON
+	Latency time.Duration `json:"latency"`
+	// Count is the number of requests affected, zero affects one request
+	Count int `json:"count"`
+}
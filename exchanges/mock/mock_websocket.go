@@ -0,0 +1,362 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+const (
+	mockWebsocketURL = "ws://127.0.0.1:8090/ws"
+)
+
+// wsResponse is a websocket message with its data left encoded until the
+// channel is known
+type wsResponse struct {
+	Channel string          `json:"channel"`
+	Action  string          `json:"action"`
+	Symbol  string          `json:"symbol"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// WsConnect initiates a websocket connection
+func (m *Mock) WsConnect() error {
+	if !m.Websocket.IsEnabled() || !m.IsEnabled() {
+		return errors.New(exchange.WebsocketNotEnabled)
+	}
+
+	var dialer websocket.Dialer
+	if m.Websocket.GetProxyAddress() != "" {
+		proxy, err := url.Parse(m.Websocket.GetProxyAddress())
+		if err != nil {
+			return err
+		}
+
+		dialer.Proxy = http.ProxyURL(proxy)
+	}
+
+	var err error
+	m.WebsocketConn, _, err = dialer.Dial(m.Websocket.GetWebsocketURL(),
+		http.Header{})
+	if err != nil {
+		return err
+	}
+
+	go m.WsReadData()
+	go m.WsHandleData()
+
+	return m.WsSubscribe()
+}
+
+// WsSubscribe subscribes to the ticker, orderbook and trade channels of the
+// enabled pairs
+func (m *Mock) WsSubscribe() error {
+	req := WsRequest{
+		Op:       wsOpSubscribe,
+		Channels: []string{ChannelTicker, ChannelOrderbook, ChannelTrades},
+	}
+
+	for _, p := range m.GetEnabledCurrencies() {
+		req.Symbols = append(req.Symbols, m.formatPair(p))
+	}
+
+	return m.WebsocketConn.WriteJSON(req)
+}
+
+// WsAuthenticate authenticates the websocket connection and subscribes to the
+// private order, fill and balance channels
+func (m *Mock) WsAuthenticate() error {
+	nonce := strconv.FormatInt(time.Now().UnixNano(), 10)
+	hmac := common.GetHMAC(common.HashSHA256, []byte(nonce+wsOpAuth),
+		[]byte(m.APISecret))
+
+	err := m.WebsocketConn.WriteJSON(WsRequest{
+		Op:        wsOpAuth,
+		Key:       m.APIKey,
+		Nonce:     nonce,
+		Signature: common.HexEncodeToString(hmac),
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.WebsocketConn.WriteJSON(WsRequest{
+		Op:       wsOpSubscribe,
+		Channels: []string{ChannelOrders, ChannelFills, ChannelBalances},
+	})
+}
+
+// WsReadData reads data from the websocket connection
+func (m *Mock) WsReadData() {
+	m.Websocket.Wg.Add(1)
+
+	defer func() {
+		err := m.WebsocketConn.Close()
+		if err != nil {
+			m.Websocket.DataHandler <- fmt.Errorf("mock_websocket.go - Unable to to close Websocket connection. Error: %s",
+				err)
+		}
+		m.Websocket.Wg.Done()
+	}()
+
+	for {
+		select {
+		case <-m.Websocket.ShutdownC:
+			return
+
+		default:
+			_, resp, err := m.WebsocketConn.ReadMessage()
+			if err != nil {
+				m.Websocket.DataHandler <- err
+				return
+			}
+
+			m.Websocket.TrafficAlert <- struct{}{}
+			m.Websocket.Intercomm <- exchange.WebsocketResponse{Raw: resp}
+		}
+	}
+}
+
+// WsHandleData handles data from the websocket connection
+func (m *Mock) WsHandleData() {
+	m.Websocket.Wg.Add(1)
+	defer m.Websocket.Wg.Done()
+
+	for {
+		select {
+		case <-m.Websocket.ShutdownC:
+			return
+
+		case resp := <-m.Websocket.Intercomm:
+			var msg wsResponse
+			err := common.JSONDecode(resp.Raw, &msg)
+			if err != nil {
+				m.Websocket.DataHandler <- err
+				continue
+			}
+
+			// Auth and balance messages are not for a symbol
+			var p pair.CurrencyPair
+			if msg.Symbol != "" {
+				p = pair.NewCurrencyPairDelimiter(msg.Symbol, symbolDelimiter)
+			}
+			switch msg.Channel {
+			case ChannelTicker:
+				var t Ticker
+				err = common.JSONDecode(msg.Data, &t)
+				if err != nil {
+					m.Websocket.DataHandler <- err
+					continue
+				}
+
+				m.Websocket.DataHandler <- exchange.TickerData{
+					Timestamp:  time.Unix(0, t.Timestamp*int64(time.Millisecond)),
+					Pair:       p,
+					AssetType:  ticker.Spot,
+					Exchange:   m.GetName(),
+					ClosePrice: t.Last,
+					Quantity:   t.Volume,
+					HighPrice:  t.High,
+					LowPrice:   t.Low,
+					BidPrice:   t.Bid,
+					AskPrice:   t.Ask,
+				}
+
+			case ChannelOrderbook:
+				var ob Orderbook
+				err = common.JSONDecode(msg.Data, &ob)
+				if err != nil {
+					m.Websocket.DataHandler <- err
+					continue
+				}
+
+				err = m.processOrderbook(p, msg.Action, ob)
+				if err != nil {
+					m.Websocket.DataHandler <- err
+					continue
+				}
+
+				m.Websocket.DataHandler <- exchange.WebsocketOrderbookUpdate{
+					Pair:     p,
+					Asset:    ticker.Spot,
+					Exchange: m.GetName(),
+				}
+
+			case ChannelTrades:
+				var t Trade
+				err = common.JSONDecode(msg.Data, &t)
+				if err != nil {
+					m.Websocket.DataHandler <- err
+					continue
+				}
+
+				m.Websocket.DataHandler <- exchange.TradeData{
+					Timestamp:    time.Unix(0, t.Timestamp*int64(time.Millisecond)),
+					CurrencyPair: p,
+					AssetType:    ticker.Spot,
+					Exchange:     m.GetName(),
+					EventType:    ChannelTrades,
+					EventTime:    t.Timestamp,
+					Price:        t.Price,
+					Amount:       t.Amount,
+					Side:         t.Side,
+				}
+
+			case ChannelAuth:
+				var auth WsAuthResponse
+				err = common.JSONDecode(msg.Data, &auth)
+				if err != nil {
+					m.Websocket.DataHandler <- err
+					continue
+				}
+
+				if !auth.Authenticated {
+					m.Websocket.SetAuthenticated(false)
+					m.Websocket.DataHandler <- fmt.Sprintf("mock_websocket.go - Websocket authentication failed. Error: %s",
+						auth.Error)
+				}
+
+			case ChannelOrders:
+				var o Order
+				err = common.JSONDecode(msg.Data, &o)
+				if err != nil {
+					m.Websocket.DataHandler <- err
+					continue
+				}
+
+				m.Websocket.DataHandler <- exchange.WebsocketOrderUpdate{
+					Exchange:      m.GetName(),
+					AssetType:     ticker.Spot,
+					Pair:          p,
+					OrderID:       o.ID,
+					ClientOrderID: o.ClientID,
+					Side:          orderSide(o.Side),
+					OrderType:     orderType(o.Type),
+					Status:        orders.ParseStatus(o.Status),
+					Price:         o.Price,
+					Amount:        o.Amount,
+					FilledAmount:  o.FilledAmount,
+					AveragePrice:  o.AveragePrice,
+					Timestamp:     time.Unix(0, o.Timestamp*int64(time.Millisecond)),
+				}
+
+			case ChannelFills:
+				var f Fill
+				err = common.JSONDecode(msg.Data, &f)
+				if err != nil {
+					m.Websocket.DataHandler <- err
+					continue
+				}
+
+				m.Websocket.DataHandler <- exchange.WebsocketOrderFill{
+					Exchange:    m.GetName(),
+					AssetType:   ticker.Spot,
+					Pair:        p,
+					OrderID:     f.OrderID,
+					TradeID:     strconv.FormatInt(f.TradeID, 10),
+					Side:        orderSide(f.Side),
+					Price:       f.Price,
+					Amount:      f.Amount,
+					Fee:         f.Fee,
+					FeeCurrency: p.SecondCurrency.String(),
+					Timestamp:   time.Unix(0, f.Timestamp*int64(time.Millisecond)),
+				}
+
+			case ChannelBalances:
+				var b Balance
+				err = common.JSONDecode(msg.Data, &b)
+				if err != nil {
+					m.Websocket.DataHandler <- err
+					continue
+				}
+
+				m.Websocket.DataHandler <- exchange.WebsocketBalanceUpdate{
+					Exchange:  m.GetName(),
+					Currency:  b.Currency,
+					Total:     b.Available + b.Hold,
+					Hold:      b.Hold,
+					Timestamp: time.Now(),
+				}
+			}
+		}
+	}
+}
+
+// processOrderbook loads an orderbook snapshot or applies an update to the
+// local orderbook cache, verifying the update sequence and checksum
+func (m *Mock) processOrderbook(p pair.CurrencyPair, action string, ob Orderbook) error {
+	bids := make([]orderbook.Item, 0, len(ob.Bids))
+	for x := range ob.Bids {
+		bids = append(bids, orderbook.Item{Price: ob.Bids[x][0], Amount: ob.Bids[x][1]})
+	}
+	asks := make([]orderbook.Item, 0, len(ob.Asks))
+	for x := range ob.Asks {
+		asks = append(asks, orderbook.Item{Price: ob.Asks[x][0], Amount: ob.Asks[x][1]})
+	}
+	updated := time.Unix(0, ob.Timestamp*int64(time.Millisecond))
+
+	if action == ActionSnapshot {
+		var newOrderbook orderbook.Base
+		newOrderbook.Bids = bids
+		newOrderbook.Asks = asks
+		newOrderbook.Pair = p
+		newOrderbook.CurrencyPair = p.Pair().String()
+		newOrderbook.AssetType = ticker.Spot
+		newOrderbook.LastUpdated = updated
+		return m.Websocket.Orderbook.LoadSnapshotWithSequence(newOrderbook,
+			m.GetName(),
+			ob.Sequence)
+	}
+
+	err := m.Websocket.Orderbook.UpdateWithSequence(bids,
+		asks,
+		p,
+		updated,
+		m.GetName(),
+		ticker.Spot,
+		0,
+		ob.Sequence)
+	if err != nil {
+		return err
+	}
+
+	return m.Websocket.Orderbook.VerifyChecksum(p, ticker.Spot, m.GetName(), ob.Checksum)
+}
+
+// SeedLocalCache loads a sequenced orderbook snapshot from the REST API into
+// the local orderbook cache
+func (m *Mock) SeedLocalCache(ctx context.Context, p pair.CurrencyPair) error {
+	ob, err := m.GetOrderbook(ctx, m.formatPair(p), 0)
+	if err != nil {
+		return err
+	}
+
+	return m.processOrderbook(p, ActionSnapshot, ob)
+}
+
+// orderbookChecksum returns the checksum of a local orderbook in the format
+// published by the server, the local cache keeps the book sorted
+func orderbookChecksum(ob orderbook.Base) uint32 {
+	bids := make([][2]float64, 0, len(ob.Bids))
+	for x := range ob.Bids {
+		bids = append(bids, [2]float64{ob.Bids[x].Price, ob.Bids[x].Amount})
+	}
+	asks := make([][2]float64, 0, len(ob.Asks))
+	for x := range ob.Asks {
+		asks = append(asks, [2]float64{ob.Asks[x].Price, ob.Asks[x].Amount})
+	}
+	return Checksum(bids, asks)
+}
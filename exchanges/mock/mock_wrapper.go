@@ -0,0 +1,354 @@
+package mock
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+// Start starts the Mock go routine
+func (m *Mock) Start(wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		m.Run()
+		wg.Done()
+	}()
+}
+
+// Run implements the Mock wrapper
+func (m *Mock) Run() {
+	if m.Verbose {
+		log.Printf("%s Websocket: %s (url: %s).\n", m.GetName(), common.IsEnabled(m.Websocket.IsEnabled()), m.Websocket.GetWebsocketURL())
+		log.Printf("%s polling delay: %ds.\n", m.GetName(), m.RESTPollingDelay)
+		log.Printf("%s %d currencies enabled: %s.\n", m.GetName(), len(m.EnabledPairs), m.EnabledPairs)
+	}
+
+	symbols, err := m.GetSymbols(context.Background())
+	if err != nil {
+		log.Printf("%s Failed to get available symbols.\n", m.GetName())
+		return
+	}
+
+	err = m.UpdateCurrencies(symbols, false, false)
+	if err != nil {
+		log.Printf("%s Failed to update available currencies %s.\n", m.GetName(), err)
+	}
+}
+
+// formatPair returns the symbol of a currency pair
+func (m *Mock) formatPair(p pair.CurrencyPair) string {
+	return p.Display(m.RequestCurrencyPairFormat.Delimiter,
+		m.RequestCurrencyPairFormat.Uppercase).String()
+}
+
+// UpdateTicker updates and returns the ticker for a currency pair
+func (m *Mock) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+	var tickerPrice ticker.Price
+	tick, err := m.GetTicker(ctx, m.formatPair(p))
+	if err != nil {
+		return tickerPrice, err
+	}
+
+	tickerPrice.Pair = p
+	tickerPrice.Last = tick.Last
+	tickerPrice.Bid = tick.Bid
+	tickerPrice.Ask = tick.Ask
+	tickerPrice.High = tick.High
+	tickerPrice.Low = tick.Low
+	tickerPrice.Volume = tick.Volume
+	ticker.ProcessTicker(m.GetName(), p, tickerPrice, assetType)
+	return ticker.GetTicker(m.GetName(), p, assetType)
+}
+
+// GetTickerPrice returns the ticker for a currency pair
+func (m *Mock) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+	tickerNew, err := ticker.GetTicker(m.GetName(), p, assetType)
+	if err != nil {
+		return m.UpdateTicker(ctx, p, assetType)
+	}
+	return tickerNew, nil
+}
+
+// GetOrderbookEx returns orderbook base on the currency pair
+func (m *Mock) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+	ob, err := orderbook.GetOrderbook(m.GetName(), p, assetType)
+	if err != nil {
+		return m.UpdateOrderbook(ctx, p, assetType)
+	}
+	return ob, nil
+}
+
+// UpdateOrderbook updates and returns the orderbook for a currency pair
+func (m *Mock) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+	var orderBook orderbook.Base
+	orderbookNew, err := m.GetOrderbook(ctx, m.formatPair(p), 0)
+	if err != nil {
+		return orderBook, err
+	}
+
+	for x := range orderbookNew.Bids {
+		orderBook.Bids = append(orderBook.Bids,
+			orderbook.Item{Price: orderbookNew.Bids[x][0], Amount: orderbookNew.Bids[x][1]})
+	}
+	for x := range orderbookNew.Asks {
+		orderBook.Asks = append(orderBook.Asks,
+			orderbook.Item{Price: orderbookNew.Asks[x][0], Amount: orderbookNew.Asks[x][1]})
+	}
+
+	orderbook.ProcessOrderbook(m.GetName(), p, orderBook, assetType)
+	return orderbook.GetOrderbook(m.GetName(), p, assetType)
+}
+
+// GetAccountInfo retrieves balances for all currencies on the mock exchange
+func (m *Mock) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
+	var response exchange.AccountInfo
+	response.ExchangeName = m.GetName()
+	balances, err := m.GetBalances(ctx)
+	if err != nil {
+		return response, err
+	}
+
+	for x := range balances {
+		response.Currencies = append(response.Currencies, exchange.AccountCurrencyInfo{
+			CurrencyName: balances[x].Currency,
+			TotalValue:   balances[x].Available + balances[x].Hold,
+			Hold:         balances[x].Hold,
+		})
+	}
+	return response, nil
+}
+
+// GetFundingHistory returns funding history, deposits and
+// withdrawals
+func (m *Mock) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
+	var fundHistory []exchange.FundHistory
+	return fundHistory, common.ErrFunctionNotSupported
+}
+
+// GetExchangeHistory returns historic trade data since exchange opening.
+func (m *Mock) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+	trades, err := m.GetTrades(ctx, m.formatPair(p))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []exchange.TradeHistory
+	for x := range trades {
+		resp = append(resp, exchange.TradeHistory{
+			Timestamp: trades[x].Timestamp / 1000,
+			TID:       trades[x].ID,
+			Price:     trades[x].Price,
+			Amount:    trades[x].Amount,
+			Exchange:  m.GetName(),
+			Type:      trades[x].Side,
+		})
+	}
+	return resp, nil
+}
+
+// SubmitOrder submits a new order
+func (m *Mock) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := m.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	req := OrderRequest{
+		Symbol:   m.formatPair(order.Pair),
+		Side:     orderSideBuy,
+		Type:     orderTypeLimit,
+		Amount:   order.Amount,
+		Price:    order.Price,
+		ClientID: order.ClientID,
+	}
+	if order.Side == exchange.Sell {
+		req.Side = orderSideSell
+	}
+	if order.OrderType == exchange.Market {
+		req.Type = orderTypeMarket
+		req.Price = 0
+	}
+
+	response, err := m.PlaceOrder(ctx, req)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
+	submitOrderResponse.OrderID = response.ID
+	submitOrderResponse.IsOrderPlaced = true
+	submitOrderResponse.FilledAmount = response.FilledAmount
+	submitOrderResponse.AveragePrice = response.AveragePrice
+	submitOrderResponse.Fee = response.Fee
+	_, submitOrderResponse.FeeCurrency = splitSymbol(response.Symbol)
+	submitOrderResponse.OrderDate = time.Unix(0, response.Timestamp*int64(time.Millisecond))
+	submitOrderResponse.LastUpdated = submitOrderResponse.OrderDate
+	return submitOrderResponse, nil
+}
+
+// ModifyOrder will allow of changing orderbook placement and limit to
+// market conversion
+func (m *Mock) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// CancelOrder cancels an order by its corresponding ID number
+func (m *Mock) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
+	_, err := m.CancelExistingOrder(ctx, order.OrderID)
+	return err
+}
+
+// CancelAllOrders cancels all open orders
+func (m *Mock) CancelAllOrders(ctx context.Context) error {
+	_, err := m.CancelOrders(ctx, "")
+	return err
+}
+
+// GetOrderInfo returns information on a current open order
+func (m *Mock) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
+	o, err := m.GetOrder(ctx, orderID)
+	if err != nil {
+		return exchange.OrderDetail{}, err
+	}
+	return m.orderDetail(o), nil
+}
+
+// GetDepositAddress returns a deposit address for a specified currency
+func (m *Mock) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// GetDerivativesPositions returns the open positions of the account
+func (m *Mock) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (m *Mock) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (m *Mock) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (m *Mock) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (m *Mock) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
+// WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
+// submitted
+func (m *Mock) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// WithdrawFiatFunds returns a withdrawal ID when a
+// withdrawal is submitted
+func (m *Mock) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// GetWebsocket returns a pointer to the exchange websocket
+func (m *Mock) GetWebsocket() (*exchange.Websocket, error) {
+	return m.Websocket, nil
+}
+
+// GetFeeByType returns an estimate of fee based on type of transaction
+func (m *Mock) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+	return m.GetFee(feeBuilder)
+}
+
+// GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
+func (m *Mock) GetWithdrawCapabilities() uint32 {
+	return m.GetWithdrawPermissions()
+}
+
+// GetActiveOrders retrieves any orders that are active/open
+func (m *Mock) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := m.GetOrders(ctx, OrderStatusOpen, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for x := range resp {
+		orders = append(orders, m.orderDetail(resp[x]))
+	}
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (m *Mock) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := m.GetOrders(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []exchange.OrderDetail
+	for x := range resp {
+		if resp[x].Status == OrderStatusOpen {
+			continue
+		}
+		orders = append(orders, m.orderDetail(resp[x]))
+	}
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (m *Mock) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// orderDetail converts a mock exchange order
+func (m *Mock) orderDetail(o Order) exchange.OrderDetail {
+	base, quote := splitSymbol(o.Symbol)
+	orderDate := time.Unix(0, o.Timestamp*int64(time.Millisecond))
+	return exchange.OrderDetail{
+		Exchange:       m.GetName(),
+		ID:             o.ID,
+		BaseCurrency:   base,
+		QuoteCurrency:  quote,
+		OrderSide:      orderSide(o.Side).ToString(),
+		OrderType:      orderType(o.Type).ToString(),
+		CreationTime:   orderDate.Unix(),
+		OrderDate:      orderDate,
+		Status:         o.Status,
+		Price:          o.Price,
+		Amount:         o.Amount,
+		OpenVolume:     o.Amount - o.FilledAmount,
+		ExecutedAmount: o.FilledAmount,
+	}
+}
+
+// orderSide converts a mock order side to an exchange order side
+func orderSide(side string) exchange.OrderSide {
+	if side == orderSideSell {
+		return exchange.Sell
+	}
+	return exchange.Buy
+}
+
+// orderType converts a mock order type to an exchange order type
+func orderType(t string) exchange.OrderType {
+	if t == orderTypeMarket {
+		return exchange.Market
+	}
+	return exchange.Limit
+}
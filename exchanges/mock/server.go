@@ -0,0 +1,1062 @@
+package mock
+
+import (
+	"errors"
+	"hash/crc32"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+// Server endpoints
+const (
+	serverSymbols    = "/api/v1/symbols"
+	serverTicker     = "/api/v1/ticker"
+	serverOrderbook  = "/api/v1/orderbook"
+	serverTrades     = "/api/v1/trades"
+	serverAccount    = "/api/v1/account"
+	serverOrders     = "/api/v1/orders"
+	serverWebsocket  = "/ws"
+	serverAdminStep  = "/admin/step"
+	serverAdminFault = "/admin/fault"
+	serverAdminDrop  = "/admin/drop"
+
+	// Authentication headers, the signature is the hex encoded HMAC-SHA256
+	// of the nonce, method, request URI and body using the API secret
+	headerKey       = "X-MOCK-KEY"
+	headerNonce     = "X-MOCK-NONCE"
+	headerSignature = "X-MOCK-SIGNATURE"
+
+	defaultDepth     = 20
+	defaultSpread    = 0.001
+	tradeAmount      = 0.1
+	maxTrades        = 1000
+	malformedJSON    = `{"symbol":"`
+	orderTypeMarket  = "market"
+	orderTypeLimit   = "limit"
+	orderSideBuy     = "buy"
+	orderSideSell    = "sell"
+	wsOpSubscribe    = "subscribe"
+	wsOpAuth         = "auth"
+	symbolDelimiter  = "-"
+	maxRequestLength = 1 << 20
+	checksumDepth    = 10
+)
+
+var (
+	errUnknownSymbol     = errors.New("unknown symbol")
+	errUnknownOrder      = errors.New("unknown order")
+	errInvalidOrder      = errors.New("invalid order parameters")
+	errInsufficientFunds = errors.New("insufficient funds")
+	errOrderNotOpen      = errors.New("order is not open")
+	errUnauthorised      = errors.New("invalid API key or signature")
+)
+
+// ServerConfig holds the settings of a mock exchange server
+type ServerConfig struct {
+	// Prices holds the scripted price path of each symbol, formatted as
+	// BASE-QUOTE. Each step moves every symbol to the next price of its path
+	// and the final price is held once a path is exhausted
+	Prices map[string][]float64
+	// Interval steps the price paths automatically, zero only steps them
+	// through Step or the admin endpoint
+	Interval time.Duration
+	// Latency delays every response
+	Latency time.Duration
+	// ErrorRate is the fraction of API requests answered with a 500 error
+	ErrorRate float64
+	// Depth is the number of orderbook levels on each side
+	Depth int
+	// Spread is the fractional spread between the best bid and ask
+	Spread float64
+	// Fee is the fractional trading fee charged in the quote currency
+	Fee float64
+	// APIKey and APISecret authenticate account and order requests, requests
+	// are not checked when no key is set
+	APIKey    string
+	APISecret string
+	// Balances holds the starting available balance of each currency
+	Balances map[string]float64
+}
+
+// symbolStats holds the running statistics of a symbol for its ticker
+type symbolStats struct {
+	high   float64
+	low    float64
+	volume float64
+}
+
+// wsClient is a websocket connection and its subscriptions
+type wsClient struct {
+	conn          *websocket.Conn
+	channels      map[string]bool
+	symbols       map[string]bool
+	authenticated bool
+	m             sync.Mutex
+}
+
+// Server is a mock exchange serving a generic REST API and pushing websocket
+// updates as it steps through scripted price paths
+type Server struct {
+	cfg         ServerConfig
+	steps       map[string]int
+	stats       map[string]*symbolStats
+	sequence    int64
+	orders      map[string]*Order
+	orderIDs    []string
+	nextOrderID int64
+	trades      map[string][]Trade
+	nextTradeID int64
+	balances    map[string]*Balance
+	faults      map[string][]Fault
+	clients     map[*wsClient]bool
+	dropUpdates int
+	m           sync.Mutex
+	upgrader    websocket.Upgrader
+	mux         *http.ServeMux
+	shutdown    chan struct{}
+	wg          sync.WaitGroup
+}
+
+// NewServer returns a mock exchange server for the config
+func NewServer(cfg ServerConfig) *Server {
+	if cfg.Depth <= 0 {
+		cfg.Depth = defaultDepth
+	}
+	if cfg.Spread <= 0 {
+		cfg.Spread = defaultSpread
+	}
+
+	s := &Server{
+		cfg:      cfg,
+		steps:    make(map[string]int),
+		stats:    make(map[string]*symbolStats),
+		orders:   make(map[string]*Order),
+		trades:   make(map[string][]Trade),
+		balances: make(map[string]*Balance),
+		faults:   make(map[string][]Fault),
+		clients:  make(map[*wsClient]bool),
+		mux:      http.NewServeMux(),
+		shutdown: make(chan struct{}),
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+
+	for symbol, path := range cfg.Prices {
+		if len(path) == 0 {
+			delete(s.cfg.Prices, symbol)
+			continue
+		}
+		s.stats[symbol] = &symbolStats{high: path[0], low: path[0]}
+	}
+
+	for currency, amount := range cfg.Balances {
+		s.balances[currency] = &Balance{Currency: currency, Available: amount}
+	}
+
+	s.mux.HandleFunc(serverSymbols, s.handleSymbols)
+	s.mux.HandleFunc(serverTicker, s.handleTicker)
+	s.mux.HandleFunc(serverOrderbook, s.handleOrderbook)
+	s.mux.HandleFunc(serverTrades, s.handleTrades)
+	s.mux.HandleFunc(serverAccount, s.authenticated(s.handleAccount))
+	s.mux.HandleFunc(serverOrders, s.authenticated(s.handleOrders))
+	s.mux.HandleFunc(serverOrders+"/", s.authenticated(s.handleOrder))
+	s.mux.HandleFunc(serverWebsocket, s.handleWebsocket)
+	s.mux.HandleFunc(serverAdminStep, s.handleAdminStep)
+	s.mux.HandleFunc(serverAdminFault, s.handleAdminFault)
+	s.mux.HandleFunc(serverAdminDrop, s.handleAdminDrop)
+	return s
+}
+
+// Start steps the price paths every interval until Stop is called
+func (s *Server) Start() {
+	if s.cfg.Interval <= 0 {
+		return
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		tick := time.NewTicker(s.cfg.Interval)
+		defer tick.Stop()
+		for {
+			select {
+			case <-s.shutdown:
+				return
+			case <-tick.C:
+				s.Step()
+			}
+		}
+	}()
+}
+
+// Stop stops stepping the price paths and closes the websocket connections
+func (s *Server) Stop() {
+	close(s.shutdown)
+	s.wg.Wait()
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	for c := range s.clients {
+		c.conn.Close()
+		delete(s.clients, c)
+	}
+}
+
+// ServeHTTP applies latency and injected faults before serving a request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.cfg.Latency > 0 {
+		time.Sleep(s.cfg.Latency)
+	}
+
+	if f, ok := s.takeFault(r.URL.Path); ok {
+		if f.Latency > 0 {
+			time.Sleep(f.Latency)
+		}
+
+		if f.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(f.RetryAfter))
+		}
+
+		if f.Malformed {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(malformedJSON))
+			return
+		}
+
+		if f.StatusCode != 0 {
+			writeError(w, f.StatusCode, errors.New(http.StatusText(f.StatusCode)))
+			return
+		}
+	}
+
+	if s.cfg.ErrorRate > 0 && strings.HasPrefix(r.URL.Path, "/api/") &&
+		rand.Float64() < s.cfg.ErrorRate {
+		writeError(w, http.StatusInternalServerError,
+			errors.New(http.StatusText(http.StatusInternalServerError)))
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// InjectFault queues a fault for the next requests to the path
+func (s *Server) InjectFault(path string, f Fault) {
+	if f.Count <= 0 {
+		f.Count = 1
+	}
+
+	s.m.Lock()
+	s.faults[path] = append(s.faults[path], f)
+	s.m.Unlock()
+}
+
+// takeFault returns the next fault queued for the path
+func (s *Server) takeFault(path string) (Fault, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	faults := s.faults[path]
+	if len(faults) == 0 {
+		return Fault{}, false
+	}
+
+	f := faults[0]
+	faults[0].Count--
+	if faults[0].Count <= 0 {
+		s.faults[path] = faults[1:]
+	}
+	return f, true
+}
+
+// DropConnections closes every websocket connection without a close frame,
+// which clients see as an abnormal closure
+func (s *Server) DropConnections() {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for c := range s.clients {
+		c.conn.UnderlyingConn().Close()
+		delete(s.clients, c)
+	}
+}
+
+// DropUpdates skips publishing the next n orderbook updates, leaving a gap in
+// the sequence seen by websocket subscribers
+func (s *Server) DropUpdates(n int) {
+	s.m.Lock()
+	s.dropUpdates += n
+	s.m.Unlock()
+}
+
+// Step moves every symbol to the next price of its path, fills resting
+// orders crossed by the new prices and pushes the updates to websocket
+// subscribers
+func (s *Server) Step() {
+	s.m.Lock()
+	var msgs []WsMessage
+	now := time.Now()
+	s.sequence++
+	for symbol, path := range s.cfg.Prices {
+		previous := s.orderbook(symbol, s.cfg.Depth, now)
+		if s.steps[symbol] < len(path)-1 {
+			s.steps[symbol]++
+		}
+
+		price := path[s.steps[symbol]]
+		side := orderSideBuy
+		if s.steps[symbol] > 0 && price < path[s.steps[symbol]-1] {
+			side = orderSideSell
+		}
+
+		trade := s.recordTrade(symbol, side, price, tradeAmount, now)
+		msgs = append(msgs, WsMessage{Channel: ChannelTrades, Symbol: symbol, Data: trade})
+		msgs = append(msgs, s.matchOrders(symbol, now)...)
+		msgs = append(msgs,
+			WsMessage{Channel: ChannelTicker, Symbol: symbol, Data: s.ticker(symbol, now)})
+
+		if s.dropUpdates > 0 {
+			s.dropUpdates--
+			continue
+		}
+		msgs = append(msgs, WsMessage{Channel: ChannelOrderbook, Action: ActionUpdate,
+			Symbol: symbol, Data: orderbookUpdate(previous, s.orderbook(symbol, s.cfg.Depth, now))})
+	}
+	s.m.Unlock()
+
+	s.broadcast(msgs)
+}
+
+// Price returns the current price of a symbol
+func (s *Server) Price(symbol string) (float64, bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.price(symbol)
+}
+
+// Balance returns the balance of a currency
+func (s *Server) Balance(currency string) Balance {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return *s.balance(currency)
+}
+
+func (s *Server) price(symbol string) (float64, bool) {
+	path, ok := s.cfg.Prices[symbol]
+	if !ok {
+		return 0, false
+	}
+	return path[s.steps[symbol]], true
+}
+
+// bidAsk returns the best bid and ask around a price
+func (s *Server) bidAsk(price float64) (bid, ask float64) {
+	return price * (1 - s.cfg.Spread/2), price * (1 + s.cfg.Spread/2)
+}
+
+func (s *Server) balance(currency string) *Balance {
+	b, ok := s.balances[currency]
+	if !ok {
+		b = &Balance{Currency: currency}
+		s.balances[currency] = b
+	}
+	return b
+}
+
+func (s *Server) ticker(symbol string, now time.Time) Ticker {
+	price, _ := s.price(symbol)
+	bid, ask := s.bidAsk(price)
+	stats := s.stats[symbol]
+	return Ticker{
+		Symbol:    symbol,
+		Last:      price,
+		Bid:       bid,
+		Ask:       ask,
+		High:      stats.high,
+		Low:       stats.low,
+		Volume:    stats.volume,
+		Timestamp: now.UnixNano() / int64(time.Millisecond),
+	}
+}
+
+// orderbook builds an orderbook around the current price with levels spaced
+// by half the spread and amounts increasing with distance from the price
+func (s *Server) orderbook(symbol string, depth int, now time.Time) Orderbook {
+	price, _ := s.price(symbol)
+	bid, ask := s.bidAsk(price)
+	tick := price * s.cfg.Spread / 2
+	ob := Orderbook{
+		Symbol:    symbol,
+		Sequence:  s.sequence,
+		Timestamp: now.UnixNano() / int64(time.Millisecond),
+	}
+	for i := 0; i < depth; i++ {
+		amount := 1 + float64(i)*0.5
+		ob.Bids = append(ob.Bids, [2]float64{bid - float64(i)*tick, amount})
+		ob.Asks = append(ob.Asks, [2]float64{ask + float64(i)*tick, amount})
+	}
+	ob.Checksum = Checksum(ob.Bids, ob.Asks)
+	return ob
+}
+
+// orderbookUpdate returns the levels of the next book which differ from the
+// previous book, with levels no longer in the book at a zero amount
+func orderbookUpdate(previous, next Orderbook) Orderbook {
+	update := next
+	update.Bids = levelChanges(previous.Bids, next.Bids)
+	update.Asks = levelChanges(previous.Asks, next.Asks)
+	return update
+}
+
+func levelChanges(previous, next [][2]float64) [][2]float64 {
+	amounts := make(map[float64]float64, len(previous))
+	for i := range previous {
+		amounts[previous[i][0]] = previous[i][1]
+	}
+
+	changes := [][2]float64{}
+	for i := range next {
+		amount, ok := amounts[next[i][0]]
+		if !ok || amount != next[i][1] {
+			changes = append(changes, next[i])
+		}
+		delete(amounts, next[i][0])
+	}
+
+	for i := range previous {
+		if _, ok := amounts[previous[i][0]]; ok {
+			changes = append(changes, [2]float64{previous[i][0], 0})
+		}
+	}
+	return changes
+}
+
+// Checksum returns the CRC32 of the top levels of a book, formatted as
+// bid price:bid amount:ask price:ask amount for each level joined by colons.
+// Bids must be sorted descending and asks ascending
+func Checksum(bids, asks [][2]float64) uint32 {
+	var levels []string
+	for i := 0; i < checksumDepth; i++ {
+		if i < len(bids) {
+			levels = append(levels, formatLevel(bids[i]))
+		}
+		if i < len(asks) {
+			levels = append(levels, formatLevel(asks[i]))
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(strings.Join(levels, ":")))
+}
+
+func formatLevel(level [2]float64) string {
+	return strconv.FormatFloat(level[0], 'f', -1, 64) + ":" +
+		strconv.FormatFloat(level[1], 'f', -1, 64)
+}
+
+func (s *Server) recordTrade(symbol, side string, price, amount float64, now time.Time) Trade {
+	s.nextTradeID++
+	t := Trade{
+		ID:        s.nextTradeID,
+		Symbol:    symbol,
+		Side:      side,
+		Price:     price,
+		Amount:    amount,
+		Timestamp: now.UnixNano() / int64(time.Millisecond),
+	}
+
+	s.trades[symbol] = append(s.trades[symbol], t)
+	if len(s.trades[symbol]) > maxTrades {
+		s.trades[symbol] = s.trades[symbol][len(s.trades[symbol])-maxTrades:]
+	}
+
+	stats := s.stats[symbol]
+	stats.high = math.Max(stats.high, price)
+	stats.low = math.Min(stats.low, price)
+	stats.volume += amount
+	return t
+}
+
+// splitSymbol returns the base and quote currencies of a symbol
+func splitSymbol(symbol string) (base, quote string) {
+	currencies := strings.SplitN(symbol, symbolDelimiter, 2)
+	if len(currencies) != 2 {
+		return symbol, ""
+	}
+	return currencies[0], currencies[1]
+}
+
+// reserved returns the currency and amount held for an open limit order
+func (s *Server) reserved(o *Order) (string, float64) {
+	base, quote := splitSymbol(o.Symbol)
+	if o.Side == orderSideBuy {
+		return quote, o.Price * o.Amount * (1 + s.cfg.Fee)
+	}
+	return base, o.Amount
+}
+
+// placeOrder validates an order, checks funds and fills it when marketable,
+// otherwise the funds are held and the order rests until a price step
+// crosses it
+func (s *Server) placeOrder(req OrderRequest, now time.Time) (*Order, []WsMessage, error) {
+	price, ok := s.price(req.Symbol)
+	if !ok {
+		return nil, nil, errUnknownSymbol
+	}
+
+	if (req.Side != orderSideBuy && req.Side != orderSideSell) || req.Amount <= 0 ||
+		(req.Type != orderTypeMarket && req.Type != orderTypeLimit) ||
+		(req.Type == orderTypeLimit && req.Price <= 0) {
+		return nil, nil, errInvalidOrder
+	}
+
+	bid, ask := s.bidAsk(price)
+	fillPrice := ask
+	if req.Side == orderSideSell {
+		fillPrice = bid
+	}
+
+	marketable := req.Type == orderTypeMarket ||
+		(req.Side == orderSideBuy && req.Price >= ask) ||
+		(req.Side == orderSideSell && req.Price <= bid)
+
+	base, quote := splitSymbol(req.Symbol)
+	required, currency := req.Amount, base
+	if req.Side == orderSideBuy {
+		currency = quote
+		required = fillPrice * req.Amount * (1 + s.cfg.Fee)
+		if !marketable {
+			required = req.Price * req.Amount * (1 + s.cfg.Fee)
+		}
+	}
+	if s.balance(currency).Available < required {
+		return nil, nil, errInsufficientFunds
+	}
+
+	s.nextOrderID++
+	o := &Order{
+		ID:        strconv.FormatInt(s.nextOrderID, 10),
+		ClientID:  req.ClientID,
+		Symbol:    req.Symbol,
+		Side:      req.Side,
+		Type:      req.Type,
+		Price:     req.Price,
+		Amount:    req.Amount,
+		Status:    OrderStatusOpen,
+		Timestamp: now.UnixNano() / int64(time.Millisecond),
+	}
+	s.orders[o.ID] = o
+	s.orderIDs = append(s.orderIDs, o.ID)
+
+	if marketable {
+		return o, s.fill(o, fillPrice, false, now), nil
+	}
+
+	b := s.balance(currency)
+	b.Available -= required
+	b.Hold += required
+	return o, s.accountMessages(o, nil, currency), nil
+}
+
+// fill executes an order at the price, releasing the funds held for resting
+// orders
+func (s *Server) fill(o *Order, price float64, resting bool, now time.Time) []WsMessage {
+	if resting {
+		currency, amount := s.reserved(o)
+		b := s.balance(currency)
+		b.Hold -= amount
+		b.Available += amount
+	}
+
+	base, quote := splitSymbol(o.Symbol)
+	value := price * o.Amount
+	fee := value * s.cfg.Fee
+	if o.Side == orderSideBuy {
+		s.balance(quote).Available -= value + fee
+		s.balance(base).Available += o.Amount
+	} else {
+		s.balance(base).Available -= o.Amount
+		s.balance(quote).Available += value - fee
+	}
+
+	o.FilledAmount = o.Amount
+	o.AveragePrice = price
+	o.Fee = fee
+	o.Status = OrderStatusFilled
+
+	trade := s.recordTrade(o.Symbol, o.Side, price, o.Amount, now)
+	fill := Fill{
+		OrderID:   o.ID,
+		TradeID:   trade.ID,
+		Symbol:    o.Symbol,
+		Side:      o.Side,
+		Price:     price,
+		Amount:    o.Amount,
+		Fee:       fee,
+		Timestamp: trade.Timestamp,
+	}
+	return append([]WsMessage{{Channel: ChannelTrades, Symbol: o.Symbol, Data: trade}},
+		s.accountMessages(o, &fill, base, quote)...)
+}
+
+// accountMessages returns the private messages for a changed order, its fill
+// and the changed balances
+func (s *Server) accountMessages(o *Order, fill *Fill, currencies ...string) []WsMessage {
+	msgs := []WsMessage{{Channel: ChannelOrders, Symbol: o.Symbol, Data: *o}}
+	if fill != nil {
+		msgs = append(msgs, WsMessage{Channel: ChannelFills, Symbol: o.Symbol, Data: *fill})
+	}
+	for _, currency := range currencies {
+		msgs = append(msgs, WsMessage{Channel: ChannelBalances, Data: *s.balance(currency)})
+	}
+	return msgs
+}
+
+// matchOrders fills resting orders of the symbol crossed by its price
+func (s *Server) matchOrders(symbol string, now time.Time) []WsMessage {
+	price, _ := s.price(symbol)
+	bid, ask := s.bidAsk(price)
+
+	var msgs []WsMessage
+	for _, id := range s.orderIDs {
+		o := s.orders[id]
+		if o.Symbol != symbol || o.Status != OrderStatusOpen {
+			continue
+		}
+
+		if (o.Side == orderSideBuy && ask <= o.Price) ||
+			(o.Side == orderSideSell && bid >= o.Price) {
+			msgs = append(msgs, s.fill(o, o.Price, true, now)...)
+		}
+	}
+	return msgs
+}
+
+// cancelOrder cancels an open order and releases its held funds
+func (s *Server) cancelOrder(o *Order) ([]WsMessage, error) {
+	if o.Status != OrderStatusOpen {
+		return nil, errOrderNotOpen
+	}
+
+	currency, amount := s.reserved(o)
+	b := s.balance(currency)
+	b.Hold -= amount
+	b.Available += amount
+	o.Status = OrderStatusCancelled
+	return s.accountMessages(o, nil, currency), nil
+}
+
+// authenticated checks the API key and signature of a request
+func (s *Server) authenticated(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.cfg.APIKey == "" {
+			h(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestLength))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		r.Body = ioutil.NopCloser(strings.NewReader(string(body)))
+
+		payload := r.Header.Get(headerNonce) + r.Method + r.URL.RequestURI() + string(body)
+		signature := common.HexEncodeToString(common.GetHMAC(common.HashSHA256,
+			[]byte(payload), []byte(s.cfg.APISecret)))
+		if r.Header.Get(headerKey) != s.cfg.APIKey || r.Header.Get(headerNonce) == "" ||
+			r.Header.Get(headerSignature) != signature {
+			writeError(w, http.StatusUnauthorized, errUnauthorised)
+			return
+		}
+		h(w, r)
+	}
+}
+
+func (s *Server) handleSymbols(w http.ResponseWriter, r *http.Request) {
+	s.m.Lock()
+	var symbols []string
+	for symbol := range s.cfg.Prices {
+		symbols = append(symbols, symbol)
+	}
+	s.m.Unlock()
+
+	sort.Strings(symbols)
+	writeJSON(w, symbols)
+}
+
+func (s *Server) handleTicker(w http.ResponseWriter, r *http.Request) {
+	symbol := r.URL.Query().Get("symbol")
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if _, ok := s.price(symbol); !ok {
+		writeError(w, http.StatusNotFound, errUnknownSymbol)
+		return
+	}
+	writeJSON(w, s.ticker(symbol, time.Now()))
+}
+
+func (s *Server) handleOrderbook(w http.ResponseWriter, r *http.Request) {
+	symbol := r.URL.Query().Get("symbol")
+	depth, err := strconv.Atoi(r.URL.Query().Get("depth"))
+	if err != nil || depth <= 0 || depth > s.cfg.Depth {
+		depth = s.cfg.Depth
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if _, ok := s.price(symbol); !ok {
+		writeError(w, http.StatusNotFound, errUnknownSymbol)
+		return
+	}
+	writeJSON(w, s.orderbook(symbol, depth, time.Now()))
+}
+
+func (s *Server) handleTrades(w http.ResponseWriter, r *http.Request) {
+	symbol := r.URL.Query().Get("symbol")
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if _, ok := s.price(symbol); !ok {
+		writeError(w, http.StatusNotFound, errUnknownSymbol)
+		return
+	}
+
+	trades := s.trades[symbol]
+	if trades == nil {
+		trades = []Trade{}
+	}
+	writeJSON(w, trades)
+}
+
+func (s *Server) handleAccount(w http.ResponseWriter, r *http.Request) {
+	s.m.Lock()
+	balances := []Balance{}
+	for _, b := range s.balances {
+		balances = append(balances, *b)
+	}
+	s.m.Unlock()
+
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Currency < balances[j].Currency
+	})
+	writeJSON(w, balances)
+}
+
+// handleOrders places an order, lists orders or cancels all open orders
+func (s *Server) handleOrders(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		var req OrderRequest
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestLength))
+		if err == nil {
+			err = common.JSONDecode(body, &req)
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		s.m.Lock()
+		o, msgs, err := s.placeOrder(req, time.Now())
+		var resp Order
+		if err == nil {
+			resp = *o
+		}
+		s.m.Unlock()
+
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		s.broadcast(msgs)
+		writeJSON(w, resp)
+
+	case http.MethodGet:
+		status := r.URL.Query().Get("status")
+		symbol := r.URL.Query().Get("symbol")
+
+		s.m.Lock()
+		orders := []Order{}
+		for _, id := range s.orderIDs {
+			o := s.orders[id]
+			if (status == "" || o.Status == status) && (symbol == "" || o.Symbol == symbol) {
+				orders = append(orders, *o)
+			}
+		}
+		s.m.Unlock()
+		writeJSON(w, orders)
+
+	case http.MethodDelete:
+		symbol := r.URL.Query().Get("symbol")
+
+		s.m.Lock()
+		cancelled := []Order{}
+		var msgs []WsMessage
+		for _, id := range s.orderIDs {
+			o := s.orders[id]
+			if o.Status != OrderStatusOpen || (symbol != "" && o.Symbol != symbol) {
+				continue
+			}
+			cancelMsgs, _ := s.cancelOrder(o)
+			msgs = append(msgs, cancelMsgs...)
+			cancelled = append(cancelled, *o)
+		}
+		s.m.Unlock()
+		s.broadcast(msgs)
+		writeJSON(w, cancelled)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// handleOrder returns or cancels an order by ID
+func (s *Server) handleOrder(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, serverOrders+"/")
+
+	s.m.Lock()
+	o, ok := s.orders[id]
+	if !ok {
+		s.m.Unlock()
+		writeError(w, http.StatusNotFound, errUnknownOrder)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		resp := *o
+		s.m.Unlock()
+		writeJSON(w, resp)
+	case http.MethodDelete:
+		msgs, err := s.cancelOrder(o)
+		resp := *o
+		s.m.Unlock()
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		s.broadcast(msgs)
+		writeJSON(w, resp)
+	default:
+		s.m.Unlock()
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// handleWebsocket upgrades the connection and serves subscriptions until the
+// client disconnects
+func (s *Server) handleWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("Mock exchange server websocket upgrade error: %s", err)
+		return
+	}
+
+	c := &wsClient{
+		conn:     conn,
+		channels: make(map[string]bool),
+		symbols:  make(map[string]bool),
+	}
+
+	s.m.Lock()
+	s.clients[c] = true
+	s.m.Unlock()
+
+	defer func() {
+		s.m.Lock()
+		delete(s.clients, c)
+		s.m.Unlock()
+		conn.Close()
+	}()
+
+	for {
+		var req WsRequest
+		err := conn.ReadJSON(&req)
+		if err != nil {
+			return
+		}
+
+		if req.Op == wsOpAuth {
+			c.authenticate(req, s.cfg.APIKey, s.cfg.APISecret)
+			continue
+		}
+
+		if req.Op != wsOpSubscribe {
+			continue
+		}
+
+		// Snapshots are written before releasing the client so later updates
+		// are always sent after them
+		now := time.Now()
+		var snapshots []WsMessage
+		s.m.Lock()
+		c.m.Lock()
+		for _, channel := range req.Channels {
+			c.channels[channel] = true
+		}
+		for _, symbol := range req.Symbols {
+			if _, ok := s.price(symbol); !ok {
+				continue
+			}
+			c.symbols[symbol] = true
+			snapshots = append(snapshots,
+				WsMessage{Channel: ChannelTicker, Symbol: symbol, Data: s.ticker(symbol, now)},
+				WsMessage{Channel: ChannelOrderbook, Action: ActionSnapshot,
+					Symbol: symbol, Data: s.orderbook(symbol, s.cfg.Depth, now)})
+		}
+		s.m.Unlock()
+
+		c.write(snapshots)
+		c.m.Unlock()
+	}
+}
+
+// authenticate checks the API key and signature of an auth request and
+// replies on the auth channel, any key is accepted when the server has none
+func (c *wsClient) authenticate(req WsRequest, apiKey, apiSecret string) {
+	signature := common.HexEncodeToString(common.GetHMAC(common.HashSHA256,
+		[]byte(req.Nonce+req.Op), []byte(apiSecret)))
+
+	var resp WsAuthResponse
+	if apiKey == "" || (req.Key == apiKey && req.Nonce != "" && req.Signature == signature) {
+		resp.Authenticated = true
+	} else {
+		resp.Error = errUnauthorised.Error()
+	}
+
+	c.m.Lock()
+	c.authenticated = resp.Authenticated
+	c.conn.WriteJSON(WsMessage{Channel: ChannelAuth, Data: resp})
+	c.m.Unlock()
+}
+
+// isPrivateChannel returns whether a channel requires an authenticated
+// connection
+func isPrivateChannel(channel string) bool {
+	return channel == ChannelOrders || channel == ChannelFills || channel == ChannelBalances
+}
+
+// send writes the messages the client is subscribed to
+func (c *wsClient) send(msgs []WsMessage) {
+	c.m.Lock()
+	c.write(msgs)
+	c.m.Unlock()
+}
+
+// write writes the messages the client is subscribed to, it must be called
+// with the client lock held
+func (c *wsClient) write(msgs []WsMessage) {
+	for i := range msgs {
+		if !c.channels[msgs[i].Channel] {
+			continue
+		}
+
+		if isPrivateChannel(msgs[i].Channel) {
+			if !c.authenticated {
+				continue
+			}
+		} else if !c.symbols[msgs[i].Symbol] {
+			continue
+		}
+		if err := c.conn.WriteJSON(msgs[i]); err != nil {
+			return
+		}
+	}
+}
+
+// broadcast pushes the messages to every subscribed websocket client
+func (s *Server) broadcast(msgs []WsMessage) {
+	if len(msgs) == 0 {
+		return
+	}
+
+	s.m.Lock()
+	clients := make([]*wsClient, 0, len(s.clients))
+	for c := range s.clients {
+		clients = append(clients, c)
+	}
+	s.m.Unlock()
+
+	for _, c := range clients {
+		c.send(msgs)
+	}
+}
+
+func (s *Server) handleAdminStep(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	s.Step()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleAdminFault(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var f Fault
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestLength))
+	if err == nil {
+		err = common.JSONDecode(body, &f)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	s.InjectFault(r.URL.Query().Get("path"), f)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleAdminDrop(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	s.DropConnections()
+	w.WriteHeader(http.StatusOK)
+}
+
+// writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, data interface{}) {
+	payload, err := common.JSONEncode(data)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(payload)
+}
+
+// writeError writes an ErrorResponse with the status code
+func writeError(w http.ResponseWriter, statusCode int, err error) {
+	payload, _ := common.JSONEncode(ErrorResponse{Error: err.Error()})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(payload)
+}
+
+// RandomWalk returns a price path of the number of steps from the start
+// price, moving up to the volatility as a fraction of the price each step
+func RandomWalk(start float64, steps int, volatility float64) []float64 {
+	path := make([]float64, steps)
+	price := start
+	for i := range path {
+		path[i] = price
+		price *= 1 + volatility*(2*rand.Float64()-1)
+	}
+	return path
+}
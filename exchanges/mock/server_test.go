@@ -0,0 +1,255 @@
+package mock
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+)
+
+func newTestServer() *Server {
+	return NewServer(ServerConfig{
+		Prices: map[string][]float64{
+			"BTC-USD": {10000, 9000, 11000},
+		},
+		Fee:      0.001,
+		Balances: map[string]float64{"USD": 100000, "BTC": 1},
+	})
+}
+
+func TestStep(t *testing.T) {
+	s := newTestServer()
+	for _, expected := range []float64{10000, 9000, 11000, 11000} {
+		price, ok := s.Price("BTC-USD")
+		if !ok || price != expected {
+			t.Errorf("Test failed. Expected price %f, received %f", expected, price)
+		}
+		s.Step()
+	}
+
+	if _, ok := s.Price("ETH-USD"); ok {
+		t.Error("Test failed. Expected unknown symbol to have no price")
+	}
+
+	s.m.Lock()
+	ticker := s.ticker("BTC-USD", time.Now())
+	s.m.Unlock()
+	if ticker.High != 11000 || ticker.Low != 9000 || ticker.Bid >= ticker.Ask {
+		t.Errorf("Test failed. Unexpected ticker %+v", ticker)
+	}
+}
+
+func TestOrderbookUpdate(t *testing.T) {
+	s := newTestServer()
+	now := time.Now()
+	previous := s.orderbook("BTC-USD", s.cfg.Depth, now)
+	s.Step()
+	next := s.orderbook("BTC-USD", s.cfg.Depth, now)
+	update := orderbookUpdate(previous, next)
+
+	apply := func(levels, changes [][2]float64) [][2]float64 {
+		amounts := make(map[float64]float64)
+		for i := range levels {
+			amounts[levels[i][0]] = levels[i][1]
+		}
+		for i := range changes {
+			if changes[i][1] == 0 {
+				delete(amounts, changes[i][0])
+				continue
+			}
+			amounts[changes[i][0]] = changes[i][1]
+		}
+
+		var book [][2]float64
+		for price, amount := range amounts {
+			book = append(book, [2]float64{price, amount})
+		}
+		return book
+	}
+
+	var ob orderbook.Base
+	for _, level := range apply(previous.Bids, update.Bids) {
+		ob.Bids = append(ob.Bids, orderbook.Item{Price: level[0], Amount: level[1]})
+	}
+	for _, level := range apply(previous.Asks, update.Asks) {
+		ob.Asks = append(ob.Asks, orderbook.Item{Price: level[0], Amount: level[1]})
+	}
+	ob.Sort()
+
+	if update.Sequence != 1 || orderbookChecksum(ob) != update.Checksum ||
+		update.Checksum != next.Checksum {
+		t.Errorf("Test failed. Expected update to rebuild the next book, received %+v", update)
+	}
+}
+
+func TestPlaceOrder(t *testing.T) {
+	s := newTestServer()
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	_, _, err := s.placeOrder(OrderRequest{Symbol: "ETH-USD", Side: orderSideBuy,
+		Type: orderTypeMarket, Amount: 1}, time.Now())
+	if err != errUnknownSymbol {
+		t.Errorf("Test failed. Expected %s, received %v", errUnknownSymbol, err)
+	}
+
+	_, _, err = s.placeOrder(OrderRequest{Symbol: "BTC-USD", Side: orderSideBuy,
+		Type: orderTypeLimit, Amount: 1}, time.Now())
+	if err != errInvalidOrder {
+		t.Errorf("Test failed. Expected %s, received %v", errInvalidOrder, err)
+	}
+
+	_, _, err = s.placeOrder(OrderRequest{Symbol: "BTC-USD", Side: orderSideSell,
+		Type: orderTypeMarket, Amount: 2}, time.Now())
+	if err != errInsufficientFunds {
+		t.Errorf("Test failed. Expected %s, received %v", errInsufficientFunds, err)
+	}
+
+	o, msgs, err := s.placeOrder(OrderRequest{Symbol: "BTC-USD", Side: orderSideBuy,
+		Type: orderTypeMarket, Amount: 1}, time.Now())
+	if err != nil {
+		t.Fatal("Test failed. placeOrder error", err)
+	}
+
+	var fills, balances int
+	for i := range msgs {
+		switch msgs[i].Channel {
+		case ChannelFills:
+			fills++
+		case ChannelBalances:
+			balances++
+		}
+	}
+	if fills != 1 || balances != 2 {
+		t.Errorf("Test failed. Expected a fill and two balance messages, received %+v", msgs)
+	}
+
+	_, ask := s.bidAsk(10000)
+	if o.Status != OrderStatusFilled || o.AveragePrice != ask {
+		t.Errorf("Test failed. Expected order filled at %f, received %+v", ask, o)
+	}
+
+	usd := 100000 - (ask + ask*s.cfg.Fee)
+	if s.balance("BTC").Available != 2 || s.balance("USD").Available != usd {
+		t.Errorf("Test failed. Unexpected balances BTC %+v USD %+v",
+			s.balance("BTC"), s.balance("USD"))
+	}
+}
+
+func TestMatchOrders(t *testing.T) {
+	s := newTestServer()
+	s.m.Lock()
+	buy, _, err := s.placeOrder(OrderRequest{Symbol: "BTC-USD", Side: orderSideBuy,
+		Type: orderTypeLimit, Amount: 1, Price: 9500}, time.Now())
+	if err != nil {
+		t.Fatal("Test failed. placeOrder error", err)
+	}
+
+	sell, _, err := s.placeOrder(OrderRequest{Symbol: "BTC-USD", Side: orderSideSell,
+		Type: orderTypeLimit, Amount: 1, Price: 12000}, time.Now())
+	if err != nil {
+		t.Fatal("Test failed. placeOrder error", err)
+	}
+
+	hold := 9500 * (1 + s.cfg.Fee)
+	if buy.Status != OrderStatusOpen || s.balance("USD").Hold != hold ||
+		s.balance("BTC").Hold != 1 {
+		t.Errorf("Test failed. Expected resting orders to hold funds, received USD %+v BTC %+v",
+			s.balance("USD"), s.balance("BTC"))
+	}
+	s.m.Unlock()
+
+	s.Step()
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if buy.Status != OrderStatusFilled || buy.AveragePrice != 9500 {
+		t.Errorf("Test failed. Expected buy order to fill at its limit, received %+v", buy)
+	}
+
+	if s.balance("USD").Hold != 0 || s.balance("BTC").Available != 1 {
+		t.Errorf("Test failed. Unexpected balances USD %+v BTC %+v",
+			s.balance("USD"), s.balance("BTC"))
+	}
+
+	msgs, err := s.cancelOrder(sell)
+	if err != nil {
+		t.Error("Test failed. cancelOrder error", err)
+	}
+
+	if len(msgs) != 2 || msgs[0].Channel != ChannelOrders || msgs[1].Channel != ChannelBalances ||
+		msgs[0].Data.(Order).Status != OrderStatusCancelled {
+		t.Errorf("Test failed. Expected cancelled order and balance messages, received %+v", msgs)
+	}
+
+	if s.balance("BTC").Hold != 0 || s.balance("BTC").Available != 2 {
+		t.Errorf("Test failed. Expected cancel to release hold, received %+v", s.balance("BTC"))
+	}
+
+	_, err = s.cancelOrder(buy)
+	if err != errOrderNotOpen {
+		t.Errorf("Test failed. Expected %s, received %v", errOrderNotOpen, err)
+	}
+}
+
+func TestInjectFault(t *testing.T) {
+	s := newTestServer()
+	s.InjectFault(serverTicker, Fault{StatusCode: http.StatusServiceUnavailable, Count: 2})
+	s.InjectFault(serverTicker, Fault{Malformed: true})
+
+	expected := []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable,
+		http.StatusOK, http.StatusOK}
+	bodies := []string{"", "", malformedJSON, ""}
+	for i := range expected {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest("GET", serverTicker+"?symbol=BTC-USD", nil))
+		if w.Code != expected[i] {
+			t.Errorf("Test failed. Request %d expected status %d, received %d",
+				i, expected[i], w.Code)
+		}
+		if bodies[i] != "" && w.Body.String() != bodies[i] {
+			t.Errorf("Test failed. Request %d expected body %s, received %s",
+				i, bodies[i], w.Body.String())
+		}
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	s := NewServer(ServerConfig{
+		Prices:    map[string][]float64{"BTC-USD": {10000}},
+		APIKey:    "key",
+		APISecret: "secret",
+	})
+
+	req := httptest.NewRequest("GET", serverAccount, nil)
+	req.Header.Set(headerKey, "key")
+	req.Header.Set(headerNonce, "1")
+	req.Header.Set(headerSignature, "invalid")
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Test failed. Expected status %d, received %d", http.StatusUnauthorized, w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest("GET", serverTicker+"?symbol=BTC-USD", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("Test failed. Expected public request to succeed, received %d", w.Code)
+	}
+}
+
+func TestRandomWalk(t *testing.T) {
+	path := RandomWalk(100, 50, 0.01)
+	if len(path) != 50 || path[0] != 100 {
+		t.Fatalf("Test failed. Unexpected path %v", path)
+	}
+
+	for i := 1; i < len(path); i++ {
+		if path[i] < path[i-1]*0.99 || path[i] > path[i-1]*1.01 {
+			t.Errorf("Test failed. Step %d moved more than the volatility %f to %f",
+				i, path[i-1], path[i])
+		}
+	}
+}
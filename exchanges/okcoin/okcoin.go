@@ -1,6 +1,7 @@
 package okcoin
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -187,11 +188,11 @@ func (o *OKCoin) Setup(exch config.ExchangeConfig) {
 }
 
 // GetSpotInstruments returns a list of tradable spot instruments and their properties
-func (o *OKCoin) GetSpotInstruments() ([]SpotInstrument, error) {
+func (o *OKCoin) GetSpotInstruments(ctx context.Context) ([]SpotInstrument, error) {
 	var resp []SpotInstrument
 
 	path := fmt.Sprintf("%sspot/v3/%s", okcoinAPIURLBase, okcoinInstruments)
-	err := o.SendHTTPRequest(path, &resp)
+	err := o.SendHTTPRequest(ctx, path, &resp)
 
 	if err != nil {
 		return nil, err
@@ -201,17 +202,17 @@ func (o *OKCoin) GetSpotInstruments() ([]SpotInstrument, error) {
 }
 
 // GetTicker returns the current ticker
-func (o *OKCoin) GetTicker(symbol string) (Ticker, error) {
+func (o *OKCoin) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	resp := TickerResponse{}
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	path := common.EncodeURLValues(o.APIUrl+okcoinTicker, vals)
 
-	return resp.Ticker, o.SendHTTPRequest(path, &resp)
+	return resp.Ticker, o.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetOrderBook returns the current order book by size
-func (o *OKCoin) GetOrderBook(symbol string, size int64, merge bool) (Orderbook, error) {
+func (o *OKCoin) GetOrderBook(ctx context.Context, symbol string, size int64, merge bool) (Orderbook, error) {
 	resp := Orderbook{}
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
@@ -223,11 +224,11 @@ func (o *OKCoin) GetOrderBook(symbol string, size int64, merge bool) (Orderbook,
 	}
 
 	path := common.EncodeURLValues(o.APIUrl+okcoinDepth, vals)
-	return resp, o.SendHTTPRequest(path, &resp)
+	return resp, o.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTrades returns historic trades since a timestamp
-func (o *OKCoin) GetTrades(symbol string, since int64) ([]Trades, error) {
+func (o *OKCoin) GetTrades(ctx context.Context, symbol string, since int64) ([]Trades, error) {
 	result := []Trades{}
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
@@ -236,11 +237,11 @@ func (o *OKCoin) GetTrades(symbol string, since int64) ([]Trades, error) {
 	}
 
 	path := common.EncodeURLValues(o.APIUrl+okcoinTrades, vals)
-	return result, o.SendHTTPRequest(path, &result)
+	return result, o.SendHTTPRequest(ctx, path, &result)
 }
 
 // GetKline returns kline data
-func (o *OKCoin) GetKline(symbol, klineType string, size, since int64) ([]interface{}, error) {
+func (o *OKCoin) GetKline(ctx context.Context, symbol, klineType string, size, since int64) ([]interface{}, error) {
 	resp := []interface{}{}
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
@@ -255,22 +256,22 @@ func (o *OKCoin) GetKline(symbol, klineType string, size, since int64) ([]interf
 	}
 
 	path := common.EncodeURLValues(o.APIUrl+okcoinKline, vals)
-	return resp, o.SendHTTPRequest(path, &resp)
+	return resp, o.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetFuturesTicker returns a current ticker for the futures market
-func (o *OKCoin) GetFuturesTicker(symbol, contractType string) (FuturesTicker, error) {
+func (o *OKCoin) GetFuturesTicker(ctx context.Context, symbol, contractType string) (FuturesTicker, error) {
 	resp := FuturesTickerResponse{}
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	vals.Set("contract_type", contractType)
 	path := common.EncodeURLValues(o.APIUrl+okcoinFuturesTicker, vals)
 
-	return resp.Ticker, o.SendHTTPRequest(path, &resp)
+	return resp.Ticker, o.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetFuturesDepth returns current depth for the futures market
-func (o *OKCoin) GetFuturesDepth(symbol, contractType string, size int64, merge bool) (Orderbook, error) {
+func (o *OKCoin) GetFuturesDepth(ctx context.Context, symbol, contractType string, size int64, merge bool) (Orderbook, error) {
 	result := Orderbook{}
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
@@ -284,22 +285,22 @@ func (o *OKCoin) GetFuturesDepth(symbol, contractType string, size int64, merge
 	}
 
 	path := common.EncodeURLValues(o.APIUrl+okcoinFuturesDepth, vals)
-	return result, o.SendHTTPRequest(path, &result)
+	return result, o.SendHTTPRequest(ctx, path, &result)
 }
 
 // GetFuturesTrades returns historic trades for the futures market
-func (o *OKCoin) GetFuturesTrades(symbol, contractType string) ([]FuturesTrades, error) {
+func (o *OKCoin) GetFuturesTrades(ctx context.Context, symbol, contractType string) ([]FuturesTrades, error) {
 	result := []FuturesTrades{}
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	vals.Set("contract_type", contractType)
 
 	path := common.EncodeURLValues(o.APIUrl+okcoinFuturesTrades, vals)
-	return result, o.SendHTTPRequest(path, &result)
+	return result, o.SendHTTPRequest(ctx, path, &result)
 }
 
 // GetFuturesIndex returns an index for the futures market
-func (o *OKCoin) GetFuturesIndex(symbol string) (float64, error) {
+func (o *OKCoin) GetFuturesIndex(ctx context.Context, symbol string) (float64, error) {
 	type Response struct {
 		Index float64 `json:"future_index"`
 	}
@@ -309,22 +310,22 @@ func (o *OKCoin) GetFuturesIndex(symbol string) (float64, error) {
 	vals.Set("symbol", symbol)
 
 	path := common.EncodeURLValues(o.APIUrl+okcoinFuturesIndex, vals)
-	return result.Index, o.SendHTTPRequest(path, &result)
+	return result.Index, o.SendHTTPRequest(ctx, path, &result)
 }
 
 // GetFuturesExchangeRate returns the exchange rate for the futures market
-func (o *OKCoin) GetFuturesExchangeRate() (float64, error) {
+func (o *OKCoin) GetFuturesExchangeRate(ctx context.Context) (float64, error) {
 	type Response struct {
 		Rate float64 `json:"rate"`
 	}
 
 	result := Response{}
-	return result.Rate, o.SendHTTPRequest(o.APIUrl+okcoinExchangeRate, &result)
+	return result.Rate, o.SendHTTPRequest(ctx, o.APIUrl+okcoinExchangeRate, &result)
 }
 
 // GetFuturesEstimatedPrice returns a current estimated futures price for a
 // currency
-func (o *OKCoin) GetFuturesEstimatedPrice(symbol string) (float64, error) {
+func (o *OKCoin) GetFuturesEstimatedPrice(ctx context.Context, symbol string) (float64, error) {
 	type Response struct {
 		Price float64 `json:"forecast_price"`
 	}
@@ -334,12 +335,12 @@ func (o *OKCoin) GetFuturesEstimatedPrice(symbol string) (float64, error) {
 	vals.Set("symbol", symbol)
 	path := common.EncodeURLValues(o.APIUrl+okcoinFuturesEstimatedPrice, vals)
 
-	return result.Price, o.SendHTTPRequest(path, &result)
+	return result.Price, o.SendHTTPRequest(ctx, path, &result)
 }
 
 // GetFuturesKline returns kline data for a specific currency on the futures
 // market
-func (o *OKCoin) GetFuturesKline(symbol, klineType, contractType string, size, since int64) ([]interface{}, error) {
+func (o *OKCoin) GetFuturesKline(ctx context.Context, symbol, klineType, contractType string, size, since int64) ([]interface{}, error) {
 	resp := []interface{}{}
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
@@ -354,22 +355,22 @@ func (o *OKCoin) GetFuturesKline(symbol, klineType, contractType string, size, s
 	}
 
 	path := common.EncodeURLValues(o.APIUrl+okcoinFuturesKline, vals)
-	return resp, o.SendHTTPRequest(path, &resp)
+	return resp, o.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetFuturesHoldAmount returns the hold amount for a futures trade
-func (o *OKCoin) GetFuturesHoldAmount(symbol, contractType string) ([]FuturesHoldAmount, error) {
+func (o *OKCoin) GetFuturesHoldAmount(ctx context.Context, symbol, contractType string) ([]FuturesHoldAmount, error) {
 	resp := []FuturesHoldAmount{}
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	vals.Set("contract_type", contractType)
 
 	path := common.EncodeURLValues(o.APIUrl+okcoinFuturesHoldAmount, vals)
-	return resp, o.SendHTTPRequest(path, &resp)
+	return resp, o.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetFuturesExplosive returns the explosive for a futures contract
-func (o *OKCoin) GetFuturesExplosive(symbol, contractType string, status, currentPage, pageLength int64) ([]FuturesExplosive, error) {
+func (o *OKCoin) GetFuturesExplosive(ctx context.Context, symbol, contractType string, status, currentPage, pageLength int64) ([]FuturesExplosive, error) {
 	type Response struct {
 		Data []FuturesExplosive `json:"data"`
 	}
@@ -383,19 +384,19 @@ func (o *OKCoin) GetFuturesExplosive(symbol, contractType string, status, curren
 
 	path := common.EncodeURLValues(o.APIUrl+okcoinFuturesExplosive, vals)
 
-	return resp.Data, o.SendHTTPRequest(path, &resp)
+	return resp.Data, o.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetUserInfo returns user information associated with the calling APIkeys
-func (o *OKCoin) GetUserInfo() (UserInfo, error) {
+func (o *OKCoin) GetUserInfo(ctx context.Context) (UserInfo, error) {
 	result := UserInfo{}
 
 	return result,
-		o.SendAuthenticatedHTTPRequest(okcoinUserInfo, url.Values{}, &result)
+		o.SendAuthenticatedHTTPRequest(ctx, okcoinUserInfo, url.Values{}, &result)
 }
 
 // Trade initiates a new trade
-func (o *OKCoin) Trade(amount, price float64, symbol, orderType string) (int64, error) {
+func (o *OKCoin) Trade(ctx context.Context, amount, price float64, symbol, orderType string) (int64, error) {
 	type Response struct {
 		Result  bool  `json:"result"`
 		OrderID int64 `json:"order_id"`
@@ -408,7 +409,7 @@ func (o *OKCoin) Trade(amount, price float64, symbol, orderType string) (int64,
 
 	result := Response{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinTrade, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinTrade, v, &result)
 
 	if err != nil {
 		return 0, err
@@ -422,13 +423,13 @@ func (o *OKCoin) Trade(amount, price float64, symbol, orderType string) (int64,
 }
 
 // GetTradeHistory returns client trade history
-func (o *OKCoin) GetTradeHistory(symbol string, TradeID int64) ([]Trades, error) {
+func (o *OKCoin) GetTradeHistory(ctx context.Context, symbol string, TradeID int64) ([]Trades, error) {
 	result := []Trades{}
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("since", strconv.FormatInt(TradeID, 10))
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinTradeHistory, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinTradeHistory, v, &result)
 
 	if err != nil {
 		return nil, err
@@ -438,14 +439,14 @@ func (o *OKCoin) GetTradeHistory(symbol string, TradeID int64) ([]Trades, error)
 }
 
 // BatchTrade initiates a trade by batch order
-func (o *OKCoin) BatchTrade(orderData string, symbol, orderType string) (BatchTrade, error) {
+func (o *OKCoin) BatchTrade(ctx context.Context, orderData string, symbol, orderType string) (BatchTrade, error) {
 	v := url.Values{}
 	v.Set("orders_data", orderData)
 	v.Set("symbol", symbol)
 	v.Set("type", orderType)
 	result := BatchTrade{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinTradeBatch, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinTradeBatch, v, &result)
 
 	if err != nil {
 		return result, err
@@ -455,7 +456,7 @@ func (o *OKCoin) BatchTrade(orderData string, symbol, orderType string) (BatchTr
 }
 
 // CancelExistingOrder cancels a specific order or list of orders by orderID
-func (o *OKCoin) CancelExistingOrder(orderID []int64, symbol string) (CancelOrderResponse, error) {
+func (o *OKCoin) CancelExistingOrder(ctx context.Context, orderID []int64, symbol string) (CancelOrderResponse, error) {
 	v := url.Values{}
 	orders := []string{}
 	result := CancelOrderResponse{}
@@ -472,11 +473,11 @@ func (o *OKCoin) CancelExistingOrder(orderID []int64, symbol string) (CancelOrde
 	v.Set("order_id", orderStr)
 	v.Set("symbol", symbol)
 
-	return result, o.SendAuthenticatedHTTPRequest(okcoinOrderCancel, v, &result)
+	return result, o.SendAuthenticatedHTTPRequest(ctx, okcoinOrderCancel, v, &result)
 }
 
 // GetOrderInformation returns order information by orderID
-func (o *OKCoin) GetOrderInformation(orderID int64, symbol string) ([]OrderInfo, error) {
+func (o *OKCoin) GetOrderInformation(ctx context.Context, orderID int64, symbol string) ([]OrderInfo, error) {
 	type Response struct {
 		Result bool        `json:"result"`
 		Orders []OrderInfo `json:"orders"`
@@ -486,7 +487,7 @@ func (o *OKCoin) GetOrderInformation(orderID int64, symbol string) ([]OrderInfo,
 	v.Set("order_id", strconv.FormatInt(orderID, 10))
 	result := Response{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinOrderInfo, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinOrderInfo, v, &result)
 
 	if err != nil {
 		return nil, err
@@ -500,7 +501,7 @@ func (o *OKCoin) GetOrderInformation(orderID int64, symbol string) ([]OrderInfo,
 }
 
 // GetOrderInfoBatch returns order info on a batch of orders
-func (o *OKCoin) GetOrderInfoBatch(orderID []int64, symbol string) ([]OrderInfo, error) {
+func (o *OKCoin) GetOrderInfoBatch(ctx context.Context, orderID []int64, symbol string) ([]OrderInfo, error) {
 	type Response struct {
 		Result bool        `json:"result"`
 		Orders []OrderInfo `json:"orders"`
@@ -516,7 +517,7 @@ func (o *OKCoin) GetOrderInfoBatch(orderID []int64, symbol string) ([]OrderInfo,
 	v.Set("order_id", common.JoinStrings(orders, ","))
 	result := Response{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinOrderInfo, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinOrderInfo, v, &result)
 
 	if err != nil {
 		return nil, err
@@ -529,8 +530,8 @@ func (o *OKCoin) GetOrderInfoBatch(orderID []int64, symbol string) ([]OrderInfo,
 	return result.Orders, nil
 }
 
-// GetOrderHistory returns a history of orders
-func (o *OKCoin) GetOrderHistory(pageLength, currentPage int64, status, symbol string) (OrderHistory, error) {
+// GetOrderHistoryForCurrency returns a history of orders
+func (o *OKCoin) GetOrderHistoryForCurrency(ctx context.Context, pageLength, currentPage int64, status, symbol string) (OrderHistory, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("status", status)
@@ -538,7 +539,7 @@ func (o *OKCoin) GetOrderHistory(pageLength, currentPage int64, status, symbol s
 	v.Set("page_length", strconv.FormatInt(pageLength, 10))
 	result := OrderHistory{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinOrderHistory, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinOrderHistory, v, &result)
 
 	if err != nil {
 		return result, err
@@ -548,7 +549,7 @@ func (o *OKCoin) GetOrderHistory(pageLength, currentPage int64, status, symbol s
 }
 
 // Withdrawal withdraws a cryptocurrency to a supplied address
-func (o *OKCoin) Withdrawal(symbol string, fee float64, tradePWD, address string, amount float64) (int, error) {
+func (o *OKCoin) Withdrawal(ctx context.Context, symbol string, fee float64, tradePWD, address string, amount float64) (int, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 
@@ -560,7 +561,7 @@ func (o *OKCoin) Withdrawal(symbol string, fee float64, tradePWD, address string
 	v.Set("withdraw_amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	result := WithdrawalResponse{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinWithdraw, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinWithdraw, v, &result)
 
 	if err != nil {
 		return 0, err
@@ -574,13 +575,13 @@ func (o *OKCoin) Withdrawal(symbol string, fee float64, tradePWD, address string
 }
 
 // CancelWithdrawal cancels a withdrawal
-func (o *OKCoin) CancelWithdrawal(symbol string, withdrawalID int64) (int, error) {
+func (o *OKCoin) CancelWithdrawal(ctx context.Context, symbol string, withdrawalID int64) (int, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("withdrawal_id", strconv.FormatInt(withdrawalID, 10))
 	result := WithdrawalResponse{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinWithdrawCancel, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinWithdrawCancel, v, &result)
 
 	if err != nil {
 		return 0, err
@@ -594,7 +595,7 @@ func (o *OKCoin) CancelWithdrawal(symbol string, withdrawalID int64) (int, error
 }
 
 // GetWithdrawalInfo returns withdrawal information
-func (o *OKCoin) GetWithdrawalInfo(symbol string, withdrawalID int64) ([]WithdrawInfo, error) {
+func (o *OKCoin) GetWithdrawalInfo(ctx context.Context, symbol string, withdrawalID int64) ([]WithdrawInfo, error) {
 	type Response struct {
 		Result   bool
 		Withdraw []WithdrawInfo `json:"withdraw"`
@@ -604,7 +605,7 @@ func (o *OKCoin) GetWithdrawalInfo(symbol string, withdrawalID int64) ([]Withdra
 	v.Set("withdrawal_id", strconv.FormatInt(withdrawalID, 10))
 	result := Response{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinWithdrawInfo, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinWithdrawInfo, v, &result)
 
 	if err != nil {
 		return nil, err
@@ -618,7 +619,7 @@ func (o *OKCoin) GetWithdrawalInfo(symbol string, withdrawalID int64) ([]Withdra
 }
 
 // GetOrderFeeInfo returns order fee information
-func (o *OKCoin) GetOrderFeeInfo(symbol string, orderID int64) (OrderFeeInfo, error) {
+func (o *OKCoin) GetOrderFeeInfo(ctx context.Context, symbol string, orderID int64) (OrderFeeInfo, error) {
 	type Response struct {
 		Data   OrderFeeInfo `json:"data"`
 		Result bool         `json:"result"`
@@ -629,7 +630,7 @@ func (o *OKCoin) GetOrderFeeInfo(symbol string, orderID int64) (OrderFeeInfo, er
 	v.Set("order_id", strconv.FormatInt(orderID, 10))
 	result := Response{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinOrderFee, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinOrderFee, v, &result)
 
 	if err != nil {
 		return result.Data, err
@@ -643,7 +644,7 @@ func (o *OKCoin) GetOrderFeeInfo(symbol string, orderID int64) (OrderFeeInfo, er
 }
 
 // GetLendDepth returns the depth of lends
-func (o *OKCoin) GetLendDepth(symbol string) ([]LendDepth, error) {
+func (o *OKCoin) GetLendDepth(ctx context.Context, symbol string) ([]LendDepth, error) {
 	type Response struct {
 		LendDepth []LendDepth `json:"lend_depth"`
 	}
@@ -652,7 +653,7 @@ func (o *OKCoin) GetLendDepth(symbol string) ([]LendDepth, error) {
 	v.Set("symbol", symbol)
 	result := Response{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinLendDepth, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinLendDepth, v, &result)
 
 	if err != nil {
 		return nil, err
@@ -662,12 +663,12 @@ func (o *OKCoin) GetLendDepth(symbol string) ([]LendDepth, error) {
 }
 
 // GetBorrowInfo returns borrow information
-func (o *OKCoin) GetBorrowInfo(symbol string) (BorrowInfo, error) {
+func (o *OKCoin) GetBorrowInfo(ctx context.Context, symbol string) (BorrowInfo, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	result := BorrowInfo{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinBorrowsInfo, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinBorrowsInfo, v, &result)
 
 	if err != nil {
 		return result, nil
@@ -677,7 +678,7 @@ func (o *OKCoin) GetBorrowInfo(symbol string) (BorrowInfo, error) {
 }
 
 // Borrow initiates a borrow request
-func (o *OKCoin) Borrow(symbol, days string, amount, rate float64) (int, error) {
+func (o *OKCoin) Borrow(ctx context.Context, symbol, days string, amount, rate float64) (int, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("days", days)
@@ -685,7 +686,7 @@ func (o *OKCoin) Borrow(symbol, days string, amount, rate float64) (int, error)
 	v.Set("rate", strconv.FormatFloat(rate, 'f', -1, 64))
 	result := BorrowResponse{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinBorrowMoney, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinBorrowMoney, v, &result)
 
 	if err != nil {
 		return 0, err
@@ -699,13 +700,13 @@ func (o *OKCoin) Borrow(symbol, days string, amount, rate float64) (int, error)
 }
 
 // CancelBorrow cancels a borrow request
-func (o *OKCoin) CancelBorrow(symbol string, borrowID int64) (bool, error) {
+func (o *OKCoin) CancelBorrow(ctx context.Context, symbol string, borrowID int64) (bool, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("borrow_id", strconv.FormatInt(borrowID, 10))
 	result := BorrowResponse{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinBorrowCancel, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinBorrowCancel, v, &result)
 
 	if err != nil {
 		return false, err
@@ -719,7 +720,7 @@ func (o *OKCoin) CancelBorrow(symbol string, borrowID int64) (bool, error) {
 }
 
 // GetBorrowOrderInfo returns information about a borrow order
-func (o *OKCoin) GetBorrowOrderInfo(borrowID int64) (BorrowInfo, error) {
+func (o *OKCoin) GetBorrowOrderInfo(ctx context.Context, borrowID int64) (BorrowInfo, error) {
 	type Response struct {
 		Result      bool       `json:"result"`
 		BorrowOrder BorrowInfo `json:"borrow_order"`
@@ -728,7 +729,7 @@ func (o *OKCoin) GetBorrowOrderInfo(borrowID int64) (BorrowInfo, error) {
 	v := url.Values{}
 	v.Set("borrow_id", strconv.FormatInt(borrowID, 10))
 	result := Response{}
-	err := o.SendAuthenticatedHTTPRequest(okcoinBorrowOrderInfo, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinBorrowOrderInfo, v, &result)
 
 	if err != nil {
 		return result.BorrowOrder, err
@@ -742,12 +743,12 @@ func (o *OKCoin) GetBorrowOrderInfo(borrowID int64) (BorrowInfo, error) {
 }
 
 // GetRepaymentInfo returns information on a repayment
-func (o *OKCoin) GetRepaymentInfo(borrowID int64) (bool, error) {
+func (o *OKCoin) GetRepaymentInfo(ctx context.Context, borrowID int64) (bool, error) {
 	v := url.Values{}
 	v.Set("borrow_id", strconv.FormatInt(borrowID, 10))
 	result := BorrowResponse{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinRepayment, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinRepayment, v, &result)
 
 	if err != nil {
 		return false, err
@@ -761,7 +762,7 @@ func (o *OKCoin) GetRepaymentInfo(borrowID int64) (bool, error) {
 }
 
 // GetUnrepaymentsInfo returns information on an unrepayment
-func (o *OKCoin) GetUnrepaymentsInfo(symbol string, currentPage, pageLength int) ([]BorrowOrder, error) {
+func (o *OKCoin) GetUnrepaymentsInfo(ctx context.Context, symbol string, currentPage, pageLength int) ([]BorrowOrder, error) {
 	type Response struct {
 		Unrepayments []BorrowOrder `json:"unrepayments"`
 		Result       bool          `json:"result"`
@@ -771,7 +772,7 @@ func (o *OKCoin) GetUnrepaymentsInfo(symbol string, currentPage, pageLength int)
 	v.Set("current_page", strconv.Itoa(currentPage))
 	v.Set("page_length", strconv.Itoa(pageLength))
 	result := Response{}
-	err := o.SendAuthenticatedHTTPRequest(okcoinUnrepaymentsInfo, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinUnrepaymentsInfo, v, &result)
 
 	if err != nil {
 		return nil, err
@@ -785,7 +786,7 @@ func (o *OKCoin) GetUnrepaymentsInfo(symbol string, currentPage, pageLength int)
 }
 
 // GetAccountRecords returns account records
-func (o *OKCoin) GetAccountRecords(symbol string, recType, currentPage, pageLength int) ([]AccountRecords, error) {
+func (o *OKCoin) GetAccountRecords(ctx context.Context, symbol string, recType, currentPage, pageLength int) ([]AccountRecords, error) {
 	type Response struct {
 		Records []AccountRecords `json:"records"`
 		Symbol  string           `json:"symbol"`
@@ -797,7 +798,7 @@ func (o *OKCoin) GetAccountRecords(symbol string, recType, currentPage, pageLeng
 	v.Set("page_length", strconv.Itoa(pageLength))
 	result := Response{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinAccountRecords, v, &result)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinAccountRecords, v, &result)
 
 	if err != nil {
 		return nil, err
@@ -807,8 +808,8 @@ func (o *OKCoin) GetAccountRecords(symbol string, recType, currentPage, pageLeng
 }
 
 // GetFuturesUserInfo returns information on a users futures
-func (o *OKCoin) GetFuturesUserInfo() {
-	err := o.SendAuthenticatedHTTPRequest(okcoinFuturesUserInfo, url.Values{}, nil)
+func (o *OKCoin) GetFuturesUserInfo(ctx context.Context) {
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinFuturesUserInfo, url.Values{}, nil)
 
 	if err != nil {
 		log.Println(err)
@@ -816,11 +817,11 @@ func (o *OKCoin) GetFuturesUserInfo() {
 }
 
 // GetFuturesPosition returns position on a futures contract
-func (o *OKCoin) GetFuturesPosition(symbol, contractType string) {
+func (o *OKCoin) GetFuturesPosition(ctx context.Context, symbol, contractType string) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("contract_type", contractType)
-	err := o.SendAuthenticatedHTTPRequest(okcoinFuturesPosition, v, nil)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinFuturesPosition, v, nil)
 
 	if err != nil {
 		log.Println(err)
@@ -828,7 +829,7 @@ func (o *OKCoin) GetFuturesPosition(symbol, contractType string) {
 }
 
 // FuturesTrade initiates a new futures trade
-func (o *OKCoin) FuturesTrade(amount, price float64, matchPrice, leverage int64, symbol, contractType, orderType string) {
+func (o *OKCoin) FuturesTrade(ctx context.Context, amount, price float64, matchPrice, leverage int64, symbol, contractType, orderType string) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("contract_type", contractType)
@@ -838,7 +839,7 @@ func (o *OKCoin) FuturesTrade(amount, price float64, matchPrice, leverage int64,
 	v.Set("match_price", strconv.FormatInt(matchPrice, 10))
 	v.Set("lever_rate", strconv.FormatInt(leverage, 10))
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinFuturesTrade, v, nil)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinFuturesTrade, v, nil)
 
 	if err != nil {
 		log.Println(err)
@@ -846,14 +847,14 @@ func (o *OKCoin) FuturesTrade(amount, price float64, matchPrice, leverage int64,
 }
 
 // FuturesBatchTrade initiates a batch of futures contract trades
-func (o *OKCoin) FuturesBatchTrade(orderData, symbol, contractType string, leverage int64, orderType string) {
+func (o *OKCoin) FuturesBatchTrade(ctx context.Context, orderData, symbol, contractType string, leverage int64, orderType string) {
 	v := url.Values{} //to-do batch trade support for orders_data)
 	v.Set("symbol", symbol)
 	v.Set("contract_type", contractType)
 	v.Set("orders_data", orderData)
 	v.Set("lever_rate", strconv.FormatInt(leverage, 10))
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinFuturesTradeBatch, v, nil)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinFuturesTradeBatch, v, nil)
 
 	if err != nil {
 		log.Println(err)
@@ -861,13 +862,13 @@ func (o *OKCoin) FuturesBatchTrade(orderData, symbol, contractType string, lever
 }
 
 // CancelFuturesOrder cancels a futures contract order
-func (o *OKCoin) CancelFuturesOrder(orderID int64, symbol, contractType string) {
+func (o *OKCoin) CancelFuturesOrder(ctx context.Context, orderID int64, symbol, contractType string) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("contract_type", contractType)
 	v.Set("order_id", strconv.FormatInt(orderID, 10))
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinFuturesCancel, v, nil)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinFuturesCancel, v, nil)
 
 	if err != nil {
 		log.Println(err)
@@ -875,7 +876,7 @@ func (o *OKCoin) CancelFuturesOrder(orderID int64, symbol, contractType string)
 }
 
 // GetFuturesOrderInfo returns information on a specific futures contract order
-func (o *OKCoin) GetFuturesOrderInfo(orderID, status, currentPage, pageLength int64, symbol, contractType string) {
+func (o *OKCoin) GetFuturesOrderInfo(ctx context.Context, orderID, status, currentPage, pageLength int64, symbol, contractType string) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("contract_type", contractType)
@@ -884,7 +885,7 @@ func (o *OKCoin) GetFuturesOrderInfo(orderID, status, currentPage, pageLength in
 	v.Set("current_page", strconv.FormatInt(currentPage, 10))
 	v.Set("page_length", strconv.FormatInt(pageLength, 10))
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinFuturesOrderInfo, v, nil)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinFuturesOrderInfo, v, nil)
 
 	if err != nil {
 		log.Println(err)
@@ -892,13 +893,13 @@ func (o *OKCoin) GetFuturesOrderInfo(orderID, status, currentPage, pageLength in
 }
 
 // GetFutureOrdersInfo returns information on a range of futures orders
-func (o *OKCoin) GetFutureOrdersInfo(orderID int64, contractType, symbol string) {
+func (o *OKCoin) GetFutureOrdersInfo(ctx context.Context, orderID int64, contractType, symbol string) {
 	v := url.Values{}
 	v.Set("order_id", strconv.FormatInt(orderID, 10))
 	v.Set("contract_type", contractType)
 	v.Set("symbol", symbol)
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinFuturesOrdersInfo, v, nil)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinFuturesOrdersInfo, v, nil)
 
 	if err != nil {
 		log.Println(err)
@@ -906,10 +907,10 @@ func (o *OKCoin) GetFutureOrdersInfo(orderID int64, contractType, symbol string)
 }
 
 // GetFuturesUserInfo4Fix returns futures user info fix rate
-func (o *OKCoin) GetFuturesUserInfo4Fix() {
+func (o *OKCoin) GetFuturesUserInfo4Fix(ctx context.Context) {
 	v := url.Values{}
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinFuturesUserInfo4Fix, v, nil)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinFuturesUserInfo4Fix, v, nil)
 
 	if err != nil {
 		log.Println(err)
@@ -917,13 +918,13 @@ func (o *OKCoin) GetFuturesUserInfo4Fix() {
 }
 
 // GetFuturesUserPosition4Fix returns futures user info on a fixed position
-func (o *OKCoin) GetFuturesUserPosition4Fix(symbol, contractType string) {
+func (o *OKCoin) GetFuturesUserPosition4Fix(ctx context.Context, symbol, contractType string) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	v.Set("contract_type", contractType)
 	v.Set("type", strconv.FormatInt(1, 10))
 
-	err := o.SendAuthenticatedHTTPRequest(okcoinFuturesUserInfo4Fix, v, nil)
+	err := o.SendAuthenticatedHTTPRequest(ctx, okcoinFuturesUserInfo4Fix, v, nil)
 
 	if err != nil {
 		log.Println(err)
@@ -931,12 +932,12 @@ func (o *OKCoin) GetFuturesUserPosition4Fix(symbol, contractType string) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (o *OKCoin) SendHTTPRequest(path string, result interface{}) error {
-	return o.SendPayload("GET", path, nil, nil, result, false, o.Verbose)
+func (o *OKCoin) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return o.SendPayload(ctx, "GET", path, nil, nil, result, false, o.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request
-func (o *OKCoin) SendAuthenticatedHTTPRequest(method string, v url.Values, result interface{}) (err error) {
+func (o *OKCoin) SendAuthenticatedHTTPRequest(ctx context.Context, method string, v url.Values, result interface{}) (err error) {
 	if !o.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, o.Name)
 	}
@@ -955,7 +956,7 @@ func (o *OKCoin) SendAuthenticatedHTTPRequest(method string, v url.Values, resul
 	headers := make(map[string]string)
 	headers["Content-Type"] = "application/x-www-form-urlencoded"
 
-	return o.SendPayload("POST", path, headers, strings.NewReader(encoded), result, true, o.Verbose)
+	return o.SendPayload(ctx, "POST", path, headers, strings.NewReader(encoded), result, true, o.Verbose)
 }
 
 // SetErrorDefaults sets default error map
@@ -1,16 +1,24 @@
 package okcoin
 
 import (
+	"context"
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var o OKCoin
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 // Please supply your own APIKEYS here for due diligence testing
 
 const (
@@ -54,7 +62,7 @@ func setFeeBuilder() exchange.FeeBuilder {
 
 func TestGetSpotInstruments(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotInstruments()
+	_, err := o.GetSpotInstruments(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - okcoin GetSpotInstruments() failed: %s", err)
 	}
@@ -148,6 +156,60 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a OKCoin using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *OKCoin {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	okcoinConfig, err := cfg.GetExchangeConfig("OKCOIN International")
+	if err != nil {
+		t.Fatal("Test Failed - OKCoin Setup() init error")
+	}
+
+	okcoinConfig.AuthenticatedAPISupport = true
+	okcoinConfig.APIKey, okcoinConfig.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r OKCoin
+	r.SetDefaults()
+	r.Setup(okcoinConfig)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.LTC, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - OKCoin GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "10000591" ||
+		orders[0].BaseCurrency != symbol.LTC || orders[0].QuoteCurrency != symbol.BTC ||
+		orders[0].OrderSide != "sell" || orders[0].Status != "PARTIALLY_FILLED" ||
+		orders[0].Price != 0.0085 || orders[0].OpenVolume != 1.5 ||
+		orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - OKCoin GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.LTC, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - OKCoin GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "10000588" ||
+		orders[0].Status != "FILLED" || orders[0].OrderSide != "buy" ||
+		orders[0].OrderType != "market" || orders[0].ExecutedAmount != 2) {
+		t.Errorf("Test Failed - OKCoin GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -173,7 +235,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.EUR,
 	}
-	response, err := o.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := o.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -199,7 +261,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := o.CancelOrder(orderCancellation)
+	err := o.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
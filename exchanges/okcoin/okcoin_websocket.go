@@ -171,15 +171,17 @@ func (o *OKCoin) WsHandleData() {
 				}
 
 				o.Websocket.DataHandler <- exchange.TickerData{
-					Timestamp:  time.Unix(0, ticker.Timestamp),
+					Timestamp:  time.Unix(0, ticker.Timestamp*int64(time.Millisecond)),
 					Pair:       pair.NewCurrencyPairFromString(currencyPair),
 					AssetType:  assetType,
 					Exchange:   o.GetName(),
-					ClosePrice: ticker.Close,
+					ClosePrice: ticker.Last,
 					OpenPrice:  ticker.Open,
-					HighPrice:  ticker.Last,
+					HighPrice:  ticker.High,
 					LowPrice:   ticker.Low,
 					Quantity:   ticker.Volume,
+					BidPrice:   ticker.Buy,
+					AskPrice:   ticker.Sell,
 				}
 
 			case common.StringContains(init[0].Channel, "depth"):
@@ -1,11 +1,13 @@
 package okcoin
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -38,7 +40,7 @@ func (o *OKCoin) Run() {
 			forceUpgrade = true
 		}
 
-		prods, err := o.GetSpotInstruments()
+		prods, err := o.GetSpotInstruments(context.Background())
 		if err != nil {
 			log.Printf("OKEX failed to obtain available spot instruments. Err: %d", err)
 		} else {
@@ -66,12 +68,12 @@ func (o *OKCoin) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (o *OKCoin) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (o *OKCoin) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	currency := exchange.FormatExchangeCurrency(o.Name, p).String()
 	var tickerPrice ticker.Price
 
 	if assetType != ticker.Spot && o.APIUrl == okcoinAPIURL {
-		tick, err := o.GetFuturesTicker(currency, assetType)
+		tick, err := o.GetFuturesTicker(ctx, currency, assetType)
 		if err != nil {
 			return tickerPrice, err
 		}
@@ -84,7 +86,7 @@ func (o *OKCoin) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pri
 		tickerPrice.High = tick.High
 		ticker.ProcessTicker(o.GetName(), p, tickerPrice, assetType)
 	} else {
-		tick, err := o.GetTicker(currency)
+		tick, err := o.GetTicker(ctx, currency)
 		if err != nil {
 			return tickerPrice, err
 		}
@@ -102,27 +104,27 @@ func (o *OKCoin) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pri
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (o *OKCoin) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (o *OKCoin) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(o.GetName(), p, assetType)
 	if err != nil {
-		return o.UpdateTicker(p, assetType)
+		return o.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (o *OKCoin) GetOrderbookEx(currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (o *OKCoin) GetOrderbookEx(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(o.GetName(), currency, assetType)
 	if err != nil {
-		return o.UpdateOrderbook(currency, assetType)
+		return o.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (o *OKCoin) UpdateOrderbook(currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (o *OKCoin) UpdateOrderbook(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := o.GetOrderBook(exchange.FormatExchangeCurrency(o.Name, currency).String(), 200, false)
+	orderbookNew, err := o.GetOrderBook(ctx, exchange.FormatExchangeCurrency(o.Name, currency).String(), 200, false)
 	if err != nil {
 		return orderBook, err
 	}
@@ -143,10 +145,10 @@ func (o *OKCoin) UpdateOrderbook(currency pair.CurrencyPair, assetType string) (
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // OKCoin exchange
-func (o *OKCoin) GetAccountInfo() (exchange.AccountInfo, error) {
+func (o *OKCoin) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = o.GetName()
-	assets, err := o.GetUserInfo()
+	assets, err := o.GetUserInfo(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -180,30 +182,34 @@ func (o *OKCoin) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (o *OKCoin) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (o *OKCoin) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (o *OKCoin) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (o *OKCoin) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (o *OKCoin) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (o *OKCoin) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := o.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
 	var oT string
-	if orderType == exchange.Limit {
-		if side == exchange.Buy {
+	if order.OrderType == exchange.Limit {
+		if order.Side == exchange.Buy {
 			oT = "buy"
 		} else {
 			oT = "sell"
 		}
-	} else if orderType == exchange.Market {
-		if side == exchange.Buy {
+	} else if order.OrderType == exchange.Market {
+		if order.Side == exchange.Buy {
 			oT = "buy_market"
 		} else {
 			oT = "sell_market"
@@ -212,7 +218,7 @@ func (o *OKCoin) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, order
 		return submitOrderResponse, errors.New("Unsupported order type")
 	}
 
-	response, err := o.Trade(amount, price, p.Pair().String(), oT)
+	response, err := o.Trade(ctx, order.Amount, order.Price, order.Pair.Pair().String(), oT)
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -227,12 +233,12 @@ func (o *OKCoin) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, order
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (o *OKCoin) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (o *OKCoin) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (o *OKCoin) CancelOrder(order exchange.OrderCancellation) error {
+func (o *OKCoin) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	orders := []int64{orderIDInt}
 
@@ -240,36 +246,63 @@ func (o *OKCoin) CancelOrder(order exchange.OrderCancellation) error {
 		return err
 	}
 
-	_, err = o.CancelExistingOrder(orders, exchange.FormatExchangeCurrency(o.Name, order.CurrencyPair).String())
+	_, err = o.CancelExistingOrder(ctx, orders, exchange.FormatExchangeCurrency(o.Name, order.CurrencyPair).String())
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (o *OKCoin) CancelAllOrders() error {
+func (o *OKCoin) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (o *OKCoin) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (o *OKCoin) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (o *OKCoin) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (o *OKCoin) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (o *OKCoin) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (o *OKCoin) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (o *OKCoin) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (o *OKCoin) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (o *OKCoin) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (o *OKCoin) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (o *OKCoin) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (o *OKCoin) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (o *OKCoin) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -293,3 +326,79 @@ func (o *OKCoin) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (o *OKCoin) GetWithdrawCapabilities() uint32 {
 	return o.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (o *OKCoin) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return o.getOrders(ctx, getOrdersRequest, func(symbol string) ([]OrderInfo, error) {
+		// An order ID of -1 returns all unfilled orders of the symbol
+		return o.GetOrderInformation(ctx, -1, symbol)
+	})
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (o *OKCoin) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return o.getOrders(ctx, getOrdersRequest, func(symbol string) ([]OrderInfo, error) {
+		resp, err := o.GetOrderHistoryForCurrency(ctx, 200, 1, "1", symbol)
+		return resp.Orders, err
+	})
+}
+
+// orderStatus maps the OKCoin order status codes to order statuses
+var orderStatus = map[int]string{
+	-1: "CANCELLED",
+	0:  "OPEN",
+	1:  "PARTIALLY_FILLED",
+	2:  "FILLED",
+	3:  "CANCELLED",
+	4:  "CANCELLED",
+}
+
+// getOrders returns the orders fetched for each requested currency pair,
+// OKCoin requires a symbol so at least one currency pair must be requested
+func (o *OKCoin) getOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest, fetch func(symbol string) ([]OrderInfo, error)) ([]exchange.OrderDetail, error) {
+	if len(getOrdersRequest.Currencies) == 0 {
+		return nil, errors.New("at least one currency pair is required")
+	}
+
+	var orders []exchange.OrderDetail
+	for _, c := range getOrdersRequest.Currencies {
+		resp, err := fetch(exchange.FormatExchangeCurrency(o.Name, c).String())
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range resp {
+			// Order types combine the side and type, for example buy_market
+			side, orderType := resp[i].Type, exchange.Limit.ToString()
+			if split := common.SplitStrings(resp[i].Type, "_"); len(split) > 1 {
+				side, orderType = split[0], split[1]
+			}
+
+			orderDate := time.Unix(0, resp[i].Created*int64(time.Millisecond))
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:       o.Name,
+				ID:             strconv.FormatInt(resp[i].OrderID, 10),
+				BaseCurrency:   c.FirstCurrency.String(),
+				QuoteCurrency:  c.SecondCurrency.String(),
+				OrderSide:      side,
+				OrderType:      orderType,
+				CreationTime:   orderDate.Unix(),
+				OrderDate:      orderDate,
+				Status:         orderStatus[resp[i].Status],
+				Price:          resp[i].Price,
+				Amount:         resp[i].Amount,
+				OpenVolume:     resp[i].Amount - resp[i].DealAmount,
+				ExecutedAmount: resp[i].DealAmount,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (o *OKCoin) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
@@ -1,6 +1,7 @@
 package okex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -75,6 +76,14 @@ const (
 
 	okexAuthRate   = 0
 	okexUnauthRate = 0
+
+	// okexMaxKlineLimit is the maximum amount of candles returned per request
+	okexMaxKlineLimit = 2000
+
+	// Futures contract values in USD, BTC contracts are worth more than the
+	// contracts of every other symbol
+	okexBTCContractValue = 100
+	okexContractValue    = 10
 )
 
 var errMissValue = errors.New("warning - resp value is missing from exchange")
@@ -85,6 +94,10 @@ type OKEX struct {
 	WebsocketConn *websocket.Conn
 	mu            sync.Mutex
 
+	// wsDepthLoaded holds the depth channels which have sent their initial
+	// full depth since connecting
+	wsDepthLoaded map[string]bool
+
 	// Spot and contract market error codes as per https://www.okex.com/rest_request.html
 	ErrorCodes map[string]error
 
@@ -168,11 +181,11 @@ func (o *OKEX) Setup(exch config.ExchangeConfig) {
 }
 
 // GetSpotInstruments returns a list of tradable spot instruments and their properties
-func (o *OKEX) GetSpotInstruments() ([]SpotInstrument, error) {
+func (o *OKEX) GetSpotInstruments(ctx context.Context) ([]SpotInstrument, error) {
 	var resp []SpotInstrument
 
 	path := fmt.Sprintf("%sspot/v3/%s", o.APIUrl, instruments)
-	err := o.SendHTTPRequest(path, &resp)
+	err := o.SendHTTPRequest(ctx, path, &resp)
 
 	if err != nil {
 		return nil, err
@@ -185,7 +198,7 @@ func (o *OKEX) GetSpotInstruments() ([]SpotInstrument, error) {
 //
 // symbol e.g. "btc_usd"
 // contractType e.g. "this_week" "next_week" "quarter"
-func (o *OKEX) GetContractPrice(symbol, contractType string) (ContractPrice, error) {
+func (o *OKEX) GetContractPrice(ctx context.Context, symbol, contractType string) (ContractPrice, error) {
 	resp := ContractPrice{}
 
 	if err := o.CheckContractType(contractType); err != nil {
@@ -201,7 +214,7 @@ func (o *OKEX) GetContractPrice(symbol, contractType string) (ContractPrice, err
 
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, contractPrice, values.Encode())
 
-	err := o.SendHTTPRequest(path, &resp)
+	err := o.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -218,7 +231,7 @@ func (o *OKEX) GetContractPrice(symbol, contractType string) (ContractPrice, err
 //
 // symbol e.g. "btc_usd"
 // contractType e.g. "this_week" "next_week" "quarter"
-func (o *OKEX) GetContractMarketDepth(symbol, contractType string) (ActualContractDepth, error) {
+func (o *OKEX) GetContractMarketDepth(ctx context.Context, symbol, contractType string) (ActualContractDepth, error) {
 	resp := ContractDepth{}
 	fullDepth := ActualContractDepth{}
 
@@ -235,7 +248,7 @@ func (o *OKEX) GetContractMarketDepth(symbol, contractType string) (ActualContra
 
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, contractFutureDepth, values.Encode())
 
-	err := o.SendHTTPRequest(path, &resp)
+	err := o.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return fullDepth, err
 	}
@@ -282,7 +295,7 @@ func (o *OKEX) GetContractMarketDepth(symbol, contractType string) (ActualContra
 }
 
 // GetContractTradeHistory returns trade history for the contract market
-func (o *OKEX) GetContractTradeHistory(symbol, contractType string) ([]ActualContractTradeHistory, error) {
+func (o *OKEX) GetContractTradeHistory(ctx context.Context, symbol, contractType string) ([]ActualContractTradeHistory, error) {
 	actualTradeHistory := []ActualContractTradeHistory{}
 	var resp interface{}
 
@@ -299,7 +312,7 @@ func (o *OKEX) GetContractTradeHistory(symbol, contractType string) ([]ActualCon
 
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, contractTradeHistory, values.Encode())
 
-	err := o.SendHTTPRequest(path, &resp)
+	err := o.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return actualTradeHistory, err
 	}
@@ -326,7 +339,7 @@ func (o *OKEX) GetContractTradeHistory(symbol, contractType string) ([]ActualCon
 // GetContractIndexPrice returns the current index price
 //
 // symbol e.g. btc_usd
-func (o *OKEX) GetContractIndexPrice(symbol string) (float64, error) {
+func (o *OKEX) GetContractIndexPrice(ctx context.Context, symbol string) (float64, error) {
 	if err := o.CheckSymbol(symbol); err != nil {
 		return 0, err
 	}
@@ -336,7 +349,7 @@ func (o *OKEX) GetContractIndexPrice(symbol string) (float64, error) {
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, contractFutureIndex, values.Encode())
 	var resp interface{}
 
-	err := o.SendHTTPRequest(path, &resp)
+	err := o.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return 0, err
 	}
@@ -355,11 +368,11 @@ func (o *OKEX) GetContractIndexPrice(symbol string) (float64, error) {
 // GetContractExchangeRate returns the current exchange rate for the currency
 // pair
 // USD-CNY exchange rate used by OKEX, updated weekly
-func (o *OKEX) GetContractExchangeRate() (float64, error) {
+func (o *OKEX) GetContractExchangeRate(ctx context.Context) (float64, error) {
 	path := fmt.Sprintf("%s%s%s.do?", o.APIUrl, apiVersion, contractExchangeRate)
 	var resp interface{}
 
-	if err := o.SendHTTPRequest(path, &resp); err != nil {
+	if err := o.SendHTTPRequest(ctx, path, &resp); err != nil {
 		return 0, err
 	}
 
@@ -377,7 +390,7 @@ func (o *OKEX) GetContractExchangeRate() (float64, error) {
 // GetContractFutureEstimatedPrice returns futures estimated price
 //
 // symbol e.g btc_usd
-func (o *OKEX) GetContractFutureEstimatedPrice(symbol string) (float64, error) {
+func (o *OKEX) GetContractFutureEstimatedPrice(ctx context.Context, symbol string) (float64, error) {
 	if err := o.CheckSymbol(symbol); err != nil {
 		return 0, err
 	}
@@ -387,7 +400,7 @@ func (o *OKEX) GetContractFutureEstimatedPrice(symbol string) (float64, error) {
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, contractFutureIndex, values.Encode())
 	var resp interface{}
 
-	if err := o.SendHTTPRequest(path, &resp); err != nil {
+	if err := o.SendHTTPRequest(ctx, path, &resp); err != nil {
 		return 0, err
 	}
 
@@ -409,7 +422,7 @@ func (o *OKEX) GetContractFutureEstimatedPrice(symbol string) (float64, error) {
 // contract_type e.g. this_week
 // size: specify data size to be acquired
 // since: timestamp(eg:1417536000000). data after the timestamp will be returned
-func (o *OKEX) GetContractCandlestickData(symbol, typeInput, contractType string, size, since int) ([]CandleStickData, error) {
+func (o *OKEX) GetContractCandlestickData(ctx context.Context, symbol, typeInput, contractType string, size, since int) ([]CandleStickData, error) {
 	var candleData []CandleStickData
 	if err := o.CheckSymbol(symbol); err != nil {
 		return candleData, err
@@ -431,7 +444,7 @@ func (o *OKEX) GetContractCandlestickData(symbol, typeInput, contractType string
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, contractCandleStick, values.Encode())
 	var resp interface{}
 
-	if err := o.SendHTTPRequest(path, &resp); err != nil {
+	if err := o.SendHTTPRequest(ctx, path, &resp); err != nil {
 		return candleData, err
 	}
 
@@ -470,7 +483,7 @@ func (o *OKEX) GetContractCandlestickData(symbol, typeInput, contractType string
 }
 
 // GetContractHoldingsNumber returns current number of holdings
-func (o *OKEX) GetContractHoldingsNumber(symbol, contractType string) (number float64, contract string, err error) {
+func (o *OKEX) GetContractHoldingsNumber(ctx context.Context, symbol, contractType string) (number float64, contract string, err error) {
 	if err = o.CheckSymbol(symbol); err != nil {
 		return number, contract, err
 	}
@@ -485,7 +498,7 @@ func (o *OKEX) GetContractHoldingsNumber(symbol, contractType string) (number fl
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, contractFutureHoldAmount, values.Encode())
 	var resp interface{}
 
-	if err = o.SendHTTPRequest(path, &resp); err != nil {
+	if err = o.SendHTTPRequest(ctx, path, &resp); err != nil {
 		return number, contract, err
 	}
 
@@ -505,7 +518,7 @@ func (o *OKEX) GetContractHoldingsNumber(symbol, contractType string) (number fl
 }
 
 // GetContractlimit returns upper and lower price limit
-func (o *OKEX) GetContractlimit(symbol, contractType string) (map[string]float64, error) {
+func (o *OKEX) GetContractlimit(ctx context.Context, symbol, contractType string) (map[string]float64, error) {
 	contractLimits := make(map[string]float64)
 	if err := o.CheckSymbol(symbol); err != nil {
 		return contractLimits, err
@@ -521,7 +534,7 @@ func (o *OKEX) GetContractlimit(symbol, contractType string) (map[string]float64
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, contractFutureLimits, values.Encode())
 	var resp interface{}
 
-	if err := o.SendHTTPRequest(path, &resp); err != nil {
+	if err := o.SendHTTPRequest(ctx, path, &resp); err != nil {
 		return contractLimits, err
 	}
 
@@ -537,9 +550,9 @@ func (o *OKEX) GetContractlimit(symbol, contractType string) (map[string]float64
 }
 
 // GetContractUserInfo returns OKEX Contract Account Info（Cross-Margin Mode）
-func (o *OKEX) GetContractUserInfo() error {
+func (o *OKEX) GetContractUserInfo(ctx context.Context) error {
 	var resp interface{}
-	if err := o.SendAuthenticatedHTTPRequest(contractFutureUserInfo, url.Values{}, &resp); err != nil {
+	if err := o.SendAuthenticatedHTTPRequest(ctx, contractFutureUserInfo, url.Values{}, &resp); err != nil {
 		return err
 	}
 
@@ -551,33 +564,32 @@ func (o *OKEX) GetContractUserInfo() error {
 }
 
 // GetContractPosition returns User Contract Positions （Cross-Margin Mode）
-func (o *OKEX) GetContractPosition(symbol, contractType string) error {
-	var resp interface{}
+func (o *OKEX) GetContractPosition(ctx context.Context, symbol, contractType string) (ContractPosition, error) {
+	var resp ContractPosition
 
 	if err := o.CheckSymbol(symbol); err != nil {
-		return err
+		return resp, err
 	}
 	if err := o.CheckContractType(contractType); err != nil {
-		return err
+		return resp, err
 	}
 
 	values := url.Values{}
 	values.Set("symbol", symbol)
 	values.Set("contract_type", contractType)
 
-	if err := o.SendAuthenticatedHTTPRequest(contractFuturePosition, values, &resp); err != nil {
-		return err
+	if err := o.SendAuthenticatedHTTPRequest(ctx, contractFuturePosition, values, &resp); err != nil {
+		return resp, err
 	}
 
-	userInfoMap := resp.(map[string]interface{})
-	if code, ok := userInfoMap["error_code"]; ok {
-		return o.GetErrorCode(code)
+	if !resp.Result && resp.Error != nil {
+		return resp, o.GetErrorCode(resp.Error)
 	}
-	return nil
+	return resp, nil
 }
 
 // PlaceContractOrders places orders
-func (o *OKEX) PlaceContractOrders(symbol, contractType, position string, leverageRate int, price, amount float64, matchPrice bool) (float64, error) {
+func (o *OKEX) PlaceContractOrders(ctx context.Context, symbol, contractType, position string, leverageRate int, price, amount float64, matchPrice bool) (float64, error) {
 	var resp interface{}
 
 	if err := o.CheckSymbol(symbol); err != nil {
@@ -607,7 +619,7 @@ func (o *OKEX) PlaceContractOrders(symbol, contractType, position string, levera
 	}
 	values.Set("lever_rate", strconv.FormatInt(int64(leverageRate), 10))
 
-	if err := o.SendAuthenticatedHTTPRequest(contractFutureTrade, values, &resp); err != nil {
+	if err := o.SendAuthenticatedHTTPRequest(ctx, contractFutureTrade, values, &resp); err != nil {
 		return 0, err
 	}
 
@@ -624,7 +636,7 @@ func (o *OKEX) PlaceContractOrders(symbol, contractType, position string, levera
 }
 
 // GetContractFuturesTradeHistory returns OKEX Contract Trade History (Not for Personal)
-func (o *OKEX) GetContractFuturesTradeHistory(symbol, date string, since int) error {
+func (o *OKEX) GetContractFuturesTradeHistory(ctx context.Context, symbol, date string, since int) error {
 	var resp interface{}
 
 	if err := o.CheckSymbol(symbol); err != nil {
@@ -636,7 +648,7 @@ func (o *OKEX) GetContractFuturesTradeHistory(symbol, date string, since int) er
 	values.Set("date", date)
 	values.Set("since", strconv.FormatInt(int64(since), 10))
 
-	if err := o.SendAuthenticatedHTTPRequest(contractFutureTradeHistory, values, &resp); err != nil {
+	if err := o.SendAuthenticatedHTTPRequest(ctx, contractFutureTradeHistory, values, &resp); err != nil {
 		return err
 	}
 
@@ -648,9 +660,9 @@ func (o *OKEX) GetContractFuturesTradeHistory(symbol, date string, since int) er
 }
 
 // GetUserInfo returns the user info
-func (o *OKEX) GetUserInfo() (SpotUserInfo, error) {
+func (o *OKEX) GetUserInfo(ctx context.Context) (SpotUserInfo, error) {
 	var resp SpotUserInfo
-	err := o.SendAuthenticatedHTTPRequest(spotUserInfo, url.Values{}, &resp)
+	err := o.SendAuthenticatedHTTPRequest(ctx, spotUserInfo, url.Values{}, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -658,7 +670,7 @@ func (o *OKEX) GetUserInfo() (SpotUserInfo, error) {
 }
 
 // SpotNewOrder creates a new spot order
-func (o *OKEX) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
+func (o *OKEX) SpotNewOrder(ctx context.Context, arg SpotNewOrderRequestParams) (int64, error) {
 	type response struct {
 		Result  bool  `json:"result"`
 		OrderID int64 `json:"order_id"`
@@ -671,7 +683,7 @@ func (o *OKEX) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 	params.Set("price", strconv.FormatFloat(arg.Price, 'f', -1, 64))
 	params.Set("amount", strconv.FormatFloat(arg.Amount, 'f', -1, 64))
 
-	err := o.SendAuthenticatedHTTPRequest(spotTrade, params, &res)
+	err := o.SendAuthenticatedHTTPRequest(ctx, spotTrade, params, &res)
 	if err != nil {
 		return res.OrderID, err
 	}
@@ -683,7 +695,7 @@ func (o *OKEX) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 // symbol such as ltc_btc
 // orderID orderID
 // returns orderID or an error
-func (o *OKEX) SpotCancelOrder(symbol string, argOrderID int64) (int64, error) {
+func (o *OKEX) SpotCancelOrder(ctx context.Context, symbol string, argOrderID int64) (int64, error) {
 	type response struct {
 		Result    bool   `json:"result"`
 		OrderID   string `json:"order_id"`
@@ -696,7 +708,7 @@ func (o *OKEX) SpotCancelOrder(symbol string, argOrderID int64) (int64, error) {
 	params.Set("symbol", symbol)
 	params.Set("order_id", strconv.FormatInt(argOrderID, 10))
 
-	err := o.SendAuthenticatedHTTPRequest(spotCancelTrade, params, &res)
+	err := o.SendAuthenticatedHTTPRequest(ctx, spotCancelTrade, params, &res)
 	var returnOrderID int64
 	if err != nil && res.ErrorCode != 0 {
 		return returnOrderID, err
@@ -712,8 +724,8 @@ func (o *OKEX) SpotCancelOrder(symbol string, argOrderID int64) (int64, error) {
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
-func (o *OKEX) GetLatestSpotPrice(symbol string) (float64, error) {
-	spotPrice, err := o.GetSpotTicker(symbol)
+func (o *OKEX) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	spotPrice, err := o.GetSpotTicker(ctx, symbol)
 
 	if err != nil {
 		return 0, err
@@ -723,14 +735,14 @@ func (o *OKEX) GetLatestSpotPrice(symbol string) (float64, error) {
 }
 
 // GetSpotTicker returns Price Ticker
-func (o *OKEX) GetSpotTicker(symbol string) (SpotPrice, error) {
+func (o *OKEX) GetSpotTicker(ctx context.Context, symbol string) (SpotPrice, error) {
 	var resp SpotPrice
 
 	values := url.Values{}
 	values.Set("symbol", symbol)
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, "ticker", values.Encode())
 
-	err := o.SendHTTPRequest(path, &resp)
+	err := o.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -741,8 +753,8 @@ func (o *OKEX) GetSpotTicker(symbol string) (SpotPrice, error) {
 	return resp, nil
 }
 
-//GetSpotMarketDepth returns Market Depth
-func (o *OKEX) GetSpotMarketDepth(asd ActualSpotDepthRequestParams) (ActualSpotDepth, error) {
+// GetSpotMarketDepth returns Market Depth
+func (o *OKEX) GetSpotMarketDepth(ctx context.Context, asd ActualSpotDepthRequestParams) (ActualSpotDepth, error) {
 	resp := SpotDepth{}
 	fullDepth := ActualSpotDepth{}
 
@@ -752,7 +764,7 @@ func (o *OKEX) GetSpotMarketDepth(asd ActualSpotDepthRequestParams) (ActualSpotD
 
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, "depth", values.Encode())
 
-	err := o.SendHTTPRequest(path, &resp)
+	err := o.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return fullDepth, err
 	}
@@ -799,7 +811,7 @@ func (o *OKEX) GetSpotMarketDepth(asd ActualSpotDepthRequestParams) (ActualSpotD
 }
 
 // GetSpotRecentTrades returns recent trades
-func (o *OKEX) GetSpotRecentTrades(ast ActualSpotTradeHistoryRequestParams) ([]ActualSpotTradeHistory, error) {
+func (o *OKEX) GetSpotRecentTrades(ctx context.Context, ast ActualSpotTradeHistoryRequestParams) ([]ActualSpotTradeHistory, error) {
 	actualTradeHistory := []ActualSpotTradeHistory{}
 	var resp interface{}
 
@@ -809,7 +821,7 @@ func (o *OKEX) GetSpotRecentTrades(ast ActualSpotTradeHistoryRequestParams) ([]A
 
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, "trades", values.Encode())
 
-	err := o.SendHTTPRequest(path, &resp)
+	err := o.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return actualTradeHistory, err
 	}
@@ -834,7 +846,7 @@ func (o *OKEX) GetSpotRecentTrades(ast ActualSpotTradeHistoryRequestParams) ([]A
 }
 
 // GetSpotKline returns candlestick data
-func (o *OKEX) GetSpotKline(arg KlinesRequestParams) ([]CandleStickData, error) {
+func (o *OKEX) GetSpotKline(ctx context.Context, arg KlinesRequestParams) ([]CandleStickData, error) {
 	var candleData []CandleStickData
 
 	values := url.Values{}
@@ -850,7 +862,7 @@ func (o *OKEX) GetSpotKline(arg KlinesRequestParams) ([]CandleStickData, error)
 	path := fmt.Sprintf("%s%s%s.do?%s", o.APIUrl, apiVersion, spotKline, values.Encode())
 	var resp interface{}
 
-	if err := o.SendHTTPRequest(path, &resp); err != nil {
+	if err := o.SendHTTPRequest(ctx, path, &resp); err != nil {
 		return candleData, err
 	}
 
@@ -909,13 +921,13 @@ func (o *OKEX) GetErrorCode(code interface{}) error {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (o *OKEX) SendHTTPRequest(path string, result interface{}) error {
-	return o.SendPayload("GET", path, nil, nil, result, false, o.Verbose)
+func (o *OKEX) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return o.SendPayload(ctx, "GET", path, nil, nil, result, false, o.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated http request to a desired
 // path
-func (o *OKEX) SendAuthenticatedHTTPRequest(method string, values url.Values, result interface{}) (err error) {
+func (o *OKEX) SendAuthenticatedHTTPRequest(ctx context.Context, method string, values url.Values, result interface{}) (err error) {
 	if !o.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, o.Name)
 	}
@@ -934,7 +946,7 @@ func (o *OKEX) SendAuthenticatedHTTPRequest(method string, values url.Values, re
 	headers := make(map[string]string)
 	headers["Content-Type"] = "application/x-www-form-urlencoded"
 
-	return o.SendPayload("POST", path, headers, strings.NewReader(encoded), result, true, o.Verbose)
+	return o.SendPayload(ctx, "POST", path, headers, strings.NewReader(encoded), result, true, o.Verbose)
 }
 
 // SetErrorDefaults sets the full error default list
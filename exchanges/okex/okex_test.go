@@ -1,12 +1,17 @@
 package okex
 
 import (
+	"context"
+	"hash/crc32"
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
 )
 
 var o OKEX
@@ -18,6 +23,12 @@ const (
 	canManipulateRealOrders = false
 )
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	o.SetDefaults()
 	if o.GetName() != "OKEX" {
@@ -42,7 +53,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetSpotInstruments(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotInstruments()
+	_, err := o.GetSpotInstruments(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - okex GetSpotInstruments() failed: %s", err)
 	}
@@ -50,15 +61,15 @@ func TestGetSpotInstruments(t *testing.T) {
 
 func TestGetContractPrice(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetContractPrice("btc_usd", "this_week")
+	_, err := o.GetContractPrice(context.Background(), "btc_usd", "this_week")
 	if err != nil {
 		t.Error("Test failed - okex GetContractPrice() error", err)
 	}
-	_, err = o.GetContractPrice("btc_bla", "123525")
+	_, err = o.GetContractPrice(context.Background(), "btc_bla", "123525")
 	if err == nil {
 		t.Error("Test failed - okex GetContractPrice() error", err)
 	}
-	_, err = o.GetContractPrice("btc_bla", "this_week")
+	_, err = o.GetContractPrice(context.Background(), "btc_bla", "this_week")
 	if err == nil {
 		t.Error("Test failed - okex GetContractPrice() error", err)
 	}
@@ -66,15 +77,15 @@ func TestGetContractPrice(t *testing.T) {
 
 func TestGetContractMarketDepth(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetContractMarketDepth("btc_usd", "this_week")
+	_, err := o.GetContractMarketDepth(context.Background(), "btc_usd", "this_week")
 	if err != nil {
 		t.Error("Test failed - okex GetContractMarketDepth() error", err)
 	}
-	_, err = o.GetContractMarketDepth("btc_bla", "123525")
+	_, err = o.GetContractMarketDepth(context.Background(), "btc_bla", "123525")
 	if err == nil {
 		t.Error("Test failed - okex GetContractMarketDepth() error", err)
 	}
-	_, err = o.GetContractMarketDepth("btc_bla", "this_week")
+	_, err = o.GetContractMarketDepth(context.Background(), "btc_bla", "this_week")
 	if err == nil {
 		t.Error("Test failed - okex GetContractMarketDepth() error", err)
 	}
@@ -82,15 +93,15 @@ func TestGetContractMarketDepth(t *testing.T) {
 
 func TestGetContractTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetContractTradeHistory("btc_usd", "this_week")
+	_, err := o.GetContractTradeHistory(context.Background(), "btc_usd", "this_week")
 	if err != nil {
 		t.Error("Test failed - okex GetContractTradeHistory() error", err)
 	}
-	_, err = o.GetContractTradeHistory("btc_bla", "123525")
+	_, err = o.GetContractTradeHistory(context.Background(), "btc_bla", "123525")
 	if err == nil {
 		t.Error("Test failed - okex GetContractTradeHistory() error", err)
 	}
-	_, err = o.GetContractTradeHistory("btc_bla", "this_week")
+	_, err = o.GetContractTradeHistory(context.Background(), "btc_bla", "this_week")
 	if err == nil {
 		t.Error("Test failed - okex GetContractTradeHistory() error", err)
 	}
@@ -98,11 +109,11 @@ func TestGetContractTradeHistory(t *testing.T) {
 
 func TestGetContractIndexPrice(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetContractIndexPrice("btc_usd")
+	_, err := o.GetContractIndexPrice(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("Test failed - okex GetContractIndexPrice() error", err)
 	}
-	_, err = o.GetContractIndexPrice("lol123")
+	_, err = o.GetContractIndexPrice(context.Background(), "lol123")
 	if err == nil {
 		t.Error("Test failed - okex GetContractTradeHistory() error", err)
 	}
@@ -110,7 +121,7 @@ func TestGetContractIndexPrice(t *testing.T) {
 
 func TestGetContractExchangeRate(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetContractExchangeRate()
+	_, err := o.GetContractExchangeRate(context.Background())
 	if err != nil {
 		t.Error("Test failed - okex GetContractExchangeRate() error", err)
 	}
@@ -118,19 +129,19 @@ func TestGetContractExchangeRate(t *testing.T) {
 
 func TestGetContractCandlestickData(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetContractCandlestickData("btc_usd", "1min", "this_week", 1, 2)
+	_, err := o.GetContractCandlestickData(context.Background(), "btc_usd", "1min", "this_week", 1, 2)
 	if err != nil {
 		t.Error("Test failed - okex GetContractCandlestickData() error", err)
 	}
-	_, err = o.GetContractCandlestickData("btc_bla", "1min", "this_week", 1, 2)
+	_, err = o.GetContractCandlestickData(context.Background(), "btc_bla", "1min", "this_week", 1, 2)
 	if err == nil {
 		t.Error("Test failed - okex GetContractCandlestickData() error", err)
 	}
-	_, err = o.GetContractCandlestickData("btc_usd", "min", "this_week", 1, 2)
+	_, err = o.GetContractCandlestickData(context.Background(), "btc_usd", "min", "this_week", 1, 2)
 	if err == nil {
 		t.Error("Test failed - okex GetContractCandlestickData() error", err)
 	}
-	_, err = o.GetContractCandlestickData("btc_usd", "1min", "this_wok", 1, 2)
+	_, err = o.GetContractCandlestickData(context.Background(), "btc_usd", "1min", "this_wok", 1, 2)
 	if err == nil {
 		t.Error("Test failed - okex GetContractCandlestickData() error", err)
 	}
@@ -138,15 +149,15 @@ func TestGetContractCandlestickData(t *testing.T) {
 
 func TestGetContractHoldingsNumber(t *testing.T) {
 	t.Parallel()
-	_, _, err := o.GetContractHoldingsNumber("btc_usd", "this_week")
+	_, _, err := o.GetContractHoldingsNumber(context.Background(), "btc_usd", "this_week")
 	if err != nil {
 		t.Error("Test failed - okex GetContractHoldingsNumber() error", err)
 	}
-	_, _, err = o.GetContractHoldingsNumber("btc_bla", "this_week")
+	_, _, err = o.GetContractHoldingsNumber(context.Background(), "btc_bla", "this_week")
 	if err == nil {
 		t.Error("Test failed - okex GetContractHoldingsNumber() error", err)
 	}
-	_, _, err = o.GetContractHoldingsNumber("btc_usd", "this_bla")
+	_, _, err = o.GetContractHoldingsNumber(context.Background(), "btc_usd", "this_bla")
 	if err == nil {
 		t.Error("Test failed - okex GetContractHoldingsNumber() error", err)
 	}
@@ -154,15 +165,15 @@ func TestGetContractHoldingsNumber(t *testing.T) {
 
 func TestGetContractlimit(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetContractlimit("btc_usd", "this_week")
+	_, err := o.GetContractlimit(context.Background(), "btc_usd", "this_week")
 	if err != nil {
 		t.Error("Test failed - okex GetContractlimit() error", err)
 	}
-	_, err = o.GetContractlimit("btc_bla", "this_week")
+	_, err = o.GetContractlimit(context.Background(), "btc_bla", "this_week")
 	if err == nil {
 		t.Error("Test failed - okex GetContractlimit() error", err)
 	}
-	_, err = o.GetContractlimit("btc_usd", "this_bla")
+	_, err = o.GetContractlimit(context.Background(), "btc_usd", "this_bla")
 	if err == nil {
 		t.Error("Test failed - okex GetContractlimit() error", err)
 	}
@@ -170,7 +181,7 @@ func TestGetContractlimit(t *testing.T) {
 
 func TestGetContractUserInfo(t *testing.T) {
 	t.Parallel()
-	err := o.GetContractUserInfo()
+	err := o.GetContractUserInfo(context.Background())
 	if err == nil {
 		t.Error("Test failed - okex GetContractUserInfo() error", err)
 	}
@@ -178,7 +189,7 @@ func TestGetContractUserInfo(t *testing.T) {
 
 func TestGetContractPosition(t *testing.T) {
 	t.Parallel()
-	err := o.GetContractPosition("btc_usd", "this_week")
+	_, err := o.GetContractPosition(context.Background(), "btc_usd", "this_week")
 	if err == nil {
 		t.Error("Test failed - okex GetContractPosition() error", err)
 	}
@@ -186,7 +197,7 @@ func TestGetContractPosition(t *testing.T) {
 
 func TestPlaceContractOrders(t *testing.T) {
 	t.Parallel()
-	_, err := o.PlaceContractOrders("btc_usd", "this_week", "1", 10, 1, 1, true)
+	_, err := o.PlaceContractOrders(context.Background(), "btc_usd", "this_week", "1", 10, 1, 1, true)
 	if err == nil {
 		t.Error("Test failed - okex PlaceContractOrders() error", err)
 	}
@@ -194,7 +205,7 @@ func TestPlaceContractOrders(t *testing.T) {
 
 func TestGetContractFuturesTradeHistory(t *testing.T) {
 	t.Parallel()
-	err := o.GetContractFuturesTradeHistory("btc_usd", "1972-01-01", 0)
+	err := o.GetContractFuturesTradeHistory(context.Background(), "btc_usd", "1972-01-01", 0)
 	if err == nil {
 		t.Error("Test failed - okex GetContractTradeHistory() error", err)
 	}
@@ -202,7 +213,7 @@ func TestGetContractFuturesTradeHistory(t *testing.T) {
 
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetLatestSpotPrice("ltc_btc")
+	_, err := o.GetLatestSpotPrice(context.Background(), "ltc_btc")
 	if err != nil {
 		t.Error("Test failed - okex GetLatestSpotPrice() error", err)
 	}
@@ -210,7 +221,7 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetSpotTicker(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotTicker("ltc_btc")
+	_, err := o.GetSpotTicker(context.Background(), "ltc_btc")
 	if err != nil {
 		t.Error("Test failed - okex GetSpotTicker() error", err)
 	}
@@ -218,7 +229,7 @@ func TestGetSpotTicker(t *testing.T) {
 
 func TestGetSpotMarketDepth(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotMarketDepth(ActualSpotDepthRequestParams{
+	_, err := o.GetSpotMarketDepth(context.Background(), ActualSpotDepthRequestParams{
 		Symbol: "eth_btc",
 		Size:   2,
 	})
@@ -229,7 +240,7 @@ func TestGetSpotMarketDepth(t *testing.T) {
 
 func TestGetSpotRecentTrades(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotRecentTrades(ActualSpotTradeHistoryRequestParams{
+	_, err := o.GetSpotRecentTrades(context.Background(), ActualSpotTradeHistoryRequestParams{
 		Symbol: "ltc_btc",
 		Since:  0,
 	})
@@ -245,7 +256,7 @@ func TestGetSpotKline(t *testing.T) {
 		Type:   TimeIntervalFiveMinutes,
 		Size:   100,
 	}
-	_, err := o.GetSpotKline(arg)
+	_, err := o.GetSpotKline(context.Background(), arg)
 	if err != nil {
 		t.Error("Test failed - okex GetSpotCandleStick() error", err)
 	}
@@ -258,7 +269,7 @@ func TestSpotNewOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := o.SpotNewOrder(SpotNewOrderRequestParams{
+	_, err := o.SpotNewOrder(context.Background(), SpotNewOrderRequestParams{
 		Symbol: "ltc_btc",
 		Amount: 1.1,
 		Price:  10.1,
@@ -276,7 +287,7 @@ func TestSpotCancelOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := o.SpotCancelOrder("ltc_btc", 519158961)
+	_, err := o.SpotCancelOrder(context.Background(), "ltc_btc", 519158961)
 	if err != nil {
 		t.Error("Test failed - okex SpotCancelOrder() error", err)
 	}
@@ -289,7 +300,7 @@ func TestGetUserInfo(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := o.GetUserInfo()
+	_, err := o.GetUserInfo(context.Background())
 	if err != nil {
 		t.Error("Test failed - okex GetUserInfo() error", err)
 	}
@@ -422,7 +433,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.EUR,
 	}
-	response, err := o.SubmitOrder(p, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := o.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: p, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -448,10 +459,92 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := o.CancelOrder(orderCancellation)
+	err := o.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
 		t.Errorf("Could not cancel order: %s", err)
 	}
 }
+
+func TestGetMarketInfo(t *testing.T) {
+	markets := getMarketInfo([]SpotInstrument{
+		{BaseCurrency: "BTC", QuoteCurrency: "USDT", BaseMinSize: 0.001,
+			BaseIncrement: 0.00000001, QuoteIncrement: 0.1},
+		{BaseCurrency: "LTC", QuoteCurrency: "BTC", MinSize: 0.1,
+			SizeIncrement: 0.000001, TickSize: 0.00001},
+	})
+
+	if len(markets) != 2 || markets[0].MinAmount != 0.001 ||
+		markets[0].AmountStep != 0.00000001 || markets[0].PriceStep != 0.1 {
+		t.Errorf("Test failed. Unexpected market info %+v", markets)
+	}
+
+	if markets[1].Pair.Pair() != "LTCBTC" || markets[1].MinAmount != 0.1 ||
+		markets[1].AmountStep != 0.000001 || markets[1].PriceStep != 0.00001 {
+		t.Errorf("Test failed. Expected fallback trading rules, received %+v", markets)
+	}
+}
+
+func TestGetContractPositions(t *testing.T) {
+	positions := getContractPositions("OKEX", &ContractPosition{
+		ForceLiquidationPrice: "5,200.5",
+		Holding: []ContractHolding{{BuyAmount: 2, BuyPriceAvg: 6500,
+			SellAmount: 1, SellPriceAvg: 6600, ContractType: "this_week",
+			LeverRate: 10, Symbol: "btc_usd"}},
+	})
+
+	if len(positions) != 2 || positions[0].Side != exchange.Long ||
+		positions[0].Amount != 2 || positions[1].Side != exchange.Short ||
+		positions[1].EntryPrice != 6600 {
+		t.Fatalf("Test failed. Unexpected positions %+v", positions)
+	}
+
+	if positions[0].Pair.Pair() != "BTC_USD" || positions[0].AssetType != "this_week" ||
+		positions[0].LiquidationPrice != 5200.5 || positions[0].Leverage != 10 {
+		t.Errorf("Test failed. Unexpected position %+v", positions[0])
+	}
+
+	if !positions[0].Inverse || positions[0].ContractValue != 100 ||
+		getContractValue("ltc_usd") != 10 {
+		t.Errorf("Test failed. Expected inverse contract values, received %+v",
+			positions[0])
+	}
+}
+
+func TestWsProcessDepth(t *testing.T) {
+	var r OKEX
+	r.SetDefaults()
+	r.Websocket.DataHandler = make(chan interface{}, 10)
+	r.Websocket.Orderbook.SetChecksum(wsChecksum)
+	r.wsDepthLoaded = make(map[string]bool)
+
+	p := pair.NewCurrencyPairFromString("ltc_btc")
+	channel := "ok_sub_spot_ltc_btc_depth"
+	err := r.wsProcessDepth(channel, p, DepthStreamData{
+		Bids:     [][]string{{"0.0085", "2"}},
+		Asks:     [][]string{{"0.0086", "1"}},
+		Checksum: int32(crc32.ChecksumIEEE([]byte("0.0085:2:0.0086:1"))),
+	})
+	if err != nil || r.Websocket.Orderbook.IsResyncing(p, ticker.Spot) {
+		t.Fatal("Test failed. Expected full depth to load", err)
+	}
+
+	err = r.wsProcessDepth(channel, p, DepthStreamData{
+		Asks: [][]string{{"0.0086", "0"}, {"0.0087", "3"}},
+	})
+	if err != nil {
+		t.Fatal("Test failed. wsProcessDepth error", err)
+	}
+
+	ob, err := orderbook.GetOrderbook(r.GetName(), p, ticker.Spot)
+	if err != nil || len(ob.Asks) != 1 || ob.Asks[0].Price != 0.0087 {
+		t.Errorf("Test failed. Expected incremental depth to apply, received %+v %v",
+			ob.Asks, err)
+	}
+
+	err = r.wsProcessDepth(channel, p, DepthStreamData{Checksum: 1337})
+	if err != nil || !r.Websocket.Orderbook.IsResyncing(p, ticker.Spot) {
+		t.Error("Test failed. Expected checksum mismatch to resync the book", err)
+	}
+}
@@ -34,6 +34,33 @@ type ContractPrice struct {
 	Error  interface{} `json:"error_code"`
 }
 
+// ContractPosition holds the positions of a contract in cross margin mode
+type ContractPosition struct {
+	ForceLiquidationPrice string            `json:"force_liqu_price"`
+	Holding               []ContractHolding `json:"holding"`
+	Result                bool              `json:"result"`
+	Error                 interface{}       `json:"error_code"`
+}
+
+// ContractHolding holds the long and short positions of a contract
+type ContractHolding struct {
+	BuyAmount      float64 `json:"buy_amount"`
+	BuyAvailable   float64 `json:"buy_available"`
+	BuyPriceAvg    float64 `json:"buy_price_avg"`
+	BuyPriceCost   float64 `json:"buy_price_cost"`
+	BuyProfitReal  float64 `json:"buy_profit_real"`
+	ContractID     int64   `json:"contract_id"`
+	ContractType   string  `json:"contract_type"`
+	CreateDate     int64   `json:"create_date"`
+	LeverRate      float64 `json:"lever_rate"`
+	SellAmount     float64 `json:"sell_amount"`
+	SellAvailable  float64 `json:"sell_available"`
+	SellPriceAvg   float64 `json:"sell_price_avg"`
+	SellPriceCost  float64 `json:"sell_price_cost"`
+	SellProfitReal float64 `json:"sell_profit_real"`
+	Symbol         string  `json:"symbol"`
+}
+
 // MultiStreamData contains raw data from okex
 type MultiStreamData struct {
 	Channel string          `json:"channel"`
@@ -65,6 +92,7 @@ type DepthStreamData struct {
 	Asks      [][]string `json:"asks"`
 	Bids      [][]string `json:"bids"`
 	Timestamp float64    `json:"timestamp"`
+	Checksum  int32      `json:"checksum"`
 }
 
 // ContractDepth response depth
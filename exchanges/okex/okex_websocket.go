@@ -5,6 +5,7 @@ import (
 	"compress/flate"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -17,10 +18,13 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
 )
 
 const (
 	okexDefaultWebsocketURL = "wss://real.okex.com:10440/websocket/okexapi"
+	okexChecksumDepth       = 25
 )
 
 func (o *OKEX) writeToWebsocket(message string) error {
@@ -56,6 +60,9 @@ func (o *OKEX) WsConnect() error {
 			err)
 	}
 
+	o.wsDepthLoaded = make(map[string]bool)
+	o.Websocket.Orderbook.SetChecksum(wsChecksum)
+
 	go o.WsHandleData()
 	go o.WsReadData()
 	go o.wsPingHandler()
@@ -236,10 +243,24 @@ func (o *OKEX) WsHandleData() {
 						log.Fatal("OKEX Ticker Decode Error:", err)
 					}
 
+					last, _ := strconv.ParseFloat(ticker.Last, 64)
+					high, _ := strconv.ParseFloat(ticker.High, 64)
+					low, _ := strconv.ParseFloat(ticker.Low, 64)
+					bid, _ := strconv.ParseFloat(ticker.Buy, 64)
+					ask, _ := strconv.ParseFloat(ticker.Sell, 64)
+					volume, _ := strconv.ParseFloat(ticker.Vol, 64)
+
 					o.Websocket.DataHandler <- exchange.TickerData{
-						Timestamp: time.Unix(0, int64(ticker.Timestamp)),
-						Exchange:  o.GetName(),
-						AssetType: assetType,
+						Timestamp:  time.Unix(0, int64(ticker.Timestamp)*int64(time.Millisecond)),
+						Exchange:   o.GetName(),
+						AssetType:  assetType,
+						Pair:       pair.NewCurrencyPairFromString(newPair),
+						ClosePrice: last,
+						HighPrice:  high,
+						LowPrice:   low,
+						BidPrice:   bid,
+						AskPrice:   ask,
+						Quantity:   volume,
 					}
 
 				} else if strings.Contains(multiStreamData.Channel, "deals") {
@@ -303,10 +324,12 @@ func (o *OKEX) WsHandleData() {
 						log.Fatal("OKEX Depth Decode Error:", err)
 					}
 
-					o.Websocket.DataHandler <- exchange.WebsocketOrderbookUpdate{
-						Exchange: o.GetName(),
-						Asset:    assetType,
-						Pair:     pair.NewCurrencyPairFromString(newPair),
+					err = o.wsProcessDepth(multiStreamData.Channel,
+						pair.NewCurrencyPairFromString(newPair),
+						depth)
+					if err != nil {
+						o.Websocket.DataHandler <- fmt.Errorf("okex_websocket.go processing depth error: %s",
+							err)
 					}
 				}
 			}
@@ -314,6 +337,106 @@ func (o *OKEX) WsHandleData() {
 	}
 }
 
+// wsProcessDepth loads the initial full depth of a channel into the local
+// orderbook cache and applies the incremental depth sent after it, a zero
+// amount removes the level. Books are verified against the depth checksum
+func (o *OKEX) wsProcessDepth(channel string, p pair.CurrencyPair, depth DepthStreamData) error {
+	bids, err := wsDepthLevels(depth.Bids)
+	if err != nil {
+		return err
+	}
+
+	asks, err := wsDepthLevels(depth.Asks)
+	if err != nil {
+		return err
+	}
+
+	updated := time.Unix(0, int64(depth.Timestamp)*int64(time.Millisecond))
+	if !o.wsDepthLoaded[channel] {
+		err = o.Websocket.Orderbook.LoadSnapshot(orderbook.Base{
+			Pair:         p,
+			CurrencyPair: p.Pair().String(),
+			Bids:         bids,
+			Asks:         asks,
+			AssetType:    ticker.Spot,
+			LastUpdated:  updated,
+		}, o.GetName())
+		if err != nil {
+			return err
+		}
+		o.wsDepthLoaded[channel] = true
+	} else if len(bids) > 0 || len(asks) > 0 {
+		err = o.Websocket.Orderbook.Update(bids,
+			asks,
+			p,
+			updated,
+			o.GetName(),
+			ticker.Spot)
+		if err != nil {
+			return err
+		}
+	}
+
+	if depth.Checksum != 0 {
+		// OKEX sends the checksum as a signed integer
+		err = o.Websocket.Orderbook.VerifyChecksum(p,
+			ticker.Spot,
+			o.GetName(),
+			uint32(depth.Checksum))
+		if err != nil {
+			return err
+		}
+	}
+
+	o.Websocket.DataHandler <- exchange.WebsocketOrderbookUpdate{
+		Exchange: o.GetName(),
+		Asset:    ticker.Spot,
+		Pair:     p,
+	}
+	return nil
+}
+
+// wsDepthLevels converts price and amount string pairs to orderbook levels
+func wsDepthLevels(levels [][]string) ([]orderbook.Item, error) {
+	var items []orderbook.Item
+	for i := range levels {
+		if len(levels[i]) < 2 {
+			return nil, errors.New("okex_websocket.go error - malformed depth level")
+		}
+
+		price, err := strconv.ParseFloat(levels[i][0], 64)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := strconv.ParseFloat(levels[i][1], 64)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, orderbook.Item{Price: price, Amount: amount})
+	}
+	return items, nil
+}
+
+// wsChecksum returns the checksum OKEX publishes for a book, the CRC32 of the
+// top 25 bids and asks interleaved as price:amount
+func wsChecksum(ob orderbook.Base) uint32 {
+	var levels []string
+	for i := 0; i < okexChecksumDepth; i++ {
+		if i < len(ob.Bids) {
+			levels = append(levels,
+				strconv.FormatFloat(ob.Bids[i].Price, 'f', -1, 64),
+				strconv.FormatFloat(ob.Bids[i].Amount, 'f', -1, 64))
+		}
+		if i < len(ob.Asks) {
+			levels = append(levels,
+				strconv.FormatFloat(ob.Asks[i].Price, 'f', -1, 64),
+				strconv.FormatFloat(ob.Asks[i].Amount, 'f', -1, 64))
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(common.JoinStrings(levels, ":")))
+}
+
 // ErrorResponse defines an error response type from the websocket connection
 type ErrorResponse struct {
 	Result    bool   `json:"result"`
@@ -1,11 +1,13 @@
 package okex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -31,7 +33,7 @@ func (o *OKEX) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", o.GetName(), len(o.EnabledPairs), o.EnabledPairs)
 	}
 
-	prods, err := o.GetSpotInstruments()
+	prods, err := o.GetSpotInstruments(context.Background())
 	if err != nil {
 		log.Printf("OKEX failed to obtain available spot instruments. Err: %d", err)
 		return
@@ -41,6 +43,7 @@ func (o *OKEX) Run() {
 	for x := range prods {
 		pairs = append(pairs, prods[x].BaseCurrency+"_"+prods[x].QuoteCurrency)
 	}
+	o.SetMarketInfo(getMarketInfo(prods))
 
 	err = o.UpdateCurrencies(pairs, false, false)
 	if err != nil {
@@ -48,13 +51,95 @@ func (o *OKEX) Run() {
 	}
 }
 
+// getMarketInfo returns the trading rules of the spot instruments, the
+// minimum size, size increment and tick size are used when the base fields
+// are not returned
+func getMarketInfo(prods []SpotInstrument) []exchange.MarketInfo {
+	var markets []exchange.MarketInfo
+	for x := range prods {
+		market := exchange.MarketInfo{
+			Pair:      pair.NewCurrencyPair(prods[x].BaseCurrency, prods[x].QuoteCurrency),
+			AssetType: ticker.Spot,
+			OrderLimits: exchange.OrderLimits{
+				MinAmount:  prods[x].BaseMinSize,
+				AmountStep: prods[x].BaseIncrement,
+				PriceStep:  prods[x].QuoteIncrement,
+			},
+		}
+
+		if market.MinAmount == 0 {
+			market.MinAmount = prods[x].MinSize
+		}
+		if market.AmountStep == 0 {
+			market.AmountStep = prods[x].SizeIncrement
+		}
+		if market.PriceStep == 0 {
+			market.PriceStep = prods[x].TickSize
+		}
+		markets = append(markets, market)
+	}
+	return markets
+}
+
+// getContractValue returns the USD value of one futures contract of a symbol
+func getContractValue(symbol string) float64 {
+	if common.StringToLower(symbol) == "btc_usd" {
+		return okexBTCContractValue
+	}
+	return okexContractValue
+}
+
+// getContractPositions returns the long and short positions of the contract
+// holdings normalised, the contract type is used as the asset type. Futures
+// are inverse contracts so unrealised profit and loss is valued in the base
+// currency
+func getContractPositions(exchName string, position *ContractPosition) []exchange.Position {
+	liquidationPrice, _ := strconv.ParseFloat(
+		common.ReplaceString(position.ForceLiquidationPrice, ",", "", -1), 64)
+
+	var positions []exchange.Position
+	for x := range position.Holding {
+		holding := &position.Holding[x]
+		base := exchange.Position{
+			Exchange:         exchName,
+			Pair:             pair.NewCurrencyPairDelimiter(common.StringToUpper(holding.Symbol), "_"),
+			AssetType:        holding.ContractType,
+			Leverage:         holding.LeverRate,
+			MarginMode:       exchange.CrossMargin,
+			LiquidationPrice: liquidationPrice,
+			ContractValue:    getContractValue(holding.Symbol),
+			Inverse:          true,
+			LastUpdated:      time.Now(),
+		}
+
+		if holding.BuyAmount > 0 {
+			long := base
+			long.Side = exchange.Long
+			long.Amount = holding.BuyAmount
+			long.EntryPrice = holding.BuyPriceAvg
+			long.RealisedPNL = holding.BuyProfitReal
+			positions = append(positions, long)
+		}
+
+		if holding.SellAmount > 0 {
+			short := base
+			short.Side = exchange.Short
+			short.Amount = holding.SellAmount
+			short.EntryPrice = holding.SellPriceAvg
+			short.RealisedPNL = holding.SellProfitReal
+			positions = append(positions, short)
+		}
+	}
+	return positions
+}
+
 // UpdateTicker updates and returns the ticker for a currency pair
-func (o *OKEX) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (o *OKEX) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	currency := exchange.FormatExchangeCurrency(o.Name, p).String()
 	var tickerPrice ticker.Price
 
 	if assetType != ticker.Spot {
-		tick, err := o.GetContractPrice(currency, assetType)
+		tick, err := o.GetContractPrice(ctx, currency, assetType)
 		if err != nil {
 			return tickerPrice, err
 		}
@@ -68,7 +153,7 @@ func (o *OKEX) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price
 		tickerPrice.High = tick.Ticker.High
 		ticker.ProcessTicker(o.GetName(), p, tickerPrice, assetType)
 	} else {
-		tick, err := o.GetSpotTicker(currency)
+		tick, err := o.GetSpotTicker(ctx, currency)
 		if err != nil {
 			return tickerPrice, err
 		}
@@ -86,30 +171,30 @@ func (o *OKEX) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (o *OKEX) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (o *OKEX) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(o.GetName(), p, assetType)
 	if err != nil {
-		return o.UpdateTicker(p, assetType)
+		return o.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (o *OKEX) GetOrderbookEx(currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (o *OKEX) GetOrderbookEx(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(o.GetName(), currency, assetType)
 	if err != nil {
-		return o.UpdateOrderbook(currency, assetType)
+		return o.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (o *OKEX) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (o *OKEX) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	currency := exchange.FormatExchangeCurrency(o.Name, p).String()
 
 	if assetType != ticker.Spot {
-		orderbookNew, err := o.GetContractMarketDepth(currency, assetType)
+		orderbookNew, err := o.GetContractMarketDepth(ctx, currency, assetType)
 		if err != nil {
 			return orderBook, err
 		}
@@ -125,7 +210,7 @@ func (o *OKEX) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook
 		}
 
 	} else {
-		orderbookNew, err := o.GetSpotMarketDepth(ActualSpotDepthRequestParams{
+		orderbookNew, err := o.GetSpotMarketDepth(ctx, ActualSpotDepthRequestParams{
 			Symbol: currency,
 			Size:   200,
 		})
@@ -150,38 +235,42 @@ func (o *OKEX) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // OKEX exchange
-func (o *OKEX) GetAccountInfo() (exchange.AccountInfo, error) {
+func (o *OKEX) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	return response, errors.New("not implemented")
 }
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (o *OKEX) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (o *OKEX) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (o *OKEX) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (o *OKEX) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (o *OKEX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (o *OKEX) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := o.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
 	var oT SpotNewOrderRequestType
 
-	if orderType == exchange.Limit {
-		if side == exchange.Buy {
+	if order.OrderType == exchange.Limit {
+		if order.Side == exchange.Buy {
 			oT = SpotNewOrderRequestTypeBuy
 		} else {
 			oT = SpotNewOrderRequestTypeSell
 		}
-	} else if orderType == exchange.Market {
-		if side == exchange.Buy {
+	} else if order.OrderType == exchange.Market {
+		if order.Side == exchange.Buy {
 			oT = SpotNewOrderRequestTypeBuyMarket
 		} else {
 			oT = SpotNewOrderRequestTypeSellMarket
@@ -191,13 +280,13 @@ func (o *OKEX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderTy
 	}
 
 	var params = SpotNewOrderRequestParams{
-		Amount: amount,
-		Price:  price,
-		Symbol: p.Pair().String(),
+		Amount: order.Amount,
+		Price:  order.Price,
+		Symbol: order.Pair.Pair().String(),
 		Type:   oT,
 	}
 
-	response, err := o.SpotNewOrder(params)
+	response, err := o.SpotNewOrder(ctx, params)
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -212,48 +301,119 @@ func (o *OKEX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderTy
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (o *OKEX) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (o *OKEX) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (o *OKEX) CancelOrder(order exchange.OrderCancellation) error {
+func (o *OKEX) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = o.SpotCancelOrder(exchange.FormatExchangeCurrency(o.Name, order.CurrencyPair).String(), orderIDInt)
+	_, err = o.SpotCancelOrder(ctx, exchange.FormatExchangeCurrency(o.Name, order.CurrencyPair).String(), orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (o *OKEX) CancelAllOrders() error {
+func (o *OKEX) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (o *OKEX) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (o *OKEX) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (o *OKEX) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (o *OKEX) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account for the
+// enabled futures pairs, across the contract types configured as asset types
+func (o *OKEX) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	var positions []exchange.Position
+	enabledPairs := o.GetEnabledCurrencies()
+	for x := range o.AssetTypes {
+		if o.CheckContractType(o.AssetTypes[x]) != nil {
+			continue
+		}
+
+		for y := range enabledPairs {
+			symbol := exchange.FormatExchangeCurrency(o.Name, enabledPairs[y]).String()
+			if o.CheckSymbol(symbol) != nil {
+				continue
+			}
+
+			resp, err := o.GetContractPosition(ctx, symbol, o.AssetTypes[x])
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, getContractPositions(o.Name, &resp)...)
+		}
+	}
+	return positions, nil
+}
+
+// SetLeverage is not supported as OKEX futures leverage is set when each
+// contract order is placed
+func (o *OKEX) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin,
+// only cross margin is supported
+func (o *OKEX) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	if mode != exchange.CrossMargin {
+		return fmt.Errorf("margin mode %s is not supported", mode)
+	}
+	return nil
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (o *OKEX) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+
+	// 3 liquidates a long position and 4 liquidates a short position
+	positionType := "3"
+	if position.Side == exchange.Short {
+		positionType = "4"
+	}
+
+	orderID, err := o.PlaceContractOrders(ctx,
+		exchange.FormatExchangeCurrency(o.Name, position.Pair).String(),
+		position.AssetType, positionType, int(position.Leverage), 0,
+		position.Amount, true)
+	if err != nil {
+		return submitOrderResponse, err
+	}
+
+	submitOrderResponse.OrderID = strconv.FormatFloat(orderID, 'f', -1, 64)
+	submitOrderResponse.IsOrderPlaced = true
+	return submitOrderResponse, nil
+}
+
+// GetFundingRate is not supported as OKEX futures are dated contracts without
+// funding
+func (o *OKEX) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrFunctionNotSupported
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (o *OKEX) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (o *OKEX) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (o *OKEX) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (o *OKEX) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -277,3 +437,82 @@ func (o *OKEX) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (o *OKEX) GetWithdrawCapabilities() uint32 {
 	return o.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (o *OKEX) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (o *OKEX) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// okexCandleIntervals maps the supported candle intervals to OKEX kline types
+var okexCandleIntervals = map[exchange.CandleInterval]string{
+	exchange.OneMin:     string(TimeIntervalMinute),
+	exchange.ThreeMin:   string(TimeIntervalThreeMinutes),
+	exchange.FiveMin:    string(TimeIntervalFiveMinutes),
+	exchange.FifteenMin: string(TimeIntervalFifteenMinutes),
+	exchange.ThirtyMin:  string(TimeIntervalThirtyMinutes),
+	exchange.OneHour:    string(TimeIntervalHour),
+	exchange.TwoHour:    "2hour",
+	exchange.FourHour:   string(TimeIntervalFourHours),
+	exchange.SixHour:    string(TimeIntervalSixHours),
+	exchange.TwelveHour: string(TimeIntervalTwelveHours),
+	exchange.OneDay:     string(TimeIntervalDay),
+	exchange.ThreeDay:   string(TimeIntervalThreeDays),
+	exchange.OneWeek:    string(TimeIntervalWeek),
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times, a non spot asset type is used as the futures contract type
+func (o *OKEX) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	okexInterval, err := exchange.ValidateCandleRequest(okexCandleIntervals,
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := exchange.FormatExchangeCurrency(o.Name, p).String()
+	return exchange.GetCandlesPaginated(interval, start, end, okexMaxKlineLimit,
+		func(windowStart, windowEnd time.Time) ([]exchange.Candle, error) {
+			since := windowStart.UnixNano() / int64(time.Millisecond)
+
+			var resp []CandleStickData
+			var err error
+			if assetType != ticker.Spot {
+				resp, err = o.GetContractCandlestickData(ctx, symbol,
+					okexInterval,
+					assetType,
+					okexMaxKlineLimit,
+					int(since))
+			} else {
+				resp, err = o.GetSpotKline(ctx, KlinesRequestParams{
+					Symbol: symbol,
+					Type:   TimeInterval(okexInterval),
+					Size:   okexMaxKlineLimit,
+					Since:  since,
+				})
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			var candles []exchange.Candle
+			for i := range resp {
+				candles = append(candles, exchange.Candle{
+					Time:   time.Unix(0, int64(resp[i].Timestamp)*int64(time.Millisecond)),
+					Open:   resp[i].Open,
+					High:   resp[i].High,
+					Low:    resp[i].Low,
+					Close:  resp[i].Close,
+					Volume: resp[i].Volume,
+				})
+			}
+			return candles, nil
+		})
+}
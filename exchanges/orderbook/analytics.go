@@ -0,0 +1,200 @@
+package orderbook
+
+import (
+	"errors"
+)
+
+// ErrInvalidAmount is returned when an analytic is requested for an amount or
+// percentage which is not greater than zero
+const ErrInvalidAmount = "Amount must be greater than zero."
+
+// FillResult is the result of simulating an order which walks the book
+type FillResult struct {
+	// Amount is the base currency amount filled
+	Amount float64
+	// Cost is the quote currency amount spent or received
+	Cost float64
+	// AveragePrice is the volume weighted price of the fill
+	AveragePrice float64
+	// BestPrice is the price of the first level filled
+	BestPrice float64
+	// WorstPrice is the price of the last level filled
+	WorstPrice float64
+	// SlippagePercent is how much worse the average price is than the best
+	// price as a percentage of the best price
+	SlippagePercent float64
+	// FullyFilled is false when the book does not hold enough liquidity to
+	// fill the requested amount
+	FullyFilled bool
+}
+
+// SimulateBuy returns the fill of a market buy for an amount of the base
+// currency against the asks
+func (o *Base) SimulateBuy(amount float64) (FillResult, error) {
+	return simulateFill(o.Asks, amount, false, true)
+}
+
+// SimulateSell returns the fill of a market sell for an amount of the base
+// currency against the bids
+func (o *Base) SimulateSell(amount float64) (FillResult, error) {
+	return simulateFill(o.Bids, amount, false, false)
+}
+
+// SimulateBuyQuote returns the fill of a market buy spending an amount of the
+// quote currency against the asks
+func (o *Base) SimulateBuyQuote(amount float64) (FillResult, error) {
+	return simulateFill(o.Asks, amount, true, true)
+}
+
+// SimulateSellQuote returns the fill of a market sell receiving an amount of
+// the quote currency against the bids
+func (o *Base) SimulateSellQuote(amount float64) (FillResult, error) {
+	return simulateFill(o.Bids, amount, true, false)
+}
+
+// simulateFill walks sorted levels until the amount, in the base or quote
+// currency, is filled
+func simulateFill(levels []Item, amount float64, isQuote, isBuy bool) (FillResult, error) {
+	var result FillResult
+	if amount <= 0 {
+		return result, errors.New(ErrInvalidAmount)
+	}
+
+	if len(levels) == 0 {
+		return result, errors.New(ErrOrderbookEmpty)
+	}
+
+	remaining := amount
+	for i := range levels {
+		if remaining <= 0 {
+			break
+		}
+
+		if levels[i].Amount <= 0 || levels[i].Price <= 0 {
+			continue
+		}
+
+		filled := levels[i].Amount
+		if isQuote {
+			if filled*levels[i].Price > remaining {
+				filled = remaining / levels[i].Price
+			}
+			remaining -= filled * levels[i].Price
+		} else {
+			if filled > remaining {
+				filled = remaining
+			}
+			remaining -= filled
+		}
+
+		if result.BestPrice == 0 {
+			result.BestPrice = levels[i].Price
+		}
+		result.WorstPrice = levels[i].Price
+		result.Amount += filled
+		result.Cost += filled * levels[i].Price
+	}
+
+	if result.Amount == 0 {
+		return result, errors.New(ErrOrderbookEmpty)
+	}
+
+	result.FullyFilled = remaining <= amount*1e-9
+	result.AveragePrice = result.Cost / result.Amount
+	result.SlippagePercent = (result.AveragePrice - result.BestPrice) / result.BestPrice * 100
+	if !isBuy {
+		result.SlippagePercent = -result.SlippagePercent
+	}
+	return result, nil
+}
+
+// LiquidityWithinPercent returns the base currency amount of the bids and asks
+// priced within a percentage of the mid price
+func (o *Base) LiquidityWithinPercent(percent float64) (bidAmount, askAmount float64, err error) {
+	if percent <= 0 {
+		return 0, 0, errors.New(ErrInvalidAmount)
+	}
+
+	mid, err := o.MidPrice()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lower := mid * (1 - percent/100)
+	for i := range o.Bids {
+		if o.Bids[i].Price < lower {
+			break
+		}
+		bidAmount += o.Bids[i].Amount
+	}
+
+	upper := mid * (1 + percent/100)
+	for i := range o.Asks {
+		if o.Asks[i].Price > upper {
+			break
+		}
+		askAmount += o.Asks[i].Amount
+	}
+	return bidAmount, askAmount, nil
+}
+
+// Imbalance returns the difference between the bid and ask volume of the top
+// levels as a fraction of their total volume, ranging from -1 when the book
+// only holds asks to 1 when it only holds bids. All levels are included when
+// levels is zero
+func (o *Base) Imbalance(levels int) (float64, error) {
+	bids := o.CumulativeBidVolume(levels)
+	asks := o.CumulativeAskVolume(levels)
+	if bids+asks == 0 {
+		return 0, errors.New(ErrOrderbookEmpty)
+	}
+	return (bids - asks) / (bids + asks), nil
+}
+
+// PriceImpactBuy returns the fill of a market buy which consumes every ask
+// below a price a percentage above the best ask, the amount required to move
+// the market up by that percentage
+func (o *Base) PriceImpactBuy(percent float64) (FillResult, error) {
+	if percent <= 0 {
+		return FillResult{}, errors.New(ErrInvalidAmount)
+	}
+
+	ask, err := o.BestAsk()
+	if err != nil {
+		return FillResult{}, err
+	}
+
+	target := ask.Price * (1 + percent/100)
+	var amount float64
+	for i := range o.Asks {
+		if o.Asks[i].Price >= target {
+			break
+		}
+		amount += o.Asks[i].Amount
+	}
+	return o.SimulateBuy(amount)
+}
+
+// PriceImpactSell returns the fill of a market sell which consumes every bid
+// above a price a percentage below the best bid, the amount required to move
+// the market down by that percentage
+func (o *Base) PriceImpactSell(percent float64) (FillResult, error) {
+	if percent <= 0 {
+		return FillResult{}, errors.New(ErrInvalidAmount)
+	}
+
+	bid, err := o.BestBid()
+	if err != nil {
+		return FillResult{}, err
+	}
+
+	target := bid.Price * (1 - percent/100)
+	var amount float64
+	for i := range o.Bids {
+		if o.Bids[i].Price <= target {
+			break
+		}
+		amount += o.Bids[i].Amount
+	}
+	return o.SimulateSell(amount)
+}
@@ -0,0 +1,110 @@
+package orderbook
+
+import (
+	"math"
+	"testing"
+)
+
+func testAnalyticsBook() Base {
+	return Base{
+		Bids: []Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}, {Price: 90, Amount: 5}},
+		Asks: []Item{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}, {Price: 110, Amount: 5}},
+	}
+}
+
+func TestSimulateFill(t *testing.T) {
+	t.Parallel()
+	base := testAnalyticsBook()
+
+	fill, err := base.SimulateBuy(2)
+	if err != nil {
+		t.Fatal("Test failed. SimulateBuy error", err)
+	}
+
+	if fill.Amount != 2 || fill.Cost != 203 || fill.AveragePrice != 101.5 ||
+		fill.WorstPrice != 102 || !fill.FullyFilled {
+		t.Errorf("Test failed. Unexpected buy fill %+v", fill)
+	}
+
+	if math.Abs(fill.SlippagePercent-0.5/101*100) > 1e-9 {
+		t.Errorf("Test failed. Unexpected buy slippage %f", fill.SlippagePercent)
+	}
+
+	fill, err = base.SimulateSell(3)
+	if err != nil || fill.Cost != 295 || fill.SlippagePercent <= 0 || !fill.FullyFilled {
+		t.Errorf("Test failed. Unexpected sell fill %+v %v", fill, err)
+	}
+
+	fill, err = base.SimulateBuyQuote(152)
+	if err != nil || fill.Amount != 1.5 || fill.Cost != 152 {
+		t.Errorf("Test failed. Unexpected quote buy fill %+v %v", fill, err)
+	}
+
+	fill, err = base.SimulateSellQuote(1000)
+	if err != nil || fill.FullyFilled || fill.Amount != 8 {
+		t.Errorf("Test failed. Expected partial fill of the whole book, received %+v %v", fill, err)
+	}
+
+	_, err = base.SimulateBuy(0)
+	if err == nil {
+		t.Error("Test failed. Expected error for zero amount")
+	}
+
+	var empty Base
+	_, err = empty.SimulateSell(1)
+	if err == nil {
+		t.Error("Test failed. Expected error for empty book")
+	}
+}
+
+func TestLiquidityWithinPercent(t *testing.T) {
+	t.Parallel()
+	base := testAnalyticsBook()
+	bids, asks, err := base.LiquidityWithinPercent(2.5)
+	if err != nil || bids != 3 || asks != 3 {
+		t.Errorf("Test failed. Expected 3 bids and asks within 2.5%%, received %f %f %v",
+			bids, asks, err)
+	}
+
+	_, _, err = base.LiquidityWithinPercent(0)
+	if err == nil {
+		t.Error("Test failed. Expected error for zero percent")
+	}
+}
+
+func TestImbalance(t *testing.T) {
+	t.Parallel()
+	base := testAnalyticsBook()
+	base.Bids[0].Amount = 3
+
+	imbalance, err := base.Imbalance(1)
+	if err != nil || imbalance != 0.5 {
+		t.Errorf("Test failed. Expected imbalance 0.5, received %f %v", imbalance, err)
+	}
+
+	var empty Base
+	_, err = empty.Imbalance(0)
+	if err == nil {
+		t.Error("Test failed. Expected error for empty book")
+	}
+}
+
+func TestPriceImpact(t *testing.T) {
+	t.Parallel()
+	base := testAnalyticsBook()
+
+	fill, err := base.PriceImpactBuy(5)
+	if err != nil || fill.Amount != 3 || fill.WorstPrice != 102 {
+		t.Errorf("Test failed. Expected 3 to move asks up 5%%, received %+v %v", fill, err)
+	}
+
+	fill, err = base.PriceImpactSell(5)
+	if err != nil || fill.Amount != 3 || fill.WorstPrice != 98 {
+		t.Errorf("Test failed. Expected 3 to move bids down 5%%, received %+v %v", fill, err)
+	}
+
+	_, err = base.PriceImpactBuy(-1)
+	if err == nil {
+		t.Error("Test failed. Expected error for negative percent")
+	}
+}
@@ -0,0 +1,144 @@
+package orderbook
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrOrderbookEmpty is returned when a side of an orderbook has no levels
+const ErrOrderbookEmpty = "Orderbook has no bids or asks."
+
+// Sort sorts the bids descending and asks ascending by price, books are kept
+// in this order so levels can be found with a binary search
+func (o *Base) Sort() {
+	if !sort.SliceIsSorted(o.Bids, func(i, j int) bool { return o.Bids[i].Price > o.Bids[j].Price }) {
+		sort.SliceStable(o.Bids, func(i, j int) bool { return o.Bids[i].Price > o.Bids[j].Price })
+	}
+	if !sort.SliceIsSorted(o.Asks, func(i, j int) bool { return o.Asks[i].Price < o.Asks[j].Price }) {
+		sort.SliceStable(o.Asks, func(i, j int) bool { return o.Asks[i].Price < o.Asks[j].Price })
+	}
+}
+
+// UpdateBid inserts, ammends or deletes the bid at the price of the item, an
+// amount of zero deletes the level. Bids must be sorted
+func (o *Base) UpdateBid(item Item) {
+	i := sort.Search(len(o.Bids), func(i int) bool { return o.Bids[i].Price <= item.Price })
+	o.Bids = updateLevel(o.Bids, i, item)
+}
+
+// UpdateAsk inserts, ammends or deletes the ask at the price of the item, an
+// amount of zero deletes the level. Asks must be sorted
+func (o *Base) UpdateAsk(item Item) {
+	i := sort.Search(len(o.Asks), func(i int) bool { return o.Asks[i].Price >= item.Price })
+	o.Asks = updateLevel(o.Asks, i, item)
+}
+
+// updateLevel applies the item to the levels at the index found by a binary
+// search for its price
+func updateLevel(levels []Item, i int, item Item) []Item {
+	if i < len(levels) && levels[i].Price == item.Price {
+		if item.Amount == 0 {
+			return append(levels[:i], levels[i+1:]...)
+		}
+		levels[i].Amount = item.Amount
+		if item.ID != 0 {
+			levels[i].ID = item.ID
+		}
+		return levels
+	}
+
+	if item.Amount == 0 {
+		// Makes sure we dont insert levels we never had
+		return levels
+	}
+
+	levels = append(levels, Item{})
+	copy(levels[i+1:], levels[i:])
+	levels[i] = item
+	return levels
+}
+
+// BestBid returns the highest bid
+func (o *Base) BestBid() (Item, error) {
+	if len(o.Bids) == 0 {
+		return Item{}, errors.New(ErrOrderbookEmpty)
+	}
+	return o.Bids[0], nil
+}
+
+// BestAsk returns the lowest ask
+func (o *Base) BestAsk() (Item, error) {
+	if len(o.Asks) == 0 {
+		return Item{}, errors.New(ErrOrderbookEmpty)
+	}
+	return o.Asks[0], nil
+}
+
+// Spread returns the difference between the best ask and best bid
+func (o *Base) Spread() (float64, error) {
+	bid, ask, err := o.bestPrices()
+	if err != nil {
+		return 0, err
+	}
+	return ask - bid, nil
+}
+
+// MidPrice returns the price halfway between the best bid and best ask
+func (o *Base) MidPrice() (float64, error) {
+	bid, ask, err := o.bestPrices()
+	if err != nil {
+		return 0, err
+	}
+	return (bid + ask) / 2, nil
+}
+
+func (o *Base) bestPrices() (bid, ask float64, err error) {
+	bestBid, err := o.BestBid()
+	if err != nil {
+		return 0, 0, err
+	}
+	bestAsk, err := o.BestAsk()
+	if err != nil {
+		return 0, 0, err
+	}
+	return bestBid.Price, bestAsk.Price, nil
+}
+
+// Depth returns copies of the top levels of the bids and asks, all levels are
+// returned when levels is zero or larger than the book
+func (o *Base) Depth(levels int) (bids, asks []Item) {
+	return copyLevels(o.Bids, levels), copyLevels(o.Asks, levels)
+}
+
+func copyLevels(items []Item, levels int) []Item {
+	if levels <= 0 || levels > len(items) {
+		levels = len(items)
+	}
+	result := make([]Item, levels)
+	copy(result, items[:levels])
+	return result
+}
+
+// CumulativeBidVolume returns the total amount of the top bid levels, all
+// levels are included when levels is zero
+func (o *Base) CumulativeBidVolume(levels int) float64 {
+	return cumulativeVolume(o.Bids, levels)
+}
+
+// CumulativeAskVolume returns the total amount of the top ask levels, all
+// levels are included when levels is zero
+func (o *Base) CumulativeAskVolume(levels int) float64 {
+	return cumulativeVolume(o.Asks, levels)
+}
+
+func cumulativeVolume(items []Item, levels int) float64 {
+	if levels <= 0 || levels > len(items) {
+		levels = len(items)
+	}
+
+	var volume float64
+	for i := 0; i < levels; i++ {
+		volume += items[i].Amount
+	}
+	return volume
+}
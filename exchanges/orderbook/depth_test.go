@@ -0,0 +1,106 @@
+package orderbook
+
+import (
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	t.Parallel()
+	base := Base{
+		Bids: []Item{{Price: 98, Amount: 1}, {Price: 99, Amount: 2}, {Price: 97, Amount: 3}},
+		Asks: []Item{{Price: 102, Amount: 1}, {Price: 101, Amount: 2}, {Price: 103, Amount: 3}},
+	}
+	base.Sort()
+
+	if base.Bids[0].Price != 99 || base.Bids[2].Price != 97 ||
+		base.Asks[0].Price != 101 || base.Asks[2].Price != 103 {
+		t.Errorf("Test failed. Unexpected sort order bids %v asks %v", base.Bids, base.Asks)
+	}
+}
+
+func TestUpdateBidAsk(t *testing.T) {
+	t.Parallel()
+	var base Base
+	for _, price := range []float64{97, 99, 98} {
+		base.UpdateBid(Item{Price: price, Amount: 1})
+		base.UpdateAsk(Item{Price: price + 4, Amount: 1})
+	}
+
+	base.UpdateBid(Item{Price: 98, Amount: 5}) // Ammend
+	base.UpdateBid(Item{Price: 97, Amount: 0}) // Delete
+	base.UpdateBid(Item{Price: 96, Amount: 0}) // Ghost delete
+	base.UpdateAsk(Item{Price: 100, Amount: 2})
+	base.UpdateAsk(Item{Price: 102, Amount: 0})
+
+	if len(base.Bids) != 2 || base.Bids[0].Price != 99 || base.Bids[1].Amount != 5 {
+		t.Errorf("Test failed. Unexpected bids %v", base.Bids)
+	}
+
+	if len(base.Asks) != 3 || base.Asks[0].Price != 100 || base.Asks[1].Price != 101 ||
+		base.Asks[2].Price != 103 {
+		t.Errorf("Test failed. Unexpected asks %v", base.Asks)
+	}
+}
+
+func TestBestPrices(t *testing.T) {
+	t.Parallel()
+	var base Base
+	_, err := base.BestBid()
+	if err == nil {
+		t.Error("Test failed. Expected error for empty bids")
+	}
+
+	_, err = base.MidPrice()
+	if err == nil {
+		t.Error("Test failed. Expected error for empty book")
+	}
+
+	base.Update([]Item{{Price: 99, Amount: 1}, {Price: 100, Amount: 2}},
+		[]Item{{Price: 102, Amount: 3}, {Price: 101, Amount: 4}})
+
+	bid, err := base.BestBid()
+	if err != nil || bid.Price != 100 {
+		t.Errorf("Test failed. Expected best bid 100, received %v %v", bid, err)
+	}
+
+	ask, err := base.BestAsk()
+	if err != nil || ask.Price != 101 {
+		t.Errorf("Test failed. Expected best ask 101, received %v %v", ask, err)
+	}
+
+	spread, err := base.Spread()
+	if err != nil || spread != 1 {
+		t.Errorf("Test failed. Expected spread 1, received %f %v", spread, err)
+	}
+
+	mid, err := base.MidPrice()
+	if err != nil || mid != 100.5 {
+		t.Errorf("Test failed. Expected mid price 100.5, received %f %v", mid, err)
+	}
+}
+
+func TestDepth(t *testing.T) {
+	t.Parallel()
+	base := Base{
+		Bids: []Item{{Price: 100, Amount: 1}, {Price: 99, Amount: 2}, {Price: 98, Amount: 3}},
+		Asks: []Item{{Price: 101, Amount: 4}, {Price: 102, Amount: 5}},
+	}
+
+	bids, asks := base.Depth(2)
+	if len(bids) != 2 || len(asks) != 2 || bids[1].Price != 99 {
+		t.Errorf("Test failed. Unexpected depth bids %v asks %v", bids, asks)
+	}
+
+	bids[0].Amount = 10
+	if base.Bids[0].Amount != 1 {
+		t.Error("Test failed. Expected depth to return a copy of the levels")
+	}
+
+	if v := base.CumulativeBidVolume(2); v != 3 {
+		t.Errorf("Test failed. Expected cumulative bid volume 3, received %f", v)
+	}
+
+	if v := base.CumulativeAskVolume(0); v != 9 {
+		t.Errorf("Test failed. Expected cumulative ask volume 9, received %f", v)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
 )
 
 // Const values for orderbook package
@@ -19,7 +20,8 @@ const (
 
 // Vars for the orderbook package
 var (
-	Orderbooks []Orderbook
+	// Orderbooks holds the orderbook of each exchange keyed by exchange name
+	Orderbooks = make(map[string]*Orderbook)
 	m          sync.Mutex
 )
 
@@ -74,22 +76,30 @@ func (o *Base) CalculateTotalAsks() (float64, float64) {
 func (o *Base) Update(Bids, Asks []Item) {
 	o.Bids = Bids
 	o.Asks = Asks
+	o.Sort()
 	o.LastUpdated = time.Now()
 }
 
+// BusTopic returns the data bus topic orderbooks are published on
+func (o Base) BusTopic() string {
+	return databus.Orderbook
+}
+
 // GetOrderbook checks and returns the orderbook given an exchange name and
 // currency pair if it exists
 func GetOrderbook(exchange string, p pair.CurrencyPair, orderbookType string) (Base, error) {
-	orderbook, err := GetOrderbookByExchange(exchange)
-	if err != nil {
-		return Base{}, err
+	m.Lock()
+	defer m.Unlock()
+	orderbook, ok := Orderbooks[exchange]
+	if !ok {
+		return Base{}, errors.New(ErrOrderbookForExchangeNotFound)
 	}
 
-	if !FirstCurrencyExists(exchange, p.FirstCurrency) {
+	if _, ok := orderbook.Orderbook[p.FirstCurrency]; !ok {
 		return Base{}, errors.New(ErrPrimaryCurrencyNotFound)
 	}
 
-	if !SecondCurrencyExists(exchange, p) {
+	if _, ok := orderbook.Orderbook[p.FirstCurrency][p.SecondCurrency]; !ok {
 		return Base{}, errors.New(ErrSecondaryCurrencyNotFound)
 	}
 
@@ -100,12 +110,11 @@ func GetOrderbook(exchange string, p pair.CurrencyPair, orderbookType string) (B
 func GetOrderbookByExchange(exchange string) (*Orderbook, error) {
 	m.Lock()
 	defer m.Unlock()
-	for _, y := range Orderbooks {
-		if y.ExchangeName == exchange {
-			return &y, nil
-		}
+	orderbook, ok := Orderbooks[exchange]
+	if !ok {
+		return nil, errors.New(ErrOrderbookForExchangeNotFound)
 	}
-	return nil, errors.New(ErrOrderbookForExchangeNotFound)
+	return orderbook, nil
 }
 
 // FirstCurrencyExists checks to see if the first currency of the orderbook map
@@ -113,14 +122,12 @@ func GetOrderbookByExchange(exchange string) (*Orderbook, error) {
 func FirstCurrencyExists(exchange string, currency pair.CurrencyItem) bool {
 	m.Lock()
 	defer m.Unlock()
-	for _, y := range Orderbooks {
-		if y.ExchangeName == exchange {
-			if _, ok := y.Orderbook[currency]; ok {
-				return true
-			}
-		}
+	orderbook, ok := Orderbooks[exchange]
+	if !ok {
+		return false
 	}
-	return false
+	_, ok = orderbook.Orderbook[currency]
+	return ok
 }
 
 // SecondCurrencyExists checks to see if the second currency of the orderbook
@@ -128,64 +135,71 @@ func FirstCurrencyExists(exchange string, currency pair.CurrencyItem) bool {
 func SecondCurrencyExists(exchange string, p pair.CurrencyPair) bool {
 	m.Lock()
 	defer m.Unlock()
-	for _, y := range Orderbooks {
-		if y.ExchangeName == exchange {
-			if _, ok := y.Orderbook[p.FirstCurrency]; ok {
-				if _, ok := y.Orderbook[p.FirstCurrency][p.SecondCurrency]; ok {
-					return true
-				}
-			}
-		}
+	orderbook, ok := Orderbooks[exchange]
+	if !ok {
+		return false
 	}
-	return false
+	_, ok = orderbook.Orderbook[p.FirstCurrency][p.SecondCurrency]
+	return ok
 }
 
 // CreateNewOrderbook creates a new orderbook
 func CreateNewOrderbook(exchangeName string, p pair.CurrencyPair, orderbookNew Base, orderbookType string) Orderbook {
 	m.Lock()
 	defer m.Unlock()
-	orderbook := Orderbook{}
-	orderbook.ExchangeName = exchangeName
-	orderbook.Orderbook = make(map[pair.CurrencyItem]map[pair.CurrencyItem]map[string]Base)
-	a := make(map[pair.CurrencyItem]map[string]Base)
-	b := make(map[string]Base)
-	b[orderbookType] = orderbookNew
-	a[p.SecondCurrency] = b
-	orderbook.Orderbook[p.FirstCurrency] = a
-	Orderbooks = append(Orderbooks, orderbook)
-	return orderbook
+	orderbook := newOrderbook(exchangeName)
+	orderbook.setBase(p, orderbookNew, orderbookType)
+	Orderbooks[exchangeName] = orderbook
+	return *orderbook
 }
 
-// ProcessOrderbook processes incoming orderbooks, creating or updating the
-// Orderbook list
+// newOrderbook returns an empty exchange orderbook
+func newOrderbook(exchangeName string) *Orderbook {
+	return &Orderbook{
+		ExchangeName: exchangeName,
+		Orderbook:    make(map[pair.CurrencyItem]map[pair.CurrencyItem]map[string]Base),
+	}
+}
+
+// setBase stores an orderbook, creating the maps of its currencies as needed
+func (o *Orderbook) setBase(p pair.CurrencyPair, orderbookNew Base, orderbookType string) {
+	if _, ok := o.Orderbook[p.FirstCurrency]; !ok {
+		o.Orderbook[p.FirstCurrency] = make(map[pair.CurrencyItem]map[string]Base)
+	}
+	if _, ok := o.Orderbook[p.FirstCurrency][p.SecondCurrency]; !ok {
+		o.Orderbook[p.FirstCurrency][p.SecondCurrency] = make(map[string]Base)
+	}
+	o.Orderbook[p.FirstCurrency][p.SecondCurrency][orderbookType] = orderbookNew
+}
+
+// ProcessOrderbook processes incoming orderbooks, storing a copy of them by
+// exchange and publishing another on the data bus, as websocket orderbooks are
+// updated in place. Bids are sorted descending and asks ascending
 func ProcessOrderbook(exchangeName string, p pair.CurrencyPair, orderbookNew Base, orderbookType string) {
 	if orderbookNew.Pair.Pair() == "" {
 		// set Pair if not set
 		orderbookNew.Pair = p
 	}
+	orderbookNew.Bids, orderbookNew.Asks = orderbookNew.Depth(0)
+	orderbookNew.Sort()
 	orderbookNew.CurrencyPair = p.Pair().String()
 	orderbookNew.LastUpdated = time.Now()
 
-	orderbook, err := GetOrderbookByExchange(exchangeName)
-	if err != nil {
-		CreateNewOrderbook(exchangeName, p, orderbookNew, orderbookType)
-		return
-	}
-
-	if FirstCurrencyExists(exchangeName, p.FirstCurrency) {
-		m.Lock()
-		a := make(map[string]Base)
-		a[orderbookType] = orderbookNew
-		orderbook.Orderbook[p.FirstCurrency][p.SecondCurrency] = a
-		m.Unlock()
-		return
-	}
-
 	m.Lock()
-	a := make(map[pair.CurrencyItem]map[string]Base)
-	b := make(map[string]Base)
-	b[orderbookType] = orderbookNew
-	a[p.SecondCurrency] = b
-	orderbook.Orderbook[p.FirstCurrency] = a
+	orderbook, ok := Orderbooks[exchangeName]
+	if !ok {
+		orderbook = newOrderbook(exchangeName)
+		Orderbooks[exchangeName] = orderbook
+	}
+	orderbook.setBase(p, orderbookNew, orderbookType)
 	m.Unlock()
+
+	orderbookNew.Bids, orderbookNew.Asks = orderbookNew.Depth(0)
+	databus.Publish(databus.Message{
+		Exchange:  exchangeName,
+		Pair:      p,
+		Asset:     orderbookType,
+		Data:      orderbookNew,
+		Timestamp: orderbookNew.LastUpdated,
+	})
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
 )
 
 func TestCalculateTotalBids(t *testing.T) {
@@ -210,7 +211,7 @@ func TestCreateNewOrderbook(t *testing.T) {
 }
 
 func TestProcessOrderbook(t *testing.T) {
-	Orderbooks = []Orderbook{}
+	Orderbooks = make(map[string]*Orderbook)
 	currency := pair.NewCurrencyPair("BTC", "USD")
 	base := Base{
 		Pair:         currency,
@@ -328,3 +329,41 @@ func TestProcessOrderbook(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestProcessOrderbookPublish(t *testing.T) {
+	sub := databus.Subscribe(databus.Filter{Exchanges: []string{"PublishTest"}},
+		1, databus.DropNewest)
+	defer sub.Unsubscribe()
+
+	ProcessOrderbook("PublishTest", pair.NewCurrencyPair("BTC", "USD"),
+		Base{Bids: []Item{{Price: 1337, Amount: 1}}}, Spot)
+	msg := <-sub.C
+	received, ok := msg.Data.(Base)
+	if !ok || msg.Topic() != databus.Orderbook || msg.Asset != Spot ||
+		len(received.Bids) != 1 || received.CurrencyPair != "BTCUSD" {
+		t.Errorf("Test failed. Unexpected published orderbook %v", msg)
+	}
+}
+
+func TestProcessOrderbookCopies(t *testing.T) {
+	p := pair.NewCurrencyPair("BTC", "USD")
+	base := Base{
+		Bids: []Item{{Price: 1336, Amount: 1}, {Price: 1337, Amount: 1}},
+		Asks: []Item{{Price: 1339, Amount: 1}, {Price: 1338, Amount: 1}},
+	}
+
+	ProcessOrderbook("CopyTest", p, base, Spot)
+	if base.Bids[0].Price != 1336 || base.Asks[0].Price != 1339 {
+		t.Error("Test failed. ProcessOrderbook sorted the orderbook of the caller")
+	}
+
+	base.Bids[1].Amount = 0
+	result, err := GetOrderbook("CopyTest", p, Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Bids[0].Price != 1337 || result.Bids[0].Amount != 1 ||
+		result.Asks[0].Price != 1338 {
+		t.Errorf("Test failed. Unexpected stored orderbook %v", result)
+	}
+}
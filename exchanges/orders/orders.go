@@ -1,40 +1,169 @@
 package orders
 
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+)
+
+// Status defines a normalised order status across all exchanges
+type Status string
+
+// Order status types
 const (
-	limitOrder = iota
-	marketOrder
+	New             Status = "NEW"
+	PartiallyFilled Status = "PARTIALLY_FILLED"
+	Filled          Status = "FILLED"
+	Cancelled       Status = "CANCELLED"
+	Rejected        Status = "REJECTED"
+	UnknownStatus   Status = "UNKNOWN"
+)
+
+// Vars for the orders package
+var (
+	// Orders variable holds an array of pointers to order structs
+	Orders []*Order
+
+	nextOrderID int
+	m           sync.Mutex
+
+	errOrderNotFound = errors.New("order not found")
 )
 
-// Orders variable holds an array of pointers to order structs
-var Orders []*Order
+// StatusChange holds a single order status transition
+type StatusChange struct {
+	Status       Status    `json:"status"`
+	FilledAmount float64   `json:"filledAmount"`
+	Time         time.Time `json:"time"`
+}
 
 // Order struct holds order values
 type Order struct {
-	OrderID  int
-	Exchange string
-	Type     int
-	Amount   float64
-	Price    float64
+	OrderID         int               `json:"orderID"`
+	ExchangeOrderID string            `json:"exchangeOrderID"`
+	ClientID        string            `json:"clientID"`
+	Exchange        string            `json:"exchange"`
+	Pair            pair.CurrencyPair `json:"pair"`
+	Side            string            `json:"side"`
+	OrderType       string            `json:"orderType"`
+	Amount          float64           `json:"amount"`
+	Price           float64           `json:"price"`
+	FilledAmount    float64           `json:"filledAmount"`
+	Status          Status            `json:"status"`
+	Created         time.Time         `json:"created"`
+	LastUpdated     time.Time         `json:"lastUpdated"`
+	History         []StatusChange    `json:"history"`
+}
+
+// IsOpen returns whether or not the order status can still change
+func (s Status) IsOpen() bool {
+	return s == New || s == PartiallyFilled || s == UnknownStatus
+}
+
+// ParseStatus converts an exchange supplied order status into a normalised
+// Status
+func ParseStatus(status string) Status {
+	switch common.StringToLower(common.ReplaceString(status, "_", " ", -1)) {
+	case "new", "open", "active", "pending", "accepted", "placed", "live":
+		return New
+	case "partially filled", "partiallyfilled", "partial", "part filled":
+		return PartiallyFilled
+	case "filled", "closed", "done", "complete", "completed", "executed", "fully filled":
+		return Filled
+	case "cancelled", "canceled", "cancel", "partially cancelled", "partially canceled":
+		return Cancelled
+	case "rejected", "expired", "failed", "error":
+		return Rejected
+	}
+	return UnknownStatus
 }
 
 // NewOrder creates a new order and returns a an orderID
 func NewOrder(Exchange string, amount, price float64) int {
-	order := &Order{}
-	if len(Orders) == 0 {
-		order.OrderID = 0
-	} else {
-		order.OrderID = len(Orders)
+	m.Lock()
+	defer m.Unlock()
+	return addOrder(&Order{
+		Exchange: Exchange,
+		Amount:   amount,
+		Price:    price,
+	})
+}
+
+// Submitted records the result of an order submission and returns the
+// internal orderID
+func Submitted(exchName, exchangeOrderID, clientID string, p pair.CurrencyPair, side, orderType string, amount, price float64, placed bool) int {
+	m.Lock()
+	defer m.Unlock()
+
+	order := &Order{
+		ExchangeOrderID: exchangeOrderID,
+		ClientID:        clientID,
+		Exchange:        exchName,
+		Pair:            p,
+		Side:            side,
+		OrderType:       orderType,
+		Amount:          amount,
+		Price:           price,
+	}
+
+	status := New
+	if !placed {
+		status = Rejected
 	}
+	order.setStatus(status, 0, time.Now())
+	return addOrder(order)
+}
 
-	order.Exchange = Exchange
-	order.Amount = amount
-	order.Price = price
+// addOrder assigns an orderID and stores the order, the caller must hold the
+// lock
+func addOrder(order *Order) int {
+	order.OrderID = nextOrderID
+	nextOrderID++
+	if order.Created.IsZero() {
+		order.Created = time.Now()
+	}
 	Orders = append(Orders, order)
 	return order.OrderID
 }
 
+// setStatus records a status transition if the status or filled amount has
+// changed, the caller must hold the lock
+func (o *Order) setStatus(status Status, filledAmount float64, t time.Time) bool {
+	if len(o.History) > 0 && o.Status == status && o.FilledAmount == filledAmount {
+		return false
+	}
+
+	o.Status = status
+	o.FilledAmount = filledAmount
+	o.LastUpdated = t
+	o.History = append(o.History, StatusChange{
+		Status:       status,
+		FilledAmount: filledAmount,
+		Time:         t,
+	})
+	return true
+}
+
+// UpdateStatus updates an orders status and filled amount, recording the
+// transition. Returns whether or not the order changed
+func UpdateStatus(orderID int, status Status, filledAmount float64) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	for i := range Orders {
+		if Orders[i].OrderID == orderID {
+			return Orders[i].setStatus(status, filledAmount, time.Now()), nil
+		}
+	}
+	return false, errOrderNotFound
+}
+
 // DeleteOrder deletes orders by ID and returns state
 func DeleteOrder(orderID int) bool {
+	m.Lock()
+	defer m.Unlock()
 	for i := range Orders {
 		if Orders[i].OrderID == orderID {
 			Orders = append(Orders[:i], Orders[i+1:]...)
@@ -44,26 +173,73 @@ func DeleteOrder(orderID int) bool {
 	return false
 }
 
-// GetOrdersByExchange returns order pointer grouped by exchange
-func GetOrdersByExchange(exchange string) []*Order {
-	orders := []*Order{}
+// GetOrdersByExchange returns a copy of the orders grouped by exchange
+func GetOrdersByExchange(exchange string) []Order {
+	m.Lock()
+	defer m.Unlock()
+	var orders []Order
 	for i := range Orders {
 		if Orders[i].Exchange == exchange {
-			orders = append(orders, Orders[i])
+			orders = append(orders, Orders[i].copy())
 		}
 	}
-	if len(orders) > 0 {
-		return orders
-	}
-	return nil
+	return orders
 }
 
-// GetOrderByOrderID returns order pointer by ID
-func GetOrderByOrderID(orderID int) *Order {
+// GetOrderByOrderID returns a copy of an order by ID
+func GetOrderByOrderID(orderID int) (Order, error) {
+	m.Lock()
+	defer m.Unlock()
 	for i := range Orders {
 		if Orders[i].OrderID == orderID {
-			return Orders[i]
+			return Orders[i].copy(), nil
+		}
+	}
+	return Order{}, errOrderNotFound
+}
+
+// GetOrderByExchangeOrderID returns a copy of an order by the exchange name
+// and the ID assigned by the exchange
+func GetOrderByExchangeOrderID(exchName, exchangeOrderID string) (Order, error) {
+	m.Lock()
+	defer m.Unlock()
+	for i := range Orders {
+		if Orders[i].Exchange == exchName &&
+			Orders[i].ExchangeOrderID == exchangeOrderID {
+			return Orders[i].copy(), nil
+		}
+	}
+	return Order{}, errOrderNotFound
+}
+
+// GetOrders returns a copy of all tracked orders
+func GetOrders() []Order {
+	m.Lock()
+	defer m.Unlock()
+	orders := make([]Order, 0, len(Orders))
+	for i := range Orders {
+		orders = append(orders, Orders[i].copy())
+	}
+	return orders
+}
+
+// GetOpenOrders returns a copy of all tracked orders whose status can still
+// change
+func GetOpenOrders() []Order {
+	m.Lock()
+	defer m.Unlock()
+	var orders []Order
+	for i := range Orders {
+		if Orders[i].Status.IsOpen() && Orders[i].ExchangeOrderID != "" {
+			orders = append(orders, Orders[i].copy())
 		}
 	}
-	return nil
+	return orders
+}
+
+// copy returns a deep copy of the order, the caller must hold the lock
+func (o *Order) copy() Order {
+	c := *o
+	c.History = append([]StatusChange(nil), o.History...)
+	return c
 }
@@ -2,6 +2,8 @@ package orders
 
 import (
 	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
 )
 
 func TestNewOrder(t *testing.T) {
@@ -28,10 +30,105 @@ func TestGetOrdersByExchange(t *testing.T) {
 	if value := GetOrdersByExchange("ANX"); len(value) != 0 {
 		t.Error("Test Failed - Orders_test.go GetOrdersByExchange() - Error")
 	}
+
+	value := GetOrdersByExchange("BATMAN")
+	if len(value) != 1 {
+		t.Fatal("Test Failed - Orders_test.go GetOrdersByExchange() - Error")
+	}
+
+	value[0].Amount = 1
+	if GetOrdersByExchange("BATMAN")[0].Amount != 400 {
+		t.Error("Test Failed - Orders_test.go GetOrdersByExchange() - Error returned order is not a copy")
+	}
 }
 
 func TestGetOrderByOrderID(t *testing.T) {
-	if value := GetOrderByOrderID(69); value != nil {
-		t.Error("Test Failed - Orders_test.go GetOrdersByExchange() - Error")
+	if _, err := GetOrderByOrderID(69); err == nil {
+		t.Error("Test Failed - Orders_test.go GetOrderByOrderID() - Error")
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	tester := map[string]Status{
+		"open":             New,
+		"NEW":              New,
+		"PARTIALLY_FILLED": PartiallyFilled,
+		"closed":           Filled,
+		"Canceled":         Cancelled,
+		"expired":          Rejected,
+		"wat":              UnknownStatus,
+	}
+
+	for input, expected := range tester {
+		if result := ParseStatus(input); result != expected {
+			t.Errorf("Test Failed - Orders_test.go ParseStatus(%s) expected %s got %s",
+				input, expected, result)
+		}
+	}
+}
+
+func TestSubmittedAndUpdateStatus(t *testing.T) {
+	p := pair.NewCurrencyPair("BTC", "USD")
+	ID := Submitted("Bitstamp", "1337", "", p, "Buy", "Limit", 1, 1000, true)
+	rejectedID := Submitted("Bitstamp", "", "", p, "Buy", "Limit", 1, 1000, false)
+
+	order, err := GetOrderByExchangeOrderID("Bitstamp", "1337")
+	if err != nil {
+		t.Fatal("Test Failed - Orders_test.go GetOrderByExchangeOrderID() - Error", err)
+	}
+
+	if order.OrderID != ID || order.Status != New || len(order.History) != 1 {
+		t.Error("Test Failed - Orders_test.go Submitted() - Error")
+	}
+
+	if rejected, _ := GetOrderByOrderID(rejectedID); rejected.Status != Rejected {
+		t.Error("Test Failed - Orders_test.go Submitted() - Error rejected status not set")
+	}
+
+	changed, err := UpdateStatus(ID, New, 0)
+	if err != nil || changed {
+		t.Error("Test Failed - Orders_test.go UpdateStatus() - Error recorded unchanged status")
+	}
+
+	changed, err = UpdateStatus(ID, PartiallyFilled, 0.5)
+	if err != nil || !changed {
+		t.Error("Test Failed - Orders_test.go UpdateStatus() - Error")
+	}
+
+	open := GetOpenOrders()
+	var found bool
+	for x := range open {
+		if open[x].OrderID == ID {
+			found = true
+		}
+		if open[x].OrderID == rejectedID {
+			t.Error("Test Failed - Orders_test.go GetOpenOrders() - Error returned rejected order")
+		}
+	}
+	if !found {
+		t.Error("Test Failed - Orders_test.go GetOpenOrders() - Error open order not found")
+	}
+
+	_, err = UpdateStatus(ID, Filled, 1)
+	if err != nil {
+		t.Error("Test Failed - Orders_test.go UpdateStatus() - Error", err)
+	}
+
+	order, err = GetOrderByOrderID(ID)
+	if err != nil {
+		t.Fatal("Test Failed - Orders_test.go GetOrderByOrderID() - Error", err)
+	}
+
+	order.History[0].Status = Cancelled
+	if order, _ = GetOrderByOrderID(ID); order.History[0].Status != New {
+		t.Error("Test Failed - Orders_test.go GetOrderByOrderID() - Error returned order is not a copy")
+	}
+
+	if order.Status != Filled || order.FilledAmount != 1 || len(order.History) != 3 {
+		t.Error("Test Failed - Orders_test.go UpdateStatus() - Error transitions not recorded")
+	}
+
+	if _, err = UpdateStatus(1337, Filled, 1); err == nil {
+		t.Error("Test Failed - Orders_test.go UpdateStatus() - Error expected error for unknown order")
 	}
 }
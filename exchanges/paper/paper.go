@@ -0,0 +1,549 @@
+package paper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+var (
+	errOrderNotFound       = errors.New("order not found")
+	errInsufficientBalance = errors.New("insufficient balance")
+	errNoLiquidity         = errors.New("no orderbook liquidity available")
+	errNotSupported        = errors.New("function not supported in paper trading mode")
+)
+
+// Trade holds a simulated fill
+type Trade struct {
+	Time     time.Time          `json:"time"`
+	OrderID  string             `json:"orderID"`
+	Pair     pair.CurrencyPair  `json:"pair"`
+	Side     exchange.OrderSide `json:"side"`
+	Price    float64            `json:"price"`
+	Amount   float64            `json:"amount"`
+	Fee      float64            `json:"fee"`
+	IsMaker  bool               `json:"isMaker"`
+	ClientID string             `json:"clientID"`
+}
+
+// paperFill is a planned fill of part of an order against an orderbook level
+type paperFill struct {
+	level  int
+	price  float64
+	amount float64
+	fee    float64
+}
+
+// paperOrder holds an order submitted to the paper exchange
+type paperOrder struct {
+	detail    exchange.OrderDetail
+	pair      pair.CurrencyPair
+	side      exchange.OrderSide
+	orderType exchange.OrderType
+	clientID  string
+}
+
+// Exchange wraps a live exchange, passing market data requests through to it
+// while simulating order execution and balances against its live orderbooks
+type Exchange struct {
+	exchange.IBotExchange
+	balances map[string]float64
+	orders   []*paperOrder
+	trades   []Trade
+	orderID  int64
+	feeRates exchange.FeeRateCache
+	m        sync.Mutex
+}
+
+// New returns a paper trading exchange wrapping the live exchange with the
+// supplied starting balances
+func New(exch exchange.IBotExchange, balances map[string]float64) *Exchange {
+	e := &Exchange{
+		IBotExchange: exch,
+		balances:     make(map[string]float64),
+	}
+
+	for k, v := range balances {
+		e.balances[common.StringToUpper(k)] = v
+	}
+	return e
+}
+
+// IsPaperTrading returns true, allowing callers to detect simulated exchanges
+func (e *Exchange) IsPaperTrading() bool {
+	return true
+}
+
+// UpdateOrderbook updates the live orderbook and matches any resting paper
+// orders against it
+func (e *Exchange) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+	ob, err := e.IBotExchange.UpdateOrderbook(ctx, p, assetType)
+	if err != nil {
+		return ob, err
+	}
+
+	if assetType == ticker.Spot {
+		e.m.Lock()
+		e.matchRestingOrders(p, ob)
+		e.m.Unlock()
+	}
+	return ob, nil
+}
+
+// GetAccountInfo returns the simulated balances, funds reserved by open
+// orders are reported as on hold and excluded from the available total
+func (e *Exchange) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	info := exchange.AccountInfo{ExchangeName: e.GetName()}
+	for k, v := range e.balances {
+		hold := e.reserved(k)
+		info.Currencies = append(info.Currencies, exchange.AccountCurrencyInfo{
+			CurrencyName: k,
+			TotalValue:   v - hold,
+			Hold:         hold,
+		})
+	}
+	return info, nil
+}
+
+// SubmitOrder simulates an order against the live orderbook, market orders
+// fill immediately against available depth and limit orders fill any
+// marketable amount with the remainder resting until a later orderbook update
+// crosses its price
+func (e *Exchange) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
+	var resp exchange.SubmitOrderResponse
+
+	err := e.ValidateOrder(&order)
+	if err != nil {
+		return resp, err
+	}
+
+	if order.OrderType != exchange.Market && order.OrderType != exchange.Limit {
+		return resp, fmt.Errorf("unsupported order type %s", order.OrderType)
+	}
+
+	if !order.Options.IsPlain() {
+		return resp, errors.New("order options are not supported in paper trading mode")
+	}
+
+	p, side, orderType := order.Pair, order.Side, order.OrderType
+	amount, price := order.Amount, order.Price
+
+	ob, err := e.GetOrderbookEx(ctx, p, ticker.Spot)
+	if err != nil {
+		return resp, err
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	reservePrice := price
+	if orderType == exchange.Market {
+		reservePrice, err = worstFillPrice(ob, side, amount)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	currency, required := requiredFunds(p, side, amount, reservePrice)
+	if e.balances[currency]-e.reserved(currency) < required {
+		return resp, errInsufficientBalance
+	}
+
+	e.orderID++
+	o := &paperOrder{
+		detail: exchange.OrderDetail{
+			Exchange:      e.GetName(),
+			ID:            strconv.FormatInt(e.orderID, 10),
+			BaseCurrency:  p.FirstCurrency.Upper().String(),
+			QuoteCurrency: p.SecondCurrency.Upper().String(),
+			OrderSide:     side.ToString(),
+			OrderType:     orderType.ToString(),
+			CreationTime:  time.Now().Unix(),
+			OrderDate:     time.Now(),
+			Status:        string(orders.New),
+			Price:         reservePrice,
+			Amount:        amount,
+			OpenVolume:    amount,
+		},
+		pair:      p,
+		side:      side,
+		orderType: orderType,
+		clientID:  order.ClientID,
+	}
+	e.orders = append(e.orders, o)
+
+	trades := len(e.trades)
+	err = e.match(o, bookSide(ob, side), false)
+	if err != nil {
+		o.detail.Status = string(orders.Rejected)
+		return resp, err
+	}
+
+	// Market orders do not rest on the book, any amount which could not be
+	// filled from the available depth is cancelled
+	if orderType == exchange.Market && o.detail.OpenVolume > 0 {
+		o.detail.Status = string(orders.Cancelled)
+	}
+
+	var cost float64
+	for _, t := range e.trades[trades:] {
+		resp.FilledAmount += t.Amount
+		resp.Fee += t.Fee
+		cost += t.Price * t.Amount
+	}
+
+	if resp.FilledAmount > 0 {
+		resp.AveragePrice = cost / resp.FilledAmount
+		resp.FeeCurrency = p.SecondCurrency.Upper().String()
+	}
+
+	resp.IsOrderPlaced = true
+	resp.OrderID = o.detail.ID
+	resp.OrderDate = o.detail.OrderDate
+	resp.LastUpdated = time.Now()
+	return resp, nil
+}
+
+// ModifyOrder is not supported in paper trading mode
+func (e *Exchange) ModifyOrder(ctx context.Context, orderID string, modify exchange.ModifyOrder) (string, error) {
+	return "", errNotSupported
+}
+
+// CancelOrder cancels an open paper order
+func (e *Exchange) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	o := e.getOrder(order.OrderID)
+	if o == nil || !orders.Status(o.detail.Status).IsOpen() {
+		return errOrderNotFound
+	}
+
+	o.detail.Status = string(orders.Cancelled)
+	return nil
+}
+
+// CancelAllOrders cancels all open paper orders
+func (e *Exchange) CancelAllOrders(ctx context.Context) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	for _, o := range e.orders {
+		if orders.Status(o.detail.Status).IsOpen() {
+			o.detail.Status = string(orders.Cancelled)
+		}
+	}
+	return nil
+}
+
+// GetOrderInfo returns information on a paper order
+func (e *Exchange) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	o := e.getOrder(orderID)
+	if o == nil {
+		return exchange.OrderDetail{}, errOrderNotFound
+	}
+	return o.detail, nil
+}
+
+// GetActiveOrders retrieves any paper orders that are active/open
+func (e *Exchange) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return e.getOrders(getOrdersRequest, true), nil
+}
+
+// GetOrderHistory retrieves all paper orders
+func (e *Exchange) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return e.getOrders(getOrdersRequest, false), nil
+}
+
+// WithdrawCryptocurrencyFunds is not supported in paper trading mode
+func (e *Exchange) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+	return "", errNotSupported
+}
+
+// WithdrawFiatFunds is not supported in paper trading mode
+func (e *Exchange) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
+	return "", errNotSupported
+}
+
+// GetDerivativesPositions returns no positions as paper trading only
+// simulates spot orders
+func (e *Exchange) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, nil
+}
+
+// SetLeverage is not supported in paper trading mode
+func (e *Exchange) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return errNotSupported
+}
+
+// SetMarginMode is not supported in paper trading mode
+func (e *Exchange) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return errNotSupported
+}
+
+// CloseDerivativesPosition is not supported in paper trading mode
+func (e *Exchange) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	return exchange.SubmitOrderResponse{}, errNotSupported
+}
+
+// GetFeeByType returns the fee of the wrapped exchange
+func (e *Exchange) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+	fees, ok := e.IBotExchange.(exchange.FeeModel)
+	if !ok {
+		return 0, common.ErrFunctionNotSupported
+	}
+	return fees.GetFeeByType(feeBuilder)
+}
+
+// GetTrades returns all simulated fills
+func (e *Exchange) GetTrades() []Trade {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return append([]Trade(nil), e.trades...)
+}
+
+// matchRestingOrders matches open orders for the pair against an updated
+// orderbook. Orders fill in submission order against a working copy of the
+// levels, so liquidity consumed by one order is not available to the next.
+// The caller must hold the lock
+func (e *Exchange) matchRestingOrders(p pair.CurrencyPair, ob orderbook.Base) {
+	asks := bookSide(ob, exchange.Buy)
+	bids := bookSide(ob, exchange.Sell)
+	for _, o := range e.orders {
+		if !orders.Status(o.detail.Status).IsOpen() || !o.pair.Equal(p, false) {
+			continue
+		}
+
+		levels := asks
+		if o.side == exchange.Sell {
+			levels = bids
+		}
+
+		err := e.match(o, levels, true)
+		if err != nil {
+			log.Printf("%s paper trading unable to fill order %s: %s",
+				e.GetName(), o.detail.ID, err)
+		}
+	}
+}
+
+// match fills as much of the order as possible from the orderbook levels,
+// decrementing the amount consumed from each level. Maker fills execute at the
+// limit price of the resting order. Funds are checked for the whole walk
+// before any fill is applied so a failed match leaves balances unchanged. The
+// caller must hold the lock
+func (e *Exchange) match(o *paperOrder, levels []orderbook.Item, isMaker bool) error {
+	var fills []paperFill
+	remaining := o.detail.OpenVolume
+	for i := range levels {
+		if remaining <= 0 {
+			break
+		}
+
+		if o.orderType == exchange.Limit {
+			if o.side == exchange.Buy && levels[i].Price > o.detail.Price {
+				break
+			}
+			if o.side == exchange.Sell && levels[i].Price < o.detail.Price {
+				break
+			}
+		}
+
+		amount := levels[i].Amount
+		if amount > remaining {
+			amount = remaining
+		}
+
+		if amount <= 0 {
+			continue
+		}
+
+		price := levels[i].Price
+		if isMaker {
+			price = o.detail.Price
+		}
+
+		fee, err := e.calculateFee(o.pair, isMaker, price, amount)
+		if err != nil {
+			return err
+		}
+
+		fills = append(fills, paperFill{level: i, price: price, amount: amount, fee: fee})
+		remaining -= amount
+	}
+
+	err := e.checkFunds(o, fills)
+	if err != nil {
+		return err
+	}
+
+	for i := range fills {
+		levels[fills[i].level].Amount -= fills[i].amount
+		e.fill(o, &fills[i], isMaker)
+	}
+	return nil
+}
+
+// checkFunds returns an error if the balances cannot cover all of the fills,
+// the fee is charged in the quote currency. The caller must hold the lock
+func (e *Exchange) checkFunds(o *paperOrder, fills []paperFill) error {
+	var amount, value, fee float64
+	for i := range fills {
+		amount += fills[i].amount
+		value += fills[i].price * fills[i].amount
+		fee += fills[i].fee
+	}
+
+	if o.side == exchange.Buy {
+		if e.balances[o.pair.SecondCurrency.Upper().String()] < value+fee {
+			return errInsufficientBalance
+		}
+		return nil
+	}
+
+	if e.balances[o.pair.FirstCurrency.Upper().String()] < amount {
+		return errInsufficientBalance
+	}
+	return nil
+}
+
+// fill applies a checked fill to the balances and the order. The caller must
+// hold the lock
+func (e *Exchange) fill(o *paperOrder, f *paperFill, isMaker bool) {
+	base := o.pair.FirstCurrency.Upper().String()
+	quote := o.pair.SecondCurrency.Upper().String()
+	value := f.price * f.amount
+
+	if o.side == exchange.Buy {
+		e.balances[quote] -= value + f.fee
+		e.balances[base] += f.amount
+	} else {
+		e.balances[base] -= f.amount
+		e.balances[quote] += value - f.fee
+	}
+
+	o.detail.OpenVolume -= f.amount
+	o.detail.ExecutedAmount += f.amount
+	if o.detail.OpenVolume <= 0 {
+		o.detail.OpenVolume = 0
+		o.detail.Status = string(orders.Filled)
+	} else {
+		o.detail.Status = string(orders.PartiallyFilled)
+	}
+
+	e.trades = append(e.trades, Trade{
+		Time:     time.Now(),
+		OrderID:  o.detail.ID,
+		Pair:     o.pair,
+		Side:     o.side,
+		Price:    f.price,
+		Amount:   f.amount,
+		Fee:      f.fee,
+		IsMaker:  isMaker,
+		ClientID: o.clientID,
+	})
+}
+
+// calculateFee returns the fee for a fill using the wrapped exchanges
+// GetFeeByType. The fee rate is cached per pair for maker and taker fills, so
+// fee models which query the account are not called for every fill
+func (e *Exchange) calculateFee(p pair.CurrencyPair, isMaker bool, price, amount float64) (float64, error) {
+	fees, ok := e.IBotExchange.(exchange.FeeModel)
+	if !ok {
+		return 0, nil
+	}
+
+	fee, err := e.feeRates.Fee(fees, e.GetName(), p, isMaker, price, amount)
+	if err != nil {
+		return 0, fmt.Errorf("%s fee model error: %s", e.GetName(), err)
+	}
+	return fee, nil
+}
+
+// reserved returns the amount of a currency reserved by open orders. The
+// caller must hold the lock
+func (e *Exchange) reserved(currency string) float64 {
+	var total float64
+	for _, o := range e.orders {
+		if !orders.Status(o.detail.Status).IsOpen() {
+			continue
+		}
+		c, amount := requiredFunds(o.pair, o.side, o.detail.OpenVolume, o.detail.Price)
+		if c == currency {
+			total += amount
+		}
+	}
+	return total
+}
+
+// getOrder returns an order by ID, the caller must hold the lock
+func (e *Exchange) getOrder(orderID string) *paperOrder {
+	for _, o := range e.orders {
+		if o.detail.ID == orderID {
+			return o
+		}
+	}
+	return nil
+}
+
+func (e *Exchange) getOrders(getOrdersRequest exchange.GetOrdersRequest, open bool) []exchange.OrderDetail {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	var details []exchange.OrderDetail
+	for _, o := range e.orders {
+		if open && !orders.Status(o.detail.Status).IsOpen() {
+			continue
+		}
+		details = append(details, o.detail)
+	}
+	return exchange.FilterOrders(details, getOrdersRequest)
+}
+
+// bookSide returns a copy of the orderbook levels an order on the side fills
+// against, so consumed liquidity can be tracked without modifying the book
+func bookSide(ob orderbook.Base, side exchange.OrderSide) []orderbook.Item {
+	if side == exchange.Sell {
+		return append([]orderbook.Item(nil), ob.Bids...)
+	}
+	return append([]orderbook.Item(nil), ob.Asks...)
+}
+
+// requiredFunds returns the currency and amount required to place an order
+func requiredFunds(p pair.CurrencyPair, side exchange.OrderSide, amount, price float64) (string, float64) {
+	if side == exchange.Buy {
+		return p.SecondCurrency.Upper().String(), amount * price
+	}
+	return p.FirstCurrency.Upper().String(), amount
+}
+
+// worstFillPrice returns the price of the deepest orderbook level required to
+// fill a market order, used to reserve funds
+func worstFillPrice(ob orderbook.Base, side exchange.OrderSide, amount float64) (float64, error) {
+	fill, err := ob.SimulateBuy(amount)
+	if side == exchange.Sell {
+		fill, err = ob.SimulateSell(amount)
+	}
+
+	if err != nil {
+		return 0, errNoLiquidity
+	}
+	return fill.WorstPrice, nil
+}
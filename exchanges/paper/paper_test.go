@@ -0,0 +1,261 @@
+package paper
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+var testPair = pair.NewCurrencyPair("BTC", "USD")
+
+// liveExchange mimics the market data, fee and order validation methods of a
+// live exchange, calling any other method panics so the tests detect requests
+// leaking through to the live exchange
+type liveExchange struct {
+	exchange.IBotExchange
+	ob orderbook.Base
+}
+
+func (l *liveExchange) GetName() string {
+	return "Live"
+}
+
+func (l *liveExchange) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+	return l.ob, nil
+}
+
+func (l *liveExchange) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+	return l.ob, nil
+}
+
+func (l *liveExchange) ValidateOrder(order *exchange.SubmitOrder) error {
+	return order.Validate()
+}
+
+func (l *liveExchange) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
+	if feeBuilder.IsMaker {
+		return 0, nil
+	}
+	return feeBuilder.PurchasePrice * feeBuilder.Amount * 0.01, nil
+}
+
+func newTestExchange() (*Exchange, *liveExchange) {
+	live := &liveExchange{
+		ob: orderbook.Base{
+			Pair: testPair,
+			Bids: []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+			Asks: []orderbook.Item{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}},
+		},
+	}
+	return New(live, map[string]float64{"usd": 1000}), live
+}
+
+func balance(t *testing.T, e *Exchange, currency string) (float64, float64) {
+	info, err := e.GetAccountInfo(context.Background())
+	if err != nil {
+		t.Fatal("Test failed. GetAccountInfo error", err)
+	}
+	for _, c := range info.Currencies {
+		if c.CurrencyName == currency {
+			return c.TotalValue, c.Hold
+		}
+	}
+	return 0, 0
+}
+
+func TestMarketOrder(t *testing.T) {
+	e, _ := newTestExchange()
+
+	resp, err := e.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1.5})
+	if err != nil || !resp.IsOrderPlaced {
+		t.Fatal("Test failed. SubmitOrder error", err)
+	}
+
+	if resp.FilledAmount != 1.5 || math.Abs(resp.AveragePrice-150.5/1.5) > 1e-9 ||
+		math.Abs(resp.Fee-1.505) > 1e-9 || resp.FeeCurrency != "USD" {
+		t.Errorf("Test failed. Unexpected fill in response %+v", resp)
+	}
+
+	// walks the asks, 1 at 100 and 0.5 at 101 with a 1% taker fee
+	usd, _ := balance(t, e, "USD")
+	if math.Abs(usd-(1000-150.5*1.01)) > 1e-9 {
+		t.Errorf("Test failed. Unexpected USD balance %f", usd)
+	}
+
+	btc, _ := balance(t, e, "BTC")
+	if btc != 1.5 {
+		t.Errorf("Test failed. Expected 1.5 BTC, received %f", btc)
+	}
+
+	if len(e.GetTrades()) != 2 {
+		t.Errorf("Test failed. Expected 2 fills, received %d", len(e.GetTrades()))
+	}
+
+	_, err = e.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Sell, OrderType: exchange.Market, Amount: 2})
+	if err != errInsufficientBalance {
+		t.Errorf("Test failed. Expected %s, received %v", errInsufficientBalance, err)
+	}
+
+	// only 2 BTC of bids are available, the remainder is cancelled
+	e.balances["BTC"] = 3
+	resp, err = e.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Sell, OrderType: exchange.Market, Amount: 3})
+	if err != nil {
+		t.Fatal("Test failed. SubmitOrder error", err)
+	}
+
+	details, err := e.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil || len(details) != 2 {
+		t.Fatalf("Test failed. Expected 2 orders, received %d %v", len(details), err)
+	}
+
+	if details[1].Status != string(orders.Cancelled) || details[1].OpenVolume != 1 {
+		t.Errorf("Test failed. Unexpected order %v", details[1])
+	}
+}
+
+func TestLimitOrder(t *testing.T) {
+	e, live := newTestExchange()
+
+	resp, err := e.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 2, Price: 95})
+	if err != nil {
+		t.Fatal("Test failed. SubmitOrder error", err)
+	}
+
+	usd, hold := balance(t, e, "USD")
+	if usd != 810 || hold != 190 {
+		t.Errorf("Test failed. Unexpected USD balance %f hold %f", usd, hold)
+	}
+
+	active, _ := e.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if len(active) != 1 {
+		t.Fatalf("Test failed. Expected 1 active order, received %d", len(active))
+	}
+
+	// the ask drops to the limit price, filling half of the order as a maker
+	live.ob.Asks = []orderbook.Item{{Price: 95, Amount: 1}, {Price: 96, Amount: 5}}
+	_, err = e.UpdateOrderbook(context.Background(), testPair, ticker.Spot)
+	if err != nil {
+		t.Fatal("Test failed. UpdateOrderbook error", err)
+	}
+
+	detail, err := e.GetOrderInfo(context.Background(), resp.OrderID)
+	if err != nil {
+		t.Fatal("Test failed. GetOrderInfo error", err)
+	}
+
+	if detail.Status != string(orders.PartiallyFilled) || detail.OpenVolume != 1 {
+		t.Errorf("Test failed. Unexpected order %v", detail)
+	}
+
+	usd, hold = balance(t, e, "USD")
+	if usd != 810 || hold != 95 {
+		t.Errorf("Test failed. Unexpected USD balance %f hold %f", usd, hold)
+	}
+
+	err = e.CancelOrder(context.Background(), exchange.OrderCancellation{OrderID: resp.OrderID})
+	if err != nil {
+		t.Error("Test failed. CancelOrder error", err)
+	}
+
+	err = e.CancelOrder(context.Background(), exchange.OrderCancellation{OrderID: resp.OrderID})
+	if err != errOrderNotFound {
+		t.Errorf("Test failed. Expected %s, received %v", errOrderNotFound, err)
+	}
+
+	_, err = e.GetOrderInfo(context.Background(), "1337")
+	if err != errOrderNotFound {
+		t.Errorf("Test failed. Expected %s, received %v", errOrderNotFound, err)
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	e, _ := newTestExchange()
+
+	_, err := e.WithdrawCryptocurrencyFunds(context.Background(), "address", "BTC", 1)
+	if err != errNotSupported {
+		t.Errorf("Test failed. Expected %s, received %v", errNotSupported, err)
+	}
+
+	_, err = e.WithdrawFiatFunds(context.Background(), "USD", 1)
+	if err != errNotSupported {
+		t.Errorf("Test failed. Expected %s, received %v", errNotSupported, err)
+	}
+
+	_, err = e.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1})
+	if err == nil {
+		t.Error("Test failed. Expected an error for a zero limit price")
+	}
+
+	if !e.IsPaperTrading() {
+		t.Error("Test failed. Expected paper trading")
+	}
+}
+
+func TestRestingOrdersShareLiquidity(t *testing.T) {
+	e, live := newTestExchange()
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		resp, err := e.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Buy, OrderType: exchange.Limit, Amount: 1, Price: 97})
+		if err != nil {
+			t.Fatal("Test failed. SubmitOrder error", err)
+		}
+		ids = append(ids, resp.OrderID)
+	}
+
+	// a single ask below both limits only has liquidity for the first order,
+	// which fills at its own limit price as a maker
+	live.ob.Asks = []orderbook.Item{{Price: 96, Amount: 1}}
+	_, err := e.UpdateOrderbook(context.Background(), testPair, ticker.Spot)
+	if err != nil {
+		t.Fatal("Test failed. UpdateOrderbook error", err)
+	}
+
+	first, _ := e.GetOrderInfo(context.Background(), ids[0])
+	second, _ := e.GetOrderInfo(context.Background(), ids[1])
+	if first.Status != string(orders.Filled) || second.Status != string(orders.New) {
+		t.Errorf("Test failed. Unexpected order statuses %s and %s", first.Status,
+			second.Status)
+	}
+
+	trades := e.GetTrades()
+	if len(trades) != 1 || trades[0].Price != 97 || !trades[0].IsMaker {
+		t.Errorf("Test failed. Unexpected fills %+v", trades)
+	}
+
+	// the second order keeps 97 USD on hold
+	usd, hold := balance(t, e, "USD")
+	if usd != 806 || hold != 97 {
+		t.Errorf("Test failed. Unexpected USD balance %f hold %f", usd, hold)
+	}
+
+	// the untouched book level is not consumed by the simulation
+	if live.ob.Asks[0].Amount != 1 {
+		t.Error("Test failed. Expected the live orderbook to be unchanged")
+	}
+}
+
+func TestMatchInsufficientFunds(t *testing.T) {
+	e, _ := newTestExchange()
+	e.balances["USD"] = 201
+
+	// the funds cover the walk across both asks but not the taker fee, no
+	// partial fill is applied
+	_, err := e.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: testPair, Side: exchange.Buy, OrderType: exchange.Market, Amount: 2})
+	if err != errInsufficientBalance {
+		t.Errorf("Test failed. Expected %s, received %v", errInsufficientBalance, err)
+	}
+
+	usd, _ := balance(t, e, "USD")
+	btc, _ := balance(t, e, "BTC")
+	if usd != 201 || btc != 0 || len(e.GetTrades()) != 0 {
+		t.Errorf("Test failed. Expected balances unchanged, received USD %f BTC %f",
+			usd, btc)
+	}
+}
@@ -2,6 +2,7 @@ package poloniex
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -48,9 +49,14 @@ const (
 	poloniexActiveLoans          = "returnActiveLoans"
 	poloniexLendingHistory       = "returnLendingHistory"
 	poloniexAutoRenew            = "toggleAutoRenew"
+	poloniexDateLayout           = "2006-01-02 15:04:05"
 
 	poloniexAuthRate   = 6
 	poloniexUnauthRate = 6
+
+	// poloniexMaxChartDataLimit is the amount of chart data periods requested
+	// at a time
+	poloniexMaxChartDataLimit = 1000
 )
 
 // Poloniex is the overarching type across the poloniex package
@@ -131,7 +137,7 @@ func (p *Poloniex) Setup(exch config.ExchangeConfig) {
 }
 
 // GetTicker returns current ticker information
-func (p *Poloniex) GetTicker() (map[string]Ticker, error) {
+func (p *Poloniex) GetTicker(ctx context.Context) (map[string]Ticker, error) {
 	type response struct {
 		Data map[string]Ticker
 	}
@@ -139,19 +145,19 @@ func (p *Poloniex) GetTicker() (map[string]Ticker, error) {
 	resp := response{}
 	path := fmt.Sprintf("%s/public?command=returnTicker", p.APIUrl)
 
-	return resp.Data, p.SendHTTPRequest(path, &resp.Data)
+	return resp.Data, p.SendHTTPRequest(ctx, path, &resp.Data)
 }
 
 // GetVolume returns a list of currencies with associated volume
-func (p *Poloniex) GetVolume() (interface{}, error) {
+func (p *Poloniex) GetVolume(ctx context.Context) (interface{}, error) {
 	var resp interface{}
 	path := fmt.Sprintf("%s/public?command=return24hVolume", p.APIUrl)
 
-	return resp, p.SendHTTPRequest(path, &resp)
+	return resp, p.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetOrderbook returns the full orderbook from poloniex
-func (p *Poloniex) GetOrderbook(currencyPair string, depth int) (OrderbookAll, error) {
+func (p *Poloniex) GetOrderbook(ctx context.Context, currencyPair string, depth int) (OrderbookAll, error) {
 	vals := url.Values{}
 
 	if depth != 0 {
@@ -163,7 +169,7 @@ func (p *Poloniex) GetOrderbook(currencyPair string, depth int) (OrderbookAll, e
 		vals.Set("currencyPair", currencyPair)
 		resp := OrderbookResponse{}
 		path := fmt.Sprintf("%s/public?command=returnOrderBook&%s", p.APIUrl, vals.Encode())
-		err := p.SendHTTPRequest(path, &resp)
+		err := p.SendHTTPRequest(ctx, path, &resp)
 		if err != nil {
 			return oba, err
 		}
@@ -196,7 +202,7 @@ func (p *Poloniex) GetOrderbook(currencyPair string, depth int) (OrderbookAll, e
 		vals.Set("currencyPair", "all")
 		resp := OrderbookResponseAll{}
 		path := fmt.Sprintf("%s/public?command=returnOrderBook&%s", p.APIUrl, vals.Encode())
-		err := p.SendHTTPRequest(path, &resp.Data)
+		err := p.SendHTTPRequest(ctx, path, &resp.Data)
 		if err != nil {
 			return oba, err
 		}
@@ -228,7 +234,7 @@ func (p *Poloniex) GetOrderbook(currencyPair string, depth int) (OrderbookAll, e
 }
 
 // GetTradeHistory returns trades history from poloniex
-func (p *Poloniex) GetTradeHistory(currencyPair, start, end string) ([]TradeHistory, error) {
+func (p *Poloniex) GetTradeHistory(ctx context.Context, currencyPair, start, end string) ([]TradeHistory, error) {
 	vals := url.Values{}
 	vals.Set("currencyPair", currencyPair)
 
@@ -243,11 +249,11 @@ func (p *Poloniex) GetTradeHistory(currencyPair, start, end string) ([]TradeHist
 	resp := []TradeHistory{}
 	path := fmt.Sprintf("%s/public?command=returnTradeHistory&%s", p.APIUrl, vals.Encode())
 
-	return resp, p.SendHTTPRequest(path, &resp)
+	return resp, p.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetChartData returns chart data for a specific currency pair
-func (p *Poloniex) GetChartData(currencyPair, start, end, period string) ([]ChartData, error) {
+func (p *Poloniex) GetChartData(ctx context.Context, currencyPair, start, end, period string) ([]ChartData, error) {
 	vals := url.Values{}
 	vals.Set("currencyPair", currencyPair)
 
@@ -266,7 +272,7 @@ func (p *Poloniex) GetChartData(currencyPair, start, end, period string) ([]Char
 	resp := []ChartData{}
 	path := fmt.Sprintf("%s/public?command=returnChartData&%s", p.APIUrl, vals.Encode())
 
-	err := p.SendHTTPRequest(path, &resp)
+	err := p.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -275,20 +281,20 @@ func (p *Poloniex) GetChartData(currencyPair, start, end, period string) ([]Char
 }
 
 // GetCurrencies returns information about currencies
-func (p *Poloniex) GetCurrencies() (map[string]Currencies, error) {
+func (p *Poloniex) GetCurrencies(ctx context.Context) (map[string]Currencies, error) {
 	type Response struct {
 		Data map[string]Currencies
 	}
 	resp := Response{}
 	path := fmt.Sprintf("%s/public?command=returnCurrencies", p.APIUrl)
 
-	return resp.Data, p.SendHTTPRequest(path, &resp.Data)
+	return resp.Data, p.SendHTTPRequest(ctx, path, &resp.Data)
 }
 
 // GetExchangeCurrencies returns a list of currencies using the GetTicker API
 // as the GetExchangeCurrencies information doesn't return currency pair information
-func (p *Poloniex) GetExchangeCurrencies() ([]string, error) {
-	response, err := p.GetTicker()
+func (p *Poloniex) GetExchangeCurrencies(ctx context.Context) ([]string, error) {
+	response, err := p.GetTicker(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -303,17 +309,17 @@ func (p *Poloniex) GetExchangeCurrencies() ([]string, error) {
 
 // GetLoanOrders returns the list of loan offers and demands for a given
 // currency, specified by the "currency" GET parameter.
-func (p *Poloniex) GetLoanOrders(currency string) (LoanOrders, error) {
+func (p *Poloniex) GetLoanOrders(ctx context.Context, currency string) (LoanOrders, error) {
 	resp := LoanOrders{}
 	path := fmt.Sprintf("%s/public?command=returnLoanOrders&currency=%s", p.APIUrl, currency)
 
-	return resp, p.SendHTTPRequest(path, &resp)
+	return resp, p.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetBalances returns balances for your account.
-func (p *Poloniex) GetBalances() (Balance, error) {
+func (p *Poloniex) GetBalances(ctx context.Context) (Balance, error) {
 	var result interface{}
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexBalances, url.Values{}, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexBalances, url.Values{}, &result)
 
 	if err != nil {
 		return Balance{}, err
@@ -331,9 +337,9 @@ func (p *Poloniex) GetBalances() (Balance, error) {
 }
 
 // GetCompleteBalances returns complete balances from your account.
-func (p *Poloniex) GetCompleteBalances() (CompleteBalances, error) {
+func (p *Poloniex) GetCompleteBalances(ctx context.Context) (CompleteBalances, error) {
 	var result interface{}
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexBalancesComplete, url.Values{}, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexBalancesComplete, url.Values{}, &result)
 
 	if err != nil {
 		return CompleteBalances{}, err
@@ -356,10 +362,10 @@ func (p *Poloniex) GetCompleteBalances() (CompleteBalances, error) {
 }
 
 // GetDepositAddresses returns deposit addresses for all enabled cryptos.
-func (p *Poloniex) GetDepositAddresses() (DepositAddresses, error) {
+func (p *Poloniex) GetDepositAddresses(ctx context.Context) (DepositAddresses, error) {
 	var result interface{}
 	addresses := DepositAddresses{}
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexDepositAddresses, url.Values{}, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexDepositAddresses, url.Values{}, &result)
 
 	if err != nil {
 		return addresses, err
@@ -375,7 +381,7 @@ func (p *Poloniex) GetDepositAddresses() (DepositAddresses, error) {
 }
 
 // GenerateNewAddress generates a new address for a currency
-func (p *Poloniex) GenerateNewAddress(currency string) (string, error) {
+func (p *Poloniex) GenerateNewAddress(ctx context.Context, currency string) (string, error) {
 	type Response struct {
 		Success  int
 		Error    string
@@ -385,7 +391,7 @@ func (p *Poloniex) GenerateNewAddress(currency string) (string, error) {
 	values := url.Values{}
 	values.Set("currency", currency)
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexGenerateNewAddress, values, &resp)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexGenerateNewAddress, values, &resp)
 
 	if err != nil {
 		return "", err
@@ -399,7 +405,7 @@ func (p *Poloniex) GenerateNewAddress(currency string) (string, error) {
 }
 
 // GetDepositsWithdrawals returns a list of deposits and withdrawals
-func (p *Poloniex) GetDepositsWithdrawals(start, end string) (DepositsWithdrawals, error) {
+func (p *Poloniex) GetDepositsWithdrawals(ctx context.Context, start, end string) (DepositsWithdrawals, error) {
 	resp := DepositsWithdrawals{}
 	values := url.Values{}
 
@@ -415,7 +421,7 @@ func (p *Poloniex) GetDepositsWithdrawals(start, end string) (DepositsWithdrawal
 		values.Set("end", strconv.FormatInt(time.Now().Unix(), 10))
 	}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexDepositsWithdrawals, values, &resp)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexDepositsWithdrawals, values, &resp)
 
 	if err != nil {
 		return resp, err
@@ -425,14 +431,14 @@ func (p *Poloniex) GetDepositsWithdrawals(start, end string) (DepositsWithdrawal
 }
 
 // GetOpenOrders returns current unfilled opened orders
-func (p *Poloniex) GetOpenOrders(currency string) (interface{}, error) {
+func (p *Poloniex) GetOpenOrders(ctx context.Context, currency string) (interface{}, error) {
 	values := url.Values{}
 
 	if currency != "" {
 		values.Set("currencyPair", currency)
 		result := OpenOrdersResponse{}
 
-		err := p.SendAuthenticatedHTTPRequest("POST", poloniexOrders, values, &result.Data)
+		err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexOrders, values, &result.Data)
 		if err != nil {
 			return result, err
 		}
@@ -442,7 +448,7 @@ func (p *Poloniex) GetOpenOrders(currency string) (interface{}, error) {
 	values.Set("currencyPair", "all")
 	result := OpenOrdersResponseAll{}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexOrders, values, &result.Data)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexOrders, values, &result.Data)
 	if err != nil {
 		return result, err
 	}
@@ -451,7 +457,7 @@ func (p *Poloniex) GetOpenOrders(currency string) (interface{}, error) {
 }
 
 // GetAuthenticatedTradeHistory returns account trade history
-func (p *Poloniex) GetAuthenticatedTradeHistory(currency, start, end, limit string) (interface{}, error) {
+func (p *Poloniex) GetAuthenticatedTradeHistory(ctx context.Context, currency, start, end, limit string) (interface{}, error) {
 	values := url.Values{}
 
 	if start != "" {
@@ -470,7 +476,7 @@ func (p *Poloniex) GetAuthenticatedTradeHistory(currency, start, end, limit stri
 		values.Set("currencyPair", currency)
 		result := AuthenticatedTradeHistoryResponse{}
 
-		err := p.SendAuthenticatedHTTPRequest("POST", poloniexTradeHistory, values, &result.Data)
+		err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexTradeHistory, values, &result.Data)
 		if err != nil {
 			return result, err
 		}
@@ -480,7 +486,7 @@ func (p *Poloniex) GetAuthenticatedTradeHistory(currency, start, end, limit stri
 	values.Set("currencyPair", "all")
 	result := AuthenticatedTradeHistoryAll{}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexTradeHistory, values, &result.Data)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexTradeHistory, values, &result.Data)
 	if err != nil {
 		return result, err
 	}
@@ -489,7 +495,7 @@ func (p *Poloniex) GetAuthenticatedTradeHistory(currency, start, end, limit stri
 }
 
 // PlaceOrder places a new order on the exchange
-func (p *Poloniex) PlaceOrder(currency string, rate, amount float64, immediate, fillOrKill, buy bool) (OrderResponse, error) {
+func (p *Poloniex) PlaceOrder(ctx context.Context, currency string, rate, amount float64, immediate, fillOrKill, buy bool) (OrderResponse, error) {
 	result := OrderResponse{}
 	values := url.Values{}
 
@@ -512,7 +518,7 @@ func (p *Poloniex) PlaceOrder(currency string, rate, amount float64, immediate,
 		values.Set("fillOrKill", "1")
 	}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", orderType, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", orderType, values, &result)
 
 	if err != nil {
 		return result, err
@@ -522,12 +528,12 @@ func (p *Poloniex) PlaceOrder(currency string, rate, amount float64, immediate,
 }
 
 // CancelExistingOrder cancels and order by orderID
-func (p *Poloniex) CancelExistingOrder(orderID int64) (bool, error) {
+func (p *Poloniex) CancelExistingOrder(ctx context.Context, orderID int64) (bool, error) {
 	result := GenericResponse{}
 	values := url.Values{}
 	values.Set("orderNumber", strconv.FormatInt(orderID, 10))
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexOrderCancel, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexOrderCancel, values, &result)
 
 	if err != nil {
 		return false, err
@@ -541,7 +547,7 @@ func (p *Poloniex) CancelExistingOrder(orderID int64) (bool, error) {
 }
 
 // MoveOrder moves an order
-func (p *Poloniex) MoveOrder(orderID int64, rate, amount float64) (MoveOrderResponse, error) {
+func (p *Poloniex) MoveOrder(ctx context.Context, orderID int64, rate, amount float64) (MoveOrderResponse, error) {
 	result := MoveOrderResponse{}
 	values := url.Values{}
 	values.Set("orderNumber", strconv.FormatInt(orderID, 10))
@@ -551,7 +557,7 @@ func (p *Poloniex) MoveOrder(orderID int64, rate, amount float64) (MoveOrderResp
 		values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexOrderMove, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexOrderMove, values, &result)
 
 	if err != nil {
 		return result, err
@@ -565,7 +571,7 @@ func (p *Poloniex) MoveOrder(orderID int64, rate, amount float64) (MoveOrderResp
 }
 
 // Withdraw withdraws a currency to a specific delegated address
-func (p *Poloniex) Withdraw(currency, address string, amount float64) (bool, error) {
+func (p *Poloniex) Withdraw(ctx context.Context, currency, address string, amount float64) (bool, error) {
 	result := Withdraw{}
 	values := url.Values{}
 
@@ -573,7 +579,7 @@ func (p *Poloniex) Withdraw(currency, address string, amount float64) (bool, err
 	values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	values.Set("address", address)
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexWithdraw, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexWithdraw, values, &result)
 
 	if err != nil {
 		return false, err
@@ -587,20 +593,20 @@ func (p *Poloniex) Withdraw(currency, address string, amount float64) (bool, err
 }
 
 // GetFeeInfo returns fee information
-func (p *Poloniex) GetFeeInfo() (Fee, error) {
+func (p *Poloniex) GetFeeInfo(ctx context.Context) (Fee, error) {
 	result := Fee{}
 
-	return result, p.SendAuthenticatedHTTPRequest("POST", poloniexFeeInfo, url.Values{}, &result)
+	return result, p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexFeeInfo, url.Values{}, &result)
 }
 
 // GetTradableBalances returns tradable balances
-func (p *Poloniex) GetTradableBalances() (map[string]map[string]float64, error) {
+func (p *Poloniex) GetTradableBalances(ctx context.Context) (map[string]map[string]float64, error) {
 	type Response struct {
 		Data map[string]map[string]interface{}
 	}
 	result := Response{}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexTradableBalances, url.Values{}, &result.Data)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexTradableBalances, url.Values{}, &result.Data)
 
 	if err != nil {
 		return nil, err
@@ -619,7 +625,7 @@ func (p *Poloniex) GetTradableBalances() (map[string]map[string]float64, error)
 }
 
 // TransferBalance transfers balances between your accounts
-func (p *Poloniex) TransferBalance(currency, from, to string, amount float64) (bool, error) {
+func (p *Poloniex) TransferBalance(ctx context.Context, currency, from, to string, amount float64) (bool, error) {
 	values := url.Values{}
 	result := GenericResponse{}
 
@@ -628,7 +634,7 @@ func (p *Poloniex) TransferBalance(currency, from, to string, amount float64) (b
 	values.Set("fromAccount", from)
 	values.Set("toAccount", to)
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexTransferBalance, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexTransferBalance, values, &result)
 
 	if err != nil {
 		return false, err
@@ -642,9 +648,9 @@ func (p *Poloniex) TransferBalance(currency, from, to string, amount float64) (b
 }
 
 // GetMarginAccountSummary returns a summary on your margin accounts
-func (p *Poloniex) GetMarginAccountSummary() (Margin, error) {
+func (p *Poloniex) GetMarginAccountSummary(ctx context.Context) (Margin, error) {
 	result := Margin{}
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexMarginAccountSummary, url.Values{}, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexMarginAccountSummary, url.Values{}, &result)
 
 	if err != nil {
 		return result, err
@@ -654,7 +660,7 @@ func (p *Poloniex) GetMarginAccountSummary() (Margin, error) {
 }
 
 // PlaceMarginOrder places a margin order
-func (p *Poloniex) PlaceMarginOrder(currency string, rate, amount, lendingRate float64, buy bool) (OrderResponse, error) {
+func (p *Poloniex) PlaceMarginOrder(ctx context.Context, currency string, rate, amount, lendingRate float64, buy bool) (OrderResponse, error) {
 	result := OrderResponse{}
 	values := url.Values{}
 
@@ -673,7 +679,7 @@ func (p *Poloniex) PlaceMarginOrder(currency string, rate, amount, lendingRate f
 		values.Set("lendingRate", strconv.FormatFloat(lendingRate, 'f', -1, 64))
 	}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", orderType, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", orderType, values, &result)
 
 	if err != nil {
 		return result, err
@@ -683,14 +689,14 @@ func (p *Poloniex) PlaceMarginOrder(currency string, rate, amount, lendingRate f
 }
 
 // GetMarginPosition returns a position on a margin order
-func (p *Poloniex) GetMarginPosition(currency string) (interface{}, error) {
+func (p *Poloniex) GetMarginPosition(ctx context.Context, currency string) (interface{}, error) {
 	values := url.Values{}
 
 	if currency != "" && currency != "all" {
 		values.Set("currencyPair", currency)
 		result := MarginPosition{}
 
-		err := p.SendAuthenticatedHTTPRequest("POST", poloniexMarginPosition, values, &result)
+		err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexMarginPosition, values, &result)
 		if err != nil {
 			return result, err
 		}
@@ -704,7 +710,7 @@ func (p *Poloniex) GetMarginPosition(currency string) (interface{}, error) {
 	}
 	result := Response{}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexMarginPosition, values, &result.Data)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexMarginPosition, values, &result.Data)
 	if err != nil {
 		return result, err
 	}
@@ -713,12 +719,12 @@ func (p *Poloniex) GetMarginPosition(currency string) (interface{}, error) {
 }
 
 // CloseMarginPosition closes a current margin position
-func (p *Poloniex) CloseMarginPosition(currency string) (bool, error) {
+func (p *Poloniex) CloseMarginPosition(ctx context.Context, currency string) (bool, error) {
 	values := url.Values{}
 	values.Set("currencyPair", currency)
 	result := GenericResponse{}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexMarginPositionClose, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexMarginPositionClose, values, &result)
 
 	if err != nil {
 		return false, err
@@ -732,7 +738,7 @@ func (p *Poloniex) CloseMarginPosition(currency string) (bool, error) {
 }
 
 // CreateLoanOffer places a loan offer on the exchange
-func (p *Poloniex) CreateLoanOffer(currency string, amount, rate float64, duration int, autoRenew bool) (int64, error) {
+func (p *Poloniex) CreateLoanOffer(ctx context.Context, currency string, amount, rate float64, duration int, autoRenew bool) (int64, error) {
 	values := url.Values{}
 	values.Set("currency", currency)
 	values.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
@@ -754,7 +760,7 @@ func (p *Poloniex) CreateLoanOffer(currency string, amount, rate float64, durati
 
 	result := Response{}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexCreateLoanOffer, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexCreateLoanOffer, values, &result)
 
 	if err != nil {
 		return 0, err
@@ -768,12 +774,12 @@ func (p *Poloniex) CreateLoanOffer(currency string, amount, rate float64, durati
 }
 
 // CancelLoanOffer cancels a loan offer order
-func (p *Poloniex) CancelLoanOffer(orderNumber int64) (bool, error) {
+func (p *Poloniex) CancelLoanOffer(ctx context.Context, orderNumber int64) (bool, error) {
 	result := GenericResponse{}
 	values := url.Values{}
 	values.Set("orderID", strconv.FormatInt(orderNumber, 10))
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexCancelLoanOffer, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexCancelLoanOffer, values, &result)
 
 	if err != nil {
 		return false, err
@@ -787,13 +793,13 @@ func (p *Poloniex) CancelLoanOffer(orderNumber int64) (bool, error) {
 }
 
 // GetOpenLoanOffers returns all open loan offers
-func (p *Poloniex) GetOpenLoanOffers() (map[string][]LoanOffer, error) {
+func (p *Poloniex) GetOpenLoanOffers(ctx context.Context) (map[string][]LoanOffer, error) {
 	type Response struct {
 		Data map[string][]LoanOffer
 	}
 	result := Response{}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexOpenLoanOffers, url.Values{}, &result.Data)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexOpenLoanOffers, url.Values{}, &result.Data)
 
 	if err != nil {
 		return nil, err
@@ -807,9 +813,9 @@ func (p *Poloniex) GetOpenLoanOffers() (map[string][]LoanOffer, error) {
 }
 
 // GetActiveLoans returns active loans
-func (p *Poloniex) GetActiveLoans() (ActiveLoans, error) {
+func (p *Poloniex) GetActiveLoans(ctx context.Context) (ActiveLoans, error) {
 	result := ActiveLoans{}
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexActiveLoans, url.Values{}, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexActiveLoans, url.Values{}, &result)
 
 	if err != nil {
 		return result, err
@@ -819,7 +825,7 @@ func (p *Poloniex) GetActiveLoans() (ActiveLoans, error) {
 }
 
 // GetLendingHistory returns lending history for the account
-func (p *Poloniex) GetLendingHistory(start, end string) ([]LendingHistory, error) {
+func (p *Poloniex) GetLendingHistory(ctx context.Context, start, end string) ([]LendingHistory, error) {
 	vals := url.Values{}
 
 	if start != "" {
@@ -831,7 +837,7 @@ func (p *Poloniex) GetLendingHistory(start, end string) ([]LendingHistory, error
 	}
 
 	resp := []LendingHistory{}
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexLendingHistory, vals, &resp)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexLendingHistory, vals, &resp)
 
 	if err != nil {
 		return nil, err
@@ -840,12 +846,12 @@ func (p *Poloniex) GetLendingHistory(start, end string) ([]LendingHistory, error
 }
 
 // ToggleAutoRenew allows for the autorenew of a contract
-func (p *Poloniex) ToggleAutoRenew(orderNumber int64) (bool, error) {
+func (p *Poloniex) ToggleAutoRenew(ctx context.Context, orderNumber int64) (bool, error) {
 	values := url.Values{}
 	values.Set("orderNumber", strconv.FormatInt(orderNumber, 10))
 	result := GenericResponse{}
 
-	err := p.SendAuthenticatedHTTPRequest("POST", poloniexAutoRenew, values, &result)
+	err := p.SendAuthenticatedHTTPRequest(ctx, "POST", poloniexAutoRenew, values, &result)
 
 	if err != nil {
 		return false, err
@@ -859,12 +865,12 @@ func (p *Poloniex) ToggleAutoRenew(orderNumber int64) (bool, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (p *Poloniex) SendHTTPRequest(path string, result interface{}) error {
-	return p.SendPayload("GET", path, nil, nil, result, false, p.Verbose)
+func (p *Poloniex) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return p.SendPayload(ctx, "GET", path, nil, nil, result, false, p.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request
-func (p *Poloniex) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Values, result interface{}) error {
+func (p *Poloniex) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, result interface{}) error {
 	if !p.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, p.Name)
 	}
@@ -885,15 +891,15 @@ func (p *Poloniex) SendAuthenticatedHTTPRequest(method, endpoint string, values
 
 	path := fmt.Sprintf("%s/%s", p.APIUrl, poloniexAPITradingEndpoint)
 
-	return p.SendPayload(method, path, headers, bytes.NewBufferString(values.Encode()), result, true, p.Verbose)
+	return p.SendPayload(ctx, method, path, headers, bytes.NewBufferString(values.Encode()), result, true, p.Verbose)
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (p *Poloniex) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (p *Poloniex) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		feeInfo, err := p.GetFeeInfo()
+		feeInfo, err := p.GetFeeInfo(ctx)
 		if err != nil {
 			return 0, err
 		}
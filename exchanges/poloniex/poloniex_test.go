@@ -1,12 +1,14 @@
 package poloniex
 
 import (
+	"context"
 	"testing"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var p Poloniex
@@ -19,6 +21,12 @@ const (
 	canManipulateRealOrders = false
 )
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	p.SetDefaults()
 }
@@ -34,54 +42,60 @@ func TestSetup(t *testing.T) {
 	poloniexConfig.AuthenticatedAPISupport = true
 	poloniexConfig.APIKey = apiKey
 	poloniexConfig.APISecret = apiSecret
+	if !request.IsRecordingFixtures() {
+		// Credentials are redacted from the fixture so any keys will replay
+		// the authenticated requests
+		poloniexConfig.APIKey = request.ReplayAPIKey
+		poloniexConfig.APISecret = request.ReplayAPISecret
+	}
 
 	p.Setup(poloniexConfig)
 }
 
 func TestGetTicker(t *testing.T) {
-	_, err := p.GetTicker()
+	_, err := p.GetTicker(context.Background())
 	if err != nil {
 		t.Error("Test faild - Poloniex GetTicker() error")
 	}
 }
 
 func TestGetVolume(t *testing.T) {
-	_, err := p.GetVolume()
+	_, err := p.GetVolume(context.Background())
 	if err != nil {
 		t.Error("Test faild - Poloniex GetVolume() error")
 	}
 }
 
 func TestGetOrderbook(t *testing.T) {
-	_, err := p.GetOrderbook("BTC_XMR", 50)
+	_, err := p.GetOrderbook(context.Background(), "BTC_XMR", 50)
 	if err != nil {
 		t.Error("Test faild - Poloniex GetOrderbook() error", err)
 	}
 }
 
 func TestGetTradeHistory(t *testing.T) {
-	_, err := p.GetTradeHistory("BTC_XMR", "", "")
+	_, err := p.GetTradeHistory(context.Background(), "BTC_XMR", "", "")
 	if err != nil {
 		t.Error("Test faild - Poloniex GetTradeHistory() error", err)
 	}
 }
 
 func TestGetChartData(t *testing.T) {
-	_, err := p.GetChartData("BTC_XMR", "1405699200", "1405699400", "300")
+	_, err := p.GetChartData(context.Background(), "BTC_XMR", "1405699200", "1405699400", "300")
 	if err != nil {
 		t.Error("Test faild - Poloniex GetChartData() error", err)
 	}
 }
 
 func TestGetCurrencies(t *testing.T) {
-	_, err := p.GetCurrencies()
+	_, err := p.GetCurrencies(context.Background())
 	if err != nil {
 		t.Error("Test faild - Poloniex GetCurrencies() error", err)
 	}
 }
 
 func TestGetLoanOrders(t *testing.T) {
-	_, err := p.GetLoanOrders("BTC")
+	_, err := p.GetLoanOrders(context.Background(), "BTC")
 	if err != nil {
 		t.Error("Test faild - Poloniex GetLoanOrders() error", err)
 	}
@@ -106,9 +120,9 @@ func TestGetFee(t *testing.T) {
 	TestSetup(t)
 	var feeBuilder = setFeeBuilder()
 
-	if apiKey != "" && apiSecret != "" {
+	if p.APIKey != "" && p.APISecret != "" {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := p.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 			t.Error(err)
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.0015), resp)
 		}
@@ -117,7 +131,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := p.GetFee(feeBuilder); resp != float64(2000) || err != nil {
+		if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(2000) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(2000), resp)
 			t.Error(err)
 		}
@@ -125,7 +139,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := p.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+		if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
 			t.Error(err)
 		}
@@ -133,7 +147,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -141,7 +155,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -150,7 +164,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FirstCurrency = "hello"
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -158,7 +172,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -166,7 +180,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -175,12 +189,48 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.USD
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
 }
 
+func TestGetAccountInfo(t *testing.T) {
+	p.SetDefaults()
+	TestSetup(t)
+	if p.APIKey == "" || p.APISecret == "" {
+		t.Skip()
+	}
+
+	info, err := p.GetAccountInfo(context.Background())
+	if err != nil {
+		t.Error("Test Failed - Poloniex GetAccountInfo() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && len(info.Currencies) != 3 {
+		t.Errorf("Test Failed - Poloniex GetAccountInfo() expected 3 currencies, received %d",
+			len(info.Currencies))
+	}
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	p.SetDefaults()
+	TestSetup(t)
+	if p.APIKey == "" || p.APISecret == "" {
+		t.Skip()
+	}
+
+	orders, err := p.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{})
+	if err != nil {
+		t.Error("Test Failed - Poloniex GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "120466" ||
+		orders[0].BaseCurrency != symbol.BTC || orders[0].Price != 0.025) {
+		t.Errorf("Test Failed - Poloniex GetActiveOrders() unexpected orders %v", orders)
+	}
+}
+
 func TestFormatWithdrawPermissions(t *testing.T) {
 	// Arrange
 	p.SetDefaults()
@@ -193,6 +243,34 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+func TestFillResponse(t *testing.T) {
+	btcltc := pair.NewCurrencyPairDelimiter("BTC_LTC", "_")
+	order := OrderResponse{Fee: 0.25, Trades: []ResultingTrades{
+		{Amount: 1, Rate: 0.5, Total: 0.5, Date: "2018-10-16 10:00:00"},
+		{Amount: 3, Rate: 1, Total: 3, Date: "2018-10-16 10:00:00"},
+	}}
+
+	var response exchange.SubmitOrderResponse
+	fillResponse(&response, &order, btcltc, exchange.Buy)
+	if response.FilledAmount != 4 || response.AveragePrice != 0.875 ||
+		response.Fee != 1 || response.FeeCurrency != "LTC" ||
+		response.OrderDate.IsZero() {
+		t.Errorf("Test Failed - fillResponse() unexpected buy response %+v", response)
+	}
+
+	response = exchange.SubmitOrderResponse{}
+	fillResponse(&response, &order, btcltc, exchange.Sell)
+	if response.Fee != 0.875 || response.FeeCurrency != "BTC" {
+		t.Errorf("Test Failed - fillResponse() unexpected sell response %+v", response)
+	}
+
+	response = exchange.SubmitOrderResponse{}
+	fillResponse(&response, &OrderResponse{Fee: 0.25}, btcltc, exchange.Buy)
+	if response.FilledAmount != 0 || response.Fee != 0 || !response.OrderDate.IsZero() {
+		t.Errorf("Test Failed - fillResponse() unexpected unfilled response %+v", response)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -218,7 +296,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.LTC,
 	}
-	response, err := p.SubmitOrder(pair, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := p.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: pair, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -244,7 +322,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := p.CancelOrder(orderCancellation)
+	err := p.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
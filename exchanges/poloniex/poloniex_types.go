@@ -200,6 +200,8 @@ type ResultingTrades struct {
 type OrderResponse struct {
 	OrderNumber int64             `json:"orderNumber,string"`
 	Trades      []ResultingTrades `json:"resultingTrades"`
+	// Fee is the fee rate charged on the resulting trades
+	Fee float64 `json:"fee,string"`
 }
 
 // GenericResponse is a response type for exchange generic responses
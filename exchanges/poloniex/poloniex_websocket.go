@@ -182,7 +182,8 @@ func (p *Poloniex) WsHandleData() {
 						p.Websocket.DataHandler <- exchange.WebsocketOrderbookUpdate{
 							Exchange: p.GetName(),
 							Asset:    "SPOT",
-							// Pair: pair.NewCurrencyPairFromString(currencyPair),
+							Pair: pair.NewCurrencyPairFromString(
+								CurrencyPairID[int64(check[0].(float64))]),
 						}
 
 					case map[string]interface{}:
@@ -214,25 +215,28 @@ func (p *Poloniex) WsHandleData() {
 					tickerData := check[2].([]interface{})
 					var ticker WsTicker
 
-					ticker.LastPrice, _ = tickerData[0].(float64)
-					// ticker.LowestAsk, _ = strconv.ParseFloat(tickerData[1].(string), 64)
-					ticker.HighestBid, _ = strconv.ParseFloat(tickerData[2].(string), 64)
-					ticker.PercentageChange, _ = strconv.ParseFloat(tickerData[3].(string), 64)
-					ticker.BaseCurrencyVolume24H, _ = strconv.ParseFloat(tickerData[4].(string), 64)
-					ticker.QuoteCurrencyVolume24H, _ = strconv.ParseFloat(tickerData[5].(string), 64)
-					frozen, _ := strconv.ParseInt(tickerData[6].(string), 10, 64)
-					if frozen == 1 {
-						ticker.IsFrozen = true
-					}
-					ticker.HighestTradeIn24H, _ = tickerData[7].(float64)
-					ticker.LowestTradePrice24H, _ = strconv.ParseFloat(tickerData[8].(string), 64)
+					currencyPair := CurrencyPairID[int64(tickerData[0].(float64))]
+					ticker.LastPrice = wsFloat(tickerData[1])
+					ticker.LowestAsk = wsFloat(tickerData[2])
+					ticker.HighestBid = wsFloat(tickerData[3])
+					ticker.PercentageChange = wsFloat(tickerData[4])
+					ticker.BaseCurrencyVolume24H = wsFloat(tickerData[5])
+					ticker.QuoteCurrencyVolume24H = wsFloat(tickerData[6])
+					ticker.IsFrozen = wsFloat(tickerData[7]) == 1
+					ticker.HighestTradeIn24H = wsFloat(tickerData[8])
+					ticker.LowestTradePrice24H = wsFloat(tickerData[9])
 
 					p.Websocket.DataHandler <- exchange.TickerData{
-						Timestamp: time.Now(),
-						Exchange:  p.GetName(),
-						AssetType: "SPOT",
-						LowPrice:  ticker.LowestAsk,
-						HighPrice: ticker.HighestBid,
+						Timestamp:  time.Now(),
+						Exchange:   p.GetName(),
+						AssetType:  "SPOT",
+						Pair:       pair.NewCurrencyPairFromString(currencyPair),
+						ClosePrice: ticker.LastPrice,
+						Quantity:   ticker.QuoteCurrencyVolume24H,
+						HighPrice:  ticker.HighestTradeIn24H,
+						LowPrice:   ticker.LowestTradePrice24H,
+						BidPrice:   ticker.HighestBid,
+						AskPrice:   ticker.LowestAsk,
 					}
 
 				default:
@@ -245,22 +249,29 @@ func (p *Poloniex) WsHandleData() {
 								continue
 							}
 
-							var trade WsTrade
+							if data[0].(string) != "t" {
+								continue
+							}
 
-							id, _ := strconv.ParseInt(data[0].(string), 10, 64)
-							trade.Symbol = CurrencyPairID[id]
-							trade.TradeID, _ = data[0].(int64)
-							trade.Side, _ = data[0].(string)
-							trade.Volume, _ = data[0].(float64)
-							trade.Price, _ = data[0].(float64)
-							trade.Timestamp, _ = data[0].(int64)
+							var trade WsTrade
+							trade.Symbol = CurrencyPairID[int64(check[0].(float64))]
+							trade.TradeID, _ = strconv.ParseInt(data[1].(string), 10, 64)
+							trade.Side = "sell"
+							if wsFloat(data[2]) == 1 {
+								trade.Side = "buy"
+							}
+							trade.Price = wsFloat(data[3])
+							trade.Volume = wsFloat(data[4])
+							trade.Timestamp = int64(wsFloat(data[5]))
 
 							p.Websocket.DataHandler <- exchange.TradeData{
-								Timestamp: time.Unix(trade.Timestamp, 0),
-								// CurrencyPair: pair.NewCurrencyPairFromString(trade.Symbol),
-								Side:   trade.Side,
-								Amount: trade.Volume,
-								Price:  trade.Price,
+								Timestamp:    time.Unix(trade.Timestamp, 0),
+								CurrencyPair: pair.NewCurrencyPairFromString(trade.Symbol),
+								AssetType:    "SPOT",
+								Exchange:     p.GetName(),
+								Side:         trade.Side,
+								Amount:       trade.Volume,
+								Price:        trade.Price,
 							}
 						}
 					}
@@ -270,6 +281,19 @@ func (p *Poloniex) WsHandleData() {
 	}
 }
 
+// wsFloat returns the value of a websocket field sent as either a number or a
+// string
+func wsFloat(v interface{}) float64 {
+	switch f := v.(type) {
+	case float64:
+		return f
+	case string:
+		result, _ := strconv.ParseFloat(f, 64)
+		return result
+	}
+	return 0
+}
+
 // WsProcessOrderbookSnapshot processes a new orderbook snapshot into a local
 // of orderbooks
 func (p *Poloniex) WsProcessOrderbookSnapshot(ob []interface{}, symbol string) error {
@@ -1,10 +1,13 @@
 package poloniex
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -30,7 +33,7 @@ func (p *Poloniex) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", p.GetName(), len(p.EnabledPairs), p.EnabledPairs)
 	}
 
-	exchangeCurrencies, err := p.GetExchangeCurrencies()
+	exchangeCurrencies, err := p.GetExchangeCurrencies(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", p.GetName())
 	} else {
@@ -48,9 +51,9 @@ func (p *Poloniex) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (p *Poloniex) UpdateTicker(currencyPair pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (p *Poloniex) UpdateTicker(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
-	tick, err := p.GetTicker()
+	tick, err := p.GetTicker(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -71,27 +74,27 @@ func (p *Poloniex) UpdateTicker(currencyPair pair.CurrencyPair, assetType string
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (p *Poloniex) GetTickerPrice(currencyPair pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (p *Poloniex) GetTickerPrice(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(p.GetName(), currencyPair, assetType)
 	if err != nil {
-		return p.UpdateTicker(currencyPair, assetType)
+		return p.UpdateTicker(ctx, currencyPair, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (p *Poloniex) GetOrderbookEx(currencyPair pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (p *Poloniex) GetOrderbookEx(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(p.GetName(), currencyPair, assetType)
 	if err != nil {
-		return p.UpdateOrderbook(currencyPair, assetType)
+		return p.UpdateOrderbook(ctx, currencyPair, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (p *Poloniex) UpdateOrderbook(currencyPair pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (p *Poloniex) UpdateOrderbook(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := p.GetOrderbook("", 1000)
+	orderbookNew, err := p.GetOrderbook(ctx, "", 1000)
 	if err != nil {
 		return orderBook, err
 	}
@@ -124,10 +127,10 @@ func (p *Poloniex) UpdateOrderbook(currencyPair pair.CurrencyPair, assetType str
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // Poloniex exchange
-func (p *Poloniex) GetAccountInfo() (exchange.AccountInfo, error) {
+func (p *Poloniex) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = p.GetName()
-	accountBalance, err := p.GetBalances()
+	accountBalance, err := p.GetBalances(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -143,24 +146,28 @@ func (p *Poloniex) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (p *Poloniex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (p *Poloniex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (p *Poloniex) GetExchangeHistory(currencyPair pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (p *Poloniex) GetExchangeHistory(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (p *Poloniex) SubmitOrder(currencyPair pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (p *Poloniex) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	fillOrKill := orderType == exchange.Market
-	isBuyOrder := side == exchange.Buy
-	response, err := p.PlaceOrder(currencyPair.Pair().String(), price, amount, false, fillOrKill, isBuyOrder)
+	if err := p.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	fillOrKill := order.OrderType == exchange.Market
+	isBuyOrder := order.Side == exchange.Buy
+	response, err := p.PlaceOrder(ctx, order.Pair.Pair().String(), order.Price, order.Amount, false, fillOrKill, isBuyOrder)
 
 	if response.OrderNumber > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response.OrderNumber)
@@ -168,55 +175,110 @@ func (p *Poloniex) SubmitOrder(currencyPair pair.CurrencyPair, side exchange.Ord
 
 	if err == nil {
 		submitOrderResponse.IsOrderPlaced = true
+		fillResponse(&submitOrderResponse, &response, order.Pair, order.Side)
 	}
 
 	return submitOrderResponse, err
 }
 
+// fillResponse sets the filled amount, average price, fees and timestamp of a
+// placed order from the trades it filled immediately. Pairs are quoted as
+// quote_base and the fee is charged in the currency received
+func fillResponse(submitOrderResponse *exchange.SubmitOrderResponse, response *OrderResponse, currencyPair pair.CurrencyPair, side exchange.OrderSide) {
+	var total float64
+	for i := range response.Trades {
+		submitOrderResponse.FilledAmount += response.Trades[i].Amount
+		total += response.Trades[i].Total
+	}
+
+	if submitOrderResponse.FilledAmount == 0 {
+		return
+	}
+
+	submitOrderResponse.AveragePrice = total / submitOrderResponse.FilledAmount
+	submitOrderResponse.OrderDate, _ = time.Parse(poloniexDateLayout,
+		response.Trades[0].Date)
+
+	if side == exchange.Buy {
+		submitOrderResponse.Fee = submitOrderResponse.FilledAmount * response.Fee
+		submitOrderResponse.FeeCurrency = currencyPair.SecondCurrency.String()
+		return
+	}
+	submitOrderResponse.Fee = total * response.Fee
+	submitOrderResponse.FeeCurrency = currencyPair.FirstCurrency.String()
+}
+
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (p *Poloniex) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (p *Poloniex) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (p *Poloniex) CancelOrder(order exchange.OrderCancellation) error {
+func (p *Poloniex) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = p.CancelExistingOrder(orderIDInt)
+	_, err = p.CancelExistingOrder(ctx, orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (p *Poloniex) CancelAllOrders() error {
+func (p *Poloniex) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (p *Poloniex) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (p *Poloniex) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (p *Poloniex) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (p *Poloniex) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (p *Poloniex) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (p *Poloniex) SetLeverage(ctx context.Context, currencyPair pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (p *Poloniex) SetMarginMode(ctx context.Context, currencyPair pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (p *Poloniex) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (p *Poloniex) GetFundingRate(ctx context.Context, currencyPair pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (p *Poloniex) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (p *Poloniex) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (p *Poloniex) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (p *Poloniex) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -233,10 +295,156 @@ func (p *Poloniex) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (p *Poloniex) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return p.GetFee(feeBuilder)
+	return p.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (p *Poloniex) GetWithdrawCapabilities() uint32 {
 	return p.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (p *Poloniex) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	resp, err := p.GetOpenOrders(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := resp.(OpenOrdersResponseAll)
+	if !ok {
+		return nil, errors.New("unable to convert open orders response")
+	}
+
+	var orders []exchange.OrderDetail
+	for currencyPair, openOrders := range result.Data {
+		symbol := pair.NewCurrencyPairDelimiter(currencyPair, "_")
+		for i := range openOrders {
+			orderDate, err := time.Parse(poloniexDateLayout, openOrders[i].Date)
+			if err != nil {
+				log.Printf("%s unable to parse order time %s: %s", p.Name,
+					openOrders[i].Date, err)
+			}
+
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:      p.Name,
+				ID:            strconv.FormatInt(openOrders[i].OrderNumber, 10),
+				BaseCurrency:  symbol.FirstCurrency.String(),
+				QuoteCurrency: symbol.SecondCurrency.String(),
+				OrderSide:     openOrders[i].Type,
+				OrderType:     exchange.Limit.ToString(),
+				CreationTime:  orderDate.Unix(),
+				OrderDate:     orderDate,
+				Price:         openOrders[i].Rate,
+				Amount:        openOrders[i].Amount,
+				OpenVolume:    openOrders[i].Amount,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (p *Poloniex) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	var start, end string
+	if !getOrdersRequest.StartTicks.IsZero() {
+		start = strconv.FormatInt(getOrdersRequest.StartTicks.Unix(), 10)
+	}
+	if !getOrdersRequest.EndTicks.IsZero() {
+		end = strconv.FormatInt(getOrdersRequest.EndTicks.Unix(), 10)
+	}
+
+	resp, err := p.GetAuthenticatedTradeHistory(ctx, "all", start, end, "")
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := resp.(AuthenticatedTradeHistoryAll)
+	if !ok {
+		return nil, errors.New("unable to convert trade history response")
+	}
+
+	var orders []exchange.OrderDetail
+	for currencyPair, trades := range result.Data {
+		symbol := pair.NewCurrencyPairDelimiter(currencyPair, "_")
+		for i := range trades {
+			orderDate, err := time.Parse(poloniexDateLayout, trades[i].Date)
+			if err != nil {
+				log.Printf("%s unable to parse order time %s: %s", p.Name,
+					trades[i].Date, err)
+			}
+
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:      p.Name,
+				ID:            strconv.FormatInt(trades[i].OrderNumber, 10),
+				BaseCurrency:  symbol.FirstCurrency.String(),
+				QuoteCurrency: symbol.SecondCurrency.String(),
+				OrderSide:     trades[i].Type,
+				CreationTime:  orderDate.Unix(),
+				OrderDate:     orderDate,
+				Price:         trades[i].Rate,
+				Amount:        trades[i].Amount,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// poloniexCandleIntervals maps the supported candle intervals to Poloniex
+// chart data periods in seconds
+var poloniexCandleIntervals = map[exchange.CandleInterval]string{
+	exchange.FiveMin:    "300",
+	exchange.FifteenMin: "900",
+	exchange.ThirtyMin:  "1800",
+	exchange.TwoHour:    "7200",
+	exchange.FourHour:   "14400",
+	exchange.OneDay:     "86400",
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (p *Poloniex) GetHistoricCandles(ctx context.Context, currencyPair pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	period, err := exchange.ValidateCandleRequest(poloniexCandleIntervals,
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := exchange.FormatExchangeCurrency(p.Name, currencyPair).String()
+	return exchange.GetCandlesPaginated(interval, start, end, poloniexMaxChartDataLimit,
+		func(windowStart, windowEnd time.Time) ([]exchange.Candle, error) {
+			resp, err := p.GetChartData(ctx, symbol,
+				strconv.FormatInt(windowStart.Unix(), 10),
+				strconv.FormatInt(windowEnd.Unix(), 10),
+				period)
+			if err != nil {
+				return nil, err
+			}
+
+			var candles []exchange.Candle
+			for i := range resp {
+				if resp[i].Error != "" {
+					return nil, errors.New(resp[i].Error)
+				}
+
+				// A window without trades is returned as a single zero period
+				if resp[i].Date == 0 {
+					continue
+				}
+
+				candles = append(candles, exchange.Candle{
+					Time:   time.Unix(int64(resp[i].Date), 0),
+					Open:   resp[i].Open,
+					High:   resp[i].High,
+					Low:    resp[i].Low,
+					Close:  resp[i].Close,
+					Volume: resp[i].Volume,
+				})
+			}
+			return candles, nil
+		})
+}
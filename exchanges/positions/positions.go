@@ -0,0 +1,104 @@
+package positions
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+)
+
+// Vars for the positions package
+var (
+	// Positions holds the open positions of each exchange keyed by the upper
+	// case exchange name
+	Positions = make(map[string][]Position)
+
+	m sync.Mutex
+)
+
+// Position holds an open derivatives position valued at the latest price
+type Position struct {
+	exchange.Position
+	// Price is the mark price when the exchange reports one and the last
+	// traded price otherwise
+	Price float64 `json:"price"`
+	// LiquidationDistance is how far the price can move against the position
+	// before it is liquidated as a percentage of the price
+	LiquidationDistance float64 `json:"liquidationDistance"`
+}
+
+// PriceFunc returns the last traded price of a currency pair, zero when it is
+// not known
+type PriceFunc func(exchName string, p pair.CurrencyPair, assetType string) float64
+
+// value returns the position valued at the mark price or the last traded
+// price, unrealised profit and loss is calculated when the exchange does not
+// report it
+func value(position exchange.Position, lastPrice PriceFunc) Position {
+	tracked := Position{Position: position, Price: position.MarkPrice}
+	if tracked.Price <= 0 && lastPrice != nil {
+		tracked.Price = lastPrice(position.Exchange, position.Pair,
+			position.AssetType)
+	}
+
+	if tracked.UnrealisedPNL == 0 {
+		tracked.UnrealisedPNL = position.CalculateUnrealisedPNL(tracked.Price)
+	}
+	tracked.LiquidationDistance = position.LiquidationDistance(tracked.Price)
+	return tracked
+}
+
+// Update replaces the tracked positions of an exchange and returns them valued
+// at the latest price
+func Update(exchName string, open []exchange.Position, lastPrice PriceFunc) []Position {
+	tracked := make([]Position, 0, len(open))
+	for i := range open {
+		if open[i].Exchange == "" {
+			open[i].Exchange = exchName
+		}
+		tracked = append(tracked, value(open[i], lastPrice))
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if len(tracked) == 0 {
+		delete(Positions, common.StringToUpper(exchName))
+		return nil
+	}
+	Positions[common.StringToUpper(exchName)] = tracked
+	return tracked
+}
+
+// Remove stops tracking the positions of an exchange
+func Remove(exchName string) {
+	m.Lock()
+	defer m.Unlock()
+	delete(Positions, common.StringToUpper(exchName))
+}
+
+// GetPositionsByExchange returns the tracked positions of an exchange
+func GetPositionsByExchange(exchName string) []Position {
+	m.Lock()
+	defer m.Unlock()
+	return append([]Position(nil), Positions[common.StringToUpper(exchName)]...)
+}
+
+// GetPositions returns all tracked positions sorted by exchange
+func GetPositions() []Position {
+	m.Lock()
+	defer m.Unlock()
+
+	exchanges := make([]string, 0, len(Positions))
+	for k := range Positions {
+		exchanges = append(exchanges, k)
+	}
+	sort.Strings(exchanges)
+
+	var all []Position
+	for i := range exchanges {
+		all = append(all, Positions[exchanges[i]]...)
+	}
+	return all
+}
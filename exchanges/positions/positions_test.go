@@ -0,0 +1,67 @@
+package positions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+)
+
+var btcusd = pair.NewCurrencyPair("BTC", "USD")
+
+func lastPrice(exchName string, p pair.CurrencyPair, assetType string) float64 {
+	return 110
+}
+
+func TestUpdate(t *testing.T) {
+	tracked := Update("OKEX", []exchange.Position{
+		{Pair: btcusd, AssetType: "this_week", Side: exchange.Long, Amount: 2,
+			EntryPrice: 100, LiquidationPrice: 88},
+	}, lastPrice)
+
+	if len(tracked) != 1 || tracked[0].Exchange != "OKEX" || tracked[0].Price != 110 ||
+		tracked[0].UnrealisedPNL != 20 || tracked[0].LiquidationDistance != 20 {
+		t.Errorf("Test failed. Unexpected tracked positions %+v", tracked)
+	}
+
+	tracked = Update("Bitmex", []exchange.Position{
+		{Pair: btcusd, Side: exchange.Short, Amount: 100, EntryPrice: 6500,
+			MarkPrice: 6400, UnrealisedPNL: 0.00024},
+	}, lastPrice)
+
+	if tracked[0].Price != 6400 || tracked[0].UnrealisedPNL != 0.00024 ||
+		tracked[0].LiquidationDistance != 0 {
+		t.Errorf("Test failed. Expected reported values to be kept, received %+v",
+			tracked[0])
+	}
+
+	tracked = Update("OKEX", []exchange.Position{
+		{Pair: btcusd, AssetType: "quarter", Side: exchange.Long, Amount: 2,
+			EntryPrice: 100, ContractValue: 100, Inverse: true},
+	}, lastPrice)
+
+	if math.Abs(tracked[0].UnrealisedPNL-0.18181818181818182) > 1e-9 {
+		t.Errorf("Test failed. Expected inverse unrealised PNL in the base currency, received %f",
+			tracked[0].UnrealisedPNL)
+	}
+
+	all := GetPositions()
+	if len(all) != 2 || all[0].Exchange != "Bitmex" {
+		t.Errorf("Test failed. Unexpected positions %+v", all)
+	}
+
+	if len(GetPositionsByExchange("okex")) != 1 {
+		t.Error("Test failed. Expected positions regardless of exchange name case")
+	}
+
+	Update("OKEX", nil, lastPrice)
+	if len(GetPositionsByExchange("OKEX")) != 0 {
+		t.Error("Test failed. Expected closed positions to be removed")
+	}
+
+	Remove("Bitmex")
+	if len(GetPositions()) != 0 {
+		t.Error("Test failed. Expected no tracked positions")
+	}
+}
@@ -0,0 +1,60 @@
+package request
+
+import (
+	"log"
+	"os"
+	"testing"
+)
+
+// Placeholder credentials authenticated exchange tests are set up with when
+// replaying fixtures. The secret is base64 encoded for exchanges which decode
+// it
+const (
+	ReplayAPIKey    = "Key"
+	ReplayAPISecret = "U2VjcmV0"
+)
+
+// fixtures is the recorder of the package tests run by RunWithFixtures
+var fixtures *Recorder
+
+// RunWithFixtures runs the tests of an exchange package with every HTTP
+// request replayed from the fixture file, or recorded into it against the live
+// API when the RecordEnv environment variable is set, then exits with the
+// result of the tests
+func RunWithFixtures(m *testing.M, path string) {
+	r, err := NewTestRecorder(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fixtures = r
+	UseRecorder(r)
+
+	code := m.Run()
+
+	UseRecorder(nil)
+	err = r.Save()
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(code)
+}
+
+// IsRecordingFixtures returns whether the tests run by RunWithFixtures are
+// recording against the live API
+func IsRecordingFixtures() bool {
+	return fixtures != nil && fixtures.IsRecording()
+}
+
+// FixtureCredentials returns the API key and secret an authenticated test is
+// set up with. Credentials are redacted from fixtures so replaying uses the
+// placeholder credentials, recording without keys skips the test
+func FixtureCredentials(t testing.TB, key, secret string) (string, string) {
+	if !IsRecordingFixtures() {
+		return ReplayAPIKey, ReplayAPISecret
+	}
+
+	if key == "" || secret == "" {
+		t.Skip("API keys are required to record authenticated requests")
+	}
+	return key, secret
+}
@@ -0,0 +1,458 @@
+package request
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+// Recorder modes
+const (
+	RecordMode = "record"
+	ReplayMode = "replay"
+)
+
+// RecordEnv is the environment variable which switches exchange tests from
+// replaying their fixtures to recording new ones against the live API
+const RecordEnv = "GCT_RECORD"
+
+// Redacted replaces credentials, signatures and other per request values in
+// recorded requests
+const Redacted = "REDACTED"
+
+// redactedFields are matched case insensitively against header, query, form
+// and JSON field names. Nonces and timestamps are redacted as they change on
+// every request and would otherwise prevent a replayed request from matching
+var redactedFields = []string{
+	"key",
+	"sign",
+	"secret",
+	"passphrase",
+	"password",
+	"auth",
+	"token",
+	"nonce",
+	"timestamp",
+	"payload",
+}
+
+// redactedHeaders are credential headers whose names don't contain one of the
+// redactedFields, such as the client ID Coinut authenticates with. They are
+// matched case insensitively against header names only, so fields like
+// userId still match when replaying
+var redactedHeaders = []string{
+	"X-USER",
+}
+
+// ignoredHeaders are not recorded as they are set by the transport or hold
+// session state
+var ignoredHeaders = []string{
+	"Set-Cookie",
+	"Cookie",
+	"Date",
+	"Content-Length",
+}
+
+var (
+	errNoInteraction = errors.New("no recorded interaction matches the request")
+	errInvalidMode   = errors.New("invalid recorder mode")
+
+	recorder    *Recorder
+	recorderMtx sync.Mutex
+)
+
+// RecordedRequest is a redacted HTTP request
+type RecordedRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+// RecordedResponse is an HTTP response served back in replay mode
+type RecordedResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Fixture holds the recorded interactions of a test run
+type Fixture struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder captures HTTP requests and responses into a fixture file in record
+// mode and serves the recorded responses back in replay mode. Credentials and
+// signatures are redacted before requests are recorded or matched, so a
+// fixture recorded with real API keys replays with any keys
+type Recorder struct {
+	mode    string
+	path    string
+	fixture Fixture
+	// existing holds the interactions of the fixture file in record mode,
+	// which Save keeps unless they were recorded again
+	existing []Interaction
+	used     []bool
+	m        sync.Mutex
+}
+
+// NewRecorder returns a recorder for the fixture file. Replay mode loads the
+// fixture and record mode records new interactions, which Save merges into
+// the fixture file
+func NewRecorder(mode, path string) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path}
+	switch mode {
+	case RecordMode:
+		existing, err := loadFixture(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		r.existing = existing.Interactions
+	case ReplayMode:
+		var err error
+		r.fixture, err = loadFixture(path)
+		if err != nil {
+			return nil, err
+		}
+		r.used = make([]bool, len(r.fixture.Interactions))
+	default:
+		return nil, errInvalidMode
+	}
+	return r, nil
+}
+
+// loadFixture reads a fixture file
+func loadFixture(path string) (Fixture, error) {
+	var fixture Fixture
+	data, err := common.ReadFile(path)
+	if err != nil {
+		return fixture, err
+	}
+	return fixture, common.JSONDecode(data, &fixture)
+}
+
+// NewTestRecorder returns a recorder for exchange tests, replaying the
+// fixture unless the RecordEnv environment variable is set
+func NewTestRecorder(path string) (*Recorder, error) {
+	if os.Getenv(RecordEnv) != "" {
+		return NewRecorder(RecordMode, path)
+	}
+	return NewRecorder(ReplayMode, path)
+}
+
+// IsRecording returns whether the recorder is sending requests to the live
+// API
+func (r *Recorder) IsRecording() bool {
+	return r.mode == RecordMode
+}
+
+// Save merges the recorded interactions into the fixture file, interactions
+// of the file which were not recorded again are kept so a run which skips
+// tests, such as one without API keys, does not lose their fixtures. It does
+// nothing in replay mode or when nothing was recorded
+func (r *Recorder) Save() error {
+	if !r.IsRecording() {
+		return nil
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if len(r.fixture.Interactions) == 0 {
+		return nil
+	}
+
+	var merged Fixture
+	for x := range r.existing {
+		if !r.isRecorded(r.existing[x].Request) {
+			merged.Interactions = append(merged.Interactions, r.existing[x])
+		}
+	}
+	merged.Interactions = append(merged.Interactions, r.fixture.Interactions...)
+
+	data, err := json.MarshalIndent(merged, "", " ")
+	if err != nil {
+		return err
+	}
+
+	err = common.CheckDir(filepath.Dir(r.path), true)
+	if err != nil {
+		return err
+	}
+	return common.WriteFile(r.path, data)
+}
+
+// UseRecorder routes the requests of every Requester through the recorder,
+// a nil recorder sends requests directly again
+func UseRecorder(r *Recorder) {
+	recorderMtx.Lock()
+	recorder = r
+	recorderMtx.Unlock()
+}
+
+func getRecorder() *Recorder {
+	recorderMtx.Lock()
+	defer recorderMtx.Unlock()
+	return recorder
+}
+
+// getHTTPClient returns the HTTP client of the requester, sending requests
+// through the recorder when one is in use
+func (r *Requester) getHTTPClient() *http.Client {
+	rec := getRecorder()
+	if rec == nil {
+		return r.HTTPClient
+	}
+
+	client := *r.HTTPClient
+	client.Transport = &recorderTransport{recorder: rec, next: client.Transport}
+	return &client
+}
+
+// recorderTransport sends requests through the recorder
+type recorderTransport struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+// RoundTrip records or replays a request
+func (t *recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	recorded := redactRequest(req, body)
+
+	if !t.recorder.IsRecording() {
+		resp, err := t.recorder.replay(recorded)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %s", recorded.Method, recorded.URL, err)
+		}
+		return resp.toHTTPResponse(req), nil
+	}
+
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	t.recorder.record(Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    recordHeaders(resp.Header, false),
+			Body:       string(respBody),
+		},
+	})
+	return resp, nil
+}
+
+// record appends an interaction to the fixture
+func (r *Recorder) record(i Interaction) {
+	r.m.Lock()
+	r.fixture.Interactions = append(r.fixture.Interactions, i)
+	r.m.Unlock()
+}
+
+// isRecorded returns whether a request was recorded in this run
+func (r *Recorder) isRecorded(req RecordedRequest) bool {
+	for x := range r.fixture.Interactions {
+		if r.fixture.Interactions[x].Request.matches(req) {
+			return true
+		}
+	}
+	return false
+}
+
+// replay returns the response of the first unused interaction matching the
+// request. Once every matching interaction has been used the last one is
+// served again so polled endpoints can be replayed indefinitely
+func (r *Recorder) replay(req RecordedRequest) (RecordedResponse, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	last := -1
+	for x := range r.fixture.Interactions {
+		if !r.fixture.Interactions[x].Request.matches(req) {
+			continue
+		}
+		if !r.used[x] {
+			r.used[x] = true
+			return r.fixture.Interactions[x].Response, nil
+		}
+		last = x
+	}
+
+	if last == -1 {
+		return RecordedResponse{}, errNoInteraction
+	}
+	return r.fixture.Interactions[last].Response, nil
+}
+
+// matches returns whether two redacted requests are the same
+func (r RecordedRequest) matches(req RecordedRequest) bool {
+	return r.Method == req.Method && r.URL == req.URL && r.Body == req.Body
+}
+
+// toHTTPResponse converts the recorded response for the request
+func (r RecordedResponse) toHTTPResponse(req *http.Request) *http.Response {
+	header := make(http.Header)
+	for k, v := range r.Headers {
+		header.Set(k, v)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// isRedacted returns whether a field name holds credentials or a per request
+// value
+func isRedacted(name string) bool {
+	name = common.StringToLower(name)
+	for x := range redactedFields {
+		if common.StringContains(name, redactedFields[x]) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordHeaders flattens headers for recording, redacting credentials when
+// redact is set
+func recordHeaders(h http.Header, redact bool) map[string]string {
+	headers := make(map[string]string)
+	for k := range h {
+		if common.StringDataCompareUpper(ignoredHeaders, k) {
+			continue
+		}
+		if redact && (isRedacted(k) ||
+			common.StringDataCompareUpper(redactedHeaders, k)) {
+			headers[k] = Redacted
+			continue
+		}
+		headers[k] = h.Get(k)
+	}
+
+	if len(headers) == 0 {
+		return nil
+	}
+	return headers
+}
+
+// redactRequest returns the recorded form of a request with credentials,
+// signatures, nonces and timestamps redacted from its headers, query and body
+func redactRequest(req *http.Request, body []byte) RecordedRequest {
+	u := *req.URL
+	if u.RawQuery != "" {
+		u.RawQuery = redactValues(u.RawQuery)
+	}
+
+	return RecordedRequest{
+		Method:  req.Method,
+		URL:     u.String(),
+		Headers: recordHeaders(req.Header, true),
+		Body:    redactBody(body),
+	}
+}
+
+// redactValues redacts URL encoded values, the result is sorted by key
+func redactValues(data string) string {
+	values, err := url.ParseQuery(data)
+	if err != nil {
+		return data
+	}
+
+	for k := range values {
+		if isRedacted(k) {
+			values.Set(k, Redacted)
+		}
+	}
+	return values.Encode()
+}
+
+// redactBody redacts a JSON or URL encoded request body
+func redactBody(body []byte) string {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return ""
+	}
+
+	if trimmed[0] == '{' || trimmed[0] == '[' {
+		var data interface{}
+		if err := json.Unmarshal(trimmed, &data); err == nil {
+			redacted, err := json.Marshal(redactJSON(data))
+			if err == nil {
+				return string(redacted)
+			}
+		}
+		return string(trimmed)
+	}
+
+	if bytes.Contains(trimmed, []byte("=")) && !bytes.ContainsAny(trimmed, " \t\r\n") {
+		return redactValues(string(trimmed))
+	}
+	return string(trimmed)
+}
+
+// redactJSON redacts fields of decoded JSON. Map keys are sorted when the
+// result is marshalled so recorded and replayed bodies compare equal
+func redactJSON(data interface{}) interface{} {
+	switch d := data.(type) {
+	case map[string]interface{}:
+		for k := range d {
+			if isRedacted(k) {
+				d[k] = Redacted
+				continue
+			}
+			d[k] = redactJSON(d[k])
+		}
+	case []interface{}:
+		for x := range d {
+			d[x] = redactJSON(d[x])
+		}
+	}
+	return data
+}
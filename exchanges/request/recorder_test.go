@@ -0,0 +1,179 @@
+package request
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+)
+
+func TestRedactBody(t *testing.T) {
+	testCases := []struct {
+		body     string
+		expected string
+	}{
+		{"", ""},
+		{"command=returnBalances&nonce=1337", "command=returnBalances&nonce=" + Redacted},
+		{`{"request":"/v1/balances","nonce":1337,"sub":{"apiKey":"abc"}}`,
+			`{"nonce":"REDACTED","request":"/v1/balances","sub":{"apiKey":"REDACTED"}}`},
+		{"plain text body", "plain text body"},
+	}
+
+	for _, test := range testCases {
+		if result := redactBody([]byte(test.body)); result != test.expected {
+			t.Errorf("Test failed. Expected %s, received %s", test.expected, result)
+		}
+	}
+}
+
+func TestRedactRequest(t *testing.T) {
+	req, err := http.NewRequest("GET",
+		"https://api.binance.com/api/v3/account?timestamp=1337&signature=abc&symbol=BTCUSDT",
+		nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-MBX-APIKEY", "secret")
+	req.Header.Set("X-USER", "clientID")
+	req.Header.Set("Content-Type", "application/json")
+
+	recorded := redactRequest(req, nil)
+	expected := "https://api.binance.com/api/v3/account?signature=REDACTED&symbol=BTCUSDT&timestamp=REDACTED"
+	if recorded.URL != expected {
+		t.Errorf("Test failed. Expected %s, received %s", expected, recorded.URL)
+	}
+
+	if recorded.Headers["X-Mbx-Apikey"] != Redacted ||
+		recorded.Headers["X-User"] != Redacted ||
+		recorded.Headers["Content-Type"] != "application/json" {
+		t.Errorf("Test failed. Unexpected headers %v", recorded.Headers)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fixture := filepath.Join(dir, "testdata", "http.json")
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"balance":1337}`))
+	}))
+	defer server.Close()
+
+	_, err = NewRecorder(ReplayMode, fixture)
+	if err == nil {
+		t.Error("Test failed. Expected error replaying a missing fixture")
+	}
+
+	_, err = NewRecorder("rewind", fixture)
+	if err != errInvalidMode {
+		t.Errorf("Test failed. Expected %s, received %v", errInvalidMode, err)
+	}
+
+	rec, err := NewRecorder(RecordMode, fixture)
+	if err != nil {
+		t.Fatal("Test failed. NewRecorder error", err)
+	}
+	UseRecorder(rec)
+	defer UseRecorder(nil)
+
+	r := New("test", NewRateLimit(time.Second, 0), NewRateLimit(time.Second, 0), new(http.Client))
+	send := func(nonce string) (map[string]float64, error) {
+		var result map[string]float64
+		headers := map[string]string{"Key": "apikey", "Sign": "signature" + nonce}
+		body := bytes.NewBufferString("command=returnBalances&nonce=" + nonce)
+		err := r.SendPayload(context.Background(), "POST", server.URL+"/tradingApi",
+			headers, body, &result, true, false)
+		return result, err
+	}
+
+	result, err := send("1")
+	if err != nil || result["balance"] != 1337 {
+		t.Fatalf("Test failed. Expected recorded request to succeed, received %v %v",
+			result, err)
+	}
+
+	err = rec.Save()
+	if err != nil {
+		t.Fatal("Test failed. Save error", err)
+	}
+
+	data, err := common.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("apikey")) || bytes.Contains(data, []byte("signature1")) {
+		t.Error("Test failed. Expected credentials to be redacted from fixture")
+	}
+
+	rec, err = NewRecorder(ReplayMode, fixture)
+	if err != nil {
+		t.Fatal("Test failed. NewRecorder error", err)
+	}
+	UseRecorder(rec)
+
+	for _, nonce := range []string{"2", "3"} {
+		result, err = send(nonce)
+		if err != nil || result["balance"] != 1337 {
+			t.Errorf("Test failed. Expected replayed response, received %v %v",
+				result, err)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("Test failed. Expected replayed requests not to reach the server, received %d",
+			requests)
+	}
+
+	err = r.SendPayload(context.Background(), "GET", server.URL+"/public", nil, nil,
+		nil, false, false)
+	if err == nil {
+		t.Error("Test failed. Expected error for unrecorded request")
+	}
+	rec, err = NewRecorder(RecordMode, fixture)
+	if err != nil {
+		t.Fatal("Test failed. NewRecorder error", err)
+	}
+
+	err = rec.Save()
+	if err != nil {
+		t.Fatal("Test failed. Save error", err)
+	}
+
+	empty, err := loadFixture(fixture)
+	if err != nil || len(empty.Interactions) != 1 {
+		t.Errorf("Test failed. Expected fixture to be kept when nothing was recorded, received %+v %v",
+			empty, err)
+	}
+
+	UseRecorder(rec)
+	err = r.SendPayload(context.Background(), "GET", server.URL+"/public", nil, nil,
+		nil, false, false)
+	if err != nil {
+		t.Fatal("Test failed. Expected recorded request to succeed", err)
+	}
+
+	err = rec.Save()
+	if err != nil {
+		t.Fatal("Test failed. Save error", err)
+	}
+
+	merged, err := loadFixture(fixture)
+	if err != nil || len(merged.Interactions) != 2 {
+		t.Errorf("Test failed. Expected recorded interaction to be merged into the fixture, received %+v %v",
+			merged, err)
+	}
+}
@@ -1,6 +1,7 @@
 package request
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
@@ -17,10 +19,33 @@ import (
 
 var supportedMethods = []string{"GET", "POST", "HEAD", "PUT", "DELETE", "OPTIONS", "CONNECT"}
 
+var errShutdown = errors.New("request handling has been shut down")
+
+// Vars for shutting down all requests
+var (
+	shutdownMtx sync.Mutex
+	shutdown    = make(chan struct{})
+	isShutdown  bool
+)
+
 const (
 	maxRequestJobs              = 50
 	proxyTLSTimeout             = 15 * time.Second
 	defaultTimeoutRetryAttempts = 3
+	defaultBackoff              = time.Second
+	maxBackoff                  = 5 * time.Minute
+)
+
+// Priority determines the order in which queued requests are sent while rate
+// limited, higher priority requests are sent first
+type Priority int
+
+// Request priorities, unauthenticated requests such as market data polls
+// default to LowPriority and authenticated requests to NormalPriority
+const (
+	LowPriority Priority = iota
+	NormalPriority
+	HighPriority
 )
 
 // Requester struct for the request client
@@ -30,21 +55,37 @@ type Requester struct {
 	AuthLimit            *RateLimit
 	Name                 string
 	UserAgent            string
-	Cycle                time.Time
+	limits               map[string]*RateLimit
 	timeoutRetryAttempts int
 	m                    sync.Mutex
-	Jobs                 chan Job
-	WorkerStarted        bool
+	jobs                 [HighPriority + 1][]*Job
+	pendingJobs          int
+	newJob               chan struct{}
+	workerStarted        bool
+	backoff              time.Duration
+	backoffUntil         time.Time
 }
 
-// RateLimit struct
+// RateLimit is a token bucket holding up to Burst tokens which refills at Rate
+// tokens per Duration. A Rate of zero is unlimited and a Burst of zero allows a
+// burst of Rate tokens
 type RateLimit struct {
 	Duration time.Duration
 	Rate     int
-	Requests int
+	Burst    int
+	tokens   float64
+	updated  time.Time
 	Mutex    sync.Mutex
 }
 
+// Options holds the rate limiting settings of a request. Limits are the names
+// of the rate limits charged by the request with their weights, when empty the
+// auth or unauth rate limit is charged a weight of one
+type Options struct {
+	Limits   map[string]int
+	Priority Priority
+}
+
 // JobResult holds a request job result
 type JobResult struct {
 	Error  error
@@ -62,6 +103,9 @@ type Job struct {
 	JobResult   chan *JobResult
 	AuthRequest bool
 	Verbose     bool
+	Limits      map[*RateLimit]int
+	Priority    Priority
+	Ctx         context.Context
 }
 
 // NewRateLimit creates a new RateLimit
@@ -69,6 +113,12 @@ func NewRateLimit(d time.Duration, rate int) *RateLimit {
 	return &RateLimit{Duration: d, Rate: rate}
 }
 
+// NewRateLimitWithBurst creates a new RateLimit which allows a burst of
+// requests above its rate
+func NewRateLimitWithBurst(d time.Duration, rate, burst int) *RateLimit {
+	return &RateLimit{Duration: d, Rate: rate, Burst: burst}
+}
+
 // ToString returns the rate limiter in string notation
 func (r *RateLimit) ToString() string {
 	return fmt.Sprintf("Rate limiter set to %d requests per %v", r.Rate, r.Duration)
@@ -88,91 +138,110 @@ func (r *RateLimit) SetRate(rate int) {
 	r.Rate = rate
 }
 
-// GetRequests returns the number of requests for the ratelimit
-func (r *RateLimit) GetRequests() int {
+// SetDuration sets the duration for the ratelimit
+func (r *RateLimit) SetDuration(d time.Duration) {
 	r.Mutex.Lock()
 	defer r.Mutex.Unlock()
-	return r.Requests
+	r.Duration = d
 }
 
-// SetRequests sets requests counter for the rateliit
-func (r *RateLimit) SetRequests(l int) {
+// GetDuration gets the duration for the ratelimit
+func (r *RateLimit) GetDuration() time.Duration {
 	r.Mutex.Lock()
 	defer r.Mutex.Unlock()
-	r.Requests = l
+	return r.Duration
 }
 
-// SetDuration sets the duration for the ratelimit
-func (r *RateLimit) SetDuration(d time.Duration) {
+// SetBurst sets the maximum number of tokens the ratelimit can hold
+func (r *RateLimit) SetBurst(burst int) {
 	r.Mutex.Lock()
 	defer r.Mutex.Unlock()
-	r.Duration = d
+	r.Burst = burst
 }
 
-// GetDuration gets the duration for the ratelimit
-func (r *RateLimit) GetDuration() time.Duration {
+// GetTokens returns the number of tokens currently available
+func (r *RateLimit) GetTokens() float64 {
 	r.Mutex.Lock()
 	defer r.Mutex.Unlock()
-	return r.Duration
+	r.refill(time.Now())
+	return r.tokens
 }
 
-// StartCycle restarts the cycle time and requests counters
-func (r *Requester) StartCycle() {
-	r.Cycle = time.Now()
-	r.AuthLimit.SetRequests(0)
-	r.UnauthLimit.SetRequests(0)
+// capacity returns the maximum number of tokens the bucket can hold
+func (r *RateLimit) capacity() float64 {
+	if r.Burst > 0 {
+		return float64(r.Burst)
+	}
+	return float64(r.Rate)
 }
 
-// IsRateLimited returns whether or not the request Requester is rate limited
-func (r *Requester) IsRateLimited(auth bool) bool {
-	if auth {
-		if r.AuthLimit.GetRequests() >= r.AuthLimit.GetRate() && r.IsValidCycle(auth) {
-			return true
-		}
-	} else {
-		if r.UnauthLimit.GetRequests() >= r.UnauthLimit.GetRate() && r.IsValidCycle(auth) {
-			return true
-		}
+// refill adds the tokens accrued since the last update, a new bucket starts
+// full
+func (r *RateLimit) refill(now time.Time) {
+	if r.updated.IsZero() {
+		r.tokens = r.capacity()
+		r.updated = now
+		return
 	}
-	return false
+
+	if r.Duration > 0 {
+		r.tokens += float64(now.Sub(r.updated)) / float64(r.Duration) * float64(r.Rate)
+	}
+
+	if c := r.capacity(); r.tokens > c {
+		r.tokens = c
+	}
+	r.updated = now
 }
 
-// RequiresRateLimiter returns whether or not the request Requester requires a rate limiter
-func (r *Requester) RequiresRateLimiter() bool {
-	if r.AuthLimit.GetRate() != 0 || r.UnauthLimit.GetRate() != 0 {
-		return true
+// wait returns how long until the bucket holds enough tokens for the weight.
+// A weight above the bucket capacity waits for a full bucket
+func (r *RateLimit) wait(weight int, now time.Time) time.Duration {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	if r.Rate <= 0 {
+		return 0
 	}
-	return false
+
+	r.refill(now)
+	required := float64(weight)
+	if c := r.capacity(); required > c {
+		required = c
+	}
+
+	if r.tokens >= required || r.Duration <= 0 {
+		return 0
+	}
+	return time.Duration((required - r.tokens) / float64(r.Rate) * float64(r.Duration))
 }
 
-// IncrementRequests increments the ratelimiter request counter for either auth or unauth
-// requests
-func (r *Requester) IncrementRequests(auth bool) {
-	if auth {
-		reqs := r.AuthLimit.GetRequests()
-		reqs++
-		r.AuthLimit.SetRequests(reqs)
+// take removes the weight from the bucket, which may leave it in debt when the
+// weight exceeds its capacity
+func (r *RateLimit) take(weight int, now time.Time) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	if r.Rate <= 0 {
 		return
 	}
 
-	reqs := r.UnauthLimit.GetRequests()
-	reqs++
-	r.UnauthLimit.SetRequests(reqs)
+	r.refill(now)
+	r.tokens -= float64(weight)
 }
 
-// DecrementRequests decrements the ratelimiter request counter for either auth or unauth
-// requests
-func (r *Requester) DecrementRequests(auth bool) {
-	if auth {
-		reqs := r.AuthLimit.GetRequests()
-		reqs--
-		r.AuthLimit.SetRequests(reqs)
-		return
+// RequiresRateLimiter returns whether or not the request Requester requires a rate limiter
+func (r *Requester) RequiresRateLimiter() bool {
+	if r.AuthLimit.GetRate() != 0 || r.UnauthLimit.GetRate() != 0 {
+		return true
 	}
 
-	reqs := r.AuthLimit.GetRequests()
-	reqs--
-	r.UnauthLimit.SetRequests(reqs)
+	r.m.Lock()
+	defer r.m.Unlock()
+	for _, limit := range r.limits {
+		if limit.GetRate() != 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // SetRateLimit sets the request Requester ratelimiter
@@ -194,6 +263,29 @@ func (r *Requester) GetRateLimit(auth bool) *RateLimit {
 	return r.UnauthLimit
 }
 
+// AddRateLimit adds a named rate limit which requests can be charged against
+// using SendPayloadWithOptions, such as an exchange's request weight or order
+// limits
+func (r *Requester) AddRateLimit(name string, limit *RateLimit) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.limits == nil {
+		r.limits = make(map[string]*RateLimit)
+	}
+	r.limits[name] = limit
+}
+
+// GetNamedRateLimit returns a rate limit added by AddRateLimit
+func (r *Requester) GetNamedRateLimit(name string) (*RateLimit, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	limit, ok := r.limits[name]
+	if !ok {
+		return nil, fmt.Errorf("%s rate limit %s not found", r.Name, name)
+	}
+	return limit, nil
+}
+
 // SetTimeoutRetryAttempts sets the amount of times the job will be retried
 // if it times out
 func (r *Requester) SetTimeoutRetryAttempts(n int) error {
@@ -211,7 +303,7 @@ func New(name string, authLimit, unauthLimit *RateLimit, httpRequester *http.Cli
 		UnauthLimit:          unauthLimit,
 		AuthLimit:            authLimit,
 		Name:                 name,
-		Jobs:                 make(chan Job, maxRequestJobs),
+		newJob:               make(chan struct{}, 1),
 		timeoutRetryAttempts: defaultTimeoutRetryAttempts,
 	}
 }
@@ -221,22 +313,6 @@ func IsValidMethod(method string) bool {
 	return common.StringDataCompareUpper(supportedMethods, method)
 }
 
-// IsValidCycle checks to see whether the current request cycle is valid or not
-func (r *Requester) IsValidCycle(auth bool) bool {
-	if auth {
-		if time.Since(r.Cycle) < r.AuthLimit.GetDuration() {
-			return true
-		}
-	} else {
-		if time.Since(r.Cycle) < r.UnauthLimit.GetDuration() {
-			return true
-		}
-	}
-
-	r.StartCycle()
-	return false
-}
-
 func (r *Requester) checkRequest(method, path string, body io.Reader, headers map[string]string) (*http.Request, error) {
 	req, err := http.NewRequest(method, path, body)
 	if err != nil {
@@ -254,16 +330,22 @@ func (r *Requester) checkRequest(method, path string, body io.Reader, headers ma
 	return req, nil
 }
 
-// DoRequest performs a HTTP/HTTPS request with the supplied params
-func (r *Requester) DoRequest(req *http.Request, method, path string, headers map[string]string, body io.Reader, result interface{}, authRequest, verbose bool) error {
+// DoRequest performs a HTTP/HTTPS request with the supplied params, the
+// request is abandoned when the context is done
+func (r *Requester) DoRequest(ctx context.Context, req *http.Request, method, path string, headers map[string]string, body io.Reader, result interface{}, authRequest, verbose bool) error {
 	if verbose {
 		log.Printf("%s exchange request path: %s requires rate limiter: %v", r.Name, path, r.RequiresRateLimiter())
 	}
 
+	req = req.WithContext(ctx)
 	var timeoutError error
 	for i := 0; i < r.timeoutRetryAttempts+1; i++ {
-		resp, err := r.HTTPClient.Do(req)
+		resp, err := r.getHTTPClient().Do(req)
 		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
 			if timeoutErr, ok := err.(net.Error); ok && timeoutErr.Timeout() {
 				if verbose {
 					log.Printf("%s request has timed-out retrying request, count %d",
@@ -273,16 +355,9 @@ func (r *Requester) DoRequest(req *http.Request, method, path string, headers ma
 				timeoutError = err
 				continue
 			}
-
-			if r.RequiresRateLimiter() {
-				r.DecrementRequests(authRequest)
-			}
 			return err
 		}
 		if resp == nil {
-			if r.RequiresRateLimiter() {
-				r.DecrementRequests(authRequest)
-			}
 			return errors.New("resp is nil")
 		}
 
@@ -291,6 +366,13 @@ func (r *Requester) DoRequest(req *http.Request, method, path string, headers ma
 			return err
 		}
 
+		if d, limited := r.checkBackoff(resp); limited {
+			resp.Body.Close()
+			log.Printf("%s request rate limited by exchange, backing off for %v", r.Name, d)
+			return fmt.Errorf("Error: HTTP Status code %s, rate limited for %v. Body: %s",
+				resp.Status, d, contents)
+		}
+
 		if resp.StatusCode != 200 && resp.StatusCode != 201 && resp.StatusCode != 202 {
 			return fmt.Errorf("Error: HTTP Status code %s. Body: %s", resp.Status, contents)
 		}
@@ -311,48 +393,173 @@ func (r *Requester) DoRequest(req *http.Request, method, path string, headers ma
 		timeoutError)
 }
 
-func (r *Requester) worker() {
-	for {
-		for x := range r.Jobs {
-			if !r.IsRateLimited(x.AuthRequest) {
-				r.IncrementRequests(x.AuthRequest)
-
-				err := r.DoRequest(x.Request, x.Method, x.Path, x.Headers, x.Body, x.Result, x.AuthRequest, x.Verbose)
-				x.JobResult <- &JobResult{
-					Error:  err,
-					Result: x.Result,
+// checkBackoff backs off all requests when the exchange responds with 429 Too
+// Many Requests, 418 (an IP ban) or a Retry-After header on an error response.
+// The Retry-After duration is used when supplied, otherwise the backoff
+// doubles for each consecutive rate limited response. A successful response
+// resets the backoff
+func (r *Requester) checkBackoff(resp *http.Response) (time.Duration, bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusTeapot &&
+		(resp.StatusCode < 400 || retryAfter <= 0) {
+		if resp.StatusCode < 400 {
+			r.backoff = 0
+		}
+		return 0, false
+	}
+
+	if r.backoff == 0 {
+		r.backoff = defaultBackoff
+	} else if r.backoff < maxBackoff {
+		r.backoff *= 2
+		if r.backoff > maxBackoff {
+			r.backoff = maxBackoff
+		}
+	}
+
+	d := r.backoff
+	if retryAfter > 0 {
+		d = retryAfter
+	}
+	r.backoffUntil = time.Now().Add(d)
+	return d, true
+}
+
+// parseRetryAfter returns the duration of a Retry-After header value in
+// either delay seconds or HTTP date format
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// GetBackoff returns the remaining time requests are backed off for after the
+// exchange rate limited a request
+func (r *Requester) GetBackoff() time.Duration {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if d := time.Until(r.backoffUntil); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// nextJob removes and returns the next job which can be sent, taking its
+// tokens. Jobs are considered from the highest priority down and in order of
+// arrival, a job waiting on a rate limit holds that rate limit so lower
+// priority jobs can't take its tokens. When no job can be sent it returns how
+// long to wait, or a negative duration when there are no jobs
+func (r *Requester) nextJob(now time.Time) (*Job, time.Duration) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.pendingJobs == 0 {
+		return nil, -1
+	}
+
+	if d := r.backoffUntil.Sub(now); d > 0 {
+		return nil, d
+	}
+
+	held := make(map[*RateLimit]bool)
+	minWait := time.Duration(-1)
+	for p := HighPriority; p >= LowPriority; p-- {
+		for i, job := range r.jobs[p] {
+			var wait time.Duration
+			blocked := false
+			for limit, weight := range job.Limits {
+				if held[limit] {
+					blocked = true
+					break
 				}
-			} else {
-				limit := r.GetRateLimit(x.AuthRequest)
-				diff := limit.GetDuration() - time.Since(r.Cycle)
-				if x.Verbose {
-					log.Printf("%s request. Rate limited! Sleeping for %v", r.Name, diff)
+				if d := limit.wait(weight, now); d > wait {
+					wait = d
 				}
-				time.Sleep(diff)
-
-				for {
-					if !r.IsRateLimited(x.AuthRequest) {
-						r.IncrementRequests(x.AuthRequest)
-
-						if x.Verbose {
-							log.Printf("%s request. No longer rate limited! Doing request", r.Name)
-						}
-
-						err := r.DoRequest(x.Request, x.Method, x.Path, x.Headers, x.Body, x.Result, x.AuthRequest, x.Verbose)
-						x.JobResult <- &JobResult{
-							Error:  err,
-							Result: x.Result,
-						}
-						break
-					}
+			}
+
+			if blocked {
+				continue
+			}
+
+			if wait == 0 {
+				for limit, weight := range job.Limits {
+					limit.take(weight, now)
 				}
+				r.jobs[p] = append(r.jobs[p][:i], r.jobs[p][i+1:]...)
+				r.pendingJobs--
+				return job, 0
+			}
+
+			for limit := range job.Limits {
+				held[limit] = true
+			}
+			if minWait < 0 || wait < minWait {
+				minWait = wait
 			}
 		}
 	}
+	return nil, minWait
 }
 
-// SendPayload handles sending HTTP/HTTPS requests
-func (r *Requester) SendPayload(method, path string, headers map[string]string, body io.Reader, result interface{}, authRequest, verbose bool) error {
+// worker sends queued jobs as their rate limits allow, sleeping until tokens
+// are available or a new job is queued
+func (r *Requester) worker() {
+	for {
+		job, wait := r.nextJob(time.Now())
+		if job != nil {
+			err := job.Ctx.Err()
+			if err == nil {
+				err = r.DoRequest(job.Ctx, job.Request, job.Method, job.Path, job.Headers, job.Body, job.Result, job.AuthRequest, job.Verbose)
+			}
+			job.JobResult <- &JobResult{
+				Error:  err,
+				Result: job.Result,
+			}
+			continue
+		}
+
+		if wait < 0 {
+			<-r.newJob
+			continue
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-r.newJob:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// SendPayload handles sending HTTP/HTTPS requests, charging the auth or unauth
+// rate limit. Cancelling the context abandons a queued request or aborts it
+// while in flight
+func (r *Requester) SendPayload(ctx context.Context, method, path string, headers map[string]string, body io.Reader, result interface{}, authRequest, verbose bool) error {
+	opts := Options{Priority: LowPriority}
+	if authRequest {
+		opts.Priority = NormalPriority
+	}
+	return r.SendPayloadWithOptions(ctx, method, path, headers, body, result, authRequest, verbose, opts)
+}
+
+// SendPayloadWithOptions handles sending HTTP/HTTPS requests charging the
+// named rate limits and weights of the options at the options priority.
+// Cancelling the context abandons a queued request or aborts it while in
+// flight
+func (r *Requester) SendPayloadWithOptions(ctx context.Context, method, path string, headers map[string]string, body io.Reader, result interface{}, authRequest, verbose bool, opts Options) error {
 	if r == nil || r.Name == "" {
 		return errors.New("not initiliased, SetDefaults() called before making request?")
 	}
@@ -365,50 +572,108 @@ func (r *Requester) SendPayload(method, path string, headers map[string]string,
 		return errors.New("invalid path")
 	}
 
+	if opts.Priority < LowPriority || opts.Priority > HighPriority {
+		return fmt.Errorf("invalid request priority %d", opts.Priority)
+	}
+
+	limits := make(map[*RateLimit]int)
+	for name, weight := range opts.Limits {
+		limit, err := r.GetNamedRateLimit(name)
+		if err != nil {
+			return err
+		}
+		limits[limit] = weight
+	}
+	if len(limits) == 0 {
+		limits[r.GetRateLimit(authRequest)] = 1
+	}
+
 	req, err := r.checkRequest(method, path, body, headers)
 	if err != nil {
 		return err
 	}
 
+	ctx, cancel, err := withShutdown(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
 	if !r.RequiresRateLimiter() {
-		return r.DoRequest(req, method, path, headers, body, result, authRequest, verbose)
+		if d := r.GetBackoff(); d > 0 {
+			if verbose {
+				log.Printf("%s request. Rate limited by exchange! Sleeping for %v", r.Name, d)
+			}
+
+			timer := time.NewTimer(d)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+		return r.DoRequest(ctx, req, method, path, headers, body, result, authRequest, verbose)
 	}
 
-	if len(r.Jobs) == maxRequestJobs {
+	r.m.Lock()
+	if r.pendingJobs >= maxRequestJobs {
+		r.m.Unlock()
 		return errors.New("max request jobs reached")
 	}
 
-	r.m.Lock()
-	if !r.WorkerStarted {
-		r.StartCycle()
-		r.WorkerStarted = true
+	if !r.workerStarted {
+		if r.newJob == nil {
+			r.newJob = make(chan struct{}, 1)
+		}
+		r.workerStarted = true
 		go r.worker()
 	}
-	r.m.Unlock()
 
-	jobResult := make(chan *JobResult)
-
-	newJob := Job{
+	newJob := &Job{
 		Request:     req,
 		Method:      method,
 		Path:        path,
 		Headers:     headers,
 		Body:        body,
 		Result:      result,
-		JobResult:   jobResult,
+		JobResult:   make(chan *JobResult, 1),
 		AuthRequest: authRequest,
 		Verbose:     verbose,
+		Limits:      limits,
+		Priority:    opts.Priority,
+		Ctx:         ctx,
 	}
 
 	if verbose {
 		log.Printf("%s request. Attaching new job.", r.Name)
 	}
-	r.Jobs <- newJob
+	r.jobs[opts.Priority] = append(r.jobs[opts.Priority], newJob)
+	r.pendingJobs++
+	r.m.Unlock()
+
+	select {
+	case r.newJob <- struct{}{}:
+	default:
+	}
 
 	if verbose {
 		log.Printf("%s request. Waiting for job to complete.", r.Name)
 	}
-	resp := <-newJob.JobResult
+
+	var resp *JobResult
+	select {
+	case resp = <-newJob.JobResult:
+	case <-ctx.Done():
+		if r.removeJob(newJob) {
+			if verbose {
+				log.Printf("%s request. Job abandoned.", r.Name)
+			}
+			return ctx.Err()
+		}
+		// the job is being sent and will return once the request is aborted
+		resp = <-newJob.JobResult
+	}
 
 	if verbose {
 		log.Printf("%s request. Job complete.", r.Name)
@@ -416,6 +681,54 @@ func (r *Requester) SendPayload(method, path string, headers map[string]string,
 	return resp.Error
 }
 
+// removeJob removes a job from the queue, returning false if the job is no
+// longer queued
+func (r *Requester) removeJob(job *Job) bool {
+	r.m.Lock()
+	defer r.m.Unlock()
+	queue := r.jobs[job.Priority]
+	for i := range queue {
+		if queue[i] == job {
+			r.jobs[job.Priority] = append(queue[:i], queue[i+1:]...)
+			r.pendingJobs--
+			return true
+		}
+	}
+	return false
+}
+
+// withShutdown returns a context which is also cancelled by Shutdown
+func withShutdown(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	shutdownMtx.Lock()
+	defer shutdownMtx.Unlock()
+	if isShutdown {
+		return nil, nil, errShutdown
+	}
+
+	done := shutdown
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel, nil
+}
+
+// Shutdown cancels all queued and in-flight requests of every Requester,
+// requests sent after shutdown return an error
+func Shutdown() {
+	shutdownMtx.Lock()
+	defer shutdownMtx.Unlock()
+	if isShutdown {
+		return
+	}
+	isShutdown = true
+	close(shutdown)
+}
+
 // SetProxy sets a proxy address to the client transport
 func (r *Requester) SetProxy(p *url.URL) error {
 	if p.String() == "" {
@@ -1,8 +1,11 @@
 package request
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 )
@@ -33,86 +36,6 @@ func TestSetDuration(t *testing.T) {
 	}
 }
 
-func TestDecerementRequests(t *testing.T) {
-	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
-
-	r.AuthLimit.SetRequests(99)
-	r.DecrementRequests(true)
-
-	if r.AuthLimit.GetRequests() != 98 {
-		t.Fatal("unexpected values")
-	}
-}
-func TestStartCycle(t *testing.T) {
-	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
-
-	if r.AuthLimit.Duration != time.Second*10 && r.AuthLimit.Rate != 5 {
-		t.Fatal("unexpected values")
-	}
-
-	if r.UnauthLimit.Duration != time.Second*20 && r.UnauthLimit.Rate != 100 {
-		t.Fatal("unexpected values")
-	}
-
-	r.AuthLimit.SetRequests(1)
-	r.UnauthLimit.SetRequests(1)
-	r.StartCycle()
-	if r.Cycle.IsZero() || r.AuthLimit.GetRequests() != 0 || r.UnauthLimit.GetRequests() != 0 {
-		t.Fatal("unexpcted values")
-	}
-}
-
-func TestIsRateLimited(t *testing.T) {
-	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
-	r.StartCycle()
-
-	if r.AuthLimit.ToString() != "Rate limiter set to 5 requests per 10s" {
-		t.Fatal("unexcpted values")
-	}
-
-	if r.UnauthLimit.ToString() != "Rate limiter set to 100 requests per 20s" {
-		t.Fatal("unexpected values")
-	}
-
-	if r.AuthLimit.ToString() != "Rate limiter set to 5 requests per 10s" {
-		t.Fatal("unexcpted values")
-	}
-
-	// FIXME: Need to account for unauth/auth/total requests
-	r.AuthLimit.SetRequests(4)
-	if r.AuthLimit.GetRequests() != 4 {
-		t.Fatal("unexpected values")
-	}
-
-	// test that we're not rate limited since 4 < 5
-	if r.IsRateLimited(true) {
-		t.Fatal("unexpected values")
-	}
-
-	// bump requests counter to 6 which would exceed the rate limiter
-	r.AuthLimit.SetRequests(6)
-	if !r.IsRateLimited(true) {
-		t.Fatal("unexpected values")
-	}
-
-	// FIXME: Need to account for unauth/auth/total requests
-	r.UnauthLimit.SetRequests(99)
-	if r.UnauthLimit.GetRequests() != 99 {
-		t.Fatal("unexpected values")
-	}
-
-	// test that we're not rate limited since 99 < 100
-	if r.IsRateLimited(false) {
-		t.Fatal("unexpected values")
-	}
-
-	// bump requests counter to 100 which would exceed the rate limiter
-	r.UnauthLimit.SetRequests(100)
-	if !r.IsRateLimited(false) {
-		t.Fatal("unexpected values")
-	}
-}
-
 func TestRequiresRateLimiter(t *testing.T) {
 	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
 	if !r.RequiresRateLimiter() {
@@ -125,6 +48,11 @@ func TestRequiresRateLimiter(t *testing.T) {
 	if r.RequiresRateLimiter() {
 		t.Fatal("unexpected values")
 	}
+
+	r.AddRateLimit("weight", NewRateLimit(time.Minute, 1200))
+	if !r.RequiresRateLimiter() {
+		t.Fatal("Test failed. Expected named rate limit to require the rate limiter")
+	}
 }
 
 func TestSetLimit(t *testing.T) {
@@ -165,31 +93,6 @@ func TestIsValidMethod(t *testing.T) {
 	}
 }
 
-func TestIsValidCycle(t *testing.T) {
-	r := New("bitfinex", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
-	r.Cycle = time.Now().Add(-9 * time.Second)
-
-	if !r.IsValidCycle(true) {
-		t.Fatal("unexpected values")
-	}
-
-	r.Cycle = time.Now().Add(-11 * time.Second)
-	if r.IsValidCycle(true) {
-		t.Fatal("unexpected values")
-	}
-
-	r.Cycle = time.Now().Add(-19 * time.Second)
-
-	if !r.IsValidCycle(false) {
-		t.Fatal("unexpected values")
-	}
-
-	r.Cycle = time.Now().Add(-21 * time.Second)
-	if r.IsValidCycle(false) {
-		t.Fatal("unexpected values")
-	}
-}
-
 func TestCheckRequest(t *testing.T) {
 	r := New("", NewRateLimit(time.Second*10, 5), NewRateLimit(time.Second*20, 100), new(http.Client))
 	_, err := r.checkRequest("bad method, bad", "http://www.google.com", nil, nil)
@@ -200,7 +103,7 @@ func TestCheckRequest(t *testing.T) {
 
 func TestDoRequest(t *testing.T) {
 	var test *Requester
-	err := test.SendPayload("GET", "https://www.google.com", nil, nil, nil, false, true)
+	err := test.SendPayload(context.Background(), "GET", "https://www.google.com", nil, nil, nil, false, true)
 	if err == nil {
 		t.Fatal("not iniitalised")
 	}
@@ -211,17 +114,17 @@ func TestDoRequest(t *testing.T) {
 	}
 
 	r.Name = "bitfinex"
-	err = r.SendPayload("BLAH", "https://www.google.com", nil, nil, nil, false, true)
+	err = r.SendPayload(context.Background(), "BLAH", "https://www.google.com", nil, nil, nil, false, true)
 	if err == nil {
 		t.Fatal("unexpected values")
 	}
 
-	err = r.SendPayload("GET", "", nil, nil, nil, false, true)
+	err = r.SendPayload(context.Background(), "GET", "", nil, nil, nil, false, true)
 	if err == nil {
 		t.Fatal("unexpected values")
 	}
 
-	err = r.SendPayload("GET", "https://www.google.com", nil, nil, nil, false, true)
+	err = r.SendPayload(context.Background(), "GET", "https://www.google.com", nil, nil, nil, false, true)
 	if err != nil {
 		t.Fatal("unexpected values")
 	}
@@ -233,7 +136,7 @@ func TestDoRequest(t *testing.T) {
 	r.SetRateLimit(false, time.Second, 0)
 	r.SetRateLimit(true, time.Second, 0)
 
-	err = r.SendPayload("GET", "https://www.google.com", nil, nil, nil, false, true)
+	err = r.SendPayload(context.Background(), "GET", "https://www.google.com", nil, nil, nil, false, true)
 	if err != nil {
 		t.Fatal("unexpected values")
 	}
@@ -244,44 +147,31 @@ func TestDoRequest(t *testing.T) {
 
 	r.SetRateLimit(false, time.Millisecond*200, 100)
 	r.SetRateLimit(true, time.Millisecond*100, 100)
-	r.Cycle = time.Now().Add(time.Millisecond * -201)
 
-	if r.IsValidCycle(false) {
-		t.Fatal("unexepcted values")
-	}
-
-	err = r.SendPayload("GET", "https://www.google.com", nil, nil, nil, false, true)
+	err = r.SendPayload(context.Background(), "GET", "https://www.google.com", nil, nil, nil, false, true)
 	if err != nil {
 		t.Fatal("unexpected values")
 	}
 
-	r.Cycle = time.Now().Add(time.Millisecond * -101)
-
-	if r.IsValidCycle(true) {
-		t.Fatal("unexepcted values")
-	}
-
-	err = r.SendPayload("GET", "https://www.google.com", nil, nil, nil, true, true)
+	err = r.SendPayload(context.Background(), "GET", "https://www.google.com", nil, nil, nil, true, true)
 	if err != nil {
 		t.Fatal("unexpected values")
 	}
 
 	var result interface{}
-	err = r.SendPayload("GET", "https://www.google.com", nil, nil, result, false, true)
+	err = r.SendPayload(context.Background(), "GET", "https://www.google.com", nil, nil, result, false, true)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	headers := make(map[string]string)
 	headers["content-type"] = "content/text"
-	err = r.SendPayload("POST", "https://bitfinex.com", headers, nil, result, false, true)
+	err = r.SendPayload(context.Background(), "POST", "https://bitfinex.com", headers, nil, result, false, true)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	r.StartCycle()
-	r.UnauthLimit.SetRequests(100)
-	err = r.SendPayload("GET", "https://www.google.com", nil, nil, result, false, false)
+	err = r.SendPayload(context.Background(), "GET", "https://www.google.com", nil, nil, result, false, false)
 	if err != nil {
 		t.Fatal("unexpected values")
 	}
@@ -297,7 +187,7 @@ func TestDoRequest(t *testing.T) {
 	}
 
 	r.HTTPClient.Timeout = 1 * time.Second
-	err = r.SendPayload("POST", "https://httpstat.us/200?sleep=20000", nil, nil, nil, false, true)
+	err = r.SendPayload(context.Background(), "POST", "https://httpstat.us/200?sleep=20000", nil, nil, nil, false, true)
 	if err == nil {
 		t.Fatal(err)
 	}
@@ -322,3 +212,236 @@ func TestDoRequest(t *testing.T) {
 		t.Error("failed to set proxy")
 	}
 }
+
+func TestRateLimitWait(t *testing.T) {
+	r := NewRateLimitWithBurst(time.Second, 10, 5)
+	now := time.Now()
+
+	if d := r.wait(5, now); d != 0 {
+		t.Fatalf("Test failed. Expected full burst to be available, received %v", d)
+	}
+	r.take(5, now)
+
+	if d := r.wait(1, now); d != 100*time.Millisecond {
+		t.Errorf("Test failed. Expected 100ms wait, received %v", d)
+	}
+
+	now = now.Add(100 * time.Millisecond)
+	if d := r.wait(1, now); d != 0 {
+		t.Errorf("Test failed. Expected refilled token, received %v", d)
+	}
+
+	// weights above the burst wait for a full bucket
+	if d := r.wait(10, now); d != 400*time.Millisecond {
+		t.Errorf("Test failed. Expected 400ms wait, received %v", d)
+	}
+
+	if d := NewRateLimit(time.Second, 0).wait(100, now); d != 0 {
+		t.Errorf("Test failed. Expected unlimited rate limit, received %v", d)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Now()
+	if d := parseRetryAfter("5", now); d != 5*time.Second {
+		t.Errorf("Test failed. Expected 5s, received %v", d)
+	}
+
+	date := now.Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if d := parseRetryAfter(date, now); d <= 8*time.Second || d > 10*time.Second {
+		t.Errorf("Test failed. Expected approximately 10s, received %v", d)
+	}
+
+	if d := parseRetryAfter("soon", now); d != 0 {
+		t.Errorf("Test failed. Expected invalid value to be ignored, received %v", d)
+	}
+}
+
+func TestCheckBackoff(t *testing.T) {
+	r := New("test", NewRateLimit(time.Second, 0), NewRateLimit(time.Second, 0), new(http.Client))
+
+	limited := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	if d, ok := r.checkBackoff(limited); !ok || d != defaultBackoff {
+		t.Errorf("Test failed. Expected %v backoff, received %v", defaultBackoff, d)
+	}
+
+	if d, _ := r.checkBackoff(limited); d != 2*defaultBackoff {
+		t.Errorf("Test failed. Expected backoff to double, received %v", d)
+	}
+
+	if r.GetBackoff() <= 0 {
+		t.Error("Test failed. Expected requests to be backed off")
+	}
+
+	if _, ok := r.checkBackoff(&http.Response{StatusCode: http.StatusOK}); ok {
+		t.Error("Test failed. Expected successful response not to back off")
+	}
+
+	retry := &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Header:     http.Header{"Retry-After": []string{"3"}},
+	}
+	if d, ok := r.checkBackoff(retry); !ok || d != 3*time.Second {
+		t.Errorf("Test failed. Expected Retry-After backoff, received %v", d)
+	}
+
+	if _, ok := r.checkBackoff(&http.Response{StatusCode: http.StatusInternalServerError}); ok {
+		t.Error("Test failed. Expected server error without Retry-After not to back off")
+	}
+}
+
+func TestSendPayloadWithOptions(t *testing.T) {
+	var m sync.Mutex
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		m.Lock()
+		paths = append(paths, req.URL.Path)
+		m.Unlock()
+
+		if req.URL.Path == "/limited" {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	r := New("test", NewRateLimit(time.Second, 0), NewRateLimit(100*time.Millisecond, 1), new(http.Client))
+	r.AddRateLimit("weight", NewRateLimit(time.Minute, 10))
+
+	err := r.SendPayloadWithOptions(context.Background(), "GET", server.URL+"/weight", nil, nil, nil, false, false,
+		Options{Limits: map[string]int{"weight": 4}})
+	if err != nil {
+		t.Fatal("Test failed. SendPayloadWithOptions error", err)
+	}
+
+	limit, err := r.GetNamedRateLimit("weight")
+	if err != nil {
+		t.Fatal("Test failed. GetNamedRateLimit error", err)
+	}
+	if tokens := limit.GetTokens(); tokens < 6 || tokens > 6.1 {
+		t.Errorf("Test failed. Expected 6 remaining tokens, have %f", tokens)
+	}
+
+	err = r.SendPayloadWithOptions(context.Background(), "GET", server.URL, nil, nil, nil, false, false,
+		Options{Limits: map[string]int{"orders": 1}})
+	if err == nil {
+		t.Error("Test failed. Expected unknown rate limit error")
+	}
+
+	err = r.SendPayloadWithOptions(context.Background(), "GET", server.URL, nil, nil, nil, false, false,
+		Options{Priority: HighPriority + 1})
+	if err == nil {
+		t.Error("Test failed. Expected invalid priority error")
+	}
+
+	// the first poll takes the only unauth token, so the cancel queued after
+	// the second poll is sent first when the next token is available
+	var wg sync.WaitGroup
+	send := func(path string, priority Priority) {
+		defer wg.Done()
+		sendErr := r.SendPayloadWithOptions(context.Background(), "GET", server.URL+path, nil, nil, nil, false, false,
+			Options{Priority: priority})
+		if sendErr != nil {
+			t.Error("Test failed. SendPayloadWithOptions error", sendErr)
+		}
+	}
+
+	m.Lock()
+	paths = nil
+	m.Unlock()
+
+	wg.Add(3)
+	go send("/poll1", LowPriority)
+	time.Sleep(20 * time.Millisecond)
+	go send("/poll2", LowPriority)
+	time.Sleep(20 * time.Millisecond)
+	go send("/cancel", HighPriority)
+	wg.Wait()
+
+	if len(paths) != 3 || paths[0] != "/poll1" || paths[1] != "/cancel" {
+		t.Errorf("Test failed. Expected high priority request to be sent first, received %v",
+			paths)
+	}
+
+	err = r.SendPayload(context.Background(), "GET", server.URL+"/limited", nil, nil, nil, false, false)
+	if err == nil {
+		t.Error("Test failed. Expected rate limited error")
+	}
+
+	if d := r.GetBackoff(); d <= 0 || d > time.Second {
+		t.Errorf("Test failed. Expected Retry-After backoff, received %v", d)
+	}
+}
+
+func TestSendPayloadCancel(t *testing.T) {
+	block := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-block:
+		case <-req.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(block)
+
+	r := New("test", NewRateLimit(time.Second, 0), NewRateLimit(time.Minute, 1), new(http.Client))
+
+	// the first request takes the only token and hangs until cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := r.SendPayload(ctx, "GET", server.URL, nil, nil, nil, false, false)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Test failed. Expected %s, received %v", context.DeadlineExceeded, err)
+	}
+
+	// the second request is queued waiting for a token and is abandoned
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	err = r.SendPayload(ctx, "GET", server.URL, nil, nil, nil, false, false)
+	if err != context.Canceled {
+		t.Errorf("Test failed. Expected %s, received %v", context.Canceled, err)
+	}
+
+	r.m.Lock()
+	pending := r.pendingJobs
+	r.m.Unlock()
+	if pending != 0 {
+		t.Errorf("Test failed. Expected abandoned job to be removed, have %d jobs",
+			pending)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-req.Context().Done()
+	}))
+	defer server.Close()
+
+	defer func() {
+		shutdownMtx.Lock()
+		shutdown = make(chan struct{})
+		isShutdown = false
+		shutdownMtx.Unlock()
+	}()
+
+	r := New("test", NewRateLimit(time.Second, 0), NewRateLimit(time.Second, 0), new(http.Client))
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		Shutdown()
+	}()
+
+	err := r.SendPayload(context.Background(), "GET", server.URL, nil, nil, nil, false, false)
+	if err != context.Canceled {
+		t.Errorf("Test failed. Expected %s, received %v", context.Canceled, err)
+	}
+
+	err = r.SendPayload(context.Background(), "GET", server.URL, nil, nil, nil, false, false)
+	if err != errShutdown {
+		t.Errorf("Test failed. Expected %s, received %v", errShutdown, err)
+	}
+}
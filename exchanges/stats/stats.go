@@ -2,6 +2,7 @@ package stats
 
 import (
 	"sort"
+	"sync"
 
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 )
@@ -18,6 +19,9 @@ type Item struct {
 // Items var array
 var Items []Item
 
+// m guards Items
+var m sync.Mutex
+
 // ByPrice allows sorting by price
 type ByPrice []Item
 
@@ -54,23 +58,33 @@ func Add(exchange string, p pair.CurrencyPair, assetType string, price, volume f
 		return
 	}
 
+	m.Lock()
+	defer m.Unlock()
+
 	if p.FirstCurrency == "XBT" {
 		newPair := pair.NewCurrencyPair("BTC", p.SecondCurrency.String())
-		Append(exchange, newPair, assetType, price, volume)
+		appendItem(exchange, newPair, assetType, price, volume)
 	}
 
 	if p.SecondCurrency == "USDT" {
 		newPair := pair.NewCurrencyPair(p.FirstCurrency.String(), "USD")
-		Append(exchange, newPair, assetType, price, volume)
+		appendItem(exchange, newPair, assetType, price, volume)
 	}
 
-	Append(exchange, p, assetType, price, volume)
+	appendItem(exchange, p, assetType, price, volume)
 }
 
 // Append adds or updates the item stats for a specific
 // currency pair and asset type
 func Append(exchange string, p pair.CurrencyPair, assetType string, price, volume float64) {
-	if AlreadyExists(exchange, p, assetType, price, volume) {
+	m.Lock()
+	defer m.Unlock()
+	appendItem(exchange, p, assetType, price, volume)
+}
+
+// appendItem adds or updates the item stats, the caller must hold the lock
+func appendItem(exchange string, p pair.CurrencyPair, assetType string, price, volume float64) {
+	if alreadyExists(exchange, p, assetType, price, volume) {
 		return
 	}
 
@@ -88,6 +102,14 @@ func Append(exchange string, p pair.CurrencyPair, assetType string, price, volum
 // AlreadyExists checks to see if item info already exists
 // for a specific currency pair and asset type
 func AlreadyExists(exchange string, p pair.CurrencyPair, assetType string, price, volume float64) bool {
+	m.Lock()
+	defer m.Unlock()
+	return alreadyExists(exchange, p, assetType, price, volume)
+}
+
+// alreadyExists updates the item stats if they exist, the caller must hold
+// the lock
+func alreadyExists(exchange string, p pair.CurrencyPair, assetType string, price, volume float64) bool {
 	for i := range Items {
 		if Items[i].Exchange == exchange && Items[i].Pair.Equal(p, false) && Items[i].AssetType == assetType {
 			Items[i].Price, Items[i].Volume = price, volume
@@ -97,16 +119,25 @@ func AlreadyExists(exchange string, p pair.CurrencyPair, assetType string, price
 	return false
 }
 
+// GetItems returns a copy of all item stats
+func GetItems() []Item {
+	m.Lock()
+	defer m.Unlock()
+	return append([]Item(nil), Items...)
+}
+
 // SortExchangesByVolume sorts item info by volume for a specific
 // currency pair and asset type. Reverse will reverse the order from lowest to
 // highest
 func SortExchangesByVolume(p pair.CurrencyPair, assetType string, reverse bool) []Item {
+	m.Lock()
 	var result []Item
 	for x := range Items {
 		if Items[x].Pair.Equal(p, false) && Items[x].AssetType == assetType {
 			result = append(result, Items[x])
 		}
 	}
+	m.Unlock()
 
 	if reverse {
 		sort.Sort(sort.Reverse(ByVolume(result)))
@@ -120,12 +151,14 @@ func SortExchangesByVolume(p pair.CurrencyPair, assetType string, reverse bool)
 // currency pair and asset type. Reverse will reverse the order from lowest to
 // highest
 func SortExchangesByPrice(p pair.CurrencyPair, assetType string, reverse bool) []Item {
+	m.Lock()
 	var result []Item
 	for x := range Items {
 		if Items[x].Pair.Equal(p, false) && Items[x].AssetType == assetType {
 			result = append(result, Items[x])
 		}
 	}
+	m.Unlock()
 
 	if reverse {
 		sort.Sort(sort.Reverse(ByPrice(result)))
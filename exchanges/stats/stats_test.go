@@ -179,3 +179,15 @@ func TestSortExchangesByPrice(t *testing.T) {
 		t.Error("Test Failed - stats SortExchangesByPrice incorrectly sorted values.")
 	}
 }
+
+func TestGetItems(t *testing.T) {
+	items := GetItems()
+	if len(items) != len(Items) {
+		t.Fatal("Test Failed - stats GetItems returned an incorrect amount of items.")
+	}
+
+	items[0].Price = -1
+	if Items[0].Price == -1 {
+		t.Error("Test Failed - stats GetItems did not return a copy.")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
 )
 
 // Const values for the ticker package
@@ -21,7 +22,8 @@ const (
 
 // Vars for the ticker package
 var (
-	Tickers []Ticker
+	// Tickers holds the ticker of each exchange keyed by exchange name
+	Tickers = make(map[string]*Ticker)
 	m       sync.Mutex
 )
 
@@ -69,18 +71,25 @@ func (t *Ticker) PriceToString(p pair.CurrencyPair, priceType, tickerType string
 	}
 }
 
+// BusTopic returns the data bus topic tickers are published on
+func (p Price) BusTopic() string {
+	return databus.Ticker
+}
+
 // GetTicker checks and returns a requested ticker if it exists
 func GetTicker(exchange string, p pair.CurrencyPair, tickerType string) (Price, error) {
-	ticker, err := GetTickerByExchange(exchange)
-	if err != nil {
-		return Price{}, err
+	m.Lock()
+	defer m.Unlock()
+	ticker, ok := Tickers[exchange]
+	if !ok {
+		return Price{}, errors.New(ErrTickerForExchangeNotFound)
 	}
 
-	if !FirstCurrencyExists(exchange, p.FirstCurrency) {
+	if _, ok := ticker.Price[p.FirstCurrency]; !ok {
 		return Price{}, errors.New(ErrPrimaryCurrencyNotFound)
 	}
 
-	if !SecondCurrencyExists(exchange, p) {
+	if _, ok := ticker.Price[p.FirstCurrency][p.SecondCurrency]; !ok {
 		return Price{}, errors.New(ErrSecondaryCurrencyNotFound)
 	}
 
@@ -91,12 +100,11 @@ func GetTicker(exchange string, p pair.CurrencyPair, tickerType string) (Price,
 func GetTickerByExchange(exchange string) (*Ticker, error) {
 	m.Lock()
 	defer m.Unlock()
-	for _, y := range Tickers {
-		if y.ExchangeName == exchange {
-			return &y, nil
-		}
+	ticker, ok := Tickers[exchange]
+	if !ok {
+		return nil, errors.New(ErrTickerForExchangeNotFound)
 	}
-	return nil, errors.New(ErrTickerForExchangeNotFound)
+	return ticker, nil
 }
 
 // FirstCurrencyExists checks to see if the first currency of the Price map
@@ -104,14 +112,12 @@ func GetTickerByExchange(exchange string) (*Ticker, error) {
 func FirstCurrencyExists(exchange string, currency pair.CurrencyItem) bool {
 	m.Lock()
 	defer m.Unlock()
-	for _, y := range Tickers {
-		if y.ExchangeName == exchange {
-			if _, ok := y.Price[currency]; ok {
-				return true
-			}
-		}
+	ticker, ok := Tickers[exchange]
+	if !ok {
+		return false
 	}
-	return false
+	_, ok = ticker.Price[currency]
+	return ok
 }
 
 // SecondCurrencyExists checks to see if the second currency of the Price map
@@ -119,36 +125,45 @@ func FirstCurrencyExists(exchange string, currency pair.CurrencyItem) bool {
 func SecondCurrencyExists(exchange string, p pair.CurrencyPair) bool {
 	m.Lock()
 	defer m.Unlock()
-	for _, y := range Tickers {
-		if y.ExchangeName == exchange {
-			if _, ok := y.Price[p.FirstCurrency]; ok {
-				if _, ok := y.Price[p.FirstCurrency][p.SecondCurrency]; ok {
-					return true
-				}
-			}
-		}
+	ticker, ok := Tickers[exchange]
+	if !ok {
+		return false
 	}
-	return false
+	_, ok = ticker.Price[p.FirstCurrency][p.SecondCurrency]
+	return ok
 }
 
 // CreateNewTicker creates a new Ticker
 func CreateNewTicker(exchangeName string, p pair.CurrencyPair, tickerNew Price, tickerType string) Ticker {
 	m.Lock()
 	defer m.Unlock()
-	ticker := Ticker{}
-	ticker.ExchangeName = exchangeName
-	ticker.Price = make(map[pair.CurrencyItem]map[pair.CurrencyItem]map[string]Price)
-	a := make(map[pair.CurrencyItem]map[string]Price)
-	b := make(map[string]Price)
-	b[tickerType] = tickerNew
-	a[p.SecondCurrency] = b
-	ticker.Price[p.FirstCurrency] = a
-	Tickers = append(Tickers, ticker)
-	return ticker
+	ticker := newTicker(exchangeName)
+	ticker.setPrice(p, tickerNew, tickerType)
+	Tickers[exchangeName] = ticker
+	return *ticker
 }
 
-// ProcessTicker processes incoming tickers, creating or updating the Tickers
-// list
+// newTicker returns an empty exchange Ticker
+func newTicker(exchangeName string) *Ticker {
+	return &Ticker{
+		ExchangeName: exchangeName,
+		Price:        make(map[pair.CurrencyItem]map[pair.CurrencyItem]map[string]Price),
+	}
+}
+
+// setPrice stores a price, creating the maps of its currencies as needed
+func (t *Ticker) setPrice(p pair.CurrencyPair, tickerNew Price, tickerType string) {
+	if _, ok := t.Price[p.FirstCurrency]; !ok {
+		t.Price[p.FirstCurrency] = make(map[pair.CurrencyItem]map[string]Price)
+	}
+	if _, ok := t.Price[p.FirstCurrency][p.SecondCurrency]; !ok {
+		t.Price[p.FirstCurrency][p.SecondCurrency] = make(map[string]Price)
+	}
+	t.Price[p.FirstCurrency][p.SecondCurrency][tickerType] = tickerNew
+}
+
+// ProcessTicker processes incoming tickers, storing them by exchange and
+// publishing them on the data bus
 func ProcessTicker(exchangeName string, p pair.CurrencyPair, tickerNew Price, tickerType string) {
 	if tickerNew.Pair.Pair() == "" {
 		// set Pair if not set
@@ -158,26 +173,20 @@ func ProcessTicker(exchangeName string, p pair.CurrencyPair, tickerNew Price, ti
 	tickerNew.CurrencyPair = p.Pair().String()
 	tickerNew.LastUpdated = time.Now()
 
-	ticker, err := GetTickerByExchange(exchangeName)
-	if err != nil {
-		CreateNewTicker(exchangeName, p, tickerNew, tickerType)
-		return
-	}
-
-	if FirstCurrencyExists(exchangeName, p.FirstCurrency) {
-		m.Lock()
-		a := make(map[string]Price)
-		a[tickerType] = tickerNew
-		ticker.Price[p.FirstCurrency][p.SecondCurrency] = a
-		m.Unlock()
-		return
-	}
-
 	m.Lock()
-	a := make(map[pair.CurrencyItem]map[string]Price)
-	b := make(map[string]Price)
-	b[tickerType] = tickerNew
-	a[p.SecondCurrency] = b
-	ticker.Price[p.FirstCurrency] = a
+	ticker, ok := Tickers[exchangeName]
+	if !ok {
+		ticker = newTicker(exchangeName)
+		Tickers[exchangeName] = ticker
+	}
+	ticker.setPrice(p, tickerNew, tickerType)
 	m.Unlock()
+
+	databus.Publish(databus.Message{
+		Exchange:  exchangeName,
+		Pair:      p,
+		Asset:     tickerType,
+		Data:      tickerNew,
+		Timestamp: tickerNew.LastUpdated,
+	})
 }
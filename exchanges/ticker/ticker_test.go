@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
 )
 
 func TestPriceToString(t *testing.T) {
@@ -119,8 +120,7 @@ func TestGetTickerByExchange(t *testing.T) {
 		PriceATH:     1337,
 	}
 
-	anxTicker := CreateNewTicker("ANX", newPair, priceStruct, Spot)
-	Tickers = append(Tickers, anxTicker)
+	CreateNewTicker("ANX", newPair, priceStruct, Spot)
 
 	tickerPtr, err := GetTickerByExchange("ANX")
 	if err != nil {
@@ -145,8 +145,7 @@ func TestFirstCurrencyExists(t *testing.T) {
 		PriceATH:     1337,
 	}
 
-	alphaTicker := CreateNewTicker("alphapoint", newPair, priceStruct, Spot)
-	Tickers = append(Tickers, alphaTicker)
+	CreateNewTicker("alphapoint", newPair, priceStruct, Spot)
 
 	if !FirstCurrencyExists("alphapoint", "BTC") {
 		t.Error("Test Failed - FirstCurrencyExists1 value return is incorrect")
@@ -172,8 +171,7 @@ func TestSecondCurrencyExists(t *testing.T) {
 		PriceATH:     1337,
 	}
 
-	bitstampTicker := CreateNewTicker("bitstamp", newPair, priceStruct, "SPOT")
-	Tickers = append(Tickers, bitstampTicker)
+	CreateNewTicker("bitstamp", newPair, priceStruct, "SPOT")
 
 	if !SecondCurrencyExists("bitstamp", newPair) {
 		t.Error("Test Failed - SecondCurrencyExists1 value return is incorrect")
@@ -241,7 +239,7 @@ func TestCreateNewTicker(t *testing.T) {
 }
 
 func TestProcessTicker(t *testing.T) { //non-appending function to tickers
-	Tickers = []Ticker{}
+	Tickers = make(map[string]*Ticker)
 	newPair := pair.NewCurrencyPair("BTC", "USD")
 	priceStruct := Price{
 		Pair:         newPair,
@@ -333,3 +331,17 @@ func TestProcessTicker(t *testing.T) { //non-appending function to tickers
 	wg.Wait()
 
 }
+
+func TestProcessTickerPublish(t *testing.T) {
+	sub := databus.Subscribe(databus.Filter{Exchanges: []string{"PublishTest"}},
+		1, databus.DropNewest)
+	defer sub.Unsubscribe()
+
+	ProcessTicker("PublishTest", pair.NewCurrencyPair("BTC", "USD"), Price{Last: 1337}, Spot)
+	msg := <-sub.C
+	received, ok := msg.Data.(Price)
+	if !ok || msg.Topic() != databus.Ticker || msg.Asset != Spot ||
+		received.Last != 1337 || received.CurrencyPair != "BTCUSD" {
+		t.Errorf("Test failed. Unexpected published ticker %v", msg)
+	}
+}
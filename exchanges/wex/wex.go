@@ -1,6 +1,7 @@
 package wex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -115,8 +116,8 @@ func (w *WEX) Setup(exch config.ExchangeConfig) {
 }
 
 // GetTradablePairs returns a list of available pairs from the exchange
-func (w *WEX) GetTradablePairs() ([]string, error) {
-	result, err := w.GetInfo()
+func (w *WEX) GetTradablePairs(ctx context.Context) ([]string, error) {
+	result, err := w.GetInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -130,15 +131,15 @@ func (w *WEX) GetTradablePairs() ([]string, error) {
 }
 
 // GetInfo returns the WEX info
-func (w *WEX) GetInfo() (Info, error) {
+func (w *WEX) GetInfo(ctx context.Context) (Info, error) {
 	resp := Info{}
 	req := fmt.Sprintf("%s/%s/%s/", w.APIUrl, wexAPIPublicVersion, wexInfo)
 
-	return resp, w.SendHTTPRequest(req, &resp)
+	return resp, w.SendHTTPRequest(ctx, req, &resp)
 }
 
 // GetTicker returns a ticker for a specific currency
-func (w *WEX) GetTicker(symbol string) (map[string]Ticker, error) {
+func (w *WEX) GetTicker(ctx context.Context, symbol string) (map[string]Ticker, error) {
 	type Response struct {
 		Data map[string]Ticker
 	}
@@ -146,11 +147,11 @@ func (w *WEX) GetTicker(symbol string) (map[string]Ticker, error) {
 	response := Response{}
 	req := fmt.Sprintf("%s/%s/%s/%s", w.APIUrl, wexAPIPublicVersion, wexTicker, symbol)
 
-	return response.Data, w.SendHTTPRequest(req, &response.Data)
+	return response.Data, w.SendHTTPRequest(ctx, req, &response.Data)
 }
 
 // GetDepth returns the depth for a specific currency
-func (w *WEX) GetDepth(symbol string) (Orderbook, error) {
+func (w *WEX) GetDepth(ctx context.Context, symbol string) (Orderbook, error) {
 	type Response struct {
 		Data map[string]Orderbook
 	}
@@ -158,11 +159,11 @@ func (w *WEX) GetDepth(symbol string) (Orderbook, error) {
 	response := Response{}
 	req := fmt.Sprintf("%s/%s/%s/%s", w.APIUrl, wexAPIPublicVersion, wexDepth, symbol)
 
-	return response.Data[symbol], w.SendHTTPRequest(req, &response.Data)
+	return response.Data[symbol], w.SendHTTPRequest(ctx, req, &response.Data)
 }
 
 // GetTrades returns the trades for a specific currency
-func (w *WEX) GetTrades(symbol string) ([]Trades, error) {
+func (w *WEX) GetTrades(ctx context.Context, symbol string) ([]Trades, error) {
 	type Response struct {
 		Data map[string][]Trades
 	}
@@ -170,14 +171,14 @@ func (w *WEX) GetTrades(symbol string) ([]Trades, error) {
 	response := Response{}
 	req := fmt.Sprintf("%s/%s/%s/%s", w.APIUrl, wexAPIPublicVersion, wexTrades, symbol)
 
-	return response.Data[symbol], w.SendHTTPRequest(req, &response.Data)
+	return response.Data[symbol], w.SendHTTPRequest(ctx, req, &response.Data)
 }
 
 // GetAccountInformation returns a users account info
-func (w *WEX) GetAccountInformation() (AccountInfo, error) {
+func (w *WEX) GetAccountInformation(ctx context.Context) (AccountInfo, error) {
 	var result AccountInfo
 
-	err := w.SendAuthenticatedHTTPRequest(wexAccountInfo, url.Values{}, &result)
+	err := w.SendAuthenticatedHTTPRequest(ctx, wexAccountInfo, url.Values{}, &result)
 	if err != nil {
 		return result, err
 	}
@@ -188,34 +189,36 @@ func (w *WEX) GetAccountInformation() (AccountInfo, error) {
 	return result, nil
 }
 
-// GetActiveOrders returns the active orders for a specific currency
-func (w *WEX) GetActiveOrders(pair string) (map[string]ActiveOrders, error) {
+// GetOpenOrders returns the active orders for a specific currency
+func (w *WEX) GetOpenOrders(ctx context.Context, pair string) (map[string]ActiveOrders, error) {
 	req := url.Values{}
-	req.Add("pair", pair)
+	if pair != "" {
+		req.Add("pair", pair)
+	}
 
 	var result map[string]ActiveOrders
 
-	return result, w.SendAuthenticatedHTTPRequest(wexActiveOrders, req, &result)
+	return result, w.SendAuthenticatedHTTPRequest(ctx, wexActiveOrders, req, &result)
 }
 
 // GetOrderInformation returns the order info for a specific order ID
-func (w *WEX) GetOrderInformation(OrderID int64) (map[string]OrderInfo, error) {
+func (w *WEX) GetOrderInformation(ctx context.Context, OrderID int64) (map[string]OrderInfo, error) {
 	req := url.Values{}
 	req.Add("order_id", strconv.FormatInt(OrderID, 10))
 
 	var result map[string]OrderInfo
 
-	return result, w.SendAuthenticatedHTTPRequest(wexOrderInfo, req, &result)
+	return result, w.SendAuthenticatedHTTPRequest(ctx, wexOrderInfo, req, &result)
 }
 
 // CancelExistingOrder cancels an order for a specific order ID
-func (w *WEX) CancelExistingOrder(OrderID int64) (bool, error) {
+func (w *WEX) CancelExistingOrder(ctx context.Context, OrderID int64) (bool, error) {
 	req := url.Values{}
 	req.Add("order_id", strconv.FormatInt(OrderID, 10))
 
 	var result CancelOrder
 
-	err := w.SendAuthenticatedHTTPRequest(wexCancelOrder, req, &result)
+	err := w.SendAuthenticatedHTTPRequest(ctx, wexCancelOrder, req, &result)
 	if err != nil {
 		return false, err
 	}
@@ -227,7 +230,7 @@ func (w *WEX) CancelExistingOrder(OrderID int64) (bool, error) {
 }
 
 // Trade places an order and returns the order ID if successful or an error
-func (w *WEX) Trade(pair, orderType string, amount, price float64) (int64, error) {
+func (w *WEX) Trade(ctx context.Context, pair, orderType string, amount, price float64) (int64, error) {
 	req := url.Values{}
 	req.Add("pair", pair)
 	req.Add("type", orderType)
@@ -236,7 +239,7 @@ func (w *WEX) Trade(pair, orderType string, amount, price float64) (int64, error
 
 	var result Trade
 
-	err := w.SendAuthenticatedHTTPRequest(wexTrade, req, &result)
+	err := w.SendAuthenticatedHTTPRequest(ctx, wexTrade, req, &result)
 	if err != nil {
 		return 0, err
 	}
@@ -248,7 +251,7 @@ func (w *WEX) Trade(pair, orderType string, amount, price float64) (int64, error
 }
 
 // GetTransactionHistory returns the transaction history
-func (w *WEX) GetTransactionHistory(TIDFrom, Count, TIDEnd int64, order, since, end string) (map[string]TransHistory, error) {
+func (w *WEX) GetTransactionHistory(ctx context.Context, TIDFrom, Count, TIDEnd int64, order, since, end string) (map[string]TransHistory, error) {
 	req := url.Values{}
 	req.Add("from", strconv.FormatInt(TIDFrom, 10))
 	req.Add("count", strconv.FormatInt(Count, 10))
@@ -261,28 +264,42 @@ func (w *WEX) GetTransactionHistory(TIDFrom, Count, TIDEnd int64, order, since,
 	var result map[string]TransHistory
 
 	return result,
-		w.SendAuthenticatedHTTPRequest(wexTransactionHistory, req, &result)
+		w.SendAuthenticatedHTTPRequest(ctx, wexTransactionHistory, req, &result)
 }
 
-// GetTradeHistory returns the trade history
-func (w *WEX) GetTradeHistory(TIDFrom, Count, TIDEnd int64, order, since, end, pair string) (map[string]TradeHistory, error) {
+// GetTradeHistory returns the trade history, unset parameters use the
+// exchange defaults
+func (w *WEX) GetTradeHistory(ctx context.Context, TIDFrom, Count, TIDEnd int64, order, since, end, pair string) (map[string]TradeHistory, error) {
 	req := url.Values{}
-	req.Add("from", strconv.FormatInt(TIDFrom, 10))
-	req.Add("count", strconv.FormatInt(Count, 10))
-	req.Add("from_id", strconv.FormatInt(TIDFrom, 10))
-	req.Add("end_id", strconv.FormatInt(TIDEnd, 10))
-	req.Add("order", order)
-	req.Add("since", since)
-	req.Add("end", end)
-	req.Add("pair", pair)
+	if TIDFrom != 0 {
+		req.Add("from_id", strconv.FormatInt(TIDFrom, 10))
+	}
+	if Count != 0 {
+		req.Add("count", strconv.FormatInt(Count, 10))
+	}
+	if TIDEnd != 0 {
+		req.Add("end_id", strconv.FormatInt(TIDEnd, 10))
+	}
+	if order != "" {
+		req.Add("order", order)
+	}
+	if since != "" {
+		req.Add("since", since)
+	}
+	if end != "" {
+		req.Add("end", end)
+	}
+	if pair != "" {
+		req.Add("pair", pair)
+	}
 
 	var result map[string]TradeHistory
 
-	return result, w.SendAuthenticatedHTTPRequest(wexTradeHistory, req, &result)
+	return result, w.SendAuthenticatedHTTPRequest(ctx, wexTradeHistory, req, &result)
 }
 
 // WithdrawCoins withdraws coins for a specific coin
-func (w *WEX) WithdrawCoins(coin string, amount float64, address string) (WithdrawCoins, error) {
+func (w *WEX) WithdrawCoins(ctx context.Context, coin string, amount float64, address string) (WithdrawCoins, error) {
 	req := url.Values{}
 	req.Add("coinName", coin)
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
@@ -290,7 +307,7 @@ func (w *WEX) WithdrawCoins(coin string, amount float64, address string) (Withdr
 
 	var result WithdrawCoins
 
-	err := w.SendAuthenticatedHTTPRequest(wexWithdrawCoin, req, &result)
+	err := w.SendAuthenticatedHTTPRequest(ctx, wexWithdrawCoin, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -302,13 +319,13 @@ func (w *WEX) WithdrawCoins(coin string, amount float64, address string) (Withdr
 }
 
 // CoinDepositAddress returns the deposit address for a specific currency
-func (w *WEX) CoinDepositAddress(coin string) (string, error) {
+func (w *WEX) CoinDepositAddress(ctx context.Context, coin string) (string, error) {
 	req := url.Values{}
 	req.Add("coinName", coin)
 
 	var result CoinDepositAddress
 
-	err := w.SendAuthenticatedHTTPRequest(wexCoinDepositAddress, req, &result)
+	err := w.SendAuthenticatedHTTPRequest(ctx, wexCoinDepositAddress, req, &result)
 	if err != nil {
 		return result.Address, err
 	}
@@ -319,14 +336,14 @@ func (w *WEX) CoinDepositAddress(coin string) (string, error) {
 }
 
 // CreateCoupon creates an exchange coupon for a sepcific currency
-func (w *WEX) CreateCoupon(currency string, amount float64) (CreateCoupon, error) {
+func (w *WEX) CreateCoupon(ctx context.Context, currency string, amount float64) (CreateCoupon, error) {
 	req := url.Values{}
 	req.Add("currency", currency)
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 
 	var result CreateCoupon
 
-	err := w.SendAuthenticatedHTTPRequest(wexCreateCoupon, req, &result)
+	err := w.SendAuthenticatedHTTPRequest(ctx, wexCreateCoupon, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -337,13 +354,13 @@ func (w *WEX) CreateCoupon(currency string, amount float64) (CreateCoupon, error
 }
 
 // RedeemCoupon redeems an exchange coupon
-func (w *WEX) RedeemCoupon(coupon string) (RedeemCoupon, error) {
+func (w *WEX) RedeemCoupon(ctx context.Context, coupon string) (RedeemCoupon, error) {
 	req := url.Values{}
 	req.Add("coupon", coupon)
 
 	var result RedeemCoupon
 
-	err := w.SendAuthenticatedHTTPRequest(wexRedeemCoupon, req, &result)
+	err := w.SendAuthenticatedHTTPRequest(ctx, wexRedeemCoupon, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -354,12 +371,12 @@ func (w *WEX) RedeemCoupon(coupon string) (RedeemCoupon, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (w *WEX) SendHTTPRequest(path string, result interface{}) error {
-	return w.SendPayload("GET", path, nil, nil, result, false, w.Verbose)
+func (w *WEX) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return w.SendPayload(ctx, "GET", path, nil, nil, result, false, w.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to WEX
-func (w *WEX) SendAuthenticatedHTTPRequest(method string, values url.Values, result interface{}) (err error) {
+func (w *WEX) SendAuthenticatedHTTPRequest(ctx context.Context, method string, values url.Values, result interface{}) (err error) {
 	if !w.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			w.Name)
@@ -388,21 +405,30 @@ func (w *WEX) SendAuthenticatedHTTPRequest(method string, values url.Values, res
 	headers["Sign"] = common.HexEncodeToString(hmac)
 	headers["Content-Type"] = "application/x-www-form-urlencoded"
 
-	return w.SendPayload("POST",
+	response := Response{Return: result}
+	err = w.SendPayload(ctx, "POST",
 		w.APIUrlSecondary,
 		headers,
 		strings.NewReader(encoded),
-		result,
+		&response,
 		true,
 		w.Verbose)
+	if err != nil {
+		return err
+	}
+
+	if response.Success != 1 {
+		return errors.New(response.Error)
+	}
+	return nil
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (w *WEX) GetFee(feeBuilder exchange.FeeBuilder) (float64, error) {
+func (w *WEX) GetFee(ctx context.Context, feeBuilder exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		info, err := w.GetInfo()
+		info, err := w.GetInfo(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -1,16 +1,25 @@
 package wex
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var w WEX
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 // Please supply your own keys for better unit testing
 const (
 	apiKey                  = ""
@@ -42,7 +51,7 @@ func TestGetTradablePairs(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetTradablePairs()
+	_, err := w.GetTradablePairs(context.Background())
 	if err != nil {
 		t.Errorf("Test failed. GetTradablePairs err: %s", err)
 	}
@@ -53,7 +62,7 @@ func TestGetInfo(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetInfo()
+	_, err := w.GetInfo(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetInfo() error")
 	}
@@ -64,7 +73,7 @@ func TestGetTicker(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetTicker("btc_usd")
+	_, err := w.GetTicker(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("Test Failed - GetTicker() error", err)
 	}
@@ -75,7 +84,7 @@ func TestGetDepth(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetDepth("btc_usd")
+	_, err := w.GetDepth(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("Test Failed - GetDepth() error", err)
 	}
@@ -86,7 +95,7 @@ func TestGetTrades(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetTrades("btc_usd")
+	_, err := w.GetTrades(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("Test Failed - GetTrades() error", err)
 	}
@@ -97,20 +106,20 @@ func TestGetAccountInfo(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetAccountInfo()
+	_, err := w.GetAccountInfo(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetAccountInfo() error", err)
 	}
 }
 
-func TestGetActiveOrders(t *testing.T) {
+func TestGetOpenOrders(t *testing.T) {
 	if isWexEncounteringIssues {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetActiveOrders("")
+	_, err := w.GetOpenOrders(context.Background(), "")
 	if err == nil {
-		t.Error("Test Failed - GetActiveOrders() error", err)
+		t.Error("Test Failed - GetOpenOrders() error", err)
 	}
 }
 
@@ -119,7 +128,7 @@ func TestGetOrderInfo(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetOrderInfo(6196974)
+	_, err := w.GetOrderInfo(context.Background(), "6196974")
 	if err == nil {
 		t.Error("Test Failed - GetOrderInfo() error", err)
 	}
@@ -130,7 +139,7 @@ func TestCancelExistingOrder(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.CancelExistingOrder(1337)
+	_, err := w.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - CancelExistingOrder() error", err)
 	}
@@ -141,7 +150,7 @@ func TestTrade(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.Trade("", "buy", 0, 0)
+	_, err := w.Trade(context.Background(), "", "buy", 0, 0)
 	if err == nil {
 		t.Error("Test Failed - Trade() error", err)
 	}
@@ -152,7 +161,7 @@ func TestGetTransactionHistory(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetTransactionHistory(0, 0, 0, "", "", "")
+	_, err := w.GetTransactionHistory(context.Background(), 0, 0, 0, "", "", "")
 	if err == nil {
 		t.Error("Test Failed - GetTransactionHistory() error", err)
 	}
@@ -163,7 +172,7 @@ func TestGetTradeHistory(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.GetTradeHistory(0, 0, 0, "", "", "", "")
+	_, err := w.GetTradeHistory(context.Background(), 0, 0, 0, "", "", "", "")
 	if err == nil {
 		t.Error("Test Failed - GetTradeHistory() error", err)
 	}
@@ -174,7 +183,7 @@ func TestWithdrawCoins(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.WithdrawCoins("", 0, "")
+	_, err := w.WithdrawCoins(context.Background(), "", 0, "")
 	if err == nil {
 		t.Error("Test Failed - WithdrawCoins() error", err)
 	}
@@ -185,7 +194,7 @@ func TestCoinDepositAddress(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.CoinDepositAddress("btc")
+	_, err := w.CoinDepositAddress(context.Background(), "btc")
 	if err == nil {
 		t.Error("Test Failed - WithdrawCoins() error", err)
 	}
@@ -196,7 +205,7 @@ func TestCreateCoupon(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.CreateCoupon("bla", 0)
+	_, err := w.CreateCoupon(context.Background(), "bla", 0)
 	if err == nil {
 		t.Error("Test Failed - CreateCoupon() error", err)
 	}
@@ -207,7 +216,7 @@ func TestRedeemCoupon(t *testing.T) {
 		t.Skip()
 	}
 	t.Parallel()
-	_, err := w.RedeemCoupon("bla")
+	_, err := w.RedeemCoupon(context.Background(), "bla")
 	if err == nil {
 		t.Error("Test Failed - RedeemCoupon() error", err)
 	}
@@ -236,7 +245,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 
 	// CryptocurrencyTradeFee Basic
-	if resp, err := w.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+	if resp, err := w.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 		t.Error(err)
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.002), resp)
 	}
@@ -245,7 +254,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := w.GetFee(feeBuilder); resp != float64(2000) || err != nil {
+	if resp, err := w.GetFee(context.Background(), feeBuilder); resp != float64(2000) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(2000), resp)
 		t.Error(err)
 	}
@@ -253,7 +262,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := w.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+	if resp, err := w.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.002), resp)
 		t.Error(err)
 	}
@@ -261,14 +270,14 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := w.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := w.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := w.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := w.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -277,7 +286,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FirstCurrency = "hello"
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := w.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := w.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -285,7 +294,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := w.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := w.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -293,7 +302,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := w.GetFee(feeBuilder); resp != float64(0.065) || err != nil {
+	if resp, err := w.GetFee(context.Background(), feeBuilder); resp != float64(0.065) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0.065), resp)
 		t.Error(err)
 	}
@@ -302,7 +311,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.CurrencyItem = symbol.USD
-	if resp, err := w.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := w.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Test Failed - GetFee() error. Expected: %f, Recieved: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -323,6 +332,60 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a WEX using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *WEX {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	conf, err := cfg.GetExchangeConfig("WEX")
+	if err != nil {
+		t.Fatal("Test Failed - WEX Setup() init error")
+	}
+
+	conf.AuthenticatedAPISupport = true
+	conf.APIKey, conf.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r WEX
+	r.SetDefaults()
+	r.Setup(conf)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.LTC, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - WEX GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "343152" ||
+		orders[0].BaseCurrency != symbol.LTC || orders[0].QuoteCurrency != symbol.BTC ||
+		orders[0].OrderSide != "sell" || orders[0].Status != "ACTIVE" ||
+		orders[0].Price != 0.015 || orders[0].OpenVolume != 1.5 ||
+		orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - WEX GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		StartTicks: time.Unix(1529900000, 0),
+	})
+	if err != nil {
+		t.Fatal("Test Failed - WEX GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "343148" ||
+		orders[0].Status != "EXECUTED" || orders[0].OrderSide != "buy" ||
+		orders[0].Price != 0.0148 || orders[0].ExecutedAmount != 2) {
+		t.Errorf("Test Failed - WEX GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -351,7 +414,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USD,
 	}
-	response, err := w.SubmitOrder(pair, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := w.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: pair, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -380,7 +443,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := w.CancelOrder(orderCancellation)
+	err := w.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
@@ -46,7 +46,7 @@ type Trades struct {
 // ActiveOrders stores active order information
 type ActiveOrders struct {
 	Pair             string  `json:"pair"`
-	Type             string  `json:"sell"`
+	Type             string  `json:"type"`
 	Amount           float64 `json:"amount"`
 	Rate             float64 `json:"rate"`
 	TimestampCreated float64 `json:"time_created"`
@@ -80,7 +80,7 @@ type AccountInfo struct {
 // OrderInfo stores order information
 type OrderInfo struct {
 	Pair             string  `json:"pair"`
-	Type             string  `json:"sell"`
+	Type             string  `json:"type"`
 	StartAmount      float64 `json:"start_amount"`
 	Amount           float64 `json:"amount"`
 	Rate             float64 `json:"rate"`
@@ -1,10 +1,12 @@
 package wex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -13,6 +15,14 @@ import (
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
 )
 
+// orderStatus maps the WEX order status codes to order statuses
+var orderStatus = map[int]string{
+	0: "ACTIVE",
+	1: "EXECUTED",
+	2: "CANCELLED",
+	3: "PARTIALLY_CANCELLED",
+}
+
 // Start starts the WEX go routine
 func (w *WEX) Start(wg *sync.WaitGroup) {
 	wg.Add(1)
@@ -30,7 +40,7 @@ func (w *WEX) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", w.GetName(), len(w.EnabledPairs), w.EnabledPairs)
 	}
 
-	exchangeProducts, err := w.GetTradablePairs()
+	exchangeProducts, err := w.GetTradablePairs(context.Background())
 	if err != nil {
 		log.Printf("%s Failed to get available symbols.\n", w.GetName())
 	} else {
@@ -56,14 +66,14 @@ func (w *WEX) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (w *WEX) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (w *WEX) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	pairsCollated, err := exchange.GetAndFormatExchangeCurrencies(w.Name, w.GetEnabledCurrencies())
 	if err != nil {
 		return tickerPrice, err
 	}
 
-	result, err := w.GetTicker(pairsCollated.String())
+	result, err := w.GetTicker(ctx, pairsCollated.String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -84,27 +94,27 @@ func (w *WEX) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (w *WEX) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (w *WEX) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(w.GetName(), p, assetType)
 	if err != nil {
-		return w.UpdateTicker(p, assetType)
+		return w.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (w *WEX) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (w *WEX) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(w.GetName(), p, assetType)
 	if err != nil {
-		return w.UpdateOrderbook(p, assetType)
+		return w.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (w *WEX) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (w *WEX) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := w.GetDepth(exchange.FormatExchangeCurrency(w.Name, p).String())
+	orderbookNew, err := w.GetDepth(ctx, exchange.FormatExchangeCurrency(w.Name, p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -125,10 +135,10 @@ func (w *WEX) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // WEX exchange
-func (w *WEX) GetAccountInfo() (exchange.AccountInfo, error) {
+func (w *WEX) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = w.GetName()
-	accountBalance, err := w.GetAccountInformation()
+	accountBalance, err := w.GetAccountInformation(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -146,22 +156,26 @@ func (w *WEX) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (w *WEX) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (w *WEX) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (w *WEX) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (w *WEX) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (w *WEX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (w *WEX) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	response, err := w.Trade(common.StringToLower(p.Pair().String()), common.StringToLower(side.ToString()), amount, price)
+	if err := w.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	response, err := w.Trade(ctx, common.StringToLower(order.Pair.Pair().String()), common.StringToLower(order.Side.ToString()), order.Amount, order.Price)
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -176,48 +190,75 @@ func (w *WEX) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderTyp
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (w *WEX) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (w *WEX) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (w *WEX) CancelOrder(order exchange.OrderCancellation) error {
+func (w *WEX) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = w.CancelExistingOrder(orderIDInt)
+	_, err = w.CancelExistingOrder(ctx, orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (w *WEX) CancelAllOrders() error {
+func (w *WEX) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (w *WEX) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (w *WEX) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (w *WEX) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (w *WEX) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (w *WEX) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (w *WEX) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (w *WEX) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (w *WEX) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (w *WEX) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (w *WEX) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (w *WEX) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (w *WEX) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (w *WEX) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -234,10 +275,97 @@ func (w *WEX) GetWebsocket() (*exchange.Websocket, error) {
 
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (w *WEX) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
-	return w.GetFee(feeBuilder)
+	return w.GetFee(context.Background(), feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
 func (w *WEX) GetWithdrawCapabilities() uint32 {
 	return w.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (w *WEX) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	symbols := []string{""}
+	if len(getOrdersRequest.Currencies) > 0 {
+		symbols = symbols[:0]
+		for _, c := range getOrdersRequest.Currencies {
+			symbols = append(symbols,
+				exchange.FormatExchangeCurrency(w.Name, c).String())
+		}
+	}
+
+	var orders []exchange.OrderDetail
+	for _, symbol := range symbols {
+		resp, err := w.GetOpenOrders(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		for ID, order := range resp {
+			p := pair.NewCurrencyPairDelimiter(order.Pair, "_")
+			orderDate := time.Unix(int64(order.TimestampCreated), 0)
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:      w.Name,
+				ID:            ID,
+				BaseCurrency:  p.FirstCurrency.Upper().String(),
+				QuoteCurrency: p.SecondCurrency.Upper().String(),
+				OrderSide:     order.Type,
+				OrderType:     exchange.Limit.ToString(),
+				CreationTime:  orderDate.Unix(),
+				OrderDate:     orderDate,
+				Status:        orderStatus[order.Status],
+				Price:         order.Rate,
+				Amount:        order.Amount,
+				OpenVolume:    order.Amount,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (w *WEX) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	var since, end string
+	if !getOrdersRequest.StartTicks.IsZero() {
+		since = strconv.FormatInt(getOrdersRequest.StartTicks.Unix(), 10)
+	}
+	if !getOrdersRequest.EndTicks.IsZero() {
+		end = strconv.FormatInt(getOrdersRequest.EndTicks.Unix(), 10)
+	}
+
+	resp, err := w.GetTradeHistory(ctx, 0, 0, 0, "", since, end, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// WEX only returns past trades, each fill is reported as a filled order
+	var orders []exchange.OrderDetail
+	for _, trade := range resp {
+		p := pair.NewCurrencyPairDelimiter(trade.Pair, "_")
+		orderDate := time.Unix(int64(trade.Timestamp), 0)
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:       w.Name,
+			ID:             strconv.FormatFloat(trade.OrderID, 'f', -1, 64),
+			BaseCurrency:   p.FirstCurrency.Upper().String(),
+			QuoteCurrency:  p.SecondCurrency.Upper().String(),
+			OrderSide:      trade.Type,
+			OrderType:      exchange.Limit.ToString(),
+			CreationTime:   orderDate.Unix(),
+			OrderDate:      orderDate,
+			Status:         orderStatus[1],
+			Price:          trade.Rate,
+			Amount:         trade.Amount,
+			ExecutedAmount: trade.Amount,
+		})
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (w *WEX) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
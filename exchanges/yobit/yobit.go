@@ -1,6 +1,7 @@
 package yobit
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -115,15 +116,15 @@ func (y *Yobit) Setup(exch config.ExchangeConfig) {
 }
 
 // GetInfo returns the Yobit info
-func (y *Yobit) GetInfo() (Info, error) {
+func (y *Yobit) GetInfo(ctx context.Context) (Info, error) {
 	resp := Info{}
 	path := fmt.Sprintf("%s/%s/%s/", y.APIUrl, apiPublicVersion, publicInfo)
 
-	return resp, y.SendHTTPRequest(path, &resp)
+	return resp, y.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTicker returns a ticker for a specific currency
-func (y *Yobit) GetTicker(symbol string) (map[string]Ticker, error) {
+func (y *Yobit) GetTicker(ctx context.Context, symbol string) (map[string]Ticker, error) {
 	type Response struct {
 		Data map[string]Ticker
 	}
@@ -131,11 +132,11 @@ func (y *Yobit) GetTicker(symbol string) (map[string]Ticker, error) {
 	response := Response{}
 	path := fmt.Sprintf("%s/%s/%s/%s", y.APIUrl, apiPublicVersion, publicTicker, symbol)
 
-	return response.Data, y.SendHTTPRequest(path, &response.Data)
+	return response.Data, y.SendHTTPRequest(ctx, path, &response.Data)
 }
 
 // GetDepth returns the depth for a specific currency
-func (y *Yobit) GetDepth(symbol string) (Orderbook, error) {
+func (y *Yobit) GetDepth(ctx context.Context, symbol string) (Orderbook, error) {
 	type Response struct {
 		Data map[string]Orderbook
 	}
@@ -144,11 +145,11 @@ func (y *Yobit) GetDepth(symbol string) (Orderbook, error) {
 	path := fmt.Sprintf("%s/%s/%s/%s", y.APIUrl, apiPublicVersion, publicDepth, symbol)
 
 	return response.Data[symbol],
-		y.SendHTTPRequest(path, &response.Data)
+		y.SendHTTPRequest(ctx, path, &response.Data)
 }
 
 // GetTrades returns the trades for a specific currency
-func (y *Yobit) GetTrades(symbol string) ([]Trades, error) {
+func (y *Yobit) GetTrades(ctx context.Context, symbol string) ([]Trades, error) {
 	type Response struct {
 		Data map[string][]Trades
 	}
@@ -156,14 +157,14 @@ func (y *Yobit) GetTrades(symbol string) ([]Trades, error) {
 	response := Response{}
 	path := fmt.Sprintf("%s/%s/%s/%s", y.APIUrl, apiPublicVersion, publicTrades, symbol)
 
-	return response.Data[symbol], y.SendHTTPRequest(path, &response.Data)
+	return response.Data[symbol], y.SendHTTPRequest(ctx, path, &response.Data)
 }
 
 // GetAccountInformation returns a users account info
-func (y *Yobit) GetAccountInformation() (AccountInfo, error) {
+func (y *Yobit) GetAccountInformation(ctx context.Context) (AccountInfo, error) {
 	result := AccountInfo{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateAccountInfo, url.Values{}, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateAccountInfo, url.Values{}, &result)
 	if err != nil {
 		return result, err
 	}
@@ -174,7 +175,7 @@ func (y *Yobit) GetAccountInformation() (AccountInfo, error) {
 }
 
 // Trade places an order and returns the order ID if successful or an error
-func (y *Yobit) Trade(pair, orderType string, amount, price float64) (int64, error) {
+func (y *Yobit) Trade(ctx context.Context, pair, orderType string, amount, price float64) (int64, error) {
 	req := url.Values{}
 	req.Add("pair", pair)
 	req.Add("type", orderType)
@@ -183,7 +184,7 @@ func (y *Yobit) Trade(pair, orderType string, amount, price float64) (int64, err
 
 	result := Trade{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateTrade, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateTrade, req, &result)
 	if err != nil {
 		return int64(result.OrderID), err
 	}
@@ -193,34 +194,36 @@ func (y *Yobit) Trade(pair, orderType string, amount, price float64) (int64, err
 	return int64(result.OrderID), nil
 }
 
-// GetActiveOrders returns the active orders for a specific currency
-func (y *Yobit) GetActiveOrders(pair string) (map[string]ActiveOrders, error) {
+// GetOpenOrders returns the active orders for a specific currency
+func (y *Yobit) GetOpenOrders(ctx context.Context, pair string) (map[string]ActiveOrders, error) {
 	req := url.Values{}
-	req.Add("pair", pair)
+	if pair != "" {
+		req.Add("pair", pair)
+	}
 
 	result := map[string]ActiveOrders{}
 
-	return result, y.SendAuthenticatedHTTPRequest(privateActiveOrders, req, &result)
+	return result, y.SendAuthenticatedHTTPRequest(ctx, privateActiveOrders, req, &result)
 }
 
 // GetOrderInformation returns the order info for a specific order ID
-func (y *Yobit) GetOrderInformation(OrderID int64) (map[string]OrderInfo, error) {
+func (y *Yobit) GetOrderInformation(ctx context.Context, OrderID int64) (map[string]OrderInfo, error) {
 	req := url.Values{}
 	req.Add("order_id", strconv.FormatInt(OrderID, 10))
 
 	result := map[string]OrderInfo{}
 
-	return result, y.SendAuthenticatedHTTPRequest(privateOrderInfo, req, &result)
+	return result, y.SendAuthenticatedHTTPRequest(ctx, privateOrderInfo, req, &result)
 }
 
 // CancelExistingOrder cancels an order for a specific order ID
-func (y *Yobit) CancelExistingOrder(OrderID int64) (bool, error) {
+func (y *Yobit) CancelExistingOrder(ctx context.Context, OrderID int64) (bool, error) {
 	req := url.Values{}
 	req.Add("order_id", strconv.FormatInt(OrderID, 10))
 
 	result := CancelOrder{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateCancelOrder, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateCancelOrder, req, &result)
 	if err != nil {
 		return false, err
 	}
@@ -230,31 +233,45 @@ func (y *Yobit) CancelExistingOrder(OrderID int64) (bool, error) {
 	return true, nil
 }
 
-// GetTradeHistory returns the trade history
-func (y *Yobit) GetTradeHistory(TIDFrom, Count, TIDEnd int64, order, since, end, pair string) (map[string]TradeHistory, error) {
+// GetTradeHistory returns the trade history, unset parameters use the
+// exchange defaults
+func (y *Yobit) GetTradeHistory(ctx context.Context, TIDFrom, Count, TIDEnd int64, order, since, end, pair string) (map[string]TradeHistory, error) {
 	req := url.Values{}
-	req.Add("from", strconv.FormatInt(TIDFrom, 10))
-	req.Add("count", strconv.FormatInt(Count, 10))
-	req.Add("from_id", strconv.FormatInt(TIDFrom, 10))
-	req.Add("end_id", strconv.FormatInt(TIDEnd, 10))
-	req.Add("order", order)
-	req.Add("since", since)
-	req.Add("end", end)
-	req.Add("pair", pair)
+	if TIDFrom != 0 {
+		req.Add("from_id", strconv.FormatInt(TIDFrom, 10))
+	}
+	if Count != 0 {
+		req.Add("count", strconv.FormatInt(Count, 10))
+	}
+	if TIDEnd != 0 {
+		req.Add("end_id", strconv.FormatInt(TIDEnd, 10))
+	}
+	if order != "" {
+		req.Add("order", order)
+	}
+	if since != "" {
+		req.Add("since", since)
+	}
+	if end != "" {
+		req.Add("end", end)
+	}
+	if pair != "" {
+		req.Add("pair", pair)
+	}
 
 	result := map[string]TradeHistory{}
 
-	return result, y.SendAuthenticatedHTTPRequest(privateTradeHistory, req, &result)
+	return result, y.SendAuthenticatedHTTPRequest(ctx, privateTradeHistory, req, &result)
 }
 
 // GetCryptoDepositAddress returns the deposit address for a specific currency
-func (y *Yobit) GetCryptoDepositAddress(coin string) (DepositAddress, error) {
+func (y *Yobit) GetCryptoDepositAddress(ctx context.Context, coin string) (DepositAddress, error) {
 	req := url.Values{}
 	req.Add("coinName", coin)
 
 	result := DepositAddress{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateGetDepositAddress, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateGetDepositAddress, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -265,7 +282,7 @@ func (y *Yobit) GetCryptoDepositAddress(coin string) (DepositAddress, error) {
 }
 
 // WithdrawCoinsToAddress initiates a withdrawal to a specified address
-func (y *Yobit) WithdrawCoinsToAddress(coin string, amount float64, address string) (WithdrawCoinsToAddress, error) {
+func (y *Yobit) WithdrawCoinsToAddress(ctx context.Context, coin string, amount float64, address string) (WithdrawCoinsToAddress, error) {
 	req := url.Values{}
 	req.Add("coinName", coin)
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
@@ -273,7 +290,7 @@ func (y *Yobit) WithdrawCoinsToAddress(coin string, amount float64, address stri
 
 	result := WithdrawCoinsToAddress{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateWithdrawCoinsToAddress, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateWithdrawCoinsToAddress, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -284,14 +301,14 @@ func (y *Yobit) WithdrawCoinsToAddress(coin string, amount float64, address stri
 }
 
 // CreateCoupon creates an exchange coupon for a sepcific currency
-func (y *Yobit) CreateCoupon(currency string, amount float64) (CreateCoupon, error) {
+func (y *Yobit) CreateCoupon(ctx context.Context, currency string, amount float64) (CreateCoupon, error) {
 	req := url.Values{}
 	req.Add("currency", currency)
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 
 	var result CreateCoupon
 
-	err := y.SendAuthenticatedHTTPRequest(privateCreateCoupon, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateCreateCoupon, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -302,13 +319,13 @@ func (y *Yobit) CreateCoupon(currency string, amount float64) (CreateCoupon, err
 }
 
 // RedeemCoupon redeems an exchange coupon
-func (y *Yobit) RedeemCoupon(coupon string) (RedeemCoupon, error) {
+func (y *Yobit) RedeemCoupon(ctx context.Context, coupon string) (RedeemCoupon, error) {
 	req := url.Values{}
 	req.Add("coupon", coupon)
 
 	result := RedeemCoupon{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateRedeemCoupon, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateRedeemCoupon, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -319,12 +336,12 @@ func (y *Yobit) RedeemCoupon(coupon string) (RedeemCoupon, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (y *Yobit) SendHTTPRequest(path string, result interface{}) error {
-	return y.SendPayload("GET", path, nil, nil, result, false, y.Verbose)
+func (y *Yobit) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return y.SendPayload(ctx, "GET", path, nil, nil, result, false, y.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to Yobit
-func (y *Yobit) SendAuthenticatedHTTPRequest(path string, params url.Values, result interface{}) (err error) {
+func (y *Yobit) SendAuthenticatedHTTPRequest(ctx context.Context, path string, params url.Values, result interface{}) (err error) {
 	if !y.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, y.Name)
 	}
@@ -353,7 +370,16 @@ func (y *Yobit) SendAuthenticatedHTTPRequest(path string, params url.Values, res
 	headers["Sign"] = common.HexEncodeToString(hmac)
 	headers["Content-Type"] = "application/x-www-form-urlencoded"
 
-	return y.SendPayload("POST", apiPrivateURL, headers, strings.NewReader(encoded), result, true, y.Verbose)
+	response := Response{Return: result}
+	err = y.SendPayload(ctx, "POST", apiPrivateURL, headers, strings.NewReader(encoded), &response, true, y.Verbose)
+	if err != nil {
+		return err
+	}
+
+	if response.Success != 1 {
+		return errors.New(response.Error)
+	}
+	return nil
 }
 
 // GetFee returns an estimate of fee based on type of transaction
@@ -1,16 +1,25 @@
 package yobit
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 var y Yobit
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 // Please supply your own keys for better unit testing
 const (
 	apiKey                  = ""
@@ -38,7 +47,7 @@ func TestSetup(t *testing.T) {
 
 func TestGetInfo(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetInfo()
+	_, err := y.GetInfo(context.Background())
 	if err != nil {
 		t.Error("Test Failed - GetInfo() error")
 	}
@@ -46,7 +55,7 @@ func TestGetInfo(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetTicker("btc_usd")
+	_, err := y.GetTicker(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("Test Failed - GetTicker() error", err)
 	}
@@ -54,7 +63,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetDepth("btc_usd")
+	_, err := y.GetDepth(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("Test Failed - GetDepth() error", err)
 	}
@@ -62,7 +71,7 @@ func TestGetDepth(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetTrades("btc_usd")
+	_, err := y.GetTrades(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("Test Failed - GetTrades() error", err)
 	}
@@ -70,23 +79,23 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetAccountInfo()
+	_, err := y.GetAccountInfo(context.Background())
 	if err == nil {
 		t.Error("Test Failed - GetAccountInfo() error", err)
 	}
 }
 
-func TestGetActiveOrders(t *testing.T) {
+func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetActiveOrders("")
+	_, err := y.GetOpenOrders(context.Background(), "")
 	if err == nil {
-		t.Error("Test Failed - GetActiveOrders() error", err)
+		t.Error("Test Failed - GetOpenOrders() error", err)
 	}
 }
 
 func TestGetOrderInfo(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetOrderInfo(6196974)
+	_, err := y.GetOrderInfo(context.Background(), "6196974")
 	if err == nil {
 		t.Error("Test Failed - GetOrderInfo() error", err)
 	}
@@ -94,7 +103,7 @@ func TestGetOrderInfo(t *testing.T) {
 
 func TestCancelOrder(t *testing.T) {
 	t.Parallel()
-	_, err := y.CancelExistingOrder(1337)
+	_, err := y.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("Test Failed - CancelOrder() error", err)
 	}
@@ -102,7 +111,7 @@ func TestCancelOrder(t *testing.T) {
 
 func TestTrade(t *testing.T) {
 	t.Parallel()
-	_, err := y.Trade("", "buy", 0, 0)
+	_, err := y.Trade(context.Background(), "", "buy", 0, 0)
 	if err == nil {
 		t.Error("Test Failed - Trade() error", err)
 	}
@@ -110,7 +119,7 @@ func TestTrade(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetTradeHistory(0, 0, 0, "", "", "", "")
+	_, err := y.GetTradeHistory(context.Background(), 0, 0, 0, "", "", "", "")
 	if err == nil {
 		t.Error("Test Failed - GetTradeHistory() error", err)
 	}
@@ -118,7 +127,7 @@ func TestGetTradeHistory(t *testing.T) {
 
 func TestWithdrawCoinsToAddress(t *testing.T) {
 	t.Parallel()
-	_, err := y.WithdrawCoinsToAddress("", 0, "")
+	_, err := y.WithdrawCoinsToAddress(context.Background(), "", 0, "")
 	if err == nil {
 		t.Error("Test Failed - WithdrawCoinsToAddress() error", err)
 	}
@@ -126,7 +135,7 @@ func TestWithdrawCoinsToAddress(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetDepositAddress("btc")
+	_, err := y.GetDepositAddress(context.Background(), "btc")
 	if err == nil {
 		t.Error("Test Failed - GetDepositAddress() error", err)
 	}
@@ -134,7 +143,7 @@ func TestGetDepositAddress(t *testing.T) {
 
 func TestCreateYobicode(t *testing.T) {
 	t.Parallel()
-	_, err := y.CreateCoupon("bla", 0)
+	_, err := y.CreateCoupon(context.Background(), "bla", 0)
 	if err == nil {
 		t.Error("Test Failed - CreateYobicode() error", err)
 	}
@@ -142,7 +151,7 @@ func TestCreateYobicode(t *testing.T) {
 
 func TestRedeemYobicode(t *testing.T) {
 	t.Parallel()
-	_, err := y.RedeemCoupon("bla2")
+	_, err := y.RedeemCoupon(context.Background(), "bla2")
 	if err == nil {
 		t.Error("Test Failed - RedeemYobicode() error", err)
 	}
@@ -312,6 +321,60 @@ func TestFormatWithdrawPermissions(t *testing.T) {
 	}
 }
 
+// replayExchange returns a Yobit using the credentials of the recorded
+// fixture, its requests are replayed until the test ends
+func replayExchange(t *testing.T) *Yobit {
+	cfg := config.GetConfig()
+	cfg.LoadConfig("../../testdata/configtest.json")
+	conf, err := cfg.GetExchangeConfig("Yobit")
+	if err != nil {
+		t.Fatal("Test Failed - Yobit Setup() init error")
+	}
+
+	conf.AuthenticatedAPISupport = true
+	conf.APIKey, conf.APISecret = request.FixtureCredentials(t, apiKey, apiSecret)
+
+	var r Yobit
+	r.SetDefaults()
+	r.Setup(conf)
+
+	return &r
+}
+
+func TestGetActiveOrders(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetActiveOrders(context.Background(), exchange.GetOrdersRequest{
+		Currencies: []pair.CurrencyPair{pair.NewCurrencyPair(symbol.LTC, symbol.BTC)},
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Yobit GetActiveOrders() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "343152" ||
+		orders[0].BaseCurrency != symbol.LTC || orders[0].QuoteCurrency != symbol.BTC ||
+		orders[0].OrderSide != "sell" || orders[0].Status != "ACTIVE" ||
+		orders[0].Price != 0.015 || orders[0].OpenVolume != 1.5 ||
+		orders[0].OrderDate.Unix() != 1530000000) {
+		t.Errorf("Test Failed - Yobit GetActiveOrders() unexpected orders %+v", orders)
+	}
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	r := replayExchange(t)
+	orders, err := r.GetOrderHistory(context.Background(), exchange.GetOrdersRequest{
+		StartTicks: time.Unix(1529900000, 0),
+	})
+	if err != nil {
+		t.Fatal("Test Failed - Yobit GetOrderHistory() error", err)
+	}
+
+	if !request.IsRecordingFixtures() && (len(orders) != 1 || orders[0].ID != "343148" ||
+		orders[0].Status != "EXECUTED" || orders[0].OrderSide != "buy" ||
+		orders[0].Price != 0.0148 || orders[0].ExecutedAmount != 2) {
+		t.Errorf("Test Failed - Yobit GetOrderHistory() unexpected orders %+v", orders)
+	}
+}
+
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
 func isRealOrderTestEnabled() bool {
@@ -337,7 +400,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.BTC,
 		SecondCurrency: symbol.USD,
 	}
-	response, err := y.SubmitOrder(pair, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := y.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: pair, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -363,7 +426,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := y.CancelOrder(orderCancellation)
+	err := y.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
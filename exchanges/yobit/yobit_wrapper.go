@@ -1,10 +1,12 @@
 package yobit
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -13,6 +15,14 @@ import (
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
 )
 
+// orderStatus maps the Yobit order status codes to order statuses
+var orderStatus = map[int]string{
+	0: "ACTIVE",
+	1: "EXECUTED",
+	2: "CANCELLED",
+	3: "PARTIALLY_CANCELLED",
+}
+
 // Start starts the WEX go routine
 func (y *Yobit) Start(wg *sync.WaitGroup) {
 	wg.Add(1)
@@ -32,14 +42,14 @@ func (y *Yobit) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (y *Yobit) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (y *Yobit) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 	pairsCollated, err := exchange.GetAndFormatExchangeCurrencies(y.Name, y.GetEnabledCurrencies())
 	if err != nil {
 		return tickerPrice, err
 	}
 
-	result, err := y.GetTicker(pairsCollated.String())
+	result, err := y.GetTicker(ctx, pairsCollated.String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -60,27 +70,27 @@ func (y *Yobit) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Pric
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (y *Yobit) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (y *Yobit) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tick, err := ticker.GetTicker(y.GetName(), p, assetType)
 	if err != nil {
-		return y.UpdateTicker(p, assetType)
+		return y.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // GetOrderbookEx returns the orderbook for a currency pair
-func (y *Yobit) GetOrderbookEx(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (y *Yobit) GetOrderbookEx(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(y.GetName(), p, assetType)
 	if err != nil {
-		return y.UpdateOrderbook(p, assetType)
+		return y.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (y *Yobit) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (y *Yobit) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
-	orderbookNew, err := y.GetDepth(exchange.FormatExchangeCurrency(y.Name, p).String())
+	orderbookNew, err := y.GetDepth(ctx, exchange.FormatExchangeCurrency(y.Name, p).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -101,10 +111,10 @@ func (y *Yobit) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderboo
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // Yobit exchange
-func (y *Yobit) GetAccountInfo() (exchange.AccountInfo, error) {
+func (y *Yobit) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	response.ExchangeName = y.GetName()
-	accountBalance, err := y.GetAccountInformation()
+	accountBalance, err := y.GetAccountInformation(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -128,22 +138,26 @@ func (y *Yobit) GetAccountInfo() (exchange.AccountInfo, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (y *Yobit) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (y *Yobit) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (y *Yobit) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (y *Yobit) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (y *Yobit) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (y *Yobit) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
-	response, err := y.Trade(p.Pair().String(), orderType.ToString(), amount, price)
+	if err := y.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
+	response, err := y.Trade(ctx, order.Pair.Pair().String(), order.OrderType.ToString(), order.Amount, order.Price)
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -158,48 +172,75 @@ func (y *Yobit) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderT
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (y *Yobit) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (y *Yobit) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (y *Yobit) CancelOrder(order exchange.OrderCancellation) error {
+func (y *Yobit) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = y.CancelExistingOrder(orderIDInt)
+	_, err = y.CancelExistingOrder(ctx, orderIDInt)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (y *Yobit) CancelAllOrders() error {
+func (y *Yobit) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (y *Yobit) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (y *Yobit) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (y *Yobit) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (y *Yobit) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (y *Yobit) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (y *Yobit) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (y *Yobit) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (y *Yobit) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (y *Yobit) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (y *Yobit) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (y *Yobit) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (y *Yobit) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (y *Yobit) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -223,3 +264,90 @@ func (y *Yobit) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (y *Yobit) GetWithdrawCapabilities() uint32 {
 	return y.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (y *Yobit) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	symbols := []string{""}
+	if len(getOrdersRequest.Currencies) > 0 {
+		symbols = symbols[:0]
+		for _, c := range getOrdersRequest.Currencies {
+			symbols = append(symbols,
+				exchange.FormatExchangeCurrency(y.Name, c).String())
+		}
+	}
+
+	var orders []exchange.OrderDetail
+	for _, symbol := range symbols {
+		resp, err := y.GetOpenOrders(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		for ID, order := range resp {
+			p := pair.NewCurrencyPairDelimiter(order.Pair, "_")
+			orderDate := time.Unix(int64(order.TimestampCreated), 0)
+			orders = append(orders, exchange.OrderDetail{
+				Exchange:      y.Name,
+				ID:            ID,
+				BaseCurrency:  p.FirstCurrency.Upper().String(),
+				QuoteCurrency: p.SecondCurrency.Upper().String(),
+				OrderSide:     order.Type,
+				OrderType:     exchange.Limit.ToString(),
+				CreationTime:  orderDate.Unix(),
+				OrderDate:     orderDate,
+				Status:        orderStatus[order.Status],
+				Price:         order.Rate,
+				Amount:        order.Amount,
+				OpenVolume:    order.Amount,
+			})
+		}
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (y *Yobit) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	var since, end string
+	if !getOrdersRequest.StartTicks.IsZero() {
+		since = strconv.FormatInt(getOrdersRequest.StartTicks.Unix(), 10)
+	}
+	if !getOrdersRequest.EndTicks.IsZero() {
+		end = strconv.FormatInt(getOrdersRequest.EndTicks.Unix(), 10)
+	}
+
+	resp, err := y.GetTradeHistory(ctx, 0, 0, 0, "", since, end, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Yobit only returns past trades, each fill is reported as a filled order
+	var orders []exchange.OrderDetail
+	for _, trade := range resp {
+		p := pair.NewCurrencyPairDelimiter(trade.Pair, "_")
+		orderDate := time.Unix(int64(trade.Timestamp), 0)
+		orders = append(orders, exchange.OrderDetail{
+			Exchange:       y.Name,
+			ID:             strconv.FormatFloat(trade.OrderID, 'f', -1, 64),
+			BaseCurrency:   p.FirstCurrency.Upper().String(),
+			QuoteCurrency:  p.SecondCurrency.Upper().String(),
+			OrderSide:      trade.Type,
+			OrderType:      exchange.Limit.ToString(),
+			CreationTime:   orderDate.Unix(),
+			OrderDate:      orderDate,
+			Status:         orderStatus[1],
+			Price:          trade.Rate,
+			Amount:         trade.Amount,
+			ExecutedAmount: trade.Amount,
+		})
+	}
+
+	return exchange.FilterOrders(orders, getOrdersRequest), nil
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (y *Yobit) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	return nil, common.ErrNotYetImplemented
+}
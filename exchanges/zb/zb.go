@@ -1,6 +1,7 @@
 package zb
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -33,6 +34,9 @@ const (
 
 	zbAuthRate   = 100
 	zbUnauthRate = 100
+
+	// zbMaxKlineLimit is the maximum amount of klines returned per request
+	zbMaxKlineLimit = 1000
 )
 
 // ZB is the overarching type across this package
@@ -109,7 +113,7 @@ func (z *ZB) Setup(exch config.ExchangeConfig) {
 }
 
 // SpotNewOrder submits an order to ZB
-func (z *ZB) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
+func (z *ZB) SpotNewOrder(ctx context.Context, arg SpotNewOrderRequestParams) (int64, error) {
 	var result SpotNewOrderResponse
 
 	vals := url.Values{}
@@ -120,7 +124,7 @@ func (z *ZB) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 	vals.Set("price", strconv.FormatFloat(arg.Price, 'f', -1, 64))
 	vals.Set("tradeType", string(arg.Type))
 
-	err := z.SendAuthenticatedHTTPRequest("GET", zbOrder, vals, &result)
+	err := z.SendAuthenticatedHTTPRequest(ctx, "GET", zbOrder, vals, &result)
 	if err != nil {
 		return 0, err
 	}
@@ -135,7 +139,7 @@ func (z *ZB) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 }
 
 // CancelExistingOrder cancels an order
-func (z *ZB) CancelExistingOrder(orderID int64, symbol string) error {
+func (z *ZB) CancelExistingOrder(ctx context.Context, orderID int64, symbol string) error {
 	type response struct {
 		Code    int    `json:"code"`    // Result code
 		Message string `json:"message"` // Result Message
@@ -148,7 +152,7 @@ func (z *ZB) CancelExistingOrder(orderID int64, symbol string) error {
 	vals.Set("currency", symbol)
 
 	var result response
-	err := z.SendAuthenticatedHTTPRequest("GET", zbCancelOrder, vals, &result)
+	err := z.SendAuthenticatedHTTPRequest(ctx, "GET", zbCancelOrder, vals, &result)
 	if err != nil {
 		return err
 	}
@@ -161,14 +165,14 @@ func (z *ZB) CancelExistingOrder(orderID int64, symbol string) error {
 
 // GetAccountInformation returns account information including coin information
 // and pricing
-func (z *ZB) GetAccountInformation() (AccountsResponse, error) {
+func (z *ZB) GetAccountInformation(ctx context.Context) (AccountsResponse, error) {
 	var result AccountsResponse
 
 	vals := url.Values{}
 	vals.Set("accesskey", z.APIKey)
 	vals.Set("method", "getAccountInfo")
 
-	err := z.SendAuthenticatedHTTPRequest("GET", zbAccountInfo, vals, &result)
+	err := z.SendAuthenticatedHTTPRequest(ctx, "GET", zbAccountInfo, vals, &result)
 	if err != nil {
 		return result, err
 	}
@@ -177,11 +181,11 @@ func (z *ZB) GetAccountInformation() (AccountsResponse, error) {
 
 // GetMarkets returns market information including pricing, symbols and
 // each symbols decimal precision
-func (z *ZB) GetMarkets() (map[string]MarketResponseItem, error) {
+func (z *ZB) GetMarkets(ctx context.Context) (map[string]MarketResponseItem, error) {
 	url := fmt.Sprintf("%s/%s/%s", z.APIUrl, zbAPIVersion, zbMarkets)
 
 	var res interface{}
-	err := z.SendHTTPRequest(url, &res)
+	err := z.SendHTTPRequest(ctx, url, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -202,8 +206,8 @@ func (z *ZB) GetMarkets() (map[string]MarketResponseItem, error) {
 //
 // symbol: string of currency pair
 // 获取最新价格
-func (z *ZB) GetLatestSpotPrice(symbol string) (float64, error) {
-	res, err := z.GetTicker(symbol)
+func (z *ZB) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	res, err := z.GetTicker(ctx, symbol)
 
 	if err != nil {
 		return 0, err
@@ -213,11 +217,11 @@ func (z *ZB) GetLatestSpotPrice(symbol string) (float64, error) {
 }
 
 // GetTicker returns a ticker for a given symbol
-func (z *ZB) GetTicker(symbol string) (TickerResponse, error) {
+func (z *ZB) GetTicker(ctx context.Context, symbol string) (TickerResponse, error) {
 	url := fmt.Sprintf("%s/%s/%s?market=%s", z.APIUrl, zbAPIVersion, zbTicker, symbol)
 	var res TickerResponse
 
-	err := z.SendHTTPRequest(url, &res)
+	err := z.SendHTTPRequest(ctx, url, &res)
 	if err != nil {
 		return res, err
 	}
@@ -226,11 +230,11 @@ func (z *ZB) GetTicker(symbol string) (TickerResponse, error) {
 }
 
 // GetTickers returns ticker data for all supported symbols
-func (z *ZB) GetTickers() (map[string]TickerChildResponse, error) {
+func (z *ZB) GetTickers(ctx context.Context) (map[string]TickerChildResponse, error) {
 	url := fmt.Sprintf("%s/%s/%s", z.APIUrl, zbAPIVersion, zbTickers)
 	resp := make(map[string]TickerChildResponse)
 
-	err := z.SendHTTPRequest(url, &resp)
+	err := z.SendHTTPRequest(ctx, url, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -239,11 +243,11 @@ func (z *ZB) GetTickers() (map[string]TickerChildResponse, error) {
 }
 
 // GetOrderbook returns the orderbook for a given symbol
-func (z *ZB) GetOrderbook(symbol string) (OrderbookResponse, error) {
+func (z *ZB) GetOrderbook(ctx context.Context, symbol string) (OrderbookResponse, error) {
 	url := fmt.Sprintf("%s/%s/%s?market=%s", z.APIUrl, zbAPIVersion, zbDepth, symbol)
 	var res OrderbookResponse
 
-	err := z.SendHTTPRequest(url, &res)
+	err := z.SendHTTPRequest(ctx, url, &res)
 	if err != nil {
 		return res, err
 	}
@@ -259,7 +263,7 @@ func (z *ZB) GetOrderbook(symbol string) (OrderbookResponse, error) {
 }
 
 // GetSpotKline returns Kline data
-func (z *ZB) GetSpotKline(arg KlinesRequestParams) (KLineResponse, error) {
+func (z *ZB) GetSpotKline(ctx context.Context, arg KlinesRequestParams) (KLineResponse, error) {
 	vals := url.Values{}
 	vals.Set("type", string(arg.Type))
 	vals.Set("market", arg.Symbol)
@@ -274,7 +278,7 @@ func (z *ZB) GetSpotKline(arg KlinesRequestParams) (KLineResponse, error) {
 
 	var res KLineResponse
 	var rawKlines map[string]interface{}
-	err := z.SendHTTPRequest(url, &rawKlines)
+	err := z.SendHTTPRequest(ctx, url, &rawKlines)
 	if err != nil {
 		return res, err
 	}
@@ -310,12 +314,12 @@ func (z *ZB) GetSpotKline(arg KlinesRequestParams) (KLineResponse, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (z *ZB) SendHTTPRequest(path string, result interface{}) error {
-	return z.SendPayload("GET", path, nil, nil, result, false, z.Verbose)
+func (z *ZB) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return z.SendPayload(ctx, "GET", path, nil, nil, result, false, z.Verbose)
 }
 
 // SendAuthenticatedHTTPRequest sends authenticated requests to the zb API
-func (z *ZB) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Values, result interface{}) error {
+func (z *ZB) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, result interface{}) error {
 	if !z.AuthenticatedAPISupport {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, z.Name)
 	}
@@ -336,7 +340,7 @@ func (z *ZB) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Va
 		endpoint,
 		values.Encode())
 
-	return z.SendPayload(method,
+	return z.SendPayload(ctx, method,
 		url,
 		nil,
 		strings.NewReader(""),
@@ -1,6 +1,7 @@
 package zb
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
 )
 
 // Please supply you own test keys here for due diligence testing.
@@ -19,6 +21,12 @@ const (
 
 var z ZB
 
+// TestMain replays the requests of the tests from testdata/http.json, set the
+// GCT_RECORD environment variable to record it against the live API
+func TestMain(m *testing.M) {
+	request.RunWithFixtures(m, "testdata/http.json")
+}
+
 func TestSetDefaults(t *testing.T) {
 	z.SetDefaults()
 }
@@ -51,7 +59,7 @@ func TestSpotNewOrder(t *testing.T) {
 		Amount: 0.01,
 		Price:  10246.1,
 	}
-	orderid, err := z.SpotNewOrder(arg)
+	orderid, err := z.SpotNewOrder(context.Background(), arg)
 	if err != nil {
 		t.Errorf("Test failed - ZB SpotNewOrder: %s", err)
 	} else {
@@ -66,7 +74,7 @@ func TestCancelExistingOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	err := z.CancelExistingOrder(20180629145864850, "btc_usdt")
+	err := z.CancelExistingOrder(context.Background(), 20180629145864850, "btc_usdt")
 	if err != nil {
 		t.Errorf("Test failed - ZB CancelExistingOrder: %s", err)
 	}
@@ -74,7 +82,7 @@ func TestCancelExistingOrder(t *testing.T) {
 
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetLatestSpotPrice("btc_usdt")
+	_, err := z.GetLatestSpotPrice(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("Test failed - ZB GetLatestSpotPrice: %s", err)
 	}
@@ -82,7 +90,7 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetTicker("btc_usdt")
+	_, err := z.GetTicker(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("Test failed - ZB GetTicker: %s", err)
 	}
@@ -90,7 +98,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetTickers()
+	_, err := z.GetTickers(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - ZB GetTicker: %s", err)
 	}
@@ -98,7 +106,7 @@ func TestGetTickers(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetOrderbook("btc_usdt")
+	_, err := z.GetOrderbook(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("Test failed - ZB GetTicker: %s", err)
 	}
@@ -106,7 +114,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := z.GetMarkets()
+	_, err := z.GetMarkets(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - ZB GetMarkets: %s", err)
 	}
@@ -119,7 +127,7 @@ func TestGetAccountInfo(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := z.GetAccountInfo()
+	_, err := z.GetAccountInfo(context.Background())
 	if err != nil {
 		t.Errorf("Test failed - ZB GetAccountInfo: %s", err)
 	}
@@ -133,7 +141,7 @@ func TestGetSpotKline(t *testing.T) {
 		Type:   TimeIntervalFiveMinutes,
 		Size:   10,
 	}
-	_, err := z.GetSpotKline(arg)
+	_, err := z.GetSpotKline(context.Background(), arg)
 	if err != nil {
 		t.Errorf("Test failed - ZB GetSpotKline: %s", err)
 	}
@@ -267,7 +275,7 @@ func TestSubmitOrder(t *testing.T) {
 		FirstCurrency:  symbol.QTUM,
 		SecondCurrency: symbol.USDT,
 	}
-	response, err := z.SubmitOrder(pair, exchange.Buy, exchange.Market, 1, 10, "hi")
+	response, err := z.SubmitOrder(context.Background(), exchange.SubmitOrder{Pair: pair, Side: exchange.Buy, OrderType: exchange.Market, Amount: 1, Price: 10, ClientID: "hi"})
 	if err != nil || !response.IsOrderPlaced {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -293,7 +301,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	}
 
 	// Act
-	err := z.CancelOrder(orderCancellation)
+	err := z.CancelOrder(context.Background(), orderCancellation)
 
 	// Assert
 	if err != nil {
@@ -1,11 +1,13 @@
 package zb
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
@@ -31,7 +33,7 @@ func (z *ZB) Run() {
 		log.Printf("%s %d currencies enabled: %s.\n", z.GetName(), len(z.EnabledPairs), z.EnabledPairs)
 	}
 
-	markets, err := z.GetMarkets()
+	markets, err := z.GetMarkets(context.Background())
 	if err != nil {
 		log.Printf("%s Unable to fetch symbols.\n", z.GetName())
 	} else {
@@ -48,10 +50,10 @@ func (z *ZB) Run() {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (z *ZB) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (z *ZB) UpdateTicker(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	var tickerPrice ticker.Price
 
-	result, err := z.GetTickers()
+	result, err := z.GetTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -75,29 +77,29 @@ func (z *ZB) UpdateTicker(p pair.CurrencyPair, assetType string) (ticker.Price,
 }
 
 // GetTickerPrice returns the ticker for a currency pair
-func (z *ZB) GetTickerPrice(p pair.CurrencyPair, assetType string) (ticker.Price, error) {
+func (z *ZB) GetTickerPrice(ctx context.Context, p pair.CurrencyPair, assetType string) (ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(z.GetName(), p, assetType)
 	if err != nil {
-		return z.UpdateTicker(p, assetType)
+		return z.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // GetOrderbookEx returns orderbook base on the currency pair
-func (z *ZB) GetOrderbookEx(currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (z *ZB) GetOrderbookEx(ctx context.Context, currency pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	ob, err := orderbook.GetOrderbook(z.GetName(), currency, assetType)
 	if err != nil {
-		return z.UpdateOrderbook(currency, assetType)
+		return z.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (z *ZB) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
+func (z *ZB) UpdateOrderbook(ctx context.Context, p pair.CurrencyPair, assetType string) (orderbook.Base, error) {
 	var orderBook orderbook.Base
 	currency := exchange.FormatExchangeCurrency(z.Name, p).String()
 
-	orderbookNew, err := z.GetOrderbook(currency)
+	orderbookNew, err := z.GetOrderbook(ctx, currency)
 	if err != nil {
 		return orderBook, err
 	}
@@ -118,43 +120,47 @@ func (z *ZB) UpdateOrderbook(p pair.CurrencyPair, assetType string) (orderbook.B
 
 // GetAccountInfo retrieves balances for all enabled currencies for the
 // ZB exchange
-func (z *ZB) GetAccountInfo() (exchange.AccountInfo, error) {
+func (z *ZB) GetAccountInfo(ctx context.Context) (exchange.AccountInfo, error) {
 	var response exchange.AccountInfo
 	return response, errors.New("not implemented")
 }
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (z *ZB) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (z *ZB) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	var fundHistory []exchange.FundHistory
 	return fundHistory, common.ErrFunctionNotSupported
 }
 
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (z *ZB) GetExchangeHistory(p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
+func (z *ZB) GetExchangeHistory(ctx context.Context, p pair.CurrencyPair, assetType string) ([]exchange.TradeHistory, error) {
 	var resp []exchange.TradeHistory
 
 	return resp, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (z *ZB) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType exchange.OrderType, amount, price float64, clientID string) (exchange.SubmitOrderResponse, error) {
+func (z *ZB) SubmitOrder(ctx context.Context, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
 	var submitOrderResponse exchange.SubmitOrderResponse
+	if err := z.ValidateOrder(&order); err != nil {
+		return submitOrderResponse, err
+	}
+
 	var oT SpotNewOrderRequestParamsType
 
-	if side == exchange.Buy {
+	if order.Side == exchange.Buy {
 		oT = SpotNewOrderRequestParamsTypeBuy
 	} else {
 		oT = SpotNewOrderRequestParamsTypeSell
 	}
 
 	var params = SpotNewOrderRequestParams{
-		Amount: amount,
-		Price:  price,
-		Symbol: common.StringToLower(p.Pair().String()),
+		Amount: order.Amount,
+		Price:  order.Price,
+		Symbol: common.StringToLower(order.Pair.Pair().String()),
 		Type:   oT,
 	}
-	response, err := z.SpotNewOrder(params)
+	response, err := z.SpotNewOrder(ctx, params)
 
 	if response > 0 {
 		submitOrderResponse.OrderID = fmt.Sprintf("%v", response)
@@ -169,46 +175,73 @@ func (z *ZB) SubmitOrder(p pair.CurrencyPair, side exchange.OrderSide, orderType
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (z *ZB) ModifyOrder(orderID int64, action exchange.ModifyOrder) (int64, error) {
-	return 0, common.ErrNotYetImplemented
+func (z *ZB) ModifyOrder(ctx context.Context, orderID string, action exchange.ModifyOrder) (string, error) {
+	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (z *ZB) CancelOrder(order exchange.OrderCancellation) error {
+func (z *ZB) CancelOrder(ctx context.Context, order exchange.OrderCancellation) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	return z.CancelExistingOrder(orderIDInt, exchange.FormatExchangeCurrency(z.Name, order.CurrencyPair).String())
+	return z.CancelExistingOrder(ctx, orderIDInt, exchange.FormatExchangeCurrency(z.Name, order.CurrencyPair).String())
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (z *ZB) CancelAllOrders() error {
+func (z *ZB) CancelAllOrders(ctx context.Context) error {
 	return common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (z *ZB) GetOrderInfo(orderID int64) (exchange.OrderDetail, error) {
+func (z *ZB) GetOrderInfo(ctx context.Context, orderID string) (exchange.OrderDetail, error) {
 	var orderDetail exchange.OrderDetail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (z *ZB) GetDepositAddress(cryptocurrency pair.CurrencyItem) (string, error) {
+func (z *ZB) GetDepositAddress(ctx context.Context, cryptocurrency pair.CurrencyItem) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
+// GetDerivativesPositions returns the open positions of the account
+func (z *ZB) GetDerivativesPositions(ctx context.Context) ([]exchange.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SetLeverage sets the leverage of a derivatives pair
+func (z *ZB) SetLeverage(ctx context.Context, p pair.CurrencyPair, assetType string, leverage float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// SetMarginMode sets whether a derivatives pair uses cross or isolated margin
+func (z *ZB) SetMarginMode(ctx context.Context, p pair.CurrencyPair, assetType string, mode exchange.MarginMode) error {
+	return common.ErrNotYetImplemented
+}
+
+// CloseDerivativesPosition closes an open position at the market price
+func (z *ZB) CloseDerivativesPosition(ctx context.Context, position exchange.Position) (exchange.SubmitOrderResponse, error) {
+	var submitOrderResponse exchange.SubmitOrderResponse
+	return submitOrderResponse, common.ErrNotYetImplemented
+}
+
+// GetFundingRate returns the funding rate of a perpetual contract
+func (z *ZB) GetFundingRate(ctx context.Context, p pair.CurrencyPair, assetType string) (exchange.FundingRate, error) {
+	var fundingRate exchange.FundingRate
+	return fundingRate, common.ErrNotYetImplemented
+}
+
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (z *ZB) WithdrawCryptocurrencyFunds(address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
+func (z *ZB) WithdrawCryptocurrencyFunds(ctx context.Context, address string, cryptocurrency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (z *ZB) WithdrawFiatFunds(currency pair.CurrencyItem, amount float64) (string, error) {
+func (z *ZB) WithdrawFiatFunds(ctx context.Context, currency pair.CurrencyItem, amount float64) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -232,3 +265,70 @@ func (z *ZB) GetFeeByType(feeBuilder exchange.FeeBuilder) (float64, error) {
 func (z *ZB) GetWithdrawCapabilities() uint32 {
 	return z.GetWithdrawPermissions()
 }
+
+// GetActiveOrders retrieves any orders that are active/open
+func (z *ZB) GetActiveOrders(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetOrderHistory retrieves account order information
+// Can Limit response to specific order status
+func (z *ZB) GetOrderHistory(ctx context.Context, getOrdersRequest exchange.GetOrdersRequest) ([]exchange.OrderDetail, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// zbCandleIntervals maps the supported candle intervals to ZB kline types
+var zbCandleIntervals = map[exchange.CandleInterval]string{
+	exchange.OneMin:     string(TimeIntervalMinute),
+	exchange.ThreeMin:   string(TimeIntervalThreeMinutes),
+	exchange.FiveMin:    string(TimeIntervalFiveMinutes),
+	exchange.FifteenMin: string(TimeIntervalFifteenMinutes),
+	exchange.ThirtyMin:  string(TimeIntervalThirtyMinutes),
+	exchange.OneHour:    string(TimeIntervalHour),
+	exchange.TwoHour:    string(TimeIntervalTwoHours),
+	exchange.FourHour:   string(TimeIntervalFourHours),
+	exchange.SixHour:    string(TimeIntervalSixHours),
+	exchange.TwelveHour: string(TimeIntervalTwelveHours),
+	exchange.OneDay:     string(TimeIntervalDay),
+	exchange.ThreeDay:   string(TimeIntervalThreeDays),
+	exchange.OneWeek:    string(TimeIntervalWeek),
+}
+
+// GetHistoricCandles returns candles for the pair between the start and end
+// times
+func (z *ZB) GetHistoricCandles(ctx context.Context, p pair.CurrencyPair, assetType string, interval exchange.CandleInterval, start, end time.Time) ([]exchange.Candle, error) {
+	zbInterval, err := exchange.ValidateCandleRequest(zbCandleIntervals,
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := exchange.FormatExchangeCurrency(z.Name, p).String()
+	return exchange.GetCandlesPaginated(interval, start, end, zbMaxKlineLimit,
+		func(windowStart, windowEnd time.Time) ([]exchange.Candle, error) {
+			resp, err := z.GetSpotKline(ctx, KlinesRequestParams{
+				Symbol: symbol,
+				Type:   TimeInterval(zbInterval),
+				Since:  strconv.FormatInt(windowStart.UnixNano()/int64(time.Millisecond), 10),
+				Size:   zbMaxKlineLimit,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			var candles []exchange.Candle
+			for i := range resp.Data {
+				candles = append(candles, exchange.Candle{
+					Time:   resp.Data[i].KlineTime,
+					Open:   resp.Data[i].Open,
+					High:   resp.Data[i].High,
+					Low:    resp.Data[i].Low,
+					Close:  resp.Data[i].Close,
+					Volume: resp.Data[i].Volume,
+				})
+			}
+			return candles, nil
+		})
+}
@@ -1,14 +1,19 @@
 module github.com/thrasher-/gocryptotrader
 
+go 1.27.1
+
 require (
-	github.com/beatgammit/turnpike v0.0.0-20170911161258-573f579df7ee // indirect
-	github.com/gorilla/context v0.0.0-20160226214623-1ea25387ff6f // indirect
 	github.com/gorilla/mux v1.6.1
 	github.com/gorilla/websocket v1.2.0
+	github.com/toorop/go-pusher v0.0.0-20180107133620-4549deda5702
+	golang.org/x/crypto v0.0.0-20180602220124-df8d4716b347
+)
+
+require (
+	github.com/beatgammit/turnpike v0.0.0-20170911161258-573f579df7ee // indirect
+	github.com/gorilla/context v0.0.0-20160226214623-1ea25387ff6f // indirect
 	github.com/streamrail/concurrent-map v0.0.0-20160823150647-8bf1e9bacbf6 // indirect
 	github.com/thrasher-/socketio v0.0.0-20150420123453-38b9599889b9 // indirect
-	github.com/toorop/go-pusher v0.0.0-20180107133620-4549deda5702
 	github.com/ugorji/go v0.0.0-20180112141927-9831f2c3ac10 // indirect
-	golang.org/x/crypto v0.0.0-20180602220124-df8d4716b347
 	golang.org/x/net v0.0.0-20180201030042-309822c5b9b9 // indirect
 )
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -11,11 +12,13 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/translation"
+	"github.com/thrasher-/gocryptotrader/events"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-/gocryptotrader/portfolio"
+	"github.com/thrasher-/gocryptotrader/synthetic"
 )
 
 const (
@@ -256,13 +259,13 @@ func GetRelatableCurrencies(p pair.CurrencyPair, incOrig, incUSDT bool) []pair.C
 
 // GetSpecificOrderbook returns a specific orderbook given the currency,
 // exchangeName and assetType
-func GetSpecificOrderbook(currency, exchangeName, assetType string) (orderbook.Base, error) {
+func GetSpecificOrderbook(ctx context.Context, currency, exchangeName, assetType string) (orderbook.Base, error) {
 	var specificOrderbook orderbook.Base
 	var err error
 	for x := range bot.exchanges {
 		if bot.exchanges[x] != nil {
 			if bot.exchanges[x].GetName() == exchangeName {
-				specificOrderbook, err = bot.exchanges[x].GetOrderbookEx(
+				specificOrderbook, err = bot.exchanges[x].GetOrderbookEx(ctx,
 					pair.NewCurrencyPairFromString(currency),
 					assetType,
 				)
@@ -275,13 +278,13 @@ func GetSpecificOrderbook(currency, exchangeName, assetType string) (orderbook.B
 
 // GetSpecificTicker returns a specific ticker given the currency,
 // exchangeName and assetType
-func GetSpecificTicker(currency, exchangeName, assetType string) (ticker.Price, error) {
+func GetSpecificTicker(ctx context.Context, currency, exchangeName, assetType string) (ticker.Price, error) {
 	var specificTicker ticker.Price
 	var err error
 	for x := range bot.exchanges {
 		if bot.exchanges[x] != nil {
 			if bot.exchanges[x].GetName() == exchangeName {
-				specificTicker, err = bot.exchanges[x].GetTickerPrice(
+				specificTicker, err = bot.exchanges[x].GetTickerPrice(ctx,
 					pair.NewCurrencyPairFromString(currency),
 					assetType,
 				)
@@ -396,3 +399,70 @@ func SeedExchangeAccountInfo(data []exchange.AccountInfo) {
 		}
 	}
 }
+
+// GetExchangeMarketInfo returns the trading rules of an exchange given its
+// name, all currency pairs are returned when the currency is empty and the
+// asset type defaults to spot
+func GetExchangeMarketInfo(exchangeName, currency, assetType string) ([]exchange.MarketInfo, error) {
+	exch := GetExchangeByName(exchangeName)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+
+	if currency == "" {
+		return exch.GetAllMarketInfo(), nil
+	}
+
+	if len(currency) < 6 {
+		return nil, errors.New("invalid currency pair supplied")
+	}
+
+	if assetType == "" {
+		assetType = ticker.Spot
+	}
+
+	info, ok := exch.GetPairMarketInfo(pair.NewCurrencyPairFromString(currency), assetType)
+	if !ok {
+		return nil, fmt.Errorf("%s market info for %s %s not found",
+			exch.GetName(), currency, assetType)
+	}
+	return []exchange.MarketInfo{info}, nil
+}
+
+// AddSyntheticOrder validates and adds a new synthetic order given the
+// exchange name and currency, the exchange name is normalised and the asset
+// type defaults to spot
+func AddSyntheticOrder(exchangeName, currency string, order synthetic.Order) (int, error) {
+	if len(currency) < 6 {
+		return 0, errors.New("invalid currency pair supplied")
+	}
+
+	exch := GetExchangeByName(exchangeName)
+	if exch == nil {
+		return 0, ErrExchangeNotFound
+	}
+
+	order.Exchange = exch.GetName()
+	order.Pair = pair.NewCurrencyPairFromString(currency)
+	return synthetic.Add(order)
+}
+
+// AddEvent validates and adds a new event to the event manager given the
+// exchange name, item, condition, currency, asset type and action
+func AddEvent(exchangeName, item, condition, currency, assetType, action string) (int, error) {
+	if len(currency) < 6 {
+		return 0, errors.New("invalid currency pair supplied")
+	}
+
+	exch := GetExchangeByName(exchangeName)
+	if exch == nil {
+		return 0, ErrExchangeNotFound
+	}
+
+	if assetType == "" {
+		assetType = ticker.Spot
+	}
+
+	return events.AddEvent(exch.GetName(), item, condition,
+		pair.NewCurrencyPairFromString(currency), assetType, action)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/thrasher-/gocryptotrader/currency"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/bitstamp"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
@@ -235,7 +237,7 @@ func TestGetSpecificOrderbook(t *testing.T) {
 	bids = append(bids, orderbook.Item{Price: 1000, Amount: 1})
 
 	orderbook.ProcessOrderbook("Bitstamp", p, orderbook.Base{Pair: p, Bids: bids}, ticker.Spot)
-	ob, err := GetSpecificOrderbook("BTCUSD", "Bitstamp", ticker.Spot)
+	ob, err := GetSpecificOrderbook(context.Background(), "BTCUSD", "Bitstamp", ticker.Spot)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -244,7 +246,7 @@ func TestGetSpecificOrderbook(t *testing.T) {
 		t.Fatal("Unexpected result")
 	}
 
-	ob, err = GetSpecificOrderbook("ETHLTC", "Bitstamp", ticker.Spot)
+	ob, err = GetSpecificOrderbook(context.Background(), "ETHLTC", "Bitstamp", ticker.Spot)
 	if err == nil {
 		t.Fatal("Unexpected result")
 	}
@@ -259,7 +261,7 @@ func TestGetSpecificTicker(t *testing.T) {
 	p := pair.NewCurrencyPair("BTC", "USD")
 	ticker.ProcessTicker("Bitstamp", p, ticker.Price{Last: 1000}, ticker.Spot)
 
-	tick, err := GetSpecificTicker("BTCUSD", "Bitstamp", ticker.Spot)
+	tick, err := GetSpecificTicker(context.Background(), "BTCUSD", "Bitstamp", ticker.Spot)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -268,7 +270,38 @@ func TestGetSpecificTicker(t *testing.T) {
 		t.Fatal("Unexpected result")
 	}
 
-	tick, err = GetSpecificTicker("ETHLTC", "Bitstamp", ticker.Spot)
+	tick, err = GetSpecificTicker(context.Background(), "ETHLTC", "Bitstamp", ticker.Spot)
+	if err == nil {
+		t.Fatal("Unexpected result")
+	}
+
+	UnloadExchange("Bitstamp")
+}
+
+func TestGetExchangeMarketInfo(t *testing.T) {
+	SetupTestHelpers(t)
+
+	LoadExchange("Bitstamp", false, nil)
+	exch := GetExchangeByName("Bitstamp").(*bitstamp.Bitstamp)
+	exch.SetOrderLimits(pair.NewCurrencyPair("BTC", "USD"), ticker.Spot,
+		exchange.OrderLimits{MinAmount: 0.001, PriceStep: 0.01})
+
+	markets, err := GetExchangeMarketInfo("Bitstamp", "", "")
+	if err != nil || len(markets) != 1 {
+		t.Fatalf("Unexpected result %+v %v", markets, err)
+	}
+
+	markets, err = GetExchangeMarketInfo("Bitstamp", "BTCUSD", "")
+	if err != nil || markets[0].MinAmount != 0.001 {
+		t.Fatalf("Unexpected result %+v %v", markets, err)
+	}
+
+	_, err = GetExchangeMarketInfo("Bitstamp", "ETHLTC", ticker.Spot)
+	if err == nil {
+		t.Fatal("Unexpected result")
+	}
+
+	_, err = GetExchangeMarketInfo("Blah", "", "")
 	if err == nil {
 		t.Fatal("Unexpected result")
 	}
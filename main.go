@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -16,22 +17,30 @@ import (
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency"
 	"github.com/thrasher-/gocryptotrader/currency/forexprovider"
+	"github.com/thrasher-/gocryptotrader/events"
 	"github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/request"
+	"github.com/thrasher-/gocryptotrader/marketdata"
 	"github.com/thrasher-/gocryptotrader/portfolio"
+	"github.com/thrasher-/gocryptotrader/strategies"
+	"github.com/thrasher-/gocryptotrader/synthetic"
 )
 
 // Bot contains configuration, portfolio, exchange & ticker data and is the
 // overarching type across this code base.
 type Bot struct {
-	config     *config.Config
-	portfolio  *portfolio.Base
-	exchanges  []exchange.IBotExchange
-	comms      *communications.Communications
-	shutdown   chan bool
-	dryRun     bool
-	configFile string
-	dataDir    string
-	logFile    string
+	config       *config.Config
+	portfolio    *portfolio.Base
+	exchanges    []exchange.IBotExchange
+	comms        *communications.Communications
+	shutdown     chan bool
+	ctx          context.Context
+	cancel       context.CancelFunc
+	dryRun       bool
+	paperTrading bool
+	configFile   string
+	dataDir      string
+	logFile      string
 }
 
 const banner = `
@@ -47,6 +56,7 @@ var bot Bot
 
 func main() {
 	bot.shutdown = make(chan bool)
+	bot.ctx, bot.cancel = context.WithCancel(context.Background())
 	HandleInterrupt()
 
 	defaultPath, err := config.GetFilePath("")
@@ -58,6 +68,7 @@ func main() {
 	flag.StringVar(&bot.configFile, "config", defaultPath, "config file to load")
 	flag.StringVar(&bot.dataDir, "datadir", common.GetDefaultDataDir(runtime.GOOS), "default data directory for GoCryptoTrader files")
 	dryrun := flag.Bool("dryrun", false, "dry runs bot, doesn't save config file")
+	flag.BoolVar(&bot.paperTrading, "papertrading", false, "simulates order execution against live orderbooks for all exchanges")
 	version := flag.Bool("version", false, "retrieves current GoCryptoTrader version")
 	verbosity := flag.Bool("verbose", false, "increases logging verbosity for GoCryptoTrader")
 
@@ -99,6 +110,7 @@ func main() {
 	AdjustGoMaxProcs()
 	log.Printf("Bot '%s' started.\n", bot.config.Name)
 	log.Printf("Bot dry run mode: %v.\n", common.IsEnabled(bot.dryRun))
+	log.Printf("Bot paper trading mode: %v.\n", common.IsEnabled(bot.paperTrading))
 
 	log.Printf("Available Exchanges: %d. Enabled Exchanges: %d.\n",
 		len(bot.config.Exchanges),
@@ -116,6 +128,19 @@ func main() {
 	bot.comms = communications.NewComm(bot.config.GetCommunicationsConfig())
 	bot.comms.GetEnabledCommunicationMediums()
 
+	log.Println("Starting event manager..")
+	events.SetComms(bot.comms)
+	err = events.Start(bot.dataDir)
+	if err != nil {
+		log.Printf("Failed to start event manager. Err: %s", err)
+	}
+
+	log.Println("Starting synthetic order manager..")
+	err = synthetic.Start(bot.dataDir, submitSyntheticOrder)
+	if err != nil {
+		log.Printf("Failed to start synthetic order manager. Err: %s", err)
+	}
+
 	log.Printf("Fiat display currency: %s.", bot.config.Currency.FiatDisplayCurrency)
 	currency.BaseCurrency = bot.config.Currency.FiatDisplayCurrency
 	currency.FXProviders = forexprovider.StartFXService(bot.config.GetCurrencyConfig().ForexProviders)
@@ -131,9 +156,23 @@ func main() {
 		log.Fatalf("Unable to fetch forex data. Error: %s", err)
 	}
 
+	if bot.config.MarketData != nil && bot.config.MarketData.Enabled {
+		log.Println("Starting market data store..")
+		err = marketdata.Start(bot.dataDir, *bot.config.MarketData)
+		if err != nil {
+			log.Printf("Failed to start market data store. Err: %s", err)
+		}
+	}
+
 	bot.portfolio = &portfolio.Portfolio
 	bot.portfolio.SeedPortfolio(bot.config.Portfolio)
-	SeedExchangeAccountInfo(GetAllEnabledExchangeAccountInfo().Data)
+	SeedExchangeAccountInfo(GetAllEnabledExchangeAccountInfo(bot.ctx).Data)
+
+	log.Println("Starting strategy manager..")
+	err = strategies.Setup(bot.config.Strategies, GetStrategyExchanges)
+	if err != nil {
+		log.Printf("Failed to start strategy manager. Err: %s", err)
+	}
 
 	if bot.config.Webserver.Enabled {
 		listenAddr := bot.config.Webserver.ListenAddress
@@ -159,8 +198,15 @@ func main() {
 
 	go portfolio.StartPortfolioWatcher()
 
-	go TickerUpdaterRoutine()
-	go OrderbookUpdaterRoutine()
+	DataBusRoutine(bot.ctx)
+	go TickerUpdaterRoutine(bot.ctx)
+	go OrderbookUpdaterRoutine(bot.ctx)
+	go OrderManagerRoutine(bot.ctx)
+	go PositionTrackerRoutine(bot.ctx)
+	if bot.config.Arbitrage != nil &&
+		(bot.config.Arbitrage.Enabled || bot.config.Arbitrage.Triangular) {
+		go ArbitrageRoutine(bot.ctx)
+	}
 	go WebsocketRoutine(*verbosity)
 
 	<-bot.shutdown
@@ -205,6 +251,39 @@ func HandleInterrupt() {
 func Shutdown() {
 	log.Println("Bot shutting down..")
 
+	strategies.StopAll()
+
+	// Cancel in-flight exchange requests and reject any new ones
+	bot.cancel()
+	request.Shutdown()
+
+	if events.IsRunning() {
+		err := events.Stop()
+		if err != nil {
+			log.Printf("Unable to save events. Err: %s", err)
+		} else {
+			log.Println("Events saved successfully.")
+		}
+	}
+
+	if synthetic.IsRunning() {
+		err := synthetic.Stop()
+		if err != nil {
+			log.Printf("Unable to save synthetic orders. Err: %s", err)
+		} else {
+			log.Println("Synthetic orders saved successfully.")
+		}
+	}
+
+	if marketdata.IsRunning() {
+		err := marketdata.Stop()
+		if err != nil {
+			log.Printf("Unable to stop market data store. Err: %s", err)
+		} else {
+			log.Println("Market data store stopped successfully.")
+		}
+	}
+
 	if len(portfolio.Portfolio.Addresses) != 0 {
 		bot.config.Portfolio = portfolio.Portfolio
 	}
@@ -0,0 +1,526 @@
+package marketdata
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+// Record kinds stored by the market data store, named after the data bus
+// topics they are recorded from
+const (
+	Ticker    = databus.Ticker
+	Orderbook = databus.Orderbook
+	Trade     = databus.Trade
+)
+
+const (
+	storeDir          = "marketdata"
+	fileExtension     = ".jsonl"
+	dateFormat        = "2006-01-02"
+	maxPendingRecords = 10000
+	maxRecordSize     = 64 * 1024 * 1024
+	retentionInterval = time.Hour
+)
+
+var (
+	errAlreadyStarted = errors.New("market data store already started")
+	errNotStarted     = errors.New("market data store not started")
+	errInvalidKind    = errors.New("invalid record kind")
+)
+
+// TickerRecord is a stored ticker
+type TickerRecord struct {
+	Time   time.Time    `json:"time"`
+	Ticker ticker.Price `json:"ticker"`
+}
+
+// OrderbookRecord is a stored orderbook
+type OrderbookRecord struct {
+	Time      time.Time      `json:"time"`
+	Orderbook orderbook.Base `json:"orderbook"`
+}
+
+// TradeRecord is a stored websocket trade
+type TradeRecord struct {
+	Time  time.Time          `json:"time"`
+	Trade exchange.TradeData `json:"trade"`
+}
+
+// record is a pending write to the store
+type record struct {
+	kind      string
+	exchange  string
+	pair      pair.CurrencyPair
+	assetType string
+	time      time.Time
+	data      interface{}
+}
+
+// Vars for the market data store routine
+var (
+	m        sync.Mutex
+	started  bool
+	dir      string
+	settings config.MarketDataConfig
+	updates  *databus.Subscription
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	// fileMtx guards the store files. Records are appended whole under it, so
+	// queries read files up to the size they have under it and never see a
+	// partially written record without blocking writes
+	fileMtx  sync.Mutex
+	files    map[string]*os.File
+	filesDay string
+)
+
+// Start creates the market data directory under the data directory, applies
+// the retention policy and starts the routine writing the tickers, orderbooks
+// and trades published on the data bus to disk
+func Start(dataDir string, cfg config.MarketDataConfig) error {
+	m.Lock()
+	defer m.Unlock()
+	if started {
+		return errAlreadyStarted
+	}
+
+	dir = dataDir + common.GetOSPathSlash() + storeDir
+	err := common.CheckDir(dir, true)
+	if err != nil {
+		return err
+	}
+
+	settings = cfg
+	err = applyRetention(time.Now())
+	if err != nil {
+		return err
+	}
+
+	updates = databus.Subscribe(databus.Filter{
+		Topics: []string{databus.Ticker, databus.Orderbook, databus.Trade},
+	}, maxPendingRecords, databus.DropNewest)
+	shutdown = make(chan struct{})
+	started = true
+
+	wg.Add(1)
+	go run(updates.C, shutdown)
+	log.Printf("Market data store started: Recording to %s with %s retention.\n",
+		dir, retentionString())
+	return nil
+}
+
+// Stop writes any pending records and closes the store files
+func Stop() error {
+	m.Lock()
+	if !started {
+		m.Unlock()
+		return errNotStarted
+	}
+	started = false
+	close(shutdown)
+	m.Unlock()
+
+	wg.Wait()
+	updates.Unsubscribe()
+	if dropped := updates.Dropped(); dropped > 0 {
+		log.Printf("Market data store: update queue full, dropped %d record(s).\n",
+			dropped)
+	}
+
+	fileMtx.Lock()
+	defer fileMtx.Unlock()
+	return closeFiles()
+}
+
+// IsRunning returns whether or not the market data store routine is running
+func IsRunning() bool {
+	m.Lock()
+	defer m.Unlock()
+	return started
+}
+
+// newRecord returns the store record of a data bus message, orderbooks are
+// limited to the configured depth and trades use their timestamp if set
+func newRecord(msg *databus.Message) (record, bool) {
+	r := record{
+		kind:      msg.Topic(),
+		exchange:  msg.Exchange,
+		pair:      msg.Pair,
+		assetType: msg.Asset,
+		time:      time.Now(),
+	}
+
+	switch data := msg.Data.(type) {
+	case ticker.Price:
+		r.data = TickerRecord{Time: r.time, Ticker: data}
+	case orderbook.Base:
+		m.Lock()
+		depth := settings.OrderbookDepth
+		m.Unlock()
+
+		if depth > 0 {
+			if len(data.Bids) > depth {
+				data.Bids = data.Bids[:depth]
+			}
+			if len(data.Asks) > depth {
+				data.Asks = data.Asks[:depth]
+			}
+		}
+		r.data = OrderbookRecord{Time: r.time, Orderbook: data}
+	case exchange.TradeData:
+		if !data.Timestamp.IsZero() {
+			r.time = data.Timestamp
+		}
+		if r.assetType == "" {
+			r.assetType = ticker.Spot
+		}
+		r.data = TradeRecord{Time: r.time, Trade: data}
+	default:
+		return record{}, false
+	}
+	return r, true
+}
+
+// QueryTickers returns the stored tickers for an exchange, pair and asset type
+// recorded between start and end. A zero start or end is unbounded
+func QueryTickers(exchName string, p pair.CurrencyPair, assetType string, start, end time.Time) ([]TickerRecord, error) {
+	var records []TickerRecord
+	err := query(Ticker, exchName, p, assetType, start, end, func(line []byte) {
+		var r TickerRecord
+		if common.JSONDecode(line, &r) != nil {
+			return
+		}
+		if inRange(r.Time, start, end) {
+			records = append(records, r)
+		}
+	})
+	return records, err
+}
+
+// QueryOrderbooks returns the stored orderbooks for an exchange, pair and
+// asset type recorded between start and end. A zero start or end is unbounded
+func QueryOrderbooks(exchName string, p pair.CurrencyPair, assetType string, start, end time.Time) ([]OrderbookRecord, error) {
+	var records []OrderbookRecord
+	err := query(Orderbook, exchName, p, assetType, start, end, func(line []byte) {
+		var r OrderbookRecord
+		if common.JSONDecode(line, &r) != nil {
+			return
+		}
+		if inRange(r.Time, start, end) {
+			records = append(records, r)
+		}
+	})
+	return records, err
+}
+
+// QueryTrades returns the stored trades for an exchange, pair and asset type
+// between start and end. A zero start or end is unbounded
+func QueryTrades(exchName string, p pair.CurrencyPair, assetType string, start, end time.Time) ([]TradeRecord, error) {
+	var records []TradeRecord
+	err := query(Trade, exchName, p, assetType, start, end, func(line []byte) {
+		var r TradeRecord
+		if common.JSONDecode(line, &r) != nil {
+			return
+		}
+		if inRange(r.Time, start, end) {
+			records = append(records, r)
+		}
+	})
+	return records, err
+}
+
+// Query returns the stored records of a kind as generic values, used by the
+// REST and websocket APIs
+func Query(kind, exchName string, p pair.CurrencyPair, assetType string, start, end time.Time) (interface{}, error) {
+	switch kind {
+	case Ticker:
+		return QueryTickers(exchName, p, assetType, start, end)
+	case Orderbook:
+		return QueryOrderbooks(exchName, p, assetType, start, end)
+	case Trade:
+		return QueryTrades(exchName, p, assetType, start, end)
+	}
+	return nil, errInvalidKind
+}
+
+// inRange returns whether t is between start and end, a zero start or end is
+// unbounded
+func inRange(t, start, end time.Time) bool {
+	if !start.IsZero() && t.Before(start) {
+		return false
+	}
+	if !end.IsZero() && t.After(end) {
+		return false
+	}
+	return true
+}
+
+// query reads the daily files of a series which may hold records between start
+// and end, calling decode for each line
+func query(kind, exchName string, p pair.CurrencyPair, assetType string, start, end time.Time, decode func(line []byte)) error {
+	m.Lock()
+	root := dir
+	m.Unlock()
+	if root == "" {
+		return errNotStarted
+	}
+
+	seriesDir := root + common.GetOSPathSlash() + seriesPath(kind, exchName, p, assetType)
+	entries, err := ioutil.ReadDir(seriesDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	// ReadDir sorts by file name, so files are read in date order
+	for _, entry := range entries {
+		day, ok := parseFileDay(entry.Name())
+		if !ok {
+			continue
+		}
+
+		if !start.IsZero() && !day.AddDate(0, 0, 1).After(start.UTC()) {
+			continue
+		}
+		if !end.IsZero() && day.After(end.UTC()) {
+			continue
+		}
+
+		err = readFile(seriesDir+common.GetOSPathSlash()+entry.Name(), decode)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFile calls decode for each line written to a store file when it is
+// called, records written while it reads are left to the next query. Lines
+// which can't be decoded, such as a record cut short by a crash, are skipped
+func readFile(path string, decode func(line []byte)) error {
+	fileMtx.Lock()
+	info, err := os.Stat(path)
+	fileMtx.Unlock()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// removed by the retention policy after Stat
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(io.LimitReader(f, info.Size()))
+	scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+	for scanner.Scan() {
+		decode(scanner.Bytes())
+	}
+	return scanner.Err()
+}
+
+// run writes queued records and applies the retention policy until shutdown,
+// writing any records still queued before returning
+func run(updates <-chan databus.Message, shutdown <-chan struct{}) {
+	defer wg.Done()
+	retention := time.NewTicker(retentionInterval)
+	defer retention.Stop()
+
+	for {
+		select {
+		case <-shutdown:
+			for {
+				select {
+				case msg := <-updates:
+					store(&msg)
+				default:
+					return
+				}
+			}
+		case msg, ok := <-updates:
+			if !ok {
+				return
+			}
+			store(&msg)
+		case now := <-retention.C:
+			err := applyRetention(now)
+			if err != nil {
+				log.Printf("Market data store: failed to apply retention policy. Error: %s\n",
+					err)
+			}
+		}
+	}
+}
+
+// store writes the record of a data bus message
+func store(msg *databus.Message) {
+	r, ok := newRecord(msg)
+	if ok {
+		write(r)
+	}
+}
+
+// write appends a record to the daily file of its series
+func write(r record) {
+	data, err := common.JSONEncode(r.data)
+	if err != nil {
+		log.Printf("Market data store: unable to encode %s record. Error: %s\n",
+			r.kind, err)
+		return
+	}
+
+	fileMtx.Lock()
+	defer fileMtx.Unlock()
+
+	day := r.time.UTC().Format(dateFormat)
+	if day != filesDay {
+		// a new day starts new files so the previous handles are released
+		closeFiles()
+		filesDay = day
+	}
+
+	seriesDir := dir + common.GetOSPathSlash() + seriesPath(r.kind, r.exchange, r.pair, r.assetType)
+	path := seriesDir + common.GetOSPathSlash() + day + fileExtension
+	f, ok := files[path]
+	if !ok {
+		err = os.MkdirAll(seriesDir, 0770)
+		if err != nil {
+			log.Printf("Market data store: unable to create %s. Error: %s\n",
+				seriesDir, err)
+			return
+		}
+
+		f, err = os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+		if err != nil {
+			log.Printf("Market data store: unable to open %s. Error: %s\n",
+				path, err)
+			return
+		}
+
+		if files == nil {
+			files = make(map[string]*os.File)
+		}
+		files[path] = f
+	}
+
+	_, err = f.Write(append(data, '\n'))
+	if err != nil {
+		log.Printf("Market data store: unable to write to %s. Error: %s\n",
+			path, err)
+	}
+}
+
+// closeFiles closes all open store files
+func closeFiles() error {
+	var err error
+	for path, f := range files {
+		if closeErr := f.Close(); closeErr != nil {
+			err = closeErr
+		}
+		delete(files, path)
+	}
+	return err
+}
+
+// applyRetention deletes the daily files older than the configured retention
+// period, a retention of zero days keeps all records
+func applyRetention(now time.Time) error {
+	if settings.RetentionDays <= 0 {
+		return nil
+	}
+
+	fileMtx.Lock()
+	defer fileMtx.Unlock()
+
+	today, _ := time.Parse(dateFormat, now.UTC().Format(dateFormat))
+	cutoff := today.AddDate(0, 0, -settings.RetentionDays)
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		day, ok := parseFileDay(info.Name())
+		if !ok || !day.Before(cutoff) {
+			return nil
+		}
+
+		if f, ok := files[path]; ok {
+			f.Close()
+			delete(files, path)
+		}
+		return os.Remove(path)
+	})
+}
+
+// retentionString returns the retention period for logging
+func retentionString() string {
+	if settings.RetentionDays <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d days", settings.RetentionDays)
+}
+
+// seriesPath returns the directory of a series relative to the store
+// directory, in the format exchange/asset/BASE-QUOTE/kind
+func seriesPath(kind, exchName string, p pair.CurrencyPair, assetType string) string {
+	slash := common.GetOSPathSlash()
+	return pathName(exchName) + slash + pathName(assetType) + slash +
+		pathName(p.FirstCurrency.Upper().String()+"-"+p.SecondCurrency.Upper().String()) +
+		slash + kind
+}
+
+// pathName returns a name safe to use as a directory
+func pathName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		}
+		return '_'
+	}, name)
+}
+
+// parseFileDay returns the UTC day of a store file name
+func parseFileDay(name string) (time.Time, bool) {
+	if !strings.HasSuffix(name, fileExtension) {
+		return time.Time{}, false
+	}
+
+	day, err := time.Parse(dateFormat, strings.TrimSuffix(name, fileExtension))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return day, true
+}
@@ -0,0 +1,206 @@
+package marketdata
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+func TestSeriesPath(t *testing.T) {
+	p := pair.NewCurrencyPairDelimiter("btc_usd", "_")
+	slash := common.GetOSPathSlash()
+	expected := "coinbase_pro" + slash + "spot" + slash + "btc-usd" + slash + Ticker
+	if path := seriesPath(Ticker, "Coinbase Pro", p, ticker.Spot); path != expected {
+		t.Errorf("Test failed. Expected %s, received %s", expected, path)
+	}
+}
+
+func TestInRange(t *testing.T) {
+	now := time.Now()
+	if !inRange(now, time.Time{}, time.Time{}) {
+		t.Error("Test failed. Expected unbounded range to include time")
+	}
+
+	if inRange(now, now.Add(time.Second), time.Time{}) {
+		t.Error("Test failed. Expected time before start to be excluded")
+	}
+
+	if inRange(now, time.Time{}, now.Add(-time.Second)) {
+		t.Error("Test failed. Expected time after end to be excluded")
+	}
+}
+
+func TestRecordAndQuery(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "marketdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	p := pair.NewCurrencyPair("BTC", "USD")
+	_, err = QueryTickers("Bitstamp", p, ticker.Spot, time.Time{}, time.Time{})
+	if err != errNotStarted {
+		t.Errorf("Test failed. Expected %s, received %v", errNotStarted, err)
+	}
+
+	err = Start(dataDir, config.MarketDataConfig{OrderbookDepth: 1})
+	if err != nil {
+		t.Fatal("Test failed. Start error", err)
+	}
+
+	err = Start(dataDir, config.MarketDataConfig{})
+	if err != errAlreadyStarted {
+		t.Errorf("Test failed. Expected %s, received %v", errAlreadyStarted, err)
+	}
+
+	start := time.Now()
+	ticker.ProcessTicker("Bitstamp", p, ticker.Price{Last: 1}, ticker.Spot)
+	ticker.ProcessTicker("Bitstamp", p, ticker.Price{Last: 2}, ticker.Spot)
+	orderbook.ProcessOrderbook("Bitstamp", p, orderbook.Base{
+		Bids: []orderbook.Item{{Price: 2, Amount: 1}, {Price: 1, Amount: 1}},
+		Asks: []orderbook.Item{{Price: 3, Amount: 1}},
+	}, ticker.Spot)
+	databus.Publish(databus.Message{
+		Exchange: "Bitstamp",
+		Pair:     p,
+		Data: exchange.TradeData{
+			Timestamp:    start.Add(-time.Hour),
+			CurrencyPair: p,
+			Price:        1337,
+			Amount:       1,
+		},
+	})
+
+	err = Stop()
+	if err != nil {
+		t.Fatal("Test failed. Stop error", err)
+	}
+
+	tickers, err := QueryTickers("Bitstamp", p, ticker.Spot, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal("Test failed. QueryTickers error", err)
+	}
+	if len(tickers) != 2 || tickers[0].Ticker.Last != 1 || tickers[1].Ticker.Last != 2 {
+		t.Errorf("Test failed. Unexpected tickers %v", tickers)
+	}
+
+	orderbooks, err := QueryOrderbooks("Bitstamp", p, ticker.Spot, start, time.Time{})
+	if err != nil {
+		t.Fatal("Test failed. QueryOrderbooks error", err)
+	}
+	if len(orderbooks) != 1 || len(orderbooks[0].Orderbook.Bids) != 1 {
+		t.Errorf("Test failed. Expected orderbook limited to 1 level, received %v",
+			orderbooks)
+	}
+
+	trades, err := QueryTrades("Bitstamp", p, ticker.Spot, start, time.Time{})
+	if err != nil {
+		t.Fatal("Test failed. QueryTrades error", err)
+	}
+	if len(trades) != 0 {
+		t.Errorf("Test failed. Expected trade before start to be excluded, received %d",
+			len(trades))
+	}
+
+	result, err := Query(Trade, "Bitstamp", p, ticker.Spot, time.Time{}, start)
+	if err != nil {
+		t.Fatal("Test failed. Query error", err)
+	}
+	if trades = result.([]TradeRecord); len(trades) != 1 || trades[0].Trade.Price != 1337 {
+		t.Errorf("Test failed. Unexpected trades %v", trades)
+	}
+
+	_, err = Query("kline", "Bitstamp", p, ticker.Spot, time.Time{}, time.Time{})
+	if err != errInvalidKind {
+		t.Errorf("Test failed. Expected %s, received %v", errInvalidKind, err)
+	}
+
+	tickers, err = QueryTickers("Kraken", p, ticker.Spot, time.Time{}, time.Time{})
+	if err != nil || len(tickers) != 0 {
+		t.Errorf("Test failed. Expected no tickers, received %d %v", len(tickers), err)
+	}
+}
+
+func TestApplyRetention(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "marketdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	dir = dataDir
+	settings = config.MarketDataConfig{RetentionDays: 2}
+	defer func() { settings = config.MarketDataConfig{} }()
+
+	now := time.Date(2018, 10, 20, 12, 0, 0, 0, time.UTC)
+	seriesDir := dataDir + common.GetOSPathSlash() + "series"
+	err = common.CheckDir(seriesDir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, day := range []string{"2018-10-17", "2018-10-18", "2018-10-20"} {
+		err = common.WriteFile(seriesDir+common.GetOSPathSlash()+day+fileExtension, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = applyRetention(now)
+	if err != nil {
+		t.Fatal("Test failed. applyRetention error", err)
+	}
+
+	entries, err := ioutil.ReadDir(seriesDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Name() != "2018-10-18"+fileExtension {
+		t.Errorf("Test failed. Expected files older than 2 days to be removed, have %d",
+			len(entries))
+	}
+}
+
+func TestReadFileDoesNotBlockWrites(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "marketdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	path := dataDir + common.GetOSPathSlash() + "2018-10-20" + fileExtension
+	err = common.WriteFile(path, []byte("1\n2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// records appended while reading, under the lock the writer takes, are
+	// left to the next query
+	var lines int
+	err = readFile(path, func(line []byte) {
+		lines++
+		fileMtx.Lock()
+		defer fileMtx.Unlock()
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0640)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		f.Write([]byte("3\n"))
+	})
+	if err != nil {
+		t.Fatal("Test failed. readFile error", err)
+	}
+	if lines != 2 {
+		t.Errorf("Test failed. Expected 2 records, received %d", lines)
+	}
+}
@@ -100,6 +100,78 @@ func NewRouter(exchanges []exchange.IBotExchange) *mux.Router {
 			"/exchanges/{exchangeName}/orderbook/latest/{currency}",
 			RESTGetOrderbook,
 		},
+		Route{
+			"GetAllEvents",
+			"GET",
+			"/events/all",
+			RESTGetAllEvents,
+		},
+		Route{
+			"AddEvent",
+			"POST",
+			"/events/add",
+			RESTAddEvent,
+		},
+		Route{
+			"RemoveEvent",
+			"DELETE",
+			"/events/{eventID}",
+			RESTRemoveEvent,
+		},
+		Route{
+			"GetAllOrders",
+			"GET",
+			"/orders/all",
+			RESTGetAllOrders,
+		},
+		Route{
+			"AddSyntheticOrder",
+			"POST",
+			"/orders/synthetic/add",
+			RESTAddSyntheticOrder,
+		},
+		Route{
+			"CancelSyntheticOrder",
+			"DELETE",
+			"/orders/synthetic/{orderID}",
+			RESTCancelSyntheticOrder,
+		},
+		Route{
+			"GetAllStrategies",
+			"GET",
+			"/strategies/all",
+			RESTGetAllStrategies,
+		},
+		Route{
+			"StartStrategy",
+			"POST",
+			"/strategies/{strategyName}/start",
+			RESTStartStrategy,
+		},
+		Route{
+			"StopStrategy",
+			"POST",
+			"/strategies/{strategyName}/stop",
+			RESTStopStrategy,
+		},
+		Route{
+			"GetMarketInfo",
+			"GET",
+			"/exchanges/{exchangeName}/marketinfo",
+			RESTGetMarketInfo,
+		},
+		Route{
+			"GetAllPositions",
+			"GET",
+			"/positions/all",
+			RESTGetAllPositions,
+		},
+		Route{
+			"GetMarketData",
+			"GET",
+			"/marketdata/{kind}/{exchangeName}/{currency}",
+			RESTGetMarketData,
+		},
 		Route{
 			"ws",
 			"GET",
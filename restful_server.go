@@ -1,15 +1,26 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/events"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/positions"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-/gocryptotrader/marketdata"
+	"github.com/thrasher-/gocryptotrader/strategies"
+	"github.com/thrasher-/gocryptotrader/synthetic"
 )
 
 // AllEnabledExchangeOrderbooks holds the enabled exchange orderbooks
@@ -41,6 +52,78 @@ type AllEnabledExchangeAccounts struct {
 	Data []exchange.AccountInfo `json:"data"`
 }
 
+// AllEvents holds all events registered with the event manager
+type AllEvents struct {
+	Data []events.Event `json:"data"`
+}
+
+// EventRequest holds the parameters required to add a new event
+type EventRequest struct {
+	Exchange  string `json:"exchangeName"`
+	Item      string `json:"item"`
+	Condition string `json:"condition"`
+	Currency  string `json:"currency"`
+	AssetType string `json:"assetType"`
+	Action    string `json:"action"`
+}
+
+// EventResponse holds the ID of an added or removed event
+type EventResponse struct {
+	ID int `json:"id"`
+}
+
+// AllOrders holds all orders tracked by the order manager and all synthetic
+// orders
+type AllOrders struct {
+	Data      []orders.Order    `json:"data"`
+	Synthetic []synthetic.Order `json:"synthetic"`
+}
+
+// SyntheticOrderRequest holds the parameters required to add a new synthetic
+// order
+type SyntheticOrderRequest struct {
+	Exchange        string  `json:"exchangeName"`
+	Currency        string  `json:"currency"`
+	AssetType       string  `json:"assetType"`
+	Side            string  `json:"side"`
+	Type            string  `json:"type"`
+	Amount          float64 `json:"amount"`
+	StopPrice       float64 `json:"stopPrice"`
+	TakeProfitPrice float64 `json:"takeProfitPrice"`
+	LimitPrice      float64 `json:"limitPrice"`
+	TrailingOffset  float64 `json:"trailingOffset"`
+}
+
+// SyntheticOrderResponse holds the ID of an added or cancelled synthetic order
+type SyntheticOrderResponse struct {
+	ID int `json:"id"`
+}
+
+// AllStrategies holds the status of all configured strategies
+type AllStrategies struct {
+	Data []strategies.Status `json:"data"`
+}
+
+// StrategyRequest holds the name of a strategy to start or stop
+type StrategyRequest struct {
+	Name string `json:"name"`
+}
+
+// MarketDataResponse holds stored market data records
+type MarketDataResponse struct {
+	Data interface{} `json:"data"`
+}
+
+// MarketInfoResponse holds the trading rules of an exchange
+type MarketInfoResponse struct {
+	Data []exchange.MarketInfo `json:"data"`
+}
+
+// AllPositions holds the open derivatives positions of all exchanges
+type AllPositions struct {
+	Data []positions.Position `json:"data"`
+}
+
 // RESTfulJSONResponse outputs a JSON response of the response interface
 func RESTfulJSONResponse(w http.ResponseWriter, r *http.Request, response interface{}) error {
 	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
@@ -99,7 +182,7 @@ func RESTGetOrderbook(w http.ResponseWriter, r *http.Request) {
 		assetType = orderbook.Spot
 	}
 
-	response, err := GetSpecificOrderbook(currency, exchange, assetType)
+	response, err := GetSpecificOrderbook(r.Context(), currency, exchange, assetType)
 	if err != nil {
 		log.Printf("Failed to fetch orderbook for %s currency: %s\n", exchange,
 			currency)
@@ -113,7 +196,7 @@ func RESTGetOrderbook(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetAllActiveOrderbooks returns all enabled exchanges orderbooks
-func GetAllActiveOrderbooks() []EnabledExchangeOrderbooks {
+func GetAllActiveOrderbooks(ctx context.Context) []EnabledExchangeOrderbooks {
 	var orderbookData []EnabledExchangeOrderbooks
 
 	for _, individualBot := range bot.exchanges {
@@ -134,11 +217,11 @@ func GetAllActiveOrderbooks() []EnabledExchangeOrderbooks {
 				var ob orderbook.Base
 				if len(assetTypes) > 1 {
 					for y := range assetTypes {
-						ob, err = individualBot.GetOrderbookEx(currency,
+						ob, err = individualBot.GetOrderbookEx(ctx, currency,
 							assetTypes[y])
 					}
 				} else {
-					ob, err = individualBot.GetOrderbookEx(currency,
+					ob, err = individualBot.GetOrderbookEx(ctx, currency,
 						assetTypes[0])
 				}
 
@@ -163,7 +246,7 @@ func GetAllActiveOrderbooks() []EnabledExchangeOrderbooks {
 // RESTGetAllActiveOrderbooks returns all enabled exchange orderbooks
 func RESTGetAllActiveOrderbooks(w http.ResponseWriter, r *http.Request) {
 	var response AllEnabledExchangeOrderbooks
-	response.Data = GetAllActiveOrderbooks()
+	response.Data = GetAllActiveOrderbooks(r.Context())
 
 	err := RESTfulJSONResponse(w, r, response)
 	if err != nil {
@@ -191,7 +274,7 @@ func RESTGetTicker(w http.ResponseWriter, r *http.Request) {
 	if assetType == "" {
 		assetType = ticker.Spot
 	}
-	response, err := GetSpecificTicker(currency, exchange, assetType)
+	response, err := GetSpecificTicker(r.Context(), currency, exchange, assetType)
 	if err != nil {
 		log.Printf("Failed to fetch ticker for %s currency: %s\n", exchange,
 			currency)
@@ -204,7 +287,7 @@ func RESTGetTicker(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetAllActiveTickers returns all enabled exchange tickers
-func GetAllActiveTickers() []EnabledExchangeCurrencies {
+func GetAllActiveTickers(ctx context.Context) []EnabledExchangeCurrencies {
 	var tickerData []EnabledExchangeCurrencies
 
 	for _, individualBot := range bot.exchanges {
@@ -224,11 +307,11 @@ func GetAllActiveTickers() []EnabledExchangeCurrencies {
 				var tickerPrice ticker.Price
 				if len(assetTypes) > 1 {
 					for y := range assetTypes {
-						tickerPrice, err = individualBot.GetTickerPrice(currency,
+						tickerPrice, err = individualBot.GetTickerPrice(ctx, currency,
 							assetTypes[y])
 					}
 				} else {
-					tickerPrice, err = individualBot.GetTickerPrice(currency,
+					tickerPrice, err = individualBot.GetTickerPrice(ctx, currency,
 						assetTypes[0])
 				}
 
@@ -253,7 +336,7 @@ func GetAllActiveTickers() []EnabledExchangeCurrencies {
 // RESTGetAllActiveTickers returns all active tickers
 func RESTGetAllActiveTickers(w http.ResponseWriter, r *http.Request) {
 	var response AllEnabledExchangeCurrencies
-	response.Data = GetAllActiveTickers()
+	response.Data = GetAllActiveTickers(r.Context())
 
 	err := RESTfulJSONResponse(w, r, response)
 	if err != nil {
@@ -262,7 +345,7 @@ func RESTGetAllActiveTickers(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetAllEnabledExchangeAccountInfo returns all the current enabled exchanges
-func GetAllEnabledExchangeAccountInfo() AllEnabledExchangeAccounts {
+func GetAllEnabledExchangeAccountInfo(ctx context.Context) AllEnabledExchangeAccounts {
 	var response AllEnabledExchangeAccounts
 	for _, individualBot := range bot.exchanges {
 		if individualBot != nil && individualBot.IsEnabled() {
@@ -270,7 +353,7 @@ func GetAllEnabledExchangeAccountInfo() AllEnabledExchangeAccounts {
 				log.Printf("GetAllEnabledExchangeAccountInfo: Skippping %s due to disabled authenticated API support.", individualBot.GetName())
 				continue
 			}
-			individualExchange, err := individualBot.GetAccountInfo()
+			individualExchange, err := individualBot.GetAccountInfo(ctx)
 			if err != nil {
 				log.Printf("Error encountered retrieving exchange account info for %s. Error %s",
 					individualBot.GetName(), err)
@@ -285,9 +368,264 @@ func GetAllEnabledExchangeAccountInfo() AllEnabledExchangeAccounts {
 // RESTGetAllEnabledAccountInfo via get request returns JSON response of account
 // info
 func RESTGetAllEnabledAccountInfo(w http.ResponseWriter, r *http.Request) {
-	response := GetAllEnabledExchangeAccountInfo()
+	response := GetAllEnabledExchangeAccountInfo(r.Context())
+	err := RESTfulJSONResponse(w, r, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetAllEvents returns all events registered with the event manager
+func RESTGetAllEvents(w http.ResponseWriter, r *http.Request) {
+	response := AllEvents{Data: events.GetEvents()}
+	err := RESTfulJSONResponse(w, r, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTAddEvent adds an event from the request body and returns its ID
+func RESTAddEvent(w http.ResponseWriter, r *http.Request) {
+	var req EventRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		RESTfulError(r.Method, err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	id, err := AddEvent(req.Exchange, req.Item, req.Condition, req.Currency,
+		req.AssetType, req.Action)
+	if err != nil {
+		log.Printf("Failed to add event. Error: %s\n", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, EventResponse{ID: id})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTRemoveEvent removes an event by its ID
+func RESTRemoveEvent(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["eventID"])
+	if err != nil {
+		log.Printf("Failed to remove event. Invalid event ID: %s\n", vars["eventID"])
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !events.RemoveEvent(id) {
+		log.Printf("Failed to remove event. Event ID %d not found\n", id)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, EventResponse{ID: id})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetAllStrategies returns the status of all configured strategies
+func RESTGetAllStrategies(w http.ResponseWriter, r *http.Request) {
+	response := AllStrategies{Data: strategies.GetStrategies()}
+	err := RESTfulJSONResponse(w, r, response)
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTStartStrategy starts a configured strategy by name
+func RESTStartStrategy(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["strategyName"]
+	err := strategies.StartStrategy(name)
+	if err != nil {
+		log.Printf("Failed to start strategy %s. Error: %s\n", name, err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, StrategyRequest{Name: name})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTStopStrategy stops a running strategy by name
+func RESTStopStrategy(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["strategyName"]
+	err := strategies.StopStrategy(name)
+	if err != nil {
+		log.Printf("Failed to stop strategy %s. Error: %s\n", name, err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, StrategyRequest{Name: name})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetAllOrders returns all orders tracked by the order manager alongside
+// all synthetic orders
+func RESTGetAllOrders(w http.ResponseWriter, r *http.Request) {
+	response := AllOrders{
+		Data:      orders.GetOrders(),
+		Synthetic: synthetic.GetOrders(),
+	}
 	err := RESTfulJSONResponse(w, r, response)
 	if err != nil {
 		RESTfulError(r.Method, err)
 	}
 }
+
+// RESTAddSyntheticOrder adds a synthetic order from the request body and
+// returns its ID
+func RESTAddSyntheticOrder(w http.ResponseWriter, r *http.Request) {
+	var req SyntheticOrderRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		RESTfulError(r.Method, err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var side exchange.OrderSide
+	switch common.StringToLower(req.Side) {
+	case "buy":
+		side = exchange.Buy
+	case "sell":
+		side = exchange.Sell
+	default:
+		log.Printf("Failed to add synthetic order. Invalid side: %s\n", req.Side)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	id, err := AddSyntheticOrder(req.Exchange, req.Currency, synthetic.Order{
+		Asset:           common.StringToUpper(req.AssetType),
+		Side:            side,
+		Type:            synthetic.Type(common.StringToUpper(req.Type)),
+		Amount:          req.Amount,
+		StopPrice:       req.StopPrice,
+		TakeProfitPrice: req.TakeProfitPrice,
+		LimitPrice:      req.LimitPrice,
+		TrailingOffset:  req.TrailingOffset,
+	})
+	if err != nil {
+		log.Printf("Failed to add synthetic order. Error: %s\n", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, SyntheticOrderResponse{ID: id})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTCancelSyntheticOrder cancels a pending synthetic order by its ID
+func RESTCancelSyntheticOrder(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["orderID"])
+	if err != nil {
+		log.Printf("Failed to cancel synthetic order. Invalid order ID: %s\n",
+			vars["orderID"])
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !synthetic.Cancel(id) {
+		log.Printf("Failed to cancel synthetic order. Pending order ID %d not found\n", id)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, SyntheticOrderResponse{ID: id})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetMarketData returns the stored tickers, orderbooks or trades for an
+// exchange and currency. The optional assetType, start and end query values
+// filter the records, start and end are unix timestamps
+func RESTGetMarketData(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	query := r.URL.Query()
+
+	assetType := query.Get("assetType")
+	if assetType == "" {
+		assetType = ticker.Spot
+	}
+
+	var start, end time.Time
+	for _, v := range []struct {
+		name string
+		t    *time.Time
+	}{{"start", &start}, {"end", &end}} {
+		value := query.Get(v.name)
+		if value == "" {
+			continue
+		}
+
+		timestamp, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			log.Printf("Failed to fetch market data. Invalid %s time: %s\n",
+				v.name, value)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		*v.t = time.Unix(timestamp, 0)
+	}
+
+	records, err := marketdata.Query(vars["kind"], vars["exchangeName"],
+		pair.NewCurrencyPairFromString(vars["currency"]), assetType, start, end)
+	if err != nil {
+		log.Printf("Failed to fetch %s market data for %s %s. Error: %s\n",
+			vars["kind"], vars["exchangeName"], vars["currency"], err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, MarketDataResponse{Data: records})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetMarketInfo returns the trading rules of an exchange. The optional
+// currency and assetType query values return the trading rules of a single
+// currency pair
+func RESTGetMarketInfo(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	query := r.URL.Query()
+
+	markets, err := GetExchangeMarketInfo(vars["exchangeName"],
+		query.Get("currency"), query.Get("assetType"))
+	if err != nil {
+		log.Printf("Failed to fetch market info for %s. Error: %s\n",
+			vars["exchangeName"], err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = RESTfulJSONResponse(w, r, MarketInfoResponse{Data: markets})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
+
+// RESTGetAllPositions replies to a request with an encoded JSON response of
+// the open derivatives positions tracked across all exchanges
+func RESTGetAllPositions(w http.ResponseWriter, r *http.Request) {
+	err := RESTfulJSONResponse(w, r, AllPositions{Data: positions.GetPositions()})
+	if err != nil {
+		RESTfulError(r.Method, err)
+	}
+}
@@ -50,3 +50,15 @@ func TestConfigAllJsonResponse(t *testing.T) {
 		t.Error("Test failed. Json not equal to config")
 	}
 }
+
+func TestRESTAddSyntheticOrderInvalidSide(t *testing.T) {
+	req := httptest.NewRequest("POST", "http://localhost:9050/orders/synthetic/add",
+		strings.NewReader(`{"exchangeName":"Bitfinex","currency":"BTCUSD","side":"short","type":"STOP","amount":1}`))
+	w := httptest.NewRecorder()
+
+	RESTAddSyntheticOrder(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Test failed. Expected status %d for an invalid side, received %d",
+			http.StatusBadRequest, w.Code)
+	}
+}
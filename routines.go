@@ -1,20 +1,27 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"sync"
 	"time"
 
+	"github.com/thrasher-/gocryptotrader/arbitrage"
 	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/communications/base"
 	"github.com/thrasher-/gocryptotrader/currency"
 	"github.com/thrasher-/gocryptotrader/currency/pair"
 	"github.com/thrasher-/gocryptotrader/currency/symbol"
+	"github.com/thrasher-/gocryptotrader/databus"
 	exchange "github.com/thrasher-/gocryptotrader/exchanges"
 	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/positions"
 	"github.com/thrasher-/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-/gocryptotrader/strategies"
 )
 
 func printCurrencyFormat(price float64) string {
@@ -176,9 +183,155 @@ func relayWebsocketEvent(result interface{}, event, assetType, exchangeName stri
 	}
 }
 
+// Data bus subscription buffer sizes
+const (
+	strategyBusBuffer = 1000
+	commsBusBuffer    = 100
+	relayBusBuffer    = 100
+)
+
+// DataBusRoutine subscribes the strategy manager, communications staging and
+// the websocket hub to the updates published on the data bus
+func DataBusRoutine(ctx context.Context) {
+	log.Println("Starting data bus routine.")
+	go consumeDataBus(ctx, databus.Subscribe(databus.Filter{
+		Topics: []string{databus.Ticker, databus.Orderbook, databus.Trade},
+	}, strategyBusBuffer, databus.DropOldest), strategyBusUpdate)
+
+	go consumeDataBus(ctx, databus.Subscribe(databus.Filter{
+		Topics: []string{databus.Ticker, databus.Orderbook},
+	}, commsBusBuffer, databus.DropOldest), commsBusUpdate)
+
+	if bot.config.Webserver.Enabled {
+		go consumeDataBus(ctx, databus.Subscribe(databus.Filter{
+			Topics: []string{databus.Ticker, databus.Orderbook, databus.Trade},
+		}, relayBusBuffer, databus.DropNewest), relayBusUpdate)
+	}
+}
+
+// consumeDataBus handles the messages of a subscription until the context is
+// done
+func consumeDataBus(ctx context.Context, sub *databus.Subscription, handle func(databus.Message)) {
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			handle(msg)
+		}
+	}
+}
+
+// strategyBusUpdate queues a data bus update for the running strategies
+func strategyBusUpdate(msg databus.Message) {
+	exch := GetExchangeByName(msg.Exchange)
+	if exch == nil {
+		return
+	}
+
+	switch data := msg.Data.(type) {
+	case ticker.Price:
+		strategies.TickerUpdated(orderTrackingExchange{exch}, msg.Pair, msg.Asset, data)
+	case orderbook.Base:
+		strategies.OrderbookUpdated(orderTrackingExchange{exch}, msg.Pair, msg.Asset, data)
+	case exchange.TradeData:
+		strategies.TradeUpdated(orderTrackingExchange{exch}, data)
+	}
+}
+
+// commsBusUpdate stages a data bus update for the communication mediums
+func commsBusUpdate(msg databus.Message) {
+	switch data := msg.Data.(type) {
+	case ticker.Price:
+		bot.comms.StageTickerData(msg.Exchange, msg.Asset, data)
+	case orderbook.Base:
+		bot.comms.StageOrderbookData(msg.Exchange, msg.Asset, data)
+	}
+}
+
+// relayBusUpdate relays a data bus update to the websocket hub clients
+func relayBusUpdate(msg databus.Message) {
+	relayWebsocketEvent(msg.Data, msg.Topic()+"_update", msg.Asset, msg.Exchange)
+}
+
+// websocketStreamTimeout is how long a websocket update for a pair suppresses
+// its REST poll
+const websocketStreamTimeout = 30 * time.Second
+
+// websocketStreams tracks the last websocket update of the pairs streamed by
+// each exchange
+type websocketStreams struct {
+	updated map[string]map[string]time.Time
+	m       sync.Mutex
+}
+
+var wsStreams = websocketStreams{updated: make(map[string]map[string]time.Time)}
+
+// websocketStreamKey returns the key of a streamed pair
+func websocketStreamKey(topic string, p pair.CurrencyPair, assetType string) string {
+	return topic + " " + common.StringToUpper(assetType) + " " +
+		p.FirstCurrency.Upper().String() + p.SecondCurrency.Upper().String()
+}
+
+// mark records a websocket update for a pair
+func (w *websocketStreams) mark(exchName, topic string, p pair.CurrencyPair, assetType string) {
+	exchName = common.StringToUpper(exchName)
+	w.m.Lock()
+	if w.updated[exchName] == nil {
+		w.updated[exchName] = make(map[string]time.Time)
+	}
+	w.updated[exchName][websocketStreamKey(topic, p, assetType)] = time.Now()
+	w.m.Unlock()
+}
+
+// unmark removes a pair so it is polled over REST again
+func (w *websocketStreams) unmark(exchName, topic string, p pair.CurrencyPair, assetType string) {
+	w.m.Lock()
+	delete(w.updated[common.StringToUpper(exchName)], websocketStreamKey(topic, p, assetType))
+	w.m.Unlock()
+}
+
+// isStreamed returns whether a pair has received a websocket update within the
+// stream timeout
+func (w *websocketStreams) isStreamed(exchName, topic string, p pair.CurrencyPair, assetType string) bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	updated, ok := w.updated[common.StringToUpper(exchName)][websocketStreamKey(topic, p, assetType)]
+	return ok && time.Since(updated) < websocketStreamTimeout
+}
+
+// reset removes every pair streamed by an exchange so they are polled over
+// REST again
+func (w *websocketStreams) reset(exchName string) {
+	w.m.Lock()
+	delete(w.updated, common.StringToUpper(exchName))
+	w.m.Unlock()
+}
+
+// normaliseWebsocketPair returns a websocket pair in the format of the matching
+// exchange enabled pair and the upper case asset type, defaulting to spot
+func normaliseWebsocketPair(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string) (pair.CurrencyPair, string) {
+	if assetType == "" {
+		assetType = ticker.Spot
+	}
+	assetType = common.StringToUpper(assetType)
+
+	if exch != nil {
+		enabled := pair.CopyPairFormat(p, exch.GetEnabledCurrencies(), true)
+		if enabled.Pair() != "" {
+			p = enabled
+		}
+	}
+	return p, assetType
+}
+
 // TickerUpdaterRoutine fetches and updates the ticker for all enabled
-// currency pairs and exchanges
-func TickerUpdaterRoutine() {
+// currency pairs and exchanges, skipping pairs streamed over a websocket
+func TickerUpdaterRoutine(ctx context.Context) {
 	log.Println("Starting ticker updater routine.")
 	var wg sync.WaitGroup
 	for {
@@ -203,26 +356,26 @@ func TickerUpdaterRoutine() {
 					var result ticker.Price
 					var err error
 					if update {
-						result, err = exch.UpdateTicker(c, assetType)
+						result, err = exch.UpdateTicker(ctx, c, assetType)
 					} else {
-						result, err = exch.GetTickerPrice(c, assetType)
+						result, err = exch.GetTickerPrice(ctx, c, assetType)
 					}
 					printTickerSummary(result, c, assetType, exchangeName, err)
-					if err == nil {
-						bot.comms.StageTickerData(exchangeName, assetType, result)
-						if bot.config.Webserver.Enabled {
-							relayWebsocketEvent(result, "ticker_update", assetType, exchangeName)
-						}
-					}
 				}
 
 				for y := range assetTypes {
+					var batched bool
 					for z := range enabledCurrencies {
-						if supportsBatching && z > 0 {
+						if wsStreams.isStreamed(exchangeName, databus.Ticker, enabledCurrencies[z], assetTypes[y]) {
+							continue
+						}
+
+						if supportsBatching && batched {
 							processTicker(bot.exchanges[x], false, enabledCurrencies[z], assetTypes[y])
 							continue
 						}
 						processTicker(bot.exchanges[x], true, enabledCurrencies[z], assetTypes[y])
+						batched = true
 					}
 				}
 			}(x, &wg)
@@ -233,9 +386,19 @@ func TickerUpdaterRoutine() {
 	}
 }
 
+// isPaperTrading returns whether an exchange simulates order execution
+func isPaperTrading(exch exchange.IBotExchange) bool {
+	paper, ok := exch.(interface {
+		IsPaperTrading() bool
+	})
+	return ok && paper.IsPaperTrading()
+}
+
 // OrderbookUpdaterRoutine fetches and updates the orderbooks for all enabled
-// currency pairs and exchanges
-func OrderbookUpdaterRoutine() {
+// currency pairs and exchanges, skipping pairs streamed over a websocket.
+// Paper trading exchanges are always polled as their resting orders are
+// matched against REST orderbook updates
+func OrderbookUpdaterRoutine(ctx context.Context) {
 	log.Println("Starting orderbook updater routine.")
 	var wg sync.WaitGroup
 	for {
@@ -257,18 +420,16 @@ func OrderbookUpdaterRoutine() {
 				}
 
 				processOrderbook := func(exch exchange.IBotExchange, c pair.CurrencyPair, assetType string) {
-					result, err := exch.UpdateOrderbook(c, assetType)
+					result, err := exch.UpdateOrderbook(ctx, c, assetType)
 					printOrderbookSummary(result, c, assetType, exchangeName, err)
-					if err == nil {
-						bot.comms.StageOrderbookData(exchangeName, assetType, result)
-						if bot.config.Webserver.Enabled {
-							relayWebsocketEvent(result, "orderbook_update", assetType, exchangeName)
-						}
-					}
 				}
 
+				paperTrading := isPaperTrading(bot.exchanges[x])
 				for y := range assetTypes {
 					for z := range enabledCurrencies {
+						if !paperTrading && wsStreams.isStreamed(exchangeName, databus.Orderbook, enabledCurrencies[z], assetTypes[y]) {
+							continue
+						}
 						processOrderbook(bot.exchanges[x], enabledCurrencies[z], assetTypes[y])
 					}
 				}
@@ -280,6 +441,275 @@ func OrderbookUpdaterRoutine() {
 	}
 }
 
+// OrderManagerRoutine periodically reconciles the state of all open orders
+// tracked by the order manager
+func OrderManagerRoutine(ctx context.Context) {
+	log.Println("Starting order manager routine.")
+	reconcile := time.NewTicker(time.Second * 10)
+	defer reconcile.Stop()
+	for {
+		ReconcileOrders(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Println("Order manager routine stopped.")
+			return
+		case <-reconcile.C:
+		}
+	}
+}
+
+// websocketReconciles holds the websocket authentication count of each
+// exchange when its orders were last reconciled over REST
+type websocketReconciles struct {
+	counts map[string]int64
+	m      sync.Mutex
+}
+
+var wsReconciles = websocketReconciles{counts: make(map[string]int64)}
+
+// streamed returns whether the orders of an exchange are kept up to date by
+// its authenticated websocket. It returns false once after each
+// authentication so a REST pass picks up updates missed while the private
+// channels were down
+func (w *websocketReconciles) streamed(exchName string, authenticated bool, authCount int64) bool {
+	if !authenticated {
+		return false
+	}
+
+	exchName = common.StringToUpper(exchName)
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.counts[exchName] == authCount {
+		return true
+	}
+	w.counts[exchName] = authCount
+	return false
+}
+
+// isOrderStreamed returns whether the orders of an exchange are kept up to date
+// by its websocket
+func isOrderStreamed(exch exchange.IBotExchange) bool {
+	ws, err := exch.GetWebsocket()
+	if err != nil || ws == nil {
+		return false
+	}
+	return wsReconciles.streamed(exch.GetName(), ws.IsAuthenticated(),
+		ws.GetAuthenticationCount())
+}
+
+// ReconcileOrders fetches the latest order information for all open orders
+// tracked by the order manager and records any status transitions. Orders on
+// exchanges streaming private websocket updates are skipped, apart from one
+// pass after each websocket authentication
+func ReconcileOrders(ctx context.Context) {
+	openOrders := orders.GetOpenOrders()
+	streamed := make(map[string]bool)
+	for x := range openOrders {
+		exch := GetExchangeByName(openOrders[x].Exchange)
+		if exch == nil || !exch.GetAuthenticatedAPISupport() {
+			continue
+		}
+
+		skip, ok := streamed[exch.GetName()]
+		if !ok {
+			skip = isOrderStreamed(exch)
+			streamed[exch.GetName()] = skip
+		}
+		if skip {
+			continue
+		}
+
+		detail, err := exch.GetOrderInfo(ctx, openOrders[x].ExchangeOrderID)
+		if err != nil {
+			if err != common.ErrNotYetImplemented &&
+				err != common.ErrFunctionNotSupported {
+				log.Printf("Order manager: failed to get %s order %s info. Error: %s",
+					openOrders[x].Exchange, openOrders[x].ExchangeOrderID, err)
+			}
+			continue
+		}
+
+		status := orders.ParseStatus(detail.Status)
+		filled, ok := executedAmount(&detail, status)
+		if !ok {
+			// Without executed or remaining volume the filled amount is
+			// unknown, keep the tracked amount rather than guessing
+			filled = openOrders[x].FilledAmount
+		}
+
+		updateTrackedOrder(exch, openOrders[x], status, filled)
+	}
+}
+
+// executedAmount returns the filled amount of an order and whether the
+// exchange reported enough to derive it. Remaining volume is only trusted when
+// it is set, as wrappers which do not report it leave it at zero
+func executedAmount(detail *exchange.OrderDetail, status orders.Status) (float64, bool) {
+	switch {
+	case detail.ExecutedAmount > 0:
+		return detail.ExecutedAmount, true
+	case detail.Amount > 0 && detail.OpenVolume > 0:
+		return detail.Amount - detail.OpenVolume, true
+	case status == orders.Filled && detail.Amount > 0:
+		return detail.Amount, true
+	}
+	return 0, false
+}
+
+// updateTrackedOrder records the latest status and filled amount of an order
+// tracked by the order manager and notifies the strategies of any change
+func updateTrackedOrder(exch exchange.IBotExchange, order orders.Order, status orders.Status, filled float64) {
+	if status == orders.UnknownStatus {
+		return
+	}
+
+	if status == orders.New && filled > 0 {
+		status = orders.PartiallyFilled
+	}
+
+	changed, err := orders.UpdateStatus(order.OrderID, status, filled)
+	if err != nil {
+		log.Printf("Order manager: failed to update %s order %s. Error: %s",
+			order.Exchange, order.ExchangeOrderID, err)
+		return
+	}
+
+	if changed {
+		log.Printf("Order manager: %s order %s status %s filled %f.",
+			order.Exchange, order.ExchangeOrderID, status, filled)
+		notifyOrderUpdate(exch, order.ExchangeOrderID)
+	}
+}
+
+// PositionTrackerRoutine periodically fetches the open derivatives positions
+// of all exchanges and reports their unrealised profit and loss and distance
+// to liquidation
+func PositionTrackerRoutine(ctx context.Context) {
+	log.Println("Starting position tracker routine.")
+	track := time.NewTicker(time.Second * 30)
+	defer track.Stop()
+	for {
+		TrackPositions(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Println("Position tracker routine stopped.")
+			return
+		case <-track.C:
+		}
+	}
+}
+
+// TrackPositions fetches the open derivatives positions of all exchanges with
+// authenticated API support and updates the position tracker
+func TrackPositions(ctx context.Context) {
+	for x := range bot.exchanges {
+		exch := bot.exchanges[x]
+		if exch == nil || !exch.GetAuthenticatedAPISupport() {
+			continue
+		}
+
+		open, err := exch.GetDerivativesPositions(ctx)
+		if err != nil {
+			if err != common.ErrNotYetImplemented &&
+				err != common.ErrFunctionNotSupported {
+				log.Printf("Position tracker: failed to get %s positions. Error: %s",
+					exch.GetName(), err)
+			}
+			continue
+		}
+
+		tracked := positions.Update(exch.GetName(), open, lastTickerPrice)
+		for y := range tracked {
+			log.Printf("Position tracker: %s %s %s %s %f @ %f price %f unrealised P&L %f liquidation distance %.2f%%.",
+				tracked[y].Exchange, tracked[y].Pair.Pair(), tracked[y].AssetType,
+				tracked[y].Side, tracked[y].Amount, tracked[y].EntryPrice,
+				tracked[y].Price, tracked[y].UnrealisedPNL,
+				tracked[y].LiquidationDistance)
+		}
+	}
+}
+
+// lastTickerPrice returns the last traded price of a currency pair from the
+// ticker, zero when the ticker has not been fetched
+func lastTickerPrice(exchName string, p pair.CurrencyPair, assetType string) float64 {
+	price, err := ticker.GetTicker(exchName, p, assetType)
+	if err != nil {
+		return 0
+	}
+	return price.Last
+}
+
+// ArbitrageRoutine periodically scans the orderbooks of all exchanges for
+// cross exchange and triangular arbitrage opportunities and publishes new
+// opportunities over the websocket and communication mediums until the context
+// is done
+func ArbitrageRoutine(ctx context.Context) {
+	log.Println("Starting arbitrage scanner routine.")
+	scanner := arbitrage.Scanner{
+		MinNetProfitPercentage: bot.config.Arbitrage.MinNetProfitPercentage,
+		MaxAmount:              bot.config.Arbitrage.MaxAmount,
+	}
+	triangular := arbitrage.TriangularScanner{
+		MinProfitPercentage: bot.config.Arbitrage.MinNetProfitPercentage,
+		UseMakerFees:        bot.config.Arbitrage.UseMakerFees,
+	}
+
+	published := make(map[string]bool)
+	for {
+		current := make(map[string]bool)
+		publish := func(key, summary, event, assetType, exchName string, data interface{}) {
+			current[key] = true
+			if published[key] {
+				return
+			}
+
+			log.Println(summary)
+			bot.comms.PushEvent(base.Event{
+				Type:         "arbitrage",
+				TradeDetails: summary,
+			})
+			if bot.config.Webserver.Enabled {
+				relayWebsocketEvent(data, event, assetType, exchName)
+			}
+		}
+
+		if bot.config.Arbitrage.Enabled {
+			opportunities := scanner.Scan(bot.exchanges)
+			for x := range opportunities {
+				publish(opportunities[x].Key(), opportunities[x].String(),
+					"arbitrage_opportunity", opportunities[x].AssetType,
+					opportunities[x].BuyExchange, opportunities[x])
+			}
+		}
+
+		if bot.config.Arbitrage.Triangular {
+			for x := range bot.exchanges {
+				if bot.exchanges[x] == nil {
+					continue
+				}
+
+				loops := triangular.Scan(bot.exchanges[x], ticker.Spot)
+				for y := range loops {
+					publish(loops[y].Key(), loops[y].String(),
+						"triangular_arbitrage_opportunity", loops[y].AssetType,
+						loops[y].Exchange, loops[y])
+				}
+			}
+		}
+
+		published = current
+
+		select {
+		case <-ctx.Done():
+			log.Println("Arbitrage scanner routine stopped.")
+			return
+		case <-time.After(bot.config.Arbitrage.ScanInterval):
+		}
+	}
+}
+
 // WebsocketRoutine Initial routine management system for websocket
 func WebsocketRoutine(verbose bool) {
 	log.Println("Connecting exchange websocket services...")
@@ -362,6 +792,7 @@ func streamDiversion(ws *exchange.Websocket, verbose bool) {
 				log.Printf("exchange %s websocket feed disconnected, switching to REST functionality",
 					ws.GetName())
 			}
+			wsStreams.reset(ws.GetName())
 		}
 	}
 }
@@ -374,6 +805,8 @@ func WebsocketDataHandler(ws *exchange.Websocket, verbose bool) {
 
 	go streamDiversion(ws, verbose)
 
+	exch := GetExchangeByName(ws.GetName())
+
 	for {
 		select {
 		case <-shutdowner:
@@ -407,22 +840,122 @@ func WebsocketDataHandler(ws *exchange.Websocket, verbose bool) {
 				if verbose {
 					log.Println("Websocket trades Updated:   ", data.(exchange.TradeData))
 				}
+				trade := data.(exchange.TradeData)
+				trade.CurrencyPair, trade.AssetType = normaliseWebsocketPair(exch,
+					trade.CurrencyPair, trade.AssetType)
+				databus.Publish(databus.Message{
+					Exchange:  ws.GetName(),
+					Pair:      trade.CurrencyPair,
+					Asset:     trade.AssetType,
+					Data:      trade,
+					Timestamp: trade.Timestamp,
+				})
 
 			case exchange.TickerData:
-				// Ticker data
+				// Ticker data, stored and published on the data bus by the
+				// ticker package
 				if verbose {
 					log.Println("Websocket Ticker Updated:   ", data.(exchange.TickerData))
 				}
+				tick := data.(exchange.TickerData)
+				if tick.Pair.Pair() == "" {
+					continue
+				}
+
+				p, assetType := normaliseWebsocketPair(exch, tick.Pair, tick.AssetType)
+				ticker.ProcessTicker(ws.GetName(), p, ticker.Price{
+					Pair:   p,
+					Last:   tick.ClosePrice,
+					High:   tick.HighPrice,
+					Low:    tick.LowPrice,
+					Bid:    tick.BidPrice,
+					Ask:    tick.AskPrice,
+					Volume: tick.Quantity,
+				}, assetType)
+				stats.Add(ws.GetName(), p, assetType, tick.ClosePrice, tick.Quantity)
+				wsStreams.mark(ws.GetName(), databus.Ticker, p, assetType)
+
 			case exchange.KlineData:
 				// Kline data
 				if verbose {
 					log.Println("Websocket Kline Updated:    ", data.(exchange.KlineData))
 				}
+				kline := data.(exchange.KlineData)
+				kline.Pair, kline.AssetType = normaliseWebsocketPair(exch, kline.Pair,
+					kline.AssetType)
+				databus.Publish(databus.Message{
+					Exchange:  ws.GetName(),
+					Pair:      kline.Pair,
+					Asset:     kline.AssetType,
+					Data:      kline,
+					Timestamp: kline.Timestamp,
+				})
+
 			case exchange.WebsocketOrderbookUpdate:
-				// Orderbook data
+				// Orderbook data, stored and published on the data bus by the
+				// orderbook package
 				if verbose {
 					log.Println("Websocket Orderbook Updated:", data.(exchange.WebsocketOrderbookUpdate))
 				}
+				update := data.(exchange.WebsocketOrderbookUpdate)
+				if update.Pair.Pair() != "" {
+					p, assetType := normaliseWebsocketPair(exch, update.Pair, update.Asset)
+					wsStreams.mark(ws.GetName(), databus.Orderbook, p, assetType)
+				}
+
+			case exchange.WebsocketOrderUpdate:
+				// Private order update, applied to the order manager in place
+				// of polling the order
+				if verbose {
+					log.Println("Websocket Order Updated:    ", data.(exchange.WebsocketOrderUpdate))
+				}
+				update := data.(exchange.WebsocketOrderUpdate)
+				order, err := orders.GetOrderByExchangeOrderID(ws.GetName(), update.OrderID)
+				if err == nil && exch != nil {
+					filled := update.FilledAmount
+					if filled < order.FilledAmount {
+						filled = order.FilledAmount
+					}
+					if update.Status == orders.Filled && filled == 0 {
+						filled = order.Amount
+					}
+					updateTrackedOrder(exch, order, update.Status, filled)
+				}
+
+			case exchange.WebsocketOrderFill:
+				// Private order fill
+				fill := data.(exchange.WebsocketOrderFill)
+				log.Printf("Websocket Order Fill:        %s %s order %s %s %f @ %f fee %f %s",
+					fill.Exchange, fill.Pair.Pair(), fill.OrderID, fill.Side, fill.Amount,
+					fill.Price, fill.Fee, fill.FeeCurrency)
+
+			case exchange.WebsocketBalanceUpdate:
+				// Private balance update, applied to the portfolio in place of
+				// polling the account
+				if verbose {
+					log.Println("Websocket Balance Updated:  ", data.(exchange.WebsocketBalanceUpdate))
+				}
+				balance := data.(exchange.WebsocketBalanceUpdate)
+				SeedExchangeAccountInfo([]exchange.AccountInfo{{
+					ExchangeName: ws.GetName(),
+					Currencies: []exchange.AccountCurrencyInfo{{
+						CurrencyName: balance.Currency,
+						TotalValue:   balance.Total - balance.Hold,
+						Hold:         balance.Hold,
+					}},
+				}})
+
+			case exchange.WebsocketOrderbookResync:
+				// Orderbook out of sync
+				resync := data.(exchange.WebsocketOrderbookResync)
+				log.Printf("Websocket Orderbook Resync:  %s %s %s %s, resync count %d",
+					resync.Exchange, resync.Pair.Pair(), resync.Asset, resync.Reason,
+					resync.Resyncs)
+				p, assetType := normaliseWebsocketPair(exch, resync.Pair, resync.Asset)
+				wsStreams.unmark(ws.GetName(), databus.Orderbook, p, assetType)
+				if exch != nil {
+					go WebsocketOrderbookResync(exch, resync, verbose)
+				}
 			default:
 				if verbose {
 					log.Println("Websocket Unknown type:     ", data)
@@ -432,6 +965,35 @@ func WebsocketDataHandler(ws *exchange.Websocket, verbose bool) {
 	}
 }
 
+// WebsocketOrderbookResync tries to load a new snapshot for an out of sync
+// websocket orderbook
+func WebsocketOrderbookResync(exch exchange.IBotExchange, resync exchange.WebsocketOrderbookResync, verbose bool) {
+	wg.Add(1)
+	defer wg.Done()
+
+	ticker := time.NewTicker(3 * time.Second)
+	defer ticker.Stop()
+	for {
+		err := exchange.ResyncOrderbook(bot.ctx, exch, resync)
+		if err == nil {
+			if verbose {
+				log.Printf("Websocket orderbook resynced for %s %s %s",
+					resync.Exchange, resync.Pair.Pair(), resync.Asset)
+			}
+			return
+		}
+
+		log.Printf("routines.go exchange %s websocket orderbook resync error - %s",
+			resync.Exchange, err)
+
+		select {
+		case <-shutdowner:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 // WebsocketReconnect tries to reconnect to a websocket stream
 func WebsocketReconnect(ws *exchange.Websocket, verbose bool) {
 	if verbose {
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/paper"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+func TestWebsocketStreams(t *testing.T) {
+	streams := websocketStreams{updated: make(map[string]map[string]time.Time)}
+	p := pair.NewCurrencyPair("BTC", "USD")
+
+	if streams.isStreamed("Bitstamp", databus.Ticker, p, ticker.Spot) {
+		t.Error("Test failed. Expected pair not to be streamed")
+	}
+
+	streams.mark("Bitstamp", databus.Ticker, pair.NewCurrencyPairDelimiter("btc-usd", "-"), "spot")
+	if !streams.isStreamed("BITSTAMP", databus.Ticker, p, ticker.Spot) {
+		t.Error("Test failed. Expected pair to be streamed regardless of format")
+	}
+
+	if streams.isStreamed("Bitstamp", databus.Orderbook, p, ticker.Spot) {
+		t.Error("Test failed. Expected orderbook not to be streamed")
+	}
+
+	streams.mark("Bitstamp", databus.Orderbook, p, ticker.Spot)
+	streams.unmark("Bitstamp", databus.Orderbook, p, ticker.Spot)
+	if streams.isStreamed("Bitstamp", databus.Orderbook, p, ticker.Spot) {
+		t.Error("Test failed. Expected orderbook to be unmarked")
+	}
+
+	streams.updated["BITSTAMP"][websocketStreamKey(databus.Orderbook, p, ticker.Spot)] =
+		time.Now().Add(-websocketStreamTimeout)
+	if streams.isStreamed("Bitstamp", databus.Orderbook, p, ticker.Spot) {
+		t.Error("Test failed. Expected stale update not to suppress polling")
+	}
+
+	streams.reset("Bitstamp")
+	if streams.isStreamed("Bitstamp", databus.Ticker, p, ticker.Spot) {
+		t.Error("Test failed. Expected pair not to be streamed after reset")
+	}
+}
+
+func TestWebsocketReconciles(t *testing.T) {
+	reconciles := websocketReconciles{counts: make(map[string]int64)}
+	if reconciles.streamed("Bitfinex", false, 0) {
+		t.Error("Test failed. Expected unauthenticated orders not to be streamed")
+	}
+
+	if reconciles.streamed("Bitfinex", true, 1) {
+		t.Error("Test failed. Expected a reconciliation after authenticating")
+	}
+
+	if !reconciles.streamed("BITFINEX", true, 1) {
+		t.Error("Test failed. Expected authenticated orders to be streamed")
+	}
+
+	if reconciles.streamed("Bitfinex", true, 2) {
+		t.Error("Test failed. Expected a reconciliation after reauthenticating")
+	}
+}
+
+func TestIsPaperTrading(t *testing.T) {
+	if isPaperTrading(nil) {
+		t.Error("Test failed. Expected nil exchange not to be paper trading")
+	}
+
+	if !isPaperTrading(paper.New(nil, nil)) {
+		t.Error("Test failed. Expected paper exchange to be paper trading")
+	}
+}
+
+func TestNormaliseWebsocketPair(t *testing.T) {
+	p, assetType := normaliseWebsocketPair(nil, pair.NewCurrencyPair("BTC", "USD"), "")
+	if p.Pair() != "BTCUSD" || assetType != ticker.Spot {
+		t.Errorf("Test failed. Unexpected pair %s and asset type %s", p.Pair(), assetType)
+	}
+
+	_, assetType = normaliseWebsocketPair(nil, p, "futures")
+	if assetType != "FUTURES" {
+		t.Errorf("Test failed. Expected upper case asset type, received %s", assetType)
+	}
+}
+
+func TestExecutedAmount(t *testing.T) {
+	tests := []struct {
+		detail   exchange.OrderDetail
+		status   orders.Status
+		expected float64
+		ok       bool
+	}{
+		{exchange.OrderDetail{Amount: 2, ExecutedAmount: 0.5}, orders.New, 0.5, true},
+		{exchange.OrderDetail{Amount: 2, OpenVolume: 1.5}, orders.New, 0.5, true},
+		{exchange.OrderDetail{Amount: 2}, orders.Filled, 2, true},
+		{exchange.OrderDetail{Amount: 2}, orders.New, 0, false},
+		{exchange.OrderDetail{Amount: 2}, orders.Cancelled, 0, false},
+	}
+
+	for i := range tests {
+		filled, ok := executedAmount(&tests[i].detail, tests[i].status)
+		if filled != tests[i].expected || ok != tests[i].ok {
+			t.Errorf("Test failed. Test %d expected %v %v, received %v %v",
+				i, tests[i].expected, tests[i].ok, filled, ok)
+		}
+	}
+}
+
+// stopsOnCancel runs a routine with a cancelled context and fails if it does
+// not return
+func stopsOnCancel(t *testing.T, name string, routine func(context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		routine(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("Test failed. %s did not stop when its context was cancelled", name)
+	}
+}
+
+func TestOrderManagerRoutineStops(t *testing.T) {
+	stopsOnCancel(t, "OrderManagerRoutine", OrderManagerRoutine)
+}
+
+func TestPositionTrackerRoutineStops(t *testing.T) {
+	stopsOnCancel(t, "PositionTrackerRoutine", PositionTrackerRoutine)
+}
@@ -0,0 +1,359 @@
+package strategies
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+const maxPendingUpdates = 1000
+
+var (
+	errAlreadyRunning = errors.New("strategy already running")
+	errNotRunning     = errors.New("strategy not running")
+	errDuplicateName  = errors.New("duplicate strategy name")
+)
+
+// Vars for the strategy manager
+var (
+	runners      []*runner
+	getExchanges func() []exchange.IBotExchange
+	m            sync.Mutex
+)
+
+// Status holds the state of a configured strategy
+type Status struct {
+	Name      string   `json:"name"`
+	Strategy  string   `json:"strategy"`
+	Running   bool     `json:"running"`
+	Exchanges []string `json:"exchanges"`
+	Pairs     []string `json:"pairs"`
+}
+
+// Update types queued for a running strategy
+type tickerUpdate struct {
+	exch      exchange.IBotExchange
+	pair      pair.CurrencyPair
+	assetType string
+	tick      ticker.Price
+}
+
+type orderbookUpdate struct {
+	exch      exchange.IBotExchange
+	pair      pair.CurrencyPair
+	assetType string
+	ob        orderbook.Base
+}
+
+type tradeUpdate struct {
+	exch  exchange.IBotExchange
+	trade exchange.TradeData
+}
+
+type orderUpdate struct {
+	exch  exchange.IBotExchange
+	order orders.Order
+}
+
+// runner holds a configured strategy and its routine
+type runner struct {
+	cfg      config.StrategyConfig
+	strategy Strategy
+	pairs    []pair.CurrencyPair
+	running  bool
+	updates  chan interface{}
+	shutdown chan struct{}
+	done     chan struct{}
+}
+
+// Setup creates the strategies from the config and starts those which are
+// enabled. The exchanges function returns the exchanges loaded by the bot
+func Setup(cfgs []config.StrategyConfig, exchanges func() []exchange.IBotExchange) error {
+	m.Lock()
+	getExchanges = exchanges
+	for i := range cfgs {
+		if getRunner(cfgs[i].Name) != nil {
+			m.Unlock()
+			return errDuplicateName
+		}
+
+		s, err := newStrategy(cfgs[i].Strategy)
+		if err != nil {
+			m.Unlock()
+			return err
+		}
+
+		r := &runner{cfg: cfgs[i], strategy: s}
+		for _, p := range cfgs[i].Pairs {
+			r.pairs = append(r.pairs, pair.NewCurrencyPairDelimiter(p, "-"))
+		}
+		runners = append(runners, r)
+	}
+	m.Unlock()
+
+	for i := range cfgs {
+		if !cfgs[i].Enabled {
+			continue
+		}
+
+		err := StartStrategy(cfgs[i].Name)
+		if err != nil {
+			log.Printf("Strategy %s: failed to start. Error: %s\n",
+				cfgs[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// StartStrategy starts a configured strategy by name
+func StartStrategy(name string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	r := getRunner(name)
+	if r == nil {
+		return errStrategyNotFound
+	}
+
+	if r.running {
+		return errAlreadyRunning
+	}
+
+	err := r.strategy.OnStart(r.cfg)
+	if err != nil {
+		return err
+	}
+
+	r.updates = make(chan interface{}, maxPendingUpdates)
+	r.shutdown = make(chan struct{})
+	r.done = make(chan struct{})
+	r.running = true
+	go r.run(r.updates, r.shutdown, r.done)
+
+	log.Printf("Strategy %s started.\n", r.cfg.Name)
+	return nil
+}
+
+// StopStrategy stops a running strategy by name, waiting for any in progress
+// hook to return
+func StopStrategy(name string) error {
+	m.Lock()
+	r := getRunner(name)
+	if r == nil {
+		m.Unlock()
+		return errStrategyNotFound
+	}
+
+	if !r.running {
+		m.Unlock()
+		return errNotRunning
+	}
+	r.running = false
+	close(r.shutdown)
+	done := r.done
+	m.Unlock()
+
+	<-done
+	log.Printf("Strategy %s stopped.\n", r.cfg.Name)
+	return r.strategy.OnStop()
+}
+
+// StopAll stops all running strategies
+func StopAll() {
+	for _, s := range GetStrategies() {
+		if !s.Running {
+			continue
+		}
+
+		err := StopStrategy(s.Name)
+		if err != nil {
+			log.Printf("Strategy %s: failed to stop. Error: %s\n", s.Name, err)
+		}
+	}
+}
+
+// GetStrategies returns the status of all configured strategies
+func GetStrategies() []Status {
+	m.Lock()
+	defer m.Unlock()
+
+	var statuses []Status
+	for _, r := range runners {
+		statuses = append(statuses, Status{
+			Name:      r.cfg.Name,
+			Strategy:  r.cfg.Strategy,
+			Running:   r.running,
+			Exchanges: r.cfg.Exchanges,
+			Pairs:     r.cfg.Pairs,
+		})
+	}
+	return statuses
+}
+
+// TickerUpdated queues a ticker update for all running strategies subscribed
+// to the exchange and currency pair
+func TickerUpdated(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string, tick ticker.Price) {
+	queueUpdate(exch.GetName(), p, tickerUpdate{
+		exch:      exch,
+		pair:      p,
+		assetType: assetType,
+		tick:      tick,
+	})
+}
+
+// OrderbookUpdated queues an orderbook update for all running strategies
+// subscribed to the exchange and currency pair
+func OrderbookUpdated(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string, ob orderbook.Base) {
+	queueUpdate(exch.GetName(), p, orderbookUpdate{
+		exch:      exch,
+		pair:      p,
+		assetType: assetType,
+		ob:        ob,
+	})
+}
+
+// TradeUpdated queues a public trade for all running strategies subscribed to
+// the exchange and currency pair
+func TradeUpdated(exch exchange.IBotExchange, trade exchange.TradeData) {
+	queueUpdate(exch.GetName(), trade.CurrencyPair, tradeUpdate{
+		exch:  exch,
+		trade: trade,
+	})
+}
+
+// OrderUpdated queues an order status change for all running strategies
+// subscribed to the exchange and currency pair
+func OrderUpdated(exch exchange.IBotExchange, order orders.Order) {
+	queueUpdate(exch.GetName(), order.Pair, orderUpdate{
+		exch:  exch,
+		order: order,
+	})
+}
+
+// queueUpdate sends an update to each subscribed running strategy, updates
+// are dropped for strategies which are not keeping up
+func queueUpdate(exchName string, p pair.CurrencyPair, update interface{}) {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, r := range runners {
+		if !r.running || !r.isSubscribed(exchName, p) {
+			continue
+		}
+
+		select {
+		case r.updates <- update:
+		default:
+			log.Printf("Strategy %s: update queue full, dropping %s %s update.\n",
+				r.cfg.Name, exchName, p.Pair().String())
+		}
+	}
+}
+
+// getRunner returns a strategy by name, the caller must hold the lock
+func getRunner(name string) *runner {
+	for _, r := range runners {
+		if common.StringToLower(r.cfg.Name) == common.StringToLower(name) {
+			return r
+		}
+	}
+	return nil
+}
+
+// isSubscribed returns whether the strategy receives updates for the exchange
+// and currency pair
+func (r *runner) isSubscribed(exchName string, p pair.CurrencyPair) bool {
+	if !r.hasExchange(exchName) {
+		return false
+	}
+
+	if len(r.pairs) == 0 {
+		return true
+	}
+
+	for i := range r.pairs {
+		if r.pairs[i].Equal(p, false) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *runner) hasExchange(exchName string) bool {
+	if len(r.cfg.Exchanges) == 0 {
+		return true
+	}
+	return common.StringDataCompareUpper(r.cfg.Exchanges, exchName)
+}
+
+// exchanges returns the loaded exchanges the strategy runs on
+func (r *runner) exchanges() []exchange.IBotExchange {
+	m.Lock()
+	loaded := getExchanges
+	m.Unlock()
+
+	if loaded == nil {
+		return nil
+	}
+
+	var exchanges []exchange.IBotExchange
+	for _, exch := range loaded() {
+		if exch != nil && r.hasExchange(exch.GetName()) {
+			exchanges = append(exchanges, exch)
+		}
+	}
+	return exchanges
+}
+
+// run calls the strategy hooks for queued updates and timer ticks until
+// shutdown
+func (r *runner) run(updates <-chan interface{}, shutdown <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	var timer <-chan time.Time
+	if r.cfg.TimerInterval > 0 {
+		t := time.NewTicker(r.cfg.TimerInterval)
+		defer t.Stop()
+		timer = t.C
+	}
+
+	for {
+		var err error
+		select {
+		case <-shutdown:
+			return
+		case t := <-timer:
+			err = r.strategy.OnTimer(r.exchanges(), t)
+		case u := <-updates:
+			err = r.dispatch(u)
+		}
+
+		if err != nil {
+			log.Printf("Strategy %s: %s\n", r.cfg.Name, err)
+		}
+	}
+}
+
+// dispatch calls the strategy hook for an update
+func (r *runner) dispatch(update interface{}) error {
+	switch u := update.(type) {
+	case tickerUpdate:
+		return r.strategy.OnTicker(u.exch, u.pair, u.assetType, u.tick)
+	case orderbookUpdate:
+		return r.strategy.OnOrderbook(u.exch, u.pair, u.assetType, u.ob)
+	case tradeUpdate:
+		return r.strategy.OnTrade(u.exch, u.trade)
+	case orderUpdate:
+		return r.strategy.OnOrderUpdate(u.exch, u.order)
+	}
+	return nil
+}
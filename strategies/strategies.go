@@ -0,0 +1,117 @@
+package strategies
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+// Strategy is implemented by trading logic run by the strategy manager. Hooks
+// for a strategy are called sequentially from its own routine, so
+// implementations do not need to be safe for concurrent use. Orders should be
+// placed through the supplied exchanges
+type Strategy interface {
+	OnStart(cfg config.StrategyConfig) error
+	OnStop() error
+	OnTicker(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string, tick ticker.Price) error
+	OnOrderbook(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string, ob orderbook.Base) error
+	OnTrade(exch exchange.IBotExchange, trade exchange.TradeData) error
+	OnOrderUpdate(exch exchange.IBotExchange, order orders.Order) error
+	OnTimer(exchanges []exchange.IBotExchange, t time.Time) error
+}
+
+// Factory returns a new instance of a strategy
+type Factory func() Strategy
+
+// Base implements every Strategy hook as a no-op and can be embedded so that
+// strategies only need to implement the hooks they use
+type Base struct{}
+
+// OnStart is called when the strategy is started
+func (b *Base) OnStart(cfg config.StrategyConfig) error { return nil }
+
+// OnStop is called when the strategy is stopped
+func (b *Base) OnStop() error { return nil }
+
+// OnTicker is called when a ticker is updated
+func (b *Base) OnTicker(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string, tick ticker.Price) error {
+	return nil
+}
+
+// OnOrderbook is called when an orderbook is updated
+func (b *Base) OnOrderbook(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string, ob orderbook.Base) error {
+	return nil
+}
+
+// OnTrade is called when a public trade is received
+func (b *Base) OnTrade(exch exchange.IBotExchange, trade exchange.TradeData) error {
+	return nil
+}
+
+// OnOrderUpdate is called when the status of an order changes
+func (b *Base) OnOrderUpdate(exch exchange.IBotExchange, order orders.Order) error {
+	return nil
+}
+
+// OnTimer is called every TimerInterval
+func (b *Base) OnTimer(exchanges []exchange.IBotExchange, t time.Time) error {
+	return nil
+}
+
+var (
+	factories = make(map[string]Factory)
+	fm        sync.Mutex
+
+	errStrategyExists   = errors.New("strategy already registered")
+	errStrategyNotFound = errors.New("strategy not found")
+)
+
+// Register makes a strategy type available for use in the config, it is
+// intended to be called from the init function of the package implementing
+// the strategy
+func Register(name string, factory Factory) error {
+	fm.Lock()
+	defer fm.Unlock()
+
+	name = common.StringToLower(name)
+	if _, ok := factories[name]; ok {
+		return errStrategyExists
+	}
+	factories[name] = factory
+	return nil
+}
+
+// GetRegistered returns the names of all registered strategy types
+func GetRegistered() []string {
+	fm.Lock()
+	defer fm.Unlock()
+
+	var names []string
+	for k := range factories {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newStrategy returns a new instance of a registered strategy type
+func newStrategy(name string) (Strategy, error) {
+	fm.Lock()
+	defer fm.Unlock()
+
+	factory, ok := factories[common.StringToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("%s: %s", errStrategyNotFound, name)
+	}
+	return factory(), nil
+}
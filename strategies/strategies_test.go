@@ -0,0 +1,167 @@
+package strategies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/config"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+type testExchange struct {
+	exchange.IBotExchange
+	name string
+}
+
+func (e *testExchange) GetName() string {
+	return e.name
+}
+
+type testStrategy struct {
+	Base
+	started bool
+	stopped bool
+	tickers chan ticker.Price
+	orders  chan orders.Order
+	timers  chan int
+}
+
+func (s *testStrategy) OnStart(cfg config.StrategyConfig) error {
+	s.started = true
+	return nil
+}
+
+func (s *testStrategy) OnStop() error {
+	s.stopped = true
+	return nil
+}
+
+func (s *testStrategy) OnTicker(exch exchange.IBotExchange, p pair.CurrencyPair, assetType string, tick ticker.Price) error {
+	s.tickers <- tick
+	return nil
+}
+
+func (s *testStrategy) OnOrderUpdate(exch exchange.IBotExchange, order orders.Order) error {
+	s.orders <- order
+	return nil
+}
+
+func (s *testStrategy) OnTimer(exchanges []exchange.IBotExchange, t time.Time) error {
+	s.timers <- len(exchanges)
+	return nil
+}
+
+var testInstance = &testStrategy{
+	tickers: make(chan ticker.Price, 10),
+	orders:  make(chan orders.Order, 10),
+	timers:  make(chan int, 10),
+}
+
+func TestRegister(t *testing.T) {
+	err := Register("Test", func() Strategy { return testInstance })
+	if err != nil {
+		t.Fatal("Test failed. Register error", err)
+	}
+
+	err = Register("test", func() Strategy { return testInstance })
+	if err != errStrategyExists {
+		t.Errorf("Test failed. Expected %s, received %v", errStrategyExists, err)
+	}
+
+	if len(GetRegistered()) != 1 {
+		t.Errorf("Test failed. Expected 1 registered strategy, received %d",
+			len(GetRegistered()))
+	}
+
+	_, err = newStrategy("invalid")
+	if err == nil {
+		t.Error("Test failed. Expected unregistered strategy error")
+	}
+}
+
+func TestStrategyLifecycle(t *testing.T) {
+	binance := &testExchange{name: "Binance"}
+	kraken := &testExchange{name: "Kraken"}
+	loaded := func() []exchange.IBotExchange {
+		return []exchange.IBotExchange{binance, kraken}
+	}
+
+	err := Setup([]config.StrategyConfig{
+		{
+			Name:          "testing",
+			Strategy:      "test",
+			Enabled:       true,
+			Exchanges:     []string{"binance"},
+			Pairs:         []string{"BTC-USDT"},
+			TimerInterval: time.Millisecond * 10,
+		},
+	}, loaded)
+	if err != nil {
+		t.Fatal("Test failed. Setup error", err)
+	}
+
+	if !testInstance.started || !GetStrategies()[0].Running {
+		t.Fatal("Test failed. Expected strategy to be started")
+	}
+
+	err = StartStrategy("testing")
+	if err != errAlreadyRunning {
+		t.Errorf("Test failed. Expected %s, received %v", errAlreadyRunning, err)
+	}
+
+	btc := pair.NewCurrencyPair("BTC", "USDT")
+	TickerUpdated(kraken, btc, ticker.Spot, ticker.Price{Last: 1})
+	TickerUpdated(binance, pair.NewCurrencyPair("LTC", "USDT"), ticker.Spot, ticker.Price{Last: 2})
+	TickerUpdated(binance, btc, ticker.Spot, ticker.Price{Last: 3})
+
+	select {
+	case tick := <-testInstance.tickers:
+		if tick.Last != 3 {
+			t.Errorf("Test failed. Expected only the subscribed ticker, received %f",
+				tick.Last)
+		}
+	case <-time.After(time.Second):
+		t.Error("Test failed. Expected OnTicker to be called")
+	}
+
+	OrderUpdated(binance, orders.Order{Pair: btc, Status: orders.Filled})
+	select {
+	case order := <-testInstance.orders:
+		if order.Status != orders.Filled {
+			t.Errorf("Test failed. Unexpected order %v", order)
+		}
+	case <-time.After(time.Second):
+		t.Error("Test failed. Expected OnOrderUpdate to be called")
+	}
+
+	select {
+	case n := <-testInstance.timers:
+		if n != 1 {
+			t.Errorf("Test failed. Expected 1 exchange, received %d", n)
+		}
+	case <-time.After(time.Second):
+		t.Error("Test failed. Expected OnTimer to be called")
+	}
+
+	err = StopStrategy("testing")
+	if err != nil {
+		t.Error("Test failed. StopStrategy error", err)
+	}
+
+	if !testInstance.stopped || GetStrategies()[0].Running {
+		t.Error("Test failed. Expected strategy to be stopped")
+	}
+
+	err = StopStrategy("testing")
+	if err != errNotRunning {
+		t.Errorf("Test failed. Expected %s, received %v", errNotRunning, err)
+	}
+
+	err = StartStrategy("invalid")
+	if err != errStrategyNotFound {
+		t.Errorf("Test failed. Expected %s, received %v", errStrategyNotFound, err)
+	}
+}
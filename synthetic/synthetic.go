@@ -0,0 +1,517 @@
+package synthetic
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	"github.com/thrasher-/gocryptotrader/databus"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+// Type defines the behaviour of a synthetic order
+type Type string
+
+// Synthetic order types
+const (
+	// StopLoss fires when the price moves through the stop price against the
+	// position
+	StopLoss Type = "STOP_LOSS"
+	// TakeProfit fires when the price moves through the take profit price in
+	// favour of the position
+	TakeProfit Type = "TAKE_PROFIT"
+	// OCO holds a stop loss and a take profit, the first to trigger fires and
+	// the other is cancelled
+	OCO Type = "OCO"
+	// TrailingStop is a stop loss whose stop price follows the best price seen
+	// by the trailing offset
+	TrailingStop Type = "TRAILING_STOP"
+)
+
+// Status defines the state of a synthetic order
+type Status string
+
+// Synthetic order statuses
+const (
+	Pending   Status = "PENDING"
+	Triggered Status = "TRIGGERED"
+	Submitted Status = "SUBMITTED"
+	Failed    Status = "FAILED"
+	Cancelled Status = "CANCELLED"
+)
+
+const (
+	ordersFile        = "synthetic_orders.json"
+	maxPendingUpdates = 1000
+
+	// saveInterval is how often trailing stop moves are persisted, status
+	// changes are saved immediately
+	saveInterval = 10 * time.Second
+
+	// ClientIDPrefix prefixes the client ID of orders fired by synthetic
+	// orders, followed by the synthetic order ID
+	ClientIDPrefix = "synthetic-"
+)
+
+var (
+	errInvalidExchange = errors.New("exchange name must be set")
+	errInvalidSide     = errors.New("side must be buy or sell")
+	errInvalidAmount   = errors.New("amount must be greater than zero")
+	errInvalidType     = errors.New("invalid synthetic order type")
+	errNoStopPrice     = errors.New("stop price must be greater than zero")
+	errNoTakeProfit    = errors.New("take profit price must be greater than zero")
+	errNoOffset        = errors.New("trailing offset must be greater than zero")
+	errInvalidOCO      = errors.New("stop price must be on the losing side of the take profit price")
+	errAlreadyStarted  = errors.New("synthetic order manager already started")
+	errNotStarted      = errors.New("synthetic order manager not started")
+	errInterrupted     = errors.New("shut down before the order was submitted")
+)
+
+// SubmitFunc submits the order fired by a triggered synthetic order
+type SubmitFunc func(exchName string, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error)
+
+// Order is an order held by the bot which fires a market order, or a limit
+// order at the limit price, when its trigger condition is met
+type Order struct {
+	ID              int                `json:"id"`
+	Exchange        string             `json:"exchange"`
+	Pair            pair.CurrencyPair  `json:"pair"`
+	Asset           string             `json:"asset"`
+	Side            exchange.OrderSide `json:"side"`
+	Type            Type               `json:"type"`
+	Amount          float64            `json:"amount"`
+	StopPrice       float64            `json:"stopPrice"`
+	TakeProfitPrice float64            `json:"takeProfitPrice"`
+	LimitPrice      float64            `json:"limitPrice"`
+	TrailingOffset  float64            `json:"trailingOffset"`
+	BestPrice       float64            `json:"bestPrice"`
+	TriggerPrice    float64            `json:"triggerPrice"`
+	Status          Status             `json:"status"`
+	ExchangeOrderID string             `json:"exchangeOrderID"`
+	Error           string             `json:"error"`
+	Created         time.Time          `json:"created"`
+	LastUpdated     time.Time          `json:"lastUpdated"`
+}
+
+// Orders holds all synthetic orders
+var Orders []*Order
+
+// Vars for the synthetic order manager routine
+var (
+	m          sync.Mutex
+	started    bool
+	ordersPath string
+	dirty      bool
+	submit     SubmitFunc
+	updates    *databus.Subscription
+	shutdown   chan struct{}
+	wg         sync.WaitGroup
+)
+
+// Validate checks the order holds the prices its type requires
+func (o *Order) Validate() error {
+	if o.Exchange == "" {
+		return errInvalidExchange
+	}
+
+	if o.Side != exchange.Buy && o.Side != exchange.Sell {
+		return errInvalidSide
+	}
+
+	if o.Amount <= 0 {
+		return errInvalidAmount
+	}
+
+	switch o.Type {
+	case StopLoss:
+		if o.StopPrice <= 0 {
+			return errNoStopPrice
+		}
+	case TakeProfit:
+		if o.TakeProfitPrice <= 0 {
+			return errNoTakeProfit
+		}
+	case OCO:
+		if o.StopPrice <= 0 {
+			return errNoStopPrice
+		}
+		if o.TakeProfitPrice <= 0 {
+			return errNoTakeProfit
+		}
+		if (o.Side == exchange.Sell) != (o.StopPrice < o.TakeProfitPrice) {
+			return errInvalidOCO
+		}
+	case TrailingStop:
+		if o.TrailingOffset <= 0 {
+			return errNoOffset
+		}
+	default:
+		return errInvalidType
+	}
+	return nil
+}
+
+// stopHit returns whether the price has moved through the stop price, sells
+// stop as the price falls and buys as it rises
+func (o *Order) stopHit(price float64) bool {
+	if o.Side == exchange.Sell {
+		return price <= o.StopPrice
+	}
+	return price >= o.StopPrice
+}
+
+// takeProfitHit returns whether the price has moved through the take profit
+// price, sells take profit as the price rises and buys as it falls
+func (o *Order) takeProfitHit(price float64) bool {
+	if o.Side == exchange.Sell {
+		return price >= o.TakeProfitPrice
+	}
+	return price <= o.TakeProfitPrice
+}
+
+// update applies a price to the order, moving the stop of a trailing stop, and
+// returns whether the order state changed and whether it triggered
+func (o *Order) update(price float64) (changed, triggered bool) {
+	if o.Type == TrailingStop {
+		if o.BestPrice == 0 ||
+			(o.Side == exchange.Sell && price > o.BestPrice) ||
+			(o.Side == exchange.Buy && price < o.BestPrice) {
+			o.BestPrice = price
+			o.StopPrice = price - o.TrailingOffset
+			if o.Side == exchange.Buy {
+				o.StopPrice = price + o.TrailingOffset
+			}
+			changed = true
+		}
+	}
+
+	switch o.Type {
+	case StopLoss, TrailingStop:
+		triggered = o.stopHit(price)
+	case TakeProfit:
+		triggered = o.takeProfitHit(price)
+	case OCO:
+		triggered = o.stopHit(price) || o.takeProfitHit(price)
+	}
+	return changed || triggered, triggered
+}
+
+// String returns a readable description of the order
+func (o *Order) String() string {
+	return fmt.Sprintf("%s %s %f %s [%s] on %s", o.Type, o.Side, o.Amount,
+		o.Pair.Pair(), o.Asset, o.Exchange)
+}
+
+// Add validates and adds a synthetic order, returning its ID
+func Add(o Order) (int, error) {
+	err := o.Validate()
+	if err != nil {
+		return 0, err
+	}
+
+	if o.Asset == "" {
+		o.Asset = ticker.Spot
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	o.ID = 0
+	for i := range Orders {
+		if Orders[i].ID >= o.ID {
+			o.ID = Orders[i].ID + 1
+		}
+	}
+
+	o.BestPrice = 0
+	o.TriggerPrice = 0
+	o.ExchangeOrderID = ""
+	o.Error = ""
+	o.Status = Pending
+	o.Created = time.Now()
+	o.LastUpdated = o.Created
+	Orders = append(Orders, &o)
+	saveOrders()
+	return o.ID, nil
+}
+
+// Cancel cancels a pending synthetic order by its ID
+func Cancel(id int) bool {
+	m.Lock()
+	defer m.Unlock()
+	for i := range Orders {
+		if Orders[i].ID == id && Orders[i].Status == Pending {
+			Orders[i].Status = Cancelled
+			Orders[i].LastUpdated = time.Now()
+			saveOrders()
+			return true
+		}
+	}
+	return false
+}
+
+// GetOrders returns a copy of all synthetic orders
+func GetOrders() []Order {
+	m.Lock()
+	defer m.Unlock()
+	orders := make([]Order, len(Orders))
+	for i := range Orders {
+		orders[i] = *Orders[i]
+	}
+	return orders
+}
+
+// CheckPrices checks the pending orders for an exchange, currency pair and
+// asset type against the latest prices, sells are checked against the bid and
+// buys against the ask. Triggered orders are submitted and the number of
+// orders triggered is returned
+func CheckPrices(exchName string, p pair.CurrencyPair, assetType string, bid, ask float64) int {
+	m.Lock()
+	var fired []*Order
+	for _, o := range Orders {
+		if o.Status != Pending ||
+			common.StringToUpper(o.Exchange) != common.StringToUpper(exchName) ||
+			o.Asset != assetType ||
+			!o.Pair.Equal(p, true) {
+			continue
+		}
+
+		price := ask
+		if o.Side == exchange.Sell {
+			price = bid
+		}
+		if price <= 0 {
+			continue
+		}
+
+		updated, triggered := o.update(price)
+		if !updated {
+			continue
+		}
+
+		dirty = true
+		o.LastUpdated = time.Now()
+		if triggered {
+			o.Status = Triggered
+			o.TriggerPrice = price
+			fired = append(fired, o)
+		}
+	}
+
+	// Trailing stop moves are left for the manager routine to save
+	if len(fired) > 0 {
+		saveOrders()
+	}
+	submitFunc := submit
+	m.Unlock()
+
+	for i := range fired {
+		fire(fired[i], submitFunc)
+	}
+	return len(fired)
+}
+
+// fire submits the order of a triggered synthetic order and records the
+// result
+func fire(o *Order, submitFunc SubmitFunc) {
+	m.Lock()
+	s := *o
+	m.Unlock()
+
+	log.Printf("Synthetic order %d triggered at %f: %s.\n", s.ID,
+		s.TriggerPrice, s.String())
+
+	orderType := exchange.Market
+	if s.LimitPrice > 0 {
+		orderType = exchange.Limit
+	}
+
+	var resp exchange.SubmitOrderResponse
+	err := errNotStarted
+	if submitFunc != nil {
+		resp, err = submitFunc(s.Exchange, exchange.SubmitOrder{
+			Pair:      s.Pair,
+			AssetType: s.Asset,
+			Side:      s.Side,
+			OrderType: orderType,
+			Amount:    s.Amount,
+			Price:     s.LimitPrice,
+			ClientID:  ClientIDPrefix + strconv.Itoa(s.ID),
+		})
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	o.ExchangeOrderID = resp.OrderID
+	o.Status = Submitted
+	if err != nil || !resp.IsOrderPlaced {
+		o.Status = Failed
+		if err != nil {
+			o.Error = err.Error()
+		}
+		log.Printf("Synthetic order %d failed to submit. Error: %v\n", s.ID, err)
+	}
+	o.LastUpdated = time.Now()
+	saveOrders()
+}
+
+// Start loads any synthetic orders persisted in the supplied data directory
+// and starts the synthetic order manager routine, which fires orders using the
+// submit function
+func Start(dataDir string, submitFunc SubmitFunc) error {
+	m.Lock()
+	defer m.Unlock()
+	if started {
+		return errAlreadyStarted
+	}
+
+	ordersPath = dataDir + common.GetOSPathSlash() + ordersFile
+	err := loadOrders()
+	if err != nil {
+		return err
+	}
+
+	submit = submitFunc
+	updates = databus.Subscribe(databus.Filter{
+		Topics: []string{databus.Ticker, databus.Orderbook},
+	}, maxPendingUpdates, databus.DropOldest)
+	shutdown = make(chan struct{})
+	started = true
+
+	wg.Add(1)
+	go run(updates.C, shutdown)
+	log.Printf("Synthetic order manager started: Have %d order(s) loaded.\n",
+		len(Orders))
+	return nil
+}
+
+// Stop shuts down the synthetic order manager routine and persists all
+// synthetic orders to the data directory
+func Stop() error {
+	m.Lock()
+	if !started {
+		m.Unlock()
+		return errNotStarted
+	}
+	started = false
+	close(shutdown)
+	updates.Unsubscribe()
+	m.Unlock()
+
+	wg.Wait()
+
+	m.Lock()
+	defer m.Unlock()
+	return saveOrdersFile()
+}
+
+// IsRunning returns whether or not the synthetic order manager routine is
+// running
+func IsRunning() bool {
+	m.Lock()
+	defer m.Unlock()
+	return started
+}
+
+// run checks synthetic orders against ticker and orderbook updates from the
+// data bus until shutdown
+func run(updates <-chan databus.Message, shutdown <-chan struct{}) {
+	defer wg.Done()
+	save := time.NewTicker(saveInterval)
+	defer save.Stop()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case <-save.C:
+			m.Lock()
+			if dirty {
+				saveOrders()
+			}
+			m.Unlock()
+		case u, ok := <-updates:
+			if !ok {
+				return
+			}
+
+			switch data := u.Data.(type) {
+			case ticker.Price:
+				CheckPrices(u.Exchange, u.Pair, u.Asset, data.Last, data.Last)
+			case orderbook.Base:
+				bid, err := data.BestBid()
+				if err != nil {
+					continue
+				}
+				ask, err := data.BestAsk()
+				if err != nil {
+					continue
+				}
+				CheckPrices(u.Exchange, u.Pair, u.Asset, bid.Price, ask.Price)
+			}
+		}
+	}
+}
+
+// loadOrders reads the persisted synthetic orders from the orders file, if
+// any
+func loadOrders() error {
+	data, err := common.ReadFile(ordersPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	var loaded []*Order
+	err = common.JSONDecode(data, &loaded)
+	if err != nil {
+		return fmt.Errorf("unable to decode synthetic orders file %s. Error: %s",
+			ordersPath, err)
+	}
+
+	// Orders triggered before a shutdown may or may not have been submitted,
+	// they are failed rather than risk firing them twice
+	for i := range loaded {
+		if loaded[i].Status == Triggered {
+			loaded[i].Status = Failed
+			loaded[i].Error = errInterrupted.Error()
+		}
+	}
+	Orders = loaded
+	return nil
+}
+
+// saveOrders persists synthetic orders if the manager is running, errors are
+// logged as callers can't act on them. It must be called with m held
+func saveOrders() {
+	if !started {
+		return
+	}
+	dirty = false
+	err := saveOrdersFile()
+	if err != nil {
+		log.Printf("Synthetic order manager: failed to save orders. Error: %s\n",
+			err)
+	}
+}
+
+// saveOrdersFile writes all synthetic orders to the orders file
+func saveOrdersFile() error {
+	if ordersPath == "" {
+		return nil
+	}
+
+	data, err := common.JSONEncode(Orders)
+	if err != nil {
+		return err
+	}
+	return common.WriteFile(ordersPath, data)
+}
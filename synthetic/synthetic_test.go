@@ -0,0 +1,278 @@
+package synthetic
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/thrasher-/gocryptotrader/currency/pair"
+	exchange "github.com/thrasher-/gocryptotrader/exchanges"
+	"github.com/thrasher-/gocryptotrader/exchanges/ticker"
+)
+
+var testPair = pair.NewCurrencyPair("BTC", "USD")
+
+type submission struct {
+	side      exchange.OrderSide
+	orderType exchange.OrderType
+	price     float64
+	clientID  string
+}
+
+func testSubmitter(submitted *[]submission, err error) SubmitFunc {
+	return func(exchName string, order exchange.SubmitOrder) (exchange.SubmitOrderResponse, error) {
+		*submitted = append(*submitted, submission{order.Side, order.OrderType,
+			order.Price, order.ClientID})
+		if err != nil {
+			return exchange.SubmitOrderResponse{}, err
+		}
+		return exchange.SubmitOrderResponse{IsOrderPlaced: true, OrderID: "1"}, nil
+	}
+}
+
+func resetOrders() {
+	m.Lock()
+	Orders = nil
+	m.Unlock()
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		order Order
+		valid bool
+	}{
+		{Order{Exchange: "Yobit", Side: exchange.Sell, Type: StopLoss, Amount: 1, StopPrice: 90}, true},
+		{Order{Side: exchange.Sell, Type: StopLoss, Amount: 1, StopPrice: 90}, false},
+		{Order{Exchange: "Yobit", Side: "Hold", Type: StopLoss, Amount: 1, StopPrice: 90}, false},
+		{Order{Exchange: "Yobit", Side: exchange.Sell, Type: StopLoss, StopPrice: 90}, false},
+		{Order{Exchange: "Yobit", Side: exchange.Sell, Type: StopLoss, Amount: 1}, false},
+		{Order{Exchange: "Yobit", Side: exchange.Sell, Type: TakeProfit, Amount: 1}, false},
+		{Order{Exchange: "Yobit", Side: exchange.Sell, Type: OCO, Amount: 1, StopPrice: 90, TakeProfitPrice: 110}, true},
+		{Order{Exchange: "Yobit", Side: exchange.Buy, Type: OCO, Amount: 1, StopPrice: 90, TakeProfitPrice: 110}, false},
+		{Order{Exchange: "Yobit", Side: exchange.Buy, Type: TrailingStop, Amount: 1}, false},
+		{Order{Exchange: "Yobit", Side: exchange.Buy, Type: "ICEBERG", Amount: 1}, false},
+	}
+
+	for i := range tests {
+		err := tests[i].order.Validate()
+		if (err == nil) != tests[i].valid {
+			t.Errorf("Test failed. Order %d expected valid %v, received %v",
+				i, tests[i].valid, err)
+		}
+	}
+}
+
+func TestCheckPrices(t *testing.T) {
+	resetOrders()
+	var submitted []submission
+	submit = testSubmitter(&submitted, nil)
+	defer func() { submit = nil }()
+
+	stopID, err := Add(Order{Exchange: "Yobit", Pair: testPair, Side: exchange.Sell,
+		Type: StopLoss, Amount: 1, StopPrice: 90})
+	if err != nil {
+		t.Fatal("Test failed. Add error", err)
+	}
+
+	ocoID, err := Add(Order{Exchange: "Yobit", Pair: testPair, Side: exchange.Buy,
+		Type: OCO, Amount: 1, StopPrice: 110, TakeProfitPrice: 80, LimitPrice: 81})
+	if err != nil {
+		t.Fatal("Test failed. Add error", err)
+	}
+
+	if stopID == ocoID {
+		t.Fatal("Test failed. Expected unique order IDs")
+	}
+
+	if CheckPrices("Yobit", testPair, ticker.Spot, 95, 96) != 0 {
+		t.Error("Test failed. Expected no orders to trigger")
+	}
+
+	if CheckPrices("yobit", testPair, ticker.Spot, 89, 90) != 1 {
+		t.Error("Test failed. Expected the stop loss to trigger")
+	}
+
+	if CheckPrices("Yobit", testPair, "FUTURES", 70, 70) != 0 {
+		t.Error("Test failed. Expected other asset types to be ignored")
+	}
+
+	if CheckPrices("Yobit", testPair, ticker.Spot, 70, 79) != 1 {
+		t.Error("Test failed. Expected the OCO take profit to trigger")
+	}
+
+	if len(submitted) != 2 || submitted[0].orderType != exchange.Market ||
+		submitted[1].orderType != exchange.Limit || submitted[1].price != 81 ||
+		submitted[1].side != exchange.Buy ||
+		submitted[0].clientID != ClientIDPrefix+"0" {
+		t.Errorf("Test failed. Unexpected submissions %+v", submitted)
+	}
+
+	orders := GetOrders()
+	if orders[0].Status != Submitted || orders[0].TriggerPrice != 89 ||
+		orders[0].ExchangeOrderID != "1" {
+		t.Errorf("Test failed. Unexpected stop loss state %+v", orders[0])
+	}
+
+	if Cancel(ocoID) {
+		t.Error("Test failed. Expected a fired order not to be cancellable")
+	}
+}
+
+func TestTrailingStop(t *testing.T) {
+	resetOrders()
+	var submitted []submission
+	submit = testSubmitter(&submitted, errors.New("rejected"))
+	defer func() { submit = nil }()
+
+	id, err := Add(Order{Exchange: "Gateio", Pair: testPair, Side: exchange.Sell,
+		Type: TrailingStop, Amount: 1, TrailingOffset: 10})
+	if err != nil {
+		t.Fatal("Test failed. Add error", err)
+	}
+
+	for _, price := range []float64{100, 120, 115, 111} {
+		if CheckPrices("Gateio", testPair, ticker.Spot, price, price) != 0 {
+			t.Fatalf("Test failed. Trailing stop triggered at %f", price)
+		}
+	}
+
+	if GetOrders()[0].StopPrice != 110 {
+		t.Errorf("Test failed. Expected stop to trail at 110, received %f",
+			GetOrders()[0].StopPrice)
+	}
+
+	if CheckPrices("Gateio", testPair, ticker.Spot, 110, 110) != 1 {
+		t.Fatal("Test failed. Expected trailing stop to trigger")
+	}
+
+	order := GetOrders()[0]
+	if order.Status != Failed || order.Error != "rejected" || len(submitted) != 1 {
+		t.Errorf("Test failed. Expected failed submission, received %+v", order)
+	}
+
+	if Cancel(id) {
+		t.Error("Test failed. Expected a failed order not to be cancellable")
+	}
+}
+
+func TestStartStop(t *testing.T) {
+	resetOrders()
+	dir, err := ioutil.TempDir("", "synthetic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = Start(dir, nil)
+	if err != nil {
+		t.Fatal("Test failed. Start error", err)
+	}
+
+	if Start(dir, nil) == nil {
+		t.Error("Test failed. Expected error starting twice")
+	}
+
+	id, err := Add(Order{Exchange: "ZB", Pair: testPair, Side: exchange.Buy,
+		Type: TakeProfit, Amount: 1, TakeProfitPrice: 50})
+	if err != nil {
+		t.Fatal("Test failed. Add error", err)
+	}
+
+	_, err = Add(Order{Exchange: "ZB", Pair: testPair, Side: exchange.Buy,
+		Type: StopLoss, Amount: 1, StopPrice: 150})
+	if err != nil {
+		t.Fatal("Test failed. Add error", err)
+	}
+
+	m.Lock()
+	Orders[1].Status = Triggered
+	m.Unlock()
+
+	if !Cancel(id) {
+		t.Error("Test failed. Expected pending order to be cancelled")
+	}
+
+	err = Stop()
+	if err != nil {
+		t.Fatal("Test failed. Stop error", err)
+	}
+
+	if Stop() == nil {
+		t.Error("Test failed. Expected error stopping twice")
+	}
+
+	resetOrders()
+	err = Start(dir, nil)
+	if err != nil {
+		t.Fatal("Test failed. Start error", err)
+	}
+	defer Stop()
+
+	orders := GetOrders()
+	if len(orders) != 2 || orders[0].Status != Cancelled ||
+		orders[1].Status != Failed {
+		t.Errorf("Test failed. Unexpected persisted orders %+v", orders)
+	}
+}
+
+func TestTrailingStopSaveThrottled(t *testing.T) {
+	resetOrders()
+	dir, err := ioutil.TempDir("", "synthetic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = Start(dir, nil)
+	if err != nil {
+		t.Fatal("Test failed. Start error", err)
+	}
+
+	_, err = Add(Order{Exchange: "Gateio", Pair: testPair, Side: exchange.Sell,
+		Type: TrailingStop, Amount: 1, TrailingOffset: 10})
+	if err != nil {
+		t.Fatal("Test failed. Add error", err)
+	}
+
+	saved, err := ioutil.ReadFile(ordersPath)
+	if err != nil {
+		t.Fatal("Test failed. ReadFile error", err)
+	}
+
+	for _, price := range []float64{100, 120} {
+		CheckPrices("Gateio", testPair, ticker.Spot, price, price)
+	}
+
+	data, err := ioutil.ReadFile(ordersPath)
+	if err != nil {
+		t.Fatal("Test failed. ReadFile error", err)
+	}
+	if string(data) != string(saved) {
+		t.Error("Test failed. Expected trailing stop moves not to be saved immediately")
+	}
+
+	m.Lock()
+	pending := dirty
+	m.Unlock()
+	if !pending {
+		t.Error("Test failed. Expected trailing stop moves to be pending a save")
+	}
+
+	err = Stop()
+	if err != nil {
+		t.Fatal("Test failed. Stop error", err)
+	}
+
+	resetOrders()
+	err = Start(dir, nil)
+	if err != nil {
+		t.Fatal("Test failed. Start error", err)
+	}
+	defer Stop()
+
+	if orders := GetOrders(); len(orders) != 1 || orders[0].StopPrice != 110 {
+		t.Errorf("Test failed. Expected the trailing stop saved on Stop, received %+v",
+			orders)
+	}
+}
@@ -13,6 +13,8 @@ import (
 )
 
 const (
+	arbitragePath                   = "..%s..%sarbitrage%s"
+	backtesterPath                  = "..%s..%sbacktester%s"
 	commonPath                      = "..%s..%scommon%s"
 	communicationsPath              = "..%s..%scommunications%s"
 	communicationsBasePath          = "..%s..%scommunications%sbase%s"
@@ -31,6 +33,7 @@ const (
 	currencyPairPath                = "..%s..%scurrency%spair%s"
 	currencySymbolPath              = "..%s..%scurrency%ssymbol%s"
 	currencyTranslationPath         = "..%s..%scurrency%stranslation%s"
+	databusPath                     = "..%s..%sdatabus%s"
 	eventsPath                      = "..%s..%sevents%s"
 	exchangesPath                   = "..%s..%sexchanges%s"
 	exchangesNoncePath              = "..%s..%sexchanges%snonce%s"
@@ -38,8 +41,14 @@ const (
 	exchangesStatsPath              = "..%s..%sexchanges%sstats%s"
 	exchangesTickerPath             = "..%s..%sexchanges%sticker%s"
 	exchangesOrdersPath             = "..%s..%sexchanges%sorders%s"
+	exchangesPositionsPath          = "..%s..%sexchanges%spositions%s"
+	exchangesPaperPath              = "..%s..%sexchanges%spaper%s"
+	exchangesMockPath               = "..%s..%sexchanges%smock%s"
 	exchangesRequestPath            = "..%s..%sexchanges%srequest%s"
+	marketdataPath                  = "..%s..%smarketdata%s"
 	portfolioPath                   = "..%s..%sportfolio%s"
+	strategiesPath                  = "..%s..%sstrategies%s"
+	syntheticPath                   = "..%s..%ssynthetic%s"
 	testdataPath                    = "..%s..%stestdata%s"
 	toolsPath                       = "..%s..%stools%s"
 	webPath                         = "..%s..%sweb%s"
@@ -205,9 +214,16 @@ func addPaths() {
 	codebasePaths["currency symbol"] = fmt.Sprintf(currencySymbolPath, path, path, path, path)
 	codebasePaths["currency translation"] = fmt.Sprintf(currencyTranslationPath, path, path, path, path)
 
+	codebasePaths["databus"] = fmt.Sprintf(databusPath, path, path, path)
 	codebasePaths["events"] = fmt.Sprintf(eventsPath, path, path, path)
 
+	codebasePaths["arbitrage"] = fmt.Sprintf(arbitragePath, path, path, path)
+	codebasePaths["backtester"] = fmt.Sprintf(backtesterPath, path, path, path)
+
+	codebasePaths["marketdata"] = fmt.Sprintf(marketdataPath, path, path, path)
 	codebasePaths["portfolio"] = fmt.Sprintf(portfolioPath, path, path, path)
+	codebasePaths["strategies"] = fmt.Sprintf(strategiesPath, path, path, path)
+	codebasePaths["synthetic"] = fmt.Sprintf(syntheticPath, path, path, path)
 	codebasePaths["testdata"] = fmt.Sprintf(testdataPath, path, path, path)
 	codebasePaths["tools"] = fmt.Sprintf(toolsPath, path, path, path)
 	codebasePaths["web"] = fmt.Sprintf(webPath, path, path, path)
@@ -219,6 +235,9 @@ func addPaths() {
 	codebasePaths["exchanges stats"] = fmt.Sprintf(exchangesStatsPath, path, path, path, path)
 	codebasePaths["exchanges ticker"] = fmt.Sprintf(exchangesTickerPath, path, path, path, path)
 	codebasePaths["exchanges orders"] = fmt.Sprintf(exchangesOrdersPath, path, path, path, path)
+	codebasePaths["exchanges positions"] = fmt.Sprintf(exchangesPositionsPath, path, path, path, path)
+	codebasePaths["exchanges paper"] = fmt.Sprintf(exchangesPaperPath, path, path, path, path)
+	codebasePaths["exchanges mock"] = fmt.Sprintf(exchangesMockPath, path, path, path, path)
 	codebasePaths["exchanges request"] = fmt.Sprintf(exchangesRequestPath, path, path, path, path)
 
 	codebasePaths["exchanges alphapoint"] = fmt.Sprintf(alphapoint, path, path, path, path)
@@ -301,14 +320,20 @@ func getslashFromName(packageName string) string {
 }
 
 var globS = []string{
+	fmt.Sprintf("arbitrage_templates%s*", common.GetOSPathSlash()),
+	fmt.Sprintf("backtester_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("common_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("communications_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("config_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("currency_templates%s*", common.GetOSPathSlash()),
+	fmt.Sprintf("databus_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("events_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("exchanges_templates%s*", common.GetOSPathSlash()),
+	fmt.Sprintf("marketdata_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("portfolio_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("root_templates%s*", common.GetOSPathSlash()),
+	fmt.Sprintf("strategies_templates%s*", common.GetOSPathSlash()),
+	fmt.Sprintf("synthetic_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("sub_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("testdata_templates%s*", common.GetOSPathSlash()),
 	fmt.Sprintf("tools_templates%s*", common.GetOSPathSlash()),
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/thrasher-/gocryptotrader/common"
+	"github.com/thrasher-/gocryptotrader/exchanges/mock"
+)
+
+func main() {
+	var listen, script, pairs, prices, balances, key, secret string
+	var interval, latency time.Duration
+	var errorRate, fee, volatility float64
+	var steps int
+
+	flag.StringVar(&listen, "listen", "127.0.0.1:8090", "the address the mock exchange listens on")
+	flag.StringVar(&script, "script", "", "a JSON file of price paths by symbol, e.g. {\"BTC-USD\": [10000, 10100]}")
+	flag.StringVar(&pairs, "pairs", "BTC-USD", "the symbols to random walk when no script is supplied")
+	flag.StringVar(&prices, "prices", "10000", "the starting price of each random walk symbol")
+	flag.IntVar(&steps, "steps", 10000, "the number of random walk steps")
+	flag.Float64Var(&volatility, "volatility", 0.001, "the maximum fractional price move of each random walk step")
+	flag.DurationVar(&interval, "interval", time.Second, "how often prices step, zero only steps on POST /admin/step")
+	flag.DurationVar(&latency, "latency", 0, "the latency added to every response")
+	flag.Float64Var(&errorRate, "errorrate", 0, "the fraction of API requests answered with a 500 error")
+	flag.Float64Var(&fee, "fee", 0.001, "the fractional trading fee")
+	flag.StringVar(&balances, "balances", "USD:100000,BTC:10", "the starting balances")
+	flag.StringVar(&key, "key", "", "the API key, requests are not authenticated when empty")
+	flag.StringVar(&secret, "secret", "", "the API secret")
+	flag.Parse()
+
+	cfg := mock.ServerConfig{
+		Prices:    make(map[string][]float64),
+		Interval:  interval,
+		Latency:   latency,
+		ErrorRate: errorRate,
+		Fee:       fee,
+		APIKey:    key,
+		APISecret: secret,
+		Balances:  make(map[string]float64),
+	}
+
+	if script != "" {
+		data, err := common.ReadFile(script)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = common.JSONDecode(data, &cfg.Prices)
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		symbols := common.SplitStrings(pairs, ",")
+		startPrices := common.SplitStrings(prices, ",")
+		for i := range symbols {
+			start, err := strconv.ParseFloat(startPrices[i%len(startPrices)], 64)
+			if err != nil {
+				log.Fatal(err)
+			}
+			cfg.Prices[symbols[i]] = mock.RandomWalk(start, steps, volatility)
+		}
+	}
+
+	for _, balance := range common.SplitStrings(balances, ",") {
+		data := common.SplitStrings(balance, ":")
+		if len(data) != 2 {
+			log.Fatalf("Invalid balance %s, expected CURRENCY:AMOUNT", balance)
+		}
+
+		amount, err := strconv.ParseFloat(data[1], 64)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cfg.Balances[data[0]] = amount
+	}
+
+	server := mock.NewServer(cfg)
+	server.Start()
+
+	go func() {
+		log.Printf("Mock exchange listening on %s with %d symbols.\n", listen, len(cfg.Prices))
+		log.Fatal(http.ListenAndServe(listen, server))
+	}()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	<-interrupt
+
+	server.Stop()
+	log.Println("Mock exchange stopped.")
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -127,7 +128,7 @@ func main() {
 			}
 		} else {
 			bf := bitfinex.Bitfinex{}
-			ticker, errf := bf.GetTicker(y.Coin + "USD")
+			ticker, errf := bf.GetTicker(context.Background(), y.Coin+"USD")
 			if errf != nil {
 				log.Println(errf)
 			} else {
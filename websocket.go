@@ -2,6 +2,7 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
 
@@ -9,6 +10,9 @@ import (
 	"github.com/thrasher-/gocryptotrader/common"
 	"github.com/thrasher-/gocryptotrader/config"
 	"github.com/thrasher-/gocryptotrader/currency"
+	"github.com/thrasher-/gocryptotrader/events"
+	"github.com/thrasher-/gocryptotrader/exchanges/orders"
+	"github.com/thrasher-/gocryptotrader/strategies"
 )
 
 // Const vars for websocket
@@ -37,6 +41,13 @@ var wsHandlers = map[string]wsCommandHandler{
 	"getorderbook":     {authRequired: false, handler: wsGetOrderbook},
 	"getexchangerates": {authRequired: false, handler: wsGetExchangeRates},
 	"getportfolio":     {authRequired: true, handler: wsGetPortfolio},
+	"getevents":        {authRequired: true, handler: wsGetEvents},
+	"addevent":         {authRequired: true, handler: wsAddEvent},
+	"removeevent":      {authRequired: true, handler: wsRemoveEvent},
+	"getorders":        {authRequired: true, handler: wsGetOrders},
+	"getstrategies":    {authRequired: true, handler: wsGetStrategies},
+	"startstrategy":    {authRequired: true, handler: wsStartStrategy},
+	"stopstrategy":     {authRequired: true, handler: wsStopStrategy},
 }
 
 // WebsocketClient stores information related to the websocket client
@@ -361,7 +372,7 @@ func wsSaveConfig(client *WebsocketClient, data interface{}) error {
 }
 
 func wsGetAccountInfo(client *WebsocketClient, data interface{}) error {
-	accountInfo := GetAllEnabledExchangeAccountInfo()
+	accountInfo := GetAllEnabledExchangeAccountInfo(bot.ctx)
 	wsResp := WebsocketEventResponse{
 		Event: "GetAccountInfo",
 		Data:  accountInfo,
@@ -373,7 +384,7 @@ func wsGetTickers(client *WebsocketClient, data interface{}) error {
 	wsResp := WebsocketEventResponse{
 		Event: "GetTickers",
 	}
-	wsResp.Data = GetAllActiveTickers()
+	wsResp.Data = GetAllActiveTickers(bot.ctx)
 	return client.SendWebsocketMessage(wsResp)
 }
 
@@ -389,7 +400,7 @@ func wsGetTicker(client *WebsocketClient, data interface{}) error {
 		return err
 	}
 
-	result, err := GetSpecificTicker(tickerReq.Currency,
+	result, err := GetSpecificTicker(bot.ctx, tickerReq.Currency,
 		tickerReq.Exchange, tickerReq.AssetType)
 
 	if err != nil {
@@ -405,7 +416,7 @@ func wsGetOrderbooks(client *WebsocketClient, data interface{}) error {
 	wsResp := WebsocketEventResponse{
 		Event: "GetOrderbooks",
 	}
-	wsResp.Data = GetAllActiveOrderbooks()
+	wsResp.Data = GetAllActiveOrderbooks(bot.ctx)
 	return client.SendWebsocketMessage(wsResp)
 }
 
@@ -421,7 +432,7 @@ func wsGetOrderbook(client *WebsocketClient, data interface{}) error {
 		return err
 	}
 
-	result, err := GetSpecificOrderbook(orderbookReq.Currency,
+	result, err := GetSpecificOrderbook(bot.ctx, orderbookReq.Currency,
 		orderbookReq.Exchange, orderbookReq.AssetType)
 
 	if err != nil {
@@ -448,3 +459,108 @@ func wsGetPortfolio(client *WebsocketClient, data interface{}) error {
 	wsResp.Data = bot.portfolio.GetPortfolioSummary()
 	return client.SendWebsocketMessage(wsResp)
 }
+
+func wsGetEvents(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetEvents",
+	}
+	wsResp.Data = events.GetEvents()
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsAddEvent(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "AddEvent",
+	}
+	var eventReq EventRequest
+	err := common.JSONDecode(data.([]byte), &eventReq)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	id, err := AddEvent(eventReq.Exchange, eventReq.Item, eventReq.Condition,
+		eventReq.Currency, eventReq.AssetType, eventReq.Action)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+	wsResp.Data = EventResponse{ID: id}
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsRemoveEvent(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "RemoveEvent",
+	}
+	var eventReq EventResponse
+	err := common.JSONDecode(data.([]byte), &eventReq)
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+
+	if !events.RemoveEvent(eventReq.ID) {
+		err = fmt.Errorf("event ID %d not found", eventReq.ID)
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+	wsResp.Data = WebsocketResponseSuccess
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetOrders(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetOrders",
+	}
+	wsResp.Data = orders.GetOrders()
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsGetStrategies(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "GetStrategies",
+	}
+	wsResp.Data = strategies.GetStrategies()
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsStartStrategy(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "StartStrategy",
+	}
+	var strategyReq StrategyRequest
+	err := common.JSONDecode(data.([]byte), &strategyReq)
+	if err == nil {
+		err = strategies.StartStrategy(strategyReq.Name)
+	}
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+	wsResp.Data = WebsocketResponseSuccess
+	return client.SendWebsocketMessage(wsResp)
+}
+
+func wsStopStrategy(client *WebsocketClient, data interface{}) error {
+	wsResp := WebsocketEventResponse{
+		Event: "StopStrategy",
+	}
+	var strategyReq StrategyRequest
+	err := common.JSONDecode(data.([]byte), &strategyReq)
+	if err == nil {
+		err = strategies.StopStrategy(strategyReq.Name)
+	}
+	if err != nil {
+		wsResp.Error = err.Error()
+		client.SendWebsocketMessage(wsResp)
+		return err
+	}
+	wsResp.Data = WebsocketResponseSuccess
+	return client.SendWebsocketMessage(wsResp)
+}